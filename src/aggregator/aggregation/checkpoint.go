@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"time"
+)
+
+var errMalformedCheckpoint = errors.New("malformed aggregation checkpoint")
+
+// CheckpointEncoder encodes the state of aggregations so they can be
+// checkpointed and later restored with a CheckpointDecoder.
+type CheckpointEncoder struct {
+	buf []byte
+}
+
+// Reset resets the encoder.
+func (e *CheckpointEncoder) Reset() { e.buf = e.buf[:0] }
+
+// Bytes returns the encoded bytes, which are only valid until the encoder is reset.
+func (e *CheckpointEncoder) Bytes() []byte { return e.buf }
+
+// EncodeVarint encodes a signed integer.
+func (e *CheckpointEncoder) EncodeVarint(v int64) {
+	e.buf = binary.AppendVarint(e.buf, v)
+}
+
+// EncodeBool encodes a boolean.
+func (e *CheckpointEncoder) EncodeBool(v bool) {
+	if v {
+		e.buf = append(e.buf, 1)
+	} else {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+// EncodeFloat64 encodes a float64.
+func (e *CheckpointEncoder) EncodeFloat64(v float64) {
+	e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(v))
+}
+
+// EncodeFloat64s encodes a list of float64s.
+func (e *CheckpointEncoder) EncodeFloat64s(v []float64) {
+	e.EncodeVarint(int64(len(v)))
+	for _, f := range v {
+		e.EncodeFloat64(f)
+	}
+}
+
+// EncodeBytes encodes a byte slice.
+func (e *CheckpointEncoder) EncodeBytes(v []byte) {
+	e.EncodeVarint(int64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// EncodeTime encodes a time, preserving whether it is zero.
+func (e *CheckpointEncoder) EncodeTime(t time.Time) {
+	if t.IsZero() {
+		e.EncodeBool(false)
+		return
+	}
+	e.EncodeBool(true)
+	e.EncodeVarint(t.UnixNano())
+}
+
+// CheckpointDecoder decodes the state of aggregations encoded by a
+// CheckpointEncoder. Once an error is encountered, all subsequent
+// decoding returns zero values and the error is returned by Err.
+type CheckpointDecoder struct {
+	buf []byte
+	err error
+}
+
+// NewCheckpointDecoder creates a new checkpoint decoder.
+func NewCheckpointDecoder(data []byte) *CheckpointDecoder {
+	return &CheckpointDecoder{buf: data}
+}
+
+// Reset resets the decoder to decode the given data.
+func (d *CheckpointDecoder) Reset(data []byte) {
+	d.buf = data
+	d.err = nil
+}
+
+// Err returns the first error encountered while decoding, if any.
+func (d *CheckpointDecoder) Err() error { return d.err }
+
+// Remaining returns the number of bytes left to decode.
+func (d *CheckpointDecoder) Remaining() int { return len(d.buf) }
+
+// DecodeVarint decodes a signed integer.
+func (d *CheckpointDecoder) DecodeVarint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = errMalformedCheckpoint
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+// DecodeBool decodes a boolean.
+func (d *CheckpointDecoder) DecodeBool() bool {
+	if d.err != nil {
+		return false
+	}
+	if len(d.buf) < 1 {
+		d.err = errMalformedCheckpoint
+		return false
+	}
+	v := d.buf[0] != 0
+	d.buf = d.buf[1:]
+	return v
+}
+
+// DecodeFloat64 decodes a float64.
+func (d *CheckpointDecoder) DecodeFloat64() float64 {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.buf) < 8 {
+		d.err = errMalformedCheckpoint
+		return 0
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(d.buf))
+	d.buf = d.buf[8:]
+	return v
+}
+
+// DecodeFloat64s decodes a list of float64s, appending them to the given slice.
+func (d *CheckpointDecoder) DecodeFloat64s(values []float64) []float64 {
+	n := d.decodeLen(8)
+	for i := 0; i < n; i++ {
+		values = append(values, d.DecodeFloat64())
+	}
+	return values
+}
+
+// DecodeBytes decodes a byte slice, which references the decoded data.
+func (d *CheckpointDecoder) DecodeBytes() []byte {
+	n := d.decodeLen(1)
+	if d.err != nil || n == 0 {
+		return nil
+	}
+	v := d.buf[:n:n]
+	d.buf = d.buf[n:]
+	return v
+}
+
+// DecodeTime decodes a time.
+func (d *CheckpointDecoder) DecodeTime() time.Time {
+	if !d.DecodeBool() {
+		return time.Time{}
+	}
+	return time.Unix(0, d.DecodeVarint())
+}
+
+// decodeLen decodes the length of a list whose items take at least
+// itemSize bytes each, failing if there are not enough bytes left.
+func (d *CheckpointDecoder) decodeLen(itemSize int) int {
+	n := d.DecodeVarint()
+	if d.err != nil {
+		return 0
+	}
+	if n < 0 || n > int64(len(d.buf)/itemSize) {
+		d.err = errMalformedCheckpoint
+		return 0
+	}
+	return int(n)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpointEncoderDecoderRoundTrip(t *testing.T) {
+	now := time.Now()
+	var enc CheckpointEncoder
+	enc.EncodeVarint(-1234)
+	enc.EncodeBool(true)
+	enc.EncodeFloat64(math.Inf(-1))
+	enc.EncodeFloat64s([]float64{1.5, math.NaN()})
+	enc.EncodeBytes([]byte("foo"))
+	enc.EncodeBytes(nil)
+	enc.EncodeTime(now)
+	enc.EncodeTime(time.Time{})
+
+	dec := NewCheckpointDecoder(enc.Bytes())
+	require.Equal(t, int64(-1234), dec.DecodeVarint())
+	require.True(t, dec.DecodeBool())
+	require.True(t, math.IsInf(dec.DecodeFloat64(), -1))
+	values := dec.DecodeFloat64s(nil)
+	require.Equal(t, 2, len(values))
+	require.Equal(t, 1.5, values[0])
+	require.True(t, math.IsNaN(values[1]))
+	require.Equal(t, []byte("foo"), dec.DecodeBytes())
+	require.Nil(t, dec.DecodeBytes())
+	require.True(t, now.Equal(dec.DecodeTime()))
+	require.True(t, dec.DecodeTime().IsZero())
+	require.NoError(t, dec.Err())
+	require.Equal(t, 0, dec.Remaining())
+
+	enc.Reset()
+	require.Equal(t, 0, len(enc.Bytes()))
+}
+
+func TestCheckpointDecoderMalformed(t *testing.T) {
+	var enc CheckpointEncoder
+	enc.EncodeVarint(100)
+	enc.EncodeFloat64(1.0)
+
+	// The length of the list exceeds the remaining bytes.
+	dec := NewCheckpointDecoder(enc.Bytes())
+	require.Nil(t, dec.DecodeFloat64s(nil))
+	require.Equal(t, errMalformedCheckpoint, dec.Err())
+
+	// Decoding after an error returns zero values.
+	require.Equal(t, 0.0, dec.DecodeFloat64())
+	require.Equal(t, errMalformedCheckpoint, dec.Err())
+
+	dec.Reset(enc.Bytes()[:4])
+	require.Equal(t, int64(100), dec.DecodeVarint())
+	require.Equal(t, 0.0, dec.DecodeFloat64())
+	require.Equal(t, errMalformedCheckpoint, dec.Err())
+}
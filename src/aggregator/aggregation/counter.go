@@ -135,5 +135,39 @@ func (c *Counter) Annotation() []byte {
 	return c.annotation
 }
 
+// EncodeCheckpoint encodes the state of the counter so it can be restored
+// by DecodeCheckpoint.
+func (c *Counter) EncodeCheckpoint(enc *CheckpointEncoder) {
+	enc.EncodeTime(c.lastAt)
+	enc.EncodeBytes(c.annotation)
+	enc.EncodeVarint(c.sum)
+	enc.EncodeVarint(c.sumSq)
+	enc.EncodeVarint(c.count)
+	enc.EncodeVarint(c.max)
+	enc.EncodeVarint(c.min)
+}
+
+// DecodeCheckpoint restores the state of a counter encoded by EncodeCheckpoint.
+func (c *Counter) DecodeCheckpoint(dec *CheckpointDecoder) error {
+	lastAt := dec.DecodeTime()
+	annotation := dec.DecodeBytes()
+	sum := dec.DecodeVarint()
+	sumSq := dec.DecodeVarint()
+	count := dec.DecodeVarint()
+	max := dec.DecodeVarint()
+	min := dec.DecodeVarint()
+	if err := dec.Err(); err != nil {
+		return err
+	}
+	c.lastAt = lastAt
+	c.annotation = MaybeReplaceAnnotation(c.annotation, annotation)
+	c.sum = sum
+	c.sumSq = sumSq
+	c.count = count
+	c.max = max
+	c.min = min
+	return nil
+}
+
 // Close closes the counter.
 func (c *Counter) Close() {}
@@ -85,3 +85,27 @@ func TestCounterCustomAggregationType(t *testing.T) {
 		}
 	}
 }
+
+func TestCounterCheckpointRoundTrip(t *testing.T) {
+	opts := NewOptions(instrument.NewOptions())
+	opts.HasExpensiveAggregations = true
+	c := NewCounter(opts)
+	now := time.Now()
+	for i := 1; i <= 100; i++ {
+		c.Update(now, int64(i), []byte("annotation"))
+	}
+
+	var enc CheckpointEncoder
+	c.EncodeCheckpoint(&enc)
+	restored := NewCounter(opts)
+	require.NoError(t, restored.DecodeCheckpoint(NewCheckpointDecoder(enc.Bytes())))
+	require.True(t, now.Equal(restored.LastAt()))
+	require.Equal(t, []byte("annotation"), restored.Annotation())
+	for aggType := range aggregation.ValidTypes {
+		require.Equal(t, c.ValueOf(aggType), restored.ValueOf(aggType))
+	}
+
+	// Truncated checkpoints cannot be restored.
+	truncated := NewCounter(opts)
+	require.Error(t, truncated.DecodeCheckpoint(NewCheckpointDecoder(enc.Bytes()[:len(enc.Bytes())-1])))
+}
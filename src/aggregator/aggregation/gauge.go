@@ -169,5 +169,42 @@ func (g *Gauge) Annotation() []byte {
 	return g.annotation
 }
 
+// EncodeCheckpoint encodes the state of the gauge so it can be restored
+// by DecodeCheckpoint.
+func (g *Gauge) EncodeCheckpoint(enc *CheckpointEncoder) {
+	enc.EncodeTime(g.lastAt)
+	enc.EncodeBytes(g.annotation)
+	enc.EncodeFloat64(g.sum)
+	enc.EncodeFloat64(g.sumSq)
+	enc.EncodeVarint(g.count)
+	enc.EncodeFloat64(g.max)
+	enc.EncodeFloat64(g.min)
+	enc.EncodeFloat64(g.last)
+}
+
+// DecodeCheckpoint restores the state of a gauge encoded by EncodeCheckpoint.
+func (g *Gauge) DecodeCheckpoint(dec *CheckpointDecoder) error {
+	lastAt := dec.DecodeTime()
+	annotation := dec.DecodeBytes()
+	sum := dec.DecodeFloat64()
+	sumSq := dec.DecodeFloat64()
+	count := dec.DecodeVarint()
+	max := dec.DecodeFloat64()
+	min := dec.DecodeFloat64()
+	last := dec.DecodeFloat64()
+	if err := dec.Err(); err != nil {
+		return err
+	}
+	g.lastAt = lastAt
+	g.annotation = MaybeReplaceAnnotation(g.annotation, annotation)
+	g.sum = sum
+	g.sumSq = sumSq
+	g.count = count
+	g.max = max
+	g.min = min
+	g.last = last
+	return nil
+}
+
 // Close closes the gauge.
 func (g *Gauge) Close() {}
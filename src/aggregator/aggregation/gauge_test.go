@@ -191,3 +191,31 @@ func TestGaugeLastOutOfOrderValues(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, int64(2), counter.Value())
 }
+
+func TestGaugeCheckpointRoundTrip(t *testing.T) {
+	opts := NewOptions(instrument.NewOptions())
+	opts.HasExpensiveAggregations = true
+	g := NewGauge(opts)
+	now := time.Now()
+	for i := 1.0; i <= 100.0; i++ {
+		g.Update(now.Add(time.Duration(i)), i, nil)
+	}
+
+	var enc CheckpointEncoder
+	g.EncodeCheckpoint(&enc)
+	restored := NewGauge(opts)
+	require.NoError(t, restored.DecodeCheckpoint(NewCheckpointDecoder(enc.Bytes())))
+	require.True(t, g.LastAt().Equal(restored.LastAt()))
+	for aggType := range aggregation.ValidTypes {
+		require.Equal(t, g.ValueOf(aggType), restored.ValueOf(aggType))
+	}
+
+	// Empty gauges are restored as empty.
+	enc.Reset()
+	empty := NewGauge(opts)
+	empty.EncodeCheckpoint(&enc)
+	restored = NewGauge(opts)
+	require.NoError(t, restored.DecodeCheckpoint(NewCheckpointDecoder(enc.Bytes())))
+	require.True(t, restored.LastAt().IsZero())
+	require.True(t, math.IsNaN(restored.Max()))
+}
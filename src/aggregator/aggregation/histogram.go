@@ -0,0 +1,255 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/m3db/m3/src/metrics/aggregation"
+)
+
+var errMalformedForwardedHistogram = errors.New("malformed forwarded histogram values")
+
+// Histogram aggregates histogram buckets. Buckets of histograms added to the
+// same aggregation are merged by upper bound, so histograms with different
+// bucket layouts can be aggregated together.
+type Histogram struct {
+	Options
+
+	lastAt      time.Time
+	annotation  []byte
+	upperBounds []float64
+	counts      []int64
+	sum         float64
+	count       int64
+}
+
+// NewHistogram creates a new histogram.
+func NewHistogram(opts Options) Histogram {
+	return Histogram{
+		Options: opts,
+	}
+}
+
+// Add adds the buckets of a histogram, where counts[i] is the number of values
+// observed in the bucket with upper bound upperBounds[i] and sum is the sum of
+// all the values observed.
+func (h *Histogram) Add(
+	timestamp time.Time,
+	upperBounds []float64,
+	counts []int64,
+	sum float64,
+	annotation []byte,
+) {
+	h.recordLastAt(timestamp)
+	for i := 0; i < len(upperBounds) && i < len(counts); i++ {
+		h.addBucket(upperBounds[i], counts[i])
+	}
+	h.sum += sum
+	h.annotation = MaybeReplaceAnnotation(h.annotation, annotation)
+}
+
+// AddForwarded adds histograms encoded by AppendForwarded, possibly by
+// several sources whose encoded values were concatenated together.
+func (h *Histogram) AddForwarded(timestamp time.Time, values []float64, annotation []byte) error {
+	h.recordLastAt(timestamp)
+	for len(values) > 0 {
+		if len(values) < 2 {
+			return errMalformedForwardedHistogram
+		}
+		numBuckets := int(values[0])
+		if numBuckets < 0 || len(values) < 2+2*numBuckets {
+			return errMalformedForwardedHistogram
+		}
+		h.sum += values[1]
+		buckets := values[2 : 2+2*numBuckets]
+		for i := 0; i < len(buckets); i += 2 {
+			h.addBucket(buckets[i], int64(buckets[i+1]))
+		}
+		values = values[2+2*numBuckets:]
+	}
+	h.annotation = MaybeReplaceAnnotation(h.annotation, annotation)
+	return nil
+}
+
+// AppendForwarded appends the histogram encoded as a flat list of values so
+// it can be forwarded to and merged by the aggregation of another aggregator.
+// The encoded values are laid out as [numBuckets, sum, b0, c0, ..., bn, cn]
+// and the encodings of multiple histograms may be concatenated.
+func (h *Histogram) AppendForwarded(values []float64) []float64 {
+	values = append(values, float64(len(h.upperBounds)), h.sum)
+	for i, upperBound := range h.upperBounds {
+		values = append(values, upperBound, float64(h.counts[i]))
+	}
+	return values
+}
+
+func (h *Histogram) addBucket(upperBound float64, count int64) {
+	if math.IsNaN(upperBound) {
+		return
+	}
+	h.count += count
+	idx := sort.SearchFloat64s(h.upperBounds, upperBound)
+	if idx < len(h.upperBounds) && h.upperBounds[idx] == upperBound {
+		h.counts[idx] += count
+		return
+	}
+	h.upperBounds = append(h.upperBounds, 0)
+	copy(h.upperBounds[idx+1:], h.upperBounds[idx:])
+	h.upperBounds[idx] = upperBound
+	h.counts = append(h.counts, 0)
+	copy(h.counts[idx+1:], h.counts[idx:])
+	h.counts[idx] = count
+}
+
+func (h *Histogram) recordLastAt(timestamp time.Time) {
+	if h.lastAt.IsZero() || timestamp.After(h.lastAt) {
+		// NB(r): Only set the last value if this value arrives
+		// after the wall clock timestamp of previous values, not
+		// the arrival time (i.e. order received).
+		h.lastAt = timestamp
+	}
+}
+
+// LastAt returns the time of the last value received.
+func (h *Histogram) LastAt() time.Time { return h.lastAt }
+
+// UpperBounds returns the upper bounds of the histogram buckets in ascending order.
+func (h *Histogram) UpperBounds() []float64 { return h.upperBounds }
+
+// Counts returns the number of values observed in each histogram bucket.
+func (h *Histogram) Counts() []int64 { return h.counts }
+
+// Count returns the number of values observed.
+func (h *Histogram) Count() int64 { return h.count }
+
+// Sum returns the sum of values observed.
+func (h *Histogram) Sum() float64 { return h.sum }
+
+// Mean returns the mean of values observed.
+func (h *Histogram) Mean() float64 {
+	if h.count == 0 {
+		return 0.0
+	}
+	return h.sum / float64(h.count)
+}
+
+// Quantile returns the value at a given quantile, assuming values are
+// distributed uniformly within each bucket. A quantile falling in the bucket
+// with an infinite upper bound returns the largest finite upper bound.
+func (h *Histogram) Quantile(q float64) float64 {
+	if h.count == 0 {
+		return 0.0
+	}
+	var (
+		rank       = q * float64(h.count)
+		cumulative int64
+	)
+	for i, upperBound := range h.upperBounds {
+		count := h.counts[i]
+		if count == 0 || float64(cumulative+count) < rank {
+			cumulative += count
+			continue
+		}
+		if math.IsInf(upperBound, 1) {
+			if i == 0 {
+				return 0.0
+			}
+			return h.upperBounds[i-1]
+		}
+		var lowerBound float64
+		if i > 0 {
+			lowerBound = h.upperBounds[i-1]
+		} else if upperBound <= 0 {
+			return upperBound
+		}
+		if math.IsInf(lowerBound, -1) {
+			return upperBound
+		}
+		return lowerBound + (upperBound-lowerBound)*(rank-float64(cumulative))/float64(count)
+	}
+	return h.upperBounds[len(h.upperBounds)-1]
+}
+
+// ValueOf returns the value for the aggregation type.
+func (h *Histogram) ValueOf(aggType aggregation.Type) float64 {
+	if q, ok := aggType.Quantile(); ok {
+		return h.Quantile(q)
+	}
+
+	switch aggType {
+	case aggregation.Mean:
+		return h.Mean()
+	case aggregation.Count:
+		return float64(h.Count())
+	case aggregation.Sum:
+		return h.Sum()
+	}
+	return 0
+}
+
+// Annotation returns the annotation associated with the histogram.
+func (h *Histogram) Annotation() []byte {
+	return h.annotation
+}
+
+// EncodeCheckpoint encodes the state of the histogram so it can be restored
+// by DecodeCheckpoint.
+func (h *Histogram) EncodeCheckpoint(enc *CheckpointEncoder) {
+	enc.EncodeTime(h.lastAt)
+	enc.EncodeBytes(h.annotation)
+	enc.EncodeFloat64(h.sum)
+	enc.EncodeFloat64s(h.upperBounds)
+	for _, count := range h.counts {
+		enc.EncodeVarint(count)
+	}
+}
+
+// DecodeCheckpoint restores the state of a histogram encoded by EncodeCheckpoint.
+func (h *Histogram) DecodeCheckpoint(dec *CheckpointDecoder) error {
+	lastAt := dec.DecodeTime()
+	annotation := dec.DecodeBytes()
+	sum := dec.DecodeFloat64()
+	upperBounds := dec.DecodeFloat64s(h.upperBounds[:0])
+	counts := h.counts[:0]
+	count := int64(0)
+	for range upperBounds {
+		c := dec.DecodeVarint()
+		counts = append(counts, c)
+		count += c
+	}
+	if err := dec.Err(); err != nil {
+		return err
+	}
+	h.lastAt = lastAt
+	h.annotation = MaybeReplaceAnnotation(h.annotation, annotation)
+	h.sum = sum
+	h.upperBounds = upperBounds
+	h.counts = counts
+	h.count = count
+	return nil
+}
+
+// Close closes the histogram.
+func (h *Histogram) Close() {}
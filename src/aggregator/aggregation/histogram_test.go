@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+func TestHistogramDefaultAggregationType(t *testing.T) {
+	h := NewHistogram(NewOptions(instrument.NewOptions()))
+	require.Equal(t, 0.0, h.ValueOf(aggregation.Count))
+	require.Equal(t, 0.0, h.ValueOf(aggregation.Mean))
+	require.Equal(t, 0.0, h.ValueOf(aggregation.P99))
+
+	h.Add(time.Now(), []float64{1, 2, 4, math.Inf(1)}, []int64{2, 4, 3, 1}, 30, nil)
+	require.Equal(t, 10.0, h.ValueOf(aggregation.Count))
+	require.Equal(t, 30.0, h.ValueOf(aggregation.Sum))
+	require.Equal(t, 3.0, h.ValueOf(aggregation.Mean))
+	require.Equal(t, 0.5, h.ValueOf(aggregation.P10))
+	require.Equal(t, 1.75, h.ValueOf(aggregation.P50))
+	require.InDelta(t, 10.0/3.0, h.ValueOf(aggregation.P80), 1e-9)
+	require.Equal(t, 4.0, h.ValueOf(aggregation.P99))
+	require.Equal(t, 0.0, h.ValueOf(aggregation.Max))
+}
+
+func TestHistogramMergeBuckets(t *testing.T) {
+	h := NewHistogram(NewOptions(instrument.NewOptions()))
+	h.Add(time.Now(), []float64{1, 10, math.Inf(1)}, []int64{1, 2, 3}, 100, nil)
+	h.Add(time.Now(), []float64{5, 10, 100}, []int64{4, 5, 6}, 200, nil)
+	require.Equal(t, []float64{1, 5, 10, 100, math.Inf(1)}, h.UpperBounds())
+	require.Equal(t, []int64{1, 4, 7, 6, 3}, h.Counts())
+	require.Equal(t, int64(21), h.Count())
+	require.Equal(t, 300.0, h.Sum())
+}
+
+func TestHistogramLastAt(t *testing.T) {
+	h := NewHistogram(NewOptions(instrument.NewOptions()))
+	now := time.Now()
+	h.Add(now, []float64{1}, []int64{1}, 1, nil)
+	h.Add(now.Add(-time.Second), []float64{1}, []int64{1}, 1, nil)
+	require.Equal(t, now, h.LastAt())
+}
+
+func TestHistogramAnnotation(t *testing.T) {
+	h := NewHistogram(NewOptions(instrument.NewOptions()))
+	h.Add(time.Now(), []float64{1}, []int64{1}, 1, []byte("first"))
+	h.Add(time.Now(), []float64{1}, []int64{1}, 1, []byte("second"))
+	require.Equal(t, []byte("second"), h.Annotation())
+}
+
+func TestHistogramForwardedRoundTrip(t *testing.T) {
+	opts := NewOptions(instrument.NewOptions())
+	h1 := NewHistogram(opts)
+	h1.Add(time.Now(), []float64{1, 10}, []int64{1, 2}, 12, nil)
+	h2 := NewHistogram(opts)
+	h2.Add(time.Now(), []float64{10, math.Inf(1)}, []int64{3, 4}, 70, nil)
+
+	// Forwarded values from multiple sources are concatenated together.
+	values := h1.AppendForwarded(nil)
+	values = h2.AppendForwarded(values)
+	require.Equal(t, []float64{2, 12, 1, 1, 10, 2, 2, 70, 10, 3, math.Inf(1), 4}, values)
+
+	merged := NewHistogram(opts)
+	require.NoError(t, merged.AddForwarded(time.Now(), values, nil))
+	require.Equal(t, []float64{1, 10, math.Inf(1)}, merged.UpperBounds())
+	require.Equal(t, []int64{1, 5, 4}, merged.Counts())
+	require.Equal(t, int64(10), merged.Count())
+	require.Equal(t, 82.0, merged.Sum())
+}
+
+func TestHistogramAddForwardedMalformed(t *testing.T) {
+	h := NewHistogram(NewOptions(instrument.NewOptions()))
+	require.Equal(t, errMalformedForwardedHistogram, h.AddForwarded(time.Now(), []float64{2}, nil))
+	require.Equal(t, errMalformedForwardedHistogram, h.AddForwarded(time.Now(), []float64{2, 1, 1, 1}, nil))
+	require.Equal(t, errMalformedForwardedHistogram, h.AddForwarded(time.Now(), []float64{-1, 1}, nil))
+}
+
+func TestHistogramCheckpointRoundTrip(t *testing.T) {
+	opts := NewOptions(instrument.NewOptions())
+	h := NewHistogram(opts)
+	h.Add(time.Now(), []float64{1, 10, math.Inf(1)}, []int64{1, 2, 3}, 42, []byte("annotation"))
+
+	var enc CheckpointEncoder
+	h.EncodeCheckpoint(&enc)
+	restored := NewHistogram(opts)
+	require.NoError(t, restored.DecodeCheckpoint(NewCheckpointDecoder(enc.Bytes())))
+	require.Equal(t, h.UpperBounds(), restored.UpperBounds())
+	require.Equal(t, h.Counts(), restored.Counts())
+	require.Equal(t, int64(6), restored.Count())
+	require.Equal(t, 42.0, restored.Sum())
+	require.Equal(t, []byte("annotation"), restored.Annotation())
+
+	// The restored histogram keeps merging buckets.
+	restored.Add(time.Now(), []float64{10}, []int64{1}, 5, nil)
+	require.Equal(t, []int64{1, 3, 3}, restored.Counts())
+}
@@ -38,6 +38,9 @@ type Options struct {
 	// HasExpensiveAggregations means expensive (multiplication／division)
 	// aggregation types are enabled.
 	HasExpensiveAggregations bool
+	// DDSketchEnabled means timer quantiles are computed with a mergeable
+	// DDSketch rather than the default quantile stream.
+	DDSketchEnabled bool
 }
 
 // Metrics is a set of metrics that can be used by elements.
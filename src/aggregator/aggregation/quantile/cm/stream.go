@@ -21,6 +21,7 @@
 package cm
 
 import (
+	"errors"
 	"math"
 )
 
@@ -30,6 +31,9 @@ const (
 
 var (
 	nan = math.NaN()
+
+	errMalformedEncodedStream = errors.New("malformed encoded stream")
+	errStreamNotEmpty         = errors.New("stream is not empty")
 )
 
 type threshold struct {
@@ -137,6 +141,40 @@ func (s *Stream) Flush() {
 	s.flushed = true
 }
 
+// AppendEncoded flushes the stream and appends its samples encoded as a
+// flat list of values laid out as [numValues, v0, r0, d0, ..., vn, rn, dn],
+// where v, r and d are the value, number of ranks and delta of each sample,
+// so the stream can be restored by SetEncoded.
+func (s *Stream) AppendEncoded(values []float64) []float64 {
+	s.Flush()
+	values = append(values, float64(s.numValues))
+	for curr := s.samples.Front(); curr != nil; curr = curr.next {
+		values = append(values, curr.value, float64(curr.numRanks), float64(curr.delta))
+	}
+	return values
+}
+
+// SetEncoded sets the samples of an empty stream to those encoded by AppendEncoded.
+func (s *Stream) SetEncoded(values []float64) error {
+	if len(values) == 0 || (len(values)-1)%3 != 0 {
+		return errMalformedEncodedStream
+	}
+	if !s.samples.Empty() || s.bufLess.Len() > 0 || s.bufMore.Len() > 0 {
+		return errStreamNotEmpty
+	}
+	for i := 1; i < len(values); i += 3 {
+		sample := s.samples.Acquire()
+		sample.value = values[i]
+		sample.numRanks = int64(values[i+1])
+		sample.delta = int64(values[i+2])
+		s.samples.PushBack(sample)
+	}
+	s.numValues = int64(values[0])
+	s.insertCursor = s.samples.Front()
+	s.flushed = false
+	return nil
+}
+
 // Min returns the minimum value.
 func (s *Stream) Min() float64 {
 	return s.Quantile(0.0)
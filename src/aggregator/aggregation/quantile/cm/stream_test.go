@@ -153,6 +153,39 @@ func TestStreamClose(t *testing.T) {
 	require.True(t, s.closed)
 }
 
+func TestStreamEncodedRoundTrip(t *testing.T) {
+	opts := testStreamOptions().SetInsertAndCompressEvery(testInsertAndCompressEvery)
+	s := NewStream(opts)
+	s.ResetSetData(testQuantiles)
+	rand.Seed(100)
+	for i := 0; i < 10000; i++ {
+		s.Add(rand.Float64())
+	}
+	encoded := s.AppendEncoded(nil)
+
+	restored := NewStream(opts)
+	restored.ResetSetData(testQuantiles)
+	require.NoError(t, restored.SetEncoded(encoded))
+	restored.Flush()
+	require.Equal(t, s.Min(), restored.Min())
+	require.Equal(t, s.Max(), restored.Max())
+	for _, q := range testQuantiles {
+		require.Equal(t, s.Quantile(q), restored.Quantile(q))
+	}
+
+	// The restored stream keeps accepting values.
+	for i := 0; i < 10000; i++ {
+		restored.Add(1 + rand.Float64())
+	}
+	restored.Flush()
+	require.Equal(t, int64(20000), restored.numValues)
+	require.InDelta(t, 1.0, restored.Quantile(0.5), 2*opts.Eps())
+
+	// Only empty streams can be set.
+	require.Error(t, restored.SetEncoded(encoded))
+	require.Error(t, NewStream(opts).SetEncoded(encoded[:2]))
+}
+
 func testStreamWithIncreasingSamples(t *testing.T, opts Options) {
 	numSamples := 100000
 	s := NewStream(opts)
@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+/*
+
+Package ddsketch implements DDSketch, a fully mergeable quantile sketch with
+relative-error guarantees from "DDSketch: A Fast and Fully-Mergeable Quantile
+Sketch with Relative-Error Guarantees" by Masson, Rim and Lee.
+
+Values are mapped to logarithmically sized buckets so that every quantile
+returned by a sketch is within the configured relative accuracy of the exact
+quantile. Unlike the Cormode-Muthukrishnan stream, two sketches with the same
+relative accuracy can be merged without any loss of accuracy, which allows
+quantiles to be computed across the sketches of several aggregators.
+
+*/
+package ddsketch
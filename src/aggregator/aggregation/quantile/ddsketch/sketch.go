@@ -0,0 +1,260 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ddsketch
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	nan = math.NaN()
+
+	errMalformedEncodedSketch     = errors.New("malformed encoded sketch")
+	errMismatchedRelativeAccuracy = errors.New("mismatched sketch relative accuracy")
+)
+
+// Sketch is a DDSketch quantile sketch. Positive and negative values are kept
+// in separate stores keyed by the logarithm of their magnitude, and values
+// equal to zero are counted separately.
+type Sketch struct {
+	sketchPool       SketchPool
+	positive         store
+	negative         store
+	relativeAccuracy float64
+	gamma            float64 // bin key base, (1 + relativeAccuracy) / (1 - relativeAccuracy)
+	multiplier       float64 // 1 / ln(gamma)
+	zeroCount        int64   // number of values equal to zero
+	min              float64 // minimum value added
+	max              float64 // maximum value added
+	closed           bool    // whether the sketch is closed
+}
+
+// NewSketch creates a new sketch.
+func NewSketch(opts Options) *Sketch {
+	if opts == nil {
+		opts = NewOptions()
+	}
+
+	var (
+		relativeAccuracy = opts.RelativeAccuracy()
+		gamma            = (1 + relativeAccuracy) / (1 - relativeAccuracy)
+	)
+	return &Sketch{
+		sketchPool:       opts.SketchPool(),
+		positive:         store{maxNumBins: opts.MaxNumBins()},
+		negative:         store{maxNumBins: opts.MaxNumBins()},
+		relativeAccuracy: relativeAccuracy,
+		gamma:            gamma,
+		multiplier:       1 / math.Log(gamma),
+		min:              math.Inf(1),
+		max:              math.Inf(-1),
+	}
+}
+
+// AddBatch adds a batch of values, values that are not finite are ignored.
+func (s *Sketch) AddBatch(values []float64) {
+	for _, v := range values {
+		s.Add(v)
+	}
+}
+
+// Add adds a value, values that are not finite are ignored.
+func (s *Sketch) Add(value float64) {
+	switch {
+	case math.IsNaN(value) || math.IsInf(value, 0):
+		return
+	case value > 0:
+		s.positive.add(s.key(value), 1)
+	case value < 0:
+		s.negative.add(s.key(-value), 1)
+	default:
+		s.zeroCount++
+	}
+	s.min = math.Min(s.min, value)
+	s.max = math.Max(s.max, value)
+}
+
+// Merge merges another sketch with the same relative accuracy into the sketch.
+func (s *Sketch) Merge(other *Sketch) error {
+	if other.relativeAccuracy != s.relativeAccuracy {
+		return errMismatchedRelativeAccuracy
+	}
+	s.positive.merge(&other.positive)
+	s.negative.merge(&other.negative)
+	s.zeroCount += other.zeroCount
+	s.min = math.Min(s.min, other.min)
+	s.max = math.Max(s.max, other.max)
+	return nil
+}
+
+// Count returns the number of values added.
+func (s *Sketch) Count() int64 {
+	return s.negative.count + s.zeroCount + s.positive.count
+}
+
+// Min returns the minimum value.
+func (s *Sketch) Min() float64 {
+	if s.Count() == 0 {
+		return 0.0
+	}
+	return s.min
+}
+
+// Max returns the maximum value.
+func (s *Sketch) Max() float64 {
+	if s.Count() == 0 {
+		return 0.0
+	}
+	return s.max
+}
+
+// Quantile returns the quantile value, which is within the relative accuracy
+// of the sketch of the exact quantile of the values added.
+func (s *Sketch) Quantile(q float64) float64 {
+	if q < 0.0 || q > 1.0 {
+		return nan
+	}
+
+	count := s.Count()
+	if count == 0 {
+		return 0.0
+	}
+
+	if q == 0.0 {
+		return s.min
+	}
+	if q == 1.0 {
+		return s.max
+	}
+
+	var (
+		rank          = q * float64(count-1)
+		negativeCount = float64(s.negative.count)
+		value         float64
+	)
+	switch {
+	case rank < negativeCount:
+		// NB: negative values are keyed by magnitude, so the lowest ranks are
+		// held by the bins with the highest keys.
+		value = -s.value(s.negative.keyAtRank(math.Ceil(negativeCount - 1 - rank)))
+	case rank < negativeCount+float64(s.zeroCount):
+		value = 0
+	default:
+		value = s.value(s.positive.keyAtRank(rank - negativeCount - float64(s.zeroCount)))
+	}
+	return math.Max(s.min, math.Min(s.max, value))
+}
+
+// AppendEncoded appends the sketch encoded as a flat list of values so it can
+// be forwarded to and merged by another sketch with MergeEncoded. The encoded
+// values are laid out as
+// [relativeAccuracy, zeroCount, min, max, positiveOffset, numPositiveBins,
+// positiveBins..., negativeOffset, numNegativeBins, negativeBins...].
+func (s *Sketch) AppendEncoded(values []float64) []float64 {
+	values = append(values, s.relativeAccuracy, float64(s.zeroCount), s.Min(), s.Max())
+	values = appendEncodedStore(values, &s.positive)
+	values = appendEncodedStore(values, &s.negative)
+	return values
+}
+
+// MergeEncoded merges the sketch encoded at the front of values by
+// AppendEncoded into the sketch, and returns the values following it.
+func (s *Sketch) MergeEncoded(values []float64) ([]float64, error) {
+	if len(values) < 4 {
+		return nil, errMalformedEncodedSketch
+	}
+	if values[0] != s.relativeAccuracy {
+		return nil, errMismatchedRelativeAccuracy
+	}
+	var (
+		zeroCount = int64(values[1])
+		min       = values[2]
+		max       = values[3]
+		prevCount = s.Count()
+		err       error
+	)
+	values, err = mergeEncodedStore(values[4:], &s.positive)
+	if err != nil {
+		return nil, err
+	}
+	values, err = mergeEncodedStore(values, &s.negative)
+	if err != nil {
+		return nil, err
+	}
+	s.zeroCount += zeroCount
+	if s.Count() > prevCount {
+		s.min = math.Min(s.min, min)
+		s.max = math.Max(s.max, max)
+	}
+	return values, nil
+}
+
+// Close closes the sketch.
+func (s *Sketch) Close() {
+	if s.closed {
+		return
+	}
+	s.closed = true
+
+	s.positive.reset()
+	s.negative.reset()
+	s.zeroCount = 0
+	s.min = math.Inf(1)
+	s.max = math.Inf(-1)
+	s.sketchPool.Put(s)
+}
+
+// key returns the key of the bin holding the given positive value.
+func (s *Sketch) key(value float64) int {
+	return int(math.Ceil(math.Log(value) * s.multiplier))
+}
+
+// value returns the value representing the bin of the given key, which is
+// within the relative accuracy of all the values held by the bin.
+func (s *Sketch) value(key int) float64 {
+	return 2 * math.Pow(s.gamma, float64(key)) / (1 + s.gamma)
+}
+
+func appendEncodedStore(values []float64, s *store) []float64 {
+	values = append(values, float64(s.offset), float64(len(s.bins)))
+	for _, count := range s.bins {
+		values = append(values, float64(count))
+	}
+	return values
+}
+
+func mergeEncodedStore(values []float64, s *store) ([]float64, error) {
+	if len(values) < 2 {
+		return nil, errMalformedEncodedSketch
+	}
+	var (
+		offset  = int(values[0])
+		numBins = int(values[1])
+	)
+	if numBins < 0 || len(values) < 2+numBins {
+		return nil, errMalformedEncodedSketch
+	}
+	for i, count := range values[2 : 2+numBins] {
+		s.add(offset+i, int64(count))
+	}
+	return values[2+numBins:], nil
+}
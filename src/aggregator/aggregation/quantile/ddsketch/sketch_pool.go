@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ddsketch
+
+import "sync"
+
+// SketchPool is a pool of sketches, wrapping sync.Pool.
+type SketchPool struct {
+	pool *sync.Pool
+}
+
+// NewSketchPool creates a new SketchPool.
+func NewSketchPool(opts Options) SketchPool {
+	return SketchPool{
+		pool: &sync.Pool{
+			New: func() interface{} {
+				return NewSketch(opts)
+			},
+		},
+	}
+}
+
+// Get returns a new Sketch from the pool.
+func (p SketchPool) Get() *Sketch {
+	s := p.pool.Get().(*Sketch) //nolint:errcheck
+	s.closed = false
+	return s
+}
+
+// Put puts a Sketch back into the pool.
+func (p SketchPool) Put(s *Sketch) {
+	p.pool.Put(s)
+}
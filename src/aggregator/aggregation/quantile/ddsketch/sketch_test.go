@@ -0,0 +1,219 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ddsketch
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRelativeAccuracy = 0.01
+)
+
+var (
+	testQuantiles = []float64{0.1, 0.5, 0.9, 0.99, 0.999}
+)
+
+func testSketchOptions() Options {
+	return NewOptions().SetRelativeAccuracy(testRelativeAccuracy)
+}
+
+func requireQuantilesWithinRelativeAccuracy(t *testing.T, s *Sketch, values []float64) {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	for _, q := range testQuantiles {
+		expected := sorted[int(q*float64(len(sorted)-1))]
+		actual := s.Quantile(q)
+		require.InDelta(t, expected, actual, math.Abs(expected)*testRelativeAccuracy+1e-12,
+			"quantile %v", q)
+	}
+	require.Equal(t, sorted[0], s.Min())
+	require.Equal(t, sorted[len(sorted)-1], s.Max())
+	require.Equal(t, int64(len(sorted)), s.Count())
+}
+
+func TestEmptySketch(t *testing.T) {
+	s := NewSketch(testSketchOptions())
+	require.Equal(t, int64(0), s.Count())
+	require.Equal(t, 0.0, s.Min())
+	require.Equal(t, 0.0, s.Max())
+	for _, q := range testQuantiles {
+		require.Equal(t, 0.0, s.Quantile(q))
+	}
+	require.True(t, math.IsNaN(s.Quantile(-1)))
+	require.True(t, math.IsNaN(s.Quantile(2)))
+}
+
+func TestSketchWithOneSample(t *testing.T) {
+	for _, v := range []float64{100.0, -100.0, 0.0} {
+		s := NewSketch(testSketchOptions())
+		s.Add(v)
+
+		require.Equal(t, v, s.Min())
+		require.Equal(t, v, s.Max())
+		for _, q := range testQuantiles {
+			require.Equal(t, v, s.Quantile(q))
+		}
+	}
+}
+
+func TestSketchIgnoresNonFiniteValues(t *testing.T) {
+	s := NewSketch(testSketchOptions())
+	s.AddBatch([]float64{math.NaN(), math.Inf(1), math.Inf(-1), 5.0})
+	require.Equal(t, int64(1), s.Count())
+	require.Equal(t, 5.0, s.Min())
+	require.Equal(t, 5.0, s.Max())
+}
+
+func TestSketchQuantilesWithinRelativeAccuracy(t *testing.T) {
+	var (
+		rnd    = rand.New(rand.NewSource(0)) //nolint:gosec
+		values = make([]float64, 0, 10000)
+	)
+	for i := 0; i < 10000; i++ {
+		values = append(values, rnd.ExpFloat64()*100)
+	}
+
+	s := NewSketch(testSketchOptions())
+	s.AddBatch(values)
+	requireQuantilesWithinRelativeAccuracy(t, s, values)
+}
+
+func TestSketchQuantilesWithNegativeAndZeroValues(t *testing.T) {
+	var (
+		rnd    = rand.New(rand.NewSource(0)) //nolint:gosec
+		values = make([]float64, 0, 10000)
+	)
+	for i := 0; i < 10000; i++ {
+		switch i % 3 {
+		case 0:
+			values = append(values, -rnd.Float64()*1000)
+		case 1:
+			values = append(values, 0)
+		default:
+			values = append(values, rnd.Float64()*1000)
+		}
+	}
+
+	s := NewSketch(testSketchOptions())
+	s.AddBatch(values)
+	requireQuantilesWithinRelativeAccuracy(t, s, values)
+}
+
+func TestSketchMerge(t *testing.T) {
+	var (
+		rnd    = rand.New(rand.NewSource(0)) //nolint:gosec
+		values []float64
+		merged = NewSketch(testSketchOptions())
+	)
+	for i := 0; i < 10; i++ {
+		s := NewSketch(testSketchOptions())
+		for j := 0; j < 1000; j++ {
+			// Each sketch sees a different range of values.
+			v := rnd.Float64() * math.Pow(10, float64(i%4))
+			values = append(values, v)
+			s.Add(v)
+		}
+		require.NoError(t, merged.Merge(s))
+	}
+	requireQuantilesWithinRelativeAccuracy(t, merged, values)
+
+	other := NewSketch(NewOptions().SetRelativeAccuracy(0.05))
+	require.Equal(t, errMismatchedRelativeAccuracy, merged.Merge(other))
+}
+
+func TestSketchMergeEncoded(t *testing.T) {
+	var (
+		rnd     = rand.New(rand.NewSource(0)) //nolint:gosec
+		values  []float64
+		encoded []float64
+	)
+	for i := 0; i < 3; i++ {
+		s := NewSketch(testSketchOptions())
+		for j := 0; j < 1000; j++ {
+			v := rnd.NormFloat64() * 100
+			values = append(values, v)
+			s.Add(v)
+		}
+		encoded = s.AppendEncoded(encoded)
+	}
+	// Empty sketches do not affect the min and max of the merged sketch.
+	encoded = NewSketch(testSketchOptions()).AppendEncoded(encoded)
+
+	var (
+		merged = NewSketch(testSketchOptions())
+		err    error
+	)
+	for len(encoded) > 0 {
+		encoded, err = merged.MergeEncoded(encoded)
+		require.NoError(t, err)
+	}
+	requireQuantilesWithinRelativeAccuracy(t, merged, values)
+}
+
+func TestSketchMergeEncodedErrors(t *testing.T) {
+	s := NewSketch(testSketchOptions())
+	s.Add(1.0)
+	encoded := s.AppendEncoded(nil)
+
+	_, err := NewSketch(testSketchOptions()).MergeEncoded(encoded[:3])
+	require.Equal(t, errMalformedEncodedSketch, err)
+
+	_, err = NewSketch(testSketchOptions()).MergeEncoded(encoded[:len(encoded)-1])
+	require.Equal(t, errMalformedEncodedSketch, err)
+
+	_, err = NewSketch(NewOptions().SetRelativeAccuracy(0.05)).MergeEncoded(encoded)
+	require.Equal(t, errMismatchedRelativeAccuracy, err)
+}
+
+func TestSketchCollapsesLowestBins(t *testing.T) {
+	s := NewSketch(testSketchOptions().SetMaxNumBins(10))
+	for v := 1.0; v < 1000; v *= 1.5 {
+		s.Add(v)
+	}
+	for i := 0; i < 1000; i++ {
+		s.Add(1000.0)
+	}
+	require.True(t, len(s.positive.bins) <= 10)
+	require.Equal(t, int64(1018), s.Count())
+	require.Equal(t, 1.0, s.Min())
+	require.Equal(t, 1000.0, s.Max())
+
+	// The highest values keep their accuracy.
+	require.InDelta(t, 1000.0, s.Quantile(0.5), 1000.0*testRelativeAccuracy)
+}
+
+func TestSketchCloseReturnsToPool(t *testing.T) {
+	opts := testSketchOptions()
+	s := opts.SketchPool().Get()
+	s.Add(1.0)
+	s.Close()
+	require.True(t, s.closed)
+	require.Equal(t, int64(0), s.Count())
+
+	// Closing a closed sketch is a no-op.
+	s.Close()
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ddsketch
+
+// store is a dense store of bin counts indexed by bin key. Once the store
+// spans more than maxNumBins bins, the bins with the lowest keys are collapsed
+// into a single bin so that the accuracy of the highest values is preserved.
+type store struct {
+	bins       []int64 // bin counts, bins[i] being the count of key offset+i
+	offset     int     // key of the first bin
+	count      int64   // total count across all bins
+	maxNumBins int
+}
+
+func (s *store) add(key int, count int64) {
+	if count == 0 {
+		return
+	}
+	if len(s.bins) == 0 {
+		s.bins = append(s.bins[:0], 0)
+		s.offset = key
+	}
+	maxKey := s.offset + len(s.bins) - 1
+	switch {
+	case key < s.offset:
+		if minKey := maxKey - s.maxNumBins + 1; key < minKey {
+			key = minKey
+		}
+		if key < s.offset {
+			s.extendBelow(key)
+		}
+	case key > maxKey:
+		if minKey := key - s.maxNumBins + 1; minKey > s.offset {
+			s.collapseBelow(minKey)
+		}
+		s.extendAbove(key)
+	}
+	s.bins[key-s.offset] += count
+	s.count += count
+}
+
+func (s *store) merge(other *store) {
+	for i, count := range other.bins {
+		s.add(other.offset+i, count)
+	}
+}
+
+// keyAtRank returns the key of the bin holding the value of the given rank.
+func (s *store) keyAtRank(rank float64) int {
+	var cumulative int64
+	for i, count := range s.bins {
+		cumulative += count
+		if float64(cumulative) > rank {
+			return s.offset + i
+		}
+	}
+	return s.offset + len(s.bins) - 1
+}
+
+func (s *store) extendBelow(key int) {
+	n := s.offset - key
+	bins := make([]int64, n+len(s.bins))
+	copy(bins[n:], s.bins)
+	s.bins = bins
+	s.offset = key
+}
+
+func (s *store) extendAbove(key int) {
+	if n := key - s.offset + 1 - len(s.bins); n > 0 {
+		s.bins = append(s.bins, make([]int64, n)...)
+	}
+}
+
+// collapseBelow folds the counts of all the bins with keys lower than minKey
+// into the bin of minKey.
+func (s *store) collapseBelow(minKey int) {
+	shift := minKey - s.offset
+	var collapsed int64
+	if shift >= len(s.bins) {
+		for _, count := range s.bins {
+			collapsed += count
+		}
+		s.bins = append(s.bins[:0], collapsed)
+		s.offset = minKey
+		return
+	}
+	for _, count := range s.bins[:shift] {
+		collapsed += count
+	}
+	n := copy(s.bins, s.bins[shift:])
+	s.bins = s.bins[:n]
+	s.bins[0] += collapsed
+	s.offset = minKey
+}
+
+func (s *store) reset() {
+	s.bins = s.bins[:0]
+	s.offset = 0
+	s.count = 0
+}
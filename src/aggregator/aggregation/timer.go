@@ -21,16 +21,21 @@
 package aggregation
 
 import (
+	"errors"
 	"time"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/metrics/aggregation"
 )
 
+var errMalformedForwardedTimer = errors.New("malformed forwarded timer values")
+
 // Timer aggregates timer values. Timer APIs are not thread-safe.
 type Timer struct {
 	lastAt                   time.Time
-	stream                   *cm.Stream // Stream of values received.
+	stream                   *cm.Stream       // Stream of values received.
+	sketch                   *ddsketch.Sketch // Sketch of values received, used in place of the stream if set.
 	annotation               []byte
 	count                    int64   // Number of values received.
 	sum                      float64 // Sum of the values.
@@ -48,6 +53,16 @@ func NewTimer(quantiles []float64, streamOpts cm.Options, opts Options) Timer {
 	}
 }
 
+// NewSketchTimer creates a new timer computing quantiles with a DDSketch.
+// Unlike timers computing quantiles with a stream, sketch timers can be
+// forwarded and merged with other sketch timers without losing accuracy.
+func NewSketchTimer(sketchOpts ddsketch.Options, opts Options) Timer {
+	return Timer{
+		hasExpensiveAggregations: opts.HasExpensiveAggregations,
+		sketch:                   sketchOpts.SketchPool().Get(),
+	}
+}
+
 // Add adds a timer value.
 func (t *Timer) Add(timestamp time.Time, value float64, annotation []byte) {
 	t.AddBatch(timestamp, []float64{value}, annotation)
@@ -70,9 +85,53 @@ func (t *Timer) AddBatch(timestamp time.Time, values []float64, annotation []byt
 		}
 	}
 
-	t.stream.AddBatch(values)
+	if t.sketch != nil {
+		t.sketch.AddBatch(values)
+	} else {
+		t.stream.AddBatch(values)
+	}
+
+	t.annotation = MaybeReplaceAnnotation(t.annotation, annotation)
+}
 
+// AddForwarded adds timers encoded by AppendForwarded, possibly by several
+// sources whose encoded values were concatenated together. Timers without
+// a sketch add the forwarded values as individual timer values instead.
+func (t *Timer) AddForwarded(timestamp time.Time, values []float64, annotation []byte) error {
+	if t.sketch == nil {
+		t.AddBatch(timestamp, values, annotation)
+		return nil
+	}
+
+	t.recordLastAt(timestamp)
+	for len(values) > 0 {
+		if len(values) < 3 {
+			return errMalformedForwardedTimer
+		}
+		t.count += int64(values[0])
+		t.sum += values[1]
+		t.sumSq += values[2]
+
+		var err error
+		if values, err = t.sketch.MergeEncoded(values[3:]); err != nil {
+			return err
+		}
+	}
 	t.annotation = MaybeReplaceAnnotation(t.annotation, annotation)
+	return nil
+}
+
+// AppendForwarded appends the timer encoded as a flat list of values so it
+// can be forwarded to and merged by the sketch timer of another aggregator,
+// and returns false if the timer has no sketch and cannot be forwarded as is.
+// The encoded values are laid out as [count, sum, sumSq, sketch...] and the
+// encodings of multiple timers may be concatenated.
+func (t *Timer) AppendForwarded(values []float64) ([]float64, bool) {
+	if t.sketch == nil {
+		return values, false
+	}
+	values = append(values, float64(t.count), t.sum, t.sumSq)
+	return t.sketch.AppendEncoded(values), true
 }
 
 func (t *Timer) recordLastAt(timestamp time.Time) {
@@ -89,6 +148,9 @@ func (t *Timer) LastAt() time.Time { return t.lastAt }
 
 // Quantile returns the value at a given quantile.
 func (t *Timer) Quantile(q float64) float64 {
+	if t.sketch != nil {
+		return t.sketch.Quantile(q)
+	}
 	t.stream.Flush()
 	return t.stream.Quantile(q)
 }
@@ -98,12 +160,18 @@ func (t *Timer) Count() int64 { return t.count }
 
 // Min returns the minimum timer value.
 func (t *Timer) Min() float64 {
+	if t.sketch != nil {
+		return t.sketch.Min()
+	}
 	t.stream.Flush()
 	return t.stream.Min()
 }
 
 // Max returns the maximum timer value.
 func (t *Timer) Max() float64 {
+	if t.sketch != nil {
+		return t.sketch.Max()
+	}
 	t.stream.Flush()
 	return t.stream.Max()
 }
@@ -157,7 +225,57 @@ func (t *Timer) Annotation() []byte {
 	return t.annotation
 }
 
+// EncodeCheckpoint encodes the state of the timer so it can be restored
+// by DecodeCheckpoint.
+func (t *Timer) EncodeCheckpoint(enc *CheckpointEncoder) {
+	enc.EncodeTime(t.lastAt)
+	enc.EncodeBytes(t.annotation)
+	enc.EncodeVarint(t.count)
+	enc.EncodeFloat64(t.sum)
+	enc.EncodeFloat64(t.sumSq)
+	if t.sketch != nil {
+		enc.EncodeFloat64s(t.sketch.AppendEncoded(nil))
+		return
+	}
+	enc.EncodeFloat64s(t.stream.AppendEncoded(nil))
+}
+
+// DecodeCheckpoint restores the state of a timer encoded by EncodeCheckpoint
+// into a timer that has not received any values.
+func (t *Timer) DecodeCheckpoint(dec *CheckpointDecoder) error {
+	lastAt := dec.DecodeTime()
+	annotation := dec.DecodeBytes()
+	count := dec.DecodeVarint()
+	sum := dec.DecodeFloat64()
+	sumSq := dec.DecodeFloat64()
+	encoded := dec.DecodeFloat64s(nil)
+	if err := dec.Err(); err != nil {
+		return err
+	}
+	if t.sketch != nil {
+		remaining, err := t.sketch.MergeEncoded(encoded)
+		if err != nil {
+			return err
+		}
+		if len(remaining) > 0 {
+			return errMalformedCheckpoint
+		}
+	} else if err := t.stream.SetEncoded(encoded); err != nil {
+		return err
+	}
+	t.lastAt = lastAt
+	t.annotation = MaybeReplaceAnnotation(t.annotation, annotation)
+	t.count = count
+	t.sum = sum
+	t.sumSq = sumSq
+	return nil
+}
+
 // Close closes the timer.
 func (t *Timer) Close() {
+	if t.sketch != nil {
+		t.sketch.Close()
+		return
+	}
 	t.stream.Close()
 }
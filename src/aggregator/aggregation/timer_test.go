@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/pool"
@@ -56,6 +57,10 @@ func testStreamOptions() cm.Options {
 	return cm.NewOptions()
 }
 
+func testSketchOptions() ddsketch.Options {
+	return ddsketch.NewOptions()
+}
+
 func getTimerSamples(
 	num int,
 	generator func(*rand.Rand) float64,
@@ -209,6 +214,93 @@ func TestTimerAggregationsNotExpensive(t *testing.T) {
 	timer.Close()
 }
 
+func TestSketchTimerAggregations(t *testing.T) {
+	opts := NewOptions(instrument.NewOptions())
+	opts.ResetSetData(testAggTypes)
+
+	timer := NewSketchTimer(testSketchOptions(), opts)
+
+	// Assert the state of an empty timer.
+	require.Equal(t, int64(0), timer.Count())
+	require.Equal(t, 0.0, timer.Min())
+	require.Equal(t, 0.0, timer.Max())
+	require.Equal(t, 0.0, timer.Quantile(0.5))
+
+	// Add values.
+	at := time.Now()
+	for i := 1; i <= 100; i++ {
+		timer.Add(at, float64(i), nil)
+	}
+
+	// Validate the timer values match expectations.
+	require.Equal(t, int64(100), timer.Count())
+	require.Equal(t, 5050.0, timer.Sum())
+	require.Equal(t, 338350.0, timer.SumSq())
+	require.Equal(t, 1.0, timer.Min())
+	require.Equal(t, 100.0, timer.Max())
+	require.Equal(t, 50.5, timer.Mean())
+	require.InDelta(t, 50.0, timer.ValueOf(aggregation.P50), 50.0*0.01)
+	require.InDelta(t, 95.0, timer.ValueOf(aggregation.P95), 95.0*0.01)
+	require.InDelta(t, 99.0, timer.ValueOf(aggregation.P99), 99.0*0.01)
+
+	// Closing the timer should close the underlying sketch.
+	timer.Close()
+	require.Equal(t, int64(0), timer.sketch.Count())
+
+	// Closing the timer a second time should be a no op.
+	timer.Close()
+}
+
+func TestSketchTimerAddForwarded(t *testing.T) {
+	opts := NewOptions(instrument.NewOptions())
+	opts.ResetSetData(testAggTypes)
+
+	var (
+		at        = time.Now()
+		forwarded []float64
+	)
+	// Each source sees a different half of the values.
+	for _, start := range []int{1, 51} {
+		timer := NewSketchTimer(testSketchOptions(), opts)
+		for i := start; i < start+50; i++ {
+			timer.Add(at, float64(i), nil)
+		}
+		var ok bool
+		forwarded, ok = timer.AppendForwarded(forwarded)
+		require.True(t, ok)
+	}
+
+	merged := NewSketchTimer(testSketchOptions(), opts)
+	require.NoError(t, merged.AddForwarded(at, forwarded, []byte("foo")))
+	require.Equal(t, at, merged.LastAt())
+	require.Equal(t, []byte("foo"), merged.Annotation())
+	require.Equal(t, int64(100), merged.Count())
+	require.Equal(t, 5050.0, merged.Sum())
+	require.Equal(t, 338350.0, merged.SumSq())
+	require.Equal(t, 1.0, merged.Min())
+	require.Equal(t, 100.0, merged.Max())
+	require.InDelta(t, 50.0, merged.Quantile(0.5), 50.0*0.01)
+	require.InDelta(t, 99.0, merged.Quantile(0.99), 99.0*0.01)
+
+	require.Equal(t, errMalformedForwardedTimer, merged.AddForwarded(at, []float64{1, 2}, nil))
+}
+
+func TestTimerWithoutSketchAddForwarded(t *testing.T) {
+	opts := NewOptions(instrument.NewOptions())
+	opts.ResetSetData(testAggTypes)
+
+	timer := NewTimer(testQuantiles, testStreamOptions(), opts)
+	values, ok := timer.AppendForwarded(nil)
+	require.False(t, ok)
+	require.Nil(t, values)
+
+	// Forwarded values are added as individual timer values.
+	require.NoError(t, timer.AddForwarded(time.Now(), []float64{1, 2, 3}, nil))
+	require.Equal(t, int64(3), timer.Count())
+	require.Equal(t, 6.0, timer.Sum())
+	require.Equal(t, 3.0, timer.Max())
+}
+
 func TestTimerReturnsLastNonEmptyAnnotation(t *testing.T) {
 	opts := NewOptions(instrument.NewOptions())
 	opts.ResetSetData(testAggTypes)
@@ -220,3 +312,30 @@ func TestTimerReturnsLastNonEmptyAnnotation(t *testing.T) {
 
 	require.Equal(t, []byte("second"), timer.Annotation())
 }
+
+func TestTimerCheckpointRoundTrip(t *testing.T) {
+	opts := NewOptions(instrument.NewOptions())
+	opts.HasExpensiveAggregations = true
+	for _, newTimerFn := range []func() Timer{
+		func() Timer { return NewTimer(testQuantiles, testStreamOptions(), opts) },
+		func() Timer { return NewSketchTimer(testSketchOptions(), opts) },
+	} {
+		timer := newTimerFn()
+		for i := 1; i <= 1000; i++ {
+			timer.Add(time.Now(), float64(i), nil)
+		}
+
+		var enc CheckpointEncoder
+		timer.EncodeCheckpoint(&enc)
+		restored := newTimerFn()
+		require.NoError(t, restored.DecodeCheckpoint(NewCheckpointDecoder(enc.Bytes())))
+		for _, aggType := range testAggTypes {
+			require.Equal(t, timer.ValueOf(aggType), restored.ValueOf(aggType))
+		}
+
+		// The restored timer keeps accepting values.
+		restored.Add(time.Now(), 1001, nil)
+		require.Equal(t, int64(1001), restored.Count())
+		require.Equal(t, 1001.0, restored.Max())
+	}
+}
@@ -49,6 +49,17 @@ func (a *counterAggregation) AddUnion(t time.Time, mu unaggregated.MetricUnion)
 	a.Counter.Update(t, mu.CounterVal, mu.Annotation)
 }
 
+func (a *counterAggregation) AddForwarded(t time.Time, values []float64, annotation []byte) error {
+	for _, v := range values {
+		a.Add(t, v, annotation)
+	}
+	return nil
+}
+
+func (a *counterAggregation) AppendForwarded(values []float64) ([]float64, bool) {
+	return values, false
+}
+
 // timerAggregation is a timer aggregation.
 type timerAggregation struct {
 	aggregation.Timer
@@ -70,6 +81,14 @@ func (a *timerAggregation) AddUnion(timestamp time.Time, mu unaggregated.MetricU
 	a.Timer.AddBatch(timestamp, mu.BatchTimerVal, mu.Annotation)
 }
 
+func (a *timerAggregation) AddForwarded(t time.Time, values []float64, annotation []byte) error {
+	return a.Timer.AddForwarded(t, values, annotation)
+}
+
+func (a *timerAggregation) AppendForwarded(values []float64) ([]float64, bool) {
+	return a.Timer.AppendForwarded(values)
+}
+
 // gaugeAggregation is a gauge aggregation.
 type gaugeAggregation struct {
 	aggregation.Gauge
@@ -91,3 +110,44 @@ func (a *gaugeAggregation) UpdateVal(t time.Time, value float64, prevValue float
 func (a *gaugeAggregation) AddUnion(t time.Time, mu unaggregated.MetricUnion) {
 	a.Gauge.Update(t, mu.GaugeVal, mu.Annotation)
 }
+
+func (a *gaugeAggregation) AddForwarded(t time.Time, values []float64, annotation []byte) error {
+	for _, v := range values {
+		a.Add(t, v, annotation)
+	}
+	return nil
+}
+
+func (a *gaugeAggregation) AppendForwarded(values []float64) ([]float64, bool) {
+	return values, false
+}
+
+// histogramAggregation is a histogram aggregation.
+type histogramAggregation struct {
+	aggregation.Histogram
+}
+
+func newHistogramAggregation(h aggregation.Histogram) histogramAggregation {
+	return histogramAggregation{Histogram: h}
+}
+
+// Add adds a single value as an observation in the bucket bounded by the value itself.
+func (a *histogramAggregation) Add(t time.Time, value float64, annotation []byte) {
+	a.Histogram.Add(t, []float64{value}, []int64{1}, value, annotation)
+}
+
+func (a *histogramAggregation) UpdateVal(t time.Time, value float64, prevValue float64) error {
+	return errors.New("histograms do not support updating values")
+}
+
+func (a *histogramAggregation) AddUnion(t time.Time, mu unaggregated.MetricUnion) {
+	a.Histogram.Add(t, mu.HistogramUpperBounds, mu.HistogramCounts, mu.HistogramSum, mu.Annotation)
+}
+
+func (a *histogramAggregation) AddForwarded(t time.Time, values []float64, annotation []byte) error {
+	return a.Histogram.AddForwarded(t, values, annotation)
+}
+
+func (a *histogramAggregation) AppendForwarded(values []float64) ([]float64, bool) {
+	return a.Histogram.AppendForwarded(values), true
+}
@@ -21,9 +21,14 @@
 package aggregator
 
 import (
+	"time"
+
+	raggregation "github.com/m3db/m3/src/aggregator/aggregation"
 	"github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/metrics/generated/proto/pipelinepb"
 	"github.com/m3db/m3/src/metrics/pipeline/applied"
 	"github.com/m3db/m3/src/metrics/policy"
+	xtime "github.com/m3db/m3/src/x/time"
 )
 
 type aggregationKey struct {
@@ -32,6 +37,7 @@ type aggregationKey struct {
 	aggregationID      aggregation.ID
 	numForwardedTimes  int
 	idPrefixSuffixType IDPrefixSuffixType
+	ddsketchEnabled    bool
 }
 
 func (k aggregationKey) Equal(other aggregationKey) bool {
@@ -39,5 +45,57 @@ func (k aggregationKey) Equal(other aggregationKey) bool {
 		k.storagePolicy == other.storagePolicy &&
 		k.pipeline.Equal(other.pipeline) &&
 		k.numForwardedTimes == other.numForwardedTimes &&
-		k.idPrefixSuffixType == other.idPrefixSuffixType
+		k.idPrefixSuffixType == other.idPrefixSuffixType &&
+		k.ddsketchEnabled == other.ddsketchEnabled
+}
+
+// encodeCheckpoint encodes the aggregation key.
+func (k aggregationKey) encodeCheckpoint(enc *raggregation.CheckpointEncoder) error {
+	var pb pipelinepb.AppliedPipeline
+	if err := k.pipeline.ToProto(&pb); err != nil {
+		return err
+	}
+	pipeline, err := pb.Marshal()
+	if err != nil {
+		return err
+	}
+	for _, word := range k.aggregationID {
+		enc.EncodeVarint(int64(word))
+	}
+	resolution := k.storagePolicy.Resolution()
+	enc.EncodeVarint(int64(resolution.Window))
+	enc.EncodeVarint(int64(resolution.Precision))
+	enc.EncodeVarint(int64(k.storagePolicy.Retention().Duration()))
+	enc.EncodeBytes(pipeline)
+	enc.EncodeVarint(int64(k.numForwardedTimes))
+	enc.EncodeVarint(int64(k.idPrefixSuffixType))
+	enc.EncodeBool(k.ddsketchEnabled)
+	return nil
+}
+
+// decodeAggregationKey decodes an aggregation key encoded by encodeCheckpoint.
+func decodeAggregationKey(dec *raggregation.CheckpointDecoder) (aggregationKey, error) {
+	var k aggregationKey
+	for i := range k.aggregationID {
+		k.aggregationID[i] = uint64(dec.DecodeVarint())
+	}
+	window := time.Duration(dec.DecodeVarint())
+	precision := xtime.Unit(dec.DecodeVarint())
+	retention := time.Duration(dec.DecodeVarint())
+	k.storagePolicy = policy.NewStoragePolicy(window, precision, retention)
+	pipeline := dec.DecodeBytes()
+	k.numForwardedTimes = int(dec.DecodeVarint())
+	k.idPrefixSuffixType = IDPrefixSuffixType(dec.DecodeVarint())
+	k.ddsketchEnabled = dec.DecodeBool()
+	if err := dec.Err(); err != nil {
+		return aggregationKey{}, err
+	}
+	var pb pipelinepb.AppliedPipeline
+	if err := pb.Unmarshal(pipeline); err != nil {
+		return aggregationKey{}, err
+	}
+	if err := k.pipeline.FromProto(pb); err != nil {
+		return aggregationKey{}, err
+	}
+	return k, nil
 }
@@ -166,10 +166,24 @@ func TestAggregationKeyEqual(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			a: aggregationKey{
+				aggregationID:   aggregation.DefaultID,
+				storagePolicy:   policy.NewStoragePolicy(10*time.Second, xtime.Second, 48*time.Hour),
+				ddsketchEnabled: true,
+			},
+			b: aggregationKey{
+				aggregationID: aggregation.DefaultID,
+				storagePolicy: policy.NewStoragePolicy(10*time.Second, xtime.Second, 48*time.Hour),
+			},
+			expected: false,
+		},
 	}
 
 	for _, input := range inputs {
 		require.Equal(t, input.expected, input.a.Equal(input.b))
+		vals := aggregationValues{{key: input.a}}
+		require.Equal(t, input.expected, vals.contains(input.b))
 		require.Equal(t, input.expected, input.b.Equal(input.a))
 	}
 }
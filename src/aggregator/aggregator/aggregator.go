@@ -32,9 +32,11 @@ import (
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
+	raggregation "github.com/m3db/m3/src/aggregator/aggregation"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler/writer"
 	"github.com/m3db/m3/src/aggregator/client"
+	schema "github.com/m3db/m3/src/aggregator/generated/proto/flush"
 	"github.com/m3db/m3/src/aggregator/sharding"
 	"github.com/m3db/m3/src/cluster/placement"
 	"github.com/m3db/m3/src/cluster/shard"
@@ -105,12 +107,15 @@ type aggregator struct {
 	placementManager  PlacementManager
 	flushTimesManager FlushTimesManager
 	flushTimesChecker flushTimesChecker
+	checkpointManager CheckpointManager
 	electionManager   ElectionManager
 	flushManager      FlushManager
 	flushHandler      handler.Handler
 	passthroughWriter writer.Writer
 	adminClient       client.AdminClient
 	resignTimeout     time.Duration
+	checkpointEvery   time.Duration
+	checkpointLock    sync.Mutex
 
 	shardSetID         uint32
 	shardSetOpen       bool
@@ -121,6 +126,7 @@ type aggregator struct {
 	state              aggregatorState
 	sleepFn            sleepFn
 	shardsPendingClose atomic.Int32
+	doneCh             chan struct{}
 	metrics            aggregatorMetrics
 	logger             *zap.Logger
 }
@@ -140,13 +146,16 @@ func NewAggregator(opts Options) Aggregator {
 		placementManager:  opts.PlacementManager(),
 		flushTimesManager: opts.FlushTimesManager(),
 		flushTimesChecker: newFlushTimesChecker(scope.SubScope("tick.shard-check")),
+		checkpointManager: opts.CheckpointManager(),
 		electionManager:   opts.ElectionManager(),
 		flushManager:      opts.FlushManager(),
 		flushHandler:      opts.FlushHandler(),
 		passthroughWriter: opts.PassthroughWriter(),
 		adminClient:       opts.AdminClient(),
 		resignTimeout:     opts.ResignTimeout(),
+		checkpointEvery:   opts.CheckpointInterval(),
 		sleepFn:           time.Sleep,
+		doneCh:            make(chan struct{}),
 		metrics:           newAggregatorMetrics(scope, timerOpts, opts.MaxAllowedForwardingDelayFn()),
 		logger:            logger,
 	}
@@ -171,6 +180,15 @@ func (agg *aggregator) Open() error {
 	if err := agg.processPlacementWithLock(placement); err != nil {
 		return err
 	}
+	if agg.checkpointManager != nil {
+		// NB: the checkpointed aggregation windows are restored before the
+		// aggregator starts accepting writes so the restored values are not
+		// mixed with values aggregated since the restart.
+		agg.restoreShardsWithLock()
+		if agg.checkpointEvery > 0 {
+			go agg.checkpointTick()
+		}
+	}
 	if agg.checkInterval > 0 {
 		// NB: tick updates some metrics on how many series the aggregator currently
 		// has of each type, and expires old metrics from the local metric lists.
@@ -385,13 +403,22 @@ func (agg *aggregator) Close() error {
 		return errAggregatorNotOpenOrClosed
 	}
 	agg.state = aggregatorClosed
+	close(agg.doneCh)
 
 	// NB: closing the flush manager is the only really necessary step for
 	// gracefully closing an aggregator leader, as this will ensure that any
 	// currently running flush completes, and updates the shared shard flush
 	// times map in etcd, allowing the follower that will be promoted to leader
 	// to avoid re-computing and re-flushing this data.
-	return agg.flushManager.Close()
+	if err := agg.flushManager.Close(); err != nil {
+		return err
+	}
+	if agg.checkpointManager != nil {
+		// Checkpoint the windows that are still open after the final flush so
+		// they can be resumed on restart.
+		agg.checkpointShards(agg.ownedShardsWithLock())
+	}
+	return nil
 }
 
 func (agg *aggregator) shardFor(id id.RawID) (*aggregatorShard, error) {
@@ -640,6 +667,9 @@ func (agg *aggregator) checkMetricType(mu unaggregated.MetricUnion) error {
 	case metric.GaugeType:
 		agg.metrics.gauges.Inc(1)
 		return nil
+	case metric.HistogramType:
+		agg.metrics.histograms.Inc(1)
+		return nil
 	default:
 		return errInvalidMetricType
 	}
@@ -697,6 +727,13 @@ func (agg *aggregator) closeShardsAsync(shards []*aggregatorShard) {
 		shard := shard
 		go func() {
 			shard.Close()
+			if agg.checkpointManager != nil {
+				// The shard is no longer owned so its checkpoint is stale.
+				if err := agg.checkpointManager.Remove(shard.ID()); err != nil {
+					agg.logger.Error("unable to remove shard checkpoint",
+						zap.Uint32("shard", shard.ID()), zap.Error(err))
+				}
+			}
 			pendingClose := agg.shardsPendingClose.Add(-1)
 			agg.metrics.shards.pendingClose.Update(float64(pendingClose))
 			agg.metrics.shards.close.Inc(1)
@@ -704,6 +741,110 @@ func (agg *aggregator) closeShardsAsync(shards []*aggregatorShard) {
 	}
 }
 
+// restoreShardsWithLock restores the open aggregation windows of the owned
+// shards from their checkpoints. The windows that have already been flushed
+// according to the flush times persisted in kv are skipped, so restored values
+// are neither lost nor flushed twice.
+func (agg *aggregator) restoreShardsWithLock() {
+	if !agg.shardSetOpen || len(agg.shardIDs) == 0 {
+		return
+	}
+	m := agg.metrics.checkpoint
+	flushTimes, err := agg.flushTimesManager.Load()
+	if err != nil {
+		// NB: without the flush times there is no way to tell which of the
+		// checkpointed windows have already been flushed.
+		m.restoreErrors.Inc(1)
+		agg.logger.Error("unable to load flush times, skipping checkpoint restore", zap.Error(err))
+		return
+	}
+	for _, shardID := range agg.shardIDs {
+		data, err := agg.checkpointManager.Read(shardID)
+		if err != nil {
+			m.restoreErrors.Inc(1)
+			agg.logger.Error("unable to read shard checkpoint",
+				zap.Uint32("shard", shardID), zap.Error(err))
+			continue
+		}
+		if data == nil {
+			continue
+		}
+		var shardFlushTimes *schema.ShardFlushTimes
+		if flushTimes != nil {
+			shardFlushTimes = flushTimes.ByShard[shardID]
+		}
+		dec := raggregation.NewCheckpointDecoder(data)
+		if err := agg.shards[shardID].Restore(dec, newCheckpointFlushedFn(shardFlushTimes)); err != nil {
+			m.restoreErrors.Inc(1)
+			agg.logger.Error("unable to restore shard checkpoint",
+				zap.Uint32("shard", shardID), zap.Error(err))
+			continue
+		}
+		m.restoreSuccess.Inc(1)
+	}
+}
+
+func (agg *aggregator) checkpointTick() {
+	ticker := time.NewTicker(agg.checkpointEvery)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-agg.doneCh:
+			return
+		}
+
+		agg.RLock()
+		shards := agg.ownedShardsWithLock()
+		agg.RUnlock()
+		agg.checkpointShards(shards)
+	}
+}
+
+func (agg *aggregator) ownedShardsWithLock() []*aggregatorShard {
+	shards := make([]*aggregatorShard, 0, len(agg.shardIDs))
+	for _, shardID := range agg.shardIDs {
+		shards = append(shards, agg.shards[shardID])
+	}
+	return shards
+}
+
+// checkpointShards writes the open aggregation windows of the given shards to
+// their checkpoints.
+func (agg *aggregator) checkpointShards(shards []*aggregatorShard) {
+	agg.checkpointLock.Lock()
+	defer agg.checkpointLock.Unlock()
+
+	var (
+		m     = agg.metrics.checkpoint
+		start = agg.nowFn()
+		enc   raggregation.CheckpointEncoder
+	)
+	for _, shard := range shards {
+		enc.Reset()
+		err := shard.Checkpoint(&enc)
+		if err == errAggregatorShardClosed {
+			continue
+		}
+		if err != nil {
+			// NB: the entries that failed to be encoded are skipped and the
+			// rest of the shard is still checkpointed.
+			m.errors.Inc(1)
+			agg.logger.Error("unable to checkpoint entries",
+				zap.Uint32("shard", shard.ID()), zap.Error(err))
+		}
+		if err := agg.checkpointManager.Write(shard.ID(), enc.Bytes()); err != nil {
+			m.errors.Inc(1)
+			agg.logger.Error("unable to write shard checkpoint",
+				zap.Uint32("shard", shard.ID()), zap.Error(err))
+			continue
+		}
+		m.success.Inc(1)
+	}
+	m.duration.Record(agg.nowFn().Sub(start))
+}
+
 func (agg *aggregator) tick() {
 	for {
 		agg.tickInternal()
@@ -1159,11 +1300,30 @@ func newAggregatorShardSetIDMetrics(scope tally.Scope) aggregatorShardSetIDMetri
 	}
 }
 
+type aggregatorCheckpointMetrics struct {
+	success        tally.Counter
+	errors         tally.Counter
+	duration       tally.Timer
+	restoreSuccess tally.Counter
+	restoreErrors  tally.Counter
+}
+
+func newAggregatorCheckpointMetrics(scope tally.Scope) aggregatorCheckpointMetrics {
+	return aggregatorCheckpointMetrics{
+		success:        scope.Counter("success"),
+		errors:         scope.Counter("errors"),
+		duration:       scope.Timer("duration"),
+		restoreSuccess: scope.Counter("restore-success"),
+		restoreErrors:  scope.Counter("restore-errors"),
+	}
+}
+
 type aggregatorMetrics struct {
 	counters       tally.Counter
 	timers         tally.Counter
 	timerBatches   tally.Counter
 	gauges         tally.Counter
+	histograms     tally.Counter
 	forwarded      tally.Counter
 	timed          tally.Counter
 	passthrough    tally.Counter
@@ -1176,6 +1336,7 @@ type aggregatorMetrics struct {
 	shards         aggregatorShardsMetrics
 	shardSetID     aggregatorShardSetIDMetrics
 	tick           aggregatorTickMetrics
+	checkpoint     aggregatorCheckpointMetrics
 }
 
 func newAggregatorMetrics(
@@ -1191,11 +1352,13 @@ func newAggregatorMetrics(
 	shardsScope := scope.SubScope("shards")
 	shardSetIDScope := scope.SubScope("shard-set-id")
 	tickScope := scope.SubScope("tick")
+	checkpointScope := scope.SubScope("checkpoint")
 	return aggregatorMetrics{
 		counters:       scope.Counter("counters"),
 		timers:         scope.Counter("timers"),
 		timerBatches:   scope.Counter("timer-batches"),
 		gauges:         scope.Counter("gauges"),
+		histograms:     scope.Counter("histograms"),
 		forwarded:      scope.Counter("forwarded"),
 		timed:          scope.Counter("timed"),
 		passthrough:    scope.Counter("passthrough"),
@@ -1208,6 +1371,7 @@ func newAggregatorMetrics(
 		shards:         newAggregatorShardsMetrics(shardsScope),
 		shardSetID:     newAggregatorShardSetIDMetrics(shardSetIDScope),
 		tick:           newAggregatorTickMetrics(tickScope),
+		checkpoint:     newAggregatorCheckpointMetrics(checkpointScope),
 	}
 }
 
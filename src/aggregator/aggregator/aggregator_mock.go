@@ -348,6 +348,21 @@ func (mr *MockFlushTimesManagerMockRecorder) Get() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFlushTimesManager)(nil).Get))
 }
 
+// Load mocks base method.
+func (m *MockFlushTimesManager) Load() (*flush.ShardSetFlushTimes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load")
+	ret0, _ := ret[0].(*flush.ShardSetFlushTimes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockFlushTimesManagerMockRecorder) Load() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockFlushTimesManager)(nil).Load))
+}
+
 // Open mocks base method.
 func (m *MockFlushTimesManager) Open(arg0 uint32) error {
 	m.ctrl.T.Helper()
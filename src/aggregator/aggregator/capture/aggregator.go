@@ -43,6 +43,7 @@ type aggregator struct {
 	countersWithMetadatas          []unaggregated.CounterWithMetadatas
 	batchTimersWithMetadatas       []unaggregated.BatchTimerWithMetadatas
 	gaugesWithMetadatas            []unaggregated.GaugeWithMetadatas
+	histogramsWithMetadatas        []unaggregated.HistogramWithMetadatas
 	forwardedMetricsWithMetadata   []aggregated.ForwardedMetricWithMetadata
 	timedMetricsWithMetadata       []aggregated.TimedMetricWithMetadata
 	timedMetricsWithMetadatas      []aggregated.TimedMetricWithMetadatas
@@ -86,6 +87,12 @@ func (agg *aggregator) AddUntimed(
 			StagedMetadatas: sm,
 		}
 		agg.gaugesWithMetadatas = append(agg.gaugesWithMetadatas, gp)
+	case metric.HistogramType:
+		hp := unaggregated.HistogramWithMetadatas{
+			Histogram:       mu.Histogram(),
+			StagedMetadatas: sm,
+		}
+		agg.histogramsWithMetadatas = append(agg.histogramsWithMetadatas, hp)
 	default:
 		return fmt.Errorf("unrecognized metric type %v", mu.Type)
 	}
@@ -191,6 +198,7 @@ func (agg *aggregator) Snapshot() SnapshotResult {
 		CountersWithMetadatas:         agg.countersWithMetadatas,
 		BatchTimersWithMetadatas:      agg.batchTimersWithMetadatas,
 		GaugesWithMetadatas:           agg.gaugesWithMetadatas,
+		HistogramsWithMetadatas:       agg.histogramsWithMetadatas,
 		ForwardedMetricsWithMetadata:  agg.forwardedMetricsWithMetadata,
 		TimedMetricWithMetadata:       agg.timedMetricsWithMetadata,
 		PassthroughMetricWithMetadata: agg.passthroughMetricsWithMetadata,
@@ -198,6 +206,7 @@ func (agg *aggregator) Snapshot() SnapshotResult {
 	agg.countersWithMetadatas = nil
 	agg.batchTimersWithMetadatas = nil
 	agg.gaugesWithMetadatas = nil
+	agg.histogramsWithMetadatas = nil
 	agg.forwardedMetricsWithMetadata = nil
 	agg.timedMetricsWithMetadata = nil
 	agg.passthroughMetricsWithMetadata = nil
@@ -222,6 +231,16 @@ func cloneUntimedMetric(m unaggregated.MetricUnion) unaggregated.MetricUnion {
 		copy(clonedTimerVal, m.BatchTimerVal)
 		mu.BatchTimerVal = clonedTimerVal
 	}
+
+	// Clone histogram buckets.
+	if m.Type == metric.HistogramType {
+		clonedUpperBounds := make([]float64, len(m.HistogramUpperBounds))
+		copy(clonedUpperBounds, m.HistogramUpperBounds)
+		mu.HistogramUpperBounds = clonedUpperBounds
+		clonedCounts := make([]int64, len(m.HistogramCounts))
+		copy(clonedCounts, m.HistogramCounts)
+		mu.HistogramCounts = clonedCounts
+	}
 	return mu
 }
 
@@ -0,0 +1,265 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregator
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	schema "github.com/m3db/m3/src/aggregator/generated/proto/flush"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	checkpointFileMagic      uint32 = 0x6d336163 // "m3ac"
+	checkpointFileVersion    uint32 = 1
+	checkpointFileHeaderSize        = 12
+	checkpointFileSuffix            = ".checkpoint"
+	checkpointTempFileSuffix        = ".tmp"
+)
+
+var (
+	errCheckpointDirNotSet            = errors.New("checkpoint directory is not set")
+	errCheckpointFileTooShort         = errors.New("checkpoint file is too short")
+	errCheckpointFileMagicMismatch    = errors.New("checkpoint file magic mismatch")
+	errCheckpointFileVersionMismatch  = errors.New("checkpoint file version mismatch")
+	errCheckpointFileChecksumMismatch = errors.New("checkpoint file checksum mismatch")
+)
+
+// CheckpointManager persists checkpoints of the open aggregation windows of
+// each shard to local disk, so a restarted instance can resume them.
+type CheckpointManager interface {
+	// Write atomically replaces the checkpoint of a shard.
+	Write(shard uint32, data []byte) error
+
+	// Read reads the checkpoint of a shard, returning nil if the shard
+	// has no checkpoint.
+	Read(shard uint32) ([]byte, error)
+
+	// Remove removes the checkpoint of a shard if it exists.
+	Remove(shard uint32) error
+}
+
+type checkpointManagerMetrics struct {
+	write        instrument.MethodMetrics
+	read         instrument.MethodMetrics
+	bytesWritten tally.Counter
+	corrupt      tally.Counter
+}
+
+func newCheckpointManagerMetrics(
+	scope tally.Scope,
+	opts instrument.TimerOptions,
+) checkpointManagerMetrics {
+	return checkpointManagerMetrics{
+		write:        instrument.NewMethodMetrics(scope, "checkpoint-write", opts),
+		read:         instrument.NewMethodMetrics(scope, "checkpoint-read", opts),
+		bytesWritten: scope.Counter("checkpoint-bytes-written"),
+		corrupt:      scope.Counter("checkpoint-corrupt"),
+	}
+}
+
+type checkpointManager struct {
+	nowFn   clock.NowFn
+	logger  *zap.Logger
+	dir     string
+	metrics checkpointManagerMetrics
+}
+
+// NewCheckpointManager creates a new checkpoint manager.
+func NewCheckpointManager(opts CheckpointManagerOptions) (CheckpointManager, error) {
+	dir := opts.CheckpointDir()
+	if dir == "" {
+		return nil, errCheckpointDirNotSet
+	}
+	if err := os.MkdirAll(dir, opts.NewDirectoryMode()); err != nil {
+		return nil, err
+	}
+	instrumentOpts := opts.InstrumentOptions()
+	return &checkpointManager{
+		nowFn:  opts.ClockOptions().NowFn(),
+		logger: instrumentOpts.Logger(),
+		dir:    dir,
+		metrics: newCheckpointManagerMetrics(instrumentOpts.MetricsScope(),
+			instrumentOpts.TimerOptions()),
+	}, nil
+}
+
+func (mgr *checkpointManager) Write(shard uint32, data []byte) error {
+	start := mgr.nowFn()
+	err := mgr.write(shard, data)
+	duration := mgr.nowFn().Sub(start)
+	if err != nil {
+		mgr.metrics.write.ReportError(duration)
+		return err
+	}
+	mgr.metrics.write.ReportSuccess(duration)
+	mgr.metrics.bytesWritten.Inc(int64(len(data)))
+	return nil
+}
+
+func (mgr *checkpointManager) Read(shard uint32) ([]byte, error) {
+	start := mgr.nowFn()
+	data, err := mgr.read(shard)
+	duration := mgr.nowFn().Sub(start)
+	if err != nil {
+		mgr.metrics.read.ReportError(duration)
+		return nil, err
+	}
+	mgr.metrics.read.ReportSuccess(duration)
+	return data, nil
+}
+
+func (mgr *checkpointManager) Remove(shard uint32) error {
+	err := os.Remove(mgr.filePath(shard))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// write writes the checkpoint to a temporary file that is synced and then
+// renamed in place of the previous checkpoint, so a crash never leaves a
+// partially written checkpoint behind.
+func (mgr *checkpointManager) write(shard uint32, data []byte) error {
+	var header [checkpointFileHeaderSize]byte
+	binary.LittleEndian.PutUint32(header[0:4], checkpointFileMagic)
+	binary.LittleEndian.PutUint32(header[4:8], checkpointFileVersion)
+	binary.LittleEndian.PutUint32(header[8:12], crc32.ChecksumIEEE(data))
+
+	filePath := mgr.filePath(shard)
+	tempFilePath := filePath + checkpointTempFileSuffix
+	f, err := os.Create(tempFilePath)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(header[:]); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tempFilePath, filePath); err != nil {
+		return err
+	}
+	return mgr.syncDir()
+}
+
+func (mgr *checkpointManager) read(shard uint32) ([]byte, error) {
+	b, err := os.ReadFile(mgr.filePath(shard))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCheckpointFile(b); err != nil {
+		mgr.metrics.corrupt.Inc(1)
+		mgr.logger.Error("corrupt checkpoint file",
+			zap.Uint32("shard", shard),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return b[checkpointFileHeaderSize:], nil
+}
+
+func (mgr *checkpointManager) syncDir() error {
+	dir, err := os.Open(mgr.dir)
+	if err != nil {
+		return err
+	}
+	if err := dir.Sync(); err != nil {
+		dir.Close()
+		return err
+	}
+	return dir.Close()
+}
+
+func (mgr *checkpointManager) filePath(shard uint32) string {
+	return filepath.Join(mgr.dir, fmt.Sprintf("shard-%d%s", shard, checkpointFileSuffix))
+}
+
+func validateCheckpointFile(b []byte) error {
+	if len(b) < checkpointFileHeaderSize {
+		return errCheckpointFileTooShort
+	}
+	if binary.LittleEndian.Uint32(b[0:4]) != checkpointFileMagic {
+		return errCheckpointFileMagicMismatch
+	}
+	if binary.LittleEndian.Uint32(b[4:8]) != checkpointFileVersion {
+		return errCheckpointFileVersionMismatch
+	}
+	if binary.LittleEndian.Uint32(b[8:12]) != crc32.ChecksumIEEE(b[checkpointFileHeaderSize:]) {
+		return errCheckpointFileChecksumMismatch
+	}
+	return nil
+}
+
+// checkpointFlushedFn returns the target time before which the aggregation
+// windows of a given metric list have been flushed, along with the function
+// used to compare window start times against it.
+type checkpointFlushedFn func(listID metricListID) (int64, isEarlierThanFn)
+
+// newCheckpointFlushedFn returns the flushed target times of a shard based on
+// the flush times persisted in kv. Windows of lists that have never been
+// flushed are all restored.
+func newCheckpointFlushedFn(shardFlushTimes *schema.ShardFlushTimes) checkpointFlushedFn {
+	return func(listID metricListID) (int64, isEarlierThanFn) {
+		if shardFlushTimes == nil {
+			return 0, isStandardMetricEarlierThan
+		}
+		switch listID.listType {
+		case standardMetricListType:
+			resolution := int64(listID.standard.resolution)
+			return shardFlushTimes.StandardByResolution[resolution], isStandardMetricEarlierThan
+		case timedMetricListType:
+			resolution := int64(listID.timed.resolution)
+			return shardFlushTimes.TimedByResolution[resolution], isStandardMetricEarlierThan
+		case forwardedMetricListType:
+			fbr, exists := shardFlushTimes.ForwardedByResolution[int64(listID.forwarded.resolution)]
+			if !exists || fbr == nil {
+				return 0, isForwardedMetricEarlierThan
+			}
+			numForwardedTimes := int32(listID.forwarded.numForwardedTimes)
+			return fbr.ByNumForwardedTimes[numForwardedTimes], isForwardedMetricEarlierThan
+		default:
+			return 0, isStandardMetricEarlierThan
+		}
+	}
+}
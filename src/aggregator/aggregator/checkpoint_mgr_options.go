@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregator
+
+import (
+	"os"
+
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	defaultCheckpointNewDirectoryMode = os.FileMode(0755)
+)
+
+// CheckpointManagerOptions provide a set of options for checkpoint manager.
+type CheckpointManagerOptions interface {
+	// SetClockOptions sets the clock options.
+	SetClockOptions(value clock.Options) CheckpointManagerOptions
+
+	// ClockOptions returns the clock options.
+	ClockOptions() clock.Options
+
+	// SetInstrumentOptions sets the instrument options.
+	SetInstrumentOptions(value instrument.Options) CheckpointManagerOptions
+
+	// InstrumentOptions returns the instrument options.
+	InstrumentOptions() instrument.Options
+
+	// SetCheckpointDir sets the directory checkpoints are written to.
+	SetCheckpointDir(value string) CheckpointManagerOptions
+
+	// CheckpointDir returns the directory checkpoints are written to.
+	CheckpointDir() string
+
+	// SetNewDirectoryMode sets the file mode of the checkpoint directory
+	// if it needs to be created.
+	SetNewDirectoryMode(value os.FileMode) CheckpointManagerOptions
+
+	// NewDirectoryMode returns the file mode of the checkpoint directory
+	// if it needs to be created.
+	NewDirectoryMode() os.FileMode
+}
+
+type checkpointManagerOptions struct {
+	clockOpts        clock.Options
+	instrumentOpts   instrument.Options
+	checkpointDir    string
+	newDirectoryMode os.FileMode
+}
+
+// NewCheckpointManagerOptions create a new set of checkpoint manager options.
+func NewCheckpointManagerOptions() CheckpointManagerOptions {
+	return &checkpointManagerOptions{
+		clockOpts:        clock.NewOptions(),
+		instrumentOpts:   instrument.NewOptions(),
+		newDirectoryMode: defaultCheckpointNewDirectoryMode,
+	}
+}
+
+func (o *checkpointManagerOptions) SetClockOptions(value clock.Options) CheckpointManagerOptions {
+	opts := *o
+	opts.clockOpts = value
+	return &opts
+}
+
+func (o *checkpointManagerOptions) ClockOptions() clock.Options {
+	return o.clockOpts
+}
+
+func (o *checkpointManagerOptions) SetInstrumentOptions(value instrument.Options) CheckpointManagerOptions {
+	opts := *o
+	opts.instrumentOpts = value
+	return &opts
+}
+
+func (o *checkpointManagerOptions) InstrumentOptions() instrument.Options {
+	return o.instrumentOpts
+}
+
+func (o *checkpointManagerOptions) SetCheckpointDir(value string) CheckpointManagerOptions {
+	opts := *o
+	opts.checkpointDir = value
+	return &opts
+}
+
+func (o *checkpointManagerOptions) CheckpointDir() string {
+	return o.checkpointDir
+}
+
+func (o *checkpointManagerOptions) SetNewDirectoryMode(value os.FileMode) CheckpointManagerOptions {
+	opts := *o
+	opts.newDirectoryMode = value
+	return &opts
+}
+
+func (o *checkpointManagerOptions) NewDirectoryMode() os.FileMode {
+	return o.newDirectoryMode
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	schema "github.com/m3db/m3/src/aggregator/generated/proto/flush"
+)
+
+func TestCheckpointManagerWriteReadRemove(t *testing.T) {
+	mgr, err := NewCheckpointManager(NewCheckpointManagerOptions().SetCheckpointDir(t.TempDir()))
+	require.NoError(t, err)
+
+	// Reading a shard without a checkpoint returns nothing.
+	data, err := mgr.Read(1)
+	require.NoError(t, err)
+	require.Nil(t, data)
+
+	require.NoError(t, mgr.Write(1, []byte("foo")))
+	require.NoError(t, mgr.Write(2, []byte("bar")))
+	require.NoError(t, mgr.Write(1, []byte("baz")))
+
+	data, err = mgr.Read(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("baz"), data)
+	data, err = mgr.Read(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("bar"), data)
+
+	require.NoError(t, mgr.Remove(1))
+	require.NoError(t, mgr.Remove(1))
+	data, err = mgr.Read(1)
+	require.NoError(t, err)
+	require.Nil(t, data)
+}
+
+func TestCheckpointManagerReadCorrupt(t *testing.T) {
+	dir := t.TempDir()
+	mgr, err := NewCheckpointManager(NewCheckpointManagerOptions().SetCheckpointDir(dir))
+	require.NoError(t, err)
+	require.NoError(t, mgr.Write(1, []byte("foobar")))
+
+	filePath := filepath.Join(dir, "shard-1.checkpoint")
+	b, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	b[len(b)-1]++
+	require.NoError(t, os.WriteFile(filePath, b, 0600))
+	_, err = mgr.Read(1)
+	require.Equal(t, errCheckpointFileChecksumMismatch, err)
+
+	require.NoError(t, os.WriteFile(filePath, b[:4], 0600))
+	_, err = mgr.Read(1)
+	require.Equal(t, errCheckpointFileTooShort, err)
+}
+
+func TestNewCheckpointManagerDirNotSet(t *testing.T) {
+	_, err := NewCheckpointManager(NewCheckpointManagerOptions())
+	require.Equal(t, errCheckpointDirNotSet, err)
+}
+
+func TestCheckpointFlushedFn(t *testing.T) {
+	flushedFn := newCheckpointFlushedFn(&schema.ShardFlushTimes{
+		StandardByResolution: map[int64]int64{int64(10 * time.Second): 1000},
+		TimedByResolution:    map[int64]int64{int64(time.Minute): 2000},
+		ForwardedByResolution: map[int64]*schema.ForwardedFlushTimesForResolution{
+			int64(10 * time.Second): {
+				ByNumForwardedTimes: map[int32]int64{1: 3000},
+			},
+		},
+	})
+
+	targetNanos, _ := flushedFn(standardMetricListID{resolution: 10 * time.Second}.toMetricListID())
+	require.Equal(t, int64(1000), targetNanos)
+	targetNanos, _ = flushedFn(standardMetricListID{resolution: time.Minute}.toMetricListID())
+	require.Equal(t, int64(0), targetNanos)
+	targetNanos, _ = flushedFn(timedMetricListID{resolution: time.Minute}.toMetricListID())
+	require.Equal(t, int64(2000), targetNanos)
+	targetNanos, _ = flushedFn(forwardedMetricListID{
+		resolution:        10 * time.Second,
+		numForwardedTimes: 1,
+	}.toMetricListID())
+	require.Equal(t, int64(3000), targetNanos)
+	targetNanos, _ = flushedFn(forwardedMetricListID{
+		resolution:        time.Minute,
+		numForwardedTimes: 1,
+	}.toMetricListID())
+	require.Equal(t, int64(0), targetNanos)
+
+	// All windows are restored without persisted flush times.
+	targetNanos, _ = newCheckpointFlushedFn(nil)(standardMetricListID{resolution: 10 * time.Second}.toMetricListID())
+	require.Equal(t, int64(0), targetNanos)
+}
@@ -209,12 +209,14 @@ func (e *CounterElem) AddUnique(
 		e.writeMetrics.updatedValues.Inc(1)
 		for i := range metric.Values {
 			if err := lockedAgg.aggregation.UpdateVal(timestamp, metric.Values[i], metric.PrevValues[i]); err != nil {
+				lockedAgg.mtx.Unlock()
 				return err
 			}
 		}
 	} else {
-		for _, v := range metric.Values {
-			lockedAgg.aggregation.Add(timestamp, v, metric.Annotation)
+		if err := lockedAgg.aggregation.AddForwarded(timestamp, metric.Values, metric.Annotation); err != nil {
+			lockedAgg.mtx.Unlock()
+			return err
 		}
 	}
 	lockedAgg.dirty = true
@@ -224,6 +226,88 @@ func (e *CounterElem) AddUnique(
 	return nil
 }
 
+// Checkpoint encodes the aggregation windows that are still open so they can be
+// restored by another instance, or by this instance after a restart.
+func (e *CounterElem) Checkpoint(enc *raggregation.CheckpointEncoder) {
+	var aggEnc raggregation.CheckpointEncoder
+	e.RLock()
+	if e.closed {
+		e.RUnlock()
+		enc.EncodeVarint(0)
+		return
+	}
+	enc.EncodeVarint(int64(len(e.values)))
+	for startAt, timedAgg := range e.values {
+		enc.EncodeVarint(int64(startAt))
+		timedAgg.lockedAgg.mtx.Lock()
+		open := !timedAgg.lockedAgg.closed
+		enc.EncodeBool(open)
+		if open {
+			enc.EncodeBool(timedAgg.lockedAgg.resendEnabled)
+			encodeSourcesSeen(enc, timedAgg.lockedAgg.sourcesSeen)
+			aggEnc.Reset()
+			timedAgg.lockedAgg.aggregation.EncodeCheckpoint(&aggEnc)
+			enc.EncodeBytes(aggEnc.Bytes())
+		}
+		timedAgg.lockedAgg.mtx.Unlock()
+	}
+	e.RUnlock()
+}
+
+// Restore restores the aggregation windows encoded by Checkpoint. Windows that
+// are earlier than the given flushed target time have already been flushed and
+// are skipped, as are windows that already exist in the element, so restored
+// values are never counted twice.
+func (e *CounterElem) Restore(
+	dec *raggregation.CheckpointDecoder,
+	flushedTargetNanos int64,
+	isEarlierThanFn isEarlierThanFn,
+) error {
+	resolution := e.sp.Resolution().Window
+	numValues := int(dec.DecodeVarint())
+	for i := 0; i < numValues && dec.Err() == nil; i++ {
+		startAt := dec.DecodeVarint()
+		if open := dec.DecodeBool(); !open {
+			continue
+		}
+		resendEnabled := dec.DecodeBool()
+		sourcesSeen := decodeSourcesSeen(dec)
+		aggData := dec.DecodeBytes()
+		if dec.Err() != nil {
+			break
+		}
+		if isEarlierThanFn(startAt, resolution, flushedTargetNanos) {
+			continue
+		}
+		existing, err := e.find(xtime.UnixNano(startAt))
+		if err != nil {
+			return err
+		}
+		if existing.lockedAgg != nil {
+			continue
+		}
+		lockedAgg, err := e.findOrCreate(startAt, createAggregationOptions{
+			initSourceSet: len(sourcesSeen) > 0,
+		})
+		if err != nil {
+			return err
+		}
+		lockedAgg.mtx.Lock()
+		if err := lockedAgg.aggregation.DecodeCheckpoint(raggregation.NewCheckpointDecoder(aggData)); err != nil {
+			lockedAgg.mtx.Unlock()
+			return err
+		}
+		for sourceID, versionsSeen := range sourcesSeen {
+			lockedAgg.sourcesSeen[sourceID] = versionsSeen
+		}
+		lockedAgg.dirty = true
+		lockedAgg.lastUpdatedAt = xtime.Now()
+		lockedAgg.resendEnabled = resendEnabled
+		lockedAgg.mtx.Unlock()
+	}
+	return dec.Err()
+}
+
 // remove expired aggregations from the values map.
 func (e *CounterElem) expireValuesWithLock(
 	targetNanos int64,
@@ -502,6 +586,10 @@ func (e *CounterElem) appendConsumeStateWithLock(
 		cState.values = append(cState.values, agg.lockedAgg.aggregation.ValueOf(aggType))
 	}
 	cState.annotation = raggregation.MaybeReplaceAnnotation(cState.annotation, agg.lockedAgg.aggregation.Annotation())
+	if e.parsedPipeline.HasRollup {
+		cState.forwardedValues, cState.hasForwardedValues = agg.lockedAgg.aggregation.AppendForwarded(
+			cState.forwardedValues)
+	}
 	agg.lockedAgg.dirty = false
 	agg.lockedAgg.mtx.Unlock()
 
@@ -764,6 +852,26 @@ func (e *CounterElem) processValue(
 		})
 	}
 
+	if cState.hasForwardedValues {
+		// NB: aggregations forwarding their own values (e.g. histograms merged bucket-wise
+		// by rollups) are forwarded as is, without transformations, and are not resent.
+		if !fState.flushed {
+			forwardedAggregationKey, _ := e.ForwardedAggregationKey()
+			for _, value := range cState.forwardedValues {
+				flushForwardedFn(e.writeForwardedMetricFn, forwardedAggregationKey,
+					int64(timestamp), value, 0, cState.annotation, false, e.routePolicy)
+			}
+			lag := xtime.Since(expectedProcessingTime.Add(latenessAllowed))
+			flushMetrics.forwardLag(forwardKey{fwdType: forwardTypeRemote, jitter: false}).
+				RecordDuration(lag)
+			flushMetrics.forwardLag(forwardKey{fwdType: forwardTypeRemote, jitter: true}).
+				RecordDuration(lag + jitter)
+		}
+		fState.flushed = true
+		e.flushState[cState.startAt] = fState
+		return
+	}
+
 	for aggTypeIdx, aggType := range e.aggTypes {
 		var extraDp transformation.Datapoint
 		value := cState.values[aggTypeIdx]
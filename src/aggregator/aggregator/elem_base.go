@@ -156,6 +156,17 @@ type metricElem interface {
 	// will be deleted once its aggregated values have been flushed.
 	MarkAsTombstoned()
 
+	// Checkpoint encodes the open aggregation windows of the element.
+	Checkpoint(enc *raggregation.CheckpointEncoder)
+
+	// Restore restores the aggregation windows encoded by Checkpoint, skipping
+	// the windows that are earlier than the flushed target time.
+	Restore(
+		dec *raggregation.CheckpointDecoder,
+		flushedTargetNanos int64,
+		isEarlierThanFn isEarlierThanFn,
+	) error
+
 	// Close closes the element.
 	Close()
 }
@@ -170,6 +181,7 @@ type ElemData struct {
 	IDPrefixSuffixType IDPrefixSuffixType
 	ListType           metricListType
 	RoutingPolicy      policy.RoutingPolicy
+	DDSketchEnabled    bool
 }
 
 // nolint: maligned
@@ -221,13 +233,18 @@ type consumeState struct {
 	dirty bool
 	// the resendEnabled bit copied from the lockedAgg
 	resendEnabled bool
+	// the values to forward copied from the lockedAgg, if the aggregation forwards its own values.
+	forwardedValues []float64
+	// true if forwardedValues are forwarded in place of the values of each aggregation type.
+	hasForwardedValues bool
 }
 
 // Reset resets the consume state for reuse.
 func (c *consumeState) Reset() {
 	*c = consumeState{
-		annotation: c.annotation[:0],
-		values:     c.values[:0],
+		annotation:      c.annotation[:0],
+		values:          c.values[:0],
+		forwardedValues: c.forwardedValues[:0],
 	}
 }
 
@@ -466,6 +483,7 @@ func (e *elemBase) resetSetData(data ElemData, useDefaultAggregation bool) error
 	e.aggTypes = data.AggTypes
 	e.useDefaultAggregation = useDefaultAggregation
 	e.aggOpts.ResetSetData(data.AggTypes)
+	e.aggOpts.DDSketchEnabled = data.DDSketchEnabled
 	e.parsedPipeline = parsed
 	e.numForwardedTimes = data.NumForwardedTimes
 	e.tombstoned = false
@@ -507,6 +525,7 @@ func (e *elemBase) ForwardedAggregationKey() (aggregationKey, bool) {
 		storagePolicy:     e.sp,
 		pipeline:          e.parsedPipeline.Remainder,
 		numForwardedTimes: e.numForwardedTimes + 1,
+		ddsketchEnabled:   e.aggOpts.DDSketchEnabled,
 	}, true
 }
 
@@ -575,6 +594,9 @@ func (e timerElemBase) TypeStringFor(aggTypesOpts maggregation.TypesOptions, agg
 func (e timerElemBase) ElemPool(opts Options) TimerElemPool { return opts.TimerElemPool() }
 
 func (e timerElemBase) NewAggregation(opts Options, aggOpts raggregation.Options) timerAggregation {
+	if aggOpts.DDSketchEnabled {
+		return newTimerAggregation(raggregation.NewSketchTimer(opts.SketchOptions(), aggOpts))
+	}
 	newTimer := raggregation.NewTimer(e.quantiles, opts.StreamOptions(), aggOpts)
 	return newTimerAggregation(newTimer)
 }
@@ -647,6 +669,39 @@ func (e *gaugeElemBase) ResetSetData(
 
 func (e *gaugeElemBase) Close() {}
 
+type histogramElemBase struct{}
+
+func (e histogramElemBase) Type() metric.Type { return metric.HistogramType }
+
+func (e histogramElemBase) FullPrefix(opts Options) []byte { return opts.FullHistogramPrefix() }
+
+func (e histogramElemBase) DefaultAggregationTypes(aggTypesOpts maggregation.TypesOptions) maggregation.Types {
+	return aggTypesOpts.DefaultHistogramAggregationTypes()
+}
+
+func (e histogramElemBase) TypeStringFor(aggTypesOpts maggregation.TypesOptions, aggType maggregation.Type) []byte {
+	return aggTypesOpts.TypeStringForHistogram(aggType)
+}
+
+func (e histogramElemBase) ElemPool(opts Options) HistogramElemPool { return opts.HistogramElemPool() }
+
+func (e histogramElemBase) NewAggregation(_ Options, aggOpts raggregation.Options) histogramAggregation {
+	return newHistogramAggregation(raggregation.NewHistogram(aggOpts))
+}
+
+func (e *histogramElemBase) ResetSetData(
+	_ maggregation.TypesOptions,
+	aggTypes maggregation.Types,
+	_ bool,
+) error {
+	if !aggTypes.IsValidForHistogram() {
+		return fmt.Errorf("invalid aggregation types %s for histogram", aggTypes.String())
+	}
+	return nil
+}
+
+func (e *histogramElemBase) Close() {}
+
 // nolint: maligned
 type parsedPipeline struct {
 	// Whether the source pipeline contains derivative transformations at its head.
@@ -753,6 +808,40 @@ func newParsedPipeline(pipeline applied.Pipeline) (parsedPipeline, error) {
 	}, nil
 }
 
+// encodeSourcesSeen encodes the versions seen for each forwarding source.
+func encodeSourcesSeen(enc *raggregation.CheckpointEncoder, sourcesSeen map[uint32]*bitset.BitSet) {
+	enc.EncodeVarint(int64(len(sourcesSeen)))
+	for sourceID, versionsSeen := range sourcesSeen {
+		enc.EncodeVarint(int64(sourceID))
+		words := versionsSeen.Bytes()
+		enc.EncodeVarint(int64(len(words)))
+		for _, word := range words {
+			enc.EncodeVarint(int64(word))
+		}
+	}
+}
+
+// decodeSourcesSeen decodes the versions seen for each forwarding source.
+func decodeSourcesSeen(dec *raggregation.CheckpointDecoder) map[uint32]*bitset.BitSet {
+	numSources := int(dec.DecodeVarint())
+	if numSources <= 0 || dec.Err() != nil {
+		return nil
+	}
+	sourcesSeen := make(map[uint32]*bitset.BitSet, numSources)
+	for i := 0; i < numSources && dec.Err() == nil; i++ {
+		sourceID := uint32(dec.DecodeVarint())
+		numWords := int(dec.DecodeVarint())
+		// NB: each word takes at least one byte so a malformed length is
+		// detected by the decoder before it is fully allocated.
+		words := make([]uint64, 0, max(0, min(numWords, dec.Remaining())))
+		for j := 0; j < numWords && dec.Err() == nil; j++ {
+			words = append(words, uint64(dec.DecodeVarint()))
+		}
+		sourcesSeen[sourceID] = bitset.From(words)
+	}
+	return sourcesSeen
+}
+
 // Placeholder to make compiler happy about generic elem base.
 // NB: lockedAggregationFromPool and not newLockedAggregation to avoid yet another rename hack in makefile
 func lockedAggregationFromPool(
@@ -825,3 +914,22 @@ func (l *lockedTimerAggregation) close() {
 	*l = lockedTimerAggregation{}
 	lockedTimerAggregationPool.Put(l)
 }
+
+var lockedHistogramAggregationPool = sync.Pool{New: func() interface{} { return &lockedHistogramAggregation{} }}
+
+func lockedHistogramAggregationFromPool(
+	aggregation histogramAggregation,
+	sourcesSeen map[uint32]*bitset.BitSet,
+) *lockedHistogramAggregation {
+	l := lockedHistogramAggregationPool.Get().(*lockedHistogramAggregation)
+	l.aggregation = aggregation
+	l.sourcesSeen = sourcesSeen
+
+	return l
+}
+
+func (l *lockedHistogramAggregation) close() {
+	l.aggregation.Close()
+	*l = lockedHistogramAggregation{}
+	lockedHistogramAggregationPool.Put(l)
+}
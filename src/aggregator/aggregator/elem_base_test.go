@@ -338,6 +338,56 @@ func TestGaugeElemBaseResetSetDataInvalidTypes(t *testing.T) {
 	require.True(t, strings.Contains(err.Error(), "invalid aggregation types P99 for gauge"))
 }
 
+func TestHistogramElemBase(t *testing.T) {
+	opts := newTestOptions()
+	aggTypesOpts := opts.AggregationTypesOptions()
+	e := histogramElemBase{}
+	require.Equal(t, []byte("stats.histograms."), e.FullPrefix(opts))
+	require.Equal(t, maggregation.Types{
+		maggregation.Sum,
+		maggregation.Mean,
+		maggregation.Count,
+		maggregation.P50,
+		maggregation.P95,
+		maggregation.P99,
+	}, e.DefaultAggregationTypes(aggTypesOpts))
+	require.Equal(t, []byte(".p99"), e.TypeStringFor(aggTypesOpts, maggregation.P99))
+	require.True(t, opts.HistogramElemPool() == e.ElemPool(opts))
+}
+
+func TestHistogramElemBaseNewAggregation(t *testing.T) {
+	e := histogramElemBase{}
+	la := e.NewAggregation(newTestOptions(), raggregation.Options{})
+	la.AddUnion(time.Now(), unaggregated.MetricUnion{
+		Type:                 metric.HistogramType,
+		HistogramUpperBounds: []float64{10, 100},
+		HistogramCounts:      []int64{3, 1},
+		HistogramSum:         80,
+	})
+	la.AddUnion(time.Now(), unaggregated.MetricUnion{
+		Type:                 metric.HistogramType,
+		HistogramUpperBounds: []float64{10, 1000},
+		HistogramCounts:      []int64{1, 1},
+		HistogramSum:         520,
+	})
+	require.Equal(t, 6.0, la.ValueOf(maggregation.Count))
+	require.Equal(t, 100.0, la.ValueOf(maggregation.Mean))
+	require.Equal(t, 7.5, la.ValueOf(maggregation.P50))
+	require.Error(t, la.UpdateVal(time.Now(), 10, 100))
+}
+
+func TestHistogramElemBaseResetSetData(t *testing.T) {
+	e := histogramElemBase{}
+	require.NoError(t, e.ResetSetData(nil, maggregation.Types{maggregation.Count, maggregation.P99}, false))
+}
+
+func TestHistogramElemBaseResetSetDataInvalidTypes(t *testing.T) {
+	e := histogramElemBase{}
+	err := e.ResetSetData(nil, maggregation.Types{maggregation.Last}, false)
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), "invalid aggregation types Last for histogram"))
+}
+
 func TestParsedPipelineEmptyPipeline(t *testing.T) {
 	p := applied.Pipeline{}
 	pp, err := newParsedPipeline(p)
@@ -90,3 +90,24 @@ func TestGaugeElemPool(t *testing.T) {
 	require.Equal(t, testGaugeID, element.id)
 	require.Equal(t, testStoragePolicy, element.sp)
 }
+
+func TestHistogramElemPool(t *testing.T) {
+	p := NewHistogramElemPool(pool.NewObjectPoolOptions().SetSize(1))
+	p.Init(func() *HistogramElem {
+		return MustNewHistogramElem(ElemData{}, NewElemOptions(newTestOptions()))
+	})
+
+	// Retrieve an element from the pool.
+	element := p.Get()
+	require.NoError(t, element.ResetSetData(ElemData{ID: testHistogramID, StoragePolicy: testStoragePolicy}))
+	require.Equal(t, testHistogramID, element.id)
+	require.Equal(t, testStoragePolicy, element.sp)
+
+	// Put the element back to pool.
+	p.Put(element)
+
+	// Retrieve the element and assert it's the same element.
+	element = p.Get()
+	require.Equal(t, testHistogramID, element.id)
+	require.Equal(t, testStoragePolicy, element.sp)
+}
@@ -52,6 +52,7 @@ var (
 	testCounterID                 = id.RawID("testCounter")
 	testBatchTimerID              = id.RawID("testBatchTimer")
 	testGaugeID                   = id.RawID("testGauge")
+	testHistogramID               = id.RawID("testHistogram")
 	testAnnot                     = []byte("testAnnotation")
 	testStoragePolicy             = policy.NewStoragePolicy(10*time.Second, xtime.Second, 6*time.Hour)
 	testRoutingPolicy             = policy.NewRoutingPolicy(0)
@@ -91,6 +92,13 @@ var (
 		ID:       testGaugeID,
 		GaugeVal: 123.456,
 	}
+	testHistogram = unaggregated.MetricUnion{
+		Type:                 metric.HistogramType,
+		ID:                   testHistogramID,
+		HistogramUpperBounds: []float64{1, 10, math.Inf(1)},
+		HistogramCounts:      []int64{2, 3, 1},
+		HistogramSum:         25,
+	}
 	testPipeline = applied.NewPipeline([]applied.OpUnion{
 		{
 			Type:           pipeline.TransformationOpType,
@@ -2376,6 +2384,285 @@ func TestGaugeFindOrCreateNoSourceSet(t *testing.T) {
 	}
 }
 
+func TestHistogramResetSetDataInvalidAggregationType(t *testing.T) {
+	opts := newTestOptions()
+	elemData := ElemData{
+		ID:            testHistogramID,
+		StoragePolicy: testStoragePolicy,
+		AggTypes:      maggregation.Types{maggregation.Last},
+		Pipeline:      applied.DefaultPipeline,
+	}
+	_, err := NewHistogramElem(elemData, NewElemOptions(opts))
+	require.Error(t, err)
+}
+
+func TestHistogramElemAddUnion(t *testing.T) {
+	elemData := ElemData{
+		ID:            testHistogramID,
+		StoragePolicy: testStoragePolicy,
+		Pipeline:      applied.DefaultPipeline,
+	}
+	e, err := NewHistogramElem(elemData, NewElemOptions(newTestOptions()))
+	require.NoError(t, err)
+
+	// Add two histograms with different bucket layouts to the same aggregation.
+	require.NoError(t, e.AddUnion(testTimestamps[0], testHistogram, false))
+	require.NoError(t, e.AddUnion(testTimestamps[1], unaggregated.MetricUnion{
+		Type:                 metric.HistogramType,
+		ID:                   testHistogramID,
+		HistogramUpperBounds: []float64{5, 10},
+		HistogramCounts:      []int64{4, 1},
+		HistogramSum:         15,
+	}, false))
+	require.Equal(t, 1, len(e.values))
+	a, err := e.find(xtime.UnixNano(testAlignedStarts[0]))
+	require.NoError(t, err)
+	v := a.lockedAgg
+	require.Equal(t, []float64{1, 5, 10, math.Inf(1)}, v.aggregation.UpperBounds())
+	require.Equal(t, []int64{2, 4, 4, 1}, v.aggregation.Counts())
+	require.Equal(t, int64(11), v.aggregation.Count())
+	require.Equal(t, 40.0, v.aggregation.Sum())
+
+	// Adding the histogram metric to a closed element results in an error.
+	e.closed = true
+	require.Equal(t, errElemClosed, e.AddUnion(testTimestamps[2], testHistogram, false))
+}
+
+func TestHistogramElemAddUniqueMergesBuckets(t *testing.T) {
+	elemData := ElemData{
+		ID:            testHistogramID,
+		StoragePolicy: testStoragePolicy,
+		AggTypes:      maggregation.Types{maggregation.P99},
+		Pipeline:      applied.DefaultPipeline,
+	}
+	e, err := NewHistogramElem(elemData, NewElemOptions(newTestOptions()))
+	require.NoError(t, err)
+
+	// Add histograms forwarded by two different sources.
+	source1 := uint32(1234)
+	require.NoError(t, e.AddUnique(testTimestamps[0],
+		aggregated.ForwardedMetric{Values: []float64{2, 12, 1, 1, 10, 2}},
+		metadata.ForwardMetadata{SourceID: source1}))
+	source2 := uint32(5678)
+	require.NoError(t, e.AddUnique(testTimestamps[1],
+		aggregated.ForwardedMetric{Values: []float64{2, 70, 10, 3, math.Inf(1), 4}},
+		metadata.ForwardMetadata{SourceID: source2}))
+	require.Equal(t, 1, len(e.values))
+	a, err := e.find(xtime.UnixNano(testAlignedStarts[0]))
+	require.NoError(t, err)
+	v := a.lockedAgg
+	require.Equal(t, []float64{1, 10, math.Inf(1)}, v.aggregation.UpperBounds())
+	require.Equal(t, []int64{1, 5, 4}, v.aggregation.Counts())
+	require.Equal(t, 82.0, v.aggregation.Sum())
+
+	// Malformed forwarded values result in an error.
+	source3 := uint32(9012)
+	require.Error(t, e.AddUnique(testTimestamps[1],
+		aggregated.ForwardedMetric{Values: []float64{2, 70, 10}},
+		metadata.ForwardMetadata{SourceID: source3}))
+}
+
+func TestHistogramElemConsumeDefaultPipeline(t *testing.T) {
+	aggTypes := maggregation.Types{maggregation.Count, maggregation.P50}
+	opts := newTestOptions()
+	e := testHistogramElem(testAlignedStarts[:1], aggTypes, applied.DefaultPipeline, opts)
+
+	localFn, localRes := testFlushLocalMetricFn()
+	forwardFn, forwardRes := testFlushForwardedMetricFn()
+	onForwardedFlushedFn, onForwardedFlushedRes := testOnForwardedFlushedFn()
+	require.False(t, e.Consume(testAlignedStarts[1], isStandardMetricEarlierThan, standardMetricTimestampNanos,
+		standardMetricTargetNanos, localFn, forwardFn, onForwardedFlushedFn, 0, consumeType))
+	expected := []testLocalMetricWithMetadata{
+		{
+			idPrefix:  []byte("stats.histograms."),
+			id:        testHistogramID,
+			idSuffix:  opts.AggregationTypesOptions().TypeStringForHistogram(maggregation.Count),
+			timeNanos: testAlignedStarts[1],
+			value:     6,
+			sp:        testStoragePolicy,
+		},
+		{
+			idPrefix:  []byte("stats.histograms."),
+			id:        testHistogramID,
+			idSuffix:  opts.AggregationTypesOptions().TypeStringForHistogram(maggregation.P50),
+			timeNanos: testAlignedStarts[1],
+			value:     4,
+			sp:        testStoragePolicy,
+		},
+	}
+	require.Equal(t, expected, *localRes)
+	require.Equal(t, 0, len(*forwardRes))
+	require.Equal(t, 0, len(*onForwardedFlushedRes))
+}
+
+func TestHistogramElemConsumeRollupPipelineForwardsBuckets(t *testing.T) {
+	rollupPipeline := applied.NewPipeline([]applied.OpUnion{
+		{
+			Type: pipeline.RollupOpType,
+			Rollup: applied.RollupOp{
+				ID:            []byte("foo.bar"),
+				AggregationID: maggregation.MustCompressTypes(maggregation.P99),
+			},
+		},
+	})
+	e := testHistogramElem(testAlignedStarts[:1], maggregation.Types{maggregation.P50}, rollupPipeline,
+		newTestOptions())
+
+	aggKey := aggregationKey{
+		aggregationID:     maggregation.MustCompressTypes(maggregation.P99),
+		storagePolicy:     testStoragePolicy,
+		numForwardedTimes: testNumForwardedTimes + 1,
+	}
+	var expectedForwardedRes []testForwardedMetricWithMetadata
+	for _, value := range []float64{3, 25, 1, 2, 10, 3, math.Inf(1), 1} {
+		expectedForwardedRes = append(expectedForwardedRes, testForwardedMetricWithMetadata{
+			aggregationKey: aggKey,
+			timeNanos:      testAlignedStarts[1],
+			value:          value,
+		})
+	}
+
+	// The buckets are forwarded in place of the value of each aggregation type.
+	localFn, localRes := testFlushLocalMetricFn()
+	forwardFn, forwardRes := testFlushForwardedMetricFn()
+	onForwardedFlushedFn, _ := testOnForwardedFlushedFn()
+	require.False(t, e.Consume(testAlignedStarts[1], isStandardMetricEarlierThan, standardMetricTimestampNanos,
+		standardMetricTargetNanos, localFn, forwardFn, onForwardedFlushedFn, 0, consumeType))
+	verifyForwardedMetrics(t, expectedForwardedRes, *forwardRes)
+	require.Equal(t, 0, len(*localRes))
+
+	// The forwarded buckets can be merged by the rollup histogram.
+	rollup := raggregation.NewHistogram(raggregation.NewOptions(instrument.NewOptions()))
+	var forwarded []float64
+	for _, res := range *forwardRes {
+		forwarded = append(forwarded, res.value)
+	}
+	require.NoError(t, rollup.AddForwarded(time.Now(), forwarded, nil))
+	require.Equal(t, []float64{1, 10, math.Inf(1)}, rollup.UpperBounds())
+	require.Equal(t, []int64{2, 3, 1}, rollup.Counts())
+}
+
+func TestTimerElemConsumeRollupPipelineForwardsSketch(t *testing.T) {
+	rollupPipeline := applied.NewPipeline([]applied.OpUnion{
+		{
+			Type: pipeline.RollupOpType,
+			Rollup: applied.RollupOp{
+				ID:            []byte("foo.bar"),
+				AggregationID: maggregation.MustCompressTypes(maggregation.P99),
+			},
+		},
+	})
+	opts := newTestOptions()
+	elemData := testTimerElemData
+	elemData.Pipeline = rollupPipeline
+	elemData.AggTypes = maggregation.Types{maggregation.P50}
+	elemData.DDSketchEnabled = true
+	e, err := NewTimerElem(elemData, NewElemOptions(opts))
+	require.NoError(t, err)
+	require.NoError(t, e.AddUnion(testTimestamps[0], testBatchTimer, false))
+
+	// The sketch is forwarded in place of the value of each aggregation type.
+	localFn, localRes := testFlushLocalMetricFn()
+	forwardFn, forwardRes := testFlushForwardedMetricFn()
+	onForwardedFlushedFn, _ := testOnForwardedFlushedFn()
+	require.False(t, e.Consume(testAlignedStarts[1], isStandardMetricEarlierThan, standardMetricTimestampNanos,
+		standardMetricTargetNanos, localFn, forwardFn, onForwardedFlushedFn, 0, consumeType))
+	require.Equal(t, 0, len(*localRes))
+	require.True(t, len(*forwardRes) > 3)
+
+	aggKey := aggregationKey{
+		aggregationID:     maggregation.MustCompressTypes(maggregation.P99),
+		storagePolicy:     testStoragePolicy,
+		numForwardedTimes: testNumForwardedTimes + 1,
+		ddsketchEnabled:   true,
+	}
+	var forwarded []float64
+	for _, res := range *forwardRes {
+		require.True(t, aggKey.Equal(res.aggregationKey))
+		require.Equal(t, testAlignedStarts[1], res.timeNanos)
+		forwarded = append(forwarded, res.value)
+	}
+
+	// The forwarded sketches of two sources merge into the rollup timer.
+	rollup := raggregation.NewSketchTimer(opts.SketchOptions(), e.aggOpts)
+	require.NoError(t, rollup.AddForwarded(time.Now(), append(forwarded, forwarded...), nil))
+	require.Equal(t, int64(10), rollup.Count())
+	require.Equal(t, 36.0, rollup.Sum())
+	require.Equal(t, 1.0, rollup.Min())
+	require.Equal(t, 6.5, rollup.Max())
+	require.InEpsilon(t, 3.5, rollup.Quantile(0.5), 0.01)
+}
+
+func TestCounterElemCheckpointRestore(t *testing.T) {
+	e, err := NewCounterElem(testCounterElemData, NewElemOptions(newTestOptions()))
+	require.NoError(t, err)
+
+	// Add metrics in two aggregation windows from two sources.
+	source1, source2 := uint32(1234), uint32(5678)
+	require.NoError(t, e.AddUnique(testTimestamps[0],
+		aggregated.ForwardedMetric{Values: []float64{345}, Annotation: testAnnot},
+		metadata.ForwardMetadata{SourceID: source1}))
+	require.NoError(t, e.AddUnique(testTimestamps[2],
+		aggregated.ForwardedMetric{Values: []float64{278}},
+		metadata.ForwardMetadata{SourceID: source1}))
+	require.NoError(t, e.AddUnique(testTimestamps[2],
+		aggregated.ForwardedMetric{Values: []float64{500}},
+		metadata.ForwardMetadata{SourceID: source2}))
+
+	var enc raggregation.CheckpointEncoder
+	e.Checkpoint(&enc)
+
+	// Restoring with nothing flushed yet restores both windows.
+	restored, err := NewCounterElem(testCounterElemData, NewElemOptions(newTestOptions()))
+	require.NoError(t, err)
+	require.NoError(t, restored.Restore(raggregation.NewCheckpointDecoder(enc.Bytes()),
+		0, isStandardMetricEarlierThan))
+	require.Equal(t, 2, len(restored.values))
+	a, err := restored.find(xtime.UnixNano(testAlignedStarts[0]))
+	require.NoError(t, err)
+	require.Equal(t, int64(345), a.lockedAgg.aggregation.Sum())
+	require.Equal(t, testAnnot, a.lockedAgg.aggregation.Annotation())
+	require.True(t, a.lockedAgg.dirty)
+	a, err = restored.find(xtime.UnixNano(testAlignedStarts[1]))
+	require.NoError(t, err)
+	require.Equal(t, int64(778), a.lockedAgg.aggregation.Sum())
+	require.Equal(t, int64(2), a.lockedAgg.aggregation.Count())
+	for _, source := range []uint32{source1, source2} {
+		versions, seen := a.lockedAgg.sourcesSeen[source]
+		require.True(t, seen)
+		require.True(t, versions.Test(0))
+	}
+
+	// A restored window rejects duplicate sources like the original.
+	require.Equal(t, errDuplicateForwardingSource, restored.AddUnique(testTimestamps[2],
+		aggregated.ForwardedMetric{Values: []float64{278}},
+		metadata.ForwardMetadata{SourceID: source1}))
+
+	// Windows that were already flushed are skipped.
+	restored, err = NewCounterElem(testCounterElemData, NewElemOptions(newTestOptions()))
+	require.NoError(t, err)
+	require.NoError(t, restored.Restore(raggregation.NewCheckpointDecoder(enc.Bytes()),
+		testAlignedStarts[1], isStandardMetricEarlierThan))
+	require.Equal(t, 1, len(restored.values))
+	a, err = restored.find(xtime.UnixNano(testAlignedStarts[1]))
+	require.NoError(t, err)
+	require.Equal(t, int64(778), a.lockedAgg.aggregation.Sum())
+
+	// Windows that already exist are not merged with the checkpoint.
+	require.NoError(t, restored.Restore(raggregation.NewCheckpointDecoder(enc.Bytes()),
+		0, isStandardMetricEarlierThan))
+	require.Equal(t, 2, len(restored.values))
+	a, err = restored.find(xtime.UnixNano(testAlignedStarts[1]))
+	require.NoError(t, err)
+	require.Equal(t, int64(778), a.lockedAgg.aggregation.Sum())
+
+	// A truncated checkpoint results in an error.
+	restored, err = NewCounterElem(testCounterElemData, NewElemOptions(newTestOptions()))
+	require.NoError(t, err)
+	require.Error(t, restored.Restore(raggregation.NewCheckpointDecoder(enc.Bytes()[:len(enc.Bytes())-1]),
+		0, isStandardMetricEarlierThan))
+}
+
 func TestDirtyConsumption(t *testing.T) {
 	e, err := NewCounterElem(testCounterElemData, NewElemOptions(newTestOptions()))
 	require.NoError(t, err)
@@ -3146,6 +3433,39 @@ func testGaugeElemWithData(
 	return e
 }
 
+func testHistogramElem(
+	alignedstartAtNanos []int64,
+	aggTypes maggregation.Types,
+	pipeline applied.Pipeline,
+	opts Options,
+) *HistogramElem {
+	elemData := ElemData{
+		ID:                testHistogramID,
+		StoragePolicy:     testStoragePolicy,
+		AggTypes:          aggTypes,
+		Pipeline:          pipeline,
+		NumForwardedTimes: testNumForwardedTimes,
+	}
+	e := MustNewHistogramElem(elemData, NewElemOptions(opts))
+	for _, aligned := range alignedstartAtNanos {
+		histogram := &lockedHistogramAggregation{
+			aggregation: newHistogramAggregation(raggregation.NewHistogram(e.aggOpts)),
+			sourcesSeen: make(map[uint32]*bitset.BitSet),
+		}
+		histogram.dirty = true
+		histogram.aggregation.AddUnion(time.Unix(0, aligned), testHistogram)
+		startAligned := xtime.UnixNano(aligned)
+		e.values[startAligned] = timedHistogram{
+			startAt:   startAligned,
+			lockedAgg: histogram,
+		}
+		e.dirty = append(e.dirty, startAligned)
+	}
+	e.minStartTime = xtime.UnixNano(alignedstartAtNanos[0])
+	e.maxStartTime = xtime.UnixNano(alignedstartAtNanos[len(alignedstartAtNanos)-1])
+	return e
+}
+
 func expectCounterSuffix(aggType maggregation.Type) []byte {
 	return testOpts.AggregationTypesOptions().TypeStringForCounter(aggType)
 }
@@ -30,6 +30,7 @@ import (
 	"github.com/uber-go/tally"
 	"go.uber.org/atomic"
 
+	raggregation "github.com/m3db/m3/src/aggregator/aggregation"
 	"github.com/m3db/m3/src/aggregator/bitset"
 	"github.com/m3db/m3/src/aggregator/rate"
 	"github.com/m3db/m3/src/aggregator/runtime"
@@ -57,6 +58,7 @@ var (
 	errEmptyMetadatas              = errors.New("empty metadata list")
 	errNoApplicableMetadata        = errors.New("no applicable metadata")
 	errNoPipelinesInMetadata       = errors.New("no pipelines in metadata")
+	errInvalidMetricCategory       = errors.New("invalid metric category")
 	errOnlyDefaultStagedMetadata   = xerrors.NewInvalidParamsError(
 		errors.New("only default staged metadata provided"),
 	)
@@ -406,6 +408,77 @@ func (e *Entry) TryExpire(now time.Time) bool {
 	return true
 }
 
+// Checkpoint encodes the aggregations of the entry along with the open
+// aggregation windows of their elements. The metric id is encoded first so
+// the entry can be located before its aggregations are restored, and is empty
+// if the entry has no aggregations.
+func (e *Entry) Checkpoint(enc *raggregation.CheckpointEncoder) error {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	if e.closed || len(e.aggregations) == 0 {
+		enc.EncodeBytes(nil)
+		enc.EncodeVarint(0)
+		return nil
+	}
+	enc.EncodeBytes(e.aggregations[0].elem.Value.(metricElem).ID())
+	enc.EncodeVarint(int64(len(e.aggregations)))
+	for i := range e.aggregations {
+		if err := e.aggregations[i].key.encodeCheckpoint(enc); err != nil {
+			return err
+		}
+		enc.EncodeBool(e.aggregations[i].resendEnabled)
+		enc.EncodeVarint(int64(e.aggregations[i].routePolicy.TrafficTypes))
+		e.aggregations[i].elem.Value.(metricElem).Checkpoint(enc)
+	}
+	return nil
+}
+
+// Restore restores the aggregations encoded by Checkpoint, following the
+// metric id that has already been decoded. The aggregations are kept until
+// the metadatas of the incoming metrics are applied, at which point the
+// restored elements are reused if their aggregation keys match.
+func (e *Entry) Restore(
+	category metricCategory,
+	metricType metric.Type,
+	metricID metricid.RawID,
+	dec *raggregation.CheckpointDecoder,
+	flushedFn checkpointFlushedFn,
+) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if e.closed {
+		return errEntryClosed
+	}
+	elemID := e.maybeCopyIDWithLock(metricID)
+	numAggregations := int(dec.DecodeVarint())
+	for i := 0; i < numAggregations && dec.Err() == nil; i++ {
+		key, err := decodeAggregationKey(dec)
+		if err != nil {
+			return err
+		}
+		resendEnabled := dec.DecodeBool()
+		routePolicy := policy.NewRoutingPolicy(uint64(dec.DecodeVarint()))
+		listID, err := checkpointListID(category, key)
+		if err != nil {
+			return err
+		}
+		newAggregations, err := e.addNewAggregationKeyWithLock(metricType, elemID, key, listID,
+			e.aggregations, resendEnabled, routePolicy)
+		if err != nil {
+			return err
+		}
+		e.aggregations = newAggregations
+		value, _ := e.aggregations.get(key)
+		flushedTargetNanos, isEarlierThanFn := flushedFn(listID)
+		if err := value.elem.Value.(metricElem).Restore(dec, flushedTargetNanos, isEarlierThanFn); err != nil {
+			return err
+		}
+	}
+	return dec.Err()
+}
+
 func (e *Entry) writeBatchTimerWithMetadatas(
 	metric unaggregated.MetricUnion,
 	metadatas metadata.StagedMetadatas,
@@ -569,6 +642,7 @@ func (e *Entry) shouldUpdateStagedMetadatasWithLock(sm metadata.StagedMetadata)
 				storagePolicy:      storagePolicies[j],
 				pipeline:           sm.Pipelines[i].Pipeline,
 				idPrefixSuffixType: WithPrefixWithSuffix,
+				ddsketchEnabled:    sm.Pipelines[i].DDSketchEnabled,
 			}
 			val, idx := e.aggregations.get(key)
 			if idx < 0 {
@@ -645,6 +719,8 @@ func (e *Entry) addNewAggregationKeyWithLock(
 		newElem = e.opts.TimerElemPool().Get()
 	case metric.GaugeType:
 		newElem = e.opts.GaugeElemPool().Get()
+	case metric.HistogramType:
+		newElem = e.opts.HistogramElemPool().Get()
 	default:
 		return nil, errInvalidMetricType
 	}
@@ -659,6 +735,7 @@ func (e *Entry) addNewAggregationKeyWithLock(
 		IDPrefixSuffixType: key.idPrefixSuffixType,
 		ListType:           listID.listType,
 		RoutingPolicy:      routePolicy,
+		DDSketchEnabled:    key.ddsketchEnabled,
 	}); err != nil {
 		return nil, err
 	}
@@ -708,6 +785,7 @@ func (e *Entry) updateStagedMetadatasWithLock(
 				storagePolicy:      storagePolicies[j],
 				pipeline:           sm.Pipelines[i].Pipeline,
 				idPrefixSuffixType: WithPrefixWithSuffix,
+				ddsketchEnabled:    sm.Pipelines[i].DDSketchEnabled,
 			}
 			var (
 				resendEnabled = sm.Pipelines[i].ResendEnabled
@@ -1073,6 +1151,7 @@ func (e *Entry) addForwarded(
 		pipeline:           metadata.Pipeline,
 		numForwardedTimes:  metadata.NumForwardedTimes,
 		idPrefixSuffixType: WithPrefixWithSuffix,
+		ddsketchEnabled:    metadata.DDSketchEnabled,
 	}
 	if idx := e.aggregations.index(key); idx >= 0 {
 		err := e.addForwardedWithLock(e.aggregations[idx], metric, metadata)
@@ -1156,6 +1235,7 @@ func (e *Entry) updateForwardMetadataWithLock(
 		pipeline:           metadata.Pipeline,
 		numForwardedTimes:  metadata.NumForwardedTimes,
 		idPrefixSuffixType: WithPrefixWithSuffix,
+		ddsketchEnabled:    metadata.DDSketchEnabled,
 	}
 	listID := forwardedMetricListID{
 		resolution:        metadata.StoragePolicy.Resolution().Window,
@@ -1190,6 +1270,25 @@ func (e *Entry) addForwardedWithLock(
 	return err
 }
 
+// checkpointListID returns the id of the list the elements of a restored
+// aggregation belong to.
+func checkpointListID(category metricCategory, key aggregationKey) (metricListID, error) {
+	resolution := key.storagePolicy.Resolution().Window
+	switch category {
+	case untimedMetric:
+		return standardMetricListID{resolution: resolution}.toMetricListID(), nil
+	case timedMetric:
+		return timedMetricListID{resolution: resolution}.toMetricListID(), nil
+	case forwardedMetric:
+		return forwardedMetricListID{
+			resolution:        resolution,
+			numForwardedTimes: key.numForwardedTimes,
+		}.toMetricListID(), nil
+	default:
+		return metricListID{}, errInvalidMetricCategory
+	}
+}
+
 func (e *Entry) shouldExpire(now xtime.UnixNano) bool {
 	// Only expire the entry if there are no active writers
 	// and it has reached its ttl since last accessed.
@@ -1245,7 +1344,8 @@ func (vals aggregationValues) get(k aggregationKey) (aggregationValue, int) {
 			vals[i].key.storagePolicy == k.storagePolicy &&
 			vals[i].key.pipeline.Equal(k.pipeline) &&
 			vals[i].key.numForwardedTimes == k.numForwardedTimes &&
-			vals[i].key.idPrefixSuffixType == k.idPrefixSuffixType {
+			vals[i].key.idPrefixSuffixType == k.idPrefixSuffixType &&
+			vals[i].key.ddsketchEnabled == k.ddsketchEnabled {
 			return vals[i], i
 		}
 	}
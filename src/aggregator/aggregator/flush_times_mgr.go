@@ -62,6 +62,10 @@ type FlushTimesManager interface {
 	// Get returns the latest flush times.
 	Get() (*schema.ShardSetFlushTimes, error)
 
+	// Load loads the flush times from kv synchronously, returning nil if
+	// no flush times have been stored yet.
+	Load() (*schema.ShardSetFlushTimes, error)
+
 	// Watch watches for updates to flush times.
 	Watch() (watch.Watch, error)
 
@@ -198,6 +202,28 @@ func (mgr *flushTimesManager) Get() (*schema.ShardSetFlushTimes, error) {
 	return mgr.proto, nil
 }
 
+func (mgr *flushTimesManager) Load() (*schema.ShardSetFlushTimes, error) {
+	mgr.RLock()
+	defer mgr.RUnlock()
+
+	if mgr.state != flushTimesManagerOpen {
+		return nil, errFlushTimesManagerNotOpenOrClosed
+	}
+	value, err := mgr.flushTimesStore.Get(mgr.flushTimesKey)
+	if err == kv.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var proto schema.ShardSetFlushTimes
+	if err := value.Unmarshal(&proto); err != nil {
+		mgr.metrics.flushTimesUnmarshalErrors.Inc(1)
+		return nil, err
+	}
+	return &proto, nil
+}
+
 func (mgr *flushTimesManager) Watch() (watch.Watch, error) {
 	mgr.RLock()
 	defer mgr.RUnlock()
@@ -493,6 +493,7 @@ func (agg *forwardedAggregation) onDone(key aggregationKey, expiredTimes []xtime
 				NumForwardedTimes: key.numForwardedTimes,
 				ResendEnabled:     b.resendEnabled,
 				RoutingPolicy:     b.routePolicy,
+				DDSketchEnabled:   key.ddsketchEnabled,
 			}
 
 			var version uint32
@@ -39,13 +39,16 @@ import (
 	"github.com/m3db/m3/src/msg/routing"
 	"github.com/m3db/m3/src/x/instrument"
 	xio "github.com/m3db/m3/src/x/io"
+	xhttp "github.com/m3db/m3/src/x/net/http"
 	"github.com/m3db/m3/src/x/pool"
+	"github.com/m3db/m3/src/x/retry"
 )
 
 var (
 	errNoHandlerConfiguration                   = errors.New("no handler configuration")
 	errNoDynamicOrStaticBackendConfiguration    = errors.New("neither dynamic nor static backend was configured")
 	errBothDynamicAndStaticBackendConfiguration = errors.New("both dynamic and static backend were configured")
+	errNoRemoteWriteConfiguration               = errors.New("no remote write configuration")
 )
 
 // FlushConfiguration configures flush handlers.
@@ -131,6 +134,11 @@ func (c FlushHandlerConfiguration) newHandler(
 		return NewBlackholeHandler(), nil
 	case loggingType:
 		return NewLoggingHandler(instrumentOpts.Logger()), nil
+	case remoteWriteType:
+		if c.StaticBackend.RemoteWrite == nil {
+			return nil, errNoRemoteWriteConfiguration
+		}
+		return c.StaticBackend.RemoteWrite.newRemoteWriteHandler(c.StaticBackend.Name, instrumentOpts)
 	default:
 		return nil, fmt.Errorf("unknown backend type %v", c.StaticBackend.Type)
 	}
@@ -341,4 +349,76 @@ type StaticBackendConfiguration struct {
 
 	// Name of the backend.
 	Name string `yaml:"name"`
+
+	// RemoteWrite configures the remote write backend.
+	RemoteWrite *RemoteWriteConfiguration `yaml:"remoteWrite"`
+}
+
+// RemoteWriteConfiguration configures a Prometheus remote write backend.
+type RemoteWriteConfiguration struct {
+	// Endpoint to send remote write requests to.
+	Endpoint string `yaml:"endpoint" validate:"nonzero"`
+
+	// Headers added to each remote write request.
+	Headers map[string]string `yaml:"headers"`
+
+	// HTTPClient configures the http client.
+	HTTPClient *xhttp.HTTPClientOptions `yaml:"httpClient"`
+
+	// Max number of series sent in a single request.
+	MaxBatchSize int `yaml:"maxBatchSize" validate:"min=0"`
+
+	// Number of batches queued before writes are rejected.
+	QueueSize int `yaml:"queueSize" validate:"min=0"`
+
+	// Number of workers sending requests concurrently.
+	NumWorkers int `yaml:"numWorkers" validate:"min=0"`
+
+	// Retrier for sending requests.
+	Retry retry.Configuration `yaml:"retry"`
+}
+
+// NewRemoteWriteOptions creates remote write options from the configuration.
+func (c RemoteWriteConfiguration) NewRemoteWriteOptions(
+	instrumentOpts instrument.Options,
+) writer.RemoteWriteOptions {
+	scope := instrumentOpts.MetricsScope()
+	opts := writer.NewRemoteWriteOptions().
+		SetInstrumentOptions(instrumentOpts).
+		SetEndpoint(c.Endpoint).
+		SetHeaders(c.Headers).
+		SetRetryOptions(c.Retry.NewOptions(scope.SubScope("retry")))
+	if c.HTTPClient != nil {
+		opts = opts.SetHTTPClientOptions(*c.HTTPClient)
+	}
+	if c.MaxBatchSize != 0 {
+		opts = opts.SetMaxBatchSize(c.MaxBatchSize)
+	}
+	if c.QueueSize != 0 {
+		opts = opts.SetQueueSize(c.QueueSize)
+	}
+	if c.NumWorkers != 0 {
+		opts = opts.SetNumWorkers(c.NumWorkers)
+	}
+	return opts
+}
+
+func (c RemoteWriteConfiguration) newRemoteWriteHandler(
+	name string,
+	instrumentOpts instrument.Options,
+) (Handler, error) {
+	scope := instrumentOpts.MetricsScope().Tagged(map[string]string{
+		"backend":   name,
+		"component": "remote-write",
+	})
+	instrumentOpts = instrumentOpts.SetMetricsScope(scope)
+	opts := c.NewRemoteWriteOptions(instrumentOpts)
+	client, err := writer.NewRemoteWriteClient(opts)
+	if err != nil {
+		return nil, err
+	}
+	instrumentOpts.Logger().Info("created flush handler with remote write",
+		zap.String("name", name),
+		zap.String("endpoint", c.Endpoint))
+	return NewRemoteWriteHandler(client, opts), nil
 }
@@ -22,10 +22,13 @@ package handler
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	yaml "gopkg.in/yaml.v2"
+
+	"github.com/m3db/m3/src/x/instrument"
 )
 
 func TestStoragePolicyFilter(t *testing.T) {
@@ -99,3 +102,45 @@ func TestRoutingPolicyConfigurationEmptyKVKey(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, handler, "handler should be nil when KVKey is empty")
 }
+
+func TestRemoteWriteConfiguration(t *testing.T) {
+	var cfg FlushHandlerConfiguration
+
+	str := `
+staticBackend:
+  type: remoteWrite
+  name: test
+  remoteWrite:
+    endpoint: http://localhost:9090/api/v1/write
+    headers:
+      X-Scope-OrgID: foo
+    httpClient:
+      requestTimeout: 5s
+    maxBatchSize: 500
+    queueSize: 16
+    numWorkers: 2
+    retry:
+      maxRetries: 5
+`
+	require.NoError(t, yaml.Unmarshal([]byte(str), &cfg))
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, remoteWriteType, cfg.StaticBackend.Type)
+
+	opts := cfg.StaticBackend.RemoteWrite.NewRemoteWriteOptions(instrument.NewOptions())
+	require.NoError(t, opts.Validate())
+	require.Equal(t, "http://localhost:9090/api/v1/write", opts.Endpoint())
+	require.Equal(t, map[string]string{"X-Scope-OrgID": "foo"}, opts.Headers())
+	require.Equal(t, 5*time.Second, opts.HTTPClientOptions().RequestTimeout)
+	require.Equal(t, 500, opts.MaxBatchSize())
+	require.Equal(t, 16, opts.QueueSize())
+	require.Equal(t, 2, opts.NumWorkers())
+	require.Equal(t, 5, opts.RetryOptions().MaxRetries())
+
+	h, err := cfg.newHandler(nil, instrument.NewOptions(), nil)
+	require.NoError(t, err)
+	h.Close()
+
+	cfg.StaticBackend.RemoteWrite = nil
+	_, err = cfg.newHandler(nil, instrument.NewOptions(), nil)
+	require.Equal(t, errNoRemoteWriteConfiguration, err)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package handler
+
+import (
+	"github.com/uber-go/tally"
+
+	"github.com/m3db/m3/src/aggregator/aggregator/handler/writer"
+)
+
+type remoteWriteHandler struct {
+	client writer.RemoteWriteClient
+	opts   writer.RemoteWriteOptions
+}
+
+// NewRemoteWriteHandler creates a new Prometheus remote write handler.
+func NewRemoteWriteHandler(
+	client writer.RemoteWriteClient,
+	opts writer.RemoteWriteOptions,
+) Handler {
+	return remoteWriteHandler{
+		client: client,
+		opts:   opts,
+	}
+}
+
+func (h remoteWriteHandler) NewWriter(scope tally.Scope) (writer.Writer, error) {
+	iOpts := h.opts.InstrumentOptions()
+	return writer.NewRemoteWriteWriter(
+		h.client,
+		h.opts.SetInstrumentOptions(iOpts.SetMetricsScope(scope)),
+	), nil
+}
+
+func (h remoteWriteHandler) Close() {
+	h.client.Close()
+}
@@ -30,14 +30,16 @@ type Type string
 
 // A list of supported handler types.
 const (
-	blackholeType Type = "blackhole"
-	loggingType   Type = "logging"
+	blackholeType   Type = "blackhole"
+	loggingType     Type = "logging"
+	remoteWriteType Type = "remoteWrite"
 )
 
 var (
 	validHandlerTypes = []Type{
 		blackholeType,
 		loggingType,
+		remoteWriteType,
 	}
 )
 
@@ -0,0 +1,375 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package writer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/metrics/metric/aggregated"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	"github.com/m3db/m3/src/x/retry"
+	"github.com/m3db/m3/src/x/serialize"
+)
+
+const (
+	remoteWriteVersionHeader = "X-Prometheus-Remote-Write-Version"
+	remoteWriteVersion       = "0.1.0"
+	remoteWriteMaxErrBody    = 512
+)
+
+var (
+	errRemoteWriteClientClosed = errors.New("remote write client is closed")
+	errRemoteWriteQueueFull    = errors.New("remote write queue is full")
+	errRemoteWriteNoTags       = errors.New("serialized tags id has no tags")
+
+	metricNameLabel = []byte("__name__")
+)
+
+// RemoteWriteClient sends batches of time series to a Prometheus remote
+// write endpoint asynchronously. It is shared by the remote write writers
+// of a handler.
+type RemoteWriteClient interface {
+	// Enqueue queues a batch of time series to be sent, the client takes
+	// ownership of the batch.
+	Enqueue(series []prompb.TimeSeries) error
+
+	// Close sends the batches left in the queue and closes the client.
+	Close()
+}
+
+type remoteWriteClientMetrics struct {
+	write         instrument.MethodMetrics
+	seriesSent    tally.Counter
+	seriesDropped tally.Counter
+	queueFull     tally.Counter
+	encodeErrors  tally.Counter
+}
+
+func newRemoteWriteClientMetrics(
+	scope tally.Scope,
+	opts instrument.TimerOptions,
+) remoteWriteClientMetrics {
+	return remoteWriteClientMetrics{
+		write:         instrument.NewMethodMetrics(scope, "write", opts),
+		seriesSent:    scope.Counter("series-sent"),
+		seriesDropped: scope.Counter("series-dropped"),
+		queueFull:     scope.Counter("queue-full"),
+		encodeErrors:  scope.SubScope("encode").Counter("errors"),
+	}
+}
+
+type remoteWriteClient struct {
+	sync.RWMutex
+
+	endpoint string
+	headers  map[string]string
+	client   *http.Client
+	retrier  retry.Retrier
+	queue    chan []prompb.TimeSeries
+	closed   bool
+	wg       sync.WaitGroup
+	logger   *zap.Logger
+	metrics  remoteWriteClientMetrics
+}
+
+// NewRemoteWriteClient creates a new remote write client.
+func NewRemoteWriteClient(opts RemoteWriteOptions) (RemoteWriteClient, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	instrumentOpts := opts.InstrumentOptions()
+	c := &remoteWriteClient{
+		endpoint: opts.Endpoint(),
+		headers:  opts.Headers(),
+		client:   xhttp.NewHTTPClient(opts.HTTPClientOptions()),
+		retrier:  retry.NewRetrier(opts.RetryOptions()),
+		queue:    make(chan []prompb.TimeSeries, opts.QueueSize()),
+		logger:   instrumentOpts.Logger(),
+		metrics: newRemoteWriteClientMetrics(instrumentOpts.MetricsScope(),
+			instrumentOpts.TimerOptions()),
+	}
+	c.wg.Add(opts.NumWorkers())
+	for i := 0; i < opts.NumWorkers(); i++ {
+		go c.sendLoop()
+	}
+	return c, nil
+}
+
+func (c *remoteWriteClient) Enqueue(series []prompb.TimeSeries) error {
+	c.RLock()
+	defer c.RUnlock()
+
+	if c.closed {
+		return errRemoteWriteClientClosed
+	}
+	select {
+	case c.queue <- series:
+		return nil
+	default:
+		c.metrics.queueFull.Inc(1)
+		c.metrics.seriesDropped.Inc(int64(len(series)))
+		return errRemoteWriteQueueFull
+	}
+}
+
+func (c *remoteWriteClient) Close() {
+	c.Lock()
+	if c.closed {
+		c.Unlock()
+		return
+	}
+	c.closed = true
+	close(c.queue)
+	c.Unlock()
+
+	c.wg.Wait()
+	c.client.CloseIdleConnections()
+}
+
+func (c *remoteWriteClient) sendLoop() {
+	defer c.wg.Done()
+
+	var buf []byte
+	for series := range c.queue {
+		buf = c.send(series, buf)
+	}
+}
+
+// send sends a batch of time series, retrying on failures that may succeed
+// on a later attempt, and returns the buffer used to compress the request.
+func (c *remoteWriteClient) send(series []prompb.TimeSeries, buf []byte) []byte {
+	req := prompb.WriteRequest{Timeseries: series}
+	data, err := req.Marshal()
+	if err != nil {
+		c.metrics.encodeErrors.Inc(1)
+		c.metrics.seriesDropped.Inc(int64(len(series)))
+		return buf
+	}
+	buf = snappy.Encode(buf[:cap(buf)], data)
+	if err := c.retrier.Attempt(func() error {
+		return c.write(buf)
+	}); err != nil {
+		c.metrics.seriesDropped.Inc(int64(len(series)))
+		c.logger.Error("unable to send remote write request",
+			zap.String("endpoint", c.endpoint),
+			zap.Int("numSeries", len(series)),
+			zap.Error(err))
+		return buf
+	}
+	c.metrics.seriesSent.Inc(int64(len(series)))
+	return buf
+}
+
+func (c *remoteWriteClient) write(encoded []byte) error {
+	req, err := http.NewRequest(http.MethodPost, c.endpoint, bytes.NewReader(encoded))
+	if err != nil {
+		return xerrors.NewNonRetryableError(err)
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeProtobuf)
+	req.Header.Set(remoteWriteVersionHeader, remoteWriteVersion)
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
+
+	start := time.Now()
+	resp, err := c.client.Do(req)
+	duration := time.Since(start)
+	if err != nil {
+		c.metrics.write.ReportError(duration)
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 == 2 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		c.metrics.write.ReportSuccess(duration)
+		return nil
+	}
+	c.metrics.write.ReportError(duration)
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, remoteWriteMaxErrBody))
+	err = fmt.Errorf("expected status code 2XX: actual=%v, endpoint=%v, resp=%s",
+		resp.StatusCode, c.endpoint, body)
+	if resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		// The request is rejected by the endpoint, retrying won't help.
+		return xerrors.NewNonRetryableError(err)
+	}
+	return err
+}
+
+type remoteWriteWriterMetrics struct {
+	writerClosed  tally.Counter
+	decodeErrors  tally.Counter
+	enqueueErrors tally.Counter
+}
+
+func newRemoteWriteWriterMetrics(scope tally.Scope) remoteWriteWriterMetrics {
+	decodeScope := scope.SubScope("decode")
+	enqueueScope := scope.SubScope("enqueue")
+	return remoteWriteWriterMetrics{
+		writerClosed:  scope.Counter("writer-closed"),
+		decodeErrors:  decodeScope.Counter("errors"),
+		enqueueErrors: enqueueScope.Counter("errors"),
+	}
+}
+
+// remoteWriteWriter converts aggregated metrics to Prometheus time series and
+// batches them before handing them to the remote write client.
+// remoteWriteWriter is not thread safe.
+type remoteWriteWriter struct {
+	client       RemoteWriteClient
+	maxBatchSize int
+	tagsIter     serialize.MetricTagsIterator
+
+	id      []byte
+	batch   []prompb.TimeSeries
+	metrics remoteWriteWriterMetrics
+	closed  bool
+}
+
+// NewRemoteWriteWriter creates a writer that sends metrics to a Prometheus
+// remote write endpoint. Metric IDs that are serialized tags are converted to
+// labels, other IDs are used as the metric name. Metrics whose serialized tags
+// can't be decoded are dropped.
+func NewRemoteWriteWriter(
+	client RemoteWriteClient,
+	opts RemoteWriteOptions,
+) Writer {
+	return &remoteWriteWriter{
+		client:       client,
+		maxBatchSize: opts.MaxBatchSize(),
+		tagsIter:     serialize.NewUncheckedMetricTagsIterator(serialize.NewTagSerializationLimits()),
+		metrics:      newRemoteWriteWriterMetrics(opts.InstrumentOptions().MetricsScope()),
+	}
+}
+
+func (w *remoteWriteWriter) Write(mp aggregated.ChunkedMetricWithStoragePolicy) error {
+	if w.closed {
+		w.metrics.writerClosed.Inc(1)
+		return errWriterClosed
+	}
+
+	w.id = w.id[:0]
+	w.id = append(w.id, mp.Prefix...)
+	w.id = append(w.id, mp.Data...)
+	w.id = append(w.id, mp.Suffix...)
+	labels, err := w.labels(w.id)
+	if err != nil {
+		w.metrics.decodeErrors.Inc(1)
+		return err
+	}
+	w.batch = append(w.batch, prompb.TimeSeries{
+		Labels: labels,
+		Samples: []prompb.Sample{{
+			Value:     mp.Value,
+			Timestamp: mp.TimeNanos / int64(time.Millisecond),
+		}},
+	})
+	if len(w.batch) >= w.maxBatchSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+func (w *remoteWriteWriter) labels(id []byte) ([]prompb.Label, error) {
+	if len(id) < 2 || serialize.ByteOrder.Uint16(id) != serialize.HeaderMagicNumber {
+		// The ID is not made of serialized tags, use it as the metric name.
+		return []prompb.Label{{Name: metricNameLabel, Value: sanitizeMetricName(id)}}, nil
+	}
+	w.tagsIter.Reset(id)
+	labels := make([]prompb.Label, 0, w.tagsIter.NumTags())
+	for w.tagsIter.Next() {
+		// NB: the tags point into the ID buffer which is reused, copy them.
+		name, value := w.tagsIter.Current()
+		labels = append(labels, prompb.Label{
+			Name:  append([]byte(nil), name...),
+			Value: append([]byte(nil), value...),
+		})
+	}
+	if err := w.tagsIter.Err(); err != nil {
+		return nil, err
+	}
+	if len(labels) == 0 {
+		return nil, errRemoteWriteNoTags
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return bytes.Compare(labels[i].Name, labels[j].Name) < 0
+	})
+	return labels, nil
+}
+
+func (w *remoteWriteWriter) Flush() error {
+	if len(w.batch) == 0 {
+		return nil
+	}
+	// NB: the client takes ownership of the batch.
+	batch := w.batch
+	w.batch = nil
+	if err := w.client.Enqueue(batch); err != nil {
+		w.metrics.enqueueErrors.Inc(1)
+		return err
+	}
+	return nil
+}
+
+func (w *remoteWriteWriter) Close() error {
+	if w.closed {
+		w.metrics.writerClosed.Inc(1)
+		return errWriterClosed
+	}
+	// Don't close the client here, it is shared by other writers.
+	err := w.Flush()
+	w.closed = true
+	return err
+}
+
+// sanitizeMetricName converts an ID that is not made of serialized tags to a
+// valid Prometheus metric name, e.g. "foo.bar-baz" becomes "foo_bar_baz".
+func sanitizeMetricName(id []byte) []byte {
+	name := make([]byte, 0, len(id)+1)
+	for i, b := range id {
+		switch {
+		case b >= 'a' && b <= 'z', b >= 'A' && b <= 'Z', b == '_', b == ':':
+			name = append(name, b)
+		case b >= '0' && b <= '9':
+			if i == 0 {
+				name = append(name, '_')
+			}
+			name = append(name, b)
+		default:
+			name = append(name, '_')
+		}
+	}
+	return name
+}
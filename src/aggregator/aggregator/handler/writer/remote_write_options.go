@@ -0,0 +1,215 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package writer
+
+import (
+	"errors"
+
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	"github.com/m3db/m3/src/x/retry"
+)
+
+const (
+	defaultRemoteWriteMaxBatchSize = 1000
+	defaultRemoteWriteQueueSize    = 128
+	defaultRemoteWriteNumWorkers   = 4
+)
+
+var (
+	errNoRemoteWriteEndpoint          = errors.New("no remote write endpoint")
+	errInvalidRemoteWriteMaxBatchSize = errors.New("invalid remote write max batch size")
+	errInvalidRemoteWriteQueueSize    = errors.New("invalid remote write queue size")
+	errInvalidRemoteWriteNumWorkers   = errors.New("invalid remote write number of workers")
+)
+
+// RemoteWriteOptions provide a set of options for the remote write client and writers.
+type RemoteWriteOptions interface {
+	// SetEndpoint sets the remote write endpoint.
+	SetEndpoint(value string) RemoteWriteOptions
+
+	// Endpoint returns the remote write endpoint.
+	Endpoint() string
+
+	// SetHeaders sets the headers added to each remote write request.
+	SetHeaders(value map[string]string) RemoteWriteOptions
+
+	// Headers returns the headers added to each remote write request.
+	Headers() map[string]string
+
+	// SetHTTPClientOptions sets the http client options.
+	SetHTTPClientOptions(value xhttp.HTTPClientOptions) RemoteWriteOptions
+
+	// HTTPClientOptions returns the http client options.
+	HTTPClientOptions() xhttp.HTTPClientOptions
+
+	// SetMaxBatchSize sets the max number of series sent in a single request.
+	SetMaxBatchSize(value int) RemoteWriteOptions
+
+	// MaxBatchSize returns the max number of series sent in a single request.
+	MaxBatchSize() int
+
+	// SetQueueSize sets the number of batches queued before writes are rejected.
+	SetQueueSize(value int) RemoteWriteOptions
+
+	// QueueSize returns the number of batches queued before writes are rejected.
+	QueueSize() int
+
+	// SetNumWorkers sets the number of workers sending requests concurrently.
+	SetNumWorkers(value int) RemoteWriteOptions
+
+	// NumWorkers returns the number of workers sending requests concurrently.
+	NumWorkers() int
+
+	// SetRetryOptions sets the retry options for sending requests.
+	SetRetryOptions(value retry.Options) RemoteWriteOptions
+
+	// RetryOptions returns the retry options for sending requests.
+	RetryOptions() retry.Options
+
+	// SetInstrumentOptions sets the instrument options.
+	SetInstrumentOptions(value instrument.Options) RemoteWriteOptions
+
+	// InstrumentOptions returns the instrument options.
+	InstrumentOptions() instrument.Options
+
+	// Validate validates the options.
+	Validate() error
+}
+
+type remoteWriteOptions struct {
+	endpoint          string
+	headers           map[string]string
+	httpClientOptions xhttp.HTTPClientOptions
+	maxBatchSize      int
+	queueSize         int
+	numWorkers        int
+	retryOpts         retry.Options
+	instrumentOpts    instrument.Options
+}
+
+// NewRemoteWriteOptions provide a set of remote write options.
+func NewRemoteWriteOptions() RemoteWriteOptions {
+	return &remoteWriteOptions{
+		httpClientOptions: xhttp.DefaultHTTPClientOptions(),
+		maxBatchSize:      defaultRemoteWriteMaxBatchSize,
+		queueSize:         defaultRemoteWriteQueueSize,
+		numWorkers:        defaultRemoteWriteNumWorkers,
+		retryOpts:         retry.NewOptions(),
+		instrumentOpts:    instrument.NewOptions(),
+	}
+}
+
+func (o *remoteWriteOptions) SetEndpoint(value string) RemoteWriteOptions {
+	opts := *o
+	opts.endpoint = value
+	return &opts
+}
+
+func (o *remoteWriteOptions) Endpoint() string {
+	return o.endpoint
+}
+
+func (o *remoteWriteOptions) SetHeaders(value map[string]string) RemoteWriteOptions {
+	opts := *o
+	opts.headers = value
+	return &opts
+}
+
+func (o *remoteWriteOptions) Headers() map[string]string {
+	return o.headers
+}
+
+func (o *remoteWriteOptions) SetHTTPClientOptions(value xhttp.HTTPClientOptions) RemoteWriteOptions {
+	opts := *o
+	opts.httpClientOptions = value
+	return &opts
+}
+
+func (o *remoteWriteOptions) HTTPClientOptions() xhttp.HTTPClientOptions {
+	return o.httpClientOptions
+}
+
+func (o *remoteWriteOptions) SetMaxBatchSize(value int) RemoteWriteOptions {
+	opts := *o
+	opts.maxBatchSize = value
+	return &opts
+}
+
+func (o *remoteWriteOptions) MaxBatchSize() int {
+	return o.maxBatchSize
+}
+
+func (o *remoteWriteOptions) SetQueueSize(value int) RemoteWriteOptions {
+	opts := *o
+	opts.queueSize = value
+	return &opts
+}
+
+func (o *remoteWriteOptions) QueueSize() int {
+	return o.queueSize
+}
+
+func (o *remoteWriteOptions) SetNumWorkers(value int) RemoteWriteOptions {
+	opts := *o
+	opts.numWorkers = value
+	return &opts
+}
+
+func (o *remoteWriteOptions) NumWorkers() int {
+	return o.numWorkers
+}
+
+func (o *remoteWriteOptions) SetRetryOptions(value retry.Options) RemoteWriteOptions {
+	opts := *o
+	opts.retryOpts = value
+	return &opts
+}
+
+func (o *remoteWriteOptions) RetryOptions() retry.Options {
+	return o.retryOpts
+}
+
+func (o *remoteWriteOptions) SetInstrumentOptions(value instrument.Options) RemoteWriteOptions {
+	opts := *o
+	opts.instrumentOpts = value
+	return &opts
+}
+
+func (o *remoteWriteOptions) InstrumentOptions() instrument.Options {
+	return o.instrumentOpts
+}
+
+func (o *remoteWriteOptions) Validate() error {
+	if o.endpoint == "" {
+		return errNoRemoteWriteEndpoint
+	}
+	if o.maxBatchSize <= 0 {
+		return errInvalidRemoteWriteMaxBatchSize
+	}
+	if o.queueSize <= 0 {
+		return errInvalidRemoteWriteQueueSize
+	}
+	if o.numWorkers <= 0 {
+		return errInvalidRemoteWriteNumWorkers
+	}
+	return nil
+}
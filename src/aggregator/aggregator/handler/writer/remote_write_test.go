@@ -0,0 +1,287 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package writer
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/m3db/m3/src/metrics/metric/aggregated"
+	"github.com/m3db/m3/src/metrics/metric/id"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	"github.com/m3db/m3/src/x/retry"
+	"github.com/m3db/m3/src/x/serialize"
+)
+
+func TestRemoteWriteWriterWriteFlush(t *testing.T) {
+	server := newTestRemoteWriteServer(t)
+	defer server.Close()
+
+	opts := testRemoteWriteOptions(server.URL)
+	client, err := NewRemoteWriteClient(opts)
+	require.NoError(t, err)
+	w := NewRemoteWriteWriter(client, opts)
+
+	tagsID := testSerializedTagsID(t, "foo", "bar", "__name__", "baz")
+	require.NoError(t, w.Write(aggregated.ChunkedMetricWithStoragePolicy{
+		ChunkedMetric: aggregated.ChunkedMetric{
+			ChunkedID: id.ChunkedID{Data: tagsID},
+			TimeNanos: int64(2 * time.Second),
+			Value:     1.5,
+		},
+	}))
+	require.NoError(t, w.Write(testChunkedMetricWithStoragePolicy))
+	require.Equal(t, 0, len(server.requests()))
+
+	require.NoError(t, w.Close())
+	client.Close()
+
+	requests := server.requests()
+	require.Equal(t, 1, len(requests))
+	require.Equal(t, []prompb.TimeSeries{
+		{
+			Labels: []prompb.Label{
+				{Name: []byte("__name__"), Value: []byte("baz")},
+				{Name: []byte("foo"), Value: []byte("bar")},
+			},
+			Samples: []prompb.Sample{{Value: 1.5, Timestamp: 2000}},
+		},
+		{
+			Labels: []prompb.Label{
+				{Name: []byte("__name__"), Value: []byte("testPrefix_testData_testSuffix")},
+			},
+			Samples: []prompb.Sample{{Value: 3.14, Timestamp: 0}},
+		},
+	}, requests[0].Timeseries)
+
+	require.Equal(t, errWriterClosed, w.Write(testChunkedMetricWithStoragePolicy))
+	require.Equal(t, errWriterClosed, w.Close())
+}
+
+func TestRemoteWriteWriterBatch(t *testing.T) {
+	client := &testRemoteWriteClient{}
+	w := NewRemoteWriteWriter(client, NewRemoteWriteOptions().SetMaxBatchSize(2))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, w.Write(testChunkedMetricWithStoragePolicy))
+	}
+	require.Equal(t, []int{2}, client.batchSizes)
+
+	require.NoError(t, w.Flush())
+	require.Equal(t, []int{2, 1}, client.batchSizes)
+
+	// Flushing without anything buffered is a no-op.
+	require.NoError(t, w.Flush())
+	require.Equal(t, []int{2, 1}, client.batchSizes)
+}
+
+func TestRemoteWriteWriterDecodeError(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	client := &testRemoteWriteClient{}
+	w := NewRemoteWriteWriter(client, NewRemoteWriteOptions().
+		SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope)))
+
+	// The serialized tags are truncated so they can't be decoded.
+	tagsID := testSerializedTagsID(t, "foo", "bar", "__name__", "baz")
+	require.Error(t, w.Write(aggregated.ChunkedMetricWithStoragePolicy{
+		ChunkedMetric: aggregated.ChunkedMetric{
+			ChunkedID: id.ChunkedID{Data: tagsID[:len(tagsID)-1]},
+		},
+	}))
+	require.Equal(t, int64(1), scope.Snapshot().Counters()["decode.errors+"].Value())
+
+	// The metric is dropped.
+	require.NoError(t, w.Flush())
+	require.Equal(t, 0, len(client.batchSizes))
+}
+
+func TestRemoteWriteClientRetry(t *testing.T) {
+	server := newTestRemoteWriteServer(t)
+	defer server.Close()
+	server.setStatusCodes(http.StatusServiceUnavailable, http.StatusTooManyRequests)
+
+	opts := testRemoteWriteOptions(server.URL)
+	client, err := NewRemoteWriteClient(opts)
+	require.NoError(t, err)
+	require.NoError(t, client.Enqueue([]prompb.TimeSeries{testRemoteWriteSeries()}))
+	client.Close()
+
+	// The request is retried until it succeeds.
+	require.Equal(t, 3, server.attempts())
+	require.Equal(t, 1, len(server.requests()))
+}
+
+func TestRemoteWriteClientNoRetryOnBadRequest(t *testing.T) {
+	server := newTestRemoteWriteServer(t)
+	defer server.Close()
+	server.setStatusCodes(http.StatusBadRequest)
+
+	opts := testRemoteWriteOptions(server.URL)
+	client, err := NewRemoteWriteClient(opts)
+	require.NoError(t, err)
+	require.NoError(t, client.Enqueue([]prompb.TimeSeries{testRemoteWriteSeries()}))
+	client.Close()
+
+	require.Equal(t, 1, server.attempts())
+	require.Equal(t, 0, len(server.requests()))
+}
+
+func TestRemoteWriteClientQueueFullAndClosed(t *testing.T) {
+	// NB: the client has no workers so the queue is never drained.
+	c := &remoteWriteClient{
+		client:  &http.Client{},
+		queue:   make(chan []prompb.TimeSeries, 1),
+		metrics: newRemoteWriteClientMetrics(tally.NoopScope, instrument.TimerOptions{}),
+	}
+	require.NoError(t, c.Enqueue([]prompb.TimeSeries{testRemoteWriteSeries()}))
+	require.Equal(t, errRemoteWriteQueueFull, c.Enqueue([]prompb.TimeSeries{testRemoteWriteSeries()}))
+
+	c.Close()
+	require.Equal(t, errRemoteWriteClientClosed, c.Enqueue([]prompb.TimeSeries{testRemoteWriteSeries()}))
+}
+
+func TestRemoteWriteOptionsValidate(t *testing.T) {
+	opts := NewRemoteWriteOptions()
+	require.Equal(t, errNoRemoteWriteEndpoint, opts.Validate())
+
+	opts = opts.SetEndpoint("http://localhost/write")
+	require.NoError(t, opts.Validate())
+	require.Equal(t, errInvalidRemoteWriteMaxBatchSize, opts.SetMaxBatchSize(0).Validate())
+	require.Equal(t, errInvalidRemoteWriteQueueSize, opts.SetQueueSize(0).Validate())
+	require.Equal(t, errInvalidRemoteWriteNumWorkers, opts.SetNumWorkers(0).Validate())
+}
+
+func TestSanitizeMetricName(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected string
+	}{
+		{id: "foo", expected: "foo"},
+		{id: "foo.bar-baz", expected: "foo_bar_baz"},
+		{id: "foo:bar_Baz9", expected: "foo:bar_Baz9"},
+		{id: "9foo", expected: "_9foo"},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.expected, string(sanitizeMetricName([]byte(test.id))))
+	}
+}
+
+type testRemoteWriteServer struct {
+	*httptest.Server
+
+	sync.Mutex
+	statusCodes []int
+	numAttempts int
+	received    []*prompb.WriteRequest
+}
+
+func newTestRemoteWriteServer(t *testing.T) *testRemoteWriteServer {
+	s := &testRemoteWriteServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "snappy", r.Header.Get("Content-Encoding"))
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		data, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		decoded, err := snappy.Decode(nil, data)
+		assert.NoError(t, err)
+		req := &prompb.WriteRequest{}
+		assert.NoError(t, req.Unmarshal(decoded))
+
+		s.Lock()
+		defer s.Unlock()
+		s.numAttempts++
+		if len(s.statusCodes) > 0 {
+			statusCode := s.statusCodes[0]
+			s.statusCodes = s.statusCodes[1:]
+			w.WriteHeader(statusCode)
+			return
+		}
+		s.received = append(s.received, req)
+	}))
+	return s
+}
+
+func (s *testRemoteWriteServer) setStatusCodes(statusCodes ...int) {
+	s.Lock()
+	s.statusCodes = statusCodes
+	s.Unlock()
+}
+
+func (s *testRemoteWriteServer) attempts() int {
+	s.Lock()
+	defer s.Unlock()
+	return s.numAttempts
+}
+
+func (s *testRemoteWriteServer) requests() []*prompb.WriteRequest {
+	s.Lock()
+	defer s.Unlock()
+	return s.received
+}
+
+type testRemoteWriteClient struct {
+	batchSizes []int
+}
+
+func (c *testRemoteWriteClient) Enqueue(series []prompb.TimeSeries) error {
+	c.batchSizes = append(c.batchSizes, len(series))
+	return nil
+}
+
+func (c *testRemoteWriteClient) Close() {}
+
+func testRemoteWriteOptions(endpoint string) RemoteWriteOptions {
+	return NewRemoteWriteOptions().
+		SetEndpoint(endpoint).
+		SetNumWorkers(1).
+		SetRetryOptions(retry.NewOptions().
+			SetInitialBackoff(time.Millisecond).
+			SetMaxBackoff(time.Millisecond).
+			SetMaxRetries(3))
+}
+
+func testRemoteWriteSeries() prompb.TimeSeries {
+	return prompb.TimeSeries{
+		Labels:  []prompb.Label{{Name: []byte("__name__"), Value: []byte("foo")}},
+		Samples: []prompb.Sample{{Value: 1, Timestamp: 1000}},
+	}
+}
+
+func testSerializedTagsID(t *testing.T, tags ...string) []byte {
+	encoderPool := serialize.NewTagEncoderPool(serialize.NewTagEncoderOptions(), nil)
+	encoderPool.Init()
+	encoder := encoderPool.Get()
+	require.NoError(t, encoder.Encode(ident.MustNewTagStringsIterator(tags...)))
+	data, ok := encoder.Data()
+	require.True(t, ok)
+	return append([]byte(nil), data.Bytes()...)
+}
@@ -29,13 +29,16 @@ import (
 
 	"github.com/uber-go/tally"
 
+	raggregation "github.com/m3db/m3/src/aggregator/aggregation"
 	"github.com/m3db/m3/src/aggregator/hash"
 	"github.com/m3db/m3/src/aggregator/rate"
 	"github.com/m3db/m3/src/aggregator/runtime"
 	"github.com/m3db/m3/src/metrics/metadata"
+	"github.com/m3db/m3/src/metrics/metric"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
 	"github.com/m3db/m3/src/metrics/metric/unaggregated"
 	"github.com/m3db/m3/src/x/clock"
+	xerrors "github.com/m3db/m3/src/x/errors"
 	xresource "github.com/m3db/m3/src/x/resource"
 	xtime "github.com/m3db/m3/src/x/time"
 )
@@ -238,6 +241,76 @@ func (m *metricMap) AddForwarded(
 	return err
 }
 
+// Checkpoint encodes the entries in the map along with the open aggregation
+// windows of their elements.
+func (m *metricMap) Checkpoint(enc *raggregation.CheckpointEncoder) error {
+	var (
+		entryEnc raggregation.CheckpointEncoder
+		multiErr = xerrors.NewMultiError()
+	)
+
+	// NB: hold onto the entry list deletion lock so the entries are not
+	// expired while they are being encoded.
+	m.entryListDelLock.Lock()
+	m.forEachEntry(func(entry hashedEntry) {
+		entryEnc.Reset()
+		if err := entry.entry.Checkpoint(&entryEnc); err != nil {
+			multiErr = multiErr.Add(err)
+			return
+		}
+		enc.EncodeBool(true)
+		enc.EncodeVarint(int64(entry.key.metricCategory))
+		enc.EncodeVarint(int64(entry.key.metricType))
+		enc.EncodeBytes(entryEnc.Bytes())
+	})
+	m.entryListDelLock.Unlock()
+	enc.EncodeBool(false)
+	return multiErr.FinalError()
+}
+
+// Restore restores the entries encoded by Checkpoint. Entries that cannot be
+// restored are skipped and their errors are returned once all other entries
+// have been restored.
+func (m *metricMap) Restore(dec *raggregation.CheckpointDecoder, flushedFn checkpointFlushedFn) error {
+	var (
+		entryDec raggregation.CheckpointDecoder
+		multiErr = xerrors.NewMultiError()
+	)
+	for dec.DecodeBool() {
+		category := metricCategory(dec.DecodeVarint())
+		mt := metricType(dec.DecodeVarint())
+		entryDec.Reset(dec.DecodeBytes())
+		if dec.Err() != nil {
+			break
+		}
+		id := entryDec.DecodeBytes()
+		if len(id) == 0 {
+			continue
+		}
+		key := entryKey{
+			metricCategory: category,
+			metricType:     mt,
+			idHash:         hash.Murmur3Hash128(id),
+		}
+		// NB: restored entries were admitted before the checkpoint was taken
+		// and as such are not subject to the new metric rate limit.
+		entry, err := m.findOrCreateWithRateLimit(key, false)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		err = entry.Restore(category, metric.Type(mt), id, &entryDec, flushedFn)
+		entry.DecWriter()
+		if err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	if err := dec.Err(); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+	return multiErr.FinalError()
+}
+
 func (m *metricMap) Tick(target time.Duration) tickResult {
 	mapTickRes := m.tick(target)
 	listsTickRes := m.metricLists.Tick()
@@ -280,6 +353,10 @@ func (m *metricMap) Close() {
 }
 
 func (m *metricMap) findOrCreate(key entryKey) (*Entry, error) {
+	return m.findOrCreateWithRateLimit(key, true)
+}
+
+func (m *metricMap) findOrCreateWithRateLimit(key entryKey, applyRateLimit bool) (*Entry, error) {
 	m.RLock()
 	if m.closed {
 		m.RUnlock()
@@ -312,9 +389,11 @@ func (m *metricMap) findOrCreate(key entryKey) (*Entry, error) {
 	if m.firstInsertAt.IsZero() {
 		m.firstInsertAt = now
 	}
-	if err := m.applyNewMetricRateLimitWithLock(now); err != nil {
-		m.Unlock()
-		return nil, err
+	if applyRateLimit {
+		if err := m.applyNewMetricRateLimitWithLock(now); err != nil {
+			m.Unlock()
+			return nil, err
+		}
 	}
 	entry = m.entryPool.Get()
 	entry.ResetSetData(m.metricLists, m.runtimeOpts, m.opts)
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler/writer"
 	"github.com/m3db/m3/src/aggregator/client"
@@ -42,6 +43,7 @@ var (
 	defaultCounterPrefix              = []byte("counts.")
 	defaultTimerPrefix                = []byte("timers.")
 	defaultGaugePrefix                = []byte("gauges.")
+	defaultHistogramPrefix            = []byte("histograms.")
 	defaultEntryTTL                   = time.Hour
 	defaultEntryCheckInterval         = time.Hour
 	defaultEntryCheckBatchPercent     = 0.01
@@ -49,6 +51,7 @@ var (
 	defaultMaxNumCachedSourceSets     = 2
 	defaultDiscardNaNAggregatedValues = true
 	defaultResignTimeout              = 5 * time.Minute
+	defaultCheckpointInterval         = time.Minute
 	defaultDefaultStoragePolicies     = []policy.StoragePolicy{
 		policy.NewStoragePolicy(10*time.Second, xtime.Second, 2*24*time.Hour),
 		policy.NewStoragePolicy(time.Minute, xtime.Minute, 40*24*time.Hour),
@@ -109,6 +112,12 @@ type Options interface {
 	// GaugePrefix returns the prefix for gauges.
 	GaugePrefix() []byte
 
+	// SetHistogramPrefix sets the prefix for histograms.
+	SetHistogramPrefix(value []byte) Options
+
+	// HistogramPrefix returns the prefix for histograms.
+	HistogramPrefix() []byte
+
 	// SetTimeLock sets the time lock.
 	SetTimeLock(value *sync.RWMutex) Options
 
@@ -139,6 +148,14 @@ type Options interface {
 	// StreamOptions returns the stream options.
 	StreamOptions() cm.Options
 
+	// SetSketchOptions sets the options of the sketches used by timers with
+	// DDSketch quantiles enabled.
+	SetSketchOptions(value ddsketch.Options) Options
+
+	// SketchOptions returns the options of the sketches used by timers with
+	// DDSketch quantiles enabled.
+	SketchOptions() ddsketch.Options
+
 	// SetAdminClient sets the administrative client.
 	SetAdminClient(value client.AdminClient) Options
 
@@ -181,6 +198,22 @@ type Options interface {
 	// FlushTimesManager returns the flush times manager.
 	FlushTimesManager() FlushTimesManager
 
+	// SetCheckpointManager sets the manager persisting checkpoints of the open
+	// aggregation windows, or nil to disable checkpointing.
+	SetCheckpointManager(value CheckpointManager) Options
+
+	// CheckpointManager returns the manager persisting checkpoints of the open
+	// aggregation windows.
+	CheckpointManager() CheckpointManager
+
+	// SetCheckpointInterval sets the interval between checkpoints of the open
+	// aggregation windows.
+	SetCheckpointInterval(value time.Duration) Options
+
+	// CheckpointInterval returns the interval between checkpoints of the open
+	// aggregation windows.
+	CheckpointInterval() time.Duration
+
 	// SetElectionManager sets the election manager.
 	SetElectionManager(value ElectionManager) Options
 
@@ -303,6 +336,12 @@ type Options interface {
 	// GaugeElemPool returns the gauge element pool.
 	GaugeElemPool() GaugeElemPool
 
+	// SetHistogramElemPool sets the histogram element pool.
+	SetHistogramElemPool(value HistogramElemPool) Options
+
+	// HistogramElemPool returns the histogram element pool.
+	HistogramElemPool() HistogramElemPool
+
 	/// Read-only derived options.
 
 	// FullCounterPrefix returns the full prefix for counters.
@@ -314,6 +353,9 @@ type Options interface {
 	// FullGaugePrefix returns the full prefix for gauges.
 	FullGaugePrefix() []byte
 
+	// FullHistogramPrefix returns the full prefix for histograms.
+	FullHistogramPrefix() []byte
+
 	// SetVerboseErrors returns whether to return verbose errors or not.
 	SetVerboseErrors(value bool) Options
 
@@ -356,10 +398,12 @@ type options struct {
 	counterPrefix                    []byte
 	timerPrefix                      []byte
 	gaugePrefix                      []byte
+	histogramPrefix                  []byte
 	timeLock                         *sync.RWMutex
 	clockOpts                        clock.Options
 	instrumentOpts                   instrument.Options
 	streamOpts                       cm.Options
+	sketchOpts                       ddsketch.Options
 	adminClient                      client.AdminClient
 	runtimeOptsManager               runtime.OptionsManager
 	placementManager                 PlacementManager
@@ -375,6 +419,8 @@ type options struct {
 	maxTimerBatchSizePerWrite        int
 	defaultStoragePolicies           []policy.StoragePolicy
 	flushTimesManager                FlushTimesManager
+	checkpointManager                CheckpointManager
+	checkpointInterval               time.Duration
 	electionManager                  ElectionManager
 	resignTimeout                    time.Duration
 	maxAllowedForwardingDelayFn      MaxAllowedForwardingDelayFn
@@ -387,6 +433,7 @@ type options struct {
 	counterElemPool                  CounterElemPool
 	timerElemPool                    TimerElemPool
 	gaugeElemPool                    GaugeElemPool
+	histogramElemPool                HistogramElemPool
 	verboseErrors                    bool
 	addToReset                       bool
 	timedMetricsFlushOffsetEnabled   bool
@@ -394,10 +441,11 @@ type options struct {
 	writesIgnoreCutoffCutover        bool
 
 	// Derived options.
-	fullCounterPrefix []byte
-	fullTimerPrefix   []byte
-	fullGaugePrefix   []byte
-	timerQuantiles    []float64
+	fullCounterPrefix   []byte
+	fullTimerPrefix     []byte
+	fullGaugePrefix     []byte
+	fullHistogramPrefix []byte
+	timerQuantiles      []float64
 }
 
 // NewOptions create a new set of options.
@@ -405,17 +453,20 @@ func NewOptions(clockOpts clock.Options) Options {
 	aggTypesOptions := aggregation.NewTypesOptions().
 		SetCounterTypeStringTransformFn(aggregation.EmptyTransform).
 		SetTimerTypeStringTransformFn(aggregation.SuffixTransform).
-		SetGaugeTypeStringTransformFn(aggregation.EmptyTransform)
+		SetGaugeTypeStringTransformFn(aggregation.EmptyTransform).
+		SetHistogramTypeStringTransformFn(aggregation.SuffixTransform)
 	o := &options{
 		aggTypesOptions:                  aggTypesOptions,
 		metricPrefix:                     defaultMetricPrefix,
 		counterPrefix:                    defaultCounterPrefix,
 		timerPrefix:                      defaultTimerPrefix,
 		gaugePrefix:                      defaultGaugePrefix,
+		histogramPrefix:                  defaultHistogramPrefix,
 		timeLock:                         &sync.RWMutex{},
 		clockOpts:                        clockOpts,
 		instrumentOpts:                   instrument.NewOptions(),
 		streamOpts:                       cm.NewOptions(),
+		sketchOpts:                       ddsketch.NewOptions(),
 		runtimeOptsManager:               runtime.NewOptionsManager(runtime.NewOptions()),
 		shardFn:                          sharding.Murmur32Hash.MustShardFn(),
 		bufferDurationBeforeShardCutover: defaultBufferDurationBeforeShardCutover,
@@ -427,6 +478,7 @@ func NewOptions(clockOpts clock.Options) Options {
 		maxTimerBatchSizePerWrite:        defaultMaxTimerBatchSizePerWrite,
 		defaultStoragePolicies:           defaultDefaultStoragePolicies,
 		resignTimeout:                    defaultResignTimeout,
+		checkpointInterval:               defaultCheckpointInterval,
 		maxAllowedForwardingDelayFn:      defaultMaxAllowedForwardingDelayFn,
 		bufferForPastTimedMetric:         defaultTimedMetricBuffer,
 		bufferForPastTimedMetricFn:       defaultBufferForPastTimedMetricFn,
@@ -489,6 +541,17 @@ func (o *options) GaugePrefix() []byte {
 	return o.gaugePrefix
 }
 
+func (o *options) SetHistogramPrefix(value []byte) Options {
+	opts := *o
+	opts.histogramPrefix = value
+	opts.computeFullHistogramPrefix()
+	return &opts
+}
+
+func (o *options) HistogramPrefix() []byte {
+	return o.histogramPrefix
+}
+
 func (o *options) SetTimeLock(value *sync.RWMutex) Options {
 	opts := *o
 	opts.timeLock = value
@@ -539,6 +602,16 @@ func (o *options) StreamOptions() cm.Options {
 	return o.streamOpts
 }
 
+func (o *options) SetSketchOptions(value ddsketch.Options) Options {
+	opts := *o
+	opts.sketchOpts = value
+	return &opts
+}
+
+func (o *options) SketchOptions() ddsketch.Options {
+	return o.sketchOpts
+}
+
 func (o *options) SetAdminClient(value client.AdminClient) Options {
 	opts := *o
 	opts.adminClient = value
@@ -609,6 +682,26 @@ func (o *options) FlushTimesManager() FlushTimesManager {
 	return o.flushTimesManager
 }
 
+func (o *options) SetCheckpointManager(value CheckpointManager) Options {
+	opts := *o
+	opts.checkpointManager = value
+	return &opts
+}
+
+func (o *options) CheckpointManager() CheckpointManager {
+	return o.checkpointManager
+}
+
+func (o *options) SetCheckpointInterval(value time.Duration) Options {
+	opts := *o
+	opts.checkpointInterval = value
+	return &opts
+}
+
+func (o *options) CheckpointInterval() time.Duration {
+	return o.checkpointInterval
+}
+
 func (o *options) SetElectionManager(value ElectionManager) Options {
 	opts := *o
 	opts.electionManager = value
@@ -809,6 +902,16 @@ func (o *options) GaugeElemPool() GaugeElemPool {
 	return o.gaugeElemPool
 }
 
+func (o *options) SetHistogramElemPool(value HistogramElemPool) Options {
+	opts := *o
+	opts.histogramElemPool = value
+	return &opts
+}
+
+func (o *options) HistogramElemPool() HistogramElemPool {
+	return o.histogramElemPool
+}
+
 func (o *options) SetVerboseErrors(value bool) Options {
 	opts := *o
 	opts.verboseErrors = value
@@ -831,6 +934,10 @@ func (o *options) FullGaugePrefix() []byte {
 	return o.fullGaugePrefix
 }
 
+func (o *options) FullHistogramPrefix() []byte {
+	return o.fullHistogramPrefix
+}
+
 func (o *options) TimerQuantiles() []float64 {
 	return o.timerQuantiles
 }
@@ -858,6 +965,11 @@ func (o *options) initPools() {
 	o.gaugeElemPool.Init(func() *GaugeElem {
 		return MustNewGaugeElem(ElemData{}, elemOpts)
 	})
+
+	o.histogramElemPool = NewHistogramElemPool(nil)
+	o.histogramElemPool.Init(func() *HistogramElem {
+		return MustNewHistogramElem(ElemData{}, elemOpts)
+	})
 }
 
 func (o *options) computeAllDerived() {
@@ -868,6 +980,7 @@ func (o *options) computeFullPrefixes() {
 	o.computeFullCounterPrefix()
 	o.computeFullTimerPrefix()
 	o.computeFullGaugePrefix()
+	o.computeFullHistogramPrefix()
 }
 
 func (o *options) computeFullCounterPrefix() {
@@ -891,6 +1004,13 @@ func (o *options) computeFullGaugePrefix() {
 	o.fullGaugePrefix = fullGaugePrefix
 }
 
+func (o *options) computeFullHistogramPrefix() {
+	fullHistogramPrefix := make([]byte, len(o.metricPrefix)+len(o.histogramPrefix))
+	n := copy(fullHistogramPrefix, o.metricPrefix)
+	copy(fullHistogramPrefix[n:], o.histogramPrefix)
+	o.fullHistogramPrefix = fullHistogramPrefix
+}
+
 func (o *options) AddToReset() bool {
 	return o.addToReset
 }
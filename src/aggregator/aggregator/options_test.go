@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler/writer"
 	"github.com/m3db/m3/src/aggregator/client"
@@ -57,6 +58,7 @@ func TestOptionsValidateDefault(t *testing.T) {
 	require.Equal(t, defaultCounterPrefix, o.CounterPrefix())
 	require.Equal(t, defaultTimerPrefix, o.TimerPrefix())
 	require.Equal(t, defaultGaugePrefix, o.GaugePrefix())
+	require.Equal(t, defaultHistogramPrefix, o.HistogramPrefix())
 	require.Equal(t, defaultEntryTTL, o.EntryTTL())
 	require.Equal(t, defaultEntryCheckInterval, o.EntryCheckInterval())
 	require.Equal(t, defaultEntryCheckBatchPercent, o.EntryCheckBatchPercent())
@@ -64,15 +66,18 @@ func TestOptionsValidateDefault(t *testing.T) {
 	require.NotNil(t, o.InstrumentOptions())
 	require.NotNil(t, o.TimeLock())
 	require.NotNil(t, o.StreamOptions())
+	require.NotNil(t, o.SketchOptions())
 	require.NotNil(t, o.EntryPool())
 	require.NotNil(t, o.CounterElemPool())
 	require.NotNil(t, o.TimerElemPool())
 	require.NotNil(t, o.GaugeElemPool())
+	require.NotNil(t, o.HistogramElemPool())
 
 	// Validate derived options.
 	validateDerivedPrefix(t, o.FullCounterPrefix(), o.MetricPrefix(), o.CounterPrefix())
 	validateDerivedPrefix(t, o.FullTimerPrefix(), o.MetricPrefix(), o.TimerPrefix())
 	validateDerivedPrefix(t, o.FullGaugePrefix(), o.MetricPrefix(), o.GaugePrefix())
+	validateDerivedPrefix(t, o.FullHistogramPrefix(), o.MetricPrefix(), o.HistogramPrefix())
 }
 
 func TestOptionsSetMetricPrefix(t *testing.T) {
@@ -82,6 +87,7 @@ func TestOptionsSetMetricPrefix(t *testing.T) {
 	validateDerivedPrefix(t, o.FullCounterPrefix(), o.MetricPrefix(), o.CounterPrefix())
 	validateDerivedPrefix(t, o.FullTimerPrefix(), o.MetricPrefix(), o.TimerPrefix())
 	validateDerivedPrefix(t, o.FullGaugePrefix(), o.MetricPrefix(), o.GaugePrefix())
+	validateDerivedPrefix(t, o.FullHistogramPrefix(), o.MetricPrefix(), o.HistogramPrefix())
 }
 
 func TestOptionsSetCounterPrefix(t *testing.T) {
@@ -105,6 +111,13 @@ func TestOptionsSetGaugePrefix(t *testing.T) {
 	validateDerivedPrefix(t, o.FullGaugePrefix(), o.MetricPrefix(), o.GaugePrefix())
 }
 
+func TestOptionsSetHistogramPrefix(t *testing.T) {
+	newPrefix := []byte("testHistogramPrefix")
+	o := newTestOptions().SetHistogramPrefix(newPrefix)
+	require.Equal(t, newPrefix, o.HistogramPrefix())
+	validateDerivedPrefix(t, o.FullHistogramPrefix(), o.MetricPrefix(), o.HistogramPrefix())
+}
+
 func TestSetClockOptions(t *testing.T) {
 	value := clock.NewOptions()
 	o := newTestOptions().SetClockOptions(value)
@@ -123,6 +136,12 @@ func TestSetStreamOptions(t *testing.T) {
 	require.Equal(t, value, o.StreamOptions())
 }
 
+func TestSetSketchOptions(t *testing.T) {
+	value := ddsketch.NewOptions().SetRelativeAccuracy(0.05)
+	o := newTestOptions().SetSketchOptions(value)
+	require.Equal(t, value, o.SketchOptions())
+}
+
 func TestSetAdminClient(t *testing.T) {
 	var c client.AdminClient = &client.M3MsgClient{}
 	o := newTestOptions().SetAdminClient(c)
@@ -194,6 +213,12 @@ func TestSetEntryCheckInterval(t *testing.T) {
 	require.Equal(t, value, o.EntryCheckInterval())
 }
 
+func TestSetCheckpointInterval(t *testing.T) {
+	value := 10 * time.Second
+	o := newTestOptions().SetCheckpointInterval(value)
+	require.Equal(t, value, o.CheckpointInterval())
+}
+
 func TestSetEntryCheckBatchPercent(t *testing.T) {
 	value := 0.05
 	o := newTestOptions().SetEntryCheckBatchPercent(value)
@@ -236,6 +261,12 @@ func TestSetGaugeElemPool(t *testing.T) {
 	require.Equal(t, value, o.GaugeElemPool())
 }
 
+func TestSetHistogramElemPool(t *testing.T) {
+	value := NewHistogramElemPool(nil)
+	o := newTestOptions().SetHistogramElemPool(value)
+	require.Equal(t, value, o.HistogramElemPool())
+}
+
 func newTestOptions() Options {
 	return NewOptions(clock.NewOptions())
 }
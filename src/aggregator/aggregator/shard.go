@@ -29,6 +29,7 @@ import (
 
 	"github.com/uber-go/tally"
 
+	raggregation "github.com/m3db/m3/src/aggregator/aggregation"
 	"github.com/m3db/m3/src/metrics/metadata"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
 	"github.com/m3db/m3/src/metrics/metric/unaggregated"
@@ -264,6 +265,29 @@ func (s *aggregatorShard) Tick(target time.Duration) tickResult {
 	return s.metricMap.Tick(target)
 }
 
+// Checkpoint encodes the open aggregation windows of the shard.
+func (s *aggregatorShard) Checkpoint(enc *raggregation.CheckpointEncoder) error {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.closed {
+		return errAggregatorShardClosed
+	}
+	return s.metricMap.Checkpoint(enc)
+}
+
+// Restore restores the aggregation windows encoded by Checkpoint, skipping
+// the windows that have already been flushed.
+func (s *aggregatorShard) Restore(dec *raggregation.CheckpointDecoder, flushedFn checkpointFlushedFn) error {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.closed {
+		return errAggregatorShardClosed
+	}
+	return s.metricMap.Restore(dec, flushedFn)
+}
+
 func (s *aggregatorShard) Close() {
 	s.Lock()
 	defer s.Unlock()
@@ -152,6 +152,24 @@ func (c *M3MsgClient) WriteUntimedGauge(
 	return err
 }
 
+// WriteUntimedHistogram writes untimed histogram metrics.
+func (c *M3MsgClient) WriteUntimedHistogram(
+	histogram unaggregated.Histogram,
+	metadatas metadata.StagedMetadatas,
+) error {
+	callStart := c.nowFn()
+	payload := payloadUnion{
+		payloadType: untimedType,
+		untimed: untimedPayload{
+			metric:    histogram.ToUnion(),
+			metadatas: metadatas,
+		},
+	}
+	err := c.write(histogram.ID, payload)
+	c.metrics.writeUntimedHistogram.ReportSuccessOrError(err, c.nowFn().Sub(callStart))
+	return err
+}
+
 // WriteTimed writes timed metrics.
 func (c *M3MsgClient) WriteTimed(
 	metric aggregated.Metric,
@@ -257,6 +275,7 @@ type m3msgClientMetrics struct {
 	writeUntimedCounter    instrument.MethodMetrics
 	writeUntimedBatchTimer instrument.MethodMetrics
 	writeUntimedGauge      instrument.MethodMetrics
+	writeUntimedHistogram  instrument.MethodMetrics
 	writePassthrough       instrument.MethodMetrics
 	writeForwarded         instrument.MethodMetrics
 }
@@ -269,6 +288,7 @@ func newM3msgClientMetrics(
 		writeUntimedCounter:    instrument.NewMethodMetrics(scope, "writeUntimedCounter", opts),
 		writeUntimedBatchTimer: instrument.NewMethodMetrics(scope, "writeUntimedBatchTimer", opts),
 		writeUntimedGauge:      instrument.NewMethodMetrics(scope, "writeUntimedGauge", opts),
+		writeUntimedHistogram:  instrument.NewMethodMetrics(scope, "writeUntimedHistogram", opts),
 		writePassthrough:       instrument.NewMethodMetrics(scope, "writePassthrough", opts),
 		writeForwarded:         instrument.NewMethodMetrics(scope, "writeForwarded", opts),
 	}
@@ -305,6 +325,7 @@ type message struct {
 	cm     metricpb.CounterWithMetadatas
 	bm     metricpb.BatchTimerWithMetadatas
 	gm     metricpb.GaugeWithMetadatas
+	hm     metricpb.HistogramWithMetadatas
 	fm     metricpb.ForwardedMetricWithMetadata
 	tm     metricpb.TimedMetricWithMetadata
 	tms    metricpb.TimedMetricWithMetadatas
@@ -369,6 +390,19 @@ func (m *message) Encode(
 				Type:               metricpb.MetricWithMetadatas_GAUGE_WITH_METADATAS,
 				GaugeWithMetadatas: &m.gm,
 			}
+		case metric.HistogramType:
+			value := unaggregated.HistogramWithMetadatas{
+				Histogram:       payload.untimed.metric.Histogram(),
+				StagedMetadatas: payload.untimed.metadatas,
+			}
+			if err := value.ToProto(&m.hm); err != nil {
+				return err
+			}
+
+			m.metric = metricpb.MetricWithMetadatas{
+				Type:                   metricpb.MetricWithMetadatas_HISTOGRAM_WITH_METADATAS,
+				HistogramWithMetadatas: &m.hm,
+			}
 		default:
 			return fmt.Errorf("unrecognized metric type: %v",
 				payload.untimed.metric.Type)
@@ -155,6 +155,23 @@ func (c *TCPClient) WriteUntimedGauge(
 	return c.write(gauge.ID, c.nowFn().UnixNano(), payload)
 }
 
+// WriteUntimedHistogram writes untimed histogram metrics.
+func (c *TCPClient) WriteUntimedHistogram(
+	histogram unaggregated.Histogram,
+	metadatas metadata.StagedMetadatas,
+) error {
+	payload := payloadUnion{
+		payloadType: untimedType,
+		untimed: untimedPayload{
+			metric:    histogram.ToUnion(),
+			metadatas: metadatas,
+		},
+	}
+
+	c.metrics.writeUntimedHistogram.Inc(1)
+	return c.write(histogram.ID, c.nowFn().UnixNano(), payload)
+}
+
 // WriteTimed writes timed metrics.
 func (c *TCPClient) WriteTimed(
 	metric aggregated.Metric,
@@ -343,6 +360,7 @@ type tcpClientMetrics struct {
 	writeUntimedCounter    tally.Counter
 	writeUntimedBatchTimer tally.Counter
 	writeUntimedGauge      tally.Counter
+	writeUntimedHistogram  tally.Counter
 	writePassthrough       tally.Counter
 	writeForwarded         tally.Counter
 	flush                  tally.Counter
@@ -359,6 +377,7 @@ func newTCPClientMetrics(
 		writeUntimedCounter:    scope.Counter("writeUntimedCounter"),
 		writeUntimedBatchTimer: scope.Counter("writeUntimedBatchTimer"),
 		writeUntimedGauge:      scope.Counter("writeUntimedGauge"),
+		writeUntimedHistogram:  scope.Counter("writeUntimedHistogram"),
 		writePassthrough:       scope.Counter("writePassthrough"),
 		writeForwarded:         scope.Counter("writeForwarded"),
 		flush:                  scope.Counter("flush"),
@@ -68,6 +68,13 @@ var (
 		ID:       []byte("foo"),
 		GaugeVal: 123.456,
 	}
+	testHistogram = unaggregated.MetricUnion{
+		Type:                 metric.HistogramType,
+		ID:                   []byte("foo"),
+		HistogramUpperBounds: []float64{10, 100, 1000},
+		HistogramCounts:      []int64{3, 5, 1},
+		HistogramSum:         1234.5,
+	}
 	testTimed = aggregated.Metric{
 		Type:      metric.CounterType,
 		ID:        []byte("testTimed"),
@@ -202,7 +209,7 @@ var (
 func TestTCPClientWriteUntimedMetricClosed(t *testing.T) {
 	c := mustNewTestTCPClient(t, testOptions())
 	require.NoError(t, c.Close())
-	for _, input := range []unaggregated.MetricUnion{testCounter, testBatchTimer, testGauge} {
+	for _, input := range []unaggregated.MetricUnion{testCounter, testBatchTimer, testGauge, testHistogram} {
 		var err error
 		switch input.Type {
 		case metric.CounterType:
@@ -211,6 +218,8 @@ func TestTCPClientWriteUntimedMetricClosed(t *testing.T) {
 			err = c.WriteUntimedBatchTimer(input.BatchTimer(), testStagedMetadatas)
 		case metric.GaugeType:
 			err = c.WriteUntimedGauge(input.Gauge(), testStagedMetadatas)
+		case metric.HistogramType:
+			err = c.WriteUntimedHistogram(input.Histogram(), testStagedMetadatas)
 		}
 		require.Error(t, err)
 	}
@@ -228,7 +237,7 @@ func TestTCPClientWriteUntimedMetricPlacementError(t *testing.T) {
 	c := mustNewTestTCPClient(t, testOptions())
 	c.placementWatcher = watcher
 
-	for _, input := range []unaggregated.MetricUnion{testCounter, testBatchTimer, testGauge} {
+	for _, input := range []unaggregated.MetricUnion{testCounter, testBatchTimer, testGauge, testHistogram} {
 		var err error
 		switch input.Type {
 		case metric.CounterType:
@@ -237,6 +246,8 @@ func TestTCPClientWriteUntimedMetricPlacementError(t *testing.T) {
 			err = c.WriteUntimedBatchTimer(input.BatchTimer(), testStagedMetadatas)
 		case metric.GaugeType:
 			err = c.WriteUntimedGauge(input.Gauge(), testStagedMetadatas)
+		case metric.HistogramType:
+			err = c.WriteUntimedHistogram(input.Histogram(), testStagedMetadatas)
 		}
 		require.Equal(t, errInvalidPlacement, err)
 	}
@@ -253,7 +264,7 @@ func TestTCPClientWriteUntimedMetricPlacementNil(t *testing.T) {
 	c := mustNewTestTCPClient(t, testOptions())
 	c.placementWatcher = watcher
 
-	for _, input := range []unaggregated.MetricUnion{testCounter, testBatchTimer, testGauge} {
+	for _, input := range []unaggregated.MetricUnion{testCounter, testBatchTimer, testGauge, testHistogram} {
 		var err error
 		switch input.Type {
 		case metric.CounterType:
@@ -262,6 +273,8 @@ func TestTCPClientWriteUntimedMetricPlacementNil(t *testing.T) {
 			err = c.WriteUntimedBatchTimer(input.BatchTimer(), testStagedMetadatas)
 		case metric.GaugeType:
 			err = c.WriteUntimedGauge(input.Gauge(), testStagedMetadatas)
+		case metric.HistogramType:
+			err = c.WriteUntimedHistogram(input.Histogram(), testStagedMetadatas)
 		}
 		require.Equal(t, errNilPlacement, err)
 	}
@@ -301,7 +314,7 @@ func TestTCPClientWriteUntimedMetricSuccess(t *testing.T) {
 		testPlacementInstances[0],
 		testPlacementInstances[2],
 	}
-	for _, input := range []unaggregated.MetricUnion{testCounter, testBatchTimer, testGauge} {
+	for _, input := range []unaggregated.MetricUnion{testCounter, testBatchTimer, testGauge, testHistogram} {
 		// Reset states in each iteration.
 		instancesRes = instancesRes[:0]
 		shardRes = 0
@@ -315,6 +328,8 @@ func TestTCPClientWriteUntimedMetricSuccess(t *testing.T) {
 			err = c.WriteUntimedBatchTimer(input.BatchTimer(), testStagedMetadatas)
 		case metric.GaugeType:
 			err = c.WriteUntimedGauge(input.Gauge(), testStagedMetadatas)
+		case metric.HistogramType:
+			err = c.WriteUntimedHistogram(input.Histogram(), testStagedMetadatas)
 		}
 
 		require.NoError(t, err)
@@ -297,6 +297,14 @@ func (w *writer) encodeUntimedWithLock(
 				StagedMetadatas: metadatas,
 			}}
 		return encoder.EncodeMessage(msg)
+	case metric.HistogramType:
+		msg := encoding.UnaggregatedMessageUnion{
+			Type: encoding.HistogramWithMetadatasType,
+			HistogramWithMetadatas: unaggregated.HistogramWithMetadatas{
+				Histogram:       metricUnion.Histogram(),
+				StagedMetadatas: metadatas,
+			}}
+		return encoder.EncodeMessage(msg)
 	default:
 	}
 
@@ -573,6 +573,38 @@ func TestWriterWriteUntimedGauge(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestWriterWriteUntimedHistogram(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	encoder := protobuf.NewMockUnaggregatedEncoder(ctrl)
+	gomock.InOrder(
+		encoder.EXPECT().Len().Return(3),
+		encoder.EXPECT().EncodeMessage(encoding.UnaggregatedMessageUnion{
+			Type: encoding.HistogramWithMetadatasType,
+			HistogramWithMetadatas: unaggregated.HistogramWithMetadatas{
+				Histogram:       testHistogram.Histogram(),
+				StagedMetadatas: testStagedMetadatas,
+			},
+		}).Return(nil),
+		encoder.EXPECT().Len().Return(7),
+	)
+	w := newInstanceWriter(testPlacementInstance, testOptions()).(*writer)
+	w.newLockedEncoderFn = func(protobuf.UnaggregatedOptions) *lockedEncoder {
+		return &lockedEncoder{UnaggregatedEncoder: encoder}
+	}
+
+	payload := payloadUnion{
+		payloadType: untimedType,
+		untimed: untimedPayload{
+			metric:    testHistogram,
+			metadatas: testStagedMetadatas,
+		},
+	}
+	_, err := w.Write(0, payload)
+	require.NoError(t, err)
+}
+
 func TestWriterWriteForwardedWithFlushingZeroSizeBefore(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
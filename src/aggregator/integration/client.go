@@ -58,6 +58,8 @@ func (c *client) writeUntimedMetricWithMetadatas(
 		return c.aggClient.WriteUntimedBatchTimer(mu.BatchTimer(), sm)
 	case metric.GaugeType:
 		return c.aggClient.WriteUntimedGauge(mu.Gauge(), sm)
+	case metric.HistogramType:
+		return c.aggClient.WriteUntimedHistogram(mu.Histogram(), sm)
 	default:
 		return fmt.Errorf("unrecognized metric type %v", mu.Type)
 	}
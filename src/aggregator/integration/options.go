@@ -278,7 +278,8 @@ func newTestServerOptions(t *testing.T) testServerOptions {
 	aggTypesOpts := aggregation.NewTypesOptions().
 		SetCounterTypeStringTransformFn(aggregation.EmptyTransform).
 		SetTimerTypeStringTransformFn(aggregation.SuffixTransform).
-		SetGaugeTypeStringTransformFn(aggregation.EmptyTransform)
+		SetGaugeTypeStringTransformFn(aggregation.EmptyTransform).
+		SetHistogramTypeStringTransformFn(aggregation.SuffixTransform)
 	connOpts := aggclient.NewConnectionOptions().SetWriteTimeout(time.Second)
 	return &serverOptions{
 		rawTCPAddr:                  defaultRawTCPAddr,
@@ -260,6 +260,12 @@ func newTestServerSetup(t *testing.T, opts testServerOptions) *testServerSetup {
 		return aggregator.MustNewGaugeElem(aggregator.ElemData{}, elemOpts)
 	})
 
+	histogramElemPool := aggregator.NewHistogramElemPool(nil)
+	aggregatorOpts = aggregatorOpts.SetHistogramElemPool(histogramElemPool)
+	histogramElemPool.Init(func() *aggregator.HistogramElem {
+		return aggregator.MustNewHistogramElem(aggregator.ElemData{}, elemOpts)
+	})
+
 	return &testServerSetup{
 		opts:             opts,
 		rawTCPAddr:       opts.RawTCPAddr(),
@@ -106,6 +106,13 @@ func (m *messageProcessor) handleMessage(
 		}
 		u := union.GaugeWithMetadatas.ToUnion()
 		return m.aggregator.AddUntimed(u, union.GaugeWithMetadatas.StagedMetadatas)
+	case metricpb.MetricWithMetadatas_HISTOGRAM_WITH_METADATAS:
+		err := union.HistogramWithMetadatas.FromProto(pb.HistogramWithMetadatas)
+		if err != nil {
+			return err
+		}
+		u := union.HistogramWithMetadatas.ToUnion()
+		return m.aggregator.AddUntimed(u, union.HistogramWithMetadatas.StagedMetadatas)
 	case metricpb.MetricWithMetadatas_FORWARDED_METRIC_WITH_METADATA:
 		err := union.ForwardedMetricWithMetadata.FromProto(pb.ForwardedMetricWithMetadata)
 		if err != nil {
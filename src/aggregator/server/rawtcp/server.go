@@ -169,6 +169,11 @@ func (s *handler) Handle(conn net.Conn) {
 			untimedMetric.Annotation = current.GaugeWithMetadatas.Annotation
 			stagedMetadatas = current.GaugeWithMetadatas.StagedMetadatas
 			err = s.aggregator.AddUntimed(untimedMetric, stagedMetadatas)
+		case encoding.HistogramWithMetadatasType:
+			untimedMetric = current.HistogramWithMetadatas.Histogram.ToUnion()
+			untimedMetric.Annotation = current.HistogramWithMetadatas.Annotation
+			stagedMetadatas = current.HistogramWithMetadatas.StagedMetadatas
+			err = s.aggregator.AddUntimed(untimedMetric, stagedMetadatas)
 		case encoding.ForwardedMetricWithMetadataType:
 			forwardedMetric = current.ForwardedMetricWithMetadata.ForwardedMetric
 			untimedMetric.Annotation = current.ForwardedMetricWithMetadata.Annotation
@@ -217,6 +222,8 @@ func (s *handler) Handle(conn net.Conn) {
 			case encoding.BatchTimerWithMetadatasType:
 				fallthrough
 			case encoding.GaugeWithMetadatasType:
+				fallthrough
+			case encoding.HistogramWithMetadatasType:
 				s.metrics.addUntimedErrors.Inc(1)
 				s.log.Error("error adding untimed metric",
 					zap.String("remoteAddress", remoteAddress),
@@ -32,6 +32,7 @@
 		KeyValueUpdateResult
 		QueryLimits
 		QueryLimit
+		TenantQueryLimits
 */
 package kvpb
 
@@ -117,10 +118,11 @@ func (m *KeyValueUpdateResult) GetNew() string {
 }
 
 type QueryLimits struct {
-	MaxRecentlyQueriedSeriesBlocks        *QueryLimit `protobuf:"bytes,1,opt,name=maxRecentlyQueriedSeriesBlocks" json:"maxRecentlyQueriedSeriesBlocks,omitempty"`
-	MaxRecentlyQueriedSeriesDiskBytesRead *QueryLimit `protobuf:"bytes,2,opt,name=maxRecentlyQueriedSeriesDiskBytesRead" json:"maxRecentlyQueriedSeriesDiskBytesRead,omitempty"`
-	MaxRecentlyQueriedSeriesDiskRead      *QueryLimit `protobuf:"bytes,3,opt,name=maxRecentlyQueriedSeriesDiskRead" json:"maxRecentlyQueriedSeriesDiskRead,omitempty"`
-	MaxRecentlyQueriedMetadataRead        *QueryLimit `protobuf:"bytes,4,opt,name=maxRecentlyQueriedMetadataRead" json:"maxRecentlyQueriedMetadataRead,omitempty"`
+	MaxRecentlyQueriedSeriesBlocks        *QueryLimit          `protobuf:"bytes,1,opt,name=maxRecentlyQueriedSeriesBlocks" json:"maxRecentlyQueriedSeriesBlocks,omitempty"`
+	MaxRecentlyQueriedSeriesDiskBytesRead *QueryLimit          `protobuf:"bytes,2,opt,name=maxRecentlyQueriedSeriesDiskBytesRead" json:"maxRecentlyQueriedSeriesDiskBytesRead,omitempty"`
+	MaxRecentlyQueriedSeriesDiskRead      *QueryLimit          `protobuf:"bytes,3,opt,name=maxRecentlyQueriedSeriesDiskRead" json:"maxRecentlyQueriedSeriesDiskRead,omitempty"`
+	MaxRecentlyQueriedMetadataRead        *QueryLimit          `protobuf:"bytes,4,opt,name=maxRecentlyQueriedMetadataRead" json:"maxRecentlyQueriedMetadataRead,omitempty"`
+	Tenants                               []*TenantQueryLimits `protobuf:"bytes,5,rep,name=tenants" json:"tenants,omitempty"`
 }
 
 func (m *QueryLimits) Reset()                    { *m = QueryLimits{} }
@@ -156,6 +158,13 @@ func (m *QueryLimits) GetMaxRecentlyQueriedMetadataRead() *QueryLimit {
 	return nil
 }
 
+func (m *QueryLimits) GetTenants() []*TenantQueryLimits {
+	if m != nil {
+		return m.Tenants
+	}
+	return nil
+}
+
 type QueryLimit struct {
 	Limit           int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	LookbackSeconds int64 `protobuf:"varint,2,opt,name=lookbackSeconds,proto3" json:"lookbackSeconds,omitempty"`
@@ -196,11 +205,60 @@ func (m *QueryLimit) GetForceWaited() bool {
 	return false
 }
 
+type TenantQueryLimits struct {
+	Source                                string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	MaxRecentlyQueriedSeriesBlocks        int64  `protobuf:"varint,2,opt,name=maxRecentlyQueriedSeriesBlocks,proto3" json:"maxRecentlyQueriedSeriesBlocks,omitempty"`
+	MaxRecentlyQueriedSeriesDiskBytesRead int64  `protobuf:"varint,3,opt,name=maxRecentlyQueriedSeriesDiskBytesRead,proto3" json:"maxRecentlyQueriedSeriesDiskBytesRead,omitempty"`
+	MaxRecentlyQueriedSeriesDiskRead      int64  `protobuf:"varint,4,opt,name=maxRecentlyQueriedSeriesDiskRead,proto3" json:"maxRecentlyQueriedSeriesDiskRead,omitempty"`
+	MaxRecentlyQueriedMetadataRead        int64  `protobuf:"varint,5,opt,name=maxRecentlyQueriedMetadataRead,proto3" json:"maxRecentlyQueriedMetadataRead,omitempty"`
+}
+
+func (m *TenantQueryLimits) Reset()                    { *m = TenantQueryLimits{} }
+func (m *TenantQueryLimits) String() string            { return proto.CompactTextString(m) }
+func (*TenantQueryLimits) ProtoMessage()               {}
+func (*TenantQueryLimits) Descriptor() ([]byte, []int) { return fileDescriptorKv, []int{4} }
+
+func (m *TenantQueryLimits) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *TenantQueryLimits) GetMaxRecentlyQueriedSeriesBlocks() int64 {
+	if m != nil {
+		return m.MaxRecentlyQueriedSeriesBlocks
+	}
+	return 0
+}
+
+func (m *TenantQueryLimits) GetMaxRecentlyQueriedSeriesDiskBytesRead() int64 {
+	if m != nil {
+		return m.MaxRecentlyQueriedSeriesDiskBytesRead
+	}
+	return 0
+}
+
+func (m *TenantQueryLimits) GetMaxRecentlyQueriedSeriesDiskRead() int64 {
+	if m != nil {
+		return m.MaxRecentlyQueriedSeriesDiskRead
+	}
+	return 0
+}
+
+func (m *TenantQueryLimits) GetMaxRecentlyQueriedMetadataRead() int64 {
+	if m != nil {
+		return m.MaxRecentlyQueriedMetadataRead
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*KeyValueUpdate)(nil), "kvpb.KeyValueUpdate")
 	proto.RegisterType((*KeyValueUpdateResult)(nil), "kvpb.KeyValueUpdateResult")
 	proto.RegisterType((*QueryLimits)(nil), "kvpb.QueryLimits")
 	proto.RegisterType((*QueryLimit)(nil), "kvpb.QueryLimit")
+	proto.RegisterType((*TenantQueryLimits)(nil), "kvpb.TenantQueryLimits")
 }
 func (m *KeyValueUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -333,6 +391,18 @@ func (m *QueryLimits) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n4
 	}
+	if len(m.Tenants) > 0 {
+		for _, msg := range m.Tenants {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintKv(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -384,6 +454,50 @@ func (m *QueryLimit) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TenantQueryLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TenantQueryLimits) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKv(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if m.MaxRecentlyQueriedSeriesBlocks != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintKv(dAtA, i, uint64(m.MaxRecentlyQueriedSeriesBlocks))
+	}
+	if m.MaxRecentlyQueriedSeriesDiskBytesRead != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintKv(dAtA, i, uint64(m.MaxRecentlyQueriedSeriesDiskBytesRead))
+	}
+	if m.MaxRecentlyQueriedSeriesDiskRead != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintKv(dAtA, i, uint64(m.MaxRecentlyQueriedSeriesDiskRead))
+	}
+	if m.MaxRecentlyQueriedMetadataRead != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintKv(dAtA, i, uint64(m.MaxRecentlyQueriedMetadataRead))
+	}
+	return i, nil
+}
+
 func encodeVarintKv(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -447,6 +561,12 @@ func (m *QueryLimits) Size() (n int) {
 		l = m.MaxRecentlyQueriedMetadataRead.Size()
 		n += 1 + l + sovKv(uint64(l))
 	}
+	if len(m.Tenants) > 0 {
+		for _, e := range m.Tenants {
+			l = e.Size()
+			n += 1 + l + sovKv(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -468,6 +588,28 @@ func (m *QueryLimit) Size() (n int) {
 	return n
 }
 
+func (m *TenantQueryLimits) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovKv(uint64(l))
+	}
+	if m.MaxRecentlyQueriedSeriesBlocks != 0 {
+		n += 1 + sovKv(uint64(m.MaxRecentlyQueriedSeriesBlocks))
+	}
+	if m.MaxRecentlyQueriedSeriesDiskBytesRead != 0 {
+		n += 1 + sovKv(uint64(m.MaxRecentlyQueriedSeriesDiskBytesRead))
+	}
+	if m.MaxRecentlyQueriedSeriesDiskRead != 0 {
+		n += 1 + sovKv(uint64(m.MaxRecentlyQueriedSeriesDiskRead))
+	}
+	if m.MaxRecentlyQueriedMetadataRead != 0 {
+		n += 1 + sovKv(uint64(m.MaxRecentlyQueriedMetadataRead))
+	}
+	return n
+}
+
 func sovKv(x uint64) (n int) {
 	for {
 		n++
@@ -907,6 +1049,37 @@ func (m *QueryLimits) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKv
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenants = append(m.Tenants, &TenantQueryLimits{})
+			if err := m.Tenants[len(m.Tenants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKv(dAtA[iNdEx:])
@@ -1056,6 +1229,161 @@ func (m *QueryLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TenantQueryLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKv
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TenantQueryLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TenantQueryLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKv
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecentlyQueriedSeriesBlocks", wireType)
+			}
+			m.MaxRecentlyQueriedSeriesBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecentlyQueriedSeriesBlocks |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecentlyQueriedSeriesDiskBytesRead", wireType)
+			}
+			m.MaxRecentlyQueriedSeriesDiskBytesRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecentlyQueriedSeriesDiskBytesRead |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecentlyQueriedSeriesDiskRead", wireType)
+			}
+			m.MaxRecentlyQueriedSeriesDiskRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecentlyQueriedSeriesDiskRead |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecentlyQueriedMetadataRead", wireType)
+			}
+			m.MaxRecentlyQueriedMetadataRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecentlyQueriedMetadataRead |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKv(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKv
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipKv(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1166,30 +1494,35 @@ func init() {
 }
 
 var fileDescriptorKv = []byte{
-	// 391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0xab, 0xd3, 0x40,
-	0x14, 0x35, 0xe6, 0xf9, 0x78, 0xef, 0x16, 0x35, 0x0c, 0x0f, 0xe9, 0x2a, 0x84, 0xa0, 0xd0, 0x55,
-	0x06, 0xec, 0xd6, 0x55, 0xd1, 0x95, 0x15, 0x74, 0x8a, 0x1f, 0x0b, 0x37, 0x93, 0x99, 0xdb, 0x1a,
-	0x32, 0xc9, 0x94, 0xcc, 0xa4, 0x36, 0xbf, 0xc0, 0xad, 0x0b, 0x7f, 0x94, 0x4b, 0x7f, 0x82, 0xd4,
-	0x3f, 0x22, 0x33, 0x29, 0xb4, 0x95, 0xd6, 0x76, 0x13, 0xee, 0x39, 0xb9, 0xe7, 0xdc, 0x3b, 0x87,
-	0x0b, 0x2f, 0x16, 0x85, 0xfd, 0xd2, 0xe6, 0x99, 0xd0, 0x15, 0xad, 0xc6, 0x32, 0xa7, 0xd5, 0x98,
-	0x9a, 0x46, 0x50, 0xa1, 0x5a, 0x63, 0xb1, 0xa1, 0x0b, 0xac, 0xb1, 0xe1, 0x16, 0x25, 0x5d, 0x36,
-	0xda, 0x6a, 0x5a, 0xae, 0x96, 0x39, 0x2d, 0x57, 0x99, 0x47, 0xe4, 0xca, 0xc1, 0xf4, 0x2d, 0x3c,
-	0x7a, 0x8d, 0xdd, 0x07, 0xae, 0x5a, 0x7c, 0xbf, 0x94, 0xdc, 0x22, 0x89, 0x20, 0x2c, 0xb1, 0x1b,
-	0x06, 0x49, 0x30, 0xba, 0x65, 0xae, 0x24, 0x77, 0xf0, 0x60, 0xe5, 0x1a, 0x86, 0xf7, 0x3d, 0xd7,
-	0x03, 0xf2, 0x04, 0xae, 0x85, 0xae, 0xaa, 0xc2, 0x0e, 0xc3, 0x24, 0x18, 0xdd, 0xb0, 0x2d, 0x4a,
-	0xa7, 0x70, 0x77, 0xe8, 0xc8, 0xd0, 0xb4, 0xca, 0x1e, 0xf1, 0x8d, 0x20, 0xd4, 0x4a, 0x6e, 0x5d,
-	0x5d, 0xe9, 0x98, 0x1a, 0xbf, 0x7a, 0xc3, 0x5b, 0xe6, 0xca, 0xf4, 0x5b, 0x08, 0x83, 0x77, 0x2d,
-	0x36, 0xdd, 0xb4, 0xa8, 0x0a, 0x6b, 0xc8, 0x27, 0x88, 0x2b, 0xbe, 0x66, 0x28, 0xb0, 0xb6, 0xaa,
-	0x73, 0x7f, 0x0a, 0x94, 0x33, 0xf7, 0x35, 0x13, 0xa5, 0x45, 0x69, 0xfc, 0x80, 0xc1, 0xf3, 0x28,
-	0x73, 0xcf, 0xcb, 0x76, 0x52, 0x76, 0x46, 0x47, 0xe6, 0xf0, 0xec, 0x54, 0xc7, 0xcb, 0xc2, 0x94,
-	0x93, 0xce, 0xa2, 0x61, 0xc8, 0xfb, 0x7d, 0x8f, 0x0d, 0xb8, 0x4c, 0x4e, 0x3e, 0x43, 0xf2, 0xbf,
-	0x46, 0x3f, 0x22, 0x3c, 0x31, 0xe2, 0xac, 0xf2, 0x78, 0x3e, 0x6f, 0xd0, 0x72, 0xc9, 0x2d, 0xf7,
-	0xde, 0x57, 0x97, 0xe7, 0xb3, 0xaf, 0x4b, 0x7f, 0x04, 0x00, 0xbb, 0x76, 0x77, 0x14, 0xca, 0x15,
-	0x3e, 0xef, 0x90, 0xf5, 0x80, 0x8c, 0xe0, 0xb1, 0xd2, 0xba, 0xcc, 0xb9, 0x28, 0x67, 0x28, 0x74,
-	0x2d, 0x8d, 0x8f, 0x2b, 0x64, 0xff, 0xd2, 0xe4, 0x29, 0x3c, 0x9c, 0xeb, 0x46, 0xe0, 0xab, 0xb5,
-	0x40, 0x94, 0x28, 0xb7, 0x57, 0x74, 0x48, 0x92, 0x04, 0x06, 0x9e, 0xf8, 0xc8, 0x0b, 0x8b, 0xfd,
-	0xee, 0x37, 0x6c, 0x9f, 0x9a, 0x44, 0x3f, 0x37, 0x71, 0xf0, 0x6b, 0x13, 0x07, 0xbf, 0x37, 0x71,
-	0xf0, 0xfd, 0x4f, 0x7c, 0x2f, 0xbf, 0xf6, 0xf7, 0x3d, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x1c,
-	0x39, 0x9e, 0xf7, 0x1f, 0x03, 0x00, 0x00,
+	// 472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xfe, 0xb9, 0x9b, 0xf4, 0xd7, 0x4e, 0x04, 0x84, 0x55, 0x05, 0x39, 0x59, 0x96, 0x05, 0x52,
+	0x4e, 0xb1, 0x68, 0xae, 0x9c, 0x22, 0xe8, 0x01, 0x8a, 0x04, 0xdb, 0xf2, 0xe7, 0xc0, 0x65, 0xbd,
+	0x3b, 0x2d, 0x96, 0xff, 0x6c, 0xe4, 0x5d, 0x87, 0xfa, 0x2d, 0x38, 0xf0, 0x34, 0x3c, 0x01, 0x47,
+	0x5e, 0x00, 0x09, 0x85, 0x17, 0x41, 0xbb, 0x36, 0x6a, 0x0a, 0x09, 0xf6, 0xc5, 0x9a, 0xef, 0xdb,
+	0x99, 0x6f, 0x76, 0x66, 0x3f, 0x19, 0x1e, 0x5f, 0x26, 0xe6, 0x43, 0x15, 0xcf, 0x84, 0xca, 0xa3,
+	0x7c, 0x2e, 0xe3, 0x28, 0x9f, 0x47, 0xba, 0x14, 0x91, 0xc8, 0x2a, 0x6d, 0xb0, 0x8c, 0x2e, 0xb1,
+	0xc0, 0x92, 0x1b, 0x94, 0xd1, 0xb2, 0x54, 0x46, 0x45, 0xe9, 0x6a, 0x19, 0x47, 0xe9, 0x6a, 0xe6,
+	0x10, 0x1d, 0x58, 0x18, 0xbe, 0x84, 0xdb, 0xcf, 0xb1, 0x7e, 0xc3, 0xb3, 0x0a, 0x5f, 0x2f, 0x25,
+	0x37, 0x48, 0xc7, 0x40, 0x52, 0xac, 0x27, 0x5e, 0xe0, 0x4d, 0x0f, 0x99, 0x0d, 0xe9, 0x11, 0x0c,
+	0x57, 0x36, 0x61, 0xb2, 0xe7, 0xb8, 0x06, 0xd0, 0x7b, 0xb0, 0x2f, 0x54, 0x9e, 0x27, 0x66, 0x42,
+	0x02, 0x6f, 0x7a, 0xc0, 0x5a, 0x14, 0x9e, 0xc2, 0xd1, 0x4d, 0x45, 0x86, 0xba, 0xca, 0xcc, 0x16,
+	0xdd, 0x31, 0x10, 0x95, 0xc9, 0x56, 0xd5, 0x86, 0x96, 0x29, 0xf0, 0xa3, 0x13, 0x3c, 0x64, 0x36,
+	0x0c, 0xbf, 0x10, 0x18, 0xbd, 0xaa, 0xb0, 0xac, 0x4f, 0x93, 0x3c, 0x31, 0x9a, 0xbe, 0x03, 0x3f,
+	0xe7, 0x57, 0x0c, 0x05, 0x16, 0x26, 0xab, 0xed, 0x49, 0x82, 0xf2, 0xcc, 0x7e, 0xf5, 0x22, 0x53,
+	0x22, 0xd5, 0xae, 0xc1, 0xe8, 0x78, 0x3c, 0xb3, 0xe3, 0xcd, 0xae, 0x4b, 0x59, 0x47, 0x1d, 0xbd,
+	0x80, 0x87, 0xbb, 0x32, 0x9e, 0x24, 0x3a, 0x5d, 0xd4, 0x06, 0x35, 0x43, 0xde, 0xdc, 0x77, 0x5b,
+	0x83, 0x7e, 0xe5, 0xf4, 0x3d, 0x04, 0xff, 0x4a, 0x74, 0x2d, 0xc8, 0x8e, 0x16, 0x9d, 0x95, 0xdb,
+	0xf7, 0xf3, 0x02, 0x0d, 0x97, 0xdc, 0x70, 0xa7, 0x3d, 0xe8, 0xbf, 0x9f, 0xcd, 0x3a, 0xfa, 0x08,
+	0xfe, 0x37, 0x58, 0xf0, 0xc2, 0xe8, 0xc9, 0x30, 0x20, 0xd3, 0xd1, 0xf1, 0xfd, 0x46, 0xe2, 0xdc,
+	0x91, 0x1b, 0x6f, 0xc4, 0x7e, 0xe7, 0x85, 0x9f, 0x3d, 0x80, 0xeb, 0x03, 0xeb, 0xa3, 0xcc, 0x06,
+	0xee, 0x89, 0x08, 0x6b, 0x00, 0x9d, 0xc2, 0x9d, 0x4c, 0xa9, 0x34, 0xe6, 0x22, 0x3d, 0x43, 0xa1,
+	0x0a, 0xa9, 0xdd, 0x86, 0x09, 0xfb, 0x93, 0xa6, 0x0f, 0xe0, 0xd6, 0x85, 0x2a, 0x05, 0x3e, 0xbd,
+	0x12, 0x88, 0x12, 0x65, 0x6b, 0xbc, 0x9b, 0x24, 0x0d, 0x60, 0xe4, 0x88, 0xb7, 0x3c, 0x31, 0xd8,
+	0x8c, 0x7b, 0xc0, 0x36, 0xa9, 0xf0, 0xfb, 0x1e, 0xdc, 0xfd, 0xeb, 0xd6, 0xd6, 0xcf, 0x5a, 0x55,
+	0xa5, 0xc0, 0xd6, 0xa2, 0x2d, 0xa2, 0x27, 0x9d, 0x8e, 0x6b, 0xae, 0xdb, 0xe5, 0xaf, 0xf3, 0xbe,
+	0xfe, 0x22, 0x4e, 0xae, 0xa7, 0x9b, 0x9e, 0xf5, 0x70, 0xd3, 0xc0, 0x09, 0x76, 0x7b, 0xe7, 0xa4,
+	0xd3, 0x3b, 0xc3, 0x5d, 0x93, 0x6e, 0x66, 0x2d, 0xc6, 0x5f, 0xd7, 0xbe, 0xf7, 0x6d, 0xed, 0x7b,
+	0x3f, 0xd6, 0xbe, 0xf7, 0xe9, 0xa7, 0xff, 0x5f, 0xbc, 0xef, 0x7e, 0x39, 0xf3, 0x5f, 0x03, 0x00,
+	0x74, 0xbf, 0x1c, 0x3b, 0xb2, 0x04, 0x00, 0x00,
 }
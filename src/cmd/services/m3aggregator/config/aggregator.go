@@ -32,6 +32,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/aggregator/aggregator"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler/writer"
@@ -91,9 +92,16 @@ type AggregatorConfiguration struct {
 	// Gauge metric prefix.
 	GaugePrefix *string `yaml:"gaugePrefix"`
 
+	// Histogram metric prefix.
+	HistogramPrefix *string `yaml:"histogramPrefix"`
+
 	// Stream configuration for computing quantiles.
 	Stream streamConfiguration `yaml:"stream"`
 
+	// Sketch configuration for computing quantiles of timers with DDSketch
+	// quantiles enabled.
+	Sketch sketchConfiguration `yaml:"sketch"`
+
 	// Client configuration.
 	Client aggclient.Configuration `yaml:"client"`
 
@@ -126,6 +134,10 @@ type AggregatorConfiguration struct {
 	// Flush times manager.
 	FlushTimesManager flushTimesManagerConfiguration `yaml:"flushTimesManager"`
 
+	// Checkpoint configuration, checkpointing of open aggregation windows
+	// is disabled if not set.
+	Checkpoint *checkpointConfiguration `yaml:"checkpoint"`
+
 	// Election manager.
 	ElectionManager electionManagerConfiguration `yaml:"electionManager"`
 
@@ -171,6 +183,9 @@ type AggregatorConfiguration struct {
 	// Pool of gauge elements.
 	GaugeElemPool pool.ObjectPoolConfiguration `yaml:"gaugeElemPool"`
 
+	// Pool of histogram elements.
+	HistogramElemPool pool.ObjectPoolConfiguration `yaml:"histogramElemPool"`
+
 	// Pool of entries.
 	EntryPool pool.ObjectPoolConfiguration `yaml:"entryPool"`
 
@@ -297,6 +312,7 @@ func (c *AggregatorConfiguration) NewAggregatorOptions(
 	opts = setMetricPrefix(opts, c.CounterPrefix, opts.SetCounterPrefix)
 	opts = setMetricPrefix(opts, c.TimerPrefix, opts.SetTimerPrefix)
 	opts = setMetricPrefix(opts, c.GaugePrefix, opts.SetGaugePrefix)
+	opts = setMetricPrefix(opts, c.HistogramPrefix, opts.SetHistogramPrefix)
 
 	// Set stream options.
 	scope := instrumentOpts.MetricsScope()
@@ -307,6 +323,13 @@ func (c *AggregatorConfiguration) NewAggregatorOptions(
 	}
 	opts = opts.SetStreamOptions(streamOpts)
 
+	// Set sketch options.
+	sketchOpts, err := c.Sketch.NewSketchOptions()
+	if err != nil {
+		return nil, err
+	}
+	opts = opts.SetSketchOptions(sketchOpts)
+
 	// Set administrative client.
 	// TODO(xichen): client retry threshold likely needs to be low for faster retries.
 	iOpts = instrumentOpts.SetMetricsScope(scope.SubScope("client"))
@@ -373,6 +396,19 @@ func (c *AggregatorConfiguration) NewAggregatorOptions(
 	}
 	opts = opts.SetFlushTimesManager(flushTimesManager)
 
+	// Set checkpoint manager.
+	if c.Checkpoint != nil {
+		iOpts = instrumentOpts.SetMetricsScope(scope.SubScope("checkpoint-manager"))
+		checkpointManager, err := c.Checkpoint.NewCheckpointManager(iOpts)
+		if err != nil {
+			return nil, err
+		}
+		opts = opts.SetCheckpointManager(checkpointManager)
+		if c.Checkpoint.Interval != 0 {
+			opts = opts.SetCheckpointInterval(c.Checkpoint.Interval)
+		}
+	}
+
 	// Set election manager.
 	iOpts = instrumentOpts.SetMetricsScope(scope.SubScope("election-manager"))
 	placementNamespace := c.PlacementManager.KVConfig.Namespace
@@ -489,6 +525,15 @@ func (c *AggregatorConfiguration) NewAggregatorOptions(
 		return aggregator.MustNewGaugeElem(aggregator.ElemData{}, elemOpts)
 	})
 
+	// Set histogram elem pool.
+	iOpts = instrumentOpts.SetMetricsScope(scope.SubScope("histogram-elem-pool"))
+	histogramElemPoolOpts := c.HistogramElemPool.NewObjectPoolOptions(iOpts)
+	histogramElemPool := aggregator.NewHistogramElemPool(histogramElemPoolOpts)
+	opts = opts.SetHistogramElemPool(histogramElemPool)
+	histogramElemPool.Init(func() *aggregator.HistogramElem {
+		return aggregator.MustNewHistogramElem(aggregator.ElemData{}, elemOpts)
+	})
+
 	// Set entry pool.
 	iOpts = instrumentOpts.SetMetricsScope(scope.SubScope("entry-pool"))
 	entryPoolOpts := c.EntryPool.NewObjectPoolOptions(iOpts)
@@ -592,6 +637,30 @@ func (c *streamConfiguration) NewStreamOptions(_ instrument.Options) (cm.Options
 	return opts, nil
 }
 
+// sketchConfiguration contains configuration for DDSketch quantile sketches.
+type sketchConfiguration struct {
+	// Relative accuracy guaranteed for quantile computation.
+	RelativeAccuracy float64 `yaml:"relativeAccuracy"`
+
+	// Maximum number of bins of a sketch, past which the lowest bins are collapsed.
+	MaxNumBins int `yaml:"maxNumBins"`
+}
+
+func (c *sketchConfiguration) NewSketchOptions() (ddsketch.Options, error) {
+	opts := ddsketch.NewOptions()
+	if c.RelativeAccuracy != 0 {
+		opts = opts.SetRelativeAccuracy(c.RelativeAccuracy)
+	}
+	if c.MaxNumBins != 0 {
+		opts = opts.SetMaxNumBins(c.MaxNumBins)
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
 type placementManagerConfiguration struct {
 	KVConfig kv.OverrideConfiguration       `yaml:"kvConfig"`
 	Watcher  placement.WatcherConfiguration `yaml:"placementWatcher"`
@@ -682,6 +751,24 @@ func (c flushTimesManagerConfiguration) NewFlushTimesManager(
 	return aggregator.NewFlushTimesManager(flushTimesManagerOpts), nil
 }
 
+type checkpointConfiguration struct {
+	// Directory to store checkpoints of open aggregation windows in.
+	Dir string `yaml:"dir" validate:"nonzero"`
+
+	// Interval between checkpoints, only a final checkpoint is taken
+	// on shutdown if negative.
+	Interval time.Duration `yaml:"interval"`
+}
+
+func (c checkpointConfiguration) NewCheckpointManager(
+	instrumentOpts instrument.Options,
+) (aggregator.CheckpointManager, error) {
+	opts := aggregator.NewCheckpointManagerOptions().
+		SetInstrumentOptions(instrumentOpts).
+		SetCheckpointDir(c.Dir)
+	return aggregator.NewCheckpointManager(opts)
+}
+
 type electionManagerConfiguration struct {
 	Election                   electionConfiguration  `yaml:"election"`
 	ServiceID                  serviceIDConfiguration `yaml:"serviceID"`
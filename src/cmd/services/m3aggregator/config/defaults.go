@@ -126,15 +126,17 @@ var (
 			Resolver: hostid.ConfigResolver,
 			Value:    &defaultHostID,
 		},
-		InstanceID:    InstanceIDConfiguration{HostIDInstanceIDType},
-		MetricPrefix:  &defaultEmptyPrefix,
-		CounterPrefix: &defaultEmptyPrefix,
-		TimerPrefix:   &defaultEmptyPrefix,
-		GaugePrefix:   &defaultEmptyPrefix,
+		InstanceID:      InstanceIDConfiguration{HostIDInstanceIDType},
+		MetricPrefix:    &defaultEmptyPrefix,
+		CounterPrefix:   &defaultEmptyPrefix,
+		TimerPrefix:     &defaultEmptyPrefix,
+		GaugePrefix:     &defaultEmptyPrefix,
+		HistogramPrefix: &defaultEmptyPrefix,
 		AggregationTypes: aggregation.TypesConfiguration{
-			CounterTransformFnType: &aggregation.EmptyTransformType,
-			TimerTransformFnType:   &aggregation.SuffixTransformType,
-			GaugeTransformFnType:   &aggregation.EmptyTransformType,
+			CounterTransformFnType:   &aggregation.EmptyTransformType,
+			TimerTransformFnType:     &aggregation.SuffixTransformType,
+			GaugeTransformFnType:     &aggregation.EmptyTransformType,
+			HistogramTransformFnType: &aggregation.SuffixTransformType,
 			AggregationTypesPool: pool.ObjectPoolConfiguration{
 				Size: 1024,
 			},
@@ -311,5 +313,6 @@ var (
 		CounterElemPool:            pool.ObjectPoolConfiguration{Size: 4096},
 		TimerElemPool:              pool.ObjectPoolConfiguration{Size: 4096},
 		GaugeElemPool:              pool.ObjectPoolConfiguration{Size: 4096},
+		HistogramElemPool:          pool.ObjectPoolConfiguration{Size: 4096},
 	}
 )
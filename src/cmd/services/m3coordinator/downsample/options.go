@@ -281,6 +281,9 @@ type Configuration struct {
 	// Pool of gauge elements.
 	GaugeElemPool pool.ObjectPoolConfiguration `yaml:"gaugeElemPool"`
 
+	// Pool of histogram elements.
+	HistogramElemPool pool.ObjectPoolConfiguration `yaml:"histogramElemPool"`
+
 	// BufferPastLimits specifies the buffer past limits.
 	BufferPastLimits []BufferPastLimitConfiguration `yaml:"bufferPastLimits"`
 
@@ -486,6 +489,11 @@ type RollupRuleConfiguration struct {
 	// Tags are the tags to be added to the metric while applying the rollup
 	// rule. Users are free to add name/value combinations to the metric.
 	Tags []Tag `yaml:"tags"`
+
+	// DDSketchEnabled computes the timer quantiles of the rollup rule with a
+	// DDSketch, which unlike the default quantile stream can be merged across
+	// aggregation stages without losing accuracy.
+	DDSketchEnabled bool `yaml:"ddsketchEnabled"`
 }
 
 // Rule returns the rollup rule for the rollup rule configuration.
@@ -586,6 +594,7 @@ func (r RollupRuleConfiguration) Rule() (view.RollupRule, error) {
 		{
 			Pipeline:        targetPipeline,
 			StoragePolicies: storagePolicies,
+			DDSketchEnabled: r.DDSketchEnabled,
 		},
 	}
 
@@ -923,6 +932,7 @@ func (cfg Configuration) newAggregator(o DownsamplerOptions) (agg, error) {
 		SetMetricPrefix(nil).
 		SetCounterPrefix(nil).
 		SetGaugePrefix(nil).
+		SetHistogramPrefix(nil).
 		SetTimerPrefix(nil).
 		SetPlacementManager(placementManager).
 		SetFlushTimesManager(flushTimesManager).
@@ -978,6 +988,16 @@ func (cfg Configuration) newAggregator(o DownsamplerOptions) (agg, error) {
 		return aggregator.MustNewGaugeElem(aggregator.ElemData{}, elemOpts)
 	})
 
+	// Set histogram elem pool.
+	histogramElemPoolOpts := cfg.HistogramElemPool.NewObjectPoolOptions(
+		instrumentOpts.SetMetricsScope(scope.SubScope("histogram-elem-pool")),
+	)
+	histogramElemPool := aggregator.NewHistogramElemPool(histogramElemPoolOpts)
+	aggregatorOpts = aggregatorOpts.SetHistogramElemPool(histogramElemPool)
+	histogramElemPool.Init(func() *aggregator.HistogramElem {
+		return aggregator.MustNewHistogramElem(aggregator.ElemData{}, elemOpts)
+	})
+
 	adminAggClient := newAggregatorLocalAdminClient()
 	aggregatorOpts = aggregatorOpts.SetAdminClient(adminAggClient)
 
@@ -1246,6 +1266,14 @@ func (c *aggregatorLocalAdminClient) WriteUntimedGauge(
 	return c.agg.AddUntimed(gauge.ToUnion(), metadatas)
 }
 
+// WriteUntimedHistogram writes untimed histogram metrics.
+func (c *aggregatorLocalAdminClient) WriteUntimedHistogram(
+	histogram unaggregated.Histogram,
+	metadatas metadata.StagedMetadatas,
+) error {
+	return c.agg.AddUntimed(histogram.ToUnion(), metadatas)
+}
+
 // WriteTimed writes timed metrics.
 func (c *aggregatorLocalAdminClient) WriteTimed(
 	metric aggregated.Metric,
@@ -65,6 +65,9 @@ var (
 	carbonSeparatorByte  = byte('.')
 	carbonSeparatorBytes = []byte{carbonSeparatorByte}
 
+	// The name tag is reserved by graphite to refer to the series path.
+	reservedGraphiteTagName = []byte("name")
+
 	errCannotGenerateTagsFromEmptyName = errors.New("cannot generate tags from empty name")
 	errIOptsMustBeSet                  = errors.New("carbon ingester options: instrument options must be st")
 	errWorkerPoolMustBeSet             = errors.New("carbon ingester options: worker pool must be set")
@@ -478,6 +481,16 @@ func newCarbonIngesterMetrics(scope tally.Scope) (carbonIngesterMetrics, error)
 //	__g0__:foo
 //	__g1__:bar
 //	__g2__:baz
+//
+// Graphite 1.1 style tags are also supported such that an input like:
+//
+//	foo.bar;dc=east
+//
+// becomes
+//
+//	__g0__:foo
+//	__g1__:bar
+//	dc:east
 func GenerateTagsFromName(
 	name []byte,
 	opts models.TagOptions,
@@ -504,7 +517,14 @@ func generateTagsFromName(
 		return models.EmptyTags(), errCannotGenerateTagsFromEmptyName
 	}
 
-	numTags := bytes.Count(name, carbonSeparatorBytes) + 1
+	fullName := name
+	name, carbonTags, err := carbon.ParseTaggedName(name, nil)
+	if err != nil {
+		return models.EmptyTags(),
+			fmt.Errorf("carbon metric: %s has invalid tags: %v", string(fullName), err)
+	}
+
+	numTags := bytes.Count(name, carbonSeparatorBytes) + 1 + len(carbonTags)
 
 	if cap(tags) >= numTags {
 		tags = tags[:0]
@@ -546,7 +566,24 @@ func generateTagsFromName(
 		})
 	}
 
-	return models.Tags{Opts: opts, Tags: tags}, nil
+	if len(carbonTags) == 0 {
+		return models.Tags{Opts: opts, Tags: tags}, nil
+	}
+
+	// Graphite 1.1 style tags are stored alongside the path tags, the
+	// graphite ID scheme sorts them after the path so that the ID of the
+	// series renders as path;tag=value.
+	for _, tag := range carbonTags {
+		if bytes.Equal(tag.Name, reservedGraphiteTagName) {
+			return models.EmptyTags(),
+				fmt.Errorf("carbon metric: %s uses reserved tag name: %s",
+					string(fullName), string(tag.Name))
+		}
+
+		tags = append(tags, models.Tag{Name: tag.Name, Value: tag.Value})
+	}
+
+	return models.Tags{Opts: opts, Tags: tags}.Normalize(), nil
 }
 
 // Compile all the carbon ingestion rules into matcher so that we can
@@ -601,6 +601,26 @@ func TestGenerateTagsFromName(t *testing.T) {
 				{Name: graphite.TagName(2), Value: []byte("baz")},
 			},
 		},
+		{
+			name: "foo.bar;host=a;dc=east",
+			id:   "foo.bar;dc=east;host=a",
+			expectedTags: []models.Tag{
+				{Name: graphite.TagName(0), Value: []byte("foo")},
+				{Name: graphite.TagName(1), Value: []byte("bar")},
+				{Name: []byte("dc"), Value: []byte("east")},
+				{Name: []byte("host"), Value: []byte("a")},
+			},
+		},
+		{
+			name:         "foo.bar;dc",
+			expectedErr:  fmt.Errorf("carbon metric: foo.bar;dc has invalid tags: invalid tag \"dc\": missing value"),
+			expectedTags: []models.Tag{},
+		},
+		{
+			name:         "foo.bar;name=baz",
+			expectedErr:  fmt.Errorf("carbon metric: foo.bar;name=baz uses reserved tag name: name"),
+			expectedTags: []models.Tag{},
+		},
 		{
 			name:         "foo..bar..baz..",
 			expectedErr:  fmt.Errorf("carbon metric: foo..bar..baz.. has duplicate separator"),
@@ -110,6 +110,23 @@ type WriteOptions struct {
 
 	DownsampleOverride bool
 	WriteOverride      bool
+
+	// TenantTag, if set, is added to the tags of every series written and
+	// replaces any existing value of the tag.
+	TenantTag *models.Tag
+}
+
+func (o WriteOptions) applyTenant(tags models.Tags) models.Tags {
+	if o.TenantTag == nil {
+		return tags
+	}
+
+	// NB: copy the tags since they may be owned by the caller and adding a
+	// tag sorts the tags in place.
+	result := tags
+	result.Tags = make([]models.Tag, 0, len(tags.Tags)+1)
+	result.Tags = append(result.Tags, tags.Tags...)
+	return result.AddOrUpdateTag(*o.TenantTag)
 }
 
 type downsamplerAndWriterMetrics struct {
@@ -187,6 +204,7 @@ func (d *downsamplerAndWriter) Write(
 		dropUnaggregated bool
 	)
 
+	tags = overrides.applyTenant(tags)
 	if d.shouldDownsample(overrides) {
 		var err error
 		dropUnaggregated, err = d.writeToDownsampler(tags, datapoints, annotation, overrides)
@@ -443,6 +461,7 @@ func (d *downsamplerAndWriter) WriteBatch(
 
 		for iter.Next() {
 			value := iter.Current()
+			value.Tags = overrides.applyTenant(value.Tags)
 			if value.Metadata.DropUnaggregated {
 				d.metrics.dropped.report(value.Attributes.Source)
 				continue
@@ -501,6 +520,7 @@ func (d *downsamplerAndWriter) writeAggregatedBatch(
 		appender.NextMetric()
 
 		value := iter.Current()
+		value.Tags = overrides.applyTenant(value.Tags)
 		if err := value.Tags.Validate(); err != nil {
 			multiErr = multiErr.Add(err)
 			continue
@@ -23,6 +23,7 @@ package ingest
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -495,6 +496,73 @@ func TestDownsampleAndWriteBatch(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDownsampleAndWriteBatchTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	downAndWrite, downsampler, session := newTestDownsamplerAndWriter(t, ctrl,
+		testDownsamplerAndWriterOptions{})
+
+	var (
+		mockSamplesAppender = downsample.NewMockSamplesAppender(ctrl)
+		mockMetricsAppender = downsample.NewMockMetricsAppender(ctrl)
+		tenantTag           = models.Tag{Name: []byte("tenant"), Value: []byte("foo")}
+		original            = testTags1.Clone()
+		tenants             []string
+		tenantsLock         sync.Mutex
+	)
+
+	mockMetricsAppender.
+		EXPECT().
+		SamplesAppender(zeroDownsamplerAppenderOpts).
+		Return(downsample.SamplesAppenderResult{SamplesAppender: mockSamplesAppender}, nil).Times(2)
+	for _, entry := range testEntries {
+		for _, tag := range entry.tags.Tags {
+			mockMetricsAppender.EXPECT().AddTag(tag.Name, tag.Value)
+		}
+		for _, dp := range entry.datapoints {
+			mockSamplesAppender.EXPECT().AppendGaugeSample(dp.Timestamp, dp.Value, entry.annotation)
+		}
+	}
+	mockMetricsAppender.EXPECT().AddTag(tenantTag.Name, tenantTag.Value).Times(2)
+	downsampler.EXPECT().NewMetricsAppender().Return(mockMetricsAppender, nil)
+
+	mockMetricsAppender.EXPECT().NextMetric().Times(2)
+	mockMetricsAppender.EXPECT().Finalize()
+
+	for _, entry := range testEntries {
+		for _, dp := range entry.datapoints {
+			session.EXPECT().WriteTagged(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), dp.Value, gomock.Any(), entry.annotation,
+			).Do(func(_, _ ident.ID, tags ident.TagIterator, _ xtime.UnixNano, _ float64, _ xtime.Unit, _ []byte) {
+				tags = tags.Duplicate()
+				defer tags.Close()
+				for tags.Next() {
+					tag := tags.Current()
+					if tag.Name.String() == string(tenantTag.Name) {
+						tenantsLock.Lock()
+						tenants = append(tenants, tag.Value.String())
+						tenantsLock.Unlock()
+					}
+				}
+				require.NoError(t, tags.Err())
+			})
+		}
+	}
+
+	iter := newTestIter(testEntries)
+	err := downAndWrite.WriteBatch(context.Background(), iter, WriteOptions{
+		TenantTag: &tenantTag,
+	})
+	require.NoError(t, err)
+
+	// Every written series should be tagged with the tenant exactly once.
+	require.Equal(t, []string{"foo", "foo", "foo", "foo", "foo", "foo"}, tenants)
+
+	// The tags of the written series should not be modified.
+	require.True(t, original.Equals(testTags1))
+}
+
 func TestDownsampleAndWriteBatchBadTags(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
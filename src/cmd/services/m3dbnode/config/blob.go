@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs/blob"
+	"github.com/m3db/m3/src/dbnode/persist/fs/blob/s3"
+)
+
+// BlobStoreBackend is a blob store backend.
+type BlobStoreBackend string
+
+const (
+	// BlobStoreBackendDirectory stores blobs in a directory.
+	BlobStoreBackendDirectory BlobStoreBackend = "directory"
+	// BlobStoreBackendS3 stores blobs in an S3 compatible store.
+	BlobStoreBackendS3 BlobStoreBackend = "s3"
+)
+
+var (
+	errBlobStoreDirectoryNotSet = errors.New("directory blob store backend requires a directory")
+	errBlobStoreS3NotSet        = errors.New("s3 blob store backend requires s3 configuration")
+)
+
+// BlobStoreConfiguration is the configuration of a blob store.
+type BlobStoreConfiguration struct {
+	// Backend is the blob store backend, either directory or s3.
+	Backend BlobStoreBackend `yaml:"backend"`
+
+	// Directory is the directory blobs are stored beneath when using the
+	// directory backend.
+	Directory string `yaml:"directory"`
+
+	// S3 is the configuration for the s3 backend.
+	S3 *s3.Configuration `yaml:"s3"`
+}
+
+// Validate validates the blob store configuration.
+func (c BlobStoreConfiguration) Validate() error {
+	switch c.Backend {
+	case BlobStoreBackendDirectory:
+		if c.Directory == "" {
+			return errBlobStoreDirectoryNotSet
+		}
+	case BlobStoreBackendS3:
+		if c.S3 == nil {
+			return errBlobStoreS3NotSet
+		}
+	default:
+		return fmt.Errorf("unknown blob store backend: %s", c.Backend)
+	}
+	return nil
+}
+
+// NewStore creates the blob store.
+func (c BlobStoreConfiguration) NewStore() (blob.Store, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	switch c.Backend {
+	case BlobStoreBackendS3:
+		return c.S3.NewStore()
+	default:
+		return blob.NewDirectoryStore(c.Directory)
+	}
+}
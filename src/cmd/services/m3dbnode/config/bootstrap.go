@@ -30,6 +30,8 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/backfill"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/backup"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/commitlog"
 	bfs "github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/fs"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/bootstrapper/peers"
@@ -151,6 +153,15 @@ type BootstrapConfiguration struct {
 	// Peers bootstrapper configuration.
 	Peers *BootstrapPeersConfiguration `yaml:"peers"`
 
+	// Backup bootstrapper configuration, if set the node restores the shards
+	// it owns from the backup before running the other bootstrappers.
+	Backup *BootstrapBackupConfiguration `yaml:"backup"`
+
+	// Backfill bootstrapper configuration, if set the node adopts the
+	// filesets written by the backfill tool before running the other
+	// bootstrappers.
+	Backfill *BootstrapBackfillConfiguration `yaml:"backfill"`
+
 	// CacheSeriesMetadata determines whether individual bootstrappers cache
 	// series metadata across all calls (namespaces / shards / blocks).
 	CacheSeriesMetadata *bool `yaml:"cacheSeriesMetadata"`
@@ -267,6 +278,28 @@ type BootstrapPeersConfiguration struct {
 	StreamPersistShardFlushConcurrency *int `yaml:"streamPersistShardFlushConcurrency"`
 }
 
+// BootstrapBackupConfiguration specifies config for the backup bootstrapper.
+type BootstrapBackupConfiguration struct {
+	// Name is the name of the backup to restore.
+	Name string `yaml:"name" validate:"nonzero"`
+
+	// Namespaces maps namespaces to the backed up namespace they are
+	// restored from, namespaces not listed are restored from the backed up
+	// namespace with the same name.
+	Namespaces map[string]string `yaml:"namespaces"`
+
+	// Store is the blob store the backup is read from.
+	Store BlobStoreConfiguration `yaml:"store"`
+}
+
+// BootstrapBackfillConfiguration specifies config for the backfill bootstrapper.
+type BootstrapBackfillConfiguration struct {
+	// Directory is the file path prefix the backfill tool wrote filesets
+	// beneath, it must be on the same filesystem as the node's file path
+	// prefix.
+	Directory string `yaml:"directory" validate:"nonzero"`
+}
+
 // New creates a bootstrap process based on the bootstrap configuration.
 func (bsc BootstrapConfiguration) New(
 	rsOpts result.Options,
@@ -379,6 +412,40 @@ func (bsc BootstrapConfiguration) New(
 			if err != nil {
 				return nil, err
 			}
+		case backup.BackupBootstrapperName:
+			bCfg := bsc.Backup
+			if bCfg == nil {
+				return nil, errors.New("backup bootstrapper requires backup configuration")
+			}
+			store, err := bCfg.Store.NewStore()
+			if err != nil {
+				return nil, err
+			}
+			bOpts := backup.NewOptions().
+				SetResultOptions(rsOpts).
+				SetInstrumentOptions(opts.InstrumentOptions()).
+				SetFilesystemOptions(fsOpts).
+				SetStore(store).
+				SetName(bCfg.Name).
+				SetSourceNamespaces(bCfg.Namespaces)
+			bs, err = backup.NewBackupBootstrapperProvider(bOpts, bs)
+			if err != nil {
+				return nil, err
+			}
+		case backfill.BackfillBootstrapperName:
+			bCfg := bsc.Backfill
+			if bCfg == nil {
+				return nil, errors.New("backfill bootstrapper requires backfill configuration")
+			}
+			bOpts := backfill.NewOptions().
+				SetResultOptions(rsOpts).
+				SetInstrumentOptions(opts.InstrumentOptions()).
+				SetFilesystemOptions(fsOpts).
+				SetDirectory(bCfg.Directory)
+			bs, err = backfill.NewBackfillBootstrapperProvider(bOpts, bs)
+			if err != nil {
+				return nil, err
+			}
 		case uninitialized.UninitializedTopologyBootstrapperName:
 			uOpts := uninitialized.NewOptions().
 				SetResultOptions(rsOpts).
@@ -423,6 +490,19 @@ func (bsc BootstrapConfiguration) peersConfig() BootstrapPeersConfiguration {
 }
 
 func (bsc BootstrapConfiguration) orderedBootstrappers() []string {
+	// The backup and backfill bootstrappers place filesets on disk for the
+	// bootstrappers that follow them to load, so they must come first.
+	var ordered []string
+	if bsc.Backup != nil {
+		ordered = append(ordered, backup.BackupBootstrapperName)
+	}
+	if bsc.Backfill != nil {
+		ordered = append(ordered, backfill.BackfillBootstrapperName)
+	}
+	return append(ordered, bsc.modeOrderedBootstrappers()...)
+}
+
+func (bsc BootstrapConfiguration) modeOrderedBootstrappers() []string {
 	if bsc.BootstrapMode != nil {
 		switch *bsc.BootstrapMode {
 		case DefaultBootstrapMode:
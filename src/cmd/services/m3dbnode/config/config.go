@@ -39,6 +39,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/discovery"
 	"github.com/m3db/m3/src/dbnode/environment"
+	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/x/config/hostid"
@@ -533,6 +534,20 @@ type CommitLogPolicy struct {
 	// works in most cases because the default size of the QueueChannel should be large
 	// enough for almost all workloads assuming a reasonable batch size is used.
 	QueueChannel *CommitLogQueuePolicy `yaml:"queueChannel"`
+
+	// The compression applied to commit log chunks, one of none, snappy or zstd.
+	// Commit logs written with any compression can be read regardless of this value.
+	Compression commitlog.CompressionType `yaml:"compression"`
+
+	// GroupCommit if set acknowledges writes only once they have been fsync'd,
+	// fsyncing the writes received within the max latency together.
+	GroupCommit *CommitLogGroupCommitPolicy `yaml:"groupCommit"`
+}
+
+// CommitLogGroupCommitPolicy is the commit log group commit policy.
+type CommitLogGroupCommitPolicy struct {
+	// The maximum amount of time a write waits to be fsync'd.
+	MaxLatency time.Duration `yaml:"maxLatency" validate:"nonzero"`
 }
 
 // CalculationType is a type of configuration parameter.
@@ -586,6 +601,14 @@ type RepairPolicy struct {
 	// If enabled, what percentage of metadata should perform a detailed debug
 	// shadow comparison.
 	DebugShadowComparisonsPercentage float64 `yaml:"debugShadowComparisonsPercentage"`
+
+	// MerkleTreeEnabled sets whether repairs exchange Merkle trees of the
+	// blocks metadata with peers and only fetch the metadata of the series
+	// in the leaves that differ.
+	MerkleTreeEnabled bool `yaml:"merkleTreeEnabled"`
+
+	// MerkleTreeDepth sets the depth of the Merkle trees if set.
+	MerkleTreeDepth int `yaml:"merkleTreeDepth"`
 }
 
 // ReplicationPolicy is the replication policy.
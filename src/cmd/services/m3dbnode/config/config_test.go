@@ -417,6 +417,8 @@ func TestConfiguration(t *testing.T) {
     shardsLeavingAndInitializingCountTowardsConsistency: null
     iterateEqualTimestampStrategy: null
     circuitBreakerConfig: null
+    readRepair: null
+    fetchTaggedStreamBatchSize: null
   gcPercentage: 100
   tick: null
   bootstrap:
@@ -427,6 +429,8 @@ func TestConfiguration(t *testing.T) {
     commitlog:
       returnUnfulfilledForCorruptCommitLogFiles: false
     peers: null
+    backup: null
+    backfill: null
     cacheSeriesMetadata: null
     indexSegmentConcurrency: null
     verify: null
@@ -455,6 +459,7 @@ func TestConfiguration(t *testing.T) {
     force_index_summaries_mmap_memory: true
     force_bloom_filter_mmap_memory: true
     bloomFilterFalsePositivePercent: null
+    tieredStorage: null
   commitlog:
     flushMaxBytes: 524288
     flushEvery: 1s
@@ -462,6 +467,8 @@ func TestConfiguration(t *testing.T) {
       calculationType: fixed
       size: 2097152
     queueChannel: null
+    compression: none
+    groupCommit: null
   repair:
     enabled: false
     type: default
@@ -472,6 +479,8 @@ func TestConfiguration(t *testing.T) {
     concurrency: 0
     debugShadowComparisonsEnabled: false
     debugShadowComparisonsPercentage: 0
+    merkleTreeEnabled: false
+    merkleTreeDepth: 0
   replication: null
   pooling:
     blockAllocSize: 16
@@ -761,6 +770,7 @@ func TestConfiguration(t *testing.T) {
     maxOutstandingRepairedBytes: 0
     maxEncodersPerBlock: 0
     writeNewSeriesPerSecond: 0
+    seriesLimits: []
   tchannel: null
   debug:
     mutexProfileFraction: 0
@@ -23,6 +23,9 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
 )
 
 const (
@@ -41,6 +44,8 @@ const (
 	defaultForceIndexSummariesMmapMemory   = false
 	defaultForceBloomFilterMmapMemory      = false
 	defaultBloomFilterFalsePositivePercent = 0.02
+	defaultTieredStorageOffloadAfter       = 24 * time.Hour
+	defaultTieredStorageCacheTTL           = time.Hour
 )
 
 // DefaultMmapConfiguration is the default mmap configuration.
@@ -98,6 +103,9 @@ type FilesystemConfiguration struct {
 	// BloomFilterFalsePositivePercent controls the target false positive percentage
 	// for the bloom filters for the fileset files.
 	BloomFilterFalsePositivePercent *float64 `yaml:"bloomFilterFalsePositivePercent"`
+
+	// TieredStorage configures offloading sealed filesets to a blob store.
+	TieredStorage *TieredStorageConfiguration `yaml:"tieredStorage"`
 }
 
 // Validate validates the Filesystem configuration. We use this method to validate
@@ -145,6 +153,12 @@ func (f FilesystemConfiguration) Validate() error {
 			*f.BloomFilterFalsePositivePercent)
 	}
 
+	if f.TieredStorage != nil {
+		if err := f.TieredStorage.Validate(); err != nil {
+			return fmt.Errorf("fs tieredStorage is invalid: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -257,6 +271,70 @@ func (f FilesystemConfiguration) BloomFilterFalsePositivePercentOrDefault() floa
 	return defaultBloomFilterFalsePositivePercent
 }
 
+// TieredStorageConfiguration is the tiered storage configuration, sealed data
+// and index files of data filesets are offloaded to a blob store once their
+// block is older than the offload age and fetched back on demand.
+type TieredStorageConfiguration struct {
+	// Enabled enables tiered storage.
+	Enabled bool `yaml:"enabled"`
+
+	// BlobStoreConfiguration is the blob store offloaded files are stored in.
+	BlobStoreConfiguration `yaml:",inline"`
+
+	// OffloadAfter is how long after a block ends its filesets are offloaded.
+	OffloadAfter *time.Duration `yaml:"offloadAfter"`
+
+	// CacheTTL is how long fetched or not yet evicted files are kept locally
+	// after they were last read.
+	CacheTTL *time.Duration `yaml:"cacheTTL"`
+}
+
+// Validate validates the tiered storage configuration.
+func (c TieredStorageConfiguration) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if err := c.BlobStoreConfiguration.Validate(); err != nil {
+		return err
+	}
+	if c.OffloadAfter != nil && *c.OffloadAfter <= 0 {
+		return fmt.Errorf("offloadAfter is set to: %v, but must be positive", *c.OffloadAfter)
+	}
+	if c.CacheTTL != nil && *c.CacheTTL < 0 {
+		return fmt.Errorf("cacheTTL is set to: %v, but must not be negative", *c.CacheTTL)
+	}
+	return nil
+}
+
+// NewOptions returns the tiered storage options, which are disabled if tiered
+// storage is not enabled.
+func (c TieredStorageConfiguration) NewOptions() (fs.TieredStorageOptions, error) {
+	if !c.Enabled {
+		return fs.TieredStorageOptions{}, nil
+	}
+	if err := c.Validate(); err != nil {
+		return fs.TieredStorageOptions{}, err
+	}
+
+	store, err := c.NewStore()
+	if err != nil {
+		return fs.TieredStorageOptions{}, err
+	}
+
+	opts := fs.TieredStorageOptions{
+		Store:        store,
+		OffloadAfter: defaultTieredStorageOffloadAfter,
+		CacheTTL:     defaultTieredStorageCacheTTL,
+	}
+	if c.OffloadAfter != nil {
+		opts.OffloadAfter = *c.OffloadAfter
+	}
+	if c.CacheTTL != nil {
+		opts.CacheTTL = *c.CacheTTL
+	}
+	return opts, nil
+}
+
 // MmapConfiguration is the mmap configuration.
 type MmapConfiguration struct {
 	// HugeTLB is the huge pages configuration which will only take affect
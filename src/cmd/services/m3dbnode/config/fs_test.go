@@ -23,6 +23,7 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -47,3 +48,30 @@ func TestFilesystemConfigurationParseNewDirectoryMode(t *testing.T) {
 
 	assert.Equal(t, os.FileMode(0775)|os.ModeDir, v)
 }
+
+func TestTieredStorageConfigurationNewOptions(t *testing.T) {
+	cfg := TieredStorageConfiguration{}
+	opts, err := cfg.NewOptions()
+	require.NoError(t, err)
+	assert.False(t, opts.Enabled())
+
+	cfg = TieredStorageConfiguration{
+		Enabled: true,
+		BlobStoreConfiguration: BlobStoreConfiguration{
+			Backend: BlobStoreBackendDirectory,
+		},
+	}
+	require.Error(t, cfg.Validate())
+
+	offloadAfter := 48 * time.Hour
+	cfg.Directory = t.TempDir()
+	cfg.OffloadAfter = &offloadAfter
+	opts, err = cfg.NewOptions()
+	require.NoError(t, err)
+	assert.True(t, opts.Enabled())
+	assert.Equal(t, offloadAfter, opts.OffloadAfter)
+	assert.Equal(t, defaultTieredStorageCacheTTL, opts.CacheTTL)
+
+	cfg.Backend = "unknown"
+	require.Error(t, cfg.Validate())
+}
@@ -20,7 +20,13 @@
 
 package config
 
-import "time"
+import (
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/storage/limits"
+)
+
+const defaultSeriesLimitsMetricNameTag = "__name__"
 
 // LimitsConfiguration contains configuration for configurable limits that can be applied to M3DB.
 type LimitsConfiguration struct {
@@ -72,6 +78,66 @@ type LimitsConfiguration struct {
 
 	// Write new series limit per second to limit overwhelming during new ID bursts.
 	WriteNewSeriesPerSecond int `yaml:"writeNewSeriesPerSecond" validate:"min=0"`
+
+	// SeriesLimits sets upper limits on the number of series of namespaces
+	// held in memory. Writes of new series which would exceed a limit are
+	// rejected.
+	SeriesLimits []NamespaceSeriesLimitsConfiguration `yaml:"seriesLimits"`
+}
+
+// NamespaceSeriesLimitsConfiguration sets upper limits on the number of series
+// of a namespace held in memory, a limit of zero disables the limit.
+type NamespaceSeriesLimitsConfiguration struct {
+	// Namespace is the namespace the limits apply to.
+	Namespace string `yaml:"namespace" validate:"nonzero"`
+
+	// MaxSeries is the maximum number of series of the namespace.
+	MaxSeries int64 `yaml:"maxSeries" validate:"min=0"`
+
+	// MetricNameTag is the name of the tag holding the metric name, it
+	// defaults to __name__.
+	MetricNameTag string `yaml:"metricNameTag"`
+
+	// MaxSeriesPerMetricName is the maximum number of series per metric name.
+	MaxSeriesPerMetricName int64 `yaml:"maxSeriesPerMetricName" validate:"min=0"`
+
+	// MetricNameLimits are limits for specific metric names which override
+	// MaxSeriesPerMetricName.
+	MetricNameLimits map[string]int64 `yaml:"metricNameLimits"`
+
+	// TenantTag is the name of the tag holding the tenant of a series, series
+	// are not limited per tenant if not set.
+	TenantTag string `yaml:"tenantTag"`
+
+	// MaxSeriesPerTenant is the maximum number of series per tenant.
+	MaxSeriesPerTenant int64 `yaml:"maxSeriesPerTenant" validate:"min=0"`
+
+	// TenantLimits are limits for specific tenants which override
+	// MaxSeriesPerTenant.
+	TenantLimits map[string]int64 `yaml:"tenantLimits"`
+}
+
+// SeriesLimitsOptions returns the series limits options of the namespace.
+func (c NamespaceSeriesLimitsConfiguration) SeriesLimitsOptions() limits.SeriesLimitsOptions {
+	metricNameTag := c.MetricNameTag
+	if metricNameTag == "" {
+		metricNameTag = defaultSeriesLimitsMetricNameTag
+	}
+
+	var tenantTag []byte
+	if c.TenantTag != "" {
+		tenantTag = []byte(c.TenantTag)
+	}
+
+	return limits.SeriesLimitsOptions{
+		MaxSeries:              c.MaxSeries,
+		MetricNameTag:          []byte(metricNameTag),
+		MaxSeriesPerMetricName: c.MaxSeriesPerMetricName,
+		MetricNameLimits:       c.MetricNameLimits,
+		TenantTag:              tenantTag,
+		MaxSeriesPerTenant:     c.MaxSeriesPerTenant,
+		TenantLimits:           c.TenantLimits,
+	}
 }
 
 // MaxRecentQueryResourceLimitConfiguration sets an upper limit on resources consumed by all queries
@@ -70,6 +70,11 @@ const (
 	defaultQueryTimeout = 30 * time.Second
 
 	defaultPrometheusMaxSamplesPerQuery = 100000000
+
+	// 1m is the default evaluation interval in Prometheus.
+	defaultRulesEvaluationInterval = time.Minute
+
+	defaultAlertmanagerTimeout = 10 * time.Second
 )
 
 var (
@@ -201,6 +206,18 @@ type Configuration struct {
 	// StoreMetricsType controls if metrics type is stored or not.
 	StoreMetricsType *bool `yaml:"storeMetricsType"`
 
+	// Exemplars is the exemplar storage configuration.
+	Exemplars ExemplarsConfiguration `yaml:"exemplars"`
+
+	// MetricMetadata is the metric metadata configuration.
+	MetricMetadata MetricMetadataConfiguration `yaml:"metricMetadata"`
+
+	// Tenancy is the multi-tenancy configuration.
+	Tenancy TenancyConfiguration `yaml:"tenancy"`
+
+	// Rules is the recording and alerting rule evaluation configuration.
+	Rules *RulesConfiguration `yaml:"rules"`
+
 	// MultiProcess is the multi-process configuration.
 	MultiProcess MultiProcessConfiguration `yaml:"multiProcess"`
 
@@ -277,6 +294,90 @@ type ResultOptions struct {
 	KeepNaNs bool `yaml:"keepNans"`
 }
 
+// ExemplarsConfiguration is the exemplar storage configuration.
+type ExemplarsConfiguration struct {
+	// Namespace is the M3DB namespace of the unaggregated cluster that
+	// exemplars are stored in. The namespace must exist in M3DB but should not
+	// be configured as a cluster namespace. Exemplars are dropped if not set.
+	Namespace string `yaml:"namespace"`
+}
+
+// MetricMetadataConfiguration is the metric metadata configuration.
+type MetricMetadataConfiguration struct {
+	// Enabled enables recording the metadata of metric families received via
+	// Prometheus remote write in KV, metadata is dropped if not enabled.
+	Enabled bool `yaml:"enabled"`
+	// KVKey is the KV key metadata is stored under.
+	KVKey string `yaml:"kvKey"`
+}
+
+// TenancyConfiguration is the multi-tenancy configuration.
+type TenancyConfiguration struct {
+	// Enabled requires every read and write request to specify a tenant
+	// with the M3-Tenant header, writes are tagged with the tenant and reads
+	// are restricted to the series of the tenant.
+	Enabled bool `yaml:"enabled"`
+	// TagName is the name of the tag the tenant is stored in.
+	TagName string `yaml:"tagName"`
+}
+
+// TenancyOptions returns the tenancy configuration as handler options.
+func (c TenancyConfiguration) TenancyOptions() handleroptions.TenancyOptions {
+	opts := handleroptions.TenancyOptions{
+		Enabled: c.Enabled,
+		TagName: []byte(handleroptions.DefaultTenantTagName),
+	}
+	if c.TagName != "" {
+		opts.TagName = []byte(c.TagName)
+	}
+	return opts
+}
+
+// RulesConfiguration is the recording and alerting rule evaluation
+// configuration.
+type RulesConfiguration struct {
+	// Files is the list of Prometheus rule group files to load, file names may
+	// contain glob patterns.
+	Files []string `yaml:"files"`
+	// EvaluationInterval is the default interval at which rule groups are
+	// evaluated, rule groups can override it with their own interval.
+	EvaluationInterval *time.Duration `yaml:"evaluationInterval"`
+	// ExternalLabels are added to alerts sent to Alertmanager.
+	ExternalLabels map[string]string `yaml:"externalLabels"`
+	// ExternalURL is the URL used for links to the coordinator, such as the
+	// generator URL of alerts.
+	ExternalURL string `yaml:"externalURL"`
+	// Alertmanager is the Alertmanager configuration, alerts are not sent if
+	// not set.
+	Alertmanager *AlertmanagerConfiguration `yaml:"alertmanager"`
+}
+
+// EvaluationIntervalOrDefault returns the configured evaluation interval or
+// default value.
+func (c RulesConfiguration) EvaluationIntervalOrDefault() time.Duration {
+	if v := c.EvaluationInterval; v != nil {
+		return *v
+	}
+	return defaultRulesEvaluationInterval
+}
+
+// AlertmanagerConfiguration is the Alertmanager configuration.
+type AlertmanagerConfiguration struct {
+	// URLs are the base URLs of the Alertmanager instances alerts are sent to,
+	// every alert is sent to every instance.
+	URLs []string `yaml:"urls" validate:"nonzero"`
+	// Timeout is the timeout for sending alerts to an instance.
+	Timeout *time.Duration `yaml:"timeout"`
+}
+
+// TimeoutOrDefault returns the configured timeout or default value.
+func (c AlertmanagerConfiguration) TimeoutOrDefault() time.Duration {
+	if v := c.Timeout; v != nil {
+		return *v
+	}
+	return defaultAlertmanagerTimeout
+}
+
 // QueryConfiguration is the query configuration.
 type QueryConfiguration struct {
 	// Timeout is the query timeout.
@@ -296,6 +397,17 @@ type QueryConfiguration struct {
 	// RequireSeriesEndpointStartEndTime requires requests to /series endpoint
 	// to specify a start and end time to prevent unbounded queries.
 	RequireSeriesEndpointStartEndTime bool `yaml:"requireSeriesEndpointStartEndTime"`
+	// Streaming is configuration for streaming fetched series from the
+	// database nodes.
+	Streaming StreamingQueryConfiguration `yaml:"streaming"`
+}
+
+// StreamingQueryConfiguration is configuration for streaming fetched series
+// from the database nodes in batches rather than in a single response.
+type StreamingQueryConfiguration struct {
+	// Enabled streams fetched series from the database nodes, consuming each
+	// batch as it arrives and stopping early once the series limit is hit.
+	Enabled bool `yaml:"enabled"`
 }
 
 // TimeoutOrDefault returns the configured timeout or default value.
@@ -0,0 +1,234 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/tsdb"
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/backfill"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var (
+	optInput          = flag.String("input", "", "Input file, or Prometheus data directory")
+	optFormat         = flag.String("format", "prometheus", "Input format, one of prometheus, csv or json")
+	optPathPrefix     = flag.String("path-prefix", "/var/lib/m3db-backfill", "Path prefix filesets are written beneath")
+	optNamespace      = flag.String("namespace", "metrics", "Namespace")
+	optNumShards      = flag.Int("num-shards", 0, "Number of shards of the cluster")
+	optShards         = flag.String("shards", "", "Comma separated shards to write, all shards if empty")
+	optBlockSize      = flag.Duration("block-size", 2*time.Hour, "Namespace block size")
+	optIndexBlockSize = flag.Duration("index-block-size", 0, "Namespace index block size, defaults to the block size")
+	optIndex          = flag.Bool("index", true, "Write index filesets")
+	optStart          = flag.Int64("start", 0, "Only write datapoints at or after this time [in sec]")
+	optEnd            = flag.Int64("end", 0, "Only write datapoints before this time [in sec]")
+)
+
+func main() {
+	flag.Parse()
+	if *optInput == "" ||
+		*optPathPrefix == "" ||
+		*optNamespace == "" ||
+		*optNumShards <= 0 ||
+		*optBlockSize <= 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	rawLogger, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatalf("unable to create logger: %+v", err)
+	}
+	logger := rawLogger.Sugar()
+
+	shards, err := parseShards(*optShards)
+	if err != nil {
+		logger.Fatalf("invalid shards: %v", err)
+	}
+
+	indexBlockSize := *optIndexBlockSize
+	if indexBlockSize == 0 {
+		indexBlockSize = *optBlockSize
+	}
+	md, err := namespace.NewMetadata(ident.StringID(*optNamespace), namespace.NewOptions().
+		SetRetentionOptions(retention.NewOptions().SetBlockSize(*optBlockSize)).
+		SetIndexOptions(namespace.NewIndexOptions().
+			SetEnabled(*optIndex).
+			SetBlockSize(indexBlockSize)))
+	if err != nil {
+		logger.Fatalf("invalid namespace: %v", err)
+	}
+
+	w, err := backfill.NewWriter(backfill.Options{
+		Namespace:         md,
+		NumShards:         *optNumShards,
+		Shards:            shards,
+		FilesystemOptions: fs.NewOptions().SetFilePathPrefix(*optPathPrefix),
+	})
+	if err != nil {
+		logger.Fatalf("unable to create writer: %v", err)
+	}
+
+	var (
+		start   = xtime.FromSeconds(*optStart)
+		end     = xtime.UnixNano(math.MaxInt64)
+		tagOpts = models.NewTagOptions().SetIDSchemeType(models.TypeQuoted)
+		read    int
+		dropped int
+	)
+	if *optEnd > 0 {
+		end = xtime.FromSeconds(*optEnd)
+	}
+	write := func(s backfill.Sample) error {
+		read++
+		if s.Timestamp.Before(start) || !s.Timestamp.Before(end) {
+			dropped++
+			return nil
+		}
+		id, tags := seriesIDAndTags(s.Labels, tagOpts)
+		if !w.Write(id, tags, ts.Datapoint{TimestampNanos: s.Timestamp, Value: s.Value}) {
+			dropped++
+		}
+		return nil
+	}
+
+	switch *optFormat {
+	case "prometheus":
+		err = readPrometheus(*optInput, start, end, write)
+	case "csv", "json":
+		err = readFile(*optInput, *optFormat, write)
+	default:
+		err = fmt.Errorf("unknown format: %s", *optFormat)
+	}
+	if err != nil {
+		logger.Fatalf("unable to read input: %v", err)
+	}
+	logger.Infof("read %d samples, dropped %d outside of time range or shards", read, dropped)
+
+	result, err := w.Flush()
+	if err != nil {
+		logger.Fatalf("unable to write filesets: %v", err)
+	}
+	logger.Infof("successfully wrote backfill filesets: %+v", result)
+}
+
+func parseShards(value string) ([]uint32, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var shards []uint32
+	for _, s := range strings.Split(value, ",") {
+		shard, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		shards = append(shards, uint32(shard))
+	}
+	return shards, nil
+}
+
+// seriesIDAndTags returns the ID and tags of the series with the given
+// labels, the ID is generated the same way as the coordinator generates it.
+func seriesIDAndTags(
+	lbls []backfill.Label,
+	tagOpts models.TagOptions,
+) (ident.ID, ident.Tags) {
+	var (
+		modelTags = models.NewTags(len(lbls), tagOpts)
+		tags      = make([]ident.Tag, 0, len(lbls))
+	)
+	for _, l := range lbls {
+		modelTags = modelTags.AddTag(models.Tag{Name: []byte(l.Name), Value: []byte(l.Value)})
+		tags = append(tags, ident.StringTag(l.Name, l.Value))
+	}
+	return ident.BytesID(modelTags.ID()), ident.NewTags(tags...)
+}
+
+func readFile(path, format string, fn backfill.SampleFn) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close() // nolint: errcheck
+
+	if format == "csv" {
+		return backfill.ReadCSV(f, fn)
+	}
+	return backfill.ReadJSON(f, fn)
+}
+
+func readPrometheus(dir string, start, end xtime.UnixNano, fn backfill.SampleFn) error {
+	db, err := tsdb.OpenDBReadOnly(dir, nil)
+	if err != nil {
+		return err
+	}
+	defer db.Close() // nolint: errcheck
+
+	q, err := db.Querier(context.Background(),
+		start.ToNormalizedTime(time.Millisecond),
+		end.ToNormalizedTime(time.Millisecond))
+	if err != nil {
+		return err
+	}
+	defer q.Close() // nolint: errcheck
+
+	set := q.Select(false, nil, labels.MustNewMatcher(labels.MatchRegexp, labels.MetricName, ".+"))
+	for set.Next() {
+		series := set.At()
+		lbls := make([]backfill.Label, 0, len(series.Labels()))
+		for _, l := range series.Labels() {
+			lbls = append(lbls, backfill.Label{Name: l.Name, Value: l.Value})
+		}
+
+		iter := series.Iterator()
+		for iter.Next() {
+			t, v := iter.At()
+			if err := fn(backfill.Sample{
+				Labels:    lbls,
+				Timestamp: xtime.UnixNano(t).FromNormalizedTime(time.Millisecond),
+				Value:     v,
+			}); err != nil {
+				return err
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return err
+		}
+	}
+	return set.Err()
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/backup"
+	"github.com/m3db/m3/src/dbnode/persist/fs/blob"
+	"github.com/m3db/m3/src/dbnode/persist/fs/blob/s3"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+var (
+	optMode              = flag.String("mode", "backup", "Mode, either backup or restore")
+	optPathPrefix        = flag.String("path-prefix", "/var/lib/m3db", "Path prefix")
+	optNamespace         = flag.String("namespace", "metrics", "Namespace to back up or restore into")
+	optName              = flag.String("name", "", "Backup name")
+	optNodeID            = flag.String("node-id", "", "Node ID, defaults to the hostname when backing up")
+	optSourceNamespace   = flag.String("source-namespace", "", "Backed up namespace to restore from, defaults to the namespace")
+	optIncludeCommitLogs = flag.Bool("include-commitlogs", true, "Restore the snapshot and commit logs, the node must be stopped")
+	optDestDir           = flag.String("dest-dir", "", "Directory to back up to or restore from")
+	optS3Bucket          = flag.String("s3-bucket", "", "S3 bucket to back up to or restore from")
+	optS3Prefix          = flag.String("s3-prefix", "", "S3 key prefix")
+	optS3Region          = flag.String("s3-region", "", "S3 region")
+	optS3Endpoint        = flag.String("s3-endpoint", "", "S3 endpoint for S3 compatible stores")
+)
+
+func main() {
+	flag.Parse()
+	if *optPathPrefix == "" ||
+		*optNamespace == "" ||
+		*optName == "" ||
+		(*optDestDir == "") == (*optS3Bucket == "") ||
+		(*optMode != "backup" && *optMode != "restore") {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	rawLogger, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatalf("unable to create logger: %+v", err)
+	}
+	logger := rawLogger.Sugar()
+
+	var store blob.Store
+	if *optDestDir != "" {
+		store, err = blob.NewDirectoryStore(*optDestDir)
+	} else {
+		store, err = s3.Configuration{
+			Bucket:         *optS3Bucket,
+			Prefix:         *optS3Prefix,
+			Region:         *optS3Region,
+			Endpoint:       *optS3Endpoint,
+			ForcePathStyle: *optS3Endpoint != "",
+		}.NewStore()
+	}
+	if err != nil {
+		logger.Fatalf("unable to create blob store: %v", err)
+	}
+
+	fsOpts := fs.NewOptions().SetFilePathPrefix(*optPathPrefix)
+	if *optMode == "restore" {
+		result, err := backup.Restore(backup.RestoreOptions{
+			Name:              *optName,
+			NodeID:            *optNodeID,
+			SourceNamespace:   *optSourceNamespace,
+			Namespace:         ident.StringID(*optNamespace),
+			IncludeCommitLogs: *optIncludeCommitLogs,
+			Store:             store,
+			FilesystemOptions: fsOpts,
+		})
+		if err != nil {
+			logger.Fatalf("unable to restore: %v", err)
+		}
+		logger.Infof("successfully restored backup: %+v", result)
+		return
+	}
+
+	nodeID := *optNodeID
+	if nodeID == "" {
+		nodeID, err = os.Hostname()
+		if err != nil {
+			logger.Fatalf("unable to determine hostname: %v", err)
+		}
+	}
+	manifest, err := backup.Backup(backup.Options{
+		Name:              *optName,
+		NodeID:            nodeID,
+		Namespace:         ident.StringID(*optNamespace),
+		Store:             store,
+		FilesystemOptions: fsOpts,
+	})
+	if err != nil {
+		logger.Fatalf("unable to back up: %v", err)
+	}
+	logger.Infof("successfully backed up %d files of %d shards",
+		len(manifest.Files), len(manifest.Shards))
+}
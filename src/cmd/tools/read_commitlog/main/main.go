@@ -92,6 +92,9 @@ func printMetrics(reader *filteringReader) error {
 		series := entry.Series
 		fmt.Printf("{id: %s, dp: %+v, ns: %s, shard: %d", // nolint: forbidigo
 			series.ID, entry.Datapoint, entry.Series.Namespace, entry.Series.Shard)
+		if !entry.Tombstone.IsEmpty() {
+			fmt.Printf(", tombstone: %s", entry.Tombstone) // nolint: forbidigo
+		}
 		if len(entry.Annotation) > 0 {
 			fmt.Printf(", annotation: %s", // nolint: forbidigo
 				base64.StdEncoding.EncodeToString(entry.Annotation))
@@ -128,6 +131,9 @@ func printSummary(reader *filteringReader, top *int) error {
 		if !found {
 			break
 		}
+		if !entry.Tombstone.IsEmpty() {
+			continue
+		}
 		dp := entry.Datapoint
 
 		if earliestDatapoint == 0 || earliestDatapoint > dp.TimestampNanos {
@@ -135,6 +135,10 @@ func (c *client) AggregateTiles(
 	return c.next.AggregateTiles(ctx, req)
 }
 
+func (c *client) AggregateTilesStatus(ctx thrift.Context) (*rpc.AggregateTilesStatusResult_, error) {
+	return c.next.AggregateTilesStatus(ctx)
+}
+
 func (c *client) Bootstrapped(ctx thrift.Context) (*rpc.NodeBootstrappedResult_, error) {
 	return c.next.Bootstrapped(ctx)
 }
@@ -145,6 +149,20 @@ func (c *client) BootstrappedInPlacementOrNoPlacement(
 	return c.next.BootstrappedInPlacementOrNoPlacement(ctx)
 }
 
+func (c *client) CardinalityRaw(
+	ctx thrift.Context,
+	req *rpc.CardinalityRawRequest,
+) (*rpc.CardinalityRawResult_, error) {
+	return c.next.CardinalityRaw(ctx, req)
+}
+
+func (c *client) DeleteTagged(
+	ctx thrift.Context,
+	req *rpc.DeleteTaggedRequest,
+) (*rpc.DeleteTaggedResult_, error) {
+	return c.next.DeleteTagged(ctx, req)
+}
+
 func (c *client) DebugIndexMemorySegments(
 	ctx thrift.Context,
 	req *rpc.DebugIndexMemorySegmentsRequest,
@@ -181,6 +199,13 @@ func (c *client) FetchBatchRawV2(
 	return c.next.FetchBatchRawV2(ctx, req)
 }
 
+func (c *client) FetchBlocksMerkleTreeRaw(
+	ctx thrift.Context,
+	req *rpc.FetchBlocksMerkleTreeRawRequest,
+) (*rpc.FetchBlocksMerkleTreeRawResult_, error) {
+	return c.next.FetchBlocksMerkleTreeRaw(ctx, req)
+}
+
 func (c *client) FetchBlocksMetadataRawV2(
 	ctx thrift.Context,
 	req *rpc.FetchBlocksMetadataRawV2Request,
@@ -199,6 +224,13 @@ func (c *client) FetchTagged(ctx thrift.Context, req *rpc.FetchTaggedRequest) (*
 	return c.next.FetchTagged(ctx, req)
 }
 
+func (c *client) FetchTaggedStream(
+	ctx thrift.Context,
+	req *rpc.FetchTaggedStreamRequest,
+) (*rpc.FetchTaggedStreamResult_, error) {
+	return c.next.FetchTaggedStream(ctx, req)
+}
+
 func (c *client) GetPersistRateLimit(ctx thrift.Context) (*rpc.NodePersistRateLimitResult_, error) {
 	return c.next.GetPersistRateLimit(ctx)
 }
@@ -167,6 +167,22 @@ func (mr *MockSessionMockRecorder) Aggregate(ctx, namespace, q, opts interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockSession)(nil).Aggregate), ctx, namespace, q, opts)
 }
 
+// Cardinality mocks base method.
+func (m *MockSession) Cardinality(ctx context.Context, namespace ident.ID, q index.Query, opts index.CardinalityOptions) (index.CardinalityResults, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(index.CardinalityResults)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Cardinality indicates an expected call of Cardinality.
+func (mr *MockSessionMockRecorder) Cardinality(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*MockSession)(nil).Cardinality), ctx, namespace, q, opts)
+}
+
 // Close mocks base method.
 func (m *MockSession) Close() error {
 	m.ctrl.T.Helper()
@@ -243,6 +259,21 @@ func (mr *MockSessionMockRecorder) FetchTaggedIDs(ctx, namespace, q, opts interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDs", reflect.TypeOf((*MockSession)(nil).FetchTaggedIDs), ctx, namespace, q, opts)
 }
 
+// FetchTaggedStream mocks base method.
+func (m *MockSession) FetchTaggedStream(ctx context.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (SeriesIteratorsStream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedStream", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(SeriesIteratorsStream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTaggedStream indicates an expected call of FetchTaggedStream.
+func (mr *MockSessionMockRecorder) FetchTaggedStream(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedStream", reflect.TypeOf((*MockSession)(nil).FetchTaggedStream), ctx, namespace, q, opts)
+}
+
 // IteratorPools mocks base method.
 func (m *MockSession) IteratorPools() (encoding.IteratorPools, error) {
 	m.ctrl.T.Helper()
@@ -331,6 +362,84 @@ func (mr *MockSessionMockRecorder) WriteTagged(namespace, id, tags, t, value, un
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTagged", reflect.TypeOf((*MockSession)(nil).WriteTagged), namespace, id, tags, t, value, unit, annotation)
 }
 
+// MockSeriesIteratorsStream is a mock of SeriesIteratorsStream interface.
+type MockSeriesIteratorsStream struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesIteratorsStreamMockRecorder
+}
+
+// MockSeriesIteratorsStreamMockRecorder is the mock recorder for MockSeriesIteratorsStream.
+type MockSeriesIteratorsStreamMockRecorder struct {
+	mock *MockSeriesIteratorsStream
+}
+
+// NewMockSeriesIteratorsStream creates a new mock instance.
+func NewMockSeriesIteratorsStream(ctrl *gomock.Controller) *MockSeriesIteratorsStream {
+	mock := &MockSeriesIteratorsStream{ctrl: ctrl}
+	mock.recorder = &MockSeriesIteratorsStreamMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeriesIteratorsStream) EXPECT() *MockSeriesIteratorsStreamMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSeriesIteratorsStream) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSeriesIteratorsStreamMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSeriesIteratorsStream)(nil).Close))
+}
+
+// Current mocks base method.
+func (m *MockSeriesIteratorsStream) Current() (encoding.SeriesIterators, FetchResponseMetadata) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current")
+	ret0, _ := ret[0].(encoding.SeriesIterators)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	return ret0, ret1
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockSeriesIteratorsStreamMockRecorder) Current() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockSeriesIteratorsStream)(nil).Current))
+}
+
+// Err mocks base method.
+func (m *MockSeriesIteratorsStream) Err() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Err")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Err indicates an expected call of Err.
+func (mr *MockSeriesIteratorsStreamMockRecorder) Err() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Err", reflect.TypeOf((*MockSeriesIteratorsStream)(nil).Err))
+}
+
+// Next mocks base method.
+func (m *MockSeriesIteratorsStream) Next() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Next indicates an expected call of Next.
+func (mr *MockSeriesIteratorsStreamMockRecorder) Next() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockSeriesIteratorsStream)(nil).Next))
+}
+
 // MockAggregatedTagsIterator is a mock of AggregatedTagsIterator interface.
 type MockAggregatedTagsIterator struct {
 	ctrl     *gomock.Controller
@@ -830,6 +939,22 @@ func (mr *MockAdminSessionMockRecorder) BorrowConnections(shardID, fn, opts inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BorrowConnections", reflect.TypeOf((*MockAdminSession)(nil).BorrowConnections), shardID, fn, opts)
 }
 
+// Cardinality mocks base method.
+func (m *MockAdminSession) Cardinality(ctx context.Context, namespace ident.ID, q index.Query, opts index.CardinalityOptions) (index.CardinalityResults, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(index.CardinalityResults)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Cardinality indicates an expected call of Cardinality.
+func (mr *MockAdminSessionMockRecorder) Cardinality(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*MockAdminSession)(nil).Cardinality), ctx, namespace, q, opts)
+}
+
 // Close mocks base method.
 func (m *MockAdminSession) Close() error {
 	m.ctrl.T.Helper()
@@ -860,6 +985,21 @@ func (mr *MockAdminSessionMockRecorder) DedicatedConnection(shardID, opts interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatedConnection", reflect.TypeOf((*MockAdminSession)(nil).DedicatedConnection), shardID, opts)
 }
 
+// DeleteTagged mocks base method.
+func (m *MockAdminSession) DeleteTagged(namespace ident.ID, q index.Query, start time.UnixNano, end time.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagged", namespace, q, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagged indicates an expected call of DeleteTagged.
+func (mr *MockAdminSessionMockRecorder) DeleteTagged(namespace, q, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagged", reflect.TypeOf((*MockAdminSession)(nil).DeleteTagged), namespace, q, start, end)
+}
+
 // Fetch mocks base method.
 func (m *MockAdminSession) Fetch(namespace, id ident.ID, startInclusive, endExclusive time.UnixNano) (encoding.SeriesIterator, error) {
 	m.ctrl.T.Helper()
@@ -890,6 +1030,36 @@ func (mr *MockAdminSessionMockRecorder) FetchBlocksFromPeers(namespace, shard, c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksFromPeers", reflect.TypeOf((*MockAdminSession)(nil).FetchBlocksFromPeers), namespace, shard, consistencyLevel, metadatas, opts)
 }
 
+// FetchBlocksMerkleTreeLeavesMetadataFromPeers mocks base method.
+func (m *MockAdminSession) FetchBlocksMerkleTreeLeavesMetadataFromPeers(namespace ident.ID, shard uint32, start, end time.UnixNano, depth int, leaves []int, consistencyLevel topology.ReadConsistencyLevel, result result.Options) (PeerBlockMetadataIter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBlocksMerkleTreeLeavesMetadataFromPeers", namespace, shard, start, end, depth, leaves, consistencyLevel, result)
+	ret0, _ := ret[0].(PeerBlockMetadataIter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBlocksMerkleTreeLeavesMetadataFromPeers indicates an expected call of FetchBlocksMerkleTreeLeavesMetadataFromPeers.
+func (mr *MockAdminSessionMockRecorder) FetchBlocksMerkleTreeLeavesMetadataFromPeers(namespace, shard, start, end, depth, leaves, consistencyLevel, result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMerkleTreeLeavesMetadataFromPeers", reflect.TypeOf((*MockAdminSession)(nil).FetchBlocksMerkleTreeLeavesMetadataFromPeers), namespace, shard, start, end, depth, leaves, consistencyLevel, result)
+}
+
+// FetchBlocksMerkleTreesFromPeers mocks base method.
+func (m *MockAdminSession) FetchBlocksMerkleTreesFromPeers(namespace ident.ID, shard uint32, start, end time.UnixNano, depth int, consistencyLevel topology.ReadConsistencyLevel) ([]PeerBlocksMerkleTree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBlocksMerkleTreesFromPeers", namespace, shard, start, end, depth, consistencyLevel)
+	ret0, _ := ret[0].([]PeerBlocksMerkleTree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBlocksMerkleTreesFromPeers indicates an expected call of FetchBlocksMerkleTreesFromPeers.
+func (mr *MockAdminSessionMockRecorder) FetchBlocksMerkleTreesFromPeers(namespace, shard, start, end, depth, consistencyLevel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMerkleTreesFromPeers", reflect.TypeOf((*MockAdminSession)(nil).FetchBlocksMerkleTreesFromPeers), namespace, shard, start, end, depth, consistencyLevel)
+}
+
 // FetchBlocksMetadataFromPeers mocks base method.
 func (m *MockAdminSession) FetchBlocksMetadataFromPeers(namespace ident.ID, shard uint32, start, end time.UnixNano, consistencyLevel topology.ReadConsistencyLevel, result result.Options) (PeerBlockMetadataIter, error) {
 	m.ctrl.T.Helper()
@@ -982,6 +1152,21 @@ func (mr *MockAdminSessionMockRecorder) FetchTaggedIDs(ctx, namespace, q, opts i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDs", reflect.TypeOf((*MockAdminSession)(nil).FetchTaggedIDs), ctx, namespace, q, opts)
 }
 
+// FetchTaggedStream mocks base method.
+func (m *MockAdminSession) FetchTaggedStream(ctx context.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (SeriesIteratorsStream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedStream", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(SeriesIteratorsStream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTaggedStream indicates an expected call of FetchTaggedStream.
+func (mr *MockAdminSessionMockRecorder) FetchTaggedStream(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedStream", reflect.TypeOf((*MockAdminSession)(nil).FetchTaggedStream), ctx, namespace, q, opts)
+}
+
 // IteratorPools mocks base method.
 func (m *MockAdminSession) IteratorPools() (encoding.IteratorPools, error) {
 	m.ctrl.T.Helper()
@@ -1375,6 +1560,20 @@ func (mr *MockOptionsMockRecorder) ContextPool() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContextPool", reflect.TypeOf((*MockOptions)(nil).ContextPool))
 }
 
+// DeleteTaggedRequestTimeout mocks base method.
+func (m *MockOptions) DeleteTaggedRequestTimeout() time0.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaggedRequestTimeout")
+	ret0, _ := ret[0].(time0.Duration)
+	return ret0
+}
+
+// DeleteTaggedRequestTimeout indicates an expected call of DeleteTaggedRequestTimeout.
+func (mr *MockOptionsMockRecorder) DeleteTaggedRequestTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaggedRequestTimeout", reflect.TypeOf((*MockOptions)(nil).DeleteTaggedRequestTimeout))
+}
+
 // FetchBatchOpPoolSize mocks base method.
 func (m *MockOptions) FetchBatchOpPoolSize() pool.Size {
 	m.ctrl.T.Helper()
@@ -1431,6 +1630,20 @@ func (mr *MockOptionsMockRecorder) FetchRetrier() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchRetrier", reflect.TypeOf((*MockOptions)(nil).FetchRetrier))
 }
 
+// FetchTaggedStreamBatchSize mocks base method.
+func (m *MockOptions) FetchTaggedStreamBatchSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedStreamBatchSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// FetchTaggedStreamBatchSize indicates an expected call of FetchTaggedStreamBatchSize.
+func (mr *MockOptionsMockRecorder) FetchTaggedStreamBatchSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedStreamBatchSize", reflect.TypeOf((*MockOptions)(nil).FetchTaggedStreamBatchSize))
+}
+
 // HostConnectTimeout mocks base method.
 func (m *MockOptions) HostConnectTimeout() time0.Duration {
 	m.ctrl.T.Helper()
@@ -1711,6 +1924,48 @@ func (mr *MockOptionsMockRecorder) ReadConsistencyLevel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadConsistencyLevel", reflect.TypeOf((*MockOptions)(nil).ReadConsistencyLevel))
 }
 
+// ReadRepairEnabled mocks base method.
+func (m *MockOptions) ReadRepairEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ReadRepairEnabled indicates an expected call of ReadRepairEnabled.
+func (mr *MockOptionsMockRecorder) ReadRepairEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairEnabled", reflect.TypeOf((*MockOptions)(nil).ReadRepairEnabled))
+}
+
+// ReadRepairMaxWritesPerSecond mocks base method.
+func (m *MockOptions) ReadRepairMaxWritesPerSecond() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairMaxWritesPerSecond")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ReadRepairMaxWritesPerSecond indicates an expected call of ReadRepairMaxWritesPerSecond.
+func (mr *MockOptionsMockRecorder) ReadRepairMaxWritesPerSecond() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairMaxWritesPerSecond", reflect.TypeOf((*MockOptions)(nil).ReadRepairMaxWritesPerSecond))
+}
+
+// ReadRepairQueueSize mocks base method.
+func (m *MockOptions) ReadRepairQueueSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairQueueSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ReadRepairQueueSize indicates an expected call of ReadRepairQueueSize.
+func (mr *MockOptionsMockRecorder) ReadRepairQueueSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairQueueSize", reflect.TypeOf((*MockOptions)(nil).ReadRepairQueueSize))
+}
+
 // ReaderIteratorAllocate mocks base method.
 func (m *MockOptions) ReaderIteratorAllocate() encoding.ReaderIteratorAllocate {
 	m.ctrl.T.Helper()
@@ -1991,6 +2246,20 @@ func (mr *MockOptionsMockRecorder) SetContextPool(value interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContextPool", reflect.TypeOf((*MockOptions)(nil).SetContextPool), value)
 }
 
+// SetDeleteTaggedRequestTimeout mocks base method.
+func (m *MockOptions) SetDeleteTaggedRequestTimeout(value time0.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDeleteTaggedRequestTimeout", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetDeleteTaggedRequestTimeout indicates an expected call of SetDeleteTaggedRequestTimeout.
+func (mr *MockOptionsMockRecorder) SetDeleteTaggedRequestTimeout(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeleteTaggedRequestTimeout", reflect.TypeOf((*MockOptions)(nil).SetDeleteTaggedRequestTimeout), value)
+}
+
 // SetEncodingM3TSZ mocks base method.
 func (m *MockOptions) SetEncodingM3TSZ() Options {
 	m.ctrl.T.Helper()
@@ -2075,6 +2344,20 @@ func (mr *MockOptionsMockRecorder) SetFetchRetrier(value interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchRetrier", reflect.TypeOf((*MockOptions)(nil).SetFetchRetrier), value)
 }
 
+// SetFetchTaggedStreamBatchSize mocks base method.
+func (m *MockOptions) SetFetchTaggedStreamBatchSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFetchTaggedStreamBatchSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetFetchTaggedStreamBatchSize indicates an expected call of SetFetchTaggedStreamBatchSize.
+func (mr *MockOptionsMockRecorder) SetFetchTaggedStreamBatchSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchTaggedStreamBatchSize", reflect.TypeOf((*MockOptions)(nil).SetFetchTaggedStreamBatchSize), value)
+}
+
 // SetHostConnectTimeout mocks base method.
 func (m *MockOptions) SetHostConnectTimeout(value time0.Duration) Options {
 	m.ctrl.T.Helper()
@@ -2341,6 +2624,48 @@ func (mr *MockOptionsMockRecorder) SetReadConsistencyLevel(value interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadConsistencyLevel", reflect.TypeOf((*MockOptions)(nil).SetReadConsistencyLevel), value)
 }
 
+// SetReadRepairEnabled mocks base method.
+func (m *MockOptions) SetReadRepairEnabled(value bool) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairEnabled", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairEnabled indicates an expected call of SetReadRepairEnabled.
+func (mr *MockOptionsMockRecorder) SetReadRepairEnabled(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairEnabled", reflect.TypeOf((*MockOptions)(nil).SetReadRepairEnabled), value)
+}
+
+// SetReadRepairMaxWritesPerSecond mocks base method.
+func (m *MockOptions) SetReadRepairMaxWritesPerSecond(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairMaxWritesPerSecond", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairMaxWritesPerSecond indicates an expected call of SetReadRepairMaxWritesPerSecond.
+func (mr *MockOptionsMockRecorder) SetReadRepairMaxWritesPerSecond(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairMaxWritesPerSecond", reflect.TypeOf((*MockOptions)(nil).SetReadRepairMaxWritesPerSecond), value)
+}
+
+// SetReadRepairQueueSize mocks base method.
+func (m *MockOptions) SetReadRepairQueueSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairQueueSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairQueueSize indicates an expected call of SetReadRepairQueueSize.
+func (mr *MockOptionsMockRecorder) SetReadRepairQueueSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairQueueSize", reflect.TypeOf((*MockOptions)(nil).SetReadRepairQueueSize), value)
+}
+
 // SetReaderIteratorAllocate mocks base method.
 func (m *MockOptions) SetReaderIteratorAllocate(value encoding.ReaderIteratorAllocate) Options {
 	m.ctrl.T.Helper()
@@ -3176,6 +3501,20 @@ func (mr *MockAdminOptionsMockRecorder) ContextPool() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContextPool", reflect.TypeOf((*MockAdminOptions)(nil).ContextPool))
 }
 
+// DeleteTaggedRequestTimeout mocks base method.
+func (m *MockAdminOptions) DeleteTaggedRequestTimeout() time0.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaggedRequestTimeout")
+	ret0, _ := ret[0].(time0.Duration)
+	return ret0
+}
+
+// DeleteTaggedRequestTimeout indicates an expected call of DeleteTaggedRequestTimeout.
+func (mr *MockAdminOptionsMockRecorder) DeleteTaggedRequestTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaggedRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).DeleteTaggedRequestTimeout))
+}
+
 // FetchBatchOpPoolSize mocks base method.
 func (m *MockAdminOptions) FetchBatchOpPoolSize() pool.Size {
 	m.ctrl.T.Helper()
@@ -3302,6 +3641,20 @@ func (mr *MockAdminOptionsMockRecorder) FetchSeriesBlocksMetadataBatchTimeout()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSeriesBlocksMetadataBatchTimeout", reflect.TypeOf((*MockAdminOptions)(nil).FetchSeriesBlocksMetadataBatchTimeout))
 }
 
+// FetchTaggedStreamBatchSize mocks base method.
+func (m *MockAdminOptions) FetchTaggedStreamBatchSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedStreamBatchSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// FetchTaggedStreamBatchSize indicates an expected call of FetchTaggedStreamBatchSize.
+func (mr *MockAdminOptionsMockRecorder) FetchTaggedStreamBatchSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedStreamBatchSize", reflect.TypeOf((*MockAdminOptions)(nil).FetchTaggedStreamBatchSize))
+}
+
 // HostConnectTimeout mocks base method.
 func (m *MockAdminOptions) HostConnectTimeout() time0.Duration {
 	m.ctrl.T.Helper()
@@ -3596,6 +3949,48 @@ func (mr *MockAdminOptionsMockRecorder) ReadConsistencyLevel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).ReadConsistencyLevel))
 }
 
+// ReadRepairEnabled mocks base method.
+func (m *MockAdminOptions) ReadRepairEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ReadRepairEnabled indicates an expected call of ReadRepairEnabled.
+func (mr *MockAdminOptionsMockRecorder) ReadRepairEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairEnabled", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairEnabled))
+}
+
+// ReadRepairMaxWritesPerSecond mocks base method.
+func (m *MockAdminOptions) ReadRepairMaxWritesPerSecond() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairMaxWritesPerSecond")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ReadRepairMaxWritesPerSecond indicates an expected call of ReadRepairMaxWritesPerSecond.
+func (mr *MockAdminOptionsMockRecorder) ReadRepairMaxWritesPerSecond() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairMaxWritesPerSecond", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairMaxWritesPerSecond))
+}
+
+// ReadRepairQueueSize mocks base method.
+func (m *MockAdminOptions) ReadRepairQueueSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRepairQueueSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// ReadRepairQueueSize indicates an expected call of ReadRepairQueueSize.
+func (mr *MockAdminOptionsMockRecorder) ReadRepairQueueSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRepairQueueSize", reflect.TypeOf((*MockAdminOptions)(nil).ReadRepairQueueSize))
+}
+
 // ReaderIteratorAllocate mocks base method.
 func (m *MockAdminOptions) ReaderIteratorAllocate() encoding.ReaderIteratorAllocate {
 	m.ctrl.T.Helper()
@@ -3890,6 +4285,20 @@ func (mr *MockAdminOptionsMockRecorder) SetContextPool(value interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContextPool", reflect.TypeOf((*MockAdminOptions)(nil).SetContextPool), value)
 }
 
+// SetDeleteTaggedRequestTimeout mocks base method.
+func (m *MockAdminOptions) SetDeleteTaggedRequestTimeout(value time0.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDeleteTaggedRequestTimeout", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetDeleteTaggedRequestTimeout indicates an expected call of SetDeleteTaggedRequestTimeout.
+func (mr *MockAdminOptionsMockRecorder) SetDeleteTaggedRequestTimeout(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeleteTaggedRequestTimeout", reflect.TypeOf((*MockAdminOptions)(nil).SetDeleteTaggedRequestTimeout), value)
+}
+
 // SetEncodingM3TSZ mocks base method.
 func (m *MockAdminOptions) SetEncodingM3TSZ() Options {
 	m.ctrl.T.Helper()
@@ -4044,6 +4453,20 @@ func (mr *MockAdminOptionsMockRecorder) SetFetchSeriesBlocksMetadataBatchTimeout
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchSeriesBlocksMetadataBatchTimeout", reflect.TypeOf((*MockAdminOptions)(nil).SetFetchSeriesBlocksMetadataBatchTimeout), value)
 }
 
+// SetFetchTaggedStreamBatchSize mocks base method.
+func (m *MockAdminOptions) SetFetchTaggedStreamBatchSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFetchTaggedStreamBatchSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetFetchTaggedStreamBatchSize indicates an expected call of SetFetchTaggedStreamBatchSize.
+func (mr *MockAdminOptionsMockRecorder) SetFetchTaggedStreamBatchSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchTaggedStreamBatchSize", reflect.TypeOf((*MockAdminOptions)(nil).SetFetchTaggedStreamBatchSize), value)
+}
+
 // SetHostConnectTimeout mocks base method.
 func (m *MockAdminOptions) SetHostConnectTimeout(value time0.Duration) Options {
 	m.ctrl.T.Helper()
@@ -4324,6 +4747,48 @@ func (mr *MockAdminOptionsMockRecorder) SetReadConsistencyLevel(value interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).SetReadConsistencyLevel), value)
 }
 
+// SetReadRepairEnabled mocks base method.
+func (m *MockAdminOptions) SetReadRepairEnabled(value bool) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairEnabled", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairEnabled indicates an expected call of SetReadRepairEnabled.
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairEnabled(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairEnabled", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairEnabled), value)
+}
+
+// SetReadRepairMaxWritesPerSecond mocks base method.
+func (m *MockAdminOptions) SetReadRepairMaxWritesPerSecond(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairMaxWritesPerSecond", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairMaxWritesPerSecond indicates an expected call of SetReadRepairMaxWritesPerSecond.
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairMaxWritesPerSecond(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairMaxWritesPerSecond", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairMaxWritesPerSecond), value)
+}
+
+// SetReadRepairQueueSize mocks base method.
+func (m *MockAdminOptions) SetReadRepairQueueSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadRepairQueueSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetReadRepairQueueSize indicates an expected call of SetReadRepairQueueSize.
+func (mr *MockAdminOptionsMockRecorder) SetReadRepairQueueSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadRepairQueueSize", reflect.TypeOf((*MockAdminOptions)(nil).SetReadRepairQueueSize), value)
+}
+
 // SetReaderIteratorAllocate mocks base method.
 func (m *MockAdminOptions) SetReaderIteratorAllocate(value encoding.ReaderIteratorAllocate) Options {
 	m.ctrl.T.Helper()
@@ -4980,6 +5445,22 @@ func (mr *MockclientSessionMockRecorder) BorrowConnections(shardID, fn, opts int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BorrowConnections", reflect.TypeOf((*MockclientSession)(nil).BorrowConnections), shardID, fn, opts)
 }
 
+// Cardinality mocks base method.
+func (m *MockclientSession) Cardinality(ctx context.Context, namespace ident.ID, q index.Query, opts index.CardinalityOptions) (index.CardinalityResults, FetchResponseMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(index.CardinalityResults)
+	ret1, _ := ret[1].(FetchResponseMetadata)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Cardinality indicates an expected call of Cardinality.
+func (mr *MockclientSessionMockRecorder) Cardinality(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*MockclientSession)(nil).Cardinality), ctx, namespace, q, opts)
+}
+
 // Close mocks base method.
 func (m *MockclientSession) Close() error {
 	m.ctrl.T.Helper()
@@ -5010,6 +5491,21 @@ func (mr *MockclientSessionMockRecorder) DedicatedConnection(shardID, opts inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatedConnection", reflect.TypeOf((*MockclientSession)(nil).DedicatedConnection), shardID, opts)
 }
 
+// DeleteTagged mocks base method.
+func (m *MockclientSession) DeleteTagged(namespace ident.ID, q index.Query, start time.UnixNano, end time.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagged", namespace, q, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagged indicates an expected call of DeleteTagged.
+func (mr *MockclientSessionMockRecorder) DeleteTagged(namespace, q, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagged", reflect.TypeOf((*MockclientSession)(nil).DeleteTagged), namespace, q, start, end)
+}
+
 // Fetch mocks base method.
 func (m *MockclientSession) Fetch(namespace, id ident.ID, startInclusive, endExclusive time.UnixNano) (encoding.SeriesIterator, error) {
 	m.ctrl.T.Helper()
@@ -5040,6 +5536,36 @@ func (mr *MockclientSessionMockRecorder) FetchBlocksFromPeers(namespace, shard,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksFromPeers", reflect.TypeOf((*MockclientSession)(nil).FetchBlocksFromPeers), namespace, shard, consistencyLevel, metadatas, opts)
 }
 
+// FetchBlocksMerkleTreeLeavesMetadataFromPeers mocks base method.
+func (m *MockclientSession) FetchBlocksMerkleTreeLeavesMetadataFromPeers(namespace ident.ID, shard uint32, start, end time.UnixNano, depth int, leaves []int, consistencyLevel topology.ReadConsistencyLevel, result result.Options) (PeerBlockMetadataIter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBlocksMerkleTreeLeavesMetadataFromPeers", namespace, shard, start, end, depth, leaves, consistencyLevel, result)
+	ret0, _ := ret[0].(PeerBlockMetadataIter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBlocksMerkleTreeLeavesMetadataFromPeers indicates an expected call of FetchBlocksMerkleTreeLeavesMetadataFromPeers.
+func (mr *MockclientSessionMockRecorder) FetchBlocksMerkleTreeLeavesMetadataFromPeers(namespace, shard, start, end, depth, leaves, consistencyLevel, result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMerkleTreeLeavesMetadataFromPeers", reflect.TypeOf((*MockclientSession)(nil).FetchBlocksMerkleTreeLeavesMetadataFromPeers), namespace, shard, start, end, depth, leaves, consistencyLevel, result)
+}
+
+// FetchBlocksMerkleTreesFromPeers mocks base method.
+func (m *MockclientSession) FetchBlocksMerkleTreesFromPeers(namespace ident.ID, shard uint32, start, end time.UnixNano, depth int, consistencyLevel topology.ReadConsistencyLevel) ([]PeerBlocksMerkleTree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBlocksMerkleTreesFromPeers", namespace, shard, start, end, depth, consistencyLevel)
+	ret0, _ := ret[0].([]PeerBlocksMerkleTree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBlocksMerkleTreesFromPeers indicates an expected call of FetchBlocksMerkleTreesFromPeers.
+func (mr *MockclientSessionMockRecorder) FetchBlocksMerkleTreesFromPeers(namespace, shard, start, end, depth, consistencyLevel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMerkleTreesFromPeers", reflect.TypeOf((*MockclientSession)(nil).FetchBlocksMerkleTreesFromPeers), namespace, shard, start, end, depth, consistencyLevel)
+}
+
 // FetchBlocksMetadataFromPeers mocks base method.
 func (m *MockclientSession) FetchBlocksMetadataFromPeers(namespace ident.ID, shard uint32, start, end time.UnixNano, consistencyLevel topology.ReadConsistencyLevel, result result.Options) (PeerBlockMetadataIter, error) {
 	m.ctrl.T.Helper()
@@ -5132,6 +5658,21 @@ func (mr *MockclientSessionMockRecorder) FetchTaggedIDs(ctx, namespace, q, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDs", reflect.TypeOf((*MockclientSession)(nil).FetchTaggedIDs), ctx, namespace, q, opts)
 }
 
+// FetchTaggedStream mocks base method.
+func (m *MockclientSession) FetchTaggedStream(ctx context.Context, namespace ident.ID, q index.Query, opts index.QueryOptions) (SeriesIteratorsStream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedStream", ctx, namespace, q, opts)
+	ret0, _ := ret[0].(SeriesIteratorsStream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTaggedStream indicates an expected call of FetchTaggedStream.
+func (mr *MockclientSessionMockRecorder) FetchTaggedStream(ctx, namespace, q, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedStream", reflect.TypeOf((*MockclientSession)(nil).FetchTaggedStream), ctx, namespace, q, opts)
+}
+
 // IteratorPools mocks base method.
 func (m *MockclientSession) IteratorPools() (encoding.IteratorPools, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +136,47 @@ type Configuration struct {
 
 	// CircuitBreakerConfig is the configuration for the circuit breaker middleware.
 	CircuitBreakerConfig *cb.Config `yaml:"circuitBreakerConfig"`
+
+	// ReadRepair is the configuration for repairing replicas that respond
+	// with differing data to fetches, repair writes older than the namespace
+	// buffer past are rejected unless cold writes are enabled.
+	ReadRepair *ReadRepairConfiguration `yaml:"readRepair"`
+
+	// FetchTaggedStreamBatchSize sets the number of series requested from each
+	// host per batch when streaming fetched series. Defaults to 1024.
+	FetchTaggedStreamBatchSize *int `yaml:"fetchTaggedStreamBatchSize"`
+}
+
+// ReadRepairConfiguration is the configuration for read repair.
+type ReadRepairConfiguration struct {
+	// Enabled specifies whether read repair is enabled.
+	Enabled bool `yaml:"enabled"`
+
+	// MaxWritesPerSecond is the maximum number of datapoints written back
+	// to replicas per second.
+	MaxWritesPerSecond *int `yaml:"maxWritesPerSecond"`
+
+	// QueueSize is the number of divergent series that can be pending repair.
+	QueueSize *int `yaml:"queueSize"`
+}
+
+// Validate validates the ReadRepairConfiguration.
+func (c *ReadRepairConfiguration) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.MaxWritesPerSecond != nil && *c.MaxWritesPerSecond <= 0 {
+		return fmt.Errorf("m3db client read repair maxWritesPerSecond was: %d but must be >0",
+			*c.MaxWritesPerSecond)
+	}
+
+	if c.QueueSize != nil && *c.QueueSize <= 0 {
+		return fmt.Errorf("m3db client read repair queueSize was: %d but must be >0",
+			*c.QueueSize)
+	}
+
+	return nil
 }
 
 // ProtoConfiguration is the configuration for running with ProtoDataMode enabled.
@@ -233,6 +274,10 @@ func (c *Configuration) Validate() error {
 		return fmt.Errorf("error validating M3DB client proto configuration: %w", err)
 	}
 
+	if err := c.ReadRepair.Validate(); err != nil {
+		return fmt.Errorf("error validating M3DB client read repair configuration: %w", err)
+	}
+
 	return nil
 }
 
@@ -490,6 +535,20 @@ func (c Configuration) NewAdminClient(
 		v = v.SetMiddlewareCircuitbreakerConfig(*c.CircuitBreakerConfig)
 	}
 
+	if c.ReadRepair != nil {
+		v = v.SetReadRepairEnabled(c.ReadRepair.Enabled)
+		if c.ReadRepair.MaxWritesPerSecond != nil {
+			v = v.SetReadRepairMaxWritesPerSecond(*c.ReadRepair.MaxWritesPerSecond)
+		}
+		if c.ReadRepair.QueueSize != nil {
+			v = v.SetReadRepairQueueSize(*c.ReadRepair.QueueSize)
+		}
+	}
+
+	if c.FetchTaggedStreamBatchSize != nil {
+		v = v.SetFetchTaggedStreamBatchSize(*c.FetchTaggedStreamBatchSize)
+	}
+
 	// Cast to admin options to apply admin config options.
 	opts := v.(AdminOptions)
 
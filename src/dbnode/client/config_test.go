@@ -73,6 +73,9 @@ proto:
     ns2:
       schemaDeployID: "deployID-345"
       messageName: "ns2_msg_name"
+readRepair:
+  enabled: true
+  maxWritesPerSecond: 500
 `
 
 	fd, err := ioutil.TempFile("", "config.yaml")
@@ -100,6 +103,7 @@ proto:
 		num4                 = 4
 		numHalf              = 0.5
 		boolTrue             = true
+		num500               = 500
 	)
 
 	expected := Configuration{
@@ -134,6 +138,10 @@ proto:
 				"ns2":    {SchemaDeployID: "deployID-345", MessageName: "ns2_msg_name"},
 			},
 		},
+		ReadRepair: &ReadRepairConfiguration{
+			Enabled:            true,
+			MaxWritesPerSecond: &num500,
+		},
 	}
 
 	assert.Equal(t, expected, cfg)
@@ -76,6 +76,13 @@ type fetchTaggedResultAccumulator struct {
 	topoMap          topology.Map
 
 	calcTransport *calcTransport
+
+	// NB: readRepairHosts tracks the host each fetched element was returned
+	// by and readRepairExhaustive whether each successful host responded
+	// exhaustively, they are only populated when read repair is enabled.
+	readRepairer         *readRepairer
+	readRepairHosts      map[*rpc.FetchTaggedIDResult_]string
+	readRepairExhaustive map[string]bool
 }
 
 type fetchTaggedShardConsistencyResult struct {
@@ -103,6 +110,12 @@ func (accum *fetchTaggedResultAccumulator) AddFetchTaggedResponse(
 		}
 		for _, elem := range opts.response.Elements {
 			accum.fetchResponses = append(accum.fetchResponses, elem)
+			if accum.readRepairer != nil {
+				accum.readRepairHosts[elem] = opts.host.ID()
+			}
+		}
+		if accum.readRepairer != nil {
+			accum.readRepairExhaustive[opts.host.ID()] = opts.response.Exhaustive
 		}
 	}
 
@@ -253,6 +266,86 @@ func (accum *fetchTaggedResultAccumulator) Clear() {
 	accum.waitedIndex = 0
 	accum.waitedSeriesRead = 0
 	accum.calcTransport.Reset()
+	accum.readRepairer = nil
+	for elem := range accum.readRepairHosts {
+		delete(accum.readRepairHosts, elem)
+	}
+	for hostID := range accum.readRepairExhaustive {
+		delete(accum.readRepairExhaustive, hostID)
+	}
+}
+
+// EnableReadRepair tracks the host each element is returned by so that
+// series the replicas responded with differing data for are repaired.
+func (accum *fetchTaggedResultAccumulator) EnableReadRepair(r *readRepairer) {
+	accum.readRepairer = r
+	if accum.readRepairHosts == nil {
+		accum.readRepairHosts = make(map[*rpc.FetchTaggedIDResult_]string)
+	}
+	if accum.readRepairExhaustive == nil {
+		accum.readRepairExhaustive = make(map[string]bool)
+	}
+}
+
+// maybeReadRepair compares the responses of every host that successfully
+// responded for the shard of the series, a host that exhaustively responded
+// without the series is treated as a replica that has no data for it.
+func (accum *fetchTaggedResultAccumulator) maybeReadRepair(elems fetchTaggedIDResults) {
+	if accum.readRepairer == nil {
+		return
+	}
+	replicas := make([]readRepairReplica, 0, len(elems))
+	for _, elem := range elems {
+		replicas = append(replicas, readRepairReplica{
+			hostID:   accum.readRepairHosts[elem],
+			segments: elem.Segments,
+		})
+	}
+	elem := elems[0]
+	replicas = accum.appendMissingReplicas(replicas, elem.ID)
+	accum.readRepairer.MaybeRepair(elem.NameSpace, elem.ID, elem.EncodedTags, true, replicas)
+}
+
+// appendMissingReplicas appends an empty replica for each host that owns the
+// shard of the series and exhaustively responded without the series.
+func (accum *fetchTaggedResultAccumulator) appendMissingReplicas(
+	replicas []readRepairReplica,
+	id []byte,
+) []readRepairReplica {
+	shardID := accum.topoMap.ShardSet().Lookup(ident.BytesID(id))
+	hosts, err := accum.topoMap.RouteShard(shardID)
+	if err != nil {
+		return replicas
+	}
+	for _, host := range hosts {
+		if exhaustive, ok := accum.readRepairExhaustive[host.ID()]; !ok || !exhaustive {
+			// Only a host that exhaustively responded is known to be missing
+			// the series rather than having truncated its response.
+			continue
+		}
+		if readRepairReplicasContain(replicas, host.ID()) {
+			continue
+		}
+		hostShardSet, ok := accum.topoMap.LookupHostShardSet(host.ID())
+		if !ok {
+			continue
+		}
+		state, err := hostShardSet.ShardSet().LookupStateByID(shardID)
+		if err != nil || state != shard.Available {
+			continue
+		}
+		replicas = append(replicas, readRepairReplica{hostID: host.ID()})
+	}
+	return replicas
+}
+
+func readRepairReplicasContain(replicas []readRepairReplica, hostID string) bool {
+	for _, replica := range replicas {
+		if replica.hostID == hostID {
+			return true
+		}
+	}
+	return false
 }
 
 func (accum *fetchTaggedResultAccumulator) Reset(
@@ -293,6 +386,20 @@ func (accum *fetchTaggedResultAccumulator) sliceResponsesAsSeriesIter(
 	elems fetchTaggedIDResults,
 	descr namespace.SchemaDescr,
 	opts index.IterationOptions,
+) encoding.SeriesIterator {
+	return responsesAsSeriesIter(pools, elems, descr,
+		accum.startTime, accum.endTime, opts)
+}
+
+// responsesAsSeriesIter returns a series iterator over the responses
+// returned for a single series by each of the replicas.
+func responsesAsSeriesIter(
+	pools fetchTaggedPools,
+	elems fetchTaggedIDResults,
+	descr namespace.SchemaDescr,
+	startTime xtime.UnixNano,
+	endTime xtime.UnixNano,
+	opts index.IterationOptions,
 ) encoding.SeriesIterator {
 	numElems := len(elems)
 	iters := pools.MultiReaderIteratorArray().Get(numElems)[:numElems]
@@ -305,8 +412,8 @@ func (accum *fetchTaggedResultAccumulator) sliceResponsesAsSeriesIter(
 	}
 
 	// pick the first element as they all have identical ids/tags
-	// NB: safe to assume this element exists as it's only called within
-	// a forEachID lambda, which provides the guarantee that len(elems) != 0
+	// NB: safe to assume this element exists as it's only called with the
+	// responses for a series that was returned by at least one replica
 	elem := elems[0]
 
 	encodedTags := pools.CheckedBytesWrapper().Get(elem.EncodedTags)
@@ -320,8 +427,8 @@ func (accum *fetchTaggedResultAccumulator) sliceResponsesAsSeriesIter(
 		ID:                            pools.ID().BinaryID(tsID),
 		Namespace:                     pools.ID().BinaryID(nsID),
 		Tags:                          decoder,
-		StartInclusive:                accum.startTime,
-		EndExclusive:                  accum.endTime,
+		StartInclusive:                startTime,
+		EndExclusive:                  endTime,
 		Replicas:                      iters,
 		SeriesIteratorConsolidator:    opts.SeriesIteratorConsolidator,
 		IterateEqualTimestampStrategy: opts.IterateEqualTimestampStrategy,
@@ -348,6 +455,7 @@ func (accum *fetchTaggedResultAccumulator) AsEncodingSeriesIterators(
 	count := 0
 	moreElems := false
 	accum.fetchResponses.forEachID(func(elems fetchTaggedIDResults, hasMore bool) bool {
+		accum.maybeReadRepair(elems)
 		seriesIter := accum.sliceResponsesAsSeriesIter(pools, elems, descr, opts)
 		result.SetAt(count, seriesIter)
 		count++
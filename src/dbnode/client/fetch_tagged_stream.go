@@ -0,0 +1,489 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"bytes"
+	gocontext "context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber/tchannel-go/thrift"
+
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/topology"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+// FetchTaggedStream resolves the provided query to known IDs, and fetches
+// the data for them in batches streamed from each host.
+func (s *session) FetchTaggedStream(
+	ctx gocontext.Context,
+	ns ident.ID,
+	q index.Query,
+	opts index.QueryOptions,
+) (SeriesIteratorsStream, error) {
+	nsCtx, err := s.nsCtxFor(ns)
+	if err != nil {
+		return nil, err
+	}
+	s.state.RLock()
+	if s.state.status != statusOpen {
+		s.state.RUnlock()
+		return nil, ErrSessionStatusNotOpen
+	}
+	topoMap := s.state.topoMap
+	readLevel := s.state.readConsistencyLevelWithRLock(opts.ReadConsistencyLevel)
+	s.state.RUnlock()
+
+	// NB: the namespace is cloned as the request referencing it lives for
+	// as long as the stream does.
+	nsClone := s.pools.id.Clone(ns)
+
+	const fetchData = true
+	req, err := convert.ToRPCFetchTaggedRequest(nsClone, q, opts, fetchData)
+	if err != nil {
+		nsClone.Finalize()
+		return nil, xerrors.NewNonRetryableError(err)
+	}
+	if req.SeriesLimit != nil && opts.InstanceMultiple > 0 {
+		iPerReplica := int64(len(topoMap.Hosts()) / topoMap.Replicas())
+		iSeriesLimit := int64(float32(opts.SeriesLimit)*opts.InstanceMultiple) / iPerReplica
+		if iSeriesLimit < *req.SeriesLimit {
+			req.SeriesLimit = &iSeriesLimit
+		}
+	}
+
+	iterOpts := s.opts.IterationOptions()
+	if opts.IterateEqualTimestampStrategy != nil {
+		iterOpts.IterateEqualTimestampStrategy = *opts.IterateEqualTimestampStrategy
+	}
+
+	return newFetchTaggedStream(fetchTaggedStreamOptions{
+		session:   s,
+		ctx:       ctx,
+		ns:        nsClone,
+		descr:     nsCtx.Schema,
+		req:       req,
+		queryOpts: opts,
+		iterOpts:  iterOpts,
+		topoMap:   topoMap,
+		readLevel: readLevel,
+		batchSize: s.opts.FetchTaggedStreamBatchSize(),
+	}), nil
+}
+
+type fetchTaggedStreamOptions struct {
+	session   *session
+	ctx       gocontext.Context
+	ns        ident.ID
+	descr     namespace.SchemaDescr
+	req       rpc.FetchTaggedRequest
+	queryOpts index.QueryOptions
+	iterOpts  index.IterationOptions
+	topoMap   topology.Map
+	readLevel topology.ReadConsistencyLevel
+	batchSize int
+}
+
+type fetchTaggedStreamHost struct {
+	host     topology.Host
+	streamID []byte
+	// lastID is the last series ID streamed from the host, hosts stream
+	// series in the order of their IDs so no series before it remain.
+	lastID  []byte
+	started bool
+	done    bool
+}
+
+func (h *fetchTaggedStreamHost) active() bool {
+	return h.started && !h.done && h.streamID != nil
+}
+
+type fetchTaggedStreamSeries struct {
+	id    []byte
+	elems fetchTaggedIDResults
+}
+
+type fetchTaggedStreamShard struct {
+	// enqueued and available are the number of hosts assigned the shard and
+	// the number of those that have the shard available.
+	enqueued  int
+	available int
+	errors    int
+}
+
+// fetchTaggedStream merges the series streamed in batches from every host of
+// the cluster. Hosts stream series in the order of their IDs, so the streams
+// are merged like sorted lists: a series is only emitted once every host
+// still streaming has streamed past its ID, so that the replicas of a series
+// are always merged into the same series iterator. Only the hosts holding
+// back the merge are requested the next batch.
+type fetchTaggedStream struct {
+	fetchTaggedStreamOptions
+
+	hosts   []*fetchTaggedStreamHost
+	shards  map[uint32]*fetchTaggedStreamShard
+	pending map[string]*fetchTaggedStreamSeries
+	ready   []*fetchTaggedStreamSeries
+	errs    []error
+	emitted int
+
+	current     encoding.SeriesIterators
+	currentMeta FetchResponseMetadata
+	exhaustive  bool
+	finished    bool
+	closed      bool
+	err         error
+
+	// Accumulated since the last emitted batch.
+	responses        int
+	waitedIndex      int
+	waitedSeriesRead int
+	calcTransport    *calcTransport
+}
+
+func newFetchTaggedStream(opts fetchTaggedStreamOptions) *fetchTaggedStream {
+	s := &fetchTaggedStream{
+		fetchTaggedStreamOptions: opts,
+		shards:                   make(map[uint32]*fetchTaggedStreamShard),
+		pending:                  make(map[string]*fetchTaggedStreamSeries),
+		exhaustive:               true,
+		calcTransport:            &calcTransport{},
+	}
+	for _, hss := range opts.topoMap.HostShardSets() {
+		s.hosts = append(s.hosts, &fetchTaggedStreamHost{host: hss.Host()})
+		for _, hs := range hss.ShardSet().All() {
+			result, ok := s.shards[hs.ID()]
+			if !ok {
+				result = &fetchTaggedStreamShard{}
+				s.shards[hs.ID()] = result
+			}
+			result.enqueued++
+			if hs.State() == shard.Available {
+				result.available++
+			}
+		}
+	}
+	return s
+}
+
+func (s *fetchTaggedStream) Next() bool {
+	s.current = nil
+	for !s.closed && s.err == nil {
+		if s.allHostsDone() || s.limitReached() {
+			if s.finished {
+				return false
+			}
+			s.finished = true
+			s.emitBatch(true)
+			return true
+		}
+		// NB: at least one ready series is held back for the final batch so
+		// that the final metadata is never carried by an empty batch.
+		if len(s.ready) > s.batchSize {
+			s.emitBatch(false)
+			return true
+		}
+		if err := s.fetchBatch(); err != nil {
+			s.err = err
+		}
+	}
+	return false
+}
+
+func (s *fetchTaggedStream) Current() (encoding.SeriesIterators, FetchResponseMetadata) {
+	return s.current, s.currentMeta
+}
+
+func (s *fetchTaggedStream) Err() error {
+	return s.err
+}
+
+func (s *fetchTaggedStream) Close() {
+	if s.closed {
+		return
+	}
+	s.closed = true
+
+	// Cancel the streams of any hosts not read to completion so they release
+	// the resources held for them without waiting for the idle timeout.
+	var wg sync.WaitGroup
+	for _, h := range s.hosts {
+		if !h.active() {
+			continue
+		}
+		h := h
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cancel := true
+			req := &rpc.FetchTaggedStreamRequest{
+				StreamID: h.streamID,
+				Cancel:   &cancel,
+			}
+			_ = s.session.BorrowConnection(h.host.ID(), func(client rpc.TChanNode, _ Channel) {
+				tctx, _ := thrift.NewContext(s.session.opts.FetchRequestTimeout())
+				_, _ = client.FetchTaggedStream(tctx, req)
+			})
+		}()
+	}
+	wg.Wait()
+
+	s.pending = nil
+	s.ready = nil
+	s.ns.Finalize()
+}
+
+func (s *fetchTaggedStream) allHostsDone() bool {
+	for _, h := range s.hosts {
+		if !h.done {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *fetchTaggedStream) limitReached() bool {
+	limit := s.queryOpts.SeriesLimit
+	return limit > 0 && s.emitted+len(s.ready) >= limit
+}
+
+func (s *fetchTaggedStream) emitBatch(final bool) {
+	n := len(s.ready)
+	if !final && n > s.batchSize {
+		n = s.batchSize
+	}
+	limitExceeded := false
+	if limit := s.queryOpts.SeriesLimit; limit > 0 && s.emitted+n >= limit {
+		n = limit - s.emitted
+		limitExceeded = len(s.ready) > n || len(s.pending) > 0 || !s.allHostsDone()
+	}
+
+	iters := encoding.NewSizedSeriesIterators(n)
+	for i := 0; i < n; i++ {
+		iters.SetAt(i, responsesAsSeriesIter(s.session.pools, s.ready[i].elems,
+			s.descr, s.queryOpts.StartInclusive, s.queryOpts.EndExclusive, s.iterOpts))
+		s.ready[i] = nil
+	}
+	s.ready = s.ready[n:]
+	s.emitted += n
+
+	s.current = iters
+	s.currentMeta = FetchResponseMetadata{
+		// NB: only the final batch can determine whether the results were
+		// exhaustive, so earlier batches are never marked exhaustive in case
+		// the stream is not read to completion.
+		Exhaustive:         final && s.exhaustive && !limitExceeded,
+		Responses:          s.responses,
+		EstimateTotalBytes: s.calcTransport.GetSize(),
+		WaitedIndex:        s.waitedIndex,
+		WaitedSeriesRead:   s.waitedSeriesRead,
+	}
+	s.responses, s.waitedIndex, s.waitedSeriesRead = 0, 0, 0
+	s.calcTransport.Reset()
+}
+
+// fetchBatch requests the next batch from the hosts whose stream is not yet
+// done and holds back the merge, only requesting more once the previous
+// batch was consumed.
+func (s *fetchTaggedStream) fetchBatch() error {
+	if err := s.ctx.Err(); err != nil {
+		return xerrors.NewNonRetryableError(err)
+	}
+
+	timeout := s.session.opts.FetchRequestTimeout()
+	if deadline, ok := s.ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	var (
+		wg        sync.WaitGroup
+		watermark = s.watermark()
+		requested = make([]bool, len(s.hosts))
+		results   = make([]*rpc.FetchTaggedStreamResult_, len(s.hosts))
+		errs      = make([]error, len(s.hosts))
+	)
+	for i, h := range s.hosts {
+		if h.done || (h.started && bytes.Compare(h.lastID, watermark) > 0) {
+			continue
+		}
+		requested[i] = true
+		req := &rpc.FetchTaggedStreamRequest{BatchSize: int64(s.batchSize)}
+		if h.started {
+			req.StreamID = h.streamID
+		} else {
+			req.Fetch = &s.req
+		}
+		i, h := i, h
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var attemptErr error
+			err := s.session.BorrowConnection(h.host.ID(), func(client rpc.TChanNode, _ Channel) {
+				tctx, _ := thrift.NewContext(timeout)
+				results[i], attemptErr = client.FetchTaggedStream(tctx, req)
+			})
+			errs[i] = xerrors.FirstError(err, attemptErr)
+		}()
+	}
+	wg.Wait()
+
+	// NB: the results of every host are processed before returning any error
+	// so that the streams opened by the hosts are cancelled when closed.
+	var consistencyErr error
+	for i, h := range s.hosts {
+		if !requested[i] {
+			continue
+		}
+		h.started = true
+		if err := errs[i]; err != nil {
+			if err := s.hostFailed(i, err); err != nil && consistencyErr == nil {
+				consistencyErr = err
+			}
+			continue
+		}
+		s.addResult(i, results[i])
+	}
+	if consistencyErr != nil {
+		return consistencyErr
+	}
+
+	s.updateReady()
+	return nil
+}
+
+func (s *fetchTaggedStream) addResult(idx int, result *rpc.FetchTaggedStreamResult_) {
+	h := s.hosts[idx]
+	h.streamID = result.StreamID
+	h.done = result.StreamID == nil
+
+	s.exhaustive = s.exhaustive && result.Exhaustive
+	if v := result.WaitedIndex; v != nil {
+		s.waitedIndex += int(*v)
+	}
+	if v := result.WaitedSeriesRead; v != nil {
+		s.waitedSeriesRead += int(*v)
+	}
+	s.responses += len(result.Elements)
+	// NB: write the response to calculate transport to work out length.
+	result.Write(s.calcTransport)
+
+	for _, elem := range result.Elements {
+		id := string(elem.ID)
+		series, ok := s.pending[id]
+		if !ok {
+			series = &fetchTaggedStreamSeries{id: elem.ID}
+			s.pending[id] = series
+		}
+		series.elems = append(series.elems, elem)
+	}
+	if n := len(result.Elements); n > 0 {
+		h.lastID = result.Elements[n-1].ID
+	}
+}
+
+// hostFailed marks the stream of a host as done, returning an error if the
+// read consistency can no longer be achieved for any of its shards.
+func (s *fetchTaggedStream) hostFailed(idx int, err error) error {
+	h := s.hosts[idx]
+	h.done = true
+	s.errs = append(s.errs, xerrors.NewRenamedError(err,
+		fmt.Errorf("error streaming fetch tagged from host %s: %v", h.host.ID(), err)))
+
+	hostShardSet, ok := s.topoMap.LookupHostShardSet(h.host.ID())
+	if !ok {
+		return nil
+	}
+	majority := s.topoMap.MajorityReplicas()
+	for _, hs := range hostShardSet.ShardSet().All() {
+		result := s.shards[hs.ID()]
+		if hs.State() == shard.Available {
+			result.errors++
+		}
+		successes := result.available - result.errors
+		if topology.ReadConsistencyAchieved(s.readLevel, majority, result.enqueued, successes) {
+			continue
+		}
+		consistencyErr := newConsistencyResultError(s.readLevel, len(s.hosts),
+			len(s.errs), s.errs)
+		return xerrors.Wrapf(consistencyErr,
+			"unable to satisfy consistency requirements, shard=%d", hs.ID())
+	}
+	return nil
+}
+
+// watermark returns the lowest of the last series IDs streamed from the
+// hosts still streaming, every series up to and including it has been
+// streamed by all of them. It returns nil if any host still streaming has
+// not streamed a series yet.
+func (s *fetchTaggedStream) watermark() []byte {
+	var (
+		watermark []byte
+		first     = true
+	)
+	for _, h := range s.hosts {
+		if h.done {
+			continue
+		}
+		if h.lastID == nil {
+			return nil
+		}
+		if first || bytes.Compare(h.lastID, watermark) < 0 {
+			watermark = h.lastID
+			first = false
+		}
+	}
+	return watermark
+}
+
+// updateReady moves the pending series streamed by every host still
+// streaming to the ready series, in the order of their IDs.
+func (s *fetchTaggedStream) updateReady() {
+	var (
+		allDone   = s.allHostsDone()
+		watermark = s.watermark()
+		ready     = len(s.ready)
+	)
+	if !allDone && watermark == nil {
+		return
+	}
+	for id, series := range s.pending {
+		if !allDone && bytes.Compare(series.id, watermark) > 0 {
+			continue
+		}
+		s.ready = append(s.ready, series)
+		delete(s.pending, id)
+	}
+
+	added := s.ready[ready:]
+	sort.Slice(added, func(i, j int) bool {
+		return bytes.Compare(added[i].id, added[j].id) < 0
+	})
+}
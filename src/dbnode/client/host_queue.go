@@ -315,6 +315,8 @@ func (q *queue) drain() {
 				}
 			case *truncateOp:
 				q.asyncTruncate(v)
+			case *deleteTaggedOp:
+				q.asyncDeleteTagged(v)
 			default:
 				completionFn := ops[i].CompletionFn()
 				completionFn(nil, errQueueUnknownOperation(q.host.ID()))
@@ -1019,6 +1021,31 @@ func (q *queue) asyncTruncate(op *truncateOp) {
 	})
 }
 
+func (q *queue) asyncDeleteTagged(op *deleteTaggedOp) {
+	q.Add(1)
+
+	q.workerPool.Go(func() {
+		cleanup := q.Done
+
+		client, _, err := q.connPool.NextClient()
+		if err != nil {
+			// No client available
+			op.completionFn(nil, err)
+			cleanup()
+			return
+		}
+
+		ctx, _ := thrift.NewContext(q.opts.DeleteTaggedRequestTimeout())
+		if res, err := client.DeleteTagged(ctx, &op.request); err != nil {
+			op.completionFn(nil, err)
+		} else {
+			op.completionFn(res, nil)
+		}
+
+		cleanup()
+	})
+}
+
 func (q *queue) mustWrapAndCheckContext(
 	callingContext context.Context,
 	method string,
@@ -89,6 +89,9 @@ const (
 	// defaultTruncateRequestTimeout is the default truncate request timeout
 	defaultTruncateRequestTimeout = 60 * time.Second
 
+	// defaultDeleteTaggedRequestTimeout is the default delete tagged request timeout
+	defaultDeleteTaggedRequestTimeout = 60 * time.Second
+
 	// defaultWriteShardsInitializing is the default write to shards intializing value
 	defaultWriteShardsInitializing = true
 
@@ -173,6 +176,21 @@ const (
 	// defaultAsyncWriteMaxConcurrency is the default maximum concurrency for async writes.
 	defaultAsyncWriteMaxConcurrency = 4096
 
+	// defaultReadRepairEnabled is the default read repair enabled value
+	defaultReadRepairEnabled = false
+
+	// defaultReadRepairMaxWritesPerSecond is the default maximum number of
+	// datapoints written back to replicas per second by read repair
+	defaultReadRepairMaxWritesPerSecond = 10000
+
+	// defaultReadRepairQueueSize is the default number of divergent series
+	// that can be pending repair
+	defaultReadRepairQueueSize = 4096
+
+	// defaultFetchTaggedStreamBatchSize is the default number of series
+	// requested from each host per batch of a fetch tagged stream
+	defaultFetchTaggedStreamBatchSize = 1024
+
 	// defaultUseV2BatchAPIs is the default setting for whether the v2 version of the batch APIs should
 	// be used.
 	defaultUseV2BatchAPIs = false
@@ -231,6 +249,10 @@ var (
 
 	errNoTopologyInitializerSet    = errors.New("no topology initializer set")
 	errNoReaderIteratorAllocateSet = errors.New("no reader iterator allocator set, encoding not set")
+
+	errReadRepairMaxWritesPerSecondNotPositive = errors.New("read repair max writes per second must be positive")
+	errReadRepairQueueSizeNotPositive          = errors.New("read repair queue size must be positive")
+	errFetchTaggedStreamBatchSizeNotPositive   = errors.New("fetch tagged stream batch size must be positive")
 )
 
 type options struct {
@@ -253,6 +275,7 @@ type options struct {
 	writeRequestTimeout                                 time.Duration
 	fetchRequestTimeout                                 time.Duration
 	truncateRequestTimeout                              time.Duration
+	deleteTaggedRequestTimeout                          time.Duration
 	backgroundConnectInterval                           time.Duration
 	backgroundConnectStutter                            time.Duration
 	backgroundHealthCheckInterval                       time.Duration
@@ -304,6 +327,10 @@ type options struct {
 	writeTimestampOffset                                time.Duration
 	namespaceInitializer                                namespace.Initializer
 	thriftContextFn                                     ThriftContextFn
+	readRepairEnabled                                   bool
+	readRepairMaxWritesPerSecond                        int
+	readRepairQueueSize                                 int
+	fetchTaggedStreamBatchSize                          int
 }
 
 // NewOptions creates a new set of client options with defaults
@@ -412,6 +439,7 @@ func newOptions() *options {
 		writeRequestTimeout:                                 defaultWriteRequestTimeout,
 		fetchRequestTimeout:                                 defaultFetchRequestTimeout,
 		truncateRequestTimeout:                              defaultTruncateRequestTimeout,
+		deleteTaggedRequestTimeout:                          defaultDeleteTaggedRequestTimeout,
 		backgroundConnectInterval:                           defaultBackgroundConnectInterval,
 		backgroundConnectStutter:                            defaultBackgroundConnectStutter,
 		backgroundHealthCheckInterval:                       defaultBackgroundHealthCheckInterval,
@@ -455,6 +483,10 @@ func newOptions() *options {
 		asyncWriteMaxConcurrency:              defaultAsyncWriteMaxConcurrency,
 		useV2BatchAPIs:                        defaultUseV2BatchAPIs,
 		thriftContextFn:                       defaultThriftContextFn,
+		readRepairEnabled:                     defaultReadRepairEnabled,
+		readRepairMaxWritesPerSecond:          defaultReadRepairMaxWritesPerSecond,
+		readRepairQueueSize:                   defaultReadRepairQueueSize,
+		fetchTaggedStreamBatchSize:            defaultFetchTaggedStreamBatchSize,
 	}
 	return opts.SetEncodingM3TSZ().(*options)
 }
@@ -486,6 +518,15 @@ func validate(opts *options) error {
 	); err != nil {
 		return err
 	}
+	if opts.readRepairMaxWritesPerSecond <= 0 {
+		return errReadRepairMaxWritesPerSecondNotPositive
+	}
+	if opts.readRepairQueueSize <= 0 {
+		return errReadRepairQueueSizeNotPositive
+	}
+	if opts.fetchTaggedStreamBatchSize <= 0 {
+		return errFetchTaggedStreamBatchSizeNotPositive
+	}
 	if err := opts.logHostWriteErrorSampleRate.Validate(); err != nil {
 		return err
 	}
@@ -712,6 +753,16 @@ func (o *options) TruncateRequestTimeout() time.Duration {
 	return o.truncateRequestTimeout
 }
 
+func (o *options) SetDeleteTaggedRequestTimeout(value time.Duration) Options {
+	opts := *o
+	opts.deleteTaggedRequestTimeout = value
+	return &opts
+}
+
+func (o *options) DeleteTaggedRequestTimeout() time.Duration {
+	return o.deleteTaggedRequestTimeout
+}
+
 func (o *options) SetBackgroundConnectInterval(value time.Duration) Options {
 	opts := *o
 	opts.backgroundConnectInterval = value
@@ -1211,3 +1262,43 @@ func (o *options) SetMiddlewareEnableProvider(value middleware.EnableProvider) O
 func (o *options) MiddlewareEnableProvider() middleware.EnableProvider {
 	return o.middlewareEnableProvider
 }
+
+func (o *options) SetReadRepairEnabled(value bool) Options {
+	opts := *o
+	opts.readRepairEnabled = value
+	return &opts
+}
+
+func (o *options) ReadRepairEnabled() bool {
+	return o.readRepairEnabled
+}
+
+func (o *options) SetReadRepairMaxWritesPerSecond(value int) Options {
+	opts := *o
+	opts.readRepairMaxWritesPerSecond = value
+	return &opts
+}
+
+func (o *options) ReadRepairMaxWritesPerSecond() int {
+	return o.readRepairMaxWritesPerSecond
+}
+
+func (o *options) SetReadRepairQueueSize(value int) Options {
+	opts := *o
+	opts.readRepairQueueSize = value
+	return &opts
+}
+
+func (o *options) ReadRepairQueueSize() int {
+	return o.readRepairQueueSize
+}
+
+func (o *options) SetFetchTaggedStreamBatchSize(value int) Options {
+	opts := *o
+	opts.fetchTaggedStreamBatchSize = value
+	return &opts
+}
+
+func (o *options) FetchTaggedStreamBatchSize() int {
+	return o.fetchTaggedStreamBatchSize
+}
@@ -0,0 +1,409 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/uber-go/tally"
+	"github.com/uber/tchannel-go/thrift"
+	"go.uber.org/zap"
+)
+
+type readRepairBorrowConnectionFn func(hostID string, fn WithConnectionFn) error
+
+type readRepairSchemaFn func(ns ident.ID) (namespace.SchemaDescr, error)
+
+// readRepairReplica is the data a single host responded with for a series.
+type readRepairReplica struct {
+	hostID   string
+	segments []*rpc.Segments
+}
+
+// readRepairRequest is a series whose replicas responded with differing data.
+type readRepairRequest struct {
+	namespace   []byte
+	id          []byte
+	encodedTags []byte
+	tagged      bool
+	replicas    []readRepairReplica
+}
+
+type readRepairDatapoint struct {
+	datapoint  ts.Datapoint
+	unit       xtime.Unit
+	annotation []byte
+}
+
+type readRepairMetrics struct {
+	divergent          tally.Counter
+	dropped            tally.Counter
+	repairedSeries     tally.Counter
+	repairedDatapoints tally.Counter
+	throttled          tally.Counter
+	decodeErrors       tally.Counter
+	writeErrors        tally.Counter
+	queueLength        tally.Gauge
+}
+
+func newReadRepairMetrics(scope tally.Scope) readRepairMetrics {
+	return readRepairMetrics{
+		divergent:          scope.Counter("divergent"),
+		dropped:            scope.Counter("dropped"),
+		repairedSeries:     scope.Counter("repaired-series"),
+		repairedDatapoints: scope.Counter("repaired-datapoints"),
+		throttled:          scope.Counter("throttled"),
+		decodeErrors:       scope.Counter("decode-errors"),
+		writeErrors:        scope.Counter("write-errors"),
+		queueLength:        scope.Gauge("queue-length"),
+	}
+}
+
+// readRepairer asynchronously writes back datapoints to replicas that are
+// missing them when a fetch observes replicas responding with differing data,
+// so that lagging replicas converge without waiting for a repair cycle.
+type readRepairer struct {
+	sync.RWMutex
+
+	borrowConnectionFn readRepairBorrowConnectionFn
+	schemaFn           readRepairSchemaFn
+	iter               encoding.MultiReaderIterator
+	slicesIter         *readerSliceOfSlicesIterator
+	nowFn              clock.NowFn
+	sleepFn            func(time.Duration)
+	writeTimeout       time.Duration
+	maxWritesPerSecond int
+	log                *zap.Logger
+	metrics            readRepairMetrics
+
+	queue   chan readRepairRequest
+	closed  bool
+	closeCh chan struct{}
+	doneCh  chan struct{}
+
+	windowStart  time.Time
+	windowWrites int
+}
+
+func newReadRepairer(
+	opts Options,
+	borrowConnectionFn readRepairBorrowConnectionFn,
+	schemaFn readRepairSchemaFn,
+) *readRepairer {
+	scope := opts.InstrumentOptions().MetricsScope().SubScope("read-repair")
+	return &readRepairer{
+		borrowConnectionFn: borrowConnectionFn,
+		schemaFn:           schemaFn,
+		iter:               encoding.NewMultiReaderIterator(opts.ReaderIteratorAllocate(), nil),
+		slicesIter:         NewReaderSliceOfSlicesIterator(nil, nil),
+		nowFn:              opts.ClockOptions().NowFn(),
+		sleepFn:            time.Sleep,
+		writeTimeout:       opts.WriteRequestTimeout(),
+		maxWritesPerSecond: opts.ReadRepairMaxWritesPerSecond(),
+		log:                opts.InstrumentOptions().Logger(),
+		metrics:            newReadRepairMetrics(scope),
+		queue:              make(chan readRepairRequest, opts.ReadRepairQueueSize()),
+		closeCh:            make(chan struct{}),
+		doneCh:             make(chan struct{}),
+	}
+}
+
+func (r *readRepairer) Start() {
+	go r.run()
+}
+
+func (r *readRepairer) Close() {
+	r.Lock()
+	if r.closed {
+		r.Unlock()
+		return
+	}
+	r.closed = true
+	close(r.closeCh)
+	r.Unlock()
+
+	<-r.doneCh
+}
+
+// MaybeRepair enqueues a series to be repaired if the replicas responded with
+// differing data, the segments are copied so the caller retains ownership.
+func (r *readRepairer) MaybeRepair(
+	namespace, id, encodedTags []byte,
+	tagged bool,
+	replicas []readRepairReplica,
+) {
+	if len(replicas) < 2 || readRepairReplicasEqual(replicas) {
+		return
+	}
+	r.metrics.divergent.Inc(1)
+
+	req := readRepairRequest{
+		namespace:   append([]byte(nil), namespace...),
+		id:          append([]byte(nil), id...),
+		encodedTags: append([]byte(nil), encodedTags...),
+		tagged:      tagged,
+		replicas:    make([]readRepairReplica, 0, len(replicas)),
+	}
+	for _, replica := range replicas {
+		req.replicas = append(req.replicas, readRepairReplica{
+			hostID:   replica.hostID,
+			segments: cloneSegments(replica.segments),
+		})
+	}
+
+	r.RLock()
+	defer r.RUnlock()
+	if r.closed {
+		return
+	}
+	select {
+	case r.queue <- req:
+	default:
+		r.metrics.dropped.Inc(1)
+	}
+}
+
+func (r *readRepairer) run() {
+	defer close(r.doneCh)
+	for {
+		select {
+		case <-r.closeCh:
+			return
+		case req := <-r.queue:
+			r.metrics.queueLength.Update(float64(len(r.queue)))
+			r.repair(req)
+		}
+	}
+}
+
+func (r *readRepairer) repair(req readRepairRequest) {
+	schema, err := r.schemaFn(ident.BytesID(req.namespace))
+	if err != nil {
+		r.metrics.decodeErrors.Inc(1)
+		r.log.Warn("read repair unable to resolve namespace schema", zap.Error(err))
+		return
+	}
+
+	var (
+		merged     = make(map[xtime.UnixNano]readRepairDatapoint)
+		perHost    = make([]map[xtime.UnixNano]struct{}, 0, len(req.replicas))
+		timestamps []xtime.UnixNano
+	)
+	for _, replica := range req.replicas {
+		present := make(map[xtime.UnixNano]struct{})
+		r.slicesIter.Reset(replica.segments)
+		r.iter.ResetSliceOfSlices(r.slicesIter, schema)
+		for r.iter.Next() {
+			dp, unit, annotation := r.iter.Current()
+			present[dp.TimestampNanos] = struct{}{}
+			if _, ok := merged[dp.TimestampNanos]; ok {
+				continue
+			}
+			merged[dp.TimestampNanos] = readRepairDatapoint{
+				datapoint:  dp,
+				unit:       unit,
+				annotation: append([]byte(nil), annotation...),
+			}
+			timestamps = append(timestamps, dp.TimestampNanos)
+		}
+		err := r.iter.Err()
+		r.iter.Close()
+		if err != nil {
+			r.metrics.decodeErrors.Inc(1)
+			r.log.Warn("read repair unable to decode replica",
+				zap.String("host", replica.hostID), zap.Error(err))
+			return
+		}
+		perHost = append(perHost, present)
+	}
+
+	repaired := false
+	for i, replica := range req.replicas {
+		var missing []readRepairDatapoint
+		for _, t := range timestamps {
+			if _, ok := perHost[i][t]; !ok {
+				missing = append(missing, merged[t])
+			}
+		}
+		for len(missing) > 0 {
+			n := r.reserveWrites(len(missing))
+			if err := r.write(replica.hostID, req, missing[:n]); err != nil {
+				r.metrics.writeErrors.Inc(1)
+				r.log.Warn("read repair unable to write to replica",
+					zap.String("host", replica.hostID), zap.Error(err))
+				break
+			}
+			r.metrics.repairedDatapoints.Inc(int64(n))
+			missing = missing[n:]
+			repaired = true
+		}
+	}
+	if repaired {
+		r.metrics.repairedSeries.Inc(1)
+	}
+}
+
+// reserveWrites blocks until at least one write is available in the current
+// one second window and returns the number of writes reserved, at most n.
+func (r *readRepairer) reserveWrites(n int) int {
+	for {
+		now := r.nowFn()
+		if now.Sub(r.windowStart) >= time.Second {
+			r.windowStart = now
+			r.windowWrites = 0
+		}
+		if available := r.maxWritesPerSecond - r.windowWrites; available > 0 {
+			if n > available {
+				n = available
+			}
+			r.windowWrites += n
+			return n
+		}
+		r.metrics.throttled.Inc(1)
+		r.sleepFn(r.windowStart.Add(time.Second).Sub(now))
+	}
+}
+
+func (r *readRepairer) write(
+	hostID string,
+	req readRepairRequest,
+	datapoints []readRepairDatapoint,
+) error {
+	rpcDatapoints := make([]*rpc.Datapoint, 0, len(datapoints))
+	for _, dp := range datapoints {
+		timeType, err := convert.ToTimeType(dp.unit)
+		if err != nil {
+			return err
+		}
+		timestamp, err := convert.ToValue(dp.datapoint.TimestampNanos, timeType)
+		if err != nil {
+			return err
+		}
+		rpcDatapoints = append(rpcDatapoints, &rpc.Datapoint{
+			Timestamp:         timestamp,
+			Value:             dp.datapoint.Value,
+			Annotation:        dp.annotation,
+			TimestampTimeType: timeType,
+		})
+	}
+
+	var writeErr error
+	if err := r.borrowConnectionFn(hostID, func(client rpc.TChanNode, _ Channel) {
+		ctx, _ := thrift.NewContext(r.writeTimeout)
+		if req.tagged {
+			batch := &rpc.WriteTaggedBatchRawRequest{NameSpace: req.namespace}
+			for _, dp := range rpcDatapoints {
+				batch.Elements = append(batch.Elements, &rpc.WriteTaggedBatchRawRequestElement{
+					ID:          req.id,
+					EncodedTags: req.encodedTags,
+					Datapoint:   dp,
+				})
+			}
+			writeErr = client.WriteTaggedBatchRaw(ctx, batch)
+			return
+		}
+		batch := &rpc.WriteBatchRawRequest{NameSpace: req.namespace}
+		for _, dp := range rpcDatapoints {
+			batch.Elements = append(batch.Elements, &rpc.WriteBatchRawRequestElement{
+				ID:        req.id,
+				Datapoint: dp,
+			})
+		}
+		writeErr = client.WriteBatchRaw(ctx, batch)
+	}); err != nil {
+		return err
+	}
+	return writeErr
+}
+
+func readRepairReplicasEqual(replicas []readRepairReplica) bool {
+	first := replicas[0].segments
+	for _, replica := range replicas[1:] {
+		if len(replica.segments) != len(first) {
+			return false
+		}
+		for i, segments := range replica.segments {
+			if !segmentsEqual(first[i], segments) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func segmentsEqual(a, b *rpc.Segments) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !segmentEqual(a.Merged, b.Merged) || len(a.Unmerged) != len(b.Unmerged) {
+		return false
+	}
+	for i := range a.Unmerged {
+		if !segmentEqual(a.Unmerged[i], b.Unmerged[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func segmentEqual(a, b *rpc.Segment) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return bytes.Equal(a.Head, b.Head) && bytes.Equal(a.Tail, b.Tail)
+}
+
+func cloneSegments(segments []*rpc.Segments) []*rpc.Segments {
+	cloned := make([]*rpc.Segments, 0, len(segments))
+	for _, s := range segments {
+		if s == nil {
+			cloned = append(cloned, nil)
+			continue
+		}
+		c := &rpc.Segments{Merged: cloneSegment(s.Merged)}
+		for _, unmerged := range s.Unmerged {
+			c.Unmerged = append(c.Unmerged, cloneSegment(unmerged))
+		}
+		cloned = append(cloned, c)
+	}
+	return cloned
+}
+
+func cloneSegment(s *rpc.Segment) *rpc.Segment {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	c.Head = append([]byte(nil), s.Head...)
+	c.Tail = append([]byte(nil), s.Tail...)
+	return &c
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/topology"
+	tu "github.com/m3db/m3/src/dbnode/topology/testutil"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func newTestReadRepairSegments(start xtime.UnixNano, offsets ...time.Duration) []*rpc.Segments {
+	encoder := m3tsz.NewEncoder(start, nil, true, nil)
+	for _, offset := range offsets {
+		dp := ts.Datapoint{TimestampNanos: start.Add(offset), Value: float64(offset)}
+		if err := encoder.Encode(dp, xtime.Second, nil); err != nil {
+			panic(err)
+		}
+	}
+	seg := encoder.Discard()
+	return []*rpc.Segments{{
+		Merged: &rpc.Segment{Head: bytesIfNotNil(seg.Head), Tail: bytesIfNotNil(seg.Tail)},
+	}}
+}
+
+func newTestReadRepairer(
+	t *testing.T,
+	borrowConnectionFn readRepairBorrowConnectionFn,
+) (*readRepairer, tally.TestScope) {
+	scope := tally.NewTestScope("", nil)
+	opts := newSessionTestOptions().
+		SetInstrumentOptions(instrument.NewOptions().SetMetricsScope(scope)).
+		SetReadRepairEnabled(true).
+		SetReadRepairQueueSize(1)
+	require.NoError(t, opts.Validate())
+
+	r := newReadRepairer(opts, borrowConnectionFn,
+		func(ident.ID) (namespace.SchemaDescr, error) { return nil, nil })
+	return r, scope
+}
+
+func TestReadRepairerMaybeRepairSkipsEqualReplicas(t *testing.T) {
+	r, scope := newTestReadRepairer(t, nil)
+
+	start := xtime.Now().Truncate(time.Hour)
+	r.MaybeRepair([]byte("ns"), []byte("foo"), nil, false, []readRepairReplica{
+		{hostID: "a", segments: newTestReadRepairSegments(start, 0, time.Second)},
+		{hostID: "b", segments: newTestReadRepairSegments(start, 0, time.Second)},
+	})
+	assert.Equal(t, 0, len(r.queue))
+
+	r.MaybeRepair([]byte("ns"), []byte("foo"), nil, false, []readRepairReplica{
+		{hostID: "a", segments: newTestReadRepairSegments(start, 0, time.Second)},
+		{hostID: "b", segments: newTestReadRepairSegments(start, 0)},
+	})
+	assert.Equal(t, 1, len(r.queue))
+
+	// Queue is full so the next divergent series is dropped.
+	r.MaybeRepair([]byte("ns"), []byte("bar"), nil, false, []readRepairReplica{
+		{hostID: "a", segments: newTestReadRepairSegments(start, 0, time.Second)},
+		{hostID: "b", segments: newTestReadRepairSegments(start, 0)},
+	})
+	assert.Equal(t, 1, len(r.queue))
+
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(2), counters["read-repair.divergent+"].Value())
+	assert.Equal(t, int64(1), counters["read-repair.dropped+"].Value())
+}
+
+func TestReadRepairerRepairWritesMissingDatapoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := rpc.NewMockTChanNode(ctrl)
+	written := make(map[string][]*rpc.WriteTaggedBatchRawRequestElement)
+	r, scope := newTestReadRepairer(t, func(hostID string, fn WithConnectionFn) error {
+		client.EXPECT().WriteTaggedBatchRaw(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, req *rpc.WriteTaggedBatchRawRequest) error {
+				assert.Equal(t, "ns", string(req.NameSpace))
+				written[hostID] = append(written[hostID], req.Elements...)
+				return nil
+			})
+		fn(client, nil)
+		return nil
+	})
+
+	start := xtime.Now().Truncate(time.Hour)
+	r.repair(readRepairRequest{
+		namespace:   []byte("ns"),
+		id:          []byte("foo"),
+		encodedTags: []byte("tags"),
+		tagged:      true,
+		replicas: []readRepairReplica{
+			{hostID: "a", segments: newTestReadRepairSegments(start, 0, time.Second, 2*time.Second)},
+			{hostID: "b", segments: newTestReadRepairSegments(start, 0)},
+			{hostID: "c", segments: newTestReadRepairSegments(start, 0, 3*time.Second)},
+		},
+	})
+
+	timestamps := func(elems []*rpc.WriteTaggedBatchRawRequestElement) []int64 {
+		var result []int64
+		for _, elem := range elems {
+			assert.Equal(t, "foo", string(elem.ID))
+			assert.Equal(t, "tags", string(elem.EncodedTags))
+			assert.Equal(t, rpc.TimeType_UNIX_SECONDS, elem.Datapoint.TimestampTimeType)
+			result = append(result, elem.Datapoint.Timestamp)
+		}
+		return result
+	}
+	secs := func(offset time.Duration) int64 {
+		return start.Add(offset).Seconds()
+	}
+	assert.Equal(t, 3, len(written))
+	assert.Equal(t, []int64{secs(3 * time.Second)}, timestamps(written["a"]))
+	assert.Equal(t, []int64{secs(time.Second), secs(2 * time.Second), secs(3 * time.Second)},
+		timestamps(written["b"]))
+	assert.Equal(t, []int64{secs(time.Second), secs(2 * time.Second)}, timestamps(written["c"]))
+
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(1), counters["read-repair.repaired-series+"].Value())
+	assert.Equal(t, int64(6), counters["read-repair.repaired-datapoints+"].Value())
+}
+
+func TestReadRepairerReserveWritesThrottles(t *testing.T) {
+	r, scope := newTestReadRepairer(t, nil)
+	r.maxWritesPerSecond = 10
+
+	now := time.Now()
+	r.nowFn = func() time.Time { return now }
+	var slept []time.Duration
+	r.sleepFn = func(d time.Duration) {
+		slept = append(slept, d)
+		now = now.Add(d)
+	}
+
+	assert.Equal(t, 4, r.reserveWrites(4))
+	assert.Equal(t, 6, r.reserveWrites(20))
+	assert.Equal(t, 0, len(slept))
+
+	now = now.Add(100 * time.Millisecond)
+	assert.Equal(t, 10, r.reserveWrites(20))
+	assert.Equal(t, []time.Duration{900 * time.Millisecond}, slept)
+
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(1), counters["read-repair.throttled+"].Value())
+}
+
+func TestFetchTaggedResultsAccumulatorReadRepairMissingReplicas(t *testing.T) {
+	topoMap := tu.MustNewTopologyMap(3, map[string][]shard.Shard{
+		"testhost0": tu.ShardsRange(0, 29, shard.Available),
+		"testhost1": tu.ShardsRange(0, 29, shard.Available),
+		"testhost2": tu.ShardsRange(0, 29, shard.Available),
+	})
+	r, _ := newTestReadRepairer(t, nil)
+
+	accum := newFetchTaggedResultAccumulator()
+	accum.Reset(0, 100, topoMap, 2, topology.ReadConsistencyLevelAll)
+	accum.EnableReadRepair(r)
+
+	start := xtime.Now().Truncate(time.Hour)
+	elem := &rpc.FetchTaggedIDResult_{
+		NameSpace: []byte("ns"),
+		ID:        []byte("foo"),
+		Segments:  newTestReadRepairSegments(start, 0),
+	}
+	responses := []struct {
+		host     string
+		response *rpc.FetchTaggedResult_
+	}{
+		{"testhost0", &rpc.FetchTaggedResult_{Elements: []*rpc.FetchTaggedIDResult_{elem}, Exhaustive: true}},
+		{"testhost1", &rpc.FetchTaggedResult_{Exhaustive: true}},
+		// NB: testhost2 is not exhaustive so it may have truncated the series.
+		{"testhost2", &rpc.FetchTaggedResult_{Exhaustive: false}},
+	}
+	for _, resp := range responses {
+		hostShardSet, ok := topoMap.LookupHostShardSet(resp.host)
+		require.True(t, ok)
+		_, err := accum.AddFetchTaggedResponse(fetchTaggedResultAccumulatorOpts{
+			host:     hostShardSet.Host(),
+			response: resp.response,
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	accum.maybeReadRepair(fetchTaggedIDResults{elem})
+	require.Equal(t, 1, len(r.queue))
+	req := <-r.queue
+	require.Equal(t, 2, len(req.replicas))
+	assert.Equal(t, "testhost0", req.replicas[0].hostID)
+	assert.Equal(t, "testhost1", req.replicas[1].hostID)
+	assert.Equal(t, 0, len(req.replicas[1].segments))
+}
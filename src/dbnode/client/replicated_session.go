@@ -282,6 +282,16 @@ func (s replicatedSession) Aggregate(
 	return s.session.Aggregate(ctx, ns, q, opts)
 }
 
+// Cardinality returns the number of series per tag name and value pair.
+func (s replicatedSession) Cardinality(
+	ctx context.Context,
+	ns ident.ID,
+	q index.Query,
+	opts index.CardinalityOptions,
+) (index.CardinalityResults, FetchResponseMetadata, error) {
+	return s.session.Cardinality(ctx, ns, q, opts)
+}
+
 // FetchTagged resolves the provided query to known IDs, and fetches the data for them.
 func (s replicatedSession) FetchTagged(
 	ctx context.Context,
@@ -302,6 +312,17 @@ func (s replicatedSession) FetchTaggedIDs(
 	return s.session.FetchTaggedIDs(ctx, namespace, q, opts)
 }
 
+// FetchTaggedStream resolves the provided query to known IDs, and fetches
+// the data for them in batches streamed from each host.
+func (s replicatedSession) FetchTaggedStream(
+	ctx context.Context,
+	namespace ident.ID,
+	q index.Query,
+	opts index.QueryOptions,
+) (SeriesIteratorsStream, error) {
+	return s.session.FetchTaggedStream(ctx, namespace, q, opts)
+}
+
 // ShardID returns the given shard for an ID for callers
 // to easily discern what shard is failing when operations
 // for given IDs begin failing.
@@ -348,6 +369,15 @@ func (s replicatedSession) Truncate(namespace ident.ID) (int64, error) {
 	return s.session.Truncate(namespace)
 }
 
+// DeleteTagged will delete datapoints for all series matching the query.
+func (s replicatedSession) DeleteTagged(
+	namespace ident.ID,
+	q index.Query,
+	start, end xtime.UnixNano,
+) (int64, error) {
+	return s.session.DeleteTagged(namespace, q, start, end)
+}
+
 // FetchBootstrapBlocksFromPeers will fetch the most fulfilled block
 // for each series using the runtime configurable bootstrap level consistency.
 func (s replicatedSession) FetchBootstrapBlocksFromPeers(
@@ -382,6 +412,34 @@ func (s replicatedSession) FetchBlocksMetadataFromPeers(
 	return s.session.FetchBlocksMetadataFromPeers(namespace, shard, start, end, consistencyLevel, result)
 }
 
+// FetchBlocksMerkleTreesFromPeers will fetch the Merkle trees built
+// from the blocks metadata of available peers.
+func (s replicatedSession) FetchBlocksMerkleTreesFromPeers(
+	namespace ident.ID,
+	shard uint32,
+	start, end xtime.UnixNano,
+	depth int,
+	consistencyLevel topology.ReadConsistencyLevel,
+) ([]PeerBlocksMerkleTree, error) {
+	return s.session.FetchBlocksMerkleTreesFromPeers(namespace, shard, start, end, depth, consistencyLevel)
+}
+
+// FetchBlocksMerkleTreeLeavesMetadataFromPeers will fetch the blocks
+// metadata of the series assigned to the given Merkle tree leaves
+// from available peers.
+func (s replicatedSession) FetchBlocksMerkleTreeLeavesMetadataFromPeers(
+	namespace ident.ID,
+	shard uint32,
+	start, end xtime.UnixNano,
+	depth int,
+	leaves []int,
+	consistencyLevel topology.ReadConsistencyLevel,
+	result result.Options,
+) (PeerBlockMetadataIter, error) {
+	return s.session.FetchBlocksMerkleTreeLeavesMetadataFromPeers(namespace, shard, start, end,
+		depth, leaves, consistencyLevel, result)
+}
+
 // FetchBlocksFromPeers will fetch the required blocks from the
 // peers specified.
 func (s replicatedSession) FetchBlocksFromPeers(
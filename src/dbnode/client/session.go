@@ -173,6 +173,7 @@ type session struct {
 	writeShardsInitializing                             bool
 	shardsLeavingCountTowardsConsistency                bool
 	shardsLeavingAndInitializingCountTowardsConsistency bool
+	readRepairer                                        *readRepairer
 	metrics                                             sessionMetrics
 }
 
@@ -403,6 +404,14 @@ func newSession(opts Options) (clientSession, error) {
 		s.streamBlocksRetrier = opts.StreamBlocksRetrier()
 	}
 
+	if opts.ReadRepairEnabled() {
+		s.readRepairer = newReadRepairer(opts, s.BorrowConnection,
+			func(ns ident.ID) (namespace.SchemaDescr, error) {
+				nsCtx, err := s.nsCtxFor(ns)
+				return nsCtx.Schema, err
+			})
+	}
+
 	if runtimeOptsMgr := opts.RuntimeOptionsManager(); runtimeOptsMgr != nil {
 		runtimeOptsMgr.RegisterListener(s)
 	}
@@ -663,6 +672,10 @@ func (s *session) Open() error {
 	s.state.status = statusOpen
 	s.state.Unlock()
 
+	if s.readRepairer != nil {
+		s.readRepairer.Start()
+	}
+
 	go func() {
 		for range watch.C() {
 			s.log.Info("received update for topology")
@@ -1582,6 +1595,129 @@ func (s *session) aggregateAttempt(
 	return iters, meta, err
 }
 
+func (s *session) Cardinality(
+	ctx gocontext.Context,
+	ns ident.ID,
+	q index.Query,
+	opts index.CardinalityOptions,
+) (index.CardinalityResults, FetchResponseMetadata, error) {
+	s.state.RLock()
+	if s.state.status != statusOpen {
+		s.state.RUnlock()
+		return nil, FetchResponseMetadata{}, ErrSessionStatusNotOpen
+	}
+	readLevel := s.state.readConsistencyLevelWithRLock(opts.ReadConsistencyLevel)
+	topoMap, err := s.topologyMapWithStateRLock()
+	s.state.RUnlock()
+	if err != nil {
+		return nil, FetchResponseMetadata{}, err
+	}
+
+	req, err := convert.ToRPCCardinalityRawRequest(ns, q, opts)
+	if err != nil {
+		return nil, FetchResponseMetadata{}, err
+	}
+
+	timeout := s.opts.FetchRequestTimeout()
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	var (
+		wg            sync.WaitGroup
+		resultsLock   sync.Mutex
+		errs          []error
+		responded     int
+		merged        = index.NewCardinalityResults(ns)
+		hostShardSets = topoMap.HostShardSets()
+		// shardsEnqueued and shardsSuccess are the number of replicas of
+		// each shard queried and that responded successfully.
+		shardsEnqueued = make(map[uint32]int)
+		shardsSuccess  = make(map[uint32]int)
+	)
+	for _, hostShardSet := range hostShardSets {
+		host, shards := hostShardSet.Host(), hostShardSet.ShardSet().AllIDs()
+		for _, shardID := range shards {
+			shardsEnqueued[shardID]++
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var (
+				result     *rpc.CardinalityRawResult_
+				attemptErr error
+			)
+			err := s.BorrowConnection(host.ID(), func(client rpc.TChanNode, _ Channel) {
+				tctx, _ := thrift.NewContext(timeout)
+				result, attemptErr = client.CardinalityRaw(tctx, &req)
+			})
+
+			resultsLock.Lock()
+			defer resultsLock.Unlock()
+			responded++
+			if err := xerrors.FirstError(err, attemptErr); err != nil {
+				errs = append(errs, fmt.Errorf(
+					"cardinality query failed for host %s: %w", host.ID(), err))
+				return
+			}
+			for _, shardID := range shards {
+				shardsSuccess[shardID]++
+			}
+			convert.FromRPCCardinalityRawResult(result, merged)
+		}()
+	}
+
+	wg.Wait()
+
+	var (
+		majority         = topoMap.MajorityReplicas()
+		numShards        int
+		numShardsSuccess int
+	)
+	for shardID, enqueued := range shardsEnqueued {
+		success := shardsSuccess[shardID]
+		if !topology.ReadConsistencyAchieved(readLevel, majority, enqueued, success) {
+			return nil, FetchResponseMetadata{}, newConsistencyResultError(readLevel,
+				len(hostShardSets), responded, errs)
+		}
+		numShards++
+		numShardsSuccess += success
+	}
+
+	// NB: every series is owned by each replica of its shard so counts summed
+	// across the hosts that responded are divided by the mean number of
+	// replicas that responded per shard, rounding up so that any series seen
+	// at all is reported.
+	results := index.NewCardinalityResults(ns)
+	results.AddSeries(scaleRoundUp(merged.NumSeries(), numShards, numShardsSuccess))
+	merged.ForEach(func(name, value string, seriesCount int) {
+		results.AddTerm([]byte(name), []byte(value),
+			scaleRoundUp(seriesCount, numShards, numShardsSuccess))
+	})
+	merged.ForEachTruncated(func(name string, numValues, valuesBytes int) {
+		// NB: values of a tag name are shared by the series of many shards, so
+		// the number of truncated values is an estimate.
+		results.AddTruncated([]byte(name),
+			scaleRoundUp(numValues, numShards, numShardsSuccess),
+			scaleRoundUp(valuesBytes, numShards, numShardsSuccess))
+	})
+
+	return results, FetchResponseMetadata{
+		Exhaustive: len(errs) == 0,
+		Responses:  responded - len(errs),
+	}, nil
+}
+
+// scaleRoundUp returns n multiplied by num and divided by denom, rounding up.
+func scaleRoundUp(n, num, denom int) int {
+	if denom <= 0 || num == denom {
+		return n
+	}
+	return (n*num + denom - 1) / denom
+}
+
 func (s *session) FetchTagged(
 	ctx gocontext.Context,
 	ns ident.ID,
@@ -1801,6 +1937,9 @@ func (s *session) newFetchStateWithRLock(
 		fetchOp.update(ctx, opts.fetchTaggedRequest, fetchState.completionFn)
 		fetchState.ResetFetchTagged(opts.startInclusive, opts.endExclusive,
 			fetchOp, topoMap, s.state.majority, readLevel)
+		if s.readRepairer != nil {
+			fetchState.tagResultAccumulator.EnableReadRepair(s.readRepairer)
+		}
 		op = fetchOp
 
 	case aggregateFetchState:
@@ -1940,6 +2079,7 @@ func (s *session) fetchIDsAttempt(
 			idAccessors      int32 = 1
 			resultsLock      sync.RWMutex
 			results          []encoding.MultiReaderIterator
+			repairReplicas   []readRepairReplica
 			enqueued         int32
 			pending          int32
 			success          int32
@@ -1981,6 +2121,10 @@ func (s *session) fetchIDsAttempt(
 				}
 
 				itersToInclude := results[:numItersToInclude]
+				if s.readRepairer != nil {
+					s.readRepairer.MaybeRepair(namespace.Bytes(), tsID.Bytes(),
+						nil, false, repairReplicas)
+				}
 				resultsLock.RUnlock()
 
 				iter := s.pools.seriesIterator.Get()
@@ -2098,8 +2242,26 @@ func (s *session) fetchIDsAttempt(
 				f.request.RangeTimeType = rpc.TimeType_UNIX_NANOSECONDS
 			}
 
+			fetchCompletionFn := completionFn
+			if s.readRepairer != nil {
+				// Track the segments each host responded with so that
+				// replicas with differing data can be repaired.
+				hostID := host.ID()
+				fetchCompletionFn = func(result interface{}, err error) {
+					if err == nil && atomic.LoadInt32(&wgIsDone) == 0 {
+						resultsLock.Lock()
+						repairReplicas = append(repairReplicas, readRepairReplica{
+							hostID:   hostID,
+							segments: result.([]*rpc.Segments),
+						})
+						resultsLock.Unlock()
+					}
+					completionFn(result, err)
+				}
+			}
+
 			// Append IDWithNamespace to this request
-			f.append(namespace.Bytes(), tsID.Bytes(), completionFn)
+			f.append(namespace.Bytes(), tsID.Bytes(), fetchCompletionFn)
 		}); err != nil {
 			routeErr = err
 			break
@@ -2203,6 +2365,10 @@ func (s *session) Close() error {
 	topoWatch.Close()
 	topo.Close()
 
+	if s.readRepairer != nil {
+		s.readRepairer.Close()
+	}
+
 	if closer := s.runtimeOptsListenerCloser; closer != nil {
 		closer.Close()
 	}
@@ -2288,6 +2454,64 @@ func (s *session) Truncate(namespace ident.ID) (int64, error) {
 	return truncated, resultErr.FinalError()
 }
 
+func (s *session) DeleteTagged(
+	namespace ident.ID,
+	q index.Query,
+	start, end xtime.UnixNano,
+) (int64, error) {
+	request, err := convert.ToRPCDeleteTaggedRequest(namespace, q, start, end)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		wg            sync.WaitGroup
+		enqueueErr    xerrors.MultiError
+		resultErrLock sync.Mutex
+		resultErr     xerrors.MultiError
+		deleted       int64
+	)
+
+	op := &deleteTaggedOp{request: request}
+	op.completionFn = func(result interface{}, err error) {
+		if err != nil {
+			resultErrLock.Lock()
+			resultErr = resultErr.Add(err)
+			resultErrLock.Unlock()
+		} else {
+			// NB: Each replica reports the series it deleted so summing the
+			// results would count each series once per replica.
+			res := result.(*rpc.DeleteTaggedResult_)
+			resultErrLock.Lock()
+			if res.NumSeries > deleted {
+				deleted = res.NumSeries
+			}
+			resultErrLock.Unlock()
+		}
+		wg.Done()
+	}
+
+	s.state.RLock()
+	for idx := range s.state.queues {
+		wg.Add(1)
+		if err := s.state.queues[idx].Enqueue(op); err != nil {
+			wg.Done()
+			enqueueErr = enqueueErr.Add(err)
+		}
+	}
+	s.state.RUnlock()
+
+	if err := enqueueErr.FinalError(); err != nil {
+		s.log.Error("failed to enqueue request", zap.Error(err))
+		return 0, err
+	}
+
+	// Wait for the series to be deleted on all replicas
+	wg.Wait()
+
+	return deleted, resultErr.FinalError()
+}
+
 // NB(r): Excluding maligned struct check here as we can
 // live with a few extra bytes since this struct is only
 // ever passed by stack, its much more readable not optimized
@@ -2400,6 +2624,179 @@ func (s *session) fetchBlocksMetadataFromPeers(
 	return iter, nil
 }
 
+func (s *session) FetchBlocksMerkleTreesFromPeers(
+	namespace ident.ID,
+	shard uint32,
+	start, end xtime.UnixNano,
+	depth int,
+	consistencyLevel topology.ReadConsistencyLevel,
+) ([]PeerBlocksMerkleTree, error) {
+	peers, err := s.peersForShard(shard)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		treesLock sync.Mutex
+		trees     = make([]PeerBlocksMerkleTree, 0, len(peers.peers))
+	)
+	err = s.fetchBlocksMerkleTreeFromPeers(namespace, shard, peers, start, end,
+		depth, nil, consistencyLevel, func(
+			peer peer,
+			result *rpc.FetchBlocksMerkleTreeRawResult_,
+		) error {
+			nodes := make([]uint32, 0, len(result.Digests))
+			for _, d := range result.Digests {
+				nodes = append(nodes, uint32(d))
+			}
+			tree, err := digest.NewMerkleTree(depth, nodes)
+			if err != nil {
+				return err
+			}
+
+			treesLock.Lock()
+			trees = append(trees, PeerBlocksMerkleTree{
+				Host: peer.Host(),
+				Tree: tree,
+			})
+			treesLock.Unlock()
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return trees, nil
+}
+
+func (s *session) FetchBlocksMerkleTreeLeavesMetadataFromPeers(
+	namespace ident.ID,
+	shard uint32,
+	start, end xtime.UnixNano,
+	depth int,
+	leaves []int,
+	consistencyLevel topology.ReadConsistencyLevel,
+	resultOpts result.Options,
+) (PeerBlockMetadataIter, error) {
+	peers, err := s.peersForShard(shard)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		metadataCh = make(chan receivedBlockMetadata,
+			blockMetadataChBufSize)
+		errCh     = make(chan error, 1)
+		idPool    = s.pools.id
+		bytesPool = resultOpts.DatabaseBlockOptions().BytesPool()
+	)
+	go func() {
+		errCh <- s.fetchBlocksMerkleTreeFromPeers(namespace, shard, peers, start, end,
+			depth, leaves, consistencyLevel, func(
+				peer peer,
+				result *rpc.FetchBlocksMerkleTreeRawResult_,
+			) error {
+				for _, elem := range result.Elements {
+					metadataCh <- newReceivedBlockMetadata(peer, elem, idPool, bytesPool)
+				}
+				return nil
+			})
+		close(metadataCh)
+		close(errCh)
+	}()
+
+	iter := newMetadataIter(metadataCh, errCh,
+		s.pools.tagDecoder, s.pools.id)
+	return iter, nil
+}
+
+// fetchBlocksMerkleTreeFromPeers fetches the Merkle tree, or the pages of blocks
+// metadata of the given leaves if any, from each peer concurrently, invoking the
+// result function for every response of a peer.
+func (s *session) fetchBlocksMerkleTreeFromPeers(
+	namespace ident.ID,
+	shard uint32,
+	peers peers,
+	start, end xtime.UnixNano,
+	depth int,
+	leaves []int,
+	level topology.ReadConsistencyLevel,
+	resultFn func(peer peer, result *rpc.FetchBlocksMerkleTreeRawResult_) error,
+) error {
+	req := rpc.NewFetchBlocksMerkleTreeRawRequest()
+	req.NameSpace = namespace.Bytes()
+	req.Shard = int32(shard)
+	req.RangeStart = int64(start)
+	req.RangeEnd = int64(end)
+	req.Depth = int32(depth)
+	req.Leaves = make([]int32, 0, len(leaves))
+	for _, leaf := range leaves {
+		req.Leaves = append(req.Leaves, int32(leaf))
+	}
+	req.Limit = int64(s.streamBlocksBatchSize)
+
+	var (
+		wg        sync.WaitGroup
+		errsLock  sync.Mutex
+		errs      []error
+		majority  = int32(peers.majorityReplicas)
+		enqueued  = int32(len(peers.peers))
+		responded int32
+	)
+	if peers.selfExcludedAndSelfHasShardAvailable() {
+		// The local replica is always available to compare against.
+		enqueued++
+	}
+
+	for _, peer := range peers.peers {
+		peer := peer
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var (
+				peerReq    = *req
+				result     *rpc.FetchBlocksMerkleTreeRawResult_
+				attemptErr error
+				err        error
+			)
+			checkedAttemptFn := func(client rpc.TChanNode, _ Channel) {
+				tctx, _ := thrift.NewContext(s.streamBlocksMetadataBatchTimeout)
+				result, attemptErr = client.FetchBlocksMerkleTreeRaw(tctx, &peerReq)
+			}
+			fetchFn := func() error {
+				borrowErr := peer.BorrowConnection(checkedAttemptFn)
+				return xerrors.FirstError(borrowErr, attemptErr)
+			}
+
+			for {
+				err = s.streamBlocksRetrier.Attempt(fetchFn)
+				if err == nil {
+					err = resultFn(peer, result)
+				}
+				if err != nil || result.NextPageToken == nil {
+					break
+				}
+				// Continue paging through the metadata of the leaves.
+				peerReq.PageToken = result.NextPageToken
+			}
+
+			atomic.AddInt32(&responded, 1)
+			if err != nil {
+				errsLock.Lock()
+				errs = append(errs, err)
+				errsLock.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return s.readConsistencyResult(level, majority, enqueued,
+		atomic.LoadInt32(&responded), int32(len(errs)), errs)
+}
+
 // FetchBootstrapBlocksFromPeers will fetch the specified blocks from peers for
 // bootstrapping purposes. Refer to peer_bootstrapping.md for more details.
 func (s *session) FetchBootstrapBlocksFromPeers(
@@ -2805,79 +3202,24 @@ func (s *session) streamBlocksMetadataFromPeer(
 		}
 
 		for _, elem := range result.Elements {
-			blockStart := xtime.UnixNano(elem.Start)
-
-			data := bytesPool.Get(len(elem.ID))
-			data.IncRef()
-			data.AppendAll(elem.ID)
-			data.DecRef()
-			clonedID := idPool.BinaryID(data)
-			// Return thrift bytes to pool once the ID has been copied.
-			tbinarypool.BytesPoolPut(elem.ID)
+			received := newReceivedBlockMetadata(peer, elem, idPool, bytesPool)
 
-			var encodedTags checked.Bytes
-			if tagBytes := elem.EncodedTags; len(tagBytes) != 0 {
-				encodedTags = bytesPool.Get(len(tagBytes))
-				encodedTags.IncRef()
-				encodedTags.AppendAll(tagBytes)
-				encodedTags.DecRef()
-				// Return thrift bytes to pool once the tags have been copied.
-				tbinarypool.BytesPoolPut(tagBytes)
-			}
-
-			// Error occurred retrieving block metadata, use default values
+			// Error occurred retrieving block metadata, the received metadata
+			// has a zeroed checksum which triggers a fanout fetch
 			if err := elem.Err; err != nil {
 				progress.metadataFetchBatchBlockErr.Inc(1)
 				s.log.Error("error occurred retrieving block metadata",
 					zap.Uint32("shard", shard),
 					zap.String("peer", peerStr),
-					zap.Time("block", blockStart.ToTime()),
+					zap.Time("block", received.block.start.ToTime()),
 					zap.Error(err),
 				)
-				// Enqueue with a zeroed checksum which triggers a fanout fetch
-				metadataCh <- receivedBlockMetadata{
-					peer:        peer,
-					id:          clonedID,
-					encodedTags: encodedTags,
-					block: blockMetadata{
-						start: blockStart,
-					},
-				}
-				continue
-			}
-
-			var size int64
-			if elem.Size != nil {
-				size = *elem.Size
-			}
-
-			var pChecksum *uint32
-			if elem.Checksum != nil {
-				value := uint32(*elem.Checksum)
-				pChecksum = &value
-			}
-
-			var lastRead xtime.UnixNano
-			if elem.LastRead != nil {
-				value, err := convert.ToTime(*elem.LastRead, elem.LastReadTimeType)
-				if err == nil {
-					lastRead = value
-				}
+			} else {
+				// Only used for logs
+				metadataCountByBlock[received.block.start]++
 			}
 
-			metadataCh <- receivedBlockMetadata{
-				peer:        peer,
-				id:          clonedID,
-				encodedTags: encodedTags,
-				block: blockMetadata{
-					start:    blockStart,
-					size:     size,
-					checksum: pChecksum,
-					lastRead: lastRead,
-				},
-			}
-			// Only used for logs
-			metadataCountByBlock[blockStart]++
+			metadataCh <- received
 		}
 		return nil
 	}
@@ -2900,6 +3242,78 @@ func (s *session) streamBlocksMetadataFromPeer(
 	return nil, nil
 }
 
+// newReceivedBlockMetadata copies the ID and tags of block metadata received
+// from a peer, returning the thrift bytes to the pool. When the block metadata
+// has an error only the block start is set.
+func newReceivedBlockMetadata(
+	peer peer,
+	elem *rpc.BlockMetadataV2,
+	idPool ident.Pool,
+	bytesPool pool.CheckedBytesPool,
+) receivedBlockMetadata {
+	blockStart := xtime.UnixNano(elem.Start)
+
+	data := bytesPool.Get(len(elem.ID))
+	data.IncRef()
+	data.AppendAll(elem.ID)
+	data.DecRef()
+	clonedID := idPool.BinaryID(data)
+	// Return thrift bytes to pool once the ID has been copied.
+	tbinarypool.BytesPoolPut(elem.ID)
+
+	var encodedTags checked.Bytes
+	if tagBytes := elem.EncodedTags; len(tagBytes) != 0 {
+		encodedTags = bytesPool.Get(len(tagBytes))
+		encodedTags.IncRef()
+		encodedTags.AppendAll(tagBytes)
+		encodedTags.DecRef()
+		// Return thrift bytes to pool once the tags have been copied.
+		tbinarypool.BytesPoolPut(tagBytes)
+	}
+
+	if elem.Err != nil {
+		return receivedBlockMetadata{
+			peer:        peer,
+			id:          clonedID,
+			encodedTags: encodedTags,
+			block: blockMetadata{
+				start: blockStart,
+			},
+		}
+	}
+
+	var size int64
+	if elem.Size != nil {
+		size = *elem.Size
+	}
+
+	var pChecksum *uint32
+	if elem.Checksum != nil {
+		value := uint32(*elem.Checksum)
+		pChecksum = &value
+	}
+
+	var lastRead xtime.UnixNano
+	if elem.LastRead != nil {
+		value, err := convert.ToTime(*elem.LastRead, elem.LastReadTimeType)
+		if err == nil {
+			lastRead = value
+		}
+	}
+
+	return receivedBlockMetadata{
+		peer:        peer,
+		id:          clonedID,
+		encodedTags: encodedTags,
+		block: blockMetadata{
+			start:    blockStart,
+			size:     size,
+			checksum: pChecksum,
+			lastRead: lastRead,
+		},
+	}
+}
+
 func (s *session) streamBlocksFromPeers(
 	nsMetadata namespace.Metadata,
 	shard uint32,
@@ -0,0 +1,323 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/tchannel-go/thrift"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// NB: the query range is fixed so that the datapoints returned by the hosts
+// are always within the range queried.
+var testFetchTaggedStreamStart = xtime.Now().Truncate(time.Hour)
+
+type testFetchTaggedStreamBatch struct {
+	ids []string
+	err error
+}
+
+func newTestFetchTaggedStreamSession(
+	t *testing.T,
+	ctrl *gomock.Controller,
+	batchSize int,
+) (*session, MockTChanNodes) {
+	opts := newSessionTestAdminOptions().
+		SetFetchTaggedStreamBatchSize(batchSize).(AdminOptions)
+	s, err := newSession(opts)
+	require.NoError(t, err)
+	session := s.(*session)
+
+	mockHostQueues, mockClients := mockHostQueuesAndClientsForFetchBootstrapBlocks(ctrl, opts)
+	session.newHostQueueFn = mockHostQueues.newHostQueueFn()
+	require.NoError(t, session.Open())
+	return session, mockClients
+}
+
+// expectFetchTaggedStream expects the client to stream the batches given,
+// returning a stream ID with each batch except the last unless more is set.
+// Every series streamed has a single datapoint within the query range.
+func expectFetchTaggedStream(
+	t *testing.T,
+	client *rpc.MockTChanNode,
+	batches []testFetchTaggedStreamBatch,
+	more bool,
+) {
+	var (
+		th       = newTestFetchTaggedHelper(t)
+		start    = testFetchTaggedStreamStart
+		streamID = []byte("stream")
+	)
+	for i, batch := range batches {
+		i, batch := i, batch
+		client.EXPECT().
+			FetchTaggedStream(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				_ thrift.Context,
+				req *rpc.FetchTaggedStreamRequest,
+			) (*rpc.FetchTaggedStreamResult_, error) {
+				if i == 0 {
+					require.NotNil(t, req.Fetch)
+					require.Nil(t, req.StreamID)
+				} else {
+					require.Nil(t, req.Fetch)
+					require.Equal(t, streamID, req.StreamID)
+				}
+				if batch.err != nil {
+					return nil, batch.err
+				}
+				result := &rpc.FetchTaggedStreamResult_{Exhaustive: true}
+				for _, id := range batch.ids {
+					result.Elements = append(result.Elements, &rpc.FetchTaggedIDResult_{
+						NameSpace: []byte("testns"),
+						ID:        []byte(id),
+						Segments:  newTestDatapoints(1, start, start.Add(time.Hour)).toRPCSegments(th, start),
+					})
+				}
+				if more || i < len(batches)-1 {
+					result.StreamID = streamID
+				}
+				return result, nil
+			})
+	}
+}
+
+func expectFetchTaggedStreamCancel(client *rpc.MockTChanNode) {
+	client.EXPECT().
+		FetchTaggedStream(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ thrift.Context,
+			req *rpc.FetchTaggedStreamRequest,
+		) (*rpc.FetchTaggedStreamResult_, error) {
+			if req.Cancel == nil || !*req.Cancel {
+				return nil, errors.New("expected cancel")
+			}
+			return &rpc.FetchTaggedStreamResult_{Exhaustive: true}, nil
+		})
+}
+
+func testFetchTaggedStreamIDs(iters encoding.SeriesIterators) []string {
+	var ids []string
+	for _, iter := range iters.Iters() {
+		ids = append(ids, iter.ID().String())
+	}
+	return ids
+}
+
+func testFetchTaggedStreamQueryOpts() (xtime.UnixNano, xtime.UnixNano) {
+	return testFetchTaggedStreamStart, testFetchTaggedStreamStart.Add(time.Hour)
+}
+
+func TestSessionFetchTaggedStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session, clients := newTestFetchTaggedStreamSession(t, ctrl, 1)
+	for _, client := range clients {
+		expectFetchTaggedStream(t, client, []testFetchTaggedStreamBatch{
+			{ids: []string{"a", "b"}},
+			{ids: []string{"c"}},
+		}, false)
+	}
+
+	stream, err := session.FetchTaggedStream(testContext(), ident.StringID("testns"),
+		testSessionFetchTaggedQuery, testSessionFetchTaggedQueryOpts(testFetchTaggedStreamQueryOpts()))
+	require.NoError(t, err)
+
+	// The first batch is emitted once every host streamed past its series,
+	// with one ready series held back for the final batch. Only the final
+	// batch is exhaustive.
+	require.True(t, stream.Next())
+	iters, meta := stream.Current()
+	require.Equal(t, []string{"a"}, testFetchTaggedStreamIDs(iters))
+	replicas, err := iters.Iters()[0].Replicas()
+	require.NoError(t, err)
+	require.Len(t, replicas, 3)
+	require.False(t, meta.Exhaustive)
+	require.Equal(t, 6, meta.Responses)
+	iters.Close()
+
+	require.True(t, stream.Next())
+	iters, meta = stream.Current()
+	require.Equal(t, []string{"b", "c"}, testFetchTaggedStreamIDs(iters))
+	require.True(t, meta.Exhaustive)
+	require.Equal(t, 3, meta.Responses)
+	iters.Close()
+
+	require.False(t, stream.Next())
+	require.NoError(t, stream.Err())
+	stream.Close()
+
+	require.NoError(t, session.Close())
+}
+
+func TestSessionFetchTaggedStreamMergesSortedStreams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// NB: the first host streams past the series the other hosts are yet to
+	// stream, so it is not requested its next batch until they catch up.
+	session, clients := newTestFetchTaggedStreamSession(t, ctrl, 2)
+	expectFetchTaggedStream(t, clients[0], []testFetchTaggedStreamBatch{
+		{ids: []string{"a", "d"}},
+		{ids: []string{"e"}},
+	}, false)
+	for _, client := range clients[1:] {
+		expectFetchTaggedStream(t, client, []testFetchTaggedStreamBatch{
+			{ids: []string{"a", "b"}},
+			{ids: []string{"c", "d"}},
+			{ids: []string{"e"}},
+		}, false)
+	}
+
+	stream, err := session.FetchTaggedStream(testContext(), ident.StringID("testns"),
+		testSessionFetchTaggedQuery, testSessionFetchTaggedQueryOpts(testFetchTaggedStreamQueryOpts()))
+	require.NoError(t, err)
+
+	require.True(t, stream.Next())
+	iters, meta := stream.Current()
+	require.Equal(t, []string{"a", "b"}, testFetchTaggedStreamIDs(iters))
+	require.False(t, meta.Exhaustive)
+	iters.Close()
+
+	require.True(t, stream.Next())
+	iters, meta = stream.Current()
+	require.Equal(t, []string{"c", "d", "e"}, testFetchTaggedStreamIDs(iters))
+	for i, numReplicas := range []int{2, 3, 3} {
+		replicas, err := iters.Iters()[i].Replicas()
+		require.NoError(t, err)
+		require.Len(t, replicas, numReplicas)
+	}
+	require.True(t, meta.Exhaustive)
+	iters.Close()
+
+	require.False(t, stream.Next())
+	require.NoError(t, stream.Err())
+	stream.Close()
+
+	require.NoError(t, session.Close())
+}
+
+func TestSessionFetchTaggedStreamHostError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session, clients := newTestFetchTaggedStreamSession(t, ctrl, 10)
+	expectFetchTaggedStream(t, clients[0], []testFetchTaggedStreamBatch{
+		{err: errors.New("an error")},
+	}, false)
+	for _, client := range clients[1:] {
+		expectFetchTaggedStream(t, client, []testFetchTaggedStreamBatch{
+			{ids: []string{"a", "b"}},
+		}, false)
+	}
+
+	level := topology.ReadConsistencyLevelMajority
+	queryOpts := testSessionFetchTaggedQueryOpts(testFetchTaggedStreamQueryOpts())
+	queryOpts.ReadConsistencyLevel = &level
+	stream, err := session.FetchTaggedStream(testContext(), ident.StringID("testns"),
+		testSessionFetchTaggedQuery, queryOpts)
+	require.NoError(t, err)
+
+	require.True(t, stream.Next())
+	iters, meta := stream.Current()
+	require.Equal(t, []string{"a", "b"}, testFetchTaggedStreamIDs(iters))
+	require.True(t, meta.Exhaustive)
+	iters.Close()
+
+	require.False(t, stream.Next())
+	require.NoError(t, stream.Err())
+	stream.Close()
+
+	require.NoError(t, session.Close())
+}
+
+func TestSessionFetchTaggedStreamConsistencyError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session, clients := newTestFetchTaggedStreamSession(t, ctrl, 10)
+	expectFetchTaggedStream(t, clients[0], []testFetchTaggedStreamBatch{
+		{err: errors.New("an error")},
+	}, false)
+	for _, client := range clients[1:] {
+		expectFetchTaggedStream(t, client, []testFetchTaggedStreamBatch{
+			{ids: []string{"a"}},
+		}, true)
+		expectFetchTaggedStreamCancel(client)
+	}
+
+	level := topology.ReadConsistencyLevelAll
+	queryOpts := testSessionFetchTaggedQueryOpts(testFetchTaggedStreamQueryOpts())
+	queryOpts.ReadConsistencyLevel = &level
+	stream, err := session.FetchTaggedStream(testContext(), ident.StringID("testns"),
+		testSessionFetchTaggedQuery, queryOpts)
+	require.NoError(t, err)
+
+	require.False(t, stream.Next())
+	require.Error(t, stream.Err())
+	require.True(t, IsConsistencyResultError(stream.Err()))
+	stream.Close()
+
+	require.NoError(t, session.Close())
+}
+
+func TestSessionFetchTaggedStreamSeriesLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session, clients := newTestFetchTaggedStreamSession(t, ctrl, 2)
+	for _, client := range clients {
+		expectFetchTaggedStream(t, client, []testFetchTaggedStreamBatch{
+			{ids: []string{"a", "b"}},
+		}, true)
+		expectFetchTaggedStreamCancel(client)
+	}
+
+	queryOpts := testSessionFetchTaggedQueryOpts(testFetchTaggedStreamQueryOpts())
+	queryOpts.SeriesLimit = 1
+	stream, err := session.FetchTaggedStream(testContext(), ident.StringID("testns"),
+		testSessionFetchTaggedQuery, queryOpts)
+	require.NoError(t, err)
+
+	require.True(t, stream.Next())
+	iters, meta := stream.Current()
+	require.Equal(t, 1, iters.Len())
+	require.False(t, meta.Exhaustive)
+	iters.Close()
+
+	require.False(t, stream.Next())
+	require.NoError(t, stream.Err())
+	// Closing the stream cancels the streams the hosts are still holding.
+	stream.Close()
+
+	require.NoError(t, session.Close())
+}
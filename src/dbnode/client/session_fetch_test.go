@@ -194,6 +194,94 @@ func testSessionFetchIDs(t *testing.T, testOpts testOptions) {
 	require.NoError(t, session.Close())
 }
 
+func TestSessionFetchIDsReadRepair(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions().
+		SetReadConsistencyLevel(topology.ReadConsistencyLevelAll).
+		SetReadRepairEnabled(true)
+	s, err := newSession(opts)
+	require.NoError(t, err)
+	session := s.(*session)
+
+	start := xtime.Now().Truncate(time.Hour)
+	end := start.Add(2 * time.Hour)
+	values := []testValue{
+		{1.0, start.Add(1 * time.Second), xtime.Second, nil},
+		{2.0, start.Add(2 * time.Second), xtime.Second, nil},
+	}
+
+	var (
+		lock         sync.Mutex
+		fetchOpHosts = make(map[*fetchBatchOp]int)
+	)
+	enqueueWg := mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			lock.Lock()
+			fetchOpHosts[op.(*fetchBatchOp)] = idx
+			lock.Unlock()
+		},
+	})
+
+	client := rpc.NewMockTChanNode(ctrl)
+	repaired := make(chan *rpc.WriteBatchRawRequest, 1)
+	client.EXPECT().WriteBatchRaw(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, req *rpc.WriteBatchRawRequest) error {
+			repaired <- req
+			return nil
+		})
+	var repairedHost string
+	session.readRepairer.borrowConnectionFn = func(hostID string, fn WithConnectionFn) error {
+		repairedHost = hostID
+		fn(client, nil)
+		return nil
+	}
+
+	go func() {
+		enqueueWg.Wait()
+		lock.Lock()
+		defer lock.Unlock()
+		for op, idx := range fetchOpHosts {
+			// The last replica is lagging and missing the latest datapoint.
+			hostValues := values
+			if idx == sessionTestReplicas-1 {
+				hostValues = values[:1]
+			}
+			encoder := m3tsz.NewEncoder(start, nil, true, nil)
+			for _, v := range hostValues {
+				dp := ts.Datapoint{TimestampNanos: v.t, Value: v.value}
+				require.NoError(t, encoder.Encode(dp, v.unit, v.annotation))
+			}
+			seg := encoder.Discard()
+			op.completionFns[0]([]*rpc.Segments{{
+				Merged: &rpc.Segment{Head: bytesIfNotNil(seg.Head), Tail: bytesIfNotNil(seg.Tail)},
+			}}, nil)
+		}
+	}()
+
+	require.NoError(t, session.Open())
+
+	results, err := session.FetchIDs(ident.StringID(testNamespaceName),
+		ident.NewStringIDsSliceIterator([]string{"foo"}), start, end)
+	require.NoError(t, err)
+	assertFetchResults(t, start, end, []testFetch{{"foo", values}}, results, nil)
+
+	select {
+	case req := <-repaired:
+		assert.Equal(t, testHostName(sessionTestReplicas-1), repairedHost)
+		assert.Equal(t, testNamespaceName, string(req.NameSpace))
+		require.Equal(t, 1, len(req.Elements))
+		assert.Equal(t, "foo", string(req.Elements[0].ID))
+		assert.Equal(t, values[1].t.Seconds(), req.Elements[0].Datapoint.Timestamp)
+		assert.Equal(t, values[1].value, req.Elements[0].Datapoint.Value)
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "timed out waiting for read repair")
+	}
+
+	require.NoError(t, session.Close())
+}
+
 func TestSessionFetchIDsWithRetries(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -29,6 +29,7 @@ import (
 
 	"github.com/m3db/m3/src/cluster/shard"
 	"github.com/m3db/m3/src/dbnode/client/circuitbreaker/middleware"
+	"github.com/m3db/m3/src/dbnode/digest"
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	"github.com/m3db/m3/src/dbnode/namespace"
@@ -136,6 +137,29 @@ type Session interface {
 		opts index.AggregationOptions,
 	) (AggregatedTagsIterator, FetchResponseMetadata, error)
 
+	// Cardinality returns the number of series per tag name and value pair
+	// for the series matching the given query. The counts are estimates
+	// derived from the hosts that responded divided by the number of replicas
+	// of each shard that responded, the read consistency level must be met
+	// for every shard. At most the limit of values with the most series of
+	// each tag name are returned by each host.
+	Cardinality(
+		ctx gocontext.Context,
+		namespace ident.ID,
+		q index.Query,
+		opts index.CardinalityOptions,
+	) (index.CardinalityResults, FetchResponseMetadata, error)
+
+	// FetchTaggedStream resolves the provided query to known IDs, and fetches
+	// the data for them in batches streamed from each host. The next batch is
+	// only requested from the hosts once the current batch is consumed.
+	FetchTaggedStream(
+		ctx gocontext.Context,
+		namespace ident.ID,
+		q index.Query,
+		opts index.QueryOptions,
+	) (SeriesIteratorsStream, error)
+
 	// ShardID returns the given shard for an ID for callers
 	// to easily discern what shard is failing when operations
 	// for given IDs begin failing.
@@ -163,6 +187,26 @@ type FetchResponseMetadata struct {
 	WaitedSeriesRead int
 }
 
+// SeriesIteratorsStream iterates over batches of series streamed from the
+// hosts of a cluster.
+type SeriesIteratorsStream interface {
+	// Next fetches the next batch of series, returning whether one exists.
+	Next() bool
+
+	// Current returns the current batch of series, owned by the caller, and
+	// the metadata of the responses received for it. Only the metadata of
+	// the final batch can be exhaustive, since whether the results were
+	// exhaustive is only known once the stream is read to completion.
+	Current() (encoding.SeriesIterators, FetchResponseMetadata)
+
+	// Err returns any error encountered.
+	Err() error
+
+	// Close stops streaming, cancelling the streams of any hosts that were
+	// not read to completion.
+	Close()
+}
+
 // AggregatedTagsIterator iterates over a collection of tag names with optionally
 // associated values.
 type AggregatedTagsIterator interface {
@@ -227,6 +271,13 @@ type PeerBlockMetadataIter interface {
 	Err() error
 }
 
+// PeerBlocksMerkleTree is a Merkle tree of the blocks metadata of a
+// shard fetched from a peer.
+type PeerBlocksMerkleTree struct {
+	Host topology.Host
+	Tree digest.MerkleTree
+}
+
 // PeerBlocksIter iterates over a collection of blocks from peers.
 type PeerBlocksIter interface {
 	// Next returns whether there are more items in the collection.
@@ -259,6 +310,15 @@ type AdminSession interface {
 	// Truncate will truncate the namespace for a given shard.
 	Truncate(namespace ident.ID) (int64, error)
 
+	// DeleteTagged will delete datapoints within the given time range for
+	// all series matching the query, returning the largest number of series
+	// deleted by any single host.
+	DeleteTagged(
+		namespace ident.ID,
+		q index.Query,
+		start, end xtime.UnixNano,
+	) (int64, error)
+
 	// FetchBootstrapBlocksFromPeers will fetch the most fulfilled block
 	// for each series using the runtime configurable bootstrap level consistency.
 	FetchBootstrapBlocksFromPeers(
@@ -287,6 +347,29 @@ type AdminSession interface {
 		result result.Options,
 	) (PeerBlockMetadataIter, error)
 
+	// FetchBlocksMerkleTreesFromPeers will fetch the Merkle trees built
+	// from the blocks metadata of available peers.
+	FetchBlocksMerkleTreesFromPeers(
+		namespace ident.ID,
+		shard uint32,
+		start, end xtime.UnixNano,
+		depth int,
+		consistencyLevel topology.ReadConsistencyLevel,
+	) ([]PeerBlocksMerkleTree, error)
+
+	// FetchBlocksMerkleTreeLeavesMetadataFromPeers will fetch the blocks
+	// metadata of the series assigned to the given Merkle tree leaves
+	// from available peers.
+	FetchBlocksMerkleTreeLeavesMetadataFromPeers(
+		namespace ident.ID,
+		shard uint32,
+		start, end xtime.UnixNano,
+		depth int,
+		leaves []int,
+		consistencyLevel topology.ReadConsistencyLevel,
+		result result.Options,
+	) (PeerBlockMetadataIter, error)
+
 	// FetchBlocksFromPeers will fetch the required blocks from the
 	// peers specified.
 	FetchBlocksFromPeers(
@@ -470,6 +553,12 @@ type Options interface {
 	// TruncateRequestTimeout returns the truncateRequestTimeout.
 	TruncateRequestTimeout() time.Duration
 
+	// SetDeleteTaggedRequestTimeout sets the deleteTaggedRequestTimeout.
+	SetDeleteTaggedRequestTimeout(value time.Duration) Options
+
+	// DeleteTaggedRequestTimeout returns the deleteTaggedRequestTimeout.
+	DeleteTaggedRequestTimeout() time.Duration
+
 	// SetBackgroundConnectInterval sets the backgroundConnectInterval.
 	SetBackgroundConnectInterval(value time.Duration) Options
 
@@ -752,6 +841,40 @@ type Options interface {
 
 	// ThriftContextFn returns the retrier for streaming blocks.
 	ThriftContextFn() ThriftContextFn
+
+	// SetReadRepairEnabled sets whether fetches asynchronously write back
+	// datapoints missing from replicas that responded with divergent data,
+	// datapoints older than the namespace buffer past are only repaired if
+	// the namespace has cold writes enabled.
+	SetReadRepairEnabled(value bool) Options
+
+	// ReadRepairEnabled returns whether fetches asynchronously write back
+	// datapoints missing from replicas that responded with divergent data.
+	ReadRepairEnabled() bool
+
+	// SetReadRepairMaxWritesPerSecond sets the maximum number of datapoints
+	// written back to replicas per second by read repair.
+	SetReadRepairMaxWritesPerSecond(value int) Options
+
+	// ReadRepairMaxWritesPerSecond returns the maximum number of datapoints
+	// written back to replicas per second by read repair.
+	ReadRepairMaxWritesPerSecond() int
+
+	// SetReadRepairQueueSize sets the number of divergent series that can be
+	// pending repair, series exceeding the queue size are not repaired.
+	SetReadRepairQueueSize(value int) Options
+
+	// ReadRepairQueueSize returns the number of divergent series that can be
+	// pending repair, series exceeding the queue size are not repaired.
+	ReadRepairQueueSize() int
+
+	// SetFetchTaggedStreamBatchSize sets the number of series requested from
+	// each host per batch of a fetch tagged stream.
+	SetFetchTaggedStreamBatchSize(value int) Options
+
+	// FetchTaggedStreamBatchSize returns the number of series requested from
+	// each host per batch of a fetch tagged stream.
+	FetchTaggedStreamBatchSize() int
 }
 
 // ThriftContextFn turns a context into a thrift context for a thrift call.
@@ -0,0 +1,213 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package digest
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	murmur3 "github.com/m3db/stackmurmur3/v2"
+)
+
+const (
+	// MinMerkleTreeDepth is the minimum depth of a Merkle tree.
+	MinMerkleTreeDepth = 1
+	// MaxMerkleTreeDepth is the maximum depth of a Merkle tree, which bounds
+	// the tree to 65536 leaves.
+	MaxMerkleTreeDepth = 16
+)
+
+var errMerkleTreeDepthMismatch = errors.New("merkle trees have different depths")
+
+// ValidateMerkleTreeDepth validates a Merkle tree depth.
+func ValidateMerkleTreeDepth(depth int) error {
+	if depth < MinMerkleTreeDepth || depth > MaxMerkleTreeDepth {
+		return fmt.Errorf("merkle tree depth %d must be between %d and %d",
+			depth, MinMerkleTreeDepth, MaxMerkleTreeDepth)
+	}
+	return nil
+}
+
+// MerkleTreeLeaf returns the leaf a series ID is assigned to in a
+// Merkle tree of the given depth.
+func MerkleTreeLeaf(id []byte, depth int) int {
+	return int(murmur3.Sum32(id) >> uint(32-depth))
+}
+
+// MerkleTree is a complete binary hash tree summarizing the block metadata
+// of a shard. Series are assigned to leaves by hashing their ID so that two
+// replicas of a shard assign the same series to the same leaf, comparing the
+// trees of two replicas top down yields the leaves whose series differ.
+type MerkleTree struct {
+	depth int
+	// nodes are stored in level order, the children of node i are at
+	// 2i+1 and 2i+2 and the leaves are the last 2^depth nodes.
+	nodes []uint32
+}
+
+// NewMerkleTree returns a Merkle tree from its nodes in level order.
+func NewMerkleTree(depth int, nodes []uint32) (MerkleTree, error) {
+	if err := ValidateMerkleTreeDepth(depth); err != nil {
+		return MerkleTree{}, err
+	}
+	if expected := merkleTreeNumNodes(depth); len(nodes) != expected {
+		return MerkleTree{}, fmt.Errorf(
+			"merkle tree of depth %d must have %d nodes, has %d",
+			depth, expected, len(nodes))
+	}
+	return MerkleTree{depth: depth, nodes: nodes}, nil
+}
+
+// Depth returns the depth of the tree.
+func (t MerkleTree) Depth() int {
+	return t.depth
+}
+
+// Nodes returns the nodes of the tree in level order.
+func (t MerkleTree) Nodes() []uint32 {
+	return t.nodes
+}
+
+// Root returns the digest of the root node of the tree.
+func (t MerkleTree) Root() uint32 {
+	if len(t.nodes) == 0 {
+		return 0
+	}
+	return t.nodes[0]
+}
+
+// DifferingLeaves returns the leaves in ascending order whose digests
+// differ between the two trees, only descending into subtrees whose
+// root digests differ.
+func (t MerkleTree) DifferingLeaves(other MerkleTree) ([]int, error) {
+	if t.depth != other.depth || len(t.nodes) != len(other.nodes) {
+		return nil, errMerkleTreeDepthMismatch
+	}
+	if len(t.nodes) == 0 {
+		return nil, nil
+	}
+
+	var (
+		firstLeaf = merkleTreeFirstLeaf(t.depth)
+		leaves    []int
+		descend   func(idx int)
+	)
+	descend = func(idx int) {
+		if t.nodes[idx] == other.nodes[idx] {
+			return
+		}
+		if idx >= firstLeaf {
+			leaves = append(leaves, idx-firstLeaf)
+			return
+		}
+		descend(2*idx + 1)
+		descend(2*idx + 2)
+	}
+	descend(0)
+
+	return leaves, nil
+}
+
+// MerkleTreeBuilder builds a Merkle tree from the block metadata of a shard,
+// it is not safe for concurrent use.
+type MerkleTreeBuilder struct {
+	depth  int
+	leaves []uint32
+	buf    []byte
+}
+
+// NewMerkleTreeBuilder returns a new Merkle tree builder.
+func NewMerkleTreeBuilder(depth int) (*MerkleTreeBuilder, error) {
+	if err := ValidateMerkleTreeDepth(depth); err != nil {
+		return nil, err
+	}
+	return &MerkleTreeBuilder{
+		depth:  depth,
+		leaves: make([]uint32, 1<<uint(depth)),
+	}, nil
+}
+
+// Add adds the metadata of a block of a series to the tree and returns the
+// leaf the series was assigned to. Blocks can be added in any order since
+// the leaf digests are order independent.
+func (b *MerkleTreeBuilder) Add(
+	id []byte,
+	start int64,
+	size int64,
+	checksum *uint32,
+) int {
+	b.buf = append(b.buf[:0], id...)
+	b.buf = binary.BigEndian.AppendUint64(b.buf, uint64(start))
+	b.buf = binary.BigEndian.AppendUint64(b.buf, uint64(size))
+	if checksum != nil {
+		b.buf = append(b.buf, 1)
+		b.buf = binary.BigEndian.AppendUint32(b.buf, *checksum)
+	} else {
+		b.buf = append(b.buf, 0)
+	}
+
+	leaf := MerkleTreeLeaf(id, b.depth)
+	b.leaves[leaf] += murmur3.Sum32(b.buf)
+	return leaf
+}
+
+// Leaves returns a copy of the leaf digests of the blocks added so far, they
+// can be cached and added to another builder of the same depth with AddLeaves.
+func (b *MerkleTreeBuilder) Leaves() []uint32 {
+	return append([]uint32(nil), b.leaves...)
+}
+
+// AddLeaves adds leaf digests returned by a builder of the same depth, this
+// is equivalent to adding each of the blocks that the digests were built from.
+func (b *MerkleTreeBuilder) AddLeaves(leaves []uint32) error {
+	if len(leaves) != len(b.leaves) {
+		return errMerkleTreeDepthMismatch
+	}
+	for i, digest := range leaves {
+		b.leaves[i] += digest
+	}
+	return nil
+}
+
+// Build builds the Merkle tree from the blocks added so far.
+func (b *MerkleTreeBuilder) Build() MerkleTree {
+	var (
+		nodes     = make([]uint32, merkleTreeNumNodes(b.depth))
+		firstLeaf = merkleTreeFirstLeaf(b.depth)
+		pair      [8]byte
+	)
+	copy(nodes[firstLeaf:], b.leaves)
+	for i := firstLeaf - 1; i >= 0; i-- {
+		binary.BigEndian.PutUint32(pair[:4], nodes[2*i+1])
+		binary.BigEndian.PutUint32(pair[4:], nodes[2*i+2])
+		nodes[i] = murmur3.Sum32(pair[:])
+	}
+	return MerkleTree{depth: b.depth, nodes: nodes}
+}
+
+func merkleTreeNumNodes(depth int) int {
+	return 1<<uint(depth+1) - 1
+}
+
+func merkleTreeFirstLeaf(depth int) int {
+	return 1<<uint(depth) - 1
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package digest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testMerkleEntry struct {
+	id       string
+	start    int64
+	size     int64
+	checksum uint32
+}
+
+func buildTestMerkleTree(t *testing.T, depth int, entries []testMerkleEntry) MerkleTree {
+	b, err := NewMerkleTreeBuilder(depth)
+	require.NoError(t, err)
+	for _, e := range entries {
+		checksum := e.checksum
+		b.Add([]byte(e.id), e.start, e.size, &checksum)
+	}
+	return b.Build()
+}
+
+func testMerkleEntries(n int) []testMerkleEntry {
+	entries := make([]testMerkleEntry, 0, n)
+	for i := 0; i < n; i++ {
+		entries = append(entries, testMerkleEntry{
+			id:       fmt.Sprintf("series-%d", i),
+			start:    int64(i % 4),
+			size:     int64(i * 10),
+			checksum: uint32(i),
+		})
+	}
+	return entries
+}
+
+func TestMerkleTreeDepthValidation(t *testing.T) {
+	_, err := NewMerkleTreeBuilder(0)
+	require.Error(t, err)
+	_, err = NewMerkleTreeBuilder(MaxMerkleTreeDepth + 1)
+	require.Error(t, err)
+
+	_, err = NewMerkleTree(2, make([]uint32, 6))
+	require.Error(t, err)
+	_, err = NewMerkleTree(2, make([]uint32, 7))
+	require.NoError(t, err)
+}
+
+func TestMerkleTreeOrderIndependent(t *testing.T) {
+	entries := testMerkleEntries(100)
+	reversed := make([]testMerkleEntry, 0, len(entries))
+	for i := len(entries) - 1; i >= 0; i-- {
+		reversed = append(reversed, entries[i])
+	}
+
+	a := buildTestMerkleTree(t, 4, entries)
+	b := buildTestMerkleTree(t, 4, reversed)
+	require.Equal(t, a.Nodes(), b.Nodes())
+	require.Equal(t, a.Root(), b.Root())
+
+	leaves, err := a.DifferingLeaves(b)
+	require.NoError(t, err)
+	require.Empty(t, leaves)
+}
+
+func TestMerkleTreeAddLeaves(t *testing.T) {
+	entries := testMerkleEntries(100)
+
+	first, err := NewMerkleTreeBuilder(4)
+	require.NoError(t, err)
+	for _, e := range entries[:50] {
+		checksum := e.checksum
+		first.Add([]byte(e.id), e.start, e.size, &checksum)
+	}
+
+	b, err := NewMerkleTreeBuilder(4)
+	require.NoError(t, err)
+	require.NoError(t, b.AddLeaves(first.Leaves()))
+	for _, e := range entries[50:] {
+		checksum := e.checksum
+		b.Add([]byte(e.id), e.start, e.size, &checksum)
+	}
+	require.Equal(t, buildTestMerkleTree(t, 4, entries).Nodes(), b.Build().Nodes())
+
+	other, err := NewMerkleTreeBuilder(5)
+	require.NoError(t, err)
+	require.Error(t, other.AddLeaves(first.Leaves()))
+}
+
+func TestMerkleTreeDifferingLeaves(t *testing.T) {
+	var (
+		depth    = 6
+		entries  = testMerkleEntries(500)
+		modified = append([]testMerkleEntry(nil), entries...)
+	)
+	modified[10].checksum++
+	modified[200].size++
+	modified = append(modified, testMerkleEntry{id: "new-series"})
+
+	a := buildTestMerkleTree(t, depth, entries)
+	b := buildTestMerkleTree(t, depth, modified)
+	require.NotEqual(t, a.Root(), b.Root())
+
+	expected := map[int]struct{}{
+		MerkleTreeLeaf([]byte(entries[10].id), depth):  {},
+		MerkleTreeLeaf([]byte(entries[200].id), depth): {},
+		MerkleTreeLeaf([]byte("new-series"), depth):    {},
+	}
+
+	leaves, err := a.DifferingLeaves(b)
+	require.NoError(t, err)
+	require.Equal(t, len(expected), len(leaves))
+	for i, leaf := range leaves {
+		_, ok := expected[leaf]
+		require.True(t, ok)
+		if i > 0 {
+			require.True(t, leaves[i-1] < leaf)
+		}
+	}
+
+	// Round trip the nodes of a tree.
+	c, err := NewMerkleTree(depth, b.Nodes())
+	require.NoError(t, err)
+	leaves, err = b.DifferingLeaves(c)
+	require.NoError(t, err)
+	require.Empty(t, leaves)
+}
+
+func TestMerkleTreeDifferingLeavesDepthMismatch(t *testing.T) {
+	a := buildTestMerkleTree(t, 2, nil)
+	b := buildTestMerkleTree(t, 3, nil)
+	_, err := a.DifferingLeaves(b)
+	require.Error(t, err)
+}
+
+func TestMerkleTreeLeafRange(t *testing.T) {
+	for depth := MinMerkleTreeDepth; depth <= MaxMerkleTreeDepth; depth++ {
+		for _, e := range testMerkleEntries(50) {
+			leaf := MerkleTreeLeaf([]byte(e.id), depth)
+			require.True(t, leaf >= 0 && leaf < 1<<uint(depth))
+		}
+	}
+}
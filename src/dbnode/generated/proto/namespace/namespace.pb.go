@@ -40,6 +40,7 @@
 		Registry
 		NamespaceRuntimeOptions
 		ExtendedOptions
+		TileAggregation
 		SchemaOptions
 		SchemaHistory
 		FileDescriptorSet
@@ -317,6 +318,9 @@ type AggregationOptions struct {
 	// to a namespace also receiving unaggregated data. In this case, the namespace will
 	// have one Aggregation with aggregated set to false and another with aggregated set to true.
 	Aggregations []*Aggregation `protobuf:"bytes,1,rep,name=aggregations" json:"aggregations,omitempty"`
+	// tileAggregations are the tiers this namespace is downsampled into once its
+	// blocks are sealed.
+	TileAggregations []*TileAggregation `protobuf:"bytes,2,rep,name=tileAggregations" json:"tileAggregations,omitempty"`
 }
 
 func (m *AggregationOptions) Reset()                    { *m = AggregationOptions{} }
@@ -331,6 +335,13 @@ func (m *AggregationOptions) GetAggregations() []*Aggregation {
 	return nil
 }
 
+func (m *AggregationOptions) GetTileAggregations() []*TileAggregation {
+	if m != nil {
+		return m.TileAggregations
+	}
+	return nil
+}
+
 // Aggregation describes data points within the namespace.
 type Aggregation struct {
 	// aggregated is true if data points are aggregated, false otherwise.
@@ -487,6 +498,34 @@ func (m *ExtendedOptions) GetOptions() *google_protobuf.Struct {
 	return nil
 }
 
+// TileAggregation describes a target namespace that data in this namespace
+// is aggregated into with large tiles.
+type TileAggregation struct {
+	// targetNamespace is the namespace the aggregated tiles are written to.
+	TargetNamespace string `protobuf:"bytes,1,opt,name=targetNamespace,proto3" json:"targetNamespace,omitempty"`
+	// stepNanos is the size of each aggregated tile.
+	StepNanos int64 `protobuf:"varint,2,opt,name=stepNanos,proto3" json:"stepNanos,omitempty"`
+}
+
+func (m *TileAggregation) Reset()                    { *m = TileAggregation{} }
+func (m *TileAggregation) String() string            { return proto.CompactTextString(m) }
+func (*TileAggregation) ProtoMessage()               {}
+func (*TileAggregation) Descriptor() ([]byte, []int) { return fileDescriptorNamespace, []int{11} }
+
+func (m *TileAggregation) GetTargetNamespace() string {
+	if m != nil {
+		return m.TargetNamespace
+	}
+	return ""
+}
+
+func (m *TileAggregation) GetStepNanos() int64 {
+	if m != nil {
+		return m.StepNanos
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*RetentionOptions)(nil), "namespace.RetentionOptions")
 	proto.RegisterType((*IndexOptions)(nil), "namespace.IndexOptions")
@@ -499,6 +538,7 @@ func init() {
 	proto.RegisterType((*Registry)(nil), "namespace.Registry")
 	proto.RegisterType((*NamespaceRuntimeOptions)(nil), "namespace.NamespaceRuntimeOptions")
 	proto.RegisterType((*ExtendedOptions)(nil), "namespace.ExtendedOptions")
+	proto.RegisterType((*TileAggregation)(nil), "namespace.TileAggregation")
 	proto.RegisterEnum("namespace.StagingStatus", StagingStatus_name, StagingStatus_value)
 }
 func (m *RetentionOptions) Marshal() (dAtA []byte, err error) {
@@ -789,6 +829,18 @@ func (m *AggregationOptions) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.TileAggregations) > 0 {
+		for _, msg := range m.TileAggregations {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintNamespace(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -1032,6 +1084,35 @@ func (m *ExtendedOptions) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TileAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TileAggregation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetNamespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(len(m.TargetNamespace)))
+		i += copy(dAtA[i:], m.TargetNamespace)
+	}
+	if m.StepNanos != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.StepNanos))
+	}
+	return i, nil
+}
+
 func encodeVarintNamespace(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1148,6 +1229,12 @@ func (m *AggregationOptions) Size() (n int) {
 			n += 1 + l + sovNamespace(uint64(l))
 		}
 	}
+	if len(m.TileAggregations) > 0 {
+		for _, e := range m.TileAggregations {
+			l = e.Size()
+			n += 1 + l + sovNamespace(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1242,6 +1329,19 @@ func (m *ExtendedOptions) Size() (n int) {
 	return n
 }
 
+func (m *TileAggregation) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TargetNamespace)
+	if l > 0 {
+		n += 1 + l + sovNamespace(uint64(l))
+	}
+	if m.StepNanos != 0 {
+		n += 1 + sovNamespace(uint64(m.StepNanos))
+	}
+	return n
+}
+
 func sovNamespace(x uint64) (n int) {
 	for {
 		n++
@@ -2042,6 +2142,37 @@ func (m *AggregationOptions) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TileAggregations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TileAggregations = append(m.TileAggregations, &TileAggregation{})
+			if err := m.TileAggregations[len(m.TileAggregations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNamespace(dAtA[iNdEx:])
@@ -2808,6 +2939,104 @@ func (m *ExtendedOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TileAggregation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TileAggregation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TileAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepNanos", wireType)
+			}
+			m.StepNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNamespace(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2918,68 +3147,71 @@ func init() {
 }
 
 var fileDescriptorNamespace = []byte{
-	// 1001 bytes of a gzipped FileDescriptorProto
+	// 1048 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0x80, 0xbb, 0xf9, 0x73, 0x72, 0xec, 0x24, 0xce, 0xa8, 0x10, 0x2b, 0x2d, 0xa6, 0x5a, 0x7e,
-	0x14, 0x55, 0xc8, 0xa6, 0xc9, 0x0d, 0x14, 0xa9, 0xe0, 0x24, 0x26, 0x72, 0x29, 0x8e, 0x35, 0x69,
-	0x29, 0xe4, 0x6e, 0x76, 0xf7, 0x78, 0xb3, 0xea, 0x7a, 0x66, 0x35, 0x33, 0xdb, 0x24, 0x3c, 0x43,
-	0x2f, 0x78, 0x0f, 0x5e, 0x84, 0x4b, 0x1e, 0x01, 0x05, 0x21, 0x71, 0xc3, 0x3b, 0xa0, 0x9d, 0xf5,
-	0x3a, 0xfb, 0xe3, 0x96, 0x88, 0x9b, 0x68, 0x72, 0xce, 0x77, 0x7e, 0xe6, 0xfc, 0xcc, 0x1a, 0x8e,
-	0xfd, 0x40, 0x9f, 0xc7, 0x4e, 0xc7, 0x15, 0x93, 0xee, 0x64, 0xdf, 0x73, 0xba, 0x93, 0xfd, 0xae,
-	0x92, 0x6e, 0xd7, 0x73, 0xb8, 0xf0, 0xb0, 0xeb, 0x23, 0x47, 0xc9, 0x34, 0x7a, 0xdd, 0x48, 0x0a,
-	0x2d, 0xba, 0x9c, 0x4d, 0x50, 0x45, 0xcc, 0xc5, 0x9b, 0x53, 0xc7, 0x68, 0xc8, 0xda, 0x4c, 0xb0,
-	0x73, 0xdf, 0x17, 0xc2, 0x0f, 0x31, 0x35, 0x71, 0xe2, 0x71, 0x57, 0x69, 0x19, 0xbb, 0x3a, 0x05,
-	0x77, 0xda, 0x65, 0xed, 0x85, 0x64, 0x51, 0x84, 0x52, 0x4d, 0xf5, 0x47, 0xff, 0x37, 0x23, 0xe5,
-	0x9e, 0xe3, 0x84, 0xa5, 0x5e, 0xec, 0x37, 0x8b, 0xd0, 0xa4, 0xa8, 0x91, 0xeb, 0x40, 0xf0, 0x93,
-	0x28, 0xf9, 0xab, 0xc8, 0x1e, 0xdc, 0x95, 0x99, 0x6c, 0x84, 0x32, 0x10, 0xde, 0x90, 0x71, 0xa1,
-	0x5a, 0xd6, 0x03, 0x6b, 0x77, 0x91, 0xce, 0xd5, 0x91, 0x4f, 0x61, 0xc3, 0x09, 0x85, 0xfb, 0xea,
-	0x34, 0xf8, 0x19, 0x53, 0x7a, 0xc1, 0xd0, 0x25, 0x29, 0xf9, 0x0c, 0xb6, 0x9c, 0x78, 0x3c, 0x46,
-	0xf9, 0x6d, 0xac, 0x63, 0x39, 0x45, 0x17, 0x0d, 0x5a, 0x55, 0x90, 0x5d, 0xd8, 0x4c, 0x85, 0x23,
-	0xa6, 0x74, 0xca, 0x2e, 0x19, 0xb6, 0x2c, 0x36, 0x64, 0x12, 0xe9, 0x88, 0x69, 0xd6, 0xbf, 0x8c,
-	0x02, 0x79, 0xd5, 0x5a, 0x7e, 0x60, 0xed, 0xae, 0xd2, 0xb2, 0x98, 0x9c, 0xc1, 0x6e, 0x49, 0xd4,
-	0x1b, 0x6b, 0x94, 0x43, 0xa1, 0x7b, 0xae, 0x8b, 0x4a, 0xe5, 0x6f, 0xbc, 0x62, 0x82, 0xdd, 0x9a,
-	0x27, 0x4f, 0x60, 0x67, 0x6c, 0xd2, 0xa7, 0xf3, 0xea, 0x57, 0x33, 0xde, 0xde, 0x41, 0xd8, 0x23,
-	0x68, 0x0c, 0xb8, 0x87, 0x97, 0x59, 0x27, 0x5a, 0x50, 0x43, 0xce, 0x9c, 0x10, 0x3d, 0x53, 0xfc,
-	0x55, 0x9a, 0xfd, 0x7b, 0xdb, 0x7a, 0xdb, 0xff, 0xac, 0x40, 0x73, 0x98, 0xf5, 0x3e, 0x73, 0xfb,
-	0x10, 0x9a, 0x8e, 0x10, 0x5a, 0x69, 0xc9, 0xa2, 0x7e, 0xc1, 0x7f, 0x45, 0x4e, 0x6c, 0x68, 0x8c,
-	0xc3, 0x58, 0x9d, 0x67, 0xdc, 0x82, 0xe1, 0x0a, 0xb2, 0xa4, 0xa9, 0x17, 0x32, 0xd0, 0xa8, 0x9e,
-	0x8b, 0x43, 0x31, 0x99, 0x04, 0xfa, 0x99, 0xf0, 0x4d, 0x53, 0x57, 0x69, 0x55, 0x91, 0xa4, 0xee,
-	0x86, 0xc8, 0x78, 0x3c, 0x8b, 0xbd, 0x64, 0xd0, 0x92, 0x94, 0x7c, 0x0c, 0xeb, 0x12, 0x23, 0x16,
-	0xc8, 0x0c, 0x4b, 0x1b, 0x5a, 0x14, 0x92, 0x63, 0x68, 0xca, 0xd2, 0x00, 0x9b, 0xb6, 0xd5, 0xf7,
-	0xee, 0x75, 0x6e, 0x96, 0xaf, 0x3c, 0xe3, 0xb4, 0x62, 0x94, 0x4c, 0x90, 0xe2, 0x2c, 0x52, 0xe7,
-	0x42, 0x67, 0x01, 0x6b, 0xe9, 0x04, 0x95, 0xc4, 0xe4, 0x2b, 0x68, 0x04, 0xb9, 0x2e, 0xb5, 0x56,
-	0x4d, 0xb8, 0xed, 0x5c, 0xb8, 0x7c, 0x13, 0x69, 0x01, 0x26, 0x4f, 0x60, 0x3d, 0xdd, 0xc0, 0xcc,
-	0x7a, 0xcd, 0x58, 0xb7, 0x72, 0xd6, 0xa7, 0x79, 0x3d, 0x2d, 0xe2, 0x49, 0xad, 0x5d, 0x11, 0x7a,
-	0x2f, 0x4d, 0x59, 0xb3, 0x44, 0x21, 0xad, 0x75, 0x45, 0x41, 0x9e, 0xc2, 0x86, 0x8c, 0xb9, 0x0e,
-	0x26, 0x59, 0xef, 0x5b, 0x75, 0x13, 0xce, 0xce, 0x85, 0x9b, 0x8d, 0x07, 0x2d, 0x90, 0xb4, 0x64,
-	0x49, 0x46, 0xf0, 0x9e, 0xcb, 0xdc, 0x73, 0x3c, 0x48, 0x26, 0x4c, 0x9d, 0x70, 0x8a, 0x5a, 0x06,
-	0xf8, 0x1a, 0x5b, 0x0d, 0xe3, 0x72, 0xa7, 0x93, 0xbe, 0x58, 0x9d, 0xec, 0xc5, 0xea, 0x1c, 0x08,
-	0x11, 0xfe, 0xc0, 0xc2, 0x18, 0xe9, 0x7c, 0x43, 0xf2, 0x3d, 0x10, 0xe6, 0xfb, 0x12, 0x7d, 0x96,
-	0xef, 0xde, 0xba, 0x71, 0xf7, 0x41, 0x2e, 0xc3, 0x5e, 0x05, 0xa2, 0x73, 0x0c, 0x93, 0xbe, 0x28,
-	0xcd, 0xfc, 0x80, 0xfb, 0xa7, 0x9a, 0x69, 0x6c, 0x6d, 0x54, 0xfa, 0x72, 0x9a, 0x53, 0xd3, 0x02,
-	0x4c, 0xfa, 0xb0, 0x89, 0x97, 0x1a, 0xb9, 0x87, 0x5e, 0x96, 0xc8, 0xdf, 0xb5, 0xe9, 0xc5, 0x6e,
-	0x1c, 0xf4, 0x8b, 0x08, 0x2d, 0xdb, 0xd8, 0x23, 0x20, 0xd5, 0x6c, 0xc9, 0x63, 0x68, 0xe4, 0xf2,
-	0x4d, 0x5e, 0xd2, 0xc5, 0xdd, 0xfa, 0xde, 0xfb, 0xf3, 0xaf, 0x48, 0x0b, 0xac, 0xcd, 0xa1, 0x9e,
-	0x53, 0x92, 0x36, 0x40, 0xa6, 0x9e, 0x6d, 0x6d, 0x4e, 0x42, 0xbe, 0x06, 0x60, 0x5a, 0xcb, 0xc0,
-	0x89, 0x35, 0xa6, 0x8f, 0x42, 0x7d, 0xef, 0xc3, 0x39, 0x81, 0xd0, 0xeb, 0xcd, 0x30, 0x9a, 0x33,
-	0xb1, 0xdf, 0x58, 0x70, 0x77, 0x1e, 0x94, 0x2c, 0x88, 0x44, 0x25, 0xc2, 0x38, 0xc9, 0x23, 0xff,
-	0x45, 0x28, 0x8b, 0xc9, 0x53, 0xd8, 0xf2, 0xc4, 0x05, 0x57, 0x6c, 0x12, 0x85, 0xb3, 0xc1, 0x4b,
-	0x53, 0xb9, 0x9f, 0x4b, 0xe5, 0xa8, 0xcc, 0xd0, 0xaa, 0x99, 0xfd, 0x09, 0x6c, 0x55, 0x38, 0xd2,
-	0x84, 0x45, 0x16, 0x86, 0xd3, 0xdb, 0x27, 0x47, 0xfb, 0x1b, 0x68, 0xe4, 0x9b, 0x4b, 0x3e, 0x87,
-	0x15, 0xa5, 0x99, 0x8e, 0xd3, 0x1c, 0x37, 0x8a, 0xfb, 0x75, 0x03, 0xc6, 0x8a, 0x4e, 0x39, 0xfb,
-	0x57, 0x0b, 0x56, 0x29, 0xfa, 0x81, 0xd2, 0xf2, 0x8a, 0x1c, 0x02, 0xcc, 0xf8, 0xac, 0x5d, 0x1f,
-	0x15, 0xde, 0x93, 0x14, 0xbc, 0x59, 0x1e, 0xd5, 0xe7, 0x5a, 0x5e, 0xd1, 0x9c, 0xd9, 0xce, 0x19,
-	0x6c, 0x96, 0xd4, 0x49, 0xe2, 0xaf, 0xf0, 0xca, 0xe4, 0xb4, 0x46, 0x93, 0x23, 0x79, 0x04, 0xcb,
-	0xaf, 0x93, 0x1d, 0x99, 0xd6, 0xe7, 0xde, 0xbc, 0xc5, 0xcc, 0xca, 0x93, 0x92, 0x8f, 0x17, 0xbe,
-	0xb0, 0xec, 0xbf, 0x2c, 0xd8, 0x7e, 0xcb, 0xe2, 0x12, 0x0f, 0xda, 0xe6, 0xd5, 0x35, 0xaf, 0x50,
-	0xc0, 0xfd, 0x11, 0xca, 0xc3, 0xd1, 0x8b, 0x43, 0xc1, 0xdd, 0x58, 0x4a, 0xe4, 0x6e, 0x1a, 0x3f,
-	0xe9, 0x45, 0x79, 0x63, 0x8f, 0x44, 0xec, 0x84, 0x98, 0xee, 0xec, 0x7f, 0xf8, 0x48, 0xa2, 0x98,
-	0x8f, 0xc0, 0xdb, 0xa3, 0x2c, 0xdc, 0x26, 0xca, 0xbb, 0x7d, 0xd8, 0x3f, 0xc2, 0x66, 0x69, 0xe7,
-	0x08, 0x81, 0x25, 0x7d, 0x15, 0xe1, 0xb4, 0x88, 0xe6, 0x4c, 0x1e, 0x41, 0x4d, 0x14, 0xe6, 0x6c,
-	0xbb, 0x12, 0xf5, 0xd4, 0xfc, 0xba, 0xa2, 0x19, 0xf7, 0xf0, 0x4b, 0x58, 0x2f, 0x0c, 0x02, 0xa9,
-	0x43, 0xed, 0xc5, 0xf0, 0xbb, 0xe1, 0xc9, 0xcb, 0x61, 0xf3, 0x0e, 0x69, 0x42, 0x63, 0x30, 0x1c,
-	0x3c, 0x1f, 0xf4, 0x9e, 0x0d, 0xce, 0x06, 0xc3, 0xe3, 0xa6, 0x45, 0xd6, 0x60, 0x99, 0xf6, 0x7b,
-	0x47, 0x3f, 0x35, 0x17, 0x0e, 0x9a, 0xbf, 0x5d, 0xb7, 0xad, 0xdf, 0xaf, 0xdb, 0xd6, 0x1f, 0xd7,
-	0x6d, 0xeb, 0x97, 0x3f, 0xdb, 0x77, 0x9c, 0x15, 0x13, 0x66, 0xff, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x48, 0x8b, 0xb8, 0x2d, 0x28, 0x0a, 0x00, 0x00,
+	0x14, 0x80, 0xbb, 0x76, 0x1b, 0x27, 0xc7, 0x4e, 0xe2, 0x8c, 0x0a, 0xb1, 0x42, 0x30, 0xd1, 0xf2,
+	0xa3, 0xa8, 0x42, 0x36, 0x4d, 0x6e, 0xa0, 0x48, 0x05, 0x27, 0x71, 0x23, 0x97, 0xe2, 0x58, 0x93,
+	0x94, 0xd2, 0xdc, 0xcd, 0xee, 0x1e, 0x6f, 0x56, 0x5d, 0xef, 0xac, 0x66, 0x66, 0x9b, 0x84, 0x67,
+	0xe8, 0x05, 0x97, 0xbc, 0x03, 0x2f, 0xc2, 0x25, 0x8f, 0x80, 0x82, 0x90, 0xb8, 0xe1, 0x1d, 0xd0,
+	0xce, 0x7a, 0xed, 0xfd, 0x71, 0x4b, 0xc4, 0x4d, 0xb4, 0x3e, 0xe7, 0x3b, 0x3f, 0x33, 0xe7, 0x67,
+	0x02, 0xc7, 0xae, 0xa7, 0x2e, 0x22, 0xab, 0x63, 0xf3, 0x49, 0x77, 0xb2, 0xef, 0x58, 0xdd, 0xc9,
+	0x7e, 0x57, 0x0a, 0xbb, 0xeb, 0x58, 0x01, 0x77, 0xb0, 0xeb, 0x62, 0x80, 0x82, 0x29, 0x74, 0xba,
+	0xa1, 0xe0, 0x8a, 0x77, 0x03, 0x36, 0x41, 0x19, 0x32, 0x1b, 0xe7, 0x5f, 0x1d, 0xad, 0x21, 0x2b,
+	0x33, 0xc1, 0xd6, 0xb6, 0xcb, 0xb9, 0xeb, 0x63, 0x62, 0x62, 0x45, 0xe3, 0xae, 0x54, 0x22, 0xb2,
+	0x55, 0x02, 0x6e, 0xb5, 0x8b, 0xda, 0x4b, 0xc1, 0xc2, 0x10, 0x85, 0x9c, 0xea, 0x8f, 0xfe, 0x6f,
+	0x46, 0xd2, 0xbe, 0xc0, 0x09, 0x4b, 0xbc, 0x98, 0x6f, 0xaa, 0xd0, 0xa4, 0xa8, 0x30, 0x50, 0x1e,
+	0x0f, 0x4e, 0xc2, 0xf8, 0xaf, 0x24, 0x7b, 0x70, 0x5f, 0xa4, 0xb2, 0x11, 0x0a, 0x8f, 0x3b, 0x43,
+	0x16, 0x70, 0xd9, 0x32, 0x76, 0x8c, 0xdd, 0x2a, 0x5d, 0xa8, 0x23, 0x9f, 0xc1, 0x9a, 0xe5, 0x73,
+	0xfb, 0xd5, 0xa9, 0xf7, 0x13, 0x26, 0x74, 0x45, 0xd3, 0x05, 0x29, 0xf9, 0x1c, 0x36, 0xac, 0x68,
+	0x3c, 0x46, 0xf1, 0x24, 0x52, 0x91, 0x98, 0xa2, 0x55, 0x8d, 0x96, 0x15, 0x64, 0x17, 0xd6, 0x13,
+	0xe1, 0x88, 0x49, 0x95, 0xb0, 0x77, 0x35, 0x5b, 0x14, 0x6b, 0x32, 0x8e, 0x74, 0xc4, 0x14, 0xeb,
+	0x5f, 0x85, 0x9e, 0xb8, 0x6e, 0xdd, 0xdb, 0x31, 0x76, 0x97, 0x69, 0x51, 0x4c, 0xce, 0x61, 0xb7,
+	0x20, 0xea, 0x8d, 0x15, 0x8a, 0x21, 0x57, 0x3d, 0xdb, 0x46, 0x29, 0xb3, 0x27, 0x5e, 0xd2, 0xc1,
+	0x6e, 0xcd, 0x93, 0xc7, 0xb0, 0x35, 0xd6, 0xe9, 0xd3, 0x45, 0xf7, 0x57, 0xd3, 0xde, 0xde, 0x41,
+	0x98, 0x23, 0x68, 0x0c, 0x02, 0x07, 0xaf, 0xd2, 0x4a, 0xb4, 0xa0, 0x86, 0x01, 0xb3, 0x7c, 0x74,
+	0xf4, 0xe5, 0x2f, 0xd3, 0xf4, 0xe7, 0x6d, 0xef, 0xdb, 0xfc, 0x67, 0x09, 0x9a, 0xc3, 0xb4, 0xf6,
+	0xa9, 0xdb, 0x07, 0xd0, 0xb4, 0x38, 0x57, 0x52, 0x09, 0x16, 0xf6, 0x73, 0xfe, 0x4b, 0x72, 0x62,
+	0x42, 0x63, 0xec, 0x47, 0xf2, 0x22, 0xe5, 0x2a, 0x9a, 0xcb, 0xc9, 0xe2, 0xa2, 0x5e, 0x0a, 0x4f,
+	0xa1, 0x3c, 0xe3, 0x87, 0x7c, 0x32, 0xf1, 0xd4, 0x33, 0xee, 0xea, 0xa2, 0x2e, 0xd3, 0xb2, 0x22,
+	0x4e, 0xdd, 0xf6, 0x91, 0x05, 0xd1, 0x2c, 0xf6, 0x5d, 0x8d, 0x16, 0xa4, 0xe4, 0x13, 0x58, 0x15,
+	0x18, 0x32, 0x4f, 0xa4, 0x58, 0x52, 0xd0, 0xbc, 0x90, 0x1c, 0x43, 0x53, 0x14, 0x1a, 0x58, 0x97,
+	0xad, 0xbe, 0xf7, 0x41, 0x67, 0x3e, 0x7c, 0xc5, 0x1e, 0xa7, 0x25, 0xa3, 0xb8, 0x83, 0x64, 0xc0,
+	0x42, 0x79, 0xc1, 0x55, 0x1a, 0xb0, 0x96, 0x74, 0x50, 0x41, 0x4c, 0xbe, 0x86, 0x86, 0x97, 0xa9,
+	0x52, 0x6b, 0x59, 0x87, 0xdb, 0xcc, 0x84, 0xcb, 0x16, 0x91, 0xe6, 0x60, 0xf2, 0x18, 0x56, 0x93,
+	0x09, 0x4c, 0xad, 0x57, 0xb4, 0x75, 0x2b, 0x63, 0x7d, 0x9a, 0xd5, 0xd3, 0x3c, 0x1e, 0xdf, 0xb5,
+	0xcd, 0x7d, 0xe7, 0x85, 0xbe, 0xd6, 0x34, 0x51, 0x48, 0xee, 0xba, 0xa4, 0x20, 0x4f, 0x61, 0x4d,
+	0x44, 0x81, 0xf2, 0x26, 0x69, 0xed, 0x5b, 0x75, 0x1d, 0xce, 0xcc, 0x84, 0x9b, 0xb5, 0x07, 0xcd,
+	0x91, 0xb4, 0x60, 0x49, 0x46, 0xf0, 0x9e, 0xcd, 0xec, 0x0b, 0x3c, 0x88, 0x3b, 0x4c, 0x9e, 0x04,
+	0x14, 0x95, 0xf0, 0xf0, 0x35, 0xb6, 0x1a, 0xda, 0xe5, 0x56, 0x27, 0xd9, 0x58, 0x9d, 0x74, 0x63,
+	0x75, 0x0e, 0x38, 0xf7, 0x7f, 0x60, 0x7e, 0x84, 0x74, 0xb1, 0x21, 0xf9, 0x1e, 0x08, 0x73, 0x5d,
+	0x81, 0x2e, 0xcb, 0x56, 0x6f, 0x55, 0xbb, 0xfb, 0x30, 0x93, 0x61, 0xaf, 0x04, 0xd1, 0x05, 0x86,
+	0x71, 0x5d, 0xa4, 0x62, 0xae, 0x17, 0xb8, 0xa7, 0x8a, 0x29, 0x6c, 0xad, 0x95, 0xea, 0x72, 0x9a,
+	0x51, 0xd3, 0x1c, 0x4c, 0xfa, 0xb0, 0x8e, 0x57, 0x0a, 0x03, 0x07, 0x9d, 0x34, 0x91, 0xbf, 0x6b,
+	0xd3, 0x83, 0xcd, 0x1d, 0xf4, 0xf3, 0x08, 0x2d, 0xda, 0x98, 0xbf, 0x18, 0x40, 0xca, 0xe9, 0x92,
+	0x47, 0xd0, 0xc8, 0x24, 0x1c, 0xaf, 0xd2, 0xea, 0x6e, 0x7d, 0xef, 0xfd, 0xc5, 0x67, 0xa4, 0x39,
+	0x96, 0x3c, 0x81, 0xa6, 0xf2, 0x7c, 0xec, 0x65, 0xed, 0x2b, 0x3b, 0xd5, 0x42, 0x66, 0x67, 0x79,
+	0x84, 0x96, 0x6c, 0xcc, 0x00, 0xea, 0x99, 0xdf, 0xa4, 0x0d, 0x90, 0x86, 0x99, 0x8d, 0x7f, 0x46,
+	0x42, 0xbe, 0x01, 0x60, 0x4a, 0x09, 0xcf, 0x8a, 0x14, 0x26, 0xdb, 0xa5, 0xbe, 0xf7, 0xd1, 0x82,
+	0x84, 0xd1, 0xe9, 0xcd, 0x30, 0x9a, 0x31, 0x31, 0xdf, 0x18, 0x70, 0x7f, 0x11, 0x14, 0x4f, 0x9a,
+	0x40, 0xc9, 0xfd, 0x28, 0xce, 0x23, 0xfb, 0xb4, 0x14, 0xc5, 0xe4, 0x29, 0x6c, 0x38, 0xfc, 0x32,
+	0x90, 0x6c, 0x12, 0xfa, 0xb3, 0x0e, 0x4e, 0x52, 0xd9, 0xce, 0xa4, 0x72, 0x54, 0x64, 0x68, 0xd9,
+	0xcc, 0xfc, 0x14, 0x36, 0x4a, 0x1c, 0x69, 0x42, 0x95, 0xf9, 0xfe, 0xf4, 0xf4, 0xf1, 0xa7, 0xf9,
+	0x2d, 0x34, 0xb2, 0x5d, 0x42, 0xbe, 0x80, 0x25, 0xa9, 0x98, 0x8a, 0x92, 0x1c, 0xd7, 0xf2, 0x83,
+	0x3a, 0x07, 0x23, 0x49, 0xa7, 0x9c, 0xf9, 0xab, 0x01, 0xcb, 0x14, 0x5d, 0x4f, 0x2a, 0x71, 0x4d,
+	0x0e, 0x01, 0x66, 0x7c, 0x5a, 0xf6, 0x8f, 0x73, 0x8b, 0x29, 0x01, 0xe7, 0x53, 0x28, 0xfb, 0x81,
+	0x12, 0xd7, 0x34, 0x63, 0xb6, 0x75, 0x0e, 0xeb, 0x05, 0x75, 0x9c, 0xf8, 0x2b, 0xbc, 0xd6, 0x39,
+	0xad, 0xd0, 0xf8, 0x93, 0x3c, 0x84, 0x7b, 0xaf, 0xe3, 0x61, 0x6b, 0x55, 0x4a, 0xdb, 0xaf, 0xf8,
+	0x00, 0xd0, 0x84, 0x7c, 0x54, 0xf9, 0xd2, 0x30, 0xff, 0x32, 0x60, 0xf3, 0x2d, 0x1b, 0x80, 0x38,
+	0xd0, 0xd6, 0xeb, 0x5b, 0xaf, 0x33, 0x2f, 0x70, 0x47, 0x28, 0x0e, 0x47, 0xcf, 0x0f, 0x79, 0x60,
+	0x47, 0x42, 0x60, 0x60, 0x27, 0xf1, 0xe3, 0x5a, 0x14, 0x47, 0xff, 0x88, 0x47, 0x96, 0x8f, 0xc9,
+	0xf0, 0xff, 0x87, 0x8f, 0x38, 0x8a, 0x7e, 0x4d, 0xde, 0x1e, 0xa5, 0x72, 0x9b, 0x28, 0xef, 0xf6,
+	0x61, 0xfe, 0x08, 0xeb, 0x85, 0xe1, 0x25, 0x04, 0xee, 0xaa, 0xeb, 0x10, 0xa7, 0x97, 0xa8, 0xbf,
+	0xc9, 0x43, 0xa8, 0xf1, 0x5c, 0x9f, 0x6d, 0x96, 0xa2, 0x9e, 0xea, 0x7f, 0xd3, 0x68, 0xca, 0x99,
+	0x2f, 0x61, 0xbd, 0x30, 0x7c, 0x71, 0x87, 0x2b, 0x26, 0x5c, 0x54, 0xb3, 0x9b, 0x9d, 0x06, 0x29,
+	0x8a, 0xc9, 0x36, 0xac, 0x48, 0x85, 0x61, 0xf6, 0x09, 0x9f, 0x0b, 0x1e, 0x7c, 0x05, 0xab, 0xb9,
+	0x1e, 0x23, 0x75, 0xa8, 0x3d, 0x1f, 0x7e, 0x37, 0x3c, 0x79, 0x31, 0x6c, 0xde, 0x21, 0x4d, 0x68,
+	0x0c, 0x86, 0x83, 0xb3, 0x41, 0xef, 0xd9, 0xe0, 0x7c, 0x30, 0x3c, 0x6e, 0x1a, 0x64, 0x05, 0xee,
+	0xd1, 0x7e, 0xef, 0xe8, 0x65, 0xb3, 0x72, 0xd0, 0xfc, 0xed, 0xa6, 0x6d, 0xfc, 0x7e, 0xd3, 0x36,
+	0xfe, 0xb8, 0x69, 0x1b, 0x3f, 0xff, 0xd9, 0xbe, 0x63, 0x2d, 0xe9, 0x13, 0xec, 0xff, 0x3b, 0x00,
+	0xc3, 0xf0, 0x38, 0xaa, 0xcc, 0x0a, 0x00, 0x00,
 }
@@ -62,15 +62,20 @@ service Node {
 	FetchBatchRawResult            fetchBatchRawV2(1: FetchBatchRawV2Request req) throws (1: Error err)
 	FetchBlocksRawResult           fetchBlocksRaw(1: FetchBlocksRawRequest req) throws (1: Error err)
 	FetchTaggedResult              fetchTagged(1: FetchTaggedRequest req) throws (1: Error err)
+	FetchTaggedStreamResult        fetchTaggedStream(1: FetchTaggedStreamRequest req) throws (1: Error err)
 	FetchBlocksMetadataRawV2Result fetchBlocksMetadataRawV2(1: FetchBlocksMetadataRawV2Request req) throws (1: Error err)
+	FetchBlocksMerkleTreeRawResult fetchBlocksMerkleTreeRaw(1: FetchBlocksMerkleTreeRawRequest req) throws (1: Error err)
+	CardinalityRawResult           cardinalityRaw(1: CardinalityRawRequest req) throws (1: Error err)
 	void                           writeBatchRaw(1: WriteBatchRawRequest req) throws (1: WriteBatchRawErrors err)
 	void                           writeBatchRawV2(1: WriteBatchRawV2Request req) throws (1: WriteBatchRawErrors err)
 	void                           writeTaggedBatchRaw(1: WriteTaggedBatchRawRequest req) throws (1: WriteBatchRawErrors err)
 	void                           writeTaggedBatchRawV2(1: WriteTaggedBatchRawV2Request req) throws (1: WriteBatchRawErrors err)
 	void                           repair() throws (1: Error err)
 	TruncateResult                 truncate(1: TruncateRequest req) throws (1: Error err)
+	DeleteTaggedResult             deleteTagged(1: DeleteTaggedRequest req) throws (1: Error err)
 
 	AggregateTilesResult aggregateTiles(1: AggregateTilesRequest req) throws (1: Error err)
+	AggregateTilesStatusResult aggregateTilesStatus() throws (1: Error err)
 
 	// Management endpoints
 	NodeHealthResult                               health() throws (1: Error err)
@@ -194,6 +199,25 @@ struct FetchTaggedResult {
 	4: optional i64 waitedSeriesRead
 }
 
+// FetchTaggedStreamRequest starts a stream with the fetch request when set,
+// otherwise continues or cancels the stream with the given stream ID.
+struct FetchTaggedStreamRequest {
+	1: required i64 batchSize
+	2: optional FetchTaggedRequest fetch
+	3: optional binary streamID
+	4: optional bool cancel
+}
+
+// FetchTaggedStreamResult is a batch of a stream, the stream ID is only set
+// if more batches remain. Series are streamed in the order of their IDs.
+struct FetchTaggedStreamResult {
+	1: required list<FetchTaggedIDResult> elements
+	2: required bool exhaustive
+	3: optional binary streamID
+	4: optional i64 waitedIndex
+	5: optional i64 waitedSeriesRead
+}
+
 struct FetchTaggedIDResult {
 	1: required binary id
 	2: required binary nameSpace
@@ -265,6 +289,23 @@ struct BlockMetadataV2 {
 	8: optional binary encodedTags
 }
 
+struct FetchBlocksMerkleTreeRawRequest {
+	1: required binary nameSpace
+	2: required i32 shard
+	3: required i64 rangeStart
+	4: required i64 rangeEnd
+	5: required i32 depth
+	6: required list<i32> leaves
+	7: required i64 limit
+	8: optional binary pageToken
+}
+
+struct FetchBlocksMerkleTreeRawResult {
+	1: optional list<i64> digests
+	2: required list<BlockMetadataV2> elements
+	3: optional binary nextPageToken
+}
+
 struct WriteBatchRawRequest {
 	1: required binary nameSpace
 	2: required list<WriteBatchRawRequestElement> elements
@@ -322,6 +363,18 @@ struct TruncateResult {
 	1: required i64 numSeries
 }
 
+struct DeleteTaggedRequest {
+	1: required binary nameSpace
+	2: required binary query
+	3: required i64 rangeStart
+	4: required i64 rangeEnd
+	5: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
+}
+
+struct DeleteTaggedResult {
+	1: required i64 numSeries
+}
+
 struct NodeHealthResult {
 	1: required bool ok
 	2: required string status
@@ -427,6 +480,31 @@ struct AggregateQueryRawResultTagValueElement {
 	1: required binary tagValue
 }
 
+struct CardinalityRawRequest {
+	1: required binary nameSpace
+	2: required binary query
+	3: required i64 rangeStart
+	4: required i64 rangeEnd
+	5: optional i64 limit
+}
+
+struct CardinalityRawResult {
+	1: required i64 numSeries
+	2: required list<CardinalityRawResultTagNameElement> results
+}
+
+struct CardinalityRawResultTagNameElement {
+	1: required binary tagName
+	2: required list<CardinalityRawResultTagValueElement> tagValues
+	3: optional i64 truncatedTagValues
+	4: optional i64 truncatedTagValuesBytes
+}
+
+struct CardinalityRawResultTagValueElement {
+	1: required binary tagValue
+	2: required i64 seriesCount
+}
+
 // AggregateQueryRequest is identical to AggregateQueryRawRequest save for using string instead of binary for types.
 struct AggregateQueryRequest {
 	1: optional Query query
@@ -560,6 +638,20 @@ struct AggregateTilesResult {
 	1: required i64 processedTileCount
 }
 
+struct AggregateTilesStatusResult {
+	1: required list<AggregateTilesStatus> statuses
+}
+
+struct AggregateTilesStatus {
+	1: required string sourceNamespace
+	2: required string targetNamespace
+	3: required string step
+	4: required i64 aggregatedUntil
+	5: required i64 processedTileCount
+	6: required i64 lastRunAt
+	7: optional string lastError
+}
+
 struct DebugProfileStartRequest {
 	1: required string name
 	2: required string filePathTemplate
@@ -4196,65 +4196,66 @@ func (p *FetchTaggedResult_) String() string {
 }
 
 // Attributes:
-//  - ID
-//  - NameSpace
-//  - EncodedTags
-//  - Segments
-//  - Err
-type FetchTaggedIDResult_ struct {
-	ID          []byte      `thrift:"id,1,required" db:"id" json:"id"`
-	NameSpace   []byte      `thrift:"nameSpace,2,required" db:"nameSpace" json:"nameSpace"`
-	EncodedTags []byte      `thrift:"encodedTags,3,required" db:"encodedTags" json:"encodedTags"`
-	Segments    []*Segments `thrift:"segments,4" db:"segments" json:"segments,omitempty"`
-	Err         *Error      `thrift:"err,5" db:"err" json:"err,omitempty"`
+//  - BatchSize
+//  - Fetch
+//  - StreamID
+//  - Cancel
+type FetchTaggedStreamRequest struct {
+	BatchSize int64               `thrift:"batchSize,1,required" db:"batchSize" json:"batchSize"`
+	Fetch     *FetchTaggedRequest `thrift:"fetch,2" db:"fetch" json:"fetch,omitempty"`
+	StreamID  []byte              `thrift:"streamID,3" db:"streamID" json:"streamID,omitempty"`
+	Cancel    *bool               `thrift:"cancel,4" db:"cancel" json:"cancel,omitempty"`
 }
 
-func NewFetchTaggedIDResult_() *FetchTaggedIDResult_ {
-	return &FetchTaggedIDResult_{}
+func NewFetchTaggedStreamRequest() *FetchTaggedStreamRequest {
+	return &FetchTaggedStreamRequest{}
 }
 
-func (p *FetchTaggedIDResult_) GetID() []byte {
-	return p.ID
+func (p *FetchTaggedStreamRequest) GetBatchSize() int64 {
+	return p.BatchSize
 }
 
-func (p *FetchTaggedIDResult_) GetNameSpace() []byte {
-	return p.NameSpace
-}
+var FetchTaggedStreamRequest_Fetch_DEFAULT *FetchTaggedRequest
 
-func (p *FetchTaggedIDResult_) GetEncodedTags() []byte {
-	return p.EncodedTags
+func (p *FetchTaggedStreamRequest) GetFetch() *FetchTaggedRequest {
+	if !p.IsSetFetch() {
+		return FetchTaggedStreamRequest_Fetch_DEFAULT
+	}
+	return p.Fetch
 }
 
-var FetchTaggedIDResult__Segments_DEFAULT []*Segments
+var FetchTaggedStreamRequest_StreamID_DEFAULT []byte
 
-func (p *FetchTaggedIDResult_) GetSegments() []*Segments {
-	return p.Segments
+func (p *FetchTaggedStreamRequest) GetStreamID() []byte {
+	return p.StreamID
 }
 
-var FetchTaggedIDResult__Err_DEFAULT *Error
+var FetchTaggedStreamRequest_Cancel_DEFAULT bool
 
-func (p *FetchTaggedIDResult_) GetErr() *Error {
-	if !p.IsSetErr() {
-		return FetchTaggedIDResult__Err_DEFAULT
+func (p *FetchTaggedStreamRequest) GetCancel() bool {
+	if !p.IsSetCancel() {
+		return FetchTaggedStreamRequest_Cancel_DEFAULT
 	}
-	return p.Err
+	return *p.Cancel
 }
-func (p *FetchTaggedIDResult_) IsSetSegments() bool {
-	return p.Segments != nil
+func (p *FetchTaggedStreamRequest) IsSetFetch() bool {
+	return p.Fetch != nil
 }
 
-func (p *FetchTaggedIDResult_) IsSetErr() bool {
-	return p.Err != nil
+func (p *FetchTaggedStreamRequest) IsSetStreamID() bool {
+	return p.StreamID != nil
 }
 
-func (p *FetchTaggedIDResult_) Read(iprot thrift.TProtocol) error {
+func (p *FetchTaggedStreamRequest) IsSetCancel() bool {
+	return p.Cancel != nil
+}
+
+func (p *FetchTaggedStreamRequest) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetID bool = false
-	var issetNameSpace bool = false
-	var issetEncodedTags bool = false
+	var issetBatchSize bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -4269,25 +4270,19 @@ func (p *FetchTaggedIDResult_) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetID = true
+			issetBatchSize = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetNameSpace = true
 		case 3:
 			if err := p.ReadField3(iprot); err != nil {
 				return err
 			}
-			issetEncodedTags = true
 		case 4:
 			if err := p.ReadField4(iprot); err != nil {
 				return err
 			}
-		case 5:
-			if err := p.ReadField5(iprot); err != nil {
-				return err
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -4300,77 +4295,53 @@ func (p *FetchTaggedIDResult_) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetID {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
-	}
-	if !issetNameSpace {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
-	}
-	if !issetEncodedTags {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field EncodedTags is not set"))
+	if !issetBatchSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field BatchSize is not set"))
 	}
 	return nil
 }
 
-func (p *FetchTaggedIDResult_) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(); err != nil {
+func (p *FetchTaggedStreamRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.ID = v
+		p.BatchSize = v
 	}
 	return nil
 }
 
-func (p *FetchTaggedIDResult_) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.NameSpace = v
+func (p *FetchTaggedStreamRequest) ReadField2(iprot thrift.TProtocol) error {
+	p.Fetch = &FetchTaggedRequest{
+		RangeTimeType: 0,
+
+		RequireExhaustive: true,
+	}
+	if err := p.Fetch.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Fetch), err)
 	}
 	return nil
 }
 
-func (p *FetchTaggedIDResult_) ReadField3(iprot thrift.TProtocol) error {
+func (p *FetchTaggedStreamRequest) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.EncodedTags = v
-	}
-	return nil
-}
-
-func (p *FetchTaggedIDResult_) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*Segments, 0, size)
-	p.Segments = tSlice
-	for i := 0; i < size; i++ {
-		_elem10 := &Segments{}
-		if err := _elem10.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem10), err)
-		}
-		p.Segments = append(p.Segments, _elem10)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+		p.StreamID = v
 	}
 	return nil
 }
 
-func (p *FetchTaggedIDResult_) ReadField5(iprot thrift.TProtocol) error {
-	p.Err = &Error{
-		Type: 0,
-	}
-	if err := p.Err.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+func (p *FetchTaggedStreamRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Cancel = &v
 	}
 	return nil
 }
 
-func (p *FetchTaggedIDResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("FetchTaggedIDResult"); err != nil {
+func (p *FetchTaggedStreamRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("FetchTaggedStreamRequest"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -4386,9 +4357,6 @@ func (p *FetchTaggedIDResult_) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField4(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField5(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -4399,135 +4367,139 @@ func (p *FetchTaggedIDResult_) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *FetchTaggedIDResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
-	}
-	if err := oprot.WriteBinary(p.ID); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
-	}
-	return err
-}
-
-func (p *FetchTaggedIDResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("nameSpace", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:nameSpace: ", p), err)
+func (p *FetchTaggedStreamRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("batchSize", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:batchSize: ", p), err)
 	}
-	if err := oprot.WriteBinary(p.NameSpace); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (2) field write error: ", p), err)
+	if err := oprot.WriteI64(int64(p.BatchSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.batchSize (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:nameSpace: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:batchSize: ", p), err)
 	}
 	return err
 }
 
-func (p *FetchTaggedIDResult_) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("encodedTags", thrift.STRING, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:encodedTags: ", p), err)
-	}
-	if err := oprot.WriteBinary(p.EncodedTags); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.encodedTags (3) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:encodedTags: ", p), err)
+func (p *FetchTaggedStreamRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFetch() {
+		if err := oprot.WriteFieldBegin("fetch", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:fetch: ", p), err)
+		}
+		if err := p.Fetch.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Fetch), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:fetch: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *FetchTaggedIDResult_) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSegments() {
-		if err := oprot.WriteFieldBegin("segments", thrift.LIST, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:segments: ", p), err)
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Segments)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
-		}
-		for _, v := range p.Segments {
-			if err := v.Write(oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-			}
+func (p *FetchTaggedStreamRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStreamID() {
+		if err := oprot.WriteFieldBegin("streamID", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:streamID: ", p), err)
 		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
+		if err := oprot.WriteBinary(p.StreamID); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.streamID (3) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:segments: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:streamID: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *FetchTaggedIDResult_) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetErr() {
-		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:err: ", p), err)
+func (p *FetchTaggedStreamRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCancel() {
+		if err := oprot.WriteFieldBegin("cancel", thrift.BOOL, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:cancel: ", p), err)
 		}
-		if err := p.Err.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		if err := oprot.WriteBool(bool(*p.Cancel)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.cancel (4) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:err: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:cancel: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *FetchTaggedIDResult_) String() string {
+func (p *FetchTaggedStreamRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FetchTaggedIDResult_(%+v)", *p)
+	return fmt.Sprintf("FetchTaggedStreamRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - NameSpace
-//  - Shard
 //  - Elements
-//  - Source
-type FetchBlocksRawRequest struct {
-	NameSpace []byte                          `thrift:"nameSpace,1,required" db:"nameSpace" json:"nameSpace"`
-	Shard     int32                           `thrift:"shard,2,required" db:"shard" json:"shard"`
-	Elements  []*FetchBlocksRawRequestElement `thrift:"elements,3,required" db:"elements" json:"elements"`
-	Source    []byte                          `thrift:"source,4" db:"source" json:"source,omitempty"`
+//  - Exhaustive
+//  - StreamID
+//  - WaitedIndex
+//  - WaitedSeriesRead
+type FetchTaggedStreamResult_ struct {
+	Elements         []*FetchTaggedIDResult_ `thrift:"elements,1,required" db:"elements" json:"elements"`
+	Exhaustive       bool                    `thrift:"exhaustive,2,required" db:"exhaustive" json:"exhaustive"`
+	StreamID         []byte                  `thrift:"streamID,3" db:"streamID" json:"streamID,omitempty"`
+	WaitedIndex      *int64                  `thrift:"waitedIndex,4" db:"waitedIndex" json:"waitedIndex,omitempty"`
+	WaitedSeriesRead *int64                  `thrift:"waitedSeriesRead,5" db:"waitedSeriesRead" json:"waitedSeriesRead,omitempty"`
 }
 
-func NewFetchBlocksRawRequest() *FetchBlocksRawRequest {
-	return &FetchBlocksRawRequest{}
+func NewFetchTaggedStreamResult_() *FetchTaggedStreamResult_ {
+	return &FetchTaggedStreamResult_{}
 }
 
-func (p *FetchBlocksRawRequest) GetNameSpace() []byte {
-	return p.NameSpace
+func (p *FetchTaggedStreamResult_) GetElements() []*FetchTaggedIDResult_ {
+	return p.Elements
 }
 
-func (p *FetchBlocksRawRequest) GetShard() int32 {
-	return p.Shard
+func (p *FetchTaggedStreamResult_) GetExhaustive() bool {
+	return p.Exhaustive
 }
 
-func (p *FetchBlocksRawRequest) GetElements() []*FetchBlocksRawRequestElement {
-	return p.Elements
+var FetchTaggedStreamResult__StreamID_DEFAULT []byte
+
+func (p *FetchTaggedStreamResult_) GetStreamID() []byte {
+	return p.StreamID
 }
 
-var FetchBlocksRawRequest_Source_DEFAULT []byte
+var FetchTaggedStreamResult__WaitedIndex_DEFAULT int64
 
-func (p *FetchBlocksRawRequest) GetSource() []byte {
-	return p.Source
+func (p *FetchTaggedStreamResult_) GetWaitedIndex() int64 {
+	if !p.IsSetWaitedIndex() {
+		return FetchTaggedStreamResult__WaitedIndex_DEFAULT
+	}
+	return *p.WaitedIndex
 }
-func (p *FetchBlocksRawRequest) IsSetSource() bool {
-	return p.Source != nil
+
+var FetchTaggedStreamResult__WaitedSeriesRead_DEFAULT int64
+
+func (p *FetchTaggedStreamResult_) GetWaitedSeriesRead() int64 {
+	if !p.IsSetWaitedSeriesRead() {
+		return FetchTaggedStreamResult__WaitedSeriesRead_DEFAULT
+	}
+	return *p.WaitedSeriesRead
+}
+func (p *FetchTaggedStreamResult_) IsSetStreamID() bool {
+	return p.StreamID != nil
 }
 
-func (p *FetchBlocksRawRequest) Read(iprot thrift.TProtocol) error {
+func (p *FetchTaggedStreamResult_) IsSetWaitedIndex() bool {
+	return p.WaitedIndex != nil
+}
+
+func (p *FetchTaggedStreamResult_) IsSetWaitedSeriesRead() bool {
+	return p.WaitedSeriesRead != nil
+}
+
+func (p *FetchTaggedStreamResult_) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetNameSpace bool = false
-	var issetShard bool = false
 	var issetElements bool = false
+	var issetExhaustive bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -4542,21 +4514,24 @@ func (p *FetchBlocksRawRequest) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetNameSpace = true
+			issetElements = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetShard = true
+			issetExhaustive = true
 		case 3:
 			if err := p.ReadField3(iprot); err != nil {
 				return err
 			}
-			issetElements = true
 		case 4:
 			if err := p.ReadField4(iprot); err != nil {
 				return err
 			}
+		case 5:
+			if err := p.ReadField5(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -4569,49 +4544,28 @@ func (p *FetchBlocksRawRequest) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetNameSpace {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
-	}
-	if !issetShard {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Shard is not set"))
-	}
 	if !issetElements {
 		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Elements is not set"))
 	}
-	return nil
-}
-
-func (p *FetchBlocksRawRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.NameSpace = v
-	}
-	return nil
-}
-
-func (p *FetchBlocksRawRequest) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.Shard = v
+	if !issetExhaustive {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Exhaustive is not set"))
 	}
 	return nil
 }
 
-func (p *FetchBlocksRawRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *FetchTaggedStreamResult_) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]*FetchBlocksRawRequestElement, 0, size)
+	tSlice := make([]*FetchTaggedIDResult_, 0, size)
 	p.Elements = tSlice
 	for i := 0; i < size; i++ {
-		_elem11 := &FetchBlocksRawRequestElement{}
-		if err := _elem11.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem11), err)
+		_elem103 := &FetchTaggedIDResult_{}
+		if err := _elem103.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem103), err)
 		}
-		p.Elements = append(p.Elements, _elem11)
+		p.Elements = append(p.Elements, _elem103)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -4619,17 +4573,44 @@ func (p *FetchBlocksRawRequest) ReadField3(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *FetchBlocksRawRequest) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
+func (p *FetchTaggedStreamResult_) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.Source = v
+		p.Exhaustive = v
 	}
 	return nil
 }
 
-func (p *FetchBlocksRawRequest) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("FetchBlocksRawRequest"); err != nil {
+func (p *FetchTaggedStreamResult_) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.StreamID = v
+	}
+	return nil
+}
+
+func (p *FetchTaggedStreamResult_) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.WaitedIndex = &v
+	}
+	return nil
+}
+
+func (p *FetchTaggedStreamResult_) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.WaitedSeriesRead = &v
+	}
+	return nil
+}
+
+func (p *FetchTaggedStreamResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("FetchTaggedStreamResult"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -4645,6 +4626,9 @@ func (p *FetchBlocksRawRequest) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField4(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField5(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -4655,101 +4639,152 @@ func (p *FetchBlocksRawRequest) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *FetchBlocksRawRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("nameSpace", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:nameSpace: ", p), err)
+func (p *FetchTaggedStreamResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("elements", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:elements: ", p), err)
 	}
-	if err := oprot.WriteBinary(p.NameSpace); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (1) field write error: ", p), err)
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Elements)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Elements {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:nameSpace: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:elements: ", p), err)
 	}
 	return err
 }
 
-func (p *FetchBlocksRawRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("shard", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:shard: ", p), err)
+func (p *FetchTaggedStreamResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("exhaustive", thrift.BOOL, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:exhaustive: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.Shard)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.shard (2) field write error: ", p), err)
+	if err := oprot.WriteBool(bool(p.Exhaustive)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.exhaustive (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:shard: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:exhaustive: ", p), err)
 	}
 	return err
 }
 
-func (p *FetchBlocksRawRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("elements", thrift.LIST, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:elements: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Elements)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Elements {
-		if err := v.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+func (p *FetchTaggedStreamResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStreamID() {
+		if err := oprot.WriteFieldBegin("streamID", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:streamID: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.StreamID); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.streamID (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:streamID: ", p), err)
 		}
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:elements: ", p), err)
+	return err
+}
+
+func (p *FetchTaggedStreamResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWaitedIndex() {
+		if err := oprot.WriteFieldBegin("waitedIndex", thrift.I64, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:waitedIndex: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.WaitedIndex)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.waitedIndex (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:waitedIndex: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *FetchBlocksRawRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSource() {
-		if err := oprot.WriteFieldBegin("source", thrift.STRING, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:source: ", p), err)
+func (p *FetchTaggedStreamResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWaitedSeriesRead() {
+		if err := oprot.WriteFieldBegin("waitedSeriesRead", thrift.I64, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:waitedSeriesRead: ", p), err)
 		}
-		if err := oprot.WriteBinary(p.Source); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.source (4) field write error: ", p), err)
+		if err := oprot.WriteI64(int64(*p.WaitedSeriesRead)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.waitedSeriesRead (5) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:source: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:waitedSeriesRead: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *FetchBlocksRawRequest) String() string {
+func (p *FetchTaggedStreamResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FetchBlocksRawRequest(%+v)", *p)
+	return fmt.Sprintf("FetchTaggedStreamResult_(%+v)", *p)
 }
 
 // Attributes:
 //  - ID
-//  - Starts
-type FetchBlocksRawRequestElement struct {
-	ID     []byte  `thrift:"id,1,required" db:"id" json:"id"`
-	Starts []int64 `thrift:"starts,2,required" db:"starts" json:"starts"`
+//  - NameSpace
+//  - EncodedTags
+//  - Segments
+//  - Err
+type FetchTaggedIDResult_ struct {
+	ID          []byte      `thrift:"id,1,required" db:"id" json:"id"`
+	NameSpace   []byte      `thrift:"nameSpace,2,required" db:"nameSpace" json:"nameSpace"`
+	EncodedTags []byte      `thrift:"encodedTags,3,required" db:"encodedTags" json:"encodedTags"`
+	Segments    []*Segments `thrift:"segments,4" db:"segments" json:"segments,omitempty"`
+	Err         *Error      `thrift:"err,5" db:"err" json:"err,omitempty"`
 }
 
-func NewFetchBlocksRawRequestElement() *FetchBlocksRawRequestElement {
-	return &FetchBlocksRawRequestElement{}
+func NewFetchTaggedIDResult_() *FetchTaggedIDResult_ {
+	return &FetchTaggedIDResult_{}
 }
 
-func (p *FetchBlocksRawRequestElement) GetID() []byte {
+func (p *FetchTaggedIDResult_) GetID() []byte {
 	return p.ID
 }
 
-func (p *FetchBlocksRawRequestElement) GetStarts() []int64 {
-	return p.Starts
+func (p *FetchTaggedIDResult_) GetNameSpace() []byte {
+	return p.NameSpace
 }
-func (p *FetchBlocksRawRequestElement) Read(iprot thrift.TProtocol) error {
+
+func (p *FetchTaggedIDResult_) GetEncodedTags() []byte {
+	return p.EncodedTags
+}
+
+var FetchTaggedIDResult__Segments_DEFAULT []*Segments
+
+func (p *FetchTaggedIDResult_) GetSegments() []*Segments {
+	return p.Segments
+}
+
+var FetchTaggedIDResult__Err_DEFAULT *Error
+
+func (p *FetchTaggedIDResult_) GetErr() *Error {
+	if !p.IsSetErr() {
+		return FetchTaggedIDResult__Err_DEFAULT
+	}
+	return p.Err
+}
+func (p *FetchTaggedIDResult_) IsSetSegments() bool {
+	return p.Segments != nil
+}
+
+func (p *FetchTaggedIDResult_) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *FetchTaggedIDResult_) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
 	var issetID bool = false
-	var issetStarts bool = false
+	var issetNameSpace bool = false
+	var issetEncodedTags bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -4769,7 +4804,20 @@ func (p *FetchBlocksRawRequestElement) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetStarts = true
+			issetNameSpace = true
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
+			issetEncodedTags = true
+		case 4:
+			if err := p.ReadField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.ReadField5(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -4785,13 +4833,16 @@ func (p *FetchBlocksRawRequestElement) Read(iprot thrift.TProtocol) error {
 	if !issetID {
 		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
 	}
-	if !issetStarts {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Starts is not set"))
+	if !issetNameSpace {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
+	}
+	if !issetEncodedTags {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field EncodedTags is not set"))
 	}
 	return nil
 }
 
-func (p *FetchBlocksRawRequestElement) ReadField1(iprot thrift.TProtocol) error {
+func (p *FetchTaggedIDResult_) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
@@ -4800,21 +4851,37 @@ func (p *FetchBlocksRawRequestElement) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *FetchBlocksRawRequestElement) ReadField2(iprot thrift.TProtocol) error {
+func (p *FetchTaggedIDResult_) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.NameSpace = v
+	}
+	return nil
+}
+
+func (p *FetchTaggedIDResult_) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.EncodedTags = v
+	}
+	return nil
+}
+
+func (p *FetchTaggedIDResult_) ReadField4(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]int64, 0, size)
-	p.Starts = tSlice
+	tSlice := make([]*Segments, 0, size)
+	p.Segments = tSlice
 	for i := 0; i < size; i++ {
-		var _elem12 int64
-		if v, err := iprot.ReadI64(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem12 = v
+		_elem10 := &Segments{}
+		if err := _elem10.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem10), err)
 		}
-		p.Starts = append(p.Starts, _elem12)
+		p.Segments = append(p.Segments, _elem10)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -4822,8 +4889,18 @@ func (p *FetchBlocksRawRequestElement) ReadField2(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *FetchBlocksRawRequestElement) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("FetchBlocksRawRequestElement"); err != nil {
+func (p *FetchTaggedIDResult_) ReadField5(iprot thrift.TProtocol) error {
+	p.Err = &Error{
+		Type: 0,
+	}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
+}
+
+func (p *FetchTaggedIDResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("FetchTaggedIDResult"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -4833,6 +4910,15 @@ func (p *FetchBlocksRawRequestElement) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField2(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -4843,7 +4929,7 @@ func (p *FetchBlocksRawRequestElement) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *FetchBlocksRawRequestElement) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FetchTaggedIDResult_) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
 	}
@@ -4856,52 +4942,121 @@ func (p *FetchBlocksRawRequestElement) writeField1(oprot thrift.TProtocol) (err
 	return err
 }
 
-func (p *FetchBlocksRawRequestElement) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("starts", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:starts: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.I64, len(p.Starts)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Starts {
-		if err := oprot.WriteI64(int64(v)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-		}
+func (p *FetchTaggedIDResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("nameSpace", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:nameSpace: ", p), err)
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := oprot.WriteBinary(p.NameSpace); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:starts: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:nameSpace: ", p), err)
 	}
 	return err
 }
 
-func (p *FetchBlocksRawRequestElement) String() string {
-	if p == nil {
+func (p *FetchTaggedIDResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("encodedTags", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:encodedTags: ", p), err)
+	}
+	if err := oprot.WriteBinary(p.EncodedTags); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.encodedTags (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:encodedTags: ", p), err)
+	}
+	return err
+}
+
+func (p *FetchTaggedIDResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSegments() {
+		if err := oprot.WriteFieldBegin("segments", thrift.LIST, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:segments: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Segments)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Segments {
+			if err := v.Write(oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:segments: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FetchTaggedIDResult_) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:err: ", p), err)
+		}
+		if err := p.Err.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:err: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FetchTaggedIDResult_) String() string {
+	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FetchBlocksRawRequestElement(%+v)", *p)
+	return fmt.Sprintf("FetchTaggedIDResult_(%+v)", *p)
 }
 
 // Attributes:
+//  - NameSpace
+//  - Shard
 //  - Elements
-type FetchBlocksRawResult_ struct {
-	Elements []*Blocks `thrift:"elements,1,required" db:"elements" json:"elements"`
+//  - Source
+type FetchBlocksRawRequest struct {
+	NameSpace []byte                          `thrift:"nameSpace,1,required" db:"nameSpace" json:"nameSpace"`
+	Shard     int32                           `thrift:"shard,2,required" db:"shard" json:"shard"`
+	Elements  []*FetchBlocksRawRequestElement `thrift:"elements,3,required" db:"elements" json:"elements"`
+	Source    []byte                          `thrift:"source,4" db:"source" json:"source,omitempty"`
 }
 
-func NewFetchBlocksRawResult_() *FetchBlocksRawResult_ {
-	return &FetchBlocksRawResult_{}
+func NewFetchBlocksRawRequest() *FetchBlocksRawRequest {
+	return &FetchBlocksRawRequest{}
 }
 
-func (p *FetchBlocksRawResult_) GetElements() []*Blocks {
+func (p *FetchBlocksRawRequest) GetNameSpace() []byte {
+	return p.NameSpace
+}
+
+func (p *FetchBlocksRawRequest) GetShard() int32 {
+	return p.Shard
+}
+
+func (p *FetchBlocksRawRequest) GetElements() []*FetchBlocksRawRequestElement {
 	return p.Elements
 }
-func (p *FetchBlocksRawResult_) Read(iprot thrift.TProtocol) error {
+
+var FetchBlocksRawRequest_Source_DEFAULT []byte
+
+func (p *FetchBlocksRawRequest) GetSource() []byte {
+	return p.Source
+}
+func (p *FetchBlocksRawRequest) IsSetSource() bool {
+	return p.Source != nil
+}
+
+func (p *FetchBlocksRawRequest) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
+	var issetNameSpace bool = false
+	var issetShard bool = false
 	var issetElements bool = false
 
 	for {
@@ -4917,7 +5072,21 @@ func (p *FetchBlocksRawResult_) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
+			issetNameSpace = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+			issetShard = true
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
 			issetElements = true
+		case 4:
+			if err := p.ReadField4(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -4930,25 +5099,49 @@ func (p *FetchBlocksRawResult_) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
+	if !issetNameSpace {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
+	}
+	if !issetShard {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Shard is not set"))
+	}
 	if !issetElements {
 		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Elements is not set"))
 	}
 	return nil
 }
 
-func (p *FetchBlocksRawResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *FetchBlocksRawRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.NameSpace = v
+	}
+	return nil
+}
+
+func (p *FetchBlocksRawRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Shard = v
+	}
+	return nil
+}
+
+func (p *FetchBlocksRawRequest) ReadField3(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]*Blocks, 0, size)
+	tSlice := make([]*FetchBlocksRawRequestElement, 0, size)
 	p.Elements = tSlice
 	for i := 0; i < size; i++ {
-		_elem13 := &Blocks{}
-		if err := _elem13.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem13), err)
+		_elem11 := &FetchBlocksRawRequestElement{}
+		if err := _elem11.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem11), err)
 		}
-		p.Elements = append(p.Elements, _elem13)
+		p.Elements = append(p.Elements, _elem11)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -4956,14 +5149,32 @@ func (p *FetchBlocksRawResult_) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *FetchBlocksRawResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("FetchBlocksRawResult"); err != nil {
+func (p *FetchBlocksRawRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Source = v
+	}
+	return nil
+}
+
+func (p *FetchBlocksRawRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("FetchBlocksRawRequest"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -4974,9 +5185,35 @@ func (p *FetchBlocksRawResult_) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *FetchBlocksRawResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("elements", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:elements: ", p), err)
+func (p *FetchBlocksRawRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("nameSpace", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:nameSpace: ", p), err)
+	}
+	if err := oprot.WriteBinary(p.NameSpace); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:nameSpace: ", p), err)
+	}
+	return err
+}
+
+func (p *FetchBlocksRawRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("shard", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:shard: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Shard)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.shard (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:shard: ", p), err)
+	}
+	return err
+}
+
+func (p *FetchBlocksRawRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("elements", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:elements: ", p), err)
 	}
 	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Elements)); err != nil {
 		return thrift.PrependError("error writing list begin: ", err)
@@ -4990,44 +5227,59 @@ func (p *FetchBlocksRawResult_) writeField1(oprot thrift.TProtocol) (err error)
 		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:elements: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:elements: ", p), err)
 	}
 	return err
 }
 
-func (p *FetchBlocksRawResult_) String() string {
+func (p *FetchBlocksRawRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSource() {
+		if err := oprot.WriteFieldBegin("source", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:source: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.Source); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.source (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:source: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FetchBlocksRawRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FetchBlocksRawResult_(%+v)", *p)
+	return fmt.Sprintf("FetchBlocksRawRequest(%+v)", *p)
 }
 
 // Attributes:
 //  - ID
-//  - Blocks
-type Blocks struct {
-	ID     []byte   `thrift:"id,1,required" db:"id" json:"id"`
-	Blocks []*Block `thrift:"blocks,2,required" db:"blocks" json:"blocks"`
+//  - Starts
+type FetchBlocksRawRequestElement struct {
+	ID     []byte  `thrift:"id,1,required" db:"id" json:"id"`
+	Starts []int64 `thrift:"starts,2,required" db:"starts" json:"starts"`
 }
 
-func NewBlocks() *Blocks {
-	return &Blocks{}
+func NewFetchBlocksRawRequestElement() *FetchBlocksRawRequestElement {
+	return &FetchBlocksRawRequestElement{}
 }
 
-func (p *Blocks) GetID() []byte {
+func (p *FetchBlocksRawRequestElement) GetID() []byte {
 	return p.ID
 }
 
-func (p *Blocks) GetBlocks() []*Block {
-	return p.Blocks
+func (p *FetchBlocksRawRequestElement) GetStarts() []int64 {
+	return p.Starts
 }
-func (p *Blocks) Read(iprot thrift.TProtocol) error {
+func (p *FetchBlocksRawRequestElement) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
 	var issetID bool = false
-	var issetBlocks bool = false
+	var issetStarts bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -5047,7 +5299,7 @@ func (p *Blocks) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetBlocks = true
+			issetStarts = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -5063,13 +5315,13 @@ func (p *Blocks) Read(iprot thrift.TProtocol) error {
 	if !issetID {
 		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
 	}
-	if !issetBlocks {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Blocks is not set"))
+	if !issetStarts {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Starts is not set"))
 	}
 	return nil
 }
 
-func (p *Blocks) ReadField1(iprot thrift.TProtocol) error {
+func (p *FetchBlocksRawRequestElement) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
@@ -5078,19 +5330,21 @@ func (p *Blocks) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *Blocks) ReadField2(iprot thrift.TProtocol) error {
+func (p *FetchBlocksRawRequestElement) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]*Block, 0, size)
-	p.Blocks = tSlice
+	tSlice := make([]int64, 0, size)
+	p.Starts = tSlice
 	for i := 0; i < size; i++ {
-		_elem14 := &Block{}
-		if err := _elem14.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem14), err)
+		var _elem12 int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem12 = v
 		}
-		p.Blocks = append(p.Blocks, _elem14)
+		p.Starts = append(p.Starts, _elem12)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -5098,8 +5352,8 @@ func (p *Blocks) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *Blocks) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("Blocks"); err != nil {
+func (p *FetchBlocksRawRequestElement) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("FetchBlocksRawRequestElement"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -5119,7 +5373,7 @@ func (p *Blocks) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *Blocks) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FetchBlocksRawRequestElement) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
 	}
@@ -5132,98 +5386,178 @@ func (p *Blocks) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *Blocks) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("blocks", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:blocks: ", p), err)
+func (p *FetchBlocksRawRequestElement) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("starts", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:starts: ", p), err)
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Blocks)); err != nil {
+	if err := oprot.WriteListBegin(thrift.I64, len(p.Starts)); err != nil {
 		return thrift.PrependError("error writing list begin: ", err)
 	}
-	for _, v := range p.Blocks {
-		if err := v.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+	for _, v := range p.Starts {
+		if err := oprot.WriteI64(int64(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 		}
 	}
 	if err := oprot.WriteListEnd(); err != nil {
 		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:blocks: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:starts: ", p), err)
 	}
 	return err
 }
 
-func (p *Blocks) String() string {
+func (p *FetchBlocksRawRequestElement) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Blocks(%+v)", *p)
+	return fmt.Sprintf("FetchBlocksRawRequestElement(%+v)", *p)
 }
 
 // Attributes:
-//  - Start
-//  - Segments
-//  - Err
-//  - Checksum
-type Block struct {
-	Start    int64     `thrift:"start,1,required" db:"start" json:"start"`
-	Segments *Segments `thrift:"segments,2" db:"segments" json:"segments,omitempty"`
-	Err      *Error    `thrift:"err,3" db:"err" json:"err,omitempty"`
-	Checksum *int64    `thrift:"checksum,4" db:"checksum" json:"checksum,omitempty"`
+//  - Elements
+type FetchBlocksRawResult_ struct {
+	Elements []*Blocks `thrift:"elements,1,required" db:"elements" json:"elements"`
 }
 
-func NewBlock() *Block {
-	return &Block{}
+func NewFetchBlocksRawResult_() *FetchBlocksRawResult_ {
+	return &FetchBlocksRawResult_{}
 }
 
-func (p *Block) GetStart() int64 {
-	return p.Start
+func (p *FetchBlocksRawResult_) GetElements() []*Blocks {
+	return p.Elements
 }
+func (p *FetchBlocksRawResult_) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
 
-var Block_Segments_DEFAULT *Segments
+	var issetElements bool = false
 
-func (p *Block) GetSegments() *Segments {
-	if !p.IsSetSegments() {
-		return Block_Segments_DEFAULT
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetElements = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
 	}
-	return p.Segments
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetElements {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Elements is not set"))
+	}
+	return nil
 }
 
-var Block_Err_DEFAULT *Error
+func (p *FetchBlocksRawResult_) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*Blocks, 0, size)
+	p.Elements = tSlice
+	for i := 0; i < size; i++ {
+		_elem13 := &Blocks{}
+		if err := _elem13.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem13), err)
+		}
+		p.Elements = append(p.Elements, _elem13)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
 
-func (p *Block) GetErr() *Error {
-	if !p.IsSetErr() {
-		return Block_Err_DEFAULT
+func (p *FetchBlocksRawResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("FetchBlocksRawResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	return p.Err
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-var Block_Checksum_DEFAULT int64
+func (p *FetchBlocksRawResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("elements", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:elements: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Elements)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Elements {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:elements: ", p), err)
+	}
+	return err
+}
 
-func (p *Block) GetChecksum() int64 {
-	if !p.IsSetChecksum() {
-		return Block_Checksum_DEFAULT
+func (p *FetchBlocksRawResult_) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Checksum
+	return fmt.Sprintf("FetchBlocksRawResult_(%+v)", *p)
 }
-func (p *Block) IsSetSegments() bool {
-	return p.Segments != nil
+
+// Attributes:
+//  - ID
+//  - Blocks
+type Blocks struct {
+	ID     []byte   `thrift:"id,1,required" db:"id" json:"id"`
+	Blocks []*Block `thrift:"blocks,2,required" db:"blocks" json:"blocks"`
 }
 
-func (p *Block) IsSetErr() bool {
-	return p.Err != nil
+func NewBlocks() *Blocks {
+	return &Blocks{}
 }
 
-func (p *Block) IsSetChecksum() bool {
-	return p.Checksum != nil
+func (p *Blocks) GetID() []byte {
+	return p.ID
 }
 
-func (p *Block) Read(iprot thrift.TProtocol) error {
+func (p *Blocks) GetBlocks() []*Block {
+	return p.Blocks
+}
+func (p *Blocks) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetStart bool = false
+	var issetID bool = false
+	var issetBlocks bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -5238,19 +5572,12 @@ func (p *Block) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetStart = true
+			issetID = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-		case 3:
-			if err := p.ReadField3(iprot); err != nil {
-				return err
-			}
-		case 4:
-			if err := p.ReadField4(iprot); err != nil {
-				return err
-			}
+			issetBlocks = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -5263,50 +5590,46 @@ func (p *Block) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetStart {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Start is not set"))
+	if !issetID {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
+	}
+	if !issetBlocks {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Blocks is not set"))
 	}
 	return nil
 }
 
-func (p *Block) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *Blocks) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.Start = v
-	}
-	return nil
-}
-
-func (p *Block) ReadField2(iprot thrift.TProtocol) error {
-	p.Segments = &Segments{}
-	if err := p.Segments.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Segments), err)
+		p.ID = v
 	}
 	return nil
 }
 
-func (p *Block) ReadField3(iprot thrift.TProtocol) error {
-	p.Err = &Error{
-		Type: 0,
+func (p *Blocks) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	if err := p.Err.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	tSlice := make([]*Block, 0, size)
+	p.Blocks = tSlice
+	for i := 0; i < size; i++ {
+		_elem14 := &Block{}
+		if err := _elem14.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem14), err)
+		}
+		p.Blocks = append(p.Blocks, _elem14)
 	}
-	return nil
-}
-
-func (p *Block) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		p.Checksum = &v
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *Block) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("Block"); err != nil {
+func (p *Blocks) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("Blocks"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -5316,12 +5639,6 @@ func (p *Block) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField2(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField4(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -5332,7 +5649,220 @@ func (p *Block) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *Block) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *Blocks) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
+	}
+	if err := oprot.WriteBinary(p.ID); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+	}
+	return err
+}
+
+func (p *Blocks) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("blocks", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:blocks: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Blocks)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Blocks {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:blocks: ", p), err)
+	}
+	return err
+}
+
+func (p *Blocks) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Blocks(%+v)", *p)
+}
+
+// Attributes:
+//  - Start
+//  - Segments
+//  - Err
+//  - Checksum
+type Block struct {
+	Start    int64     `thrift:"start,1,required" db:"start" json:"start"`
+	Segments *Segments `thrift:"segments,2" db:"segments" json:"segments,omitempty"`
+	Err      *Error    `thrift:"err,3" db:"err" json:"err,omitempty"`
+	Checksum *int64    `thrift:"checksum,4" db:"checksum" json:"checksum,omitempty"`
+}
+
+func NewBlock() *Block {
+	return &Block{}
+}
+
+func (p *Block) GetStart() int64 {
+	return p.Start
+}
+
+var Block_Segments_DEFAULT *Segments
+
+func (p *Block) GetSegments() *Segments {
+	if !p.IsSetSegments() {
+		return Block_Segments_DEFAULT
+	}
+	return p.Segments
+}
+
+var Block_Err_DEFAULT *Error
+
+func (p *Block) GetErr() *Error {
+	if !p.IsSetErr() {
+		return Block_Err_DEFAULT
+	}
+	return p.Err
+}
+
+var Block_Checksum_DEFAULT int64
+
+func (p *Block) GetChecksum() int64 {
+	if !p.IsSetChecksum() {
+		return Block_Checksum_DEFAULT
+	}
+	return *p.Checksum
+}
+func (p *Block) IsSetSegments() bool {
+	return p.Segments != nil
+}
+
+func (p *Block) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *Block) IsSetChecksum() bool {
+	return p.Checksum != nil
+}
+
+func (p *Block) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetStart bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetStart = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.ReadField4(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetStart {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Start is not set"))
+	}
+	return nil
+}
+
+func (p *Block) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Start = v
+	}
+	return nil
+}
+
+func (p *Block) ReadField2(iprot thrift.TProtocol) error {
+	p.Segments = &Segments{}
+	if err := p.Segments.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Segments), err)
+	}
+	return nil
+}
+
+func (p *Block) ReadField3(iprot thrift.TProtocol) error {
+	p.Err = &Error{
+		Type: 0,
+	}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
+}
+
+func (p *Block) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Checksum = &v
+	}
+	return nil
+}
+
+func (p *Block) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("Block"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Block) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("start", thrift.I64, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:start: ", p), err)
 	}
@@ -6554,30 +7084,77 @@ func (p *BlockMetadataV2) String() string {
 
 // Attributes:
 //  - NameSpace
-//  - Elements
-type WriteBatchRawRequest struct {
-	NameSpace []byte                         `thrift:"nameSpace,1,required" db:"nameSpace" json:"nameSpace"`
-	Elements  []*WriteBatchRawRequestElement `thrift:"elements,2,required" db:"elements" json:"elements"`
+//  - Shard
+//  - RangeStart
+//  - RangeEnd
+//  - Depth
+//  - Leaves
+//  - Limit
+//  - PageToken
+type FetchBlocksMerkleTreeRawRequest struct {
+	NameSpace  []byte  `thrift:"nameSpace,1,required" db:"nameSpace" json:"nameSpace"`
+	Shard      int32   `thrift:"shard,2,required" db:"shard" json:"shard"`
+	RangeStart int64   `thrift:"rangeStart,3,required" db:"rangeStart" json:"rangeStart"`
+	RangeEnd   int64   `thrift:"rangeEnd,4,required" db:"rangeEnd" json:"rangeEnd"`
+	Depth      int32   `thrift:"depth,5,required" db:"depth" json:"depth"`
+	Leaves     []int32 `thrift:"leaves,6,required" db:"leaves" json:"leaves"`
+	Limit      int64   `thrift:"limit,7,required" db:"limit" json:"limit"`
+	PageToken  []byte  `thrift:"pageToken,8" db:"pageToken" json:"pageToken,omitempty"`
 }
 
-func NewWriteBatchRawRequest() *WriteBatchRawRequest {
-	return &WriteBatchRawRequest{}
+func NewFetchBlocksMerkleTreeRawRequest() *FetchBlocksMerkleTreeRawRequest {
+	return &FetchBlocksMerkleTreeRawRequest{}
 }
 
-func (p *WriteBatchRawRequest) GetNameSpace() []byte {
+func (p *FetchBlocksMerkleTreeRawRequest) GetNameSpace() []byte {
 	return p.NameSpace
 }
 
-func (p *WriteBatchRawRequest) GetElements() []*WriteBatchRawRequestElement {
-	return p.Elements
+func (p *FetchBlocksMerkleTreeRawRequest) GetShard() int32 {
+	return p.Shard
 }
-func (p *WriteBatchRawRequest) Read(iprot thrift.TProtocol) error {
+
+func (p *FetchBlocksMerkleTreeRawRequest) GetRangeStart() int64 {
+	return p.RangeStart
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) GetRangeEnd() int64 {
+	return p.RangeEnd
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) GetDepth() int32 {
+	return p.Depth
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) GetLeaves() []int32 {
+	return p.Leaves
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) GetLimit() int64 {
+	return p.Limit
+}
+
+var FetchBlocksMerkleTreeRawRequest_PageToken_DEFAULT []byte
+
+func (p *FetchBlocksMerkleTreeRawRequest) GetPageToken() []byte {
+	return p.PageToken
+}
+func (p *FetchBlocksMerkleTreeRawRequest) IsSetPageToken() bool {
+	return p.PageToken != nil
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
 	var issetNameSpace bool = false
-	var issetElements bool = false
+	var issetShard bool = false
+	var issetRangeStart bool = false
+	var issetRangeEnd bool = false
+	var issetDepth bool = false
+	var issetLeaves bool = false
+	var issetLimit bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -6597,7 +7174,36 @@ func (p *WriteBatchRawRequest) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetElements = true
+			issetShard = true
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
+			issetRangeStart = true
+		case 4:
+			if err := p.ReadField4(iprot); err != nil {
+				return err
+			}
+			issetRangeEnd = true
+		case 5:
+			if err := p.ReadField5(iprot); err != nil {
+				return err
+			}
+			issetDepth = true
+		case 6:
+			if err := p.ReadField6(iprot); err != nil {
+				return err
+			}
+			issetLeaves = true
+		case 7:
+			if err := p.ReadField7(iprot); err != nil {
+				return err
+			}
+			issetLimit = true
+		case 8:
+			if err := p.ReadField8(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -6613,13 +7219,28 @@ func (p *WriteBatchRawRequest) Read(iprot thrift.TProtocol) error {
 	if !issetNameSpace {
 		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
 	}
-	if !issetElements {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Elements is not set"))
+	if !issetShard {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Shard is not set"))
+	}
+	if !issetRangeStart {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RangeStart is not set"))
+	}
+	if !issetRangeEnd {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RangeEnd is not set"))
+	}
+	if !issetDepth {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Depth is not set"))
+	}
+	if !issetLeaves {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Leaves is not set"))
+	}
+	if !issetLimit {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Limit is not set"))
 	}
 	return nil
 }
 
-func (p *WriteBatchRawRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *FetchBlocksMerkleTreeRawRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
@@ -6628,19 +7249,57 @@ func (p *WriteBatchRawRequest) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *WriteBatchRawRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *FetchBlocksMerkleTreeRawRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Shard = v
+	}
+	return nil
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.RangeStart = v
+	}
+	return nil
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.RangeEnd = v
+	}
+	return nil
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Depth = v
+	}
+	return nil
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) ReadField6(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]*WriteBatchRawRequestElement, 0, size)
-	p.Elements = tSlice
+	tSlice := make([]int32, 0, size)
+	p.Leaves = tSlice
 	for i := 0; i < size; i++ {
-		_elem16 := &WriteBatchRawRequestElement{}
-		if err := _elem16.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem16), err)
+		var _elem36 int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem36 = v
 		}
-		p.Elements = append(p.Elements, _elem16)
+		p.Leaves = append(p.Leaves, _elem36)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -6648,8 +7307,26 @@ func (p *WriteBatchRawRequest) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *WriteBatchRawRequest) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("WriteBatchRawRequest"); err != nil {
+func (p *FetchBlocksMerkleTreeRawRequest) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.PageToken = v
+	}
+	return nil
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("FetchBlocksMerkleTreeRawRequest"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -6659,6 +7336,24 @@ func (p *WriteBatchRawRequest) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField2(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -6669,7 +7364,7 @@ func (p *WriteBatchRawRequest) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *WriteBatchRawRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *FetchBlocksMerkleTreeRawRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("nameSpace", thrift.STRING, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:nameSpace: ", p), err)
 	}
@@ -6682,59 +7377,155 @@ func (p *WriteBatchRawRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *WriteBatchRawRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("elements", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:elements: ", p), err)
+func (p *FetchBlocksMerkleTreeRawRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("shard", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:shard: ", p), err)
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Elements)); err != nil {
+	if err := oprot.WriteI32(int32(p.Shard)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.shard (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:shard: ", p), err)
+	}
+	return err
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("rangeStart", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:rangeStart: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.RangeStart)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.rangeStart (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:rangeStart: ", p), err)
+	}
+	return err
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("rangeEnd", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:rangeEnd: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.RangeEnd)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.rangeEnd (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:rangeEnd: ", p), err)
+	}
+	return err
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("depth", thrift.I32, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:depth: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Depth)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.depth (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:depth: ", p), err)
+	}
+	return err
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("leaves", thrift.LIST, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:leaves: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.I32, len(p.Leaves)); err != nil {
 		return thrift.PrependError("error writing list begin: ", err)
 	}
-	for _, v := range p.Elements {
-		if err := v.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+	for _, v := range p.Leaves {
+		if err := oprot.WriteI32(int32(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 		}
 	}
 	if err := oprot.WriteListEnd(); err != nil {
 		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:elements: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:leaves: ", p), err)
 	}
 	return err
 }
 
-func (p *WriteBatchRawRequest) String() string {
+func (p *FetchBlocksMerkleTreeRawRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("limit", thrift.I64, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:limit: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.Limit)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.limit (7) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:limit: ", p), err)
+	}
+	return err
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPageToken() {
+		if err := oprot.WriteFieldBegin("pageToken", thrift.STRING, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:pageToken: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.PageToken); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.pageToken (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:pageToken: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FetchBlocksMerkleTreeRawRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("WriteBatchRawRequest(%+v)", *p)
+	return fmt.Sprintf("FetchBlocksMerkleTreeRawRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - NameSpaces
+//  - Digests
 //  - Elements
-type WriteBatchRawV2Request struct {
-	NameSpaces [][]byte                         `thrift:"nameSpaces,1,required" db:"nameSpaces" json:"nameSpaces"`
-	Elements   []*WriteBatchRawV2RequestElement `thrift:"elements,2,required" db:"elements" json:"elements"`
+//  - NextPageToken
+type FetchBlocksMerkleTreeRawResult_ struct {
+	Digests       []int64            `thrift:"digests,1" db:"digests" json:"digests,omitempty"`
+	Elements      []*BlockMetadataV2 `thrift:"elements,2,required" db:"elements" json:"elements"`
+	NextPageToken []byte             `thrift:"nextPageToken,3" db:"nextPageToken" json:"nextPageToken,omitempty"`
 }
 
-func NewWriteBatchRawV2Request() *WriteBatchRawV2Request {
-	return &WriteBatchRawV2Request{}
+func NewFetchBlocksMerkleTreeRawResult_() *FetchBlocksMerkleTreeRawResult_ {
+	return &FetchBlocksMerkleTreeRawResult_{}
 }
 
-func (p *WriteBatchRawV2Request) GetNameSpaces() [][]byte {
-	return p.NameSpaces
+var FetchBlocksMerkleTreeRawResult__Digests_DEFAULT []int64
+
+func (p *FetchBlocksMerkleTreeRawResult_) GetDigests() []int64 {
+	return p.Digests
 }
 
-func (p *WriteBatchRawV2Request) GetElements() []*WriteBatchRawV2RequestElement {
+func (p *FetchBlocksMerkleTreeRawResult_) GetElements() []*BlockMetadataV2 {
 	return p.Elements
 }
-func (p *WriteBatchRawV2Request) Read(iprot thrift.TProtocol) error {
+
+var FetchBlocksMerkleTreeRawResult__NextPageToken_DEFAULT []byte
+
+func (p *FetchBlocksMerkleTreeRawResult_) GetNextPageToken() []byte {
+	return p.NextPageToken
+}
+func (p *FetchBlocksMerkleTreeRawResult_) IsSetDigests() bool {
+	return p.Digests != nil
+}
+
+func (p *FetchBlocksMerkleTreeRawResult_) IsSetNextPageToken() bool {
+	return p.NextPageToken != nil
+}
+func (p *FetchBlocksMerkleTreeRawResult_) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetNameSpaces bool = false
 	var issetElements bool = false
 
 	for {
@@ -6750,12 +7541,15 @@ func (p *WriteBatchRawV2Request) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetNameSpaces = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
 			issetElements = true
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -6768,30 +7562,27 @@ func (p *WriteBatchRawV2Request) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetNameSpaces {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpaces is not set"))
-	}
 	if !issetElements {
 		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Elements is not set"))
 	}
 	return nil
 }
 
-func (p *WriteBatchRawV2Request) ReadField1(iprot thrift.TProtocol) error {
+func (p *FetchBlocksMerkleTreeRawResult_) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([][]byte, 0, size)
-	p.NameSpaces = tSlice
+	tSlice := make([]int64, 0, size)
+	p.Digests = tSlice
 	for i := 0; i < size; i++ {
-		var _elem17 []byte
-		if v, err := iprot.ReadBinary(); err != nil {
+		var _elem37 int64
+		if v, err := iprot.ReadI64(); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_elem17 = v
+			_elem37 = v
 		}
-		p.NameSpaces = append(p.NameSpaces, _elem17)
+		p.Digests = append(p.Digests, _elem37)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -6799,19 +7590,19 @@ func (p *WriteBatchRawV2Request) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *WriteBatchRawV2Request) ReadField2(iprot thrift.TProtocol) error {
+func (p *FetchBlocksMerkleTreeRawResult_) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]*WriteBatchRawV2RequestElement, 0, size)
+	tSlice := make([]*BlockMetadataV2, 0, size)
 	p.Elements = tSlice
 	for i := 0; i < size; i++ {
-		_elem18 := &WriteBatchRawV2RequestElement{}
-		if err := _elem18.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem18), err)
+		_elem38 := &BlockMetadataV2{}
+		if err := _elem38.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem38), err)
 		}
-		p.Elements = append(p.Elements, _elem18)
+		p.Elements = append(p.Elements, _elem38)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -6819,8 +7610,17 @@ func (p *WriteBatchRawV2Request) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *WriteBatchRawV2Request) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("WriteBatchRawV2Request"); err != nil {
+func (p *FetchBlocksMerkleTreeRawResult_) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.NextPageToken = v
+	}
+	return nil
+}
+
+func (p *FetchBlocksMerkleTreeRawResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("FetchBlocksMerkleTreeRawResult"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -6830,6 +7630,9 @@ func (p *WriteBatchRawV2Request) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField2(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -6840,28 +7643,30 @@ func (p *WriteBatchRawV2Request) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *WriteBatchRawV2Request) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("nameSpaces", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:nameSpaces: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.NameSpaces)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.NameSpaces {
-		if err := oprot.WriteBinary(v); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+func (p *FetchBlocksMerkleTreeRawResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDigests() {
+		if err := oprot.WriteFieldBegin("digests", thrift.LIST, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:digests: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.Digests)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Digests {
+			if err := oprot.WriteI64(int64(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:digests: ", p), err)
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:nameSpaces: ", p), err)
 	}
 	return err
 }
 
-func (p *WriteBatchRawV2Request) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *FetchBlocksMerkleTreeRawResult_) writeField2(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("elements", thrift.LIST, 2); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:elements: ", p), err)
 	}
@@ -6882,48 +7687,54 @@ func (p *WriteBatchRawV2Request) writeField2(oprot thrift.TProtocol) (err error)
 	return err
 }
 
-func (p *WriteBatchRawV2Request) String() string {
+func (p *FetchBlocksMerkleTreeRawResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNextPageToken() {
+		if err := oprot.WriteFieldBegin("nextPageToken", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:nextPageToken: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.NextPageToken); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.nextPageToken (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:nextPageToken: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FetchBlocksMerkleTreeRawResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("WriteBatchRawV2Request(%+v)", *p)
+	return fmt.Sprintf("FetchBlocksMerkleTreeRawResult_(%+v)", *p)
 }
 
 // Attributes:
-//  - ID
-//  - Datapoint
-type WriteBatchRawRequestElement struct {
-	ID        []byte     `thrift:"id,1,required" db:"id" json:"id"`
-	Datapoint *Datapoint `thrift:"datapoint,2,required" db:"datapoint" json:"datapoint"`
+//  - NameSpace
+//  - Elements
+type WriteBatchRawRequest struct {
+	NameSpace []byte                         `thrift:"nameSpace,1,required" db:"nameSpace" json:"nameSpace"`
+	Elements  []*WriteBatchRawRequestElement `thrift:"elements,2,required" db:"elements" json:"elements"`
 }
 
-func NewWriteBatchRawRequestElement() *WriteBatchRawRequestElement {
-	return &WriteBatchRawRequestElement{}
+func NewWriteBatchRawRequest() *WriteBatchRawRequest {
+	return &WriteBatchRawRequest{}
 }
 
-func (p *WriteBatchRawRequestElement) GetID() []byte {
-	return p.ID
+func (p *WriteBatchRawRequest) GetNameSpace() []byte {
+	return p.NameSpace
 }
 
-var WriteBatchRawRequestElement_Datapoint_DEFAULT *Datapoint
-
-func (p *WriteBatchRawRequestElement) GetDatapoint() *Datapoint {
-	if !p.IsSetDatapoint() {
-		return WriteBatchRawRequestElement_Datapoint_DEFAULT
-	}
-	return p.Datapoint
-}
-func (p *WriteBatchRawRequestElement) IsSetDatapoint() bool {
-	return p.Datapoint != nil
+func (p *WriteBatchRawRequest) GetElements() []*WriteBatchRawRequestElement {
+	return p.Elements
 }
-
-func (p *WriteBatchRawRequestElement) Read(iprot thrift.TProtocol) error {
+func (p *WriteBatchRawRequest) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetID bool = false
-	var issetDatapoint bool = false
+	var issetNameSpace bool = false
+	var issetElements bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -6938,12 +7749,12 @@ func (p *WriteBatchRawRequestElement) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetID = true
+			issetNameSpace = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetDatapoint = true
+			issetElements = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -6956,36 +7767,46 @@ func (p *WriteBatchRawRequestElement) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetID {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
+	if !issetNameSpace {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
 	}
-	if !issetDatapoint {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Datapoint is not set"))
+	if !issetElements {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Elements is not set"))
 	}
 	return nil
 }
 
-func (p *WriteBatchRawRequestElement) ReadField1(iprot thrift.TProtocol) error {
+func (p *WriteBatchRawRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.ID = v
+		p.NameSpace = v
 	}
 	return nil
 }
 
-func (p *WriteBatchRawRequestElement) ReadField2(iprot thrift.TProtocol) error {
-	p.Datapoint = &Datapoint{
-		TimestampTimeType: 0,
+func (p *WriteBatchRawRequest) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	if err := p.Datapoint.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Datapoint), err)
+	tSlice := make([]*WriteBatchRawRequestElement, 0, size)
+	p.Elements = tSlice
+	for i := 0; i < size; i++ {
+		_elem16 := &WriteBatchRawRequestElement{}
+		if err := _elem16.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem16), err)
+		}
+		p.Elements = append(p.Elements, _elem16)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *WriteBatchRawRequestElement) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("WriteBatchRawRequestElement"); err != nil {
+func (p *WriteBatchRawRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("WriteBatchRawRequest"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7005,81 +7826,73 @@ func (p *WriteBatchRawRequestElement) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *WriteBatchRawRequestElement) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
+func (p *WriteBatchRawRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("nameSpace", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:nameSpace: ", p), err)
 	}
-	if err := oprot.WriteBinary(p.ID); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+	if err := oprot.WriteBinary(p.NameSpace); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:nameSpace: ", p), err)
 	}
 	return err
 }
 
-func (p *WriteBatchRawRequestElement) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("datapoint", thrift.STRUCT, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:datapoint: ", p), err)
+func (p *WriteBatchRawRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("elements", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:elements: ", p), err)
 	}
-	if err := p.Datapoint.Write(oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Datapoint), err)
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Elements)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Elements {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:datapoint: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:elements: ", p), err)
 	}
 	return err
 }
 
-func (p *WriteBatchRawRequestElement) String() string {
+func (p *WriteBatchRawRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("WriteBatchRawRequestElement(%+v)", *p)
+	return fmt.Sprintf("WriteBatchRawRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - ID
-//  - Datapoint
-//  - NameSpace
-type WriteBatchRawV2RequestElement struct {
-	ID        []byte     `thrift:"id,1,required" db:"id" json:"id"`
-	Datapoint *Datapoint `thrift:"datapoint,2,required" db:"datapoint" json:"datapoint"`
-	NameSpace int64      `thrift:"nameSpace,3,required" db:"nameSpace" json:"nameSpace"`
-}
-
-func NewWriteBatchRawV2RequestElement() *WriteBatchRawV2RequestElement {
-	return &WriteBatchRawV2RequestElement{}
+//  - NameSpaces
+//  - Elements
+type WriteBatchRawV2Request struct {
+	NameSpaces [][]byte                         `thrift:"nameSpaces,1,required" db:"nameSpaces" json:"nameSpaces"`
+	Elements   []*WriteBatchRawV2RequestElement `thrift:"elements,2,required" db:"elements" json:"elements"`
 }
 
-func (p *WriteBatchRawV2RequestElement) GetID() []byte {
-	return p.ID
+func NewWriteBatchRawV2Request() *WriteBatchRawV2Request {
+	return &WriteBatchRawV2Request{}
 }
 
-var WriteBatchRawV2RequestElement_Datapoint_DEFAULT *Datapoint
-
-func (p *WriteBatchRawV2RequestElement) GetDatapoint() *Datapoint {
-	if !p.IsSetDatapoint() {
-		return WriteBatchRawV2RequestElement_Datapoint_DEFAULT
-	}
-	return p.Datapoint
+func (p *WriteBatchRawV2Request) GetNameSpaces() [][]byte {
+	return p.NameSpaces
 }
 
-func (p *WriteBatchRawV2RequestElement) GetNameSpace() int64 {
-	return p.NameSpace
-}
-func (p *WriteBatchRawV2RequestElement) IsSetDatapoint() bool {
-	return p.Datapoint != nil
+func (p *WriteBatchRawV2Request) GetElements() []*WriteBatchRawV2RequestElement {
+	return p.Elements
 }
-
-func (p *WriteBatchRawV2RequestElement) Read(iprot thrift.TProtocol) error {
+func (p *WriteBatchRawV2Request) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetID bool = false
-	var issetDatapoint bool = false
-	var issetNameSpace bool = false
+	var issetNameSpaces bool = false
+	var issetElements bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -7094,17 +7907,12 @@ func (p *WriteBatchRawV2RequestElement) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetID = true
+			issetNameSpaces = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetDatapoint = true
-		case 3:
-			if err := p.ReadField3(iprot); err != nil {
-				return err
-			}
-			issetNameSpace = true
+			issetElements = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -7117,48 +7925,59 @@ func (p *WriteBatchRawV2RequestElement) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetID {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
-	}
-	if !issetDatapoint {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Datapoint is not set"))
+	if !issetNameSpaces {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpaces is not set"))
 	}
-	if !issetNameSpace {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
+	if !issetElements {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Elements is not set"))
 	}
 	return nil
 }
 
-func (p *WriteBatchRawV2RequestElement) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.ID = v
+func (p *WriteBatchRawV2Request) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([][]byte, 0, size)
+	p.NameSpaces = tSlice
+	for i := 0; i < size; i++ {
+		var _elem17 []byte
+		if v, err := iprot.ReadBinary(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem17 = v
+		}
+		p.NameSpaces = append(p.NameSpaces, _elem17)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *WriteBatchRawV2RequestElement) ReadField2(iprot thrift.TProtocol) error {
-	p.Datapoint = &Datapoint{
-		TimestampTimeType: 0,
+func (p *WriteBatchRawV2Request) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	if err := p.Datapoint.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Datapoint), err)
+	tSlice := make([]*WriteBatchRawV2RequestElement, 0, size)
+	p.Elements = tSlice
+	for i := 0; i < size; i++ {
+		_elem18 := &WriteBatchRawV2RequestElement{}
+		if err := _elem18.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem18), err)
+		}
+		p.Elements = append(p.Elements, _elem18)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *WriteBatchRawV2RequestElement) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.NameSpace = v
-	}
-	return nil
-}
-
-func (p *WriteBatchRawV2RequestElement) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("WriteBatchRawV2RequestElement"); err != nil {
+func (p *WriteBatchRawV2Request) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("WriteBatchRawV2Request"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7168,9 +7987,6 @@ func (p *WriteBatchRawV2RequestElement) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField2(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7181,78 +7997,90 @@ func (p *WriteBatchRawV2RequestElement) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *WriteBatchRawV2RequestElement) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
-	}
-	if err := oprot.WriteBinary(p.ID); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+func (p *WriteBatchRawV2Request) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("nameSpaces", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:nameSpaces: ", p), err)
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.NameSpaces)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
 	}
-	return err
-}
-
-func (p *WriteBatchRawV2RequestElement) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("datapoint", thrift.STRUCT, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:datapoint: ", p), err)
+	for _, v := range p.NameSpaces {
+		if err := oprot.WriteBinary(v); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
 	}
-	if err := p.Datapoint.Write(oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Datapoint), err)
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:datapoint: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:nameSpaces: ", p), err)
 	}
 	return err
 }
 
-func (p *WriteBatchRawV2RequestElement) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("nameSpace", thrift.I64, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:nameSpace: ", p), err)
+func (p *WriteBatchRawV2Request) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("elements", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:elements: ", p), err)
 	}
-	if err := oprot.WriteI64(int64(p.NameSpace)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (3) field write error: ", p), err)
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Elements)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Elements {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:nameSpace: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:elements: ", p), err)
 	}
 	return err
 }
 
-func (p *WriteBatchRawV2RequestElement) String() string {
+func (p *WriteBatchRawV2Request) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("WriteBatchRawV2RequestElement(%+v)", *p)
+	return fmt.Sprintf("WriteBatchRawV2Request(%+v)", *p)
 }
 
 // Attributes:
-//  - NameSpace
-//  - Elements
-type WriteTaggedBatchRawRequest struct {
-	NameSpace []byte                               `thrift:"nameSpace,1,required" db:"nameSpace" json:"nameSpace"`
-	Elements  []*WriteTaggedBatchRawRequestElement `thrift:"elements,2,required" db:"elements" json:"elements"`
+//  - ID
+//  - Datapoint
+type WriteBatchRawRequestElement struct {
+	ID        []byte     `thrift:"id,1,required" db:"id" json:"id"`
+	Datapoint *Datapoint `thrift:"datapoint,2,required" db:"datapoint" json:"datapoint"`
 }
 
-func NewWriteTaggedBatchRawRequest() *WriteTaggedBatchRawRequest {
-	return &WriteTaggedBatchRawRequest{}
+func NewWriteBatchRawRequestElement() *WriteBatchRawRequestElement {
+	return &WriteBatchRawRequestElement{}
 }
 
-func (p *WriteTaggedBatchRawRequest) GetNameSpace() []byte {
-	return p.NameSpace
+func (p *WriteBatchRawRequestElement) GetID() []byte {
+	return p.ID
 }
 
-func (p *WriteTaggedBatchRawRequest) GetElements() []*WriteTaggedBatchRawRequestElement {
-	return p.Elements
+var WriteBatchRawRequestElement_Datapoint_DEFAULT *Datapoint
+
+func (p *WriteBatchRawRequestElement) GetDatapoint() *Datapoint {
+	if !p.IsSetDatapoint() {
+		return WriteBatchRawRequestElement_Datapoint_DEFAULT
+	}
+	return p.Datapoint
 }
-func (p *WriteTaggedBatchRawRequest) Read(iprot thrift.TProtocol) error {
+func (p *WriteBatchRawRequestElement) IsSetDatapoint() bool {
+	return p.Datapoint != nil
+}
+
+func (p *WriteBatchRawRequestElement) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetNameSpace bool = false
-	var issetElements bool = false
+	var issetID bool = false
+	var issetDatapoint bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -7267,12 +8095,12 @@ func (p *WriteTaggedBatchRawRequest) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetNameSpace = true
+			issetID = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetElements = true
+			issetDatapoint = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -7285,46 +8113,36 @@ func (p *WriteTaggedBatchRawRequest) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetNameSpace {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
+	if !issetID {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
 	}
-	if !issetElements {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Elements is not set"))
+	if !issetDatapoint {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Datapoint is not set"))
 	}
 	return nil
 }
 
-func (p *WriteTaggedBatchRawRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *WriteBatchRawRequestElement) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.NameSpace = v
+		p.ID = v
 	}
 	return nil
 }
 
-func (p *WriteTaggedBatchRawRequest) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*WriteTaggedBatchRawRequestElement, 0, size)
-	p.Elements = tSlice
-	for i := 0; i < size; i++ {
-		_elem19 := &WriteTaggedBatchRawRequestElement{}
-		if err := _elem19.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem19), err)
-		}
-		p.Elements = append(p.Elements, _elem19)
+func (p *WriteBatchRawRequestElement) ReadField2(iprot thrift.TProtocol) error {
+	p.Datapoint = &Datapoint{
+		TimestampTimeType: 0,
 	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	if err := p.Datapoint.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Datapoint), err)
 	}
 	return nil
 }
 
-func (p *WriteTaggedBatchRawRequest) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("WriteTaggedBatchRawRequest"); err != nil {
+func (p *WriteBatchRawRequestElement) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("WriteBatchRawRequestElement"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7344,73 +8162,81 @@ func (p *WriteTaggedBatchRawRequest) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *WriteTaggedBatchRawRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("nameSpace", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:nameSpace: ", p), err)
+func (p *WriteBatchRawRequestElement) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
 	}
-	if err := oprot.WriteBinary(p.NameSpace); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (1) field write error: ", p), err)
+	if err := oprot.WriteBinary(p.ID); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:nameSpace: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
 	}
 	return err
 }
 
-func (p *WriteTaggedBatchRawRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("elements", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:elements: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Elements)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Elements {
-		if err := v.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-		}
+func (p *WriteBatchRawRequestElement) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("datapoint", thrift.STRUCT, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:datapoint: ", p), err)
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := p.Datapoint.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Datapoint), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:elements: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:datapoint: ", p), err)
 	}
 	return err
 }
 
-func (p *WriteTaggedBatchRawRequest) String() string {
+func (p *WriteBatchRawRequestElement) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("WriteTaggedBatchRawRequest(%+v)", *p)
+	return fmt.Sprintf("WriteBatchRawRequestElement(%+v)", *p)
 }
 
 // Attributes:
-//  - NameSpaces
-//  - Elements
-type WriteTaggedBatchRawV2Request struct {
-	NameSpaces [][]byte                               `thrift:"nameSpaces,1,required" db:"nameSpaces" json:"nameSpaces"`
-	Elements   []*WriteTaggedBatchRawV2RequestElement `thrift:"elements,2,required" db:"elements" json:"elements"`
+//  - ID
+//  - Datapoint
+//  - NameSpace
+type WriteBatchRawV2RequestElement struct {
+	ID        []byte     `thrift:"id,1,required" db:"id" json:"id"`
+	Datapoint *Datapoint `thrift:"datapoint,2,required" db:"datapoint" json:"datapoint"`
+	NameSpace int64      `thrift:"nameSpace,3,required" db:"nameSpace" json:"nameSpace"`
 }
 
-func NewWriteTaggedBatchRawV2Request() *WriteTaggedBatchRawV2Request {
-	return &WriteTaggedBatchRawV2Request{}
+func NewWriteBatchRawV2RequestElement() *WriteBatchRawV2RequestElement {
+	return &WriteBatchRawV2RequestElement{}
 }
 
-func (p *WriteTaggedBatchRawV2Request) GetNameSpaces() [][]byte {
-	return p.NameSpaces
+func (p *WriteBatchRawV2RequestElement) GetID() []byte {
+	return p.ID
 }
 
-func (p *WriteTaggedBatchRawV2Request) GetElements() []*WriteTaggedBatchRawV2RequestElement {
-	return p.Elements
+var WriteBatchRawV2RequestElement_Datapoint_DEFAULT *Datapoint
+
+func (p *WriteBatchRawV2RequestElement) GetDatapoint() *Datapoint {
+	if !p.IsSetDatapoint() {
+		return WriteBatchRawV2RequestElement_Datapoint_DEFAULT
+	}
+	return p.Datapoint
 }
-func (p *WriteTaggedBatchRawV2Request) Read(iprot thrift.TProtocol) error {
+
+func (p *WriteBatchRawV2RequestElement) GetNameSpace() int64 {
+	return p.NameSpace
+}
+func (p *WriteBatchRawV2RequestElement) IsSetDatapoint() bool {
+	return p.Datapoint != nil
+}
+
+func (p *WriteBatchRawV2RequestElement) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetNameSpaces bool = false
-	var issetElements bool = false
+	var issetID bool = false
+	var issetDatapoint bool = false
+	var issetNameSpace bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -7425,12 +8251,17 @@ func (p *WriteTaggedBatchRawV2Request) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetNameSpaces = true
+			issetID = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetElements = true
+			issetDatapoint = true
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
+			issetNameSpace = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -7443,59 +8274,48 @@ func (p *WriteTaggedBatchRawV2Request) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetNameSpaces {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpaces is not set"))
+	if !issetID {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
 	}
-	if !issetElements {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Elements is not set"))
+	if !issetDatapoint {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Datapoint is not set"))
+	}
+	if !issetNameSpace {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
 	}
 	return nil
 }
 
-func (p *WriteTaggedBatchRawV2Request) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([][]byte, 0, size)
-	p.NameSpaces = tSlice
-	for i := 0; i < size; i++ {
-		var _elem20 []byte
-		if v, err := iprot.ReadBinary(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem20 = v
-		}
-		p.NameSpaces = append(p.NameSpaces, _elem20)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *WriteBatchRawV2RequestElement) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
 	}
 	return nil
 }
 
-func (p *WriteTaggedBatchRawV2Request) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
+func (p *WriteBatchRawV2RequestElement) ReadField2(iprot thrift.TProtocol) error {
+	p.Datapoint = &Datapoint{
+		TimestampTimeType: 0,
 	}
-	tSlice := make([]*WriteTaggedBatchRawV2RequestElement, 0, size)
-	p.Elements = tSlice
-	for i := 0; i < size; i++ {
-		_elem21 := &WriteTaggedBatchRawV2RequestElement{}
-		if err := _elem21.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem21), err)
-		}
-		p.Elements = append(p.Elements, _elem21)
+	if err := p.Datapoint.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Datapoint), err)
 	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	return nil
+}
+
+func (p *WriteBatchRawV2RequestElement) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.NameSpace = v
 	}
 	return nil
 }
 
-func (p *WriteTaggedBatchRawV2Request) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("WriteTaggedBatchRawV2Request"); err != nil {
+func (p *WriteBatchRawV2RequestElement) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("WriteBatchRawV2RequestElement"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7505,6 +8325,9 @@ func (p *WriteTaggedBatchRawV2Request) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField2(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7515,97 +8338,78 @@ func (p *WriteTaggedBatchRawV2Request) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *WriteTaggedBatchRawV2Request) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("nameSpaces", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:nameSpaces: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.NameSpaces)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.NameSpaces {
-		if err := oprot.WriteBinary(v); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-		}
+func (p *WriteBatchRawV2RequestElement) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := oprot.WriteBinary(p.ID); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:nameSpaces: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
 	}
 	return err
 }
 
-func (p *WriteTaggedBatchRawV2Request) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("elements", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:elements: ", p), err)
+func (p *WriteBatchRawV2RequestElement) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("datapoint", thrift.STRUCT, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:datapoint: ", p), err)
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Elements)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
+	if err := p.Datapoint.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Datapoint), err)
 	}
-	for _, v := range p.Elements {
-		if err := v.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-		}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:datapoint: ", p), err)
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	return err
+}
+
+func (p *WriteBatchRawV2RequestElement) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("nameSpace", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:nameSpace: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.NameSpace)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (3) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:elements: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:nameSpace: ", p), err)
 	}
 	return err
 }
 
-func (p *WriteTaggedBatchRawV2Request) String() string {
+func (p *WriteBatchRawV2RequestElement) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("WriteTaggedBatchRawV2Request(%+v)", *p)
+	return fmt.Sprintf("WriteBatchRawV2RequestElement(%+v)", *p)
 }
 
 // Attributes:
-//  - ID
-//  - EncodedTags
-//  - Datapoint
-type WriteTaggedBatchRawRequestElement struct {
-	ID          []byte     `thrift:"id,1,required" db:"id" json:"id"`
-	EncodedTags []byte     `thrift:"encodedTags,2,required" db:"encodedTags" json:"encodedTags"`
-	Datapoint   *Datapoint `thrift:"datapoint,3,required" db:"datapoint" json:"datapoint"`
-}
-
-func NewWriteTaggedBatchRawRequestElement() *WriteTaggedBatchRawRequestElement {
-	return &WriteTaggedBatchRawRequestElement{}
+//  - NameSpace
+//  - Elements
+type WriteTaggedBatchRawRequest struct {
+	NameSpace []byte                               `thrift:"nameSpace,1,required" db:"nameSpace" json:"nameSpace"`
+	Elements  []*WriteTaggedBatchRawRequestElement `thrift:"elements,2,required" db:"elements" json:"elements"`
 }
 
-func (p *WriteTaggedBatchRawRequestElement) GetID() []byte {
-	return p.ID
+func NewWriteTaggedBatchRawRequest() *WriteTaggedBatchRawRequest {
+	return &WriteTaggedBatchRawRequest{}
 }
 
-func (p *WriteTaggedBatchRawRequestElement) GetEncodedTags() []byte {
-	return p.EncodedTags
+func (p *WriteTaggedBatchRawRequest) GetNameSpace() []byte {
+	return p.NameSpace
 }
 
-var WriteTaggedBatchRawRequestElement_Datapoint_DEFAULT *Datapoint
-
-func (p *WriteTaggedBatchRawRequestElement) GetDatapoint() *Datapoint {
-	if !p.IsSetDatapoint() {
-		return WriteTaggedBatchRawRequestElement_Datapoint_DEFAULT
-	}
-	return p.Datapoint
-}
-func (p *WriteTaggedBatchRawRequestElement) IsSetDatapoint() bool {
-	return p.Datapoint != nil
+func (p *WriteTaggedBatchRawRequest) GetElements() []*WriteTaggedBatchRawRequestElement {
+	return p.Elements
 }
-
-func (p *WriteTaggedBatchRawRequestElement) Read(iprot thrift.TProtocol) error {
+func (p *WriteTaggedBatchRawRequest) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetID bool = false
-	var issetEncodedTags bool = false
-	var issetDatapoint bool = false
+	var issetNameSpace bool = false
+	var issetElements bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -7620,17 +8424,12 @@ func (p *WriteTaggedBatchRawRequestElement) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetID = true
+			issetNameSpace = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetEncodedTags = true
-		case 3:
-			if err := p.ReadField3(iprot); err != nil {
-				return err
-			}
-			issetDatapoint = true
+			issetElements = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -7643,48 +8442,46 @@ func (p *WriteTaggedBatchRawRequestElement) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetID {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
-	}
-	if !issetEncodedTags {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field EncodedTags is not set"))
+	if !issetNameSpace {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
 	}
-	if !issetDatapoint {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Datapoint is not set"))
+	if !issetElements {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Elements is not set"))
 	}
 	return nil
 }
 
-func (p *WriteTaggedBatchRawRequestElement) ReadField1(iprot thrift.TProtocol) error {
+func (p *WriteTaggedBatchRawRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.ID = v
+		p.NameSpace = v
 	}
 	return nil
 }
 
-func (p *WriteTaggedBatchRawRequestElement) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.EncodedTags = v
+func (p *WriteTaggedBatchRawRequest) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	return nil
-}
-
-func (p *WriteTaggedBatchRawRequestElement) ReadField3(iprot thrift.TProtocol) error {
-	p.Datapoint = &Datapoint{
-		TimestampTimeType: 0,
+	tSlice := make([]*WriteTaggedBatchRawRequestElement, 0, size)
+	p.Elements = tSlice
+	for i := 0; i < size; i++ {
+		_elem19 := &WriteTaggedBatchRawRequestElement{}
+		if err := _elem19.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem19), err)
+		}
+		p.Elements = append(p.Elements, _elem19)
 	}
-	if err := p.Datapoint.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Datapoint), err)
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *WriteTaggedBatchRawRequestElement) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("WriteTaggedBatchRawRequestElement"); err != nil {
+func (p *WriteTaggedBatchRawRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("WriteTaggedBatchRawRequest"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7694,9 +8491,6 @@ func (p *WriteTaggedBatchRawRequestElement) Write(oprot thrift.TProtocol) error
 		if err := p.writeField2(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7707,93 +8501,261 @@ func (p *WriteTaggedBatchRawRequestElement) Write(oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *WriteTaggedBatchRawRequestElement) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
+func (p *WriteTaggedBatchRawRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("nameSpace", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:nameSpace: ", p), err)
 	}
-	if err := oprot.WriteBinary(p.ID); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+	if err := oprot.WriteBinary(p.NameSpace); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:nameSpace: ", p), err)
 	}
 	return err
 }
 
-func (p *WriteTaggedBatchRawRequestElement) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("encodedTags", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encodedTags: ", p), err)
-	}
-	if err := oprot.WriteBinary(p.EncodedTags); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.encodedTags (2) field write error: ", p), err)
+func (p *WriteTaggedBatchRawRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("elements", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:elements: ", p), err)
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encodedTags: ", p), err)
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Elements)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
 	}
-	return err
-}
-
-func (p *WriteTaggedBatchRawRequestElement) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("datapoint", thrift.STRUCT, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:datapoint: ", p), err)
+	for _, v := range p.Elements {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
 	}
-	if err := p.Datapoint.Write(oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Datapoint), err)
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:datapoint: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:elements: ", p), err)
 	}
 	return err
 }
 
-func (p *WriteTaggedBatchRawRequestElement) String() string {
+func (p *WriteTaggedBatchRawRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("WriteTaggedBatchRawRequestElement(%+v)", *p)
+	return fmt.Sprintf("WriteTaggedBatchRawRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - ID
-//  - EncodedTags
-//  - Datapoint
-//  - NameSpace
-type WriteTaggedBatchRawV2RequestElement struct {
-	ID          []byte     `thrift:"id,1,required" db:"id" json:"id"`
-	EncodedTags []byte     `thrift:"encodedTags,2,required" db:"encodedTags" json:"encodedTags"`
-	Datapoint   *Datapoint `thrift:"datapoint,3,required" db:"datapoint" json:"datapoint"`
-	NameSpace   int64      `thrift:"nameSpace,4,required" db:"nameSpace" json:"nameSpace"`
-}
-
-func NewWriteTaggedBatchRawV2RequestElement() *WriteTaggedBatchRawV2RequestElement {
-	return &WriteTaggedBatchRawV2RequestElement{}
+//  - NameSpaces
+//  - Elements
+type WriteTaggedBatchRawV2Request struct {
+	NameSpaces [][]byte                               `thrift:"nameSpaces,1,required" db:"nameSpaces" json:"nameSpaces"`
+	Elements   []*WriteTaggedBatchRawV2RequestElement `thrift:"elements,2,required" db:"elements" json:"elements"`
 }
 
-func (p *WriteTaggedBatchRawV2RequestElement) GetID() []byte {
+func NewWriteTaggedBatchRawV2Request() *WriteTaggedBatchRawV2Request {
+	return &WriteTaggedBatchRawV2Request{}
+}
+
+func (p *WriteTaggedBatchRawV2Request) GetNameSpaces() [][]byte {
+	return p.NameSpaces
+}
+
+func (p *WriteTaggedBatchRawV2Request) GetElements() []*WriteTaggedBatchRawV2RequestElement {
+	return p.Elements
+}
+func (p *WriteTaggedBatchRawV2Request) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetNameSpaces bool = false
+	var issetElements bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetNameSpaces = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+			issetElements = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetNameSpaces {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpaces is not set"))
+	}
+	if !issetElements {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Elements is not set"))
+	}
+	return nil
+}
+
+func (p *WriteTaggedBatchRawV2Request) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([][]byte, 0, size)
+	p.NameSpaces = tSlice
+	for i := 0; i < size; i++ {
+		var _elem20 []byte
+		if v, err := iprot.ReadBinary(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem20 = v
+		}
+		p.NameSpaces = append(p.NameSpaces, _elem20)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *WriteTaggedBatchRawV2Request) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*WriteTaggedBatchRawV2RequestElement, 0, size)
+	p.Elements = tSlice
+	for i := 0; i < size; i++ {
+		_elem21 := &WriteTaggedBatchRawV2RequestElement{}
+		if err := _elem21.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem21), err)
+		}
+		p.Elements = append(p.Elements, _elem21)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *WriteTaggedBatchRawV2Request) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("WriteTaggedBatchRawV2Request"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *WriteTaggedBatchRawV2Request) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("nameSpaces", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:nameSpaces: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.NameSpaces)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.NameSpaces {
+		if err := oprot.WriteBinary(v); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:nameSpaces: ", p), err)
+	}
+	return err
+}
+
+func (p *WriteTaggedBatchRawV2Request) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("elements", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:elements: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Elements)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Elements {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:elements: ", p), err)
+	}
+	return err
+}
+
+func (p *WriteTaggedBatchRawV2Request) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WriteTaggedBatchRawV2Request(%+v)", *p)
+}
+
+// Attributes:
+//  - ID
+//  - EncodedTags
+//  - Datapoint
+type WriteTaggedBatchRawRequestElement struct {
+	ID          []byte     `thrift:"id,1,required" db:"id" json:"id"`
+	EncodedTags []byte     `thrift:"encodedTags,2,required" db:"encodedTags" json:"encodedTags"`
+	Datapoint   *Datapoint `thrift:"datapoint,3,required" db:"datapoint" json:"datapoint"`
+}
+
+func NewWriteTaggedBatchRawRequestElement() *WriteTaggedBatchRawRequestElement {
+	return &WriteTaggedBatchRawRequestElement{}
+}
+
+func (p *WriteTaggedBatchRawRequestElement) GetID() []byte {
 	return p.ID
 }
 
-func (p *WriteTaggedBatchRawV2RequestElement) GetEncodedTags() []byte {
+func (p *WriteTaggedBatchRawRequestElement) GetEncodedTags() []byte {
 	return p.EncodedTags
 }
 
-var WriteTaggedBatchRawV2RequestElement_Datapoint_DEFAULT *Datapoint
+var WriteTaggedBatchRawRequestElement_Datapoint_DEFAULT *Datapoint
 
-func (p *WriteTaggedBatchRawV2RequestElement) GetDatapoint() *Datapoint {
+func (p *WriteTaggedBatchRawRequestElement) GetDatapoint() *Datapoint {
 	if !p.IsSetDatapoint() {
-		return WriteTaggedBatchRawV2RequestElement_Datapoint_DEFAULT
+		return WriteTaggedBatchRawRequestElement_Datapoint_DEFAULT
 	}
 	return p.Datapoint
 }
-
-func (p *WriteTaggedBatchRawV2RequestElement) GetNameSpace() int64 {
-	return p.NameSpace
-}
-func (p *WriteTaggedBatchRawV2RequestElement) IsSetDatapoint() bool {
+func (p *WriteTaggedBatchRawRequestElement) IsSetDatapoint() bool {
 	return p.Datapoint != nil
 }
 
-func (p *WriteTaggedBatchRawV2RequestElement) Read(iprot thrift.TProtocol) error {
+func (p *WriteTaggedBatchRawRequestElement) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -7801,7 +8763,6 @@ func (p *WriteTaggedBatchRawV2RequestElement) Read(iprot thrift.TProtocol) error
 	var issetID bool = false
 	var issetEncodedTags bool = false
 	var issetDatapoint bool = false
-	var issetNameSpace bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -7827,11 +8788,6 @@ func (p *WriteTaggedBatchRawV2RequestElement) Read(iprot thrift.TProtocol) error
 				return err
 			}
 			issetDatapoint = true
-		case 4:
-			if err := p.ReadField4(iprot); err != nil {
-				return err
-			}
-			issetNameSpace = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -7853,13 +8809,10 @@ func (p *WriteTaggedBatchRawV2RequestElement) Read(iprot thrift.TProtocol) error
 	if !issetDatapoint {
 		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Datapoint is not set"))
 	}
-	if !issetNameSpace {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
-	}
 	return nil
 }
 
-func (p *WriteTaggedBatchRawV2RequestElement) ReadField1(iprot thrift.TProtocol) error {
+func (p *WriteTaggedBatchRawRequestElement) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
@@ -7868,7 +8821,7 @@ func (p *WriteTaggedBatchRawV2RequestElement) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *WriteTaggedBatchRawV2RequestElement) ReadField2(iprot thrift.TProtocol) error {
+func (p *WriteTaggedBatchRawRequestElement) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
@@ -7877,7 +8830,7 @@ func (p *WriteTaggedBatchRawV2RequestElement) ReadField2(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *WriteTaggedBatchRawV2RequestElement) ReadField3(iprot thrift.TProtocol) error {
+func (p *WriteTaggedBatchRawRequestElement) ReadField3(iprot thrift.TProtocol) error {
 	p.Datapoint = &Datapoint{
 		TimestampTimeType: 0,
 	}
@@ -7887,17 +8840,8 @@ func (p *WriteTaggedBatchRawV2RequestElement) ReadField3(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *WriteTaggedBatchRawV2RequestElement) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		p.NameSpace = v
-	}
-	return nil
-}
-
-func (p *WriteTaggedBatchRawV2RequestElement) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("WriteTaggedBatchRawV2RequestElement"); err != nil {
+func (p *WriteTaggedBatchRawRequestElement) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("WriteTaggedBatchRawRequestElement"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7910,9 +8854,6 @@ func (p *WriteTaggedBatchRawV2RequestElement) Write(oprot thrift.TProtocol) erro
 		if err := p.writeField3(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField4(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7923,7 +8864,7 @@ func (p *WriteTaggedBatchRawV2RequestElement) Write(oprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *WriteTaggedBatchRawV2RequestElement) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WriteTaggedBatchRawRequestElement) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
 	}
@@ -7936,7 +8877,7 @@ func (p *WriteTaggedBatchRawV2RequestElement) writeField1(oprot thrift.TProtocol
 	return err
 }
 
-func (p *WriteTaggedBatchRawV2RequestElement) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WriteTaggedBatchRawRequestElement) writeField2(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("encodedTags", thrift.STRING, 2); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encodedTags: ", p), err)
 	}
@@ -7949,7 +8890,7 @@ func (p *WriteTaggedBatchRawV2RequestElement) writeField2(oprot thrift.TProtocol
 	return err
 }
 
-func (p *WriteTaggedBatchRawV2RequestElement) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WriteTaggedBatchRawRequestElement) writeField3(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("datapoint", thrift.STRUCT, 3); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:datapoint: ", p), err)
 	}
@@ -7962,12 +8903,228 @@ func (p *WriteTaggedBatchRawV2RequestElement) writeField3(oprot thrift.TProtocol
 	return err
 }
 
-func (p *WriteTaggedBatchRawV2RequestElement) writeField4(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("nameSpace", thrift.I64, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:nameSpace: ", p), err)
-	}
-	if err := oprot.WriteI64(int64(p.NameSpace)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (4) field write error: ", p), err)
+func (p *WriteTaggedBatchRawRequestElement) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WriteTaggedBatchRawRequestElement(%+v)", *p)
+}
+
+// Attributes:
+//  - ID
+//  - EncodedTags
+//  - Datapoint
+//  - NameSpace
+type WriteTaggedBatchRawV2RequestElement struct {
+	ID          []byte     `thrift:"id,1,required" db:"id" json:"id"`
+	EncodedTags []byte     `thrift:"encodedTags,2,required" db:"encodedTags" json:"encodedTags"`
+	Datapoint   *Datapoint `thrift:"datapoint,3,required" db:"datapoint" json:"datapoint"`
+	NameSpace   int64      `thrift:"nameSpace,4,required" db:"nameSpace" json:"nameSpace"`
+}
+
+func NewWriteTaggedBatchRawV2RequestElement() *WriteTaggedBatchRawV2RequestElement {
+	return &WriteTaggedBatchRawV2RequestElement{}
+}
+
+func (p *WriteTaggedBatchRawV2RequestElement) GetID() []byte {
+	return p.ID
+}
+
+func (p *WriteTaggedBatchRawV2RequestElement) GetEncodedTags() []byte {
+	return p.EncodedTags
+}
+
+var WriteTaggedBatchRawV2RequestElement_Datapoint_DEFAULT *Datapoint
+
+func (p *WriteTaggedBatchRawV2RequestElement) GetDatapoint() *Datapoint {
+	if !p.IsSetDatapoint() {
+		return WriteTaggedBatchRawV2RequestElement_Datapoint_DEFAULT
+	}
+	return p.Datapoint
+}
+
+func (p *WriteTaggedBatchRawV2RequestElement) GetNameSpace() int64 {
+	return p.NameSpace
+}
+func (p *WriteTaggedBatchRawV2RequestElement) IsSetDatapoint() bool {
+	return p.Datapoint != nil
+}
+
+func (p *WriteTaggedBatchRawV2RequestElement) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetID bool = false
+	var issetEncodedTags bool = false
+	var issetDatapoint bool = false
+	var issetNameSpace bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetID = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+			issetEncodedTags = true
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
+			issetDatapoint = true
+		case 4:
+			if err := p.ReadField4(iprot); err != nil {
+				return err
+			}
+			issetNameSpace = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetID {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
+	}
+	if !issetEncodedTags {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field EncodedTags is not set"))
+	}
+	if !issetDatapoint {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Datapoint is not set"))
+	}
+	if !issetNameSpace {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
+	}
+	return nil
+}
+
+func (p *WriteTaggedBatchRawV2RequestElement) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *WriteTaggedBatchRawV2RequestElement) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.EncodedTags = v
+	}
+	return nil
+}
+
+func (p *WriteTaggedBatchRawV2RequestElement) ReadField3(iprot thrift.TProtocol) error {
+	p.Datapoint = &Datapoint{
+		TimestampTimeType: 0,
+	}
+	if err := p.Datapoint.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Datapoint), err)
+	}
+	return nil
+}
+
+func (p *WriteTaggedBatchRawV2RequestElement) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.NameSpace = v
+	}
+	return nil
+}
+
+func (p *WriteTaggedBatchRawV2RequestElement) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("WriteTaggedBatchRawV2RequestElement"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *WriteTaggedBatchRawV2RequestElement) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
+	}
+	if err := oprot.WriteBinary(p.ID); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+	}
+	return err
+}
+
+func (p *WriteTaggedBatchRawV2RequestElement) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("encodedTags", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encodedTags: ", p), err)
+	}
+	if err := oprot.WriteBinary(p.EncodedTags); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.encodedTags (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encodedTags: ", p), err)
+	}
+	return err
+}
+
+func (p *WriteTaggedBatchRawV2RequestElement) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("datapoint", thrift.STRUCT, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:datapoint: ", p), err)
+	}
+	if err := p.Datapoint.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Datapoint), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:datapoint: ", p), err)
+	}
+	return err
+}
+
+func (p *WriteTaggedBatchRawV2RequestElement) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("nameSpace", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:nameSpace: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.NameSpace)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (4) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:nameSpace: ", p), err)
@@ -8330,50 +9487,59 @@ func (p *TruncateResult_) String() string {
 }
 
 // Attributes:
-//  - Ok
-//  - Status
-//  - Bootstrapped
-//  - Metadata
-type NodeHealthResult_ struct {
-	Ok           bool              `thrift:"ok,1,required" db:"ok" json:"ok"`
-	Status       string            `thrift:"status,2,required" db:"status" json:"status"`
-	Bootstrapped bool              `thrift:"bootstrapped,3,required" db:"bootstrapped" json:"bootstrapped"`
-	Metadata     map[string]string `thrift:"metadata,4" db:"metadata" json:"metadata,omitempty"`
+//  - NameSpace
+//  - Query
+//  - RangeStart
+//  - RangeEnd
+//  - RangeTimeType
+type DeleteTaggedRequest struct {
+	NameSpace     []byte   `thrift:"nameSpace,1,required" db:"nameSpace" json:"nameSpace"`
+	Query         []byte   `thrift:"query,2,required" db:"query" json:"query"`
+	RangeStart    int64    `thrift:"rangeStart,3,required" db:"rangeStart" json:"rangeStart"`
+	RangeEnd      int64    `thrift:"rangeEnd,4,required" db:"rangeEnd" json:"rangeEnd"`
+	RangeTimeType TimeType `thrift:"rangeTimeType,5" db:"rangeTimeType" json:"rangeTimeType,omitempty"`
 }
 
-func NewNodeHealthResult_() *NodeHealthResult_ {
-	return &NodeHealthResult_{}
+func NewDeleteTaggedRequest() *DeleteTaggedRequest {
+	return &DeleteTaggedRequest{
+		RangeTimeType: 0,
+	}
 }
 
-func (p *NodeHealthResult_) GetOk() bool {
-	return p.Ok
+func (p *DeleteTaggedRequest) GetNameSpace() []byte {
+	return p.NameSpace
 }
 
-func (p *NodeHealthResult_) GetStatus() string {
-	return p.Status
+func (p *DeleteTaggedRequest) GetQuery() []byte {
+	return p.Query
 }
 
-func (p *NodeHealthResult_) GetBootstrapped() bool {
-	return p.Bootstrapped
+func (p *DeleteTaggedRequest) GetRangeStart() int64 {
+	return p.RangeStart
 }
 
-var NodeHealthResult__Metadata_DEFAULT map[string]string
+func (p *DeleteTaggedRequest) GetRangeEnd() int64 {
+	return p.RangeEnd
+}
 
-func (p *NodeHealthResult_) GetMetadata() map[string]string {
-	return p.Metadata
+var DeleteTaggedRequest_RangeTimeType_DEFAULT TimeType = 0
+
+func (p *DeleteTaggedRequest) GetRangeTimeType() TimeType {
+	return p.RangeTimeType
 }
-func (p *NodeHealthResult_) IsSetMetadata() bool {
-	return p.Metadata != nil
+func (p *DeleteTaggedRequest) IsSetRangeTimeType() bool {
+	return p.RangeTimeType != DeleteTaggedRequest_RangeTimeType_DEFAULT
 }
 
-func (p *NodeHealthResult_) Read(iprot thrift.TProtocol) error {
+func (p *DeleteTaggedRequest) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetOk bool = false
-	var issetStatus bool = false
-	var issetBootstrapped bool = false
+	var issetNameSpace bool = false
+	var issetQuery bool = false
+	var issetRangeStart bool = false
+	var issetRangeEnd bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -8388,21 +9554,26 @@ func (p *NodeHealthResult_) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetOk = true
+			issetNameSpace = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetStatus = true
+			issetQuery = true
 		case 3:
 			if err := p.ReadField3(iprot); err != nil {
 				return err
 			}
-			issetBootstrapped = true
+			issetRangeStart = true
 		case 4:
 			if err := p.ReadField4(iprot); err != nil {
 				return err
 			}
+			issetRangeEnd = true
+		case 5:
+			if err := p.ReadField5(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -8415,75 +9586,69 @@ func (p *NodeHealthResult_) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetOk {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Ok is not set"))
+	if !issetNameSpace {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
 	}
-	if !issetStatus {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Status is not set"))
+	if !issetQuery {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Query is not set"))
 	}
-	if !issetBootstrapped {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Bootstrapped is not set"))
+	if !issetRangeStart {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RangeStart is not set"))
+	}
+	if !issetRangeEnd {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RangeEnd is not set"))
 	}
 	return nil
 }
 
-func (p *NodeHealthResult_) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(); err != nil {
+func (p *DeleteTaggedRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.Ok = v
+		p.NameSpace = v
 	}
 	return nil
 }
 
-func (p *NodeHealthResult_) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *DeleteTaggedRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.Status = v
+		p.Query = v
 	}
 	return nil
 }
 
-func (p *NodeHealthResult_) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(); err != nil {
+func (p *DeleteTaggedRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.Bootstrapped = v
+		p.RangeStart = v
 	}
 	return nil
 }
 
-func (p *NodeHealthResult_) ReadField4(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return thrift.PrependError("error reading map begin: ", err)
-	}
-	tMap := make(map[string]string, size)
-	p.Metadata = tMap
-	for i := 0; i < size; i++ {
-		var _key22 string
-		if v, err := iprot.ReadString(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_key22 = v
-		}
-		var _val23 string
-		if v, err := iprot.ReadString(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_val23 = v
-		}
-		p.Metadata[_key22] = _val23
+func (p *DeleteTaggedRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.RangeEnd = v
 	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return thrift.PrependError("error reading map end: ", err)
+	return nil
+}
+
+func (p *DeleteTaggedRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		temp := TimeType(v)
+		p.RangeTimeType = temp
 	}
 	return nil
 }
 
-func (p *NodeHealthResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("NodeHealthResult"); err != nil {
+func (p *DeleteTaggedRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DeleteTaggedRequest"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -8499,6 +9664,9 @@ func (p *NodeHealthResult_) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField4(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField5(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -8509,90 +9677,100 @@ func (p *NodeHealthResult_) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeHealthResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("ok", thrift.BOOL, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ok: ", p), err)
+func (p *DeleteTaggedRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("nameSpace", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:nameSpace: ", p), err)
 	}
-	if err := oprot.WriteBool(bool(p.Ok)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.ok (1) field write error: ", p), err)
+	if err := oprot.WriteBinary(p.NameSpace); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ok: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:nameSpace: ", p), err)
 	}
 	return err
 }
 
-func (p *NodeHealthResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:status: ", p), err)
+func (p *DeleteTaggedRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("query", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:query: ", p), err)
 	}
-	if err := oprot.WriteString(string(p.Status)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.status (2) field write error: ", p), err)
+	if err := oprot.WriteBinary(p.Query); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.query (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:status: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:query: ", p), err)
 	}
 	return err
 }
 
-func (p *NodeHealthResult_) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("bootstrapped", thrift.BOOL, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:bootstrapped: ", p), err)
+func (p *DeleteTaggedRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("rangeStart", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:rangeStart: ", p), err)
 	}
-	if err := oprot.WriteBool(bool(p.Bootstrapped)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.bootstrapped (3) field write error: ", p), err)
+	if err := oprot.WriteI64(int64(p.RangeStart)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.rangeStart (3) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:bootstrapped: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:rangeStart: ", p), err)
 	}
 	return err
 }
 
-func (p *NodeHealthResult_) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMetadata() {
-		if err := oprot.WriteFieldBegin("metadata", thrift.MAP, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:metadata: ", p), err)
-		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Metadata)); err != nil {
-			return thrift.PrependError("error writing map begin: ", err)
-		}
-		for k, v := range p.Metadata {
-			if err := oprot.WriteString(string(k)); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-			}
-			if err := oprot.WriteString(string(v)); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-			}
+func (p *DeleteTaggedRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("rangeEnd", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:rangeEnd: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.RangeEnd)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.rangeEnd (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:rangeEnd: ", p), err)
+	}
+	return err
+}
+
+func (p *DeleteTaggedRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRangeTimeType() {
+		if err := oprot.WriteFieldBegin("rangeTimeType", thrift.I32, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:rangeTimeType: ", p), err)
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
-			return thrift.PrependError("error writing map end: ", err)
+		if err := oprot.WriteI32(int32(p.RangeTimeType)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.rangeTimeType (5) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:metadata: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:rangeTimeType: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *NodeHealthResult_) String() string {
+func (p *DeleteTaggedRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeHealthResult_(%+v)", *p)
+	return fmt.Sprintf("DeleteTaggedRequest(%+v)", *p)
 }
 
-type NodeBootstrappedResult_ struct {
+// Attributes:
+//  - NumSeries
+type DeleteTaggedResult_ struct {
+	NumSeries int64 `thrift:"numSeries,1,required" db:"numSeries" json:"numSeries"`
 }
 
-func NewNodeBootstrappedResult_() *NodeBootstrappedResult_ {
-	return &NodeBootstrappedResult_{}
+func NewDeleteTaggedResult_() *DeleteTaggedResult_ {
+	return &DeleteTaggedResult_{}
 }
 
-func (p *NodeBootstrappedResult_) Read(iprot thrift.TProtocol) error {
+func (p *DeleteTaggedResult_) GetNumSeries() int64 {
+	return p.NumSeries
+}
+func (p *DeleteTaggedResult_) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
+	var issetNumSeries bool = false
+
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -8601,8 +9779,16 @@ func (p *NodeBootstrappedResult_) Read(iprot thrift.TProtocol) error {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err := iprot.Skip(fieldTypeId); err != nil {
-			return err
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetNumSeries = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
 		}
 		if err := iprot.ReadFieldEnd(); err != nil {
 			return err
@@ -8611,14 +9797,29 @@ func (p *NodeBootstrappedResult_) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
+	if !issetNumSeries {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumSeries is not set"))
+	}
 	return nil
 }
 
-func (p *NodeBootstrappedResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("NodeBootstrappedResult"); err != nil {
+func (p *DeleteTaggedResult_) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.NumSeries = v
+	}
+	return nil
+}
+
+func (p *DeleteTaggedResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DeleteTaggedResult"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -8629,101 +9830,71 @@ func (p *NodeBootstrappedResult_) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeBootstrappedResult_) String() string {
+func (p *DeleteTaggedResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("numSeries", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numSeries: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.NumSeries)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.numSeries (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numSeries: ", p), err)
+	}
+	return err
+}
+
+func (p *DeleteTaggedResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeBootstrappedResult_(%+v)", *p)
+	return fmt.Sprintf("DeleteTaggedResult_(%+v)", *p)
 }
 
-type NodeBootstrappedInPlacementOrNoPlacementResult_ struct {
+// Attributes:
+//  - Ok
+//  - Status
+//  - Bootstrapped
+//  - Metadata
+type NodeHealthResult_ struct {
+	Ok           bool              `thrift:"ok,1,required" db:"ok" json:"ok"`
+	Status       string            `thrift:"status,2,required" db:"status" json:"status"`
+	Bootstrapped bool              `thrift:"bootstrapped,3,required" db:"bootstrapped" json:"bootstrapped"`
+	Metadata     map[string]string `thrift:"metadata,4" db:"metadata" json:"metadata,omitempty"`
 }
 
-func NewNodeBootstrappedInPlacementOrNoPlacementResult_() *NodeBootstrappedInPlacementOrNoPlacementResult_ {
-	return &NodeBootstrappedInPlacementOrNoPlacementResult_{}
+func NewNodeHealthResult_() *NodeHealthResult_ {
+	return &NodeHealthResult_{}
 }
 
-func (p *NodeBootstrappedInPlacementOrNoPlacementResult_) Read(iprot thrift.TProtocol) error {
+func (p *NodeHealthResult_) GetOk() bool {
+	return p.Ok
+}
+
+func (p *NodeHealthResult_) GetStatus() string {
+	return p.Status
+}
+
+func (p *NodeHealthResult_) GetBootstrapped() bool {
+	return p.Bootstrapped
+}
+
+var NodeHealthResult__Metadata_DEFAULT map[string]string
+
+func (p *NodeHealthResult_) GetMetadata() map[string]string {
+	return p.Metadata
+}
+func (p *NodeHealthResult_) IsSetMetadata() bool {
+	return p.Metadata != nil
+}
+
+func (p *NodeHealthResult_) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		if err := iprot.Skip(fieldTypeId); err != nil {
-			return err
-		}
-		if err := iprot.ReadFieldEnd(); err != nil {
-			return err
-		}
-	}
-	if err := iprot.ReadStructEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	return nil
-}
-
-func (p *NodeBootstrappedInPlacementOrNoPlacementResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("NodeBootstrappedInPlacementOrNoPlacementResult"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
-	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
-	}
-	return nil
-}
-
-func (p *NodeBootstrappedInPlacementOrNoPlacementResult_) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("NodeBootstrappedInPlacementOrNoPlacementResult_(%+v)", *p)
-}
-
-// Attributes:
-//  - LimitEnabled
-//  - LimitMbps
-//  - LimitCheckEvery
-type NodePersistRateLimitResult_ struct {
-	LimitEnabled    bool    `thrift:"limitEnabled,1,required" db:"limitEnabled" json:"limitEnabled"`
-	LimitMbps       float64 `thrift:"limitMbps,2,required" db:"limitMbps" json:"limitMbps"`
-	LimitCheckEvery int64   `thrift:"limitCheckEvery,3,required" db:"limitCheckEvery" json:"limitCheckEvery"`
-}
-
-func NewNodePersistRateLimitResult_() *NodePersistRateLimitResult_ {
-	return &NodePersistRateLimitResult_{}
-}
-
-func (p *NodePersistRateLimitResult_) GetLimitEnabled() bool {
-	return p.LimitEnabled
-}
-
-func (p *NodePersistRateLimitResult_) GetLimitMbps() float64 {
-	return p.LimitMbps
-}
-
-func (p *NodePersistRateLimitResult_) GetLimitCheckEvery() int64 {
-	return p.LimitCheckEvery
-}
-func (p *NodePersistRateLimitResult_) Read(iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-	}
-
-	var issetLimitEnabled bool = false
-	var issetLimitMbps bool = false
-	var issetLimitCheckEvery bool = false
+
+	var issetOk bool = false
+	var issetStatus bool = false
+	var issetBootstrapped bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -8738,17 +9909,21 @@ func (p *NodePersistRateLimitResult_) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetLimitEnabled = true
+			issetOk = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetLimitMbps = true
+			issetStatus = true
 		case 3:
 			if err := p.ReadField3(iprot); err != nil {
 				return err
 			}
-			issetLimitCheckEvery = true
+			issetBootstrapped = true
+		case 4:
+			if err := p.ReadField4(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -8761,47 +9936,75 @@ func (p *NodePersistRateLimitResult_) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetLimitEnabled {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field LimitEnabled is not set"))
+	if !issetOk {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Ok is not set"))
 	}
-	if !issetLimitMbps {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field LimitMbps is not set"))
+	if !issetStatus {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Status is not set"))
 	}
-	if !issetLimitCheckEvery {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field LimitCheckEvery is not set"))
+	if !issetBootstrapped {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Bootstrapped is not set"))
 	}
 	return nil
 }
 
-func (p *NodePersistRateLimitResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeHealthResult_) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBool(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.LimitEnabled = v
+		p.Ok = v
 	}
 	return nil
 }
 
-func (p *NodePersistRateLimitResult_) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadDouble(); err != nil {
+func (p *NodeHealthResult_) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.LimitMbps = v
+		p.Status = v
 	}
 	return nil
 }
 
-func (p *NodePersistRateLimitResult_) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *NodeHealthResult_) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.LimitCheckEvery = v
+		p.Bootstrapped = v
 	}
 	return nil
 }
 
-func (p *NodePersistRateLimitResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("NodePersistRateLimitResult"); err != nil {
+func (p *NodeHealthResult_) ReadField4(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.PrependError("error reading map begin: ", err)
+	}
+	tMap := make(map[string]string, size)
+	p.Metadata = tMap
+	for i := 0; i < size; i++ {
+		var _key22 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key22 = v
+		}
+		var _val23 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_val23 = v
+		}
+		p.Metadata[_key22] = _val23
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
+	}
+	return nil
+}
+
+func (p *NodeHealthResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("NodeHealthResult"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -8814,6 +10017,9 @@ func (p *NodePersistRateLimitResult_) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField3(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -8824,105 +10030,141 @@ func (p *NodePersistRateLimitResult_) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodePersistRateLimitResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("limitEnabled", thrift.BOOL, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:limitEnabled: ", p), err)
+func (p *NodeHealthResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("ok", thrift.BOOL, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ok: ", p), err)
 	}
-	if err := oprot.WriteBool(bool(p.LimitEnabled)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.limitEnabled (1) field write error: ", p), err)
+	if err := oprot.WriteBool(bool(p.Ok)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ok (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:limitEnabled: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ok: ", p), err)
 	}
 	return err
 }
 
-func (p *NodePersistRateLimitResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("limitMbps", thrift.DOUBLE, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:limitMbps: ", p), err)
+func (p *NodeHealthResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("status", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:status: ", p), err)
 	}
-	if err := oprot.WriteDouble(float64(p.LimitMbps)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.limitMbps (2) field write error: ", p), err)
+	if err := oprot.WriteString(string(p.Status)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.status (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:limitMbps: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:status: ", p), err)
 	}
 	return err
 }
 
-func (p *NodePersistRateLimitResult_) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("limitCheckEvery", thrift.I64, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:limitCheckEvery: ", p), err)
+func (p *NodeHealthResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("bootstrapped", thrift.BOOL, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:bootstrapped: ", p), err)
 	}
-	if err := oprot.WriteI64(int64(p.LimitCheckEvery)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.limitCheckEvery (3) field write error: ", p), err)
+	if err := oprot.WriteBool(bool(p.Bootstrapped)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.bootstrapped (3) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:limitCheckEvery: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:bootstrapped: ", p), err)
 	}
 	return err
 }
 
-func (p *NodePersistRateLimitResult_) String() string {
+func (p *NodeHealthResult_) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMetadata() {
+		if err := oprot.WriteFieldBegin("metadata", thrift.MAP, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:metadata: ", p), err)
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Metadata)); err != nil {
+			return thrift.PrependError("error writing map begin: ", err)
+		}
+		for k, v := range p.Metadata {
+			if err := oprot.WriteString(string(k)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return thrift.PrependError("error writing map end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:metadata: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *NodeHealthResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodePersistRateLimitResult_(%+v)", *p)
+	return fmt.Sprintf("NodeHealthResult_(%+v)", *p)
 }
 
-// Attributes:
-//  - LimitEnabled
-//  - LimitMbps
-//  - LimitCheckEvery
-type NodeSetPersistRateLimitRequest struct {
-	LimitEnabled    *bool    `thrift:"limitEnabled,1" db:"limitEnabled" json:"limitEnabled,omitempty"`
-	LimitMbps       *float64 `thrift:"limitMbps,2" db:"limitMbps" json:"limitMbps,omitempty"`
-	LimitCheckEvery *int64   `thrift:"limitCheckEvery,3" db:"limitCheckEvery" json:"limitCheckEvery,omitempty"`
+type NodeBootstrappedResult_ struct {
 }
 
-func NewNodeSetPersistRateLimitRequest() *NodeSetPersistRateLimitRequest {
-	return &NodeSetPersistRateLimitRequest{}
+func NewNodeBootstrappedResult_() *NodeBootstrappedResult_ {
+	return &NodeBootstrappedResult_{}
 }
 
-var NodeSetPersistRateLimitRequest_LimitEnabled_DEFAULT bool
+func (p *NodeBootstrappedResult_) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
 
-func (p *NodeSetPersistRateLimitRequest) GetLimitEnabled() bool {
-	if !p.IsSetLimitEnabled() {
-		return NodeSetPersistRateLimitRequest_LimitEnabled_DEFAULT
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
 	}
-	return *p.LimitEnabled
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-var NodeSetPersistRateLimitRequest_LimitMbps_DEFAULT float64
-
-func (p *NodeSetPersistRateLimitRequest) GetLimitMbps() float64 {
-	if !p.IsSetLimitMbps() {
-		return NodeSetPersistRateLimitRequest_LimitMbps_DEFAULT
+func (p *NodeBootstrappedResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("NodeBootstrappedResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	return *p.LimitMbps
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-var NodeSetPersistRateLimitRequest_LimitCheckEvery_DEFAULT int64
-
-func (p *NodeSetPersistRateLimitRequest) GetLimitCheckEvery() int64 {
-	if !p.IsSetLimitCheckEvery() {
-		return NodeSetPersistRateLimitRequest_LimitCheckEvery_DEFAULT
+func (p *NodeBootstrappedResult_) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.LimitCheckEvery
-}
-func (p *NodeSetPersistRateLimitRequest) IsSetLimitEnabled() bool {
-	return p.LimitEnabled != nil
+	return fmt.Sprintf("NodeBootstrappedResult_(%+v)", *p)
 }
 
-func (p *NodeSetPersistRateLimitRequest) IsSetLimitMbps() bool {
-	return p.LimitMbps != nil
+type NodeBootstrappedInPlacementOrNoPlacementResult_ struct {
 }
 
-func (p *NodeSetPersistRateLimitRequest) IsSetLimitCheckEvery() bool {
-	return p.LimitCheckEvery != nil
+func NewNodeBootstrappedInPlacementOrNoPlacementResult_() *NodeBootstrappedInPlacementOrNoPlacementResult_ {
+	return &NodeBootstrappedInPlacementOrNoPlacementResult_{}
 }
 
-func (p *NodeSetPersistRateLimitRequest) Read(iprot thrift.TProtocol) error {
+func (p *NodeBootstrappedInPlacementOrNoPlacementResult_) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -8935,23 +10177,8 @@ func (p *NodeSetPersistRateLimitRequest) Read(iprot thrift.TProtocol) error {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		switch fieldId {
-		case 1:
-			if err := p.ReadField1(iprot); err != nil {
-				return err
-			}
-		case 2:
-			if err := p.ReadField2(iprot); err != nil {
-				return err
-			}
-		case 3:
-			if err := p.ReadField3(iprot); err != nil {
-				return err
-			}
-		default:
-			if err := iprot.Skip(fieldTypeId); err != nil {
-				return err
-			}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
 		}
 		if err := iprot.ReadFieldEnd(); err != nil {
 			return err
@@ -8963,47 +10190,11 @@ func (p *NodeSetPersistRateLimitRequest) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeSetPersistRateLimitRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.LimitEnabled = &v
-	}
-	return nil
-}
-
-func (p *NodeSetPersistRateLimitRequest) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadDouble(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.LimitMbps = &v
-	}
-	return nil
-}
-
-func (p *NodeSetPersistRateLimitRequest) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.LimitCheckEvery = &v
-	}
-	return nil
-}
-
-func (p *NodeSetPersistRateLimitRequest) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("NodeSetPersistRateLimitRequest"); err != nil {
+func (p *NodeBootstrappedInPlacementOrNoPlacementResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("NodeBootstrappedInPlacementOrNoPlacementResult"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
-		if err := p.writeField1(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField2(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField3(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -9014,77 +10205,46 @@ func (p *NodeSetPersistRateLimitRequest) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeSetPersistRateLimitRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLimitEnabled() {
-		if err := oprot.WriteFieldBegin("limitEnabled", thrift.BOOL, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:limitEnabled: ", p), err)
-		}
-		if err := oprot.WriteBool(bool(*p.LimitEnabled)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.limitEnabled (1) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:limitEnabled: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *NodeSetPersistRateLimitRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLimitMbps() {
-		if err := oprot.WriteFieldBegin("limitMbps", thrift.DOUBLE, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:limitMbps: ", p), err)
-		}
-		if err := oprot.WriteDouble(float64(*p.LimitMbps)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.limitMbps (2) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:limitMbps: ", p), err)
-		}
+func (p *NodeBootstrappedInPlacementOrNoPlacementResult_) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return err
+	return fmt.Sprintf("NodeBootstrappedInPlacementOrNoPlacementResult_(%+v)", *p)
 }
 
-func (p *NodeSetPersistRateLimitRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLimitCheckEvery() {
-		if err := oprot.WriteFieldBegin("limitCheckEvery", thrift.I64, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:limitCheckEvery: ", p), err)
-		}
-		if err := oprot.WriteI64(int64(*p.LimitCheckEvery)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.limitCheckEvery (3) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:limitCheckEvery: ", p), err)
-		}
-	}
-	return err
+// Attributes:
+//  - LimitEnabled
+//  - LimitMbps
+//  - LimitCheckEvery
+type NodePersistRateLimitResult_ struct {
+	LimitEnabled    bool    `thrift:"limitEnabled,1,required" db:"limitEnabled" json:"limitEnabled"`
+	LimitMbps       float64 `thrift:"limitMbps,2,required" db:"limitMbps" json:"limitMbps"`
+	LimitCheckEvery int64   `thrift:"limitCheckEvery,3,required" db:"limitCheckEvery" json:"limitCheckEvery"`
 }
 
-func (p *NodeSetPersistRateLimitRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("NodeSetPersistRateLimitRequest(%+v)", *p)
+func NewNodePersistRateLimitResult_() *NodePersistRateLimitResult_ {
+	return &NodePersistRateLimitResult_{}
 }
 
-// Attributes:
-//  - WriteNewSeriesAsync
-type NodeWriteNewSeriesAsyncResult_ struct {
-	WriteNewSeriesAsync bool `thrift:"writeNewSeriesAsync,1,required" db:"writeNewSeriesAsync" json:"writeNewSeriesAsync"`
+func (p *NodePersistRateLimitResult_) GetLimitEnabled() bool {
+	return p.LimitEnabled
 }
 
-func NewNodeWriteNewSeriesAsyncResult_() *NodeWriteNewSeriesAsyncResult_ {
-	return &NodeWriteNewSeriesAsyncResult_{}
+func (p *NodePersistRateLimitResult_) GetLimitMbps() float64 {
+	return p.LimitMbps
 }
 
-func (p *NodeWriteNewSeriesAsyncResult_) GetWriteNewSeriesAsync() bool {
-	return p.WriteNewSeriesAsync
+func (p *NodePersistRateLimitResult_) GetLimitCheckEvery() int64 {
+	return p.LimitCheckEvery
 }
-func (p *NodeWriteNewSeriesAsyncResult_) Read(iprot thrift.TProtocol) error {
+func (p *NodePersistRateLimitResult_) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetWriteNewSeriesAsync bool = false
+	var issetLimitEnabled bool = false
+	var issetLimitMbps bool = false
+	var issetLimitCheckEvery bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -9099,7 +10259,17 @@ func (p *NodeWriteNewSeriesAsyncResult_) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetWriteNewSeriesAsync = true
+			issetLimitEnabled = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+			issetLimitMbps = true
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
+			issetLimitCheckEvery = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -9112,29 +10282,59 @@ func (p *NodeWriteNewSeriesAsyncResult_) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetWriteNewSeriesAsync {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field WriteNewSeriesAsync is not set"))
+	if !issetLimitEnabled {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field LimitEnabled is not set"))
+	}
+	if !issetLimitMbps {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field LimitMbps is not set"))
+	}
+	if !issetLimitCheckEvery {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field LimitCheckEvery is not set"))
 	}
 	return nil
 }
 
-func (p *NodeWriteNewSeriesAsyncResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodePersistRateLimitResult_) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBool(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.WriteNewSeriesAsync = v
+		p.LimitEnabled = v
 	}
 	return nil
 }
 
-func (p *NodeWriteNewSeriesAsyncResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("NodeWriteNewSeriesAsyncResult"); err != nil {
+func (p *NodePersistRateLimitResult_) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.LimitMbps = v
+	}
+	return nil
+}
+
+func (p *NodePersistRateLimitResult_) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.LimitCheckEvery = v
+	}
+	return nil
+}
+
+func (p *NodePersistRateLimitResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("NodePersistRateLimitResult"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -9145,46 +10345,109 @@ func (p *NodeWriteNewSeriesAsyncResult_) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeWriteNewSeriesAsyncResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("writeNewSeriesAsync", thrift.BOOL, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:writeNewSeriesAsync: ", p), err)
+func (p *NodePersistRateLimitResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("limitEnabled", thrift.BOOL, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:limitEnabled: ", p), err)
 	}
-	if err := oprot.WriteBool(bool(p.WriteNewSeriesAsync)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.writeNewSeriesAsync (1) field write error: ", p), err)
+	if err := oprot.WriteBool(bool(p.LimitEnabled)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.limitEnabled (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:writeNewSeriesAsync: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:limitEnabled: ", p), err)
 	}
 	return err
 }
 
-func (p *NodeWriteNewSeriesAsyncResult_) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *NodePersistRateLimitResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("limitMbps", thrift.DOUBLE, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:limitMbps: ", p), err)
 	}
-	return fmt.Sprintf("NodeWriteNewSeriesAsyncResult_(%+v)", *p)
-}
-
-// Attributes:
-//  - WriteNewSeriesAsync
-type NodeSetWriteNewSeriesAsyncRequest struct {
-	WriteNewSeriesAsync bool `thrift:"writeNewSeriesAsync,1,required" db:"writeNewSeriesAsync" json:"writeNewSeriesAsync"`
+	if err := oprot.WriteDouble(float64(p.LimitMbps)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.limitMbps (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:limitMbps: ", p), err)
+	}
+	return err
 }
 
-func NewNodeSetWriteNewSeriesAsyncRequest() *NodeSetWriteNewSeriesAsyncRequest {
-	return &NodeSetWriteNewSeriesAsyncRequest{}
+func (p *NodePersistRateLimitResult_) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("limitCheckEvery", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:limitCheckEvery: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.LimitCheckEvery)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.limitCheckEvery (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:limitCheckEvery: ", p), err)
+	}
+	return err
 }
 
-func (p *NodeSetWriteNewSeriesAsyncRequest) GetWriteNewSeriesAsync() bool {
-	return p.WriteNewSeriesAsync
+func (p *NodePersistRateLimitResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NodePersistRateLimitResult_(%+v)", *p)
 }
-func (p *NodeSetWriteNewSeriesAsyncRequest) Read(iprot thrift.TProtocol) error {
+
+// Attributes:
+//  - LimitEnabled
+//  - LimitMbps
+//  - LimitCheckEvery
+type NodeSetPersistRateLimitRequest struct {
+	LimitEnabled    *bool    `thrift:"limitEnabled,1" db:"limitEnabled" json:"limitEnabled,omitempty"`
+	LimitMbps       *float64 `thrift:"limitMbps,2" db:"limitMbps" json:"limitMbps,omitempty"`
+	LimitCheckEvery *int64   `thrift:"limitCheckEvery,3" db:"limitCheckEvery" json:"limitCheckEvery,omitempty"`
+}
+
+func NewNodeSetPersistRateLimitRequest() *NodeSetPersistRateLimitRequest {
+	return &NodeSetPersistRateLimitRequest{}
+}
+
+var NodeSetPersistRateLimitRequest_LimitEnabled_DEFAULT bool
+
+func (p *NodeSetPersistRateLimitRequest) GetLimitEnabled() bool {
+	if !p.IsSetLimitEnabled() {
+		return NodeSetPersistRateLimitRequest_LimitEnabled_DEFAULT
+	}
+	return *p.LimitEnabled
+}
+
+var NodeSetPersistRateLimitRequest_LimitMbps_DEFAULT float64
+
+func (p *NodeSetPersistRateLimitRequest) GetLimitMbps() float64 {
+	if !p.IsSetLimitMbps() {
+		return NodeSetPersistRateLimitRequest_LimitMbps_DEFAULT
+	}
+	return *p.LimitMbps
+}
+
+var NodeSetPersistRateLimitRequest_LimitCheckEvery_DEFAULT int64
+
+func (p *NodeSetPersistRateLimitRequest) GetLimitCheckEvery() int64 {
+	if !p.IsSetLimitCheckEvery() {
+		return NodeSetPersistRateLimitRequest_LimitCheckEvery_DEFAULT
+	}
+	return *p.LimitCheckEvery
+}
+func (p *NodeSetPersistRateLimitRequest) IsSetLimitEnabled() bool {
+	return p.LimitEnabled != nil
+}
+
+func (p *NodeSetPersistRateLimitRequest) IsSetLimitMbps() bool {
+	return p.LimitMbps != nil
+}
+
+func (p *NodeSetPersistRateLimitRequest) IsSetLimitCheckEvery() bool {
+	return p.LimitCheckEvery != nil
+}
+
+func (p *NodeSetPersistRateLimitRequest) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetWriteNewSeriesAsync bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -9198,7 +10461,14 @@ func (p *NodeSetWriteNewSeriesAsyncRequest) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetWriteNewSeriesAsync = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -9211,29 +10481,50 @@ func (p *NodeSetWriteNewSeriesAsyncRequest) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetWriteNewSeriesAsync {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field WriteNewSeriesAsync is not set"))
-	}
 	return nil
 }
 
-func (p *NodeSetWriteNewSeriesAsyncRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeSetPersistRateLimitRequest) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBool(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.WriteNewSeriesAsync = v
+		p.LimitEnabled = &v
 	}
 	return nil
 }
 
-func (p *NodeSetWriteNewSeriesAsyncRequest) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("NodeSetWriteNewSeriesAsyncRequest"); err != nil {
+func (p *NodeSetPersistRateLimitRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.LimitMbps = &v
+	}
+	return nil
+}
+
+func (p *NodeSetPersistRateLimitRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.LimitCheckEvery = &v
+	}
+	return nil
+}
+
+func (p *NodeSetPersistRateLimitRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("NodeSetPersistRateLimitRequest"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -9244,52 +10535,77 @@ func (p *NodeSetWriteNewSeriesAsyncRequest) Write(oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *NodeSetWriteNewSeriesAsyncRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("writeNewSeriesAsync", thrift.BOOL, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:writeNewSeriesAsync: ", p), err)
+func (p *NodeSetPersistRateLimitRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimitEnabled() {
+		if err := oprot.WriteFieldBegin("limitEnabled", thrift.BOOL, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:limitEnabled: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(*p.LimitEnabled)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.limitEnabled (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:limitEnabled: ", p), err)
+		}
 	}
-	if err := oprot.WriteBool(bool(p.WriteNewSeriesAsync)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.writeNewSeriesAsync (1) field write error: ", p), err)
+	return err
+}
+
+func (p *NodeSetPersistRateLimitRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimitMbps() {
+		if err := oprot.WriteFieldBegin("limitMbps", thrift.DOUBLE, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:limitMbps: ", p), err)
+		}
+		if err := oprot.WriteDouble(float64(*p.LimitMbps)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.limitMbps (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:limitMbps: ", p), err)
+		}
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:writeNewSeriesAsync: ", p), err)
+	return err
+}
+
+func (p *NodeSetPersistRateLimitRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimitCheckEvery() {
+		if err := oprot.WriteFieldBegin("limitCheckEvery", thrift.I64, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:limitCheckEvery: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.LimitCheckEvery)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.limitCheckEvery (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:limitCheckEvery: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *NodeSetWriteNewSeriesAsyncRequest) String() string {
+func (p *NodeSetPersistRateLimitRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeSetWriteNewSeriesAsyncRequest(%+v)", *p)
+	return fmt.Sprintf("NodeSetPersistRateLimitRequest(%+v)", *p)
 }
 
 // Attributes:
-//  - WriteNewSeriesBackoffDuration
-//  - DurationType
-type NodeWriteNewSeriesBackoffDurationResult_ struct {
-	WriteNewSeriesBackoffDuration int64    `thrift:"writeNewSeriesBackoffDuration,1,required" db:"writeNewSeriesBackoffDuration" json:"writeNewSeriesBackoffDuration"`
-	DurationType                  TimeType `thrift:"durationType,2,required" db:"durationType" json:"durationType"`
-}
-
-func NewNodeWriteNewSeriesBackoffDurationResult_() *NodeWriteNewSeriesBackoffDurationResult_ {
-	return &NodeWriteNewSeriesBackoffDurationResult_{}
+//  - WriteNewSeriesAsync
+type NodeWriteNewSeriesAsyncResult_ struct {
+	WriteNewSeriesAsync bool `thrift:"writeNewSeriesAsync,1,required" db:"writeNewSeriesAsync" json:"writeNewSeriesAsync"`
 }
 
-func (p *NodeWriteNewSeriesBackoffDurationResult_) GetWriteNewSeriesBackoffDuration() int64 {
-	return p.WriteNewSeriesBackoffDuration
+func NewNodeWriteNewSeriesAsyncResult_() *NodeWriteNewSeriesAsyncResult_ {
+	return &NodeWriteNewSeriesAsyncResult_{}
 }
 
-func (p *NodeWriteNewSeriesBackoffDurationResult_) GetDurationType() TimeType {
-	return p.DurationType
+func (p *NodeWriteNewSeriesAsyncResult_) GetWriteNewSeriesAsync() bool {
+	return p.WriteNewSeriesAsync
 }
-func (p *NodeWriteNewSeriesBackoffDurationResult_) Read(iprot thrift.TProtocol) error {
+func (p *NodeWriteNewSeriesAsyncResult_) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetWriteNewSeriesBackoffDuration bool = false
-	var issetDurationType bool = false
+	var issetWriteNewSeriesAsync bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -9304,12 +10620,7 @@ func (p *NodeWriteNewSeriesBackoffDurationResult_) Read(iprot thrift.TProtocol)
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetWriteNewSeriesBackoffDuration = true
-		case 2:
-			if err := p.ReadField2(iprot); err != nil {
-				return err
-			}
-			issetDurationType = true
+			issetWriteNewSeriesAsync = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -9322,45 +10633,29 @@ func (p *NodeWriteNewSeriesBackoffDurationResult_) Read(iprot thrift.TProtocol)
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetWriteNewSeriesBackoffDuration {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field WriteNewSeriesBackoffDuration is not set"))
-	}
-	if !issetDurationType {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field DurationType is not set"))
+	if !issetWriteNewSeriesAsync {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field WriteNewSeriesAsync is not set"))
 	}
 	return nil
 }
 
-func (p *NodeWriteNewSeriesBackoffDurationResult_) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *NodeWriteNewSeriesAsyncResult_) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.WriteNewSeriesBackoffDuration = v
-	}
-	return nil
-}
-
-func (p *NodeWriteNewSeriesBackoffDurationResult_) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		temp := TimeType(v)
-		p.DurationType = temp
+		p.WriteNewSeriesAsync = v
 	}
 	return nil
 }
 
-func (p *NodeWriteNewSeriesBackoffDurationResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("NodeWriteNewSeriesBackoffDurationResult"); err != nil {
+func (p *NodeWriteNewSeriesAsyncResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("NodeWriteNewSeriesAsyncResult"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField2(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -9371,23 +10666,249 @@ func (p *NodeWriteNewSeriesBackoffDurationResult_) Write(oprot thrift.TProtocol)
 	return nil
 }
 
-func (p *NodeWriteNewSeriesBackoffDurationResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("writeNewSeriesBackoffDuration", thrift.I64, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:writeNewSeriesBackoffDuration: ", p), err)
+func (p *NodeWriteNewSeriesAsyncResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("writeNewSeriesAsync", thrift.BOOL, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:writeNewSeriesAsync: ", p), err)
 	}
-	if err := oprot.WriteI64(int64(p.WriteNewSeriesBackoffDuration)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.writeNewSeriesBackoffDuration (1) field write error: ", p), err)
+	if err := oprot.WriteBool(bool(p.WriteNewSeriesAsync)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.writeNewSeriesAsync (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:writeNewSeriesBackoffDuration: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:writeNewSeriesAsync: ", p), err)
 	}
 	return err
 }
 
-func (p *NodeWriteNewSeriesBackoffDurationResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("durationType", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:durationType: ", p), err)
-	}
+func (p *NodeWriteNewSeriesAsyncResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NodeWriteNewSeriesAsyncResult_(%+v)", *p)
+}
+
+// Attributes:
+//  - WriteNewSeriesAsync
+type NodeSetWriteNewSeriesAsyncRequest struct {
+	WriteNewSeriesAsync bool `thrift:"writeNewSeriesAsync,1,required" db:"writeNewSeriesAsync" json:"writeNewSeriesAsync"`
+}
+
+func NewNodeSetWriteNewSeriesAsyncRequest() *NodeSetWriteNewSeriesAsyncRequest {
+	return &NodeSetWriteNewSeriesAsyncRequest{}
+}
+
+func (p *NodeSetWriteNewSeriesAsyncRequest) GetWriteNewSeriesAsync() bool {
+	return p.WriteNewSeriesAsync
+}
+func (p *NodeSetWriteNewSeriesAsyncRequest) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetWriteNewSeriesAsync bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetWriteNewSeriesAsync = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetWriteNewSeriesAsync {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field WriteNewSeriesAsync is not set"))
+	}
+	return nil
+}
+
+func (p *NodeSetWriteNewSeriesAsyncRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.WriteNewSeriesAsync = v
+	}
+	return nil
+}
+
+func (p *NodeSetWriteNewSeriesAsyncRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("NodeSetWriteNewSeriesAsyncRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *NodeSetWriteNewSeriesAsyncRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("writeNewSeriesAsync", thrift.BOOL, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:writeNewSeriesAsync: ", p), err)
+	}
+	if err := oprot.WriteBool(bool(p.WriteNewSeriesAsync)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.writeNewSeriesAsync (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:writeNewSeriesAsync: ", p), err)
+	}
+	return err
+}
+
+func (p *NodeSetWriteNewSeriesAsyncRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NodeSetWriteNewSeriesAsyncRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - WriteNewSeriesBackoffDuration
+//  - DurationType
+type NodeWriteNewSeriesBackoffDurationResult_ struct {
+	WriteNewSeriesBackoffDuration int64    `thrift:"writeNewSeriesBackoffDuration,1,required" db:"writeNewSeriesBackoffDuration" json:"writeNewSeriesBackoffDuration"`
+	DurationType                  TimeType `thrift:"durationType,2,required" db:"durationType" json:"durationType"`
+}
+
+func NewNodeWriteNewSeriesBackoffDurationResult_() *NodeWriteNewSeriesBackoffDurationResult_ {
+	return &NodeWriteNewSeriesBackoffDurationResult_{}
+}
+
+func (p *NodeWriteNewSeriesBackoffDurationResult_) GetWriteNewSeriesBackoffDuration() int64 {
+	return p.WriteNewSeriesBackoffDuration
+}
+
+func (p *NodeWriteNewSeriesBackoffDurationResult_) GetDurationType() TimeType {
+	return p.DurationType
+}
+func (p *NodeWriteNewSeriesBackoffDurationResult_) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetWriteNewSeriesBackoffDuration bool = false
+	var issetDurationType bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetWriteNewSeriesBackoffDuration = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+			issetDurationType = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetWriteNewSeriesBackoffDuration {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field WriteNewSeriesBackoffDuration is not set"))
+	}
+	if !issetDurationType {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field DurationType is not set"))
+	}
+	return nil
+}
+
+func (p *NodeWriteNewSeriesBackoffDurationResult_) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.WriteNewSeriesBackoffDuration = v
+	}
+	return nil
+}
+
+func (p *NodeWriteNewSeriesBackoffDurationResult_) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := TimeType(v)
+		p.DurationType = temp
+	}
+	return nil
+}
+
+func (p *NodeWriteNewSeriesBackoffDurationResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("NodeWriteNewSeriesBackoffDurationResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *NodeWriteNewSeriesBackoffDurationResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("writeNewSeriesBackoffDuration", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:writeNewSeriesBackoffDuration: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.WriteNewSeriesBackoffDuration)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.writeNewSeriesBackoffDuration (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:writeNewSeriesBackoffDuration: ", p), err)
+	}
+	return err
+}
+
+func (p *NodeWriteNewSeriesBackoffDurationResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("durationType", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:durationType: ", p), err)
+	}
 	if err := oprot.WriteI32(int32(p.DurationType)); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T.durationType (2) field write error: ", p), err)
 	}
@@ -10953,165 +12474,60 @@ func (p *AggregateQueryRawResultTagValueElement) String() string {
 }
 
 // Attributes:
+//  - NameSpace
 //  - Query
 //  - RangeStart
 //  - RangeEnd
-//  - NameSpace
-//  - SeriesLimit
-//  - TagNameFilter
-//  - AggregateQueryType
-//  - RangeType
-//  - Source
-//  - DocsLimit
-//  - RequireExhaustive
-//  - RequireNoWait
-type AggregateQueryRequest struct {
-	Query              *Query             `thrift:"query,1" db:"query" json:"query,omitempty"`
-	RangeStart         int64              `thrift:"rangeStart,2,required" db:"rangeStart" json:"rangeStart"`
-	RangeEnd           int64              `thrift:"rangeEnd,3,required" db:"rangeEnd" json:"rangeEnd"`
-	NameSpace          string             `thrift:"nameSpace,4,required" db:"nameSpace" json:"nameSpace"`
-	SeriesLimit        *int64             `thrift:"seriesLimit,5" db:"seriesLimit" json:"seriesLimit,omitempty"`
-	TagNameFilter      []string           `thrift:"tagNameFilter,6" db:"tagNameFilter" json:"tagNameFilter,omitempty"`
-	AggregateQueryType AggregateQueryType `thrift:"aggregateQueryType,7" db:"aggregateQueryType" json:"aggregateQueryType,omitempty"`
-	RangeType          TimeType           `thrift:"rangeType,8" db:"rangeType" json:"rangeType,omitempty"`
-	Source             []byte             `thrift:"source,9" db:"source" json:"source,omitempty"`
-	DocsLimit          *int64             `thrift:"docsLimit,10" db:"docsLimit" json:"docsLimit,omitempty"`
-	RequireExhaustive  *bool              `thrift:"requireExhaustive,11" db:"requireExhaustive" json:"requireExhaustive,omitempty"`
-	RequireNoWait      *bool              `thrift:"requireNoWait,12" db:"requireNoWait" json:"requireNoWait,omitempty"`
+//  - Limit
+type CardinalityRawRequest struct {
+	NameSpace  []byte `thrift:"nameSpace,1,required" db:"nameSpace" json:"nameSpace"`
+	Query      []byte `thrift:"query,2,required" db:"query" json:"query"`
+	RangeStart int64  `thrift:"rangeStart,3,required" db:"rangeStart" json:"rangeStart"`
+	RangeEnd   int64  `thrift:"rangeEnd,4,required" db:"rangeEnd" json:"rangeEnd"`
+	Limit      *int64 `thrift:"limit,5" db:"limit" json:"limit,omitempty"`
 }
 
-func NewAggregateQueryRequest() *AggregateQueryRequest {
-	return &AggregateQueryRequest{
-		AggregateQueryType: 1,
-
-		RangeType: 0,
-	}
+func NewCardinalityRawRequest() *CardinalityRawRequest {
+	return &CardinalityRawRequest{}
 }
 
-var AggregateQueryRequest_Query_DEFAULT *Query
+func (p *CardinalityRawRequest) GetNameSpace() []byte {
+	return p.NameSpace
+}
 
-func (p *AggregateQueryRequest) GetQuery() *Query {
-	if !p.IsSetQuery() {
-		return AggregateQueryRequest_Query_DEFAULT
-	}
+func (p *CardinalityRawRequest) GetQuery() []byte {
 	return p.Query
 }
 
-func (p *AggregateQueryRequest) GetRangeStart() int64 {
+func (p *CardinalityRawRequest) GetRangeStart() int64 {
 	return p.RangeStart
 }
 
-func (p *AggregateQueryRequest) GetRangeEnd() int64 {
+func (p *CardinalityRawRequest) GetRangeEnd() int64 {
 	return p.RangeEnd
 }
 
-func (p *AggregateQueryRequest) GetNameSpace() string {
-	return p.NameSpace
-}
-
-var AggregateQueryRequest_SeriesLimit_DEFAULT int64
-
-func (p *AggregateQueryRequest) GetSeriesLimit() int64 {
-	if !p.IsSetSeriesLimit() {
-		return AggregateQueryRequest_SeriesLimit_DEFAULT
-	}
-	return *p.SeriesLimit
-}
-
-var AggregateQueryRequest_TagNameFilter_DEFAULT []string
-
-func (p *AggregateQueryRequest) GetTagNameFilter() []string {
-	return p.TagNameFilter
-}
-
-var AggregateQueryRequest_AggregateQueryType_DEFAULT AggregateQueryType = 1
-
-func (p *AggregateQueryRequest) GetAggregateQueryType() AggregateQueryType {
-	return p.AggregateQueryType
-}
-
-var AggregateQueryRequest_RangeType_DEFAULT TimeType = 0
-
-func (p *AggregateQueryRequest) GetRangeType() TimeType {
-	return p.RangeType
-}
-
-var AggregateQueryRequest_Source_DEFAULT []byte
-
-func (p *AggregateQueryRequest) GetSource() []byte {
-	return p.Source
-}
-
-var AggregateQueryRequest_DocsLimit_DEFAULT int64
-
-func (p *AggregateQueryRequest) GetDocsLimit() int64 {
-	if !p.IsSetDocsLimit() {
-		return AggregateQueryRequest_DocsLimit_DEFAULT
-	}
-	return *p.DocsLimit
-}
-
-var AggregateQueryRequest_RequireExhaustive_DEFAULT bool
-
-func (p *AggregateQueryRequest) GetRequireExhaustive() bool {
-	if !p.IsSetRequireExhaustive() {
-		return AggregateQueryRequest_RequireExhaustive_DEFAULT
-	}
-	return *p.RequireExhaustive
-}
-
-var AggregateQueryRequest_RequireNoWait_DEFAULT bool
+var CardinalityRawRequest_Limit_DEFAULT int64
 
-func (p *AggregateQueryRequest) GetRequireNoWait() bool {
-	if !p.IsSetRequireNoWait() {
-		return AggregateQueryRequest_RequireNoWait_DEFAULT
+func (p *CardinalityRawRequest) GetLimit() int64 {
+	if !p.IsSetLimit() {
+		return CardinalityRawRequest_Limit_DEFAULT
 	}
-	return *p.RequireNoWait
-}
-func (p *AggregateQueryRequest) IsSetQuery() bool {
-	return p.Query != nil
-}
-
-func (p *AggregateQueryRequest) IsSetSeriesLimit() bool {
-	return p.SeriesLimit != nil
-}
-
-func (p *AggregateQueryRequest) IsSetTagNameFilter() bool {
-	return p.TagNameFilter != nil
-}
-
-func (p *AggregateQueryRequest) IsSetAggregateQueryType() bool {
-	return p.AggregateQueryType != AggregateQueryRequest_AggregateQueryType_DEFAULT
-}
-
-func (p *AggregateQueryRequest) IsSetRangeType() bool {
-	return p.RangeType != AggregateQueryRequest_RangeType_DEFAULT
-}
-
-func (p *AggregateQueryRequest) IsSetSource() bool {
-	return p.Source != nil
-}
-
-func (p *AggregateQueryRequest) IsSetDocsLimit() bool {
-	return p.DocsLimit != nil
-}
-
-func (p *AggregateQueryRequest) IsSetRequireExhaustive() bool {
-	return p.RequireExhaustive != nil
+	return *p.Limit
 }
-
-func (p *AggregateQueryRequest) IsSetRequireNoWait() bool {
-	return p.RequireNoWait != nil
+func (p *CardinalityRawRequest) IsSetLimit() bool {
+	return p.Limit != nil
 }
 
-func (p *AggregateQueryRequest) Read(iprot thrift.TProtocol) error {
+func (p *CardinalityRawRequest) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
+	var issetNameSpace bool = false
+	var issetQuery bool = false
 	var issetRangeStart bool = false
 	var issetRangeEnd bool = false
-	var issetNameSpace bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -11126,53 +12542,26 @@ func (p *AggregateQueryRequest) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
+			issetNameSpace = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetRangeStart = true
+			issetQuery = true
 		case 3:
 			if err := p.ReadField3(iprot); err != nil {
 				return err
 			}
-			issetRangeEnd = true
+			issetRangeStart = true
 		case 4:
 			if err := p.ReadField4(iprot); err != nil {
 				return err
 			}
-			issetNameSpace = true
+			issetRangeEnd = true
 		case 5:
 			if err := p.ReadField5(iprot); err != nil {
 				return err
 			}
-		case 6:
-			if err := p.ReadField6(iprot); err != nil {
-				return err
-			}
-		case 7:
-			if err := p.ReadField7(iprot); err != nil {
-				return err
-			}
-		case 8:
-			if err := p.ReadField8(iprot); err != nil {
-				return err
-			}
-		case 9:
-			if err := p.ReadField9(iprot); err != nil {
-				return err
-			}
-		case 10:
-			if err := p.ReadField10(iprot); err != nil {
-				return err
-			}
-		case 11:
-			if err := p.ReadField11(iprot); err != nil {
-				return err
-			}
-		case 12:
-			if err := p.ReadField12(iprot); err != nil {
-				return err
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -11185,179 +12574,275 @@ func (p *AggregateQueryRequest) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
+	if !issetNameSpace {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
+	}
+	if !issetQuery {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Query is not set"))
+	}
 	if !issetRangeStart {
 		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RangeStart is not set"))
 	}
 	if !issetRangeEnd {
 		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RangeEnd is not set"))
 	}
-	if !issetNameSpace {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
-	}
 	return nil
 }
 
-func (p *AggregateQueryRequest) ReadField1(iprot thrift.TProtocol) error {
-	p.Query = &Query{}
-	if err := p.Query.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Query), err)
+func (p *CardinalityRawRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.NameSpace = v
 	}
 	return nil
 }
 
-func (p *AggregateQueryRequest) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *CardinalityRawRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.RangeStart = v
+		p.Query = v
 	}
 	return nil
 }
 
-func (p *AggregateQueryRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *CardinalityRawRequest) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.RangeEnd = v
+		p.RangeStart = v
 	}
 	return nil
 }
 
-func (p *AggregateQueryRequest) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *CardinalityRawRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return thrift.PrependError("error reading field 4: ", err)
 	} else {
-		p.NameSpace = v
+		p.RangeEnd = v
 	}
 	return nil
 }
 
-func (p *AggregateQueryRequest) ReadField5(iprot thrift.TProtocol) error {
+func (p *CardinalityRawRequest) ReadField5(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return thrift.PrependError("error reading field 5: ", err)
 	} else {
-		p.SeriesLimit = &v
+		p.Limit = &v
 	}
 	return nil
 }
 
-func (p *AggregateQueryRequest) ReadField6(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
+func (p *CardinalityRawRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("CardinalityRawRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	tSlice := make([]string, 0, size)
-	p.TagNameFilter = tSlice
-	for i := 0; i < size; i++ {
-		var _elem27 string
-		if v, err := iprot.ReadString(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem27 = v
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(oprot); err != nil {
+			return err
 		}
-		p.TagNameFilter = append(p.TagNameFilter, _elem27)
 	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	return nil
-}
-
-func (p *AggregateQueryRequest) ReadField7(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 7: ", err)
-	} else {
-		temp := AggregateQueryType(v)
-		p.AggregateQueryType = temp
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
 	return nil
 }
 
-func (p *AggregateQueryRequest) ReadField8(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 8: ", err)
-	} else {
-		temp := TimeType(v)
-		p.RangeType = temp
+func (p *CardinalityRawRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("nameSpace", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:nameSpace: ", p), err)
 	}
-	return nil
-}
-
-func (p *AggregateQueryRequest) ReadField9(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBinary(); err != nil {
-		return thrift.PrependError("error reading field 9: ", err)
-	} else {
-		p.Source = v
+	if err := oprot.WriteBinary(p.NameSpace); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (1) field write error: ", p), err)
 	}
-	return nil
-}
-
-func (p *AggregateQueryRequest) ReadField10(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 10: ", err)
-	} else {
-		p.DocsLimit = &v
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:nameSpace: ", p), err)
 	}
-	return nil
+	return err
 }
 
-func (p *AggregateQueryRequest) ReadField11(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(); err != nil {
-		return thrift.PrependError("error reading field 11: ", err)
-	} else {
-		p.RequireExhaustive = &v
+func (p *CardinalityRawRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("query", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:query: ", p), err)
 	}
-	return nil
+	if err := oprot.WriteBinary(p.Query); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.query (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:query: ", p), err)
+	}
+	return err
 }
 
-func (p *AggregateQueryRequest) ReadField12(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(); err != nil {
-		return thrift.PrependError("error reading field 12: ", err)
-	} else {
-		p.RequireNoWait = &v
+func (p *CardinalityRawRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("rangeStart", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:rangeStart: ", p), err)
 	}
-	return nil
+	if err := oprot.WriteI64(int64(p.RangeStart)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.rangeStart (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:rangeStart: ", p), err)
+	}
+	return err
 }
 
-func (p *AggregateQueryRequest) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("AggregateQueryRequest"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+func (p *CardinalityRawRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("rangeEnd", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:rangeEnd: ", p), err)
 	}
-	if p != nil {
-		if err := p.writeField1(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField2(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField3(oprot); err != nil {
-			return err
+	if err := oprot.WriteI64(int64(p.RangeEnd)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.rangeEnd (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:rangeEnd: ", p), err)
+	}
+	return err
+}
+
+func (p *CardinalityRawRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimit() {
+		if err := oprot.WriteFieldBegin("limit", thrift.I64, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:limit: ", p), err)
 		}
-		if err := p.writeField4(oprot); err != nil {
-			return err
+		if err := oprot.WriteI64(int64(*p.Limit)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.limit (5) field write error: ", p), err)
 		}
-		if err := p.writeField5(oprot); err != nil {
-			return err
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:limit: ", p), err)
 		}
-		if err := p.writeField6(oprot); err != nil {
-			return err
+	}
+	return err
+}
+
+func (p *CardinalityRawRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CardinalityRawRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - NumSeries
+//  - Results
+type CardinalityRawResult_ struct {
+	NumSeries int64                                 `thrift:"numSeries,1,required" db:"numSeries" json:"numSeries"`
+	Results   []*CardinalityRawResultTagNameElement `thrift:"results,2,required" db:"results" json:"results"`
+}
+
+func NewCardinalityRawResult_() *CardinalityRawResult_ {
+	return &CardinalityRawResult_{}
+}
+
+func (p *CardinalityRawResult_) GetNumSeries() int64 {
+	return p.NumSeries
+}
+
+func (p *CardinalityRawResult_) GetResults() []*CardinalityRawResultTagNameElement {
+	return p.Results
+}
+func (p *CardinalityRawResult_) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetNumSeries bool = false
+	var issetResults bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
 		}
-		if err := p.writeField7(oprot); err != nil {
-			return err
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		if err := p.writeField8(oprot); err != nil {
-			return err
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetNumSeries = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+			issetResults = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
 		}
-		if err := p.writeField9(oprot); err != nil {
+		if err := iprot.ReadFieldEnd(); err != nil {
 			return err
 		}
-		if err := p.writeField10(oprot); err != nil {
-			return err
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetNumSeries {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumSeries is not set"))
+	}
+	if !issetResults {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Results is not set"))
+	}
+	return nil
+}
+
+func (p *CardinalityRawResult_) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.NumSeries = v
+	}
+	return nil
+}
+
+func (p *CardinalityRawResult_) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*CardinalityRawResultTagNameElement, 0, size)
+	p.Results = tSlice
+	for i := 0; i < size; i++ {
+		_elem101 := &CardinalityRawResultTagNameElement{}
+		if err := _elem101.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem101), err)
 		}
-		if err := p.writeField11(oprot); err != nil {
+		p.Results = append(p.Results, _elem101)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CardinalityRawResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("CardinalityRawResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField12(oprot); err != nil {
+		if err := p.writeField2(oprot); err != nil {
 			return err
 		}
 	}
@@ -11370,221 +12855,103 @@ func (p *AggregateQueryRequest) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AggregateQueryRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetQuery() {
-		if err := oprot.WriteFieldBegin("query", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:query: ", p), err)
-		}
-		if err := p.Query.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Query), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:query: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *AggregateQueryRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("rangeStart", thrift.I64, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:rangeStart: ", p), err)
+func (p *CardinalityRawResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("numSeries", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numSeries: ", p), err)
 	}
-	if err := oprot.WriteI64(int64(p.RangeStart)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.rangeStart (2) field write error: ", p), err)
+	if err := oprot.WriteI64(int64(p.NumSeries)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.numSeries (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:rangeStart: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numSeries: ", p), err)
 	}
 	return err
 }
 
-func (p *AggregateQueryRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("rangeEnd", thrift.I64, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:rangeEnd: ", p), err)
-	}
-	if err := oprot.WriteI64(int64(p.RangeEnd)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.rangeEnd (3) field write error: ", p), err)
+func (p *CardinalityRawResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("results", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:results: ", p), err)
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:rangeEnd: ", p), err)
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Results)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
 	}
-	return err
-}
-
-func (p *AggregateQueryRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("nameSpace", thrift.STRING, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:nameSpace: ", p), err)
+	for _, v := range p.Results {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
 	}
-	if err := oprot.WriteString(string(p.NameSpace)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (4) field write error: ", p), err)
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:nameSpace: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:results: ", p), err)
 	}
 	return err
 }
 
-func (p *AggregateQueryRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSeriesLimit() {
-		if err := oprot.WriteFieldBegin("seriesLimit", thrift.I64, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:seriesLimit: ", p), err)
-		}
-		if err := oprot.WriteI64(int64(*p.SeriesLimit)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.seriesLimit (5) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:seriesLimit: ", p), err)
-		}
+func (p *CardinalityRawResult_) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return err
+	return fmt.Sprintf("CardinalityRawResult_(%+v)", *p)
 }
 
-func (p *AggregateQueryRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTagNameFilter() {
-		if err := oprot.WriteFieldBegin("tagNameFilter", thrift.LIST, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:tagNameFilter: ", p), err)
-		}
-		if err := oprot.WriteListBegin(thrift.STRING, len(p.TagNameFilter)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
-		}
-		for _, v := range p.TagNameFilter {
-			if err := oprot.WriteString(string(v)); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:tagNameFilter: ", p), err)
-		}
-	}
-	return err
+// Attributes:
+//  - TagName
+//  - TagValues
+//  - TruncatedTagValues
+//  - TruncatedTagValuesBytes
+type CardinalityRawResultTagNameElement struct {
+	TagName                 []byte                                 `thrift:"tagName,1,required" db:"tagName" json:"tagName"`
+	TagValues               []*CardinalityRawResultTagValueElement `thrift:"tagValues,2,required" db:"tagValues" json:"tagValues"`
+	TruncatedTagValues      *int64                                 `thrift:"truncatedTagValues,3" db:"truncatedTagValues" json:"truncatedTagValues,omitempty"`
+	TruncatedTagValuesBytes *int64                                 `thrift:"truncatedTagValuesBytes,4" db:"truncatedTagValuesBytes" json:"truncatedTagValuesBytes,omitempty"`
 }
 
-func (p *AggregateQueryRequest) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAggregateQueryType() {
-		if err := oprot.WriteFieldBegin("aggregateQueryType", thrift.I32, 7); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:aggregateQueryType: ", p), err)
-		}
-		if err := oprot.WriteI32(int32(p.AggregateQueryType)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.aggregateQueryType (7) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:aggregateQueryType: ", p), err)
-		}
-	}
-	return err
+func NewCardinalityRawResultTagNameElement() *CardinalityRawResultTagNameElement {
+	return &CardinalityRawResultTagNameElement{}
 }
 
-func (p *AggregateQueryRequest) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRangeType() {
-		if err := oprot.WriteFieldBegin("rangeType", thrift.I32, 8); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:rangeType: ", p), err)
-		}
-		if err := oprot.WriteI32(int32(p.RangeType)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.rangeType (8) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:rangeType: ", p), err)
-		}
-	}
-	return err
+func (p *CardinalityRawResultTagNameElement) GetTagName() []byte {
+	return p.TagName
 }
 
-func (p *AggregateQueryRequest) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSource() {
-		if err := oprot.WriteFieldBegin("source", thrift.STRING, 9); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:source: ", p), err)
-		}
-		if err := oprot.WriteBinary(p.Source); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.source (9) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:source: ", p), err)
-		}
-	}
-	return err
+func (p *CardinalityRawResultTagNameElement) GetTagValues() []*CardinalityRawResultTagValueElement {
+	return p.TagValues
 }
 
-func (p *AggregateQueryRequest) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDocsLimit() {
-		if err := oprot.WriteFieldBegin("docsLimit", thrift.I64, 10); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:docsLimit: ", p), err)
-		}
-		if err := oprot.WriteI64(int64(*p.DocsLimit)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.docsLimit (10) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:docsLimit: ", p), err)
-		}
-	}
-	return err
-}
+var CardinalityRawResultTagNameElement_TruncatedTagValues_DEFAULT int64
 
-func (p *AggregateQueryRequest) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRequireExhaustive() {
-		if err := oprot.WriteFieldBegin("requireExhaustive", thrift.BOOL, 11); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:requireExhaustive: ", p), err)
-		}
-		if err := oprot.WriteBool(bool(*p.RequireExhaustive)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.requireExhaustive (11) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:requireExhaustive: ", p), err)
-		}
+func (p *CardinalityRawResultTagNameElement) GetTruncatedTagValues() int64 {
+	if !p.IsSetTruncatedTagValues() {
+		return CardinalityRawResultTagNameElement_TruncatedTagValues_DEFAULT
 	}
-	return err
+	return *p.TruncatedTagValues
 }
 
-func (p *AggregateQueryRequest) writeField12(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRequireNoWait() {
-		if err := oprot.WriteFieldBegin("requireNoWait", thrift.BOOL, 12); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:requireNoWait: ", p), err)
-		}
-		if err := oprot.WriteBool(bool(*p.RequireNoWait)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.requireNoWait (12) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 12:requireNoWait: ", p), err)
-		}
-	}
-	return err
-}
+var CardinalityRawResultTagNameElement_TruncatedTagValuesBytes_DEFAULT int64
 
-func (p *AggregateQueryRequest) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *CardinalityRawResultTagNameElement) GetTruncatedTagValuesBytes() int64 {
+	if !p.IsSetTruncatedTagValuesBytes() {
+		return CardinalityRawResultTagNameElement_TruncatedTagValuesBytes_DEFAULT
 	}
-	return fmt.Sprintf("AggregateQueryRequest(%+v)", *p)
-}
-
-// Attributes:
-//  - Results
-//  - Exhaustive
-type AggregateQueryResult_ struct {
-	Results    []*AggregateQueryResultTagNameElement `thrift:"results,1,required" db:"results" json:"results"`
-	Exhaustive bool                                  `thrift:"exhaustive,2,required" db:"exhaustive" json:"exhaustive"`
+	return *p.TruncatedTagValuesBytes
 }
-
-func NewAggregateQueryResult_() *AggregateQueryResult_ {
-	return &AggregateQueryResult_{}
+func (p *CardinalityRawResultTagNameElement) IsSetTruncatedTagValues() bool {
+	return p.TruncatedTagValues != nil
 }
 
-func (p *AggregateQueryResult_) GetResults() []*AggregateQueryResultTagNameElement {
-	return p.Results
+func (p *CardinalityRawResultTagNameElement) IsSetTruncatedTagValuesBytes() bool {
+	return p.TruncatedTagValuesBytes != nil
 }
 
-func (p *AggregateQueryResult_) GetExhaustive() bool {
-	return p.Exhaustive
-}
-func (p *AggregateQueryResult_) Read(iprot thrift.TProtocol) error {
+func (p *CardinalityRawResultTagNameElement) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetResults bool = false
-	var issetExhaustive bool = false
+	var issetTagName bool = false
+	var issetTagValues bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -11599,12 +12966,20 @@ func (p *AggregateQueryResult_) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetResults = true
+			issetTagName = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetExhaustive = true
+			issetTagValues = true
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.ReadField4(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -11617,28 +12992,37 @@ func (p *AggregateQueryResult_) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetResults {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Results is not set"))
+	if !issetTagName {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TagName is not set"))
 	}
-	if !issetExhaustive {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Exhaustive is not set"))
+	if !issetTagValues {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TagValues is not set"))
 	}
 	return nil
 }
 
-func (p *AggregateQueryResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *CardinalityRawResultTagNameElement) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.TagName = v
+	}
+	return nil
+}
+
+func (p *CardinalityRawResultTagNameElement) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]*AggregateQueryResultTagNameElement, 0, size)
-	p.Results = tSlice
+	tSlice := make([]*CardinalityRawResultTagValueElement, 0, size)
+	p.TagValues = tSlice
 	for i := 0; i < size; i++ {
-		_elem28 := &AggregateQueryResultTagNameElement{}
-		if err := _elem28.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem28), err)
+		_elem102 := &CardinalityRawResultTagValueElement{}
+		if err := _elem102.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem102), err)
 		}
-		p.Results = append(p.Results, _elem28)
+		p.TagValues = append(p.TagValues, _elem102)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -11646,17 +13030,26 @@ func (p *AggregateQueryResult_) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AggregateQueryResult_) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
+func (p *CardinalityRawResultTagNameElement) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.Exhaustive = v
+		p.TruncatedTagValues = &v
 	}
 	return nil
 }
 
-func (p *AggregateQueryResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("AggregateQueryResult"); err != nil {
+func (p *CardinalityRawResultTagNameElement) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.TruncatedTagValuesBytes = &v
+	}
+	return nil
+}
+
+func (p *CardinalityRawResultTagNameElement) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("CardinalityRawResultTagNameElement"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -11666,6 +13059,12 @@ func (p *AggregateQueryResult_) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField2(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -11676,14 +13075,27 @@ func (p *AggregateQueryResult_) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AggregateQueryResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("results", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:results: ", p), err)
+func (p *CardinalityRawResultTagNameElement) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("tagName", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:tagName: ", p), err)
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Results)); err != nil {
+	if err := oprot.WriteBinary(p.TagName); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.tagName (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:tagName: ", p), err)
+	}
+	return err
+}
+
+func (p *CardinalityRawResultTagNameElement) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("tagValues", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:tagValues: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TagValues)); err != nil {
 		return thrift.PrependError("error writing list begin: ", err)
 	}
-	for _, v := range p.Results {
+	for _, v := range p.TagValues {
 		if err := v.Write(oprot); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 		}
@@ -11692,62 +13104,74 @@ func (p *AggregateQueryResult_) writeField1(oprot thrift.TProtocol) (err error)
 		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:results: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:tagValues: ", p), err)
 	}
 	return err
 }
 
-func (p *AggregateQueryResult_) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("exhaustive", thrift.BOOL, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:exhaustive: ", p), err)
-	}
-	if err := oprot.WriteBool(bool(p.Exhaustive)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.exhaustive (2) field write error: ", p), err)
+func (p *CardinalityRawResultTagNameElement) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTruncatedTagValues() {
+		if err := oprot.WriteFieldBegin("truncatedTagValues", thrift.I64, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:truncatedTagValues: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.TruncatedTagValues)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.truncatedTagValues (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:truncatedTagValues: ", p), err)
+		}
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:exhaustive: ", p), err)
+	return err
+}
+
+func (p *CardinalityRawResultTagNameElement) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTruncatedTagValuesBytes() {
+		if err := oprot.WriteFieldBegin("truncatedTagValuesBytes", thrift.I64, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:truncatedTagValuesBytes: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.TruncatedTagValuesBytes)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.truncatedTagValuesBytes (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:truncatedTagValuesBytes: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *AggregateQueryResult_) String() string {
+func (p *CardinalityRawResultTagNameElement) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AggregateQueryResult_(%+v)", *p)
+	return fmt.Sprintf("CardinalityRawResultTagNameElement(%+v)", *p)
 }
 
 // Attributes:
-//  - TagName
-//  - TagValues
-type AggregateQueryResultTagNameElement struct {
-	TagName   string                                 `thrift:"tagName,1,required" db:"tagName" json:"tagName"`
-	TagValues []*AggregateQueryResultTagValueElement `thrift:"tagValues,2" db:"tagValues" json:"tagValues,omitempty"`
+//  - TagValue
+//  - SeriesCount
+type CardinalityRawResultTagValueElement struct {
+	TagValue    []byte `thrift:"tagValue,1,required" db:"tagValue" json:"tagValue"`
+	SeriesCount int64  `thrift:"seriesCount,2,required" db:"seriesCount" json:"seriesCount"`
 }
 
-func NewAggregateQueryResultTagNameElement() *AggregateQueryResultTagNameElement {
-	return &AggregateQueryResultTagNameElement{}
+func NewCardinalityRawResultTagValueElement() *CardinalityRawResultTagValueElement {
+	return &CardinalityRawResultTagValueElement{}
 }
 
-func (p *AggregateQueryResultTagNameElement) GetTagName() string {
-	return p.TagName
+func (p *CardinalityRawResultTagValueElement) GetTagValue() []byte {
+	return p.TagValue
 }
 
-var AggregateQueryResultTagNameElement_TagValues_DEFAULT []*AggregateQueryResultTagValueElement
-
-func (p *AggregateQueryResultTagNameElement) GetTagValues() []*AggregateQueryResultTagValueElement {
-	return p.TagValues
-}
-func (p *AggregateQueryResultTagNameElement) IsSetTagValues() bool {
-	return p.TagValues != nil
+func (p *CardinalityRawResultTagValueElement) GetSeriesCount() int64 {
+	return p.SeriesCount
 }
-
-func (p *AggregateQueryResultTagNameElement) Read(iprot thrift.TProtocol) error {
+func (p *CardinalityRawResultTagValueElement) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetTagName bool = false
+	var issetTagValue bool = false
+	var issetSeriesCount bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -11762,11 +13186,12 @@ func (p *AggregateQueryResultTagNameElement) Read(iprot thrift.TProtocol) error
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetTagName = true
+			issetTagValue = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
+			issetSeriesCount = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -11779,43 +13204,35 @@ func (p *AggregateQueryResultTagNameElement) Read(iprot thrift.TProtocol) error
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetTagName {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TagName is not set"))
+	if !issetTagValue {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TagValue is not set"))
+	}
+	if !issetSeriesCount {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field SeriesCount is not set"))
 	}
 	return nil
 }
 
-func (p *AggregateQueryResultTagNameElement) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *CardinalityRawResultTagValueElement) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.TagName = v
+		p.TagValue = v
 	}
 	return nil
 }
 
-func (p *AggregateQueryResultTagNameElement) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*AggregateQueryResultTagValueElement, 0, size)
-	p.TagValues = tSlice
-	for i := 0; i < size; i++ {
-		_elem29 := &AggregateQueryResultTagValueElement{}
-		if err := _elem29.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem29), err)
-		}
-		p.TagValues = append(p.TagValues, _elem29)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *CardinalityRawResultTagValueElement) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.SeriesCount = v
 	}
 	return nil
 }
 
-func (p *AggregateQueryResultTagNameElement) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("AggregateQueryResultTagNameElement"); err != nil {
+func (p *CardinalityRawResultTagValueElement) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("CardinalityRawResultTagValueElement"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -11835,146 +13252,37 @@ func (p *AggregateQueryResultTagNameElement) Write(oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AggregateQueryResultTagNameElement) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("tagName", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:tagName: ", p), err)
+func (p *CardinalityRawResultTagValueElement) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("tagValue", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:tagValue: ", p), err)
 	}
-	if err := oprot.WriteString(string(p.TagName)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.tagName (1) field write error: ", p), err)
+	if err := oprot.WriteBinary(p.TagValue); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.tagValue (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:tagName: ", p), err)
-	}
-	return err
-}
-
-func (p *AggregateQueryResultTagNameElement) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTagValues() {
-		if err := oprot.WriteFieldBegin("tagValues", thrift.LIST, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:tagValues: ", p), err)
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TagValues)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
-		}
-		for _, v := range p.TagValues {
-			if err := v.Write(oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:tagValues: ", p), err)
-		}
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:tagValue: ", p), err)
 	}
 	return err
 }
 
-func (p *AggregateQueryResultTagNameElement) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("AggregateQueryResultTagNameElement(%+v)", *p)
-}
-
-// Attributes:
-//  - TagValue
-type AggregateQueryResultTagValueElement struct {
-	TagValue string `thrift:"tagValue,1,required" db:"tagValue" json:"tagValue"`
-}
-
-func NewAggregateQueryResultTagValueElement() *AggregateQueryResultTagValueElement {
-	return &AggregateQueryResultTagValueElement{}
-}
-
-func (p *AggregateQueryResultTagValueElement) GetTagValue() string {
-	return p.TagValue
-}
-func (p *AggregateQueryResultTagValueElement) Read(iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-	}
-
-	var issetTagValue bool = false
-
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if err := p.ReadField1(iprot); err != nil {
-				return err
-			}
-			issetTagValue = true
-		default:
-			if err := iprot.Skip(fieldTypeId); err != nil {
-				return err
-			}
-		}
-		if err := iprot.ReadFieldEnd(); err != nil {
-			return err
-		}
-	}
-	if err := iprot.ReadStructEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	if !issetTagValue {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TagValue is not set"))
-	}
-	return nil
-}
-
-func (p *AggregateQueryResultTagValueElement) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.TagValue = v
-	}
-	return nil
-}
-
-func (p *AggregateQueryResultTagValueElement) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("AggregateQueryResultTagValueElement"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
-		if err := p.writeField1(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
-	}
-	return nil
-}
-
-func (p *AggregateQueryResultTagValueElement) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("tagValue", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:tagValue: ", p), err)
+func (p *CardinalityRawResultTagValueElement) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("seriesCount", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:seriesCount: ", p), err)
 	}
-	if err := oprot.WriteString(string(p.TagValue)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.tagValue (1) field write error: ", p), err)
+	if err := oprot.WriteI64(int64(p.SeriesCount)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.seriesCount (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:tagValue: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:seriesCount: ", p), err)
 	}
 	return err
 }
 
-func (p *AggregateQueryResultTagValueElement) String() string {
+func (p *CardinalityRawResultTagValueElement) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AggregateQueryResultTagValueElement(%+v)", *p)
+	return fmt.Sprintf("CardinalityRawResultTagValueElement(%+v)", *p)
 }
 
 // Attributes:
@@ -11982,132 +13290,158 @@ func (p *AggregateQueryResultTagValueElement) String() string {
 //  - RangeStart
 //  - RangeEnd
 //  - NameSpace
-//  - Limit
-//  - NoData
+//  - SeriesLimit
+//  - TagNameFilter
+//  - AggregateQueryType
 //  - RangeType
-//  - ResultTimeType
 //  - Source
-//  - ClusterOptions
-type QueryRequest struct {
-	Query          *Query               `thrift:"query,1,required" db:"query" json:"query"`
-	RangeStart     int64                `thrift:"rangeStart,2,required" db:"rangeStart" json:"rangeStart"`
-	RangeEnd       int64                `thrift:"rangeEnd,3,required" db:"rangeEnd" json:"rangeEnd"`
-	NameSpace      string               `thrift:"nameSpace,4,required" db:"nameSpace" json:"nameSpace"`
-	Limit          *int64               `thrift:"limit,5" db:"limit" json:"limit,omitempty"`
-	NoData         *bool                `thrift:"noData,6" db:"noData" json:"noData,omitempty"`
-	RangeType      TimeType             `thrift:"rangeType,7" db:"rangeType" json:"rangeType,omitempty"`
-	ResultTimeType TimeType             `thrift:"resultTimeType,8" db:"resultTimeType" json:"resultTimeType,omitempty"`
-	Source         []byte               `thrift:"source,9" db:"source" json:"source,omitempty"`
-	ClusterOptions *ClusterQueryOptions `thrift:"clusterOptions,10" db:"clusterOptions" json:"clusterOptions,omitempty"`
+//  - DocsLimit
+//  - RequireExhaustive
+//  - RequireNoWait
+type AggregateQueryRequest struct {
+	Query              *Query             `thrift:"query,1" db:"query" json:"query,omitempty"`
+	RangeStart         int64              `thrift:"rangeStart,2,required" db:"rangeStart" json:"rangeStart"`
+	RangeEnd           int64              `thrift:"rangeEnd,3,required" db:"rangeEnd" json:"rangeEnd"`
+	NameSpace          string             `thrift:"nameSpace,4,required" db:"nameSpace" json:"nameSpace"`
+	SeriesLimit        *int64             `thrift:"seriesLimit,5" db:"seriesLimit" json:"seriesLimit,omitempty"`
+	TagNameFilter      []string           `thrift:"tagNameFilter,6" db:"tagNameFilter" json:"tagNameFilter,omitempty"`
+	AggregateQueryType AggregateQueryType `thrift:"aggregateQueryType,7" db:"aggregateQueryType" json:"aggregateQueryType,omitempty"`
+	RangeType          TimeType           `thrift:"rangeType,8" db:"rangeType" json:"rangeType,omitempty"`
+	Source             []byte             `thrift:"source,9" db:"source" json:"source,omitempty"`
+	DocsLimit          *int64             `thrift:"docsLimit,10" db:"docsLimit" json:"docsLimit,omitempty"`
+	RequireExhaustive  *bool              `thrift:"requireExhaustive,11" db:"requireExhaustive" json:"requireExhaustive,omitempty"`
+	RequireNoWait      *bool              `thrift:"requireNoWait,12" db:"requireNoWait" json:"requireNoWait,omitempty"`
 }
 
-func NewQueryRequest() *QueryRequest {
-	return &QueryRequest{
-		RangeType: 0,
+func NewAggregateQueryRequest() *AggregateQueryRequest {
+	return &AggregateQueryRequest{
+		AggregateQueryType: 1,
 
-		ResultTimeType: 0,
+		RangeType: 0,
 	}
 }
 
-var QueryRequest_Query_DEFAULT *Query
+var AggregateQueryRequest_Query_DEFAULT *Query
 
-func (p *QueryRequest) GetQuery() *Query {
+func (p *AggregateQueryRequest) GetQuery() *Query {
 	if !p.IsSetQuery() {
-		return QueryRequest_Query_DEFAULT
+		return AggregateQueryRequest_Query_DEFAULT
 	}
 	return p.Query
 }
 
-func (p *QueryRequest) GetRangeStart() int64 {
+func (p *AggregateQueryRequest) GetRangeStart() int64 {
 	return p.RangeStart
 }
 
-func (p *QueryRequest) GetRangeEnd() int64 {
+func (p *AggregateQueryRequest) GetRangeEnd() int64 {
 	return p.RangeEnd
 }
 
-func (p *QueryRequest) GetNameSpace() string {
+func (p *AggregateQueryRequest) GetNameSpace() string {
 	return p.NameSpace
 }
 
-var QueryRequest_Limit_DEFAULT int64
+var AggregateQueryRequest_SeriesLimit_DEFAULT int64
 
-func (p *QueryRequest) GetLimit() int64 {
-	if !p.IsSetLimit() {
-		return QueryRequest_Limit_DEFAULT
+func (p *AggregateQueryRequest) GetSeriesLimit() int64 {
+	if !p.IsSetSeriesLimit() {
+		return AggregateQueryRequest_SeriesLimit_DEFAULT
 	}
-	return *p.Limit
+	return *p.SeriesLimit
 }
 
-var QueryRequest_NoData_DEFAULT bool
+var AggregateQueryRequest_TagNameFilter_DEFAULT []string
 
-func (p *QueryRequest) GetNoData() bool {
-	if !p.IsSetNoData() {
-		return QueryRequest_NoData_DEFAULT
-	}
-	return *p.NoData
+func (p *AggregateQueryRequest) GetTagNameFilter() []string {
+	return p.TagNameFilter
 }
 
-var QueryRequest_RangeType_DEFAULT TimeType = 0
+var AggregateQueryRequest_AggregateQueryType_DEFAULT AggregateQueryType = 1
 
-func (p *QueryRequest) GetRangeType() TimeType {
-	return p.RangeType
+func (p *AggregateQueryRequest) GetAggregateQueryType() AggregateQueryType {
+	return p.AggregateQueryType
 }
 
-var QueryRequest_ResultTimeType_DEFAULT TimeType = 0
+var AggregateQueryRequest_RangeType_DEFAULT TimeType = 0
 
-func (p *QueryRequest) GetResultTimeType() TimeType {
-	return p.ResultTimeType
+func (p *AggregateQueryRequest) GetRangeType() TimeType {
+	return p.RangeType
 }
 
-var QueryRequest_Source_DEFAULT []byte
+var AggregateQueryRequest_Source_DEFAULT []byte
 
-func (p *QueryRequest) GetSource() []byte {
+func (p *AggregateQueryRequest) GetSource() []byte {
 	return p.Source
 }
 
-var QueryRequest_ClusterOptions_DEFAULT *ClusterQueryOptions
+var AggregateQueryRequest_DocsLimit_DEFAULT int64
 
-func (p *QueryRequest) GetClusterOptions() *ClusterQueryOptions {
-	if !p.IsSetClusterOptions() {
-		return QueryRequest_ClusterOptions_DEFAULT
+func (p *AggregateQueryRequest) GetDocsLimit() int64 {
+	if !p.IsSetDocsLimit() {
+		return AggregateQueryRequest_DocsLimit_DEFAULT
 	}
-	return p.ClusterOptions
-}
-func (p *QueryRequest) IsSetQuery() bool {
-	return p.Query != nil
+	return *p.DocsLimit
 }
 
-func (p *QueryRequest) IsSetLimit() bool {
-	return p.Limit != nil
-}
+var AggregateQueryRequest_RequireExhaustive_DEFAULT bool
 
-func (p *QueryRequest) IsSetNoData() bool {
-	return p.NoData != nil
+func (p *AggregateQueryRequest) GetRequireExhaustive() bool {
+	if !p.IsSetRequireExhaustive() {
+		return AggregateQueryRequest_RequireExhaustive_DEFAULT
+	}
+	return *p.RequireExhaustive
 }
 
-func (p *QueryRequest) IsSetRangeType() bool {
-	return p.RangeType != QueryRequest_RangeType_DEFAULT
-}
+var AggregateQueryRequest_RequireNoWait_DEFAULT bool
 
-func (p *QueryRequest) IsSetResultTimeType() bool {
-	return p.ResultTimeType != QueryRequest_ResultTimeType_DEFAULT
+func (p *AggregateQueryRequest) GetRequireNoWait() bool {
+	if !p.IsSetRequireNoWait() {
+		return AggregateQueryRequest_RequireNoWait_DEFAULT
+	}
+	return *p.RequireNoWait
 }
-
-func (p *QueryRequest) IsSetSource() bool {
-	return p.Source != nil
+func (p *AggregateQueryRequest) IsSetQuery() bool {
+	return p.Query != nil
 }
 
-func (p *QueryRequest) IsSetClusterOptions() bool {
-	return p.ClusterOptions != nil
+func (p *AggregateQueryRequest) IsSetSeriesLimit() bool {
+	return p.SeriesLimit != nil
 }
 
-func (p *QueryRequest) Read(iprot thrift.TProtocol) error {
+func (p *AggregateQueryRequest) IsSetTagNameFilter() bool {
+	return p.TagNameFilter != nil
+}
+
+func (p *AggregateQueryRequest) IsSetAggregateQueryType() bool {
+	return p.AggregateQueryType != AggregateQueryRequest_AggregateQueryType_DEFAULT
+}
+
+func (p *AggregateQueryRequest) IsSetRangeType() bool {
+	return p.RangeType != AggregateQueryRequest_RangeType_DEFAULT
+}
+
+func (p *AggregateQueryRequest) IsSetSource() bool {
+	return p.Source != nil
+}
+
+func (p *AggregateQueryRequest) IsSetDocsLimit() bool {
+	return p.DocsLimit != nil
+}
+
+func (p *AggregateQueryRequest) IsSetRequireExhaustive() bool {
+	return p.RequireExhaustive != nil
+}
+
+func (p *AggregateQueryRequest) IsSetRequireNoWait() bool {
+	return p.RequireNoWait != nil
+}
+
+func (p *AggregateQueryRequest) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetQuery bool = false
 	var issetRangeStart bool = false
 	var issetRangeEnd bool = false
 	var issetNameSpace bool = false
@@ -12125,7 +13459,6 @@ func (p *QueryRequest) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetQuery = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
@@ -12165,6 +13498,14 @@ func (p *QueryRequest) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField10(iprot); err != nil {
 				return err
 			}
+		case 11:
+			if err := p.ReadField11(iprot); err != nil {
+				return err
+			}
+		case 12:
+			if err := p.ReadField12(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -12177,9 +13518,6 @@ func (p *QueryRequest) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetQuery {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Query is not set"))
-	}
 	if !issetRangeStart {
 		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RangeStart is not set"))
 	}
@@ -12192,7 +13530,7 @@ func (p *QueryRequest) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *AggregateQueryRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.Query = &Query{}
 	if err := p.Query.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Query), err)
@@ -12200,7 +13538,7 @@ func (p *QueryRequest) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *AggregateQueryRequest) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
@@ -12209,7 +13547,7 @@ func (p *QueryRequest) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *AggregateQueryRequest) ReadField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
@@ -12218,7 +13556,7 @@ func (p *QueryRequest) ReadField3(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryRequest) ReadField4(iprot thrift.TProtocol) error {
+func (p *AggregateQueryRequest) ReadField4(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return thrift.PrependError("error reading field 4: ", err)
 	} else {
@@ -12227,45 +13565,58 @@ func (p *QueryRequest) ReadField4(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryRequest) ReadField5(iprot thrift.TProtocol) error {
+func (p *AggregateQueryRequest) ReadField5(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(); err != nil {
 		return thrift.PrependError("error reading field 5: ", err)
 	} else {
-		p.Limit = &v
+		p.SeriesLimit = &v
 	}
 	return nil
 }
 
-func (p *QueryRequest) ReadField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
-	} else {
-		p.NoData = &v
+func (p *AggregateQueryRequest) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.TagNameFilter = tSlice
+	for i := 0; i < size; i++ {
+		var _elem27 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem27 = v
+		}
+		p.TagNameFilter = append(p.TagNameFilter, _elem27)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *QueryRequest) ReadField7(iprot thrift.TProtocol) error {
+func (p *AggregateQueryRequest) ReadField7(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return thrift.PrependError("error reading field 7: ", err)
 	} else {
-		temp := TimeType(v)
-		p.RangeType = temp
+		temp := AggregateQueryType(v)
+		p.AggregateQueryType = temp
 	}
 	return nil
 }
 
-func (p *QueryRequest) ReadField8(iprot thrift.TProtocol) error {
+func (p *AggregateQueryRequest) ReadField8(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return thrift.PrependError("error reading field 8: ", err)
 	} else {
 		temp := TimeType(v)
-		p.ResultTimeType = temp
+		p.RangeType = temp
 	}
 	return nil
 }
 
-func (p *QueryRequest) ReadField9(iprot thrift.TProtocol) error {
+func (p *AggregateQueryRequest) ReadField9(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 9: ", err)
 	} else {
@@ -12274,16 +13625,35 @@ func (p *QueryRequest) ReadField9(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryRequest) ReadField10(iprot thrift.TProtocol) error {
-	p.ClusterOptions = &ClusterQueryOptions{}
-	if err := p.ClusterOptions.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ClusterOptions), err)
+func (p *AggregateQueryRequest) ReadField10(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 10: ", err)
+	} else {
+		p.DocsLimit = &v
 	}
 	return nil
 }
 
-func (p *QueryRequest) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("QueryRequest"); err != nil {
+func (p *AggregateQueryRequest) ReadField11(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 11: ", err)
+	} else {
+		p.RequireExhaustive = &v
+	}
+	return nil
+}
+
+func (p *AggregateQueryRequest) ReadField12(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 12: ", err)
+	} else {
+		p.RequireNoWait = &v
+	}
+	return nil
+}
+
+func (p *AggregateQueryRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("AggregateQueryRequest"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -12317,6 +13687,12 @@ func (p *QueryRequest) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField10(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField11(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField12(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -12327,20 +13703,22 @@ func (p *QueryRequest) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("query", thrift.STRUCT, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:query: ", p), err)
-	}
-	if err := p.Query.Write(oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Query), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:query: ", p), err)
+func (p *AggregateQueryRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQuery() {
+		if err := oprot.WriteFieldBegin("query", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:query: ", p), err)
+		}
+		if err := p.Query.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Query), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:query: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *QueryRequest) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *AggregateQueryRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("rangeStart", thrift.I64, 2); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:rangeStart: ", p), err)
 	}
@@ -12353,7 +13731,7 @@ func (p *QueryRequest) writeField2(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *QueryRequest) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *AggregateQueryRequest) writeField3(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("rangeEnd", thrift.I64, 3); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:rangeEnd: ", p), err)
 	}
@@ -12366,7 +13744,7 @@ func (p *QueryRequest) writeField3(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *QueryRequest) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *AggregateQueryRequest) writeField4(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("nameSpace", thrift.STRING, 4); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:nameSpace: ", p), err)
 	}
@@ -12379,67 +13757,75 @@ func (p *QueryRequest) writeField4(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *QueryRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetLimit() {
-		if err := oprot.WriteFieldBegin("limit", thrift.I64, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:limit: ", p), err)
+func (p *AggregateQueryRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSeriesLimit() {
+		if err := oprot.WriteFieldBegin("seriesLimit", thrift.I64, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:seriesLimit: ", p), err)
 		}
-		if err := oprot.WriteI64(int64(*p.Limit)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.limit (5) field write error: ", p), err)
+		if err := oprot.WriteI64(int64(*p.SeriesLimit)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.seriesLimit (5) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:limit: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:seriesLimit: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *QueryRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetNoData() {
-		if err := oprot.WriteFieldBegin("noData", thrift.BOOL, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:noData: ", p), err)
+func (p *AggregateQueryRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTagNameFilter() {
+		if err := oprot.WriteFieldBegin("tagNameFilter", thrift.LIST, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:tagNameFilter: ", p), err)
 		}
-		if err := oprot.WriteBool(bool(*p.NoData)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.noData (6) field write error: ", p), err)
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.TagNameFilter)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.TagNameFilter {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:noData: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:tagNameFilter: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *QueryRequest) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRangeType() {
-		if err := oprot.WriteFieldBegin("rangeType", thrift.I32, 7); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:rangeType: ", p), err)
+func (p *AggregateQueryRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAggregateQueryType() {
+		if err := oprot.WriteFieldBegin("aggregateQueryType", thrift.I32, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:aggregateQueryType: ", p), err)
 		}
-		if err := oprot.WriteI32(int32(p.RangeType)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.rangeType (7) field write error: ", p), err)
+		if err := oprot.WriteI32(int32(p.AggregateQueryType)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.aggregateQueryType (7) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:rangeType: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:aggregateQueryType: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *QueryRequest) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetResultTimeType() {
-		if err := oprot.WriteFieldBegin("resultTimeType", thrift.I32, 8); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:resultTimeType: ", p), err)
+func (p *AggregateQueryRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRangeType() {
+		if err := oprot.WriteFieldBegin("rangeType", thrift.I32, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:rangeType: ", p), err)
 		}
-		if err := oprot.WriteI32(int32(p.ResultTimeType)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.resultTimeType (8) field write error: ", p), err)
+		if err := oprot.WriteI32(int32(p.RangeType)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.rangeType (8) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:resultTimeType: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:rangeType: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *QueryRequest) writeField9(oprot thrift.TProtocol) (err error) {
+func (p *AggregateQueryRequest) writeField9(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSource() {
 		if err := oprot.WriteFieldBegin("source", thrift.STRING, 9); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:source: ", p), err)
@@ -12454,200 +13840,78 @@ func (p *QueryRequest) writeField9(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *QueryRequest) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetClusterOptions() {
-		if err := oprot.WriteFieldBegin("clusterOptions", thrift.STRUCT, 10); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:clusterOptions: ", p), err)
+func (p *AggregateQueryRequest) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDocsLimit() {
+		if err := oprot.WriteFieldBegin("docsLimit", thrift.I64, 10); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:docsLimit: ", p), err)
 		}
-		if err := p.ClusterOptions.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ClusterOptions), err)
+		if err := oprot.WriteI64(int64(*p.DocsLimit)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.docsLimit (10) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:clusterOptions: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:docsLimit: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *QueryRequest) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *AggregateQueryRequest) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRequireExhaustive() {
+		if err := oprot.WriteFieldBegin("requireExhaustive", thrift.BOOL, 11); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:requireExhaustive: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(*p.RequireExhaustive)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.requireExhaustive (11) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:requireExhaustive: ", p), err)
+		}
 	}
-	return fmt.Sprintf("QueryRequest(%+v)", *p)
-}
-
-// Attributes:
-//  - ReadConsistency
-//  - ConflictResolutionStrategy
-type ClusterQueryOptions struct {
-	ReadConsistency            *ReadConsistency        `thrift:"readConsistency,1" db:"readConsistency" json:"readConsistency,omitempty"`
-	ConflictResolutionStrategy *EqualTimestampStrategy `thrift:"conflictResolutionStrategy,2" db:"conflictResolutionStrategy" json:"conflictResolutionStrategy,omitempty"`
+	return err
 }
 
-func NewClusterQueryOptions() *ClusterQueryOptions {
-	return &ClusterQueryOptions{}
-}
-
-var ClusterQueryOptions_ReadConsistency_DEFAULT ReadConsistency
-
-func (p *ClusterQueryOptions) GetReadConsistency() ReadConsistency {
-	if !p.IsSetReadConsistency() {
-		return ClusterQueryOptions_ReadConsistency_DEFAULT
-	}
-	return *p.ReadConsistency
-}
-
-var ClusterQueryOptions_ConflictResolutionStrategy_DEFAULT EqualTimestampStrategy
-
-func (p *ClusterQueryOptions) GetConflictResolutionStrategy() EqualTimestampStrategy {
-	if !p.IsSetConflictResolutionStrategy() {
-		return ClusterQueryOptions_ConflictResolutionStrategy_DEFAULT
-	}
-	return *p.ConflictResolutionStrategy
-}
-func (p *ClusterQueryOptions) IsSetReadConsistency() bool {
-	return p.ReadConsistency != nil
-}
-
-func (p *ClusterQueryOptions) IsSetConflictResolutionStrategy() bool {
-	return p.ConflictResolutionStrategy != nil
-}
-
-func (p *ClusterQueryOptions) Read(iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if err := p.ReadField1(iprot); err != nil {
-				return err
-			}
-		case 2:
-			if err := p.ReadField2(iprot); err != nil {
-				return err
-			}
-		default:
-			if err := iprot.Skip(fieldTypeId); err != nil {
-				return err
-			}
-		}
-		if err := iprot.ReadFieldEnd(); err != nil {
-			return err
-		}
-	}
-	if err := iprot.ReadStructEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	return nil
-}
-
-func (p *ClusterQueryOptions) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		temp := ReadConsistency(v)
-		p.ReadConsistency = &temp
-	}
-	return nil
-}
-
-func (p *ClusterQueryOptions) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		temp := EqualTimestampStrategy(v)
-		p.ConflictResolutionStrategy = &temp
-	}
-	return nil
-}
-
-func (p *ClusterQueryOptions) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("ClusterQueryOptions"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
-		if err := p.writeField1(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField2(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
-	}
-	return nil
-}
-
-func (p *ClusterQueryOptions) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetReadConsistency() {
-		if err := oprot.WriteFieldBegin("readConsistency", thrift.I32, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:readConsistency: ", p), err)
-		}
-		if err := oprot.WriteI32(int32(*p.ReadConsistency)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.readConsistency (1) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:readConsistency: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *ClusterQueryOptions) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetConflictResolutionStrategy() {
-		if err := oprot.WriteFieldBegin("conflictResolutionStrategy", thrift.I32, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:conflictResolutionStrategy: ", p), err)
+func (p *AggregateQueryRequest) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRequireNoWait() {
+		if err := oprot.WriteFieldBegin("requireNoWait", thrift.BOOL, 12); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:requireNoWait: ", p), err)
 		}
-		if err := oprot.WriteI32(int32(*p.ConflictResolutionStrategy)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.conflictResolutionStrategy (2) field write error: ", p), err)
+		if err := oprot.WriteBool(bool(*p.RequireNoWait)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.requireNoWait (12) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:conflictResolutionStrategy: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 12:requireNoWait: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ClusterQueryOptions) String() string {
+func (p *AggregateQueryRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ClusterQueryOptions(%+v)", *p)
+	return fmt.Sprintf("AggregateQueryRequest(%+v)", *p)
 }
 
 // Attributes:
 //  - Results
 //  - Exhaustive
-type QueryResult_ struct {
-	Results    []*QueryResultElement `thrift:"results,1,required" db:"results" json:"results"`
-	Exhaustive bool                  `thrift:"exhaustive,2,required" db:"exhaustive" json:"exhaustive"`
+type AggregateQueryResult_ struct {
+	Results    []*AggregateQueryResultTagNameElement `thrift:"results,1,required" db:"results" json:"results"`
+	Exhaustive bool                                  `thrift:"exhaustive,2,required" db:"exhaustive" json:"exhaustive"`
 }
 
-func NewQueryResult_() *QueryResult_ {
-	return &QueryResult_{}
+func NewAggregateQueryResult_() *AggregateQueryResult_ {
+	return &AggregateQueryResult_{}
 }
 
-func (p *QueryResult_) GetResults() []*QueryResultElement {
+func (p *AggregateQueryResult_) GetResults() []*AggregateQueryResultTagNameElement {
 	return p.Results
 }
 
-func (p *QueryResult_) GetExhaustive() bool {
+func (p *AggregateQueryResult_) GetExhaustive() bool {
 	return p.Exhaustive
 }
-func (p *QueryResult_) Read(iprot thrift.TProtocol) error {
+func (p *AggregateQueryResult_) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -12695,19 +13959,19 @@ func (p *QueryResult_) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryResult_) ReadField1(iprot thrift.TProtocol) error {
+func (p *AggregateQueryResult_) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]*QueryResultElement, 0, size)
+	tSlice := make([]*AggregateQueryResultTagNameElement, 0, size)
 	p.Results = tSlice
 	for i := 0; i < size; i++ {
-		_elem30 := &QueryResultElement{}
-		if err := _elem30.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem30), err)
+		_elem28 := &AggregateQueryResultTagNameElement{}
+		if err := _elem28.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem28), err)
 		}
-		p.Results = append(p.Results, _elem30)
+		p.Results = append(p.Results, _elem28)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -12715,7 +13979,7 @@ func (p *QueryResult_) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryResult_) ReadField2(iprot thrift.TProtocol) error {
+func (p *AggregateQueryResult_) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBool(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
@@ -12724,8 +13988,8 @@ func (p *QueryResult_) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("QueryResult"); err != nil {
+func (p *AggregateQueryResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("AggregateQueryResult"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -12745,7 +14009,7 @@ func (p *QueryResult_) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryResult_) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AggregateQueryResult_) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("results", thrift.LIST, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:results: ", p), err)
 	}
@@ -12766,7 +14030,7 @@ func (p *QueryResult_) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *QueryResult_) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *AggregateQueryResult_) writeField2(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("exhaustive", thrift.BOOL, 2); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:exhaustive: ", p), err)
 	}
@@ -12779,46 +14043,44 @@ func (p *QueryResult_) writeField2(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *QueryResult_) String() string {
+func (p *AggregateQueryResult_) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryResult_(%+v)", *p)
+	return fmt.Sprintf("AggregateQueryResult_(%+v)", *p)
 }
 
 // Attributes:
-//  - ID
-//  - Tags
-//  - Datapoints
-type QueryResultElement struct {
-	ID         string       `thrift:"id,1,required" db:"id" json:"id"`
-	Tags       []*Tag       `thrift:"tags,2,required" db:"tags" json:"tags"`
-	Datapoints []*Datapoint `thrift:"datapoints,3,required" db:"datapoints" json:"datapoints"`
+//  - TagName
+//  - TagValues
+type AggregateQueryResultTagNameElement struct {
+	TagName   string                                 `thrift:"tagName,1,required" db:"tagName" json:"tagName"`
+	TagValues []*AggregateQueryResultTagValueElement `thrift:"tagValues,2" db:"tagValues" json:"tagValues,omitempty"`
 }
 
-func NewQueryResultElement() *QueryResultElement {
-	return &QueryResultElement{}
+func NewAggregateQueryResultTagNameElement() *AggregateQueryResultTagNameElement {
+	return &AggregateQueryResultTagNameElement{}
 }
 
-func (p *QueryResultElement) GetID() string {
-	return p.ID
+func (p *AggregateQueryResultTagNameElement) GetTagName() string {
+	return p.TagName
 }
 
-func (p *QueryResultElement) GetTags() []*Tag {
-	return p.Tags
-}
+var AggregateQueryResultTagNameElement_TagValues_DEFAULT []*AggregateQueryResultTagValueElement
 
-func (p *QueryResultElement) GetDatapoints() []*Datapoint {
-	return p.Datapoints
+func (p *AggregateQueryResultTagNameElement) GetTagValues() []*AggregateQueryResultTagValueElement {
+	return p.TagValues
 }
-func (p *QueryResultElement) Read(iprot thrift.TProtocol) error {
+func (p *AggregateQueryResultTagNameElement) IsSetTagValues() bool {
+	return p.TagValues != nil
+}
+
+func (p *AggregateQueryResultTagNameElement) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetID bool = false
-	var issetTags bool = false
-	var issetDatapoints bool = false
+	var issetTagName bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -12833,17 +14095,11 @@ func (p *QueryResultElement) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetID = true
+			issetTagName = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetTags = true
-		case 3:
-			if err := p.ReadField3(iprot); err != nil {
-				return err
-			}
-			issetDatapoints = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -12856,40 +14112,34 @@ func (p *QueryResultElement) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetID {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
-	}
-	if !issetTags {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Tags is not set"))
-	}
-	if !issetDatapoints {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Datapoints is not set"))
+	if !issetTagName {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TagName is not set"))
 	}
 	return nil
 }
 
-func (p *QueryResultElement) ReadField1(iprot thrift.TProtocol) error {
+func (p *AggregateQueryResultTagNameElement) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.ID = v
+		p.TagName = v
 	}
 	return nil
 }
 
-func (p *QueryResultElement) ReadField2(iprot thrift.TProtocol) error {
+func (p *AggregateQueryResultTagNameElement) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]*Tag, 0, size)
-	p.Tags = tSlice
+	tSlice := make([]*AggregateQueryResultTagValueElement, 0, size)
+	p.TagValues = tSlice
 	for i := 0; i < size; i++ {
-		_elem31 := &Tag{}
-		if err := _elem31.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem31), err)
+		_elem29 := &AggregateQueryResultTagValueElement{}
+		if err := _elem29.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem29), err)
 		}
-		p.Tags = append(p.Tags, _elem31)
+		p.TagValues = append(p.TagValues, _elem29)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -12897,30 +14147,8 @@ func (p *QueryResultElement) ReadField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryResultElement) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*Datapoint, 0, size)
-	p.Datapoints = tSlice
-	for i := 0; i < size; i++ {
-		_elem32 := &Datapoint{
-			TimestampTimeType: 0,
-		}
-		if err := _elem32.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem32), err)
-		}
-		p.Datapoints = append(p.Datapoints, _elem32)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
-	}
-	return nil
-}
-
-func (p *QueryResultElement) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("QueryResultElement"); err != nil {
+func (p *AggregateQueryResultTagNameElement) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("AggregateQueryResultTagNameElement"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -12930,9 +14158,6 @@ func (p *QueryResultElement) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField2(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -12943,94 +14168,68 @@ func (p *QueryResultElement) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryResultElement) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
+func (p *AggregateQueryResultTagNameElement) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("tagName", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:tagName: ", p), err)
 	}
-	if err := oprot.WriteString(string(p.ID)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+	if err := oprot.WriteString(string(p.TagName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.tagName (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:tagName: ", p), err)
 	}
 	return err
 }
 
-func (p *QueryResultElement) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("tags", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:tags: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Tags)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Tags {
-		if err := v.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+func (p *AggregateQueryResultTagNameElement) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTagValues() {
+		if err := oprot.WriteFieldBegin("tagValues", thrift.LIST, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:tagValues: ", p), err)
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:tags: ", p), err)
-	}
-	return err
-}
-
-func (p *QueryResultElement) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("datapoints", thrift.LIST, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:datapoints: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Datapoints)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Datapoints {
-		if err := v.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.TagValues)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.TagValues {
+			if err := v.Write(oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:tagValues: ", p), err)
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:datapoints: ", p), err)
 	}
 	return err
 }
 
-func (p *QueryResultElement) String() string {
+func (p *AggregateQueryResultTagNameElement) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryResultElement(%+v)", *p)
+	return fmt.Sprintf("AggregateQueryResultTagNameElement(%+v)", *p)
 }
 
 // Attributes:
-//  - Field
-//  - Term
-type TermQuery struct {
-	Field string `thrift:"field,1,required" db:"field" json:"field"`
-	Term  string `thrift:"term,2,required" db:"term" json:"term"`
-}
-
-func NewTermQuery() *TermQuery {
-	return &TermQuery{}
+//  - TagValue
+type AggregateQueryResultTagValueElement struct {
+	TagValue string `thrift:"tagValue,1,required" db:"tagValue" json:"tagValue"`
 }
 
-func (p *TermQuery) GetField() string {
-	return p.Field
+func NewAggregateQueryResultTagValueElement() *AggregateQueryResultTagValueElement {
+	return &AggregateQueryResultTagValueElement{}
 }
 
-func (p *TermQuery) GetTerm() string {
-	return p.Term
+func (p *AggregateQueryResultTagValueElement) GetTagValue() string {
+	return p.TagValue
 }
-func (p *TermQuery) Read(iprot thrift.TProtocol) error {
+func (p *AggregateQueryResultTagValueElement) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetField bool = false
-	var issetTerm bool = false
+	var issetTagValue bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -13045,12 +14244,7 @@ func (p *TermQuery) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetField = true
-		case 2:
-			if err := p.ReadField2(iprot); err != nil {
-				return err
-			}
-			issetTerm = true
+			issetTagValue = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -13063,44 +14257,29 @@ func (p *TermQuery) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetField {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Field is not set"))
-	}
-	if !issetTerm {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Term is not set"))
+	if !issetTagValue {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TagValue is not set"))
 	}
 	return nil
 }
 
-func (p *TermQuery) ReadField1(iprot thrift.TProtocol) error {
+func (p *AggregateQueryResultTagValueElement) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.Field = v
-	}
-	return nil
-}
-
-func (p *TermQuery) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.Term = v
+		p.TagValue = v
 	}
 	return nil
 }
 
-func (p *TermQuery) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("TermQuery"); err != nil {
+func (p *AggregateQueryResultTagValueElement) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("AggregateQueryResultTagValueElement"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField2(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -13111,206 +14290,160 @@ func (p *TermQuery) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TermQuery) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("field", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:field: ", p), err)
-	}
-	if err := oprot.WriteString(string(p.Field)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.field (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:field: ", p), err)
-	}
-	return err
-}
-
-func (p *TermQuery) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("term", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:term: ", p), err)
+func (p *AggregateQueryResultTagValueElement) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("tagValue", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:tagValue: ", p), err)
 	}
-	if err := oprot.WriteString(string(p.Term)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.term (2) field write error: ", p), err)
+	if err := oprot.WriteString(string(p.TagValue)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.tagValue (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:term: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:tagValue: ", p), err)
 	}
 	return err
 }
 
-func (p *TermQuery) String() string {
+func (p *AggregateQueryResultTagValueElement) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TermQuery(%+v)", *p)
+	return fmt.Sprintf("AggregateQueryResultTagValueElement(%+v)", *p)
 }
 
 // Attributes:
-//  - Field
-//  - Regexp
-type RegexpQuery struct {
-	Field  string `thrift:"field,1,required" db:"field" json:"field"`
-	Regexp string `thrift:"regexp,2,required" db:"regexp" json:"regexp"`
+//  - Query
+//  - RangeStart
+//  - RangeEnd
+//  - NameSpace
+//  - Limit
+//  - NoData
+//  - RangeType
+//  - ResultTimeType
+//  - Source
+//  - ClusterOptions
+type QueryRequest struct {
+	Query          *Query               `thrift:"query,1,required" db:"query" json:"query"`
+	RangeStart     int64                `thrift:"rangeStart,2,required" db:"rangeStart" json:"rangeStart"`
+	RangeEnd       int64                `thrift:"rangeEnd,3,required" db:"rangeEnd" json:"rangeEnd"`
+	NameSpace      string               `thrift:"nameSpace,4,required" db:"nameSpace" json:"nameSpace"`
+	Limit          *int64               `thrift:"limit,5" db:"limit" json:"limit,omitempty"`
+	NoData         *bool                `thrift:"noData,6" db:"noData" json:"noData,omitempty"`
+	RangeType      TimeType             `thrift:"rangeType,7" db:"rangeType" json:"rangeType,omitempty"`
+	ResultTimeType TimeType             `thrift:"resultTimeType,8" db:"resultTimeType" json:"resultTimeType,omitempty"`
+	Source         []byte               `thrift:"source,9" db:"source" json:"source,omitempty"`
+	ClusterOptions *ClusterQueryOptions `thrift:"clusterOptions,10" db:"clusterOptions" json:"clusterOptions,omitempty"`
 }
 
-func NewRegexpQuery() *RegexpQuery {
-	return &RegexpQuery{}
+func NewQueryRequest() *QueryRequest {
+	return &QueryRequest{
+		RangeType: 0,
+
+		ResultTimeType: 0,
+	}
 }
 
-func (p *RegexpQuery) GetField() string {
-	return p.Field
+var QueryRequest_Query_DEFAULT *Query
+
+func (p *QueryRequest) GetQuery() *Query {
+	if !p.IsSetQuery() {
+		return QueryRequest_Query_DEFAULT
+	}
+	return p.Query
 }
 
-func (p *RegexpQuery) GetRegexp() string {
-	return p.Regexp
+func (p *QueryRequest) GetRangeStart() int64 {
+	return p.RangeStart
 }
-func (p *RegexpQuery) Read(iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+
+func (p *QueryRequest) GetRangeEnd() int64 {
+	return p.RangeEnd
+}
+
+func (p *QueryRequest) GetNameSpace() string {
+	return p.NameSpace
+}
+
+var QueryRequest_Limit_DEFAULT int64
+
+func (p *QueryRequest) GetLimit() int64 {
+	if !p.IsSetLimit() {
+		return QueryRequest_Limit_DEFAULT
 	}
+	return *p.Limit
+}
 
-	var issetField bool = false
-	var issetRegexp bool = false
+var QueryRequest_NoData_DEFAULT bool
 
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if err := p.ReadField1(iprot); err != nil {
-				return err
-			}
-			issetField = true
-		case 2:
-			if err := p.ReadField2(iprot); err != nil {
-				return err
-			}
-			issetRegexp = true
-		default:
-			if err := iprot.Skip(fieldTypeId); err != nil {
-				return err
-			}
-		}
-		if err := iprot.ReadFieldEnd(); err != nil {
-			return err
-		}
-	}
-	if err := iprot.ReadStructEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	if !issetField {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Field is not set"))
-	}
-	if !issetRegexp {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Regexp is not set"))
+func (p *QueryRequest) GetNoData() bool {
+	if !p.IsSetNoData() {
+		return QueryRequest_NoData_DEFAULT
 	}
-	return nil
+	return *p.NoData
 }
 
-func (p *RegexpQuery) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.Field = v
-	}
-	return nil
-}
+var QueryRequest_RangeType_DEFAULT TimeType = 0
 
-func (p *RegexpQuery) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.Regexp = v
-	}
-	return nil
+func (p *QueryRequest) GetRangeType() TimeType {
+	return p.RangeType
 }
 
-func (p *RegexpQuery) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("RegexpQuery"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
-		if err := p.writeField1(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField2(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
-	}
-	return nil
+var QueryRequest_ResultTimeType_DEFAULT TimeType = 0
+
+func (p *QueryRequest) GetResultTimeType() TimeType {
+	return p.ResultTimeType
 }
 
-func (p *RegexpQuery) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("field", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:field: ", p), err)
-	}
-	if err := oprot.WriteString(string(p.Field)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.field (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:field: ", p), err)
-	}
-	return err
+var QueryRequest_Source_DEFAULT []byte
+
+func (p *QueryRequest) GetSource() []byte {
+	return p.Source
 }
 
-func (p *RegexpQuery) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("regexp", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:regexp: ", p), err)
-	}
-	if err := oprot.WriteString(string(p.Regexp)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.regexp (2) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:regexp: ", p), err)
+var QueryRequest_ClusterOptions_DEFAULT *ClusterQueryOptions
+
+func (p *QueryRequest) GetClusterOptions() *ClusterQueryOptions {
+	if !p.IsSetClusterOptions() {
+		return QueryRequest_ClusterOptions_DEFAULT
 	}
-	return err
+	return p.ClusterOptions
+}
+func (p *QueryRequest) IsSetQuery() bool {
+	return p.Query != nil
 }
 
-func (p *RegexpQuery) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("RegexpQuery(%+v)", *p)
+func (p *QueryRequest) IsSetLimit() bool {
+	return p.Limit != nil
 }
 
-// Attributes:
-//  - Query
-type NegationQuery struct {
-	Query *Query `thrift:"query,1,required" db:"query" json:"query"`
+func (p *QueryRequest) IsSetNoData() bool {
+	return p.NoData != nil
 }
 
-func NewNegationQuery() *NegationQuery {
-	return &NegationQuery{}
+func (p *QueryRequest) IsSetRangeType() bool {
+	return p.RangeType != QueryRequest_RangeType_DEFAULT
 }
 
-var NegationQuery_Query_DEFAULT *Query
+func (p *QueryRequest) IsSetResultTimeType() bool {
+	return p.ResultTimeType != QueryRequest_ResultTimeType_DEFAULT
+}
 
-func (p *NegationQuery) GetQuery() *Query {
-	if !p.IsSetQuery() {
-		return NegationQuery_Query_DEFAULT
-	}
-	return p.Query
+func (p *QueryRequest) IsSetSource() bool {
+	return p.Source != nil
 }
-func (p *NegationQuery) IsSetQuery() bool {
-	return p.Query != nil
+
+func (p *QueryRequest) IsSetClusterOptions() bool {
+	return p.ClusterOptions != nil
 }
 
-func (p *NegationQuery) Read(iprot thrift.TProtocol) error {
+func (p *QueryRequest) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
 	var issetQuery bool = false
+	var issetRangeStart bool = false
+	var issetRangeEnd bool = false
+	var issetNameSpace bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -13326,6 +14459,45 @@ func (p *NegationQuery) Read(iprot thrift.TProtocol) error {
 				return err
 			}
 			issetQuery = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+			issetRangeStart = true
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
+			issetRangeEnd = true
+		case 4:
+			if err := p.ReadField4(iprot); err != nil {
+				return err
+			}
+			issetNameSpace = true
+		case 5:
+			if err := p.ReadField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.ReadField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.ReadField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.ReadField8(iprot); err != nil {
+				return err
+			}
+		case 9:
+			if err := p.ReadField9(iprot); err != nil {
+				return err
+			}
+		case 10:
+			if err := p.ReadField10(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -13341,10 +14513,19 @@ func (p *NegationQuery) Read(iprot thrift.TProtocol) error {
 	if !issetQuery {
 		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Query is not set"))
 	}
+	if !issetRangeStart {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RangeStart is not set"))
+	}
+	if !issetRangeEnd {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RangeEnd is not set"))
+	}
+	if !issetNameSpace {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NameSpace is not set"))
+	}
 	return nil
 }
 
-func (p *NegationQuery) ReadField1(iprot thrift.TProtocol) error {
+func (p *QueryRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.Query = &Query{}
 	if err := p.Query.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Query), err)
@@ -13352,124 +14533,123 @@ func (p *NegationQuery) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NegationQuery) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("NegationQuery"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
-		if err := p.writeField1(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
+func (p *QueryRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.RangeStart = v
 	}
 	return nil
 }
 
-func (p *NegationQuery) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("query", thrift.STRUCT, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:query: ", p), err)
-	}
-	if err := p.Query.Write(oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Query), err)
+func (p *QueryRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.RangeEnd = v
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:query: ", p), err)
+	return nil
+}
+
+func (p *QueryRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.NameSpace = v
 	}
-	return err
+	return nil
 }
 
-func (p *NegationQuery) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *QueryRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Limit = &v
 	}
-	return fmt.Sprintf("NegationQuery(%+v)", *p)
+	return nil
 }
 
-// Attributes:
-//  - Queries
-type ConjunctionQuery struct {
-	Queries []*Query `thrift:"queries,1,required" db:"queries" json:"queries"`
+func (p *QueryRequest) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.NoData = &v
+	}
+	return nil
 }
 
-func NewConjunctionQuery() *ConjunctionQuery {
-	return &ConjunctionQuery{}
+func (p *QueryRequest) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		temp := TimeType(v)
+		p.RangeType = temp
+	}
+	return nil
 }
 
-func (p *ConjunctionQuery) GetQueries() []*Query {
-	return p.Queries
-}
-func (p *ConjunctionQuery) Read(iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+func (p *QueryRequest) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		temp := TimeType(v)
+		p.ResultTimeType = temp
 	}
+	return nil
+}
 
-	var issetQueries bool = false
-
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if err := p.ReadField1(iprot); err != nil {
-				return err
-			}
-			issetQueries = true
-		default:
-			if err := iprot.Skip(fieldTypeId); err != nil {
-				return err
-			}
-		}
-		if err := iprot.ReadFieldEnd(); err != nil {
-			return err
-		}
-	}
-	if err := iprot.ReadStructEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	if !issetQueries {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Queries is not set"))
+func (p *QueryRequest) ReadField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.Source = v
 	}
 	return nil
 }
 
-func (p *ConjunctionQuery) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*Query, 0, size)
-	p.Queries = tSlice
-	for i := 0; i < size; i++ {
-		_elem33 := &Query{}
-		if err := _elem33.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem33), err)
-		}
-		p.Queries = append(p.Queries, _elem33)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *QueryRequest) ReadField10(iprot thrift.TProtocol) error {
+	p.ClusterOptions = &ClusterQueryOptions{}
+	if err := p.ClusterOptions.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ClusterOptions), err)
 	}
 	return nil
 }
 
-func (p *ConjunctionQuery) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("ConjunctionQuery"); err != nil {
+func (p *QueryRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("QueryRequest"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField10(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -13480,160 +14660,193 @@ func (p *ConjunctionQuery) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ConjunctionQuery) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("queries", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:queries: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Queries)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Queries {
-		if err := v.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-		}
+func (p *QueryRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("query", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:query: ", p), err)
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := p.Query.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Query), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:queries: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:query: ", p), err)
 	}
 	return err
 }
 
-func (p *ConjunctionQuery) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *QueryRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("rangeStart", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:rangeStart: ", p), err)
 	}
-	return fmt.Sprintf("ConjunctionQuery(%+v)", *p)
-}
-
-// Attributes:
-//  - Queries
-type DisjunctionQuery struct {
-	Queries []*Query `thrift:"queries,1,required" db:"queries" json:"queries"`
+	if err := oprot.WriteI64(int64(p.RangeStart)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.rangeStart (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:rangeStart: ", p), err)
+	}
+	return err
 }
 
-func NewDisjunctionQuery() *DisjunctionQuery {
-	return &DisjunctionQuery{}
+func (p *QueryRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("rangeEnd", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:rangeEnd: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.RangeEnd)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.rangeEnd (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:rangeEnd: ", p), err)
+	}
+	return err
 }
 
-func (p *DisjunctionQuery) GetQueries() []*Query {
-	return p.Queries
-}
-func (p *DisjunctionQuery) Read(iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+func (p *QueryRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("nameSpace", thrift.STRING, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:nameSpace: ", p), err)
 	}
+	if err := oprot.WriteString(string(p.NameSpace)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.nameSpace (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:nameSpace: ", p), err)
+	}
+	return err
+}
 
-	var issetQueries bool = false
-
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-		}
-		if fieldTypeId == thrift.STOP {
-			break
+func (p *QueryRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimit() {
+		if err := oprot.WriteFieldBegin("limit", thrift.I64, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:limit: ", p), err)
 		}
-		switch fieldId {
-		case 1:
-			if err := p.ReadField1(iprot); err != nil {
-				return err
-			}
-			issetQueries = true
-		default:
-			if err := iprot.Skip(fieldTypeId); err != nil {
-				return err
-			}
+		if err := oprot.WriteI64(int64(*p.Limit)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.limit (5) field write error: ", p), err)
 		}
-		if err := iprot.ReadFieldEnd(); err != nil {
-			return err
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:limit: ", p), err)
 		}
 	}
-	if err := iprot.ReadStructEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	if !issetQueries {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Queries is not set"))
-	}
-	return nil
+	return err
 }
 
-func (p *DisjunctionQuery) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*Query, 0, size)
-	p.Queries = tSlice
-	for i := 0; i < size; i++ {
-		_elem34 := &Query{}
-		if err := _elem34.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem34), err)
+func (p *QueryRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNoData() {
+		if err := oprot.WriteFieldBegin("noData", thrift.BOOL, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:noData: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(*p.NoData)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.noData (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:noData: ", p), err)
 		}
-		p.Queries = append(p.Queries, _elem34)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
 	}
-	return nil
+	return err
 }
 
-func (p *DisjunctionQuery) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("DisjunctionQuery"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
-		if err := p.writeField1(oprot); err != nil {
-			return err
+func (p *QueryRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRangeType() {
+		if err := oprot.WriteFieldBegin("rangeType", thrift.I32, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:rangeType: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(p.RangeType)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.rangeType (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:rangeType: ", p), err)
 		}
 	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
-	}
-	return nil
+	return err
 }
 
-func (p *DisjunctionQuery) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("queries", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:queries: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Queries)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Queries {
-		if err := v.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+func (p *QueryRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResultTimeType() {
+		if err := oprot.WriteFieldBegin("resultTimeType", thrift.I32, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:resultTimeType: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(p.ResultTimeType)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.resultTimeType (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:resultTimeType: ", p), err)
 		}
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	return err
+}
+
+func (p *QueryRequest) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSource() {
+		if err := oprot.WriteFieldBegin("source", thrift.STRING, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:source: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.Source); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.source (9) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:source: ", p), err)
+		}
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:queries: ", p), err)
+	return err
+}
+
+func (p *QueryRequest) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetClusterOptions() {
+		if err := oprot.WriteFieldBegin("clusterOptions", thrift.STRUCT, 10); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:clusterOptions: ", p), err)
+		}
+		if err := p.ClusterOptions.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ClusterOptions), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:clusterOptions: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *DisjunctionQuery) String() string {
+func (p *QueryRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DisjunctionQuery(%+v)", *p)
+	return fmt.Sprintf("QueryRequest(%+v)", *p)
 }
 
-type AllQuery struct {
+// Attributes:
+//  - ReadConsistency
+//  - ConflictResolutionStrategy
+type ClusterQueryOptions struct {
+	ReadConsistency            *ReadConsistency        `thrift:"readConsistency,1" db:"readConsistency" json:"readConsistency,omitempty"`
+	ConflictResolutionStrategy *EqualTimestampStrategy `thrift:"conflictResolutionStrategy,2" db:"conflictResolutionStrategy" json:"conflictResolutionStrategy,omitempty"`
 }
 
-func NewAllQuery() *AllQuery {
-	return &AllQuery{}
+func NewClusterQueryOptions() *ClusterQueryOptions {
+	return &ClusterQueryOptions{}
 }
 
-func (p *AllQuery) Read(iprot thrift.TProtocol) error {
+var ClusterQueryOptions_ReadConsistency_DEFAULT ReadConsistency
+
+func (p *ClusterQueryOptions) GetReadConsistency() ReadConsistency {
+	if !p.IsSetReadConsistency() {
+		return ClusterQueryOptions_ReadConsistency_DEFAULT
+	}
+	return *p.ReadConsistency
+}
+
+var ClusterQueryOptions_ConflictResolutionStrategy_DEFAULT EqualTimestampStrategy
+
+func (p *ClusterQueryOptions) GetConflictResolutionStrategy() EqualTimestampStrategy {
+	if !p.IsSetConflictResolutionStrategy() {
+		return ClusterQueryOptions_ConflictResolutionStrategy_DEFAULT
+	}
+	return *p.ConflictResolutionStrategy
+}
+func (p *ClusterQueryOptions) IsSetReadConsistency() bool {
+	return p.ReadConsistency != nil
+}
+
+func (p *ClusterQueryOptions) IsSetConflictResolutionStrategy() bool {
+	return p.ConflictResolutionStrategy != nil
+}
+
+func (p *ClusterQueryOptions) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -13646,8 +14859,19 @@ func (p *AllQuery) Read(iprot thrift.TProtocol) error {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err := iprot.Skip(fieldTypeId); err != nil {
-			return err
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
 		}
 		if err := iprot.ReadFieldEnd(); err != nil {
 			return err
@@ -13659,11 +14883,37 @@ func (p *AllQuery) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AllQuery) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("AllQuery"); err != nil {
+func (p *ClusterQueryOptions) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := ReadConsistency(v)
+		p.ReadConsistency = &temp
+	}
+	return nil
+}
+
+func (p *ClusterQueryOptions) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := EqualTimestampStrategy(v)
+		p.ConflictResolutionStrategy = &temp
+	}
+	return nil
+}
+
+func (p *ClusterQueryOptions) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ClusterQueryOptions"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -13674,32 +14924,69 @@ func (p *AllQuery) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AllQuery) String() string {
+func (p *ClusterQueryOptions) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReadConsistency() {
+		if err := oprot.WriteFieldBegin("readConsistency", thrift.I32, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:readConsistency: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.ReadConsistency)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.readConsistency (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:readConsistency: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ClusterQueryOptions) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConflictResolutionStrategy() {
+		if err := oprot.WriteFieldBegin("conflictResolutionStrategy", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:conflictResolutionStrategy: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.ConflictResolutionStrategy)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.conflictResolutionStrategy (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:conflictResolutionStrategy: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ClusterQueryOptions) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AllQuery(%+v)", *p)
+	return fmt.Sprintf("ClusterQueryOptions(%+v)", *p)
 }
 
 // Attributes:
-//  - Field
-type FieldQuery struct {
-	Field string `thrift:"field,1,required" db:"field" json:"field"`
+//  - Results
+//  - Exhaustive
+type QueryResult_ struct {
+	Results    []*QueryResultElement `thrift:"results,1,required" db:"results" json:"results"`
+	Exhaustive bool                  `thrift:"exhaustive,2,required" db:"exhaustive" json:"exhaustive"`
 }
 
-func NewFieldQuery() *FieldQuery {
-	return &FieldQuery{}
+func NewQueryResult_() *QueryResult_ {
+	return &QueryResult_{}
 }
 
-func (p *FieldQuery) GetField() string {
-	return p.Field
+func (p *QueryResult_) GetResults() []*QueryResultElement {
+	return p.Results
 }
-func (p *FieldQuery) Read(iprot thrift.TProtocol) error {
+
+func (p *QueryResult_) GetExhaustive() bool {
+	return p.Exhaustive
+}
+func (p *QueryResult_) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetField bool = false
+	var issetResults bool = false
+	var issetExhaustive bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -13714,7 +15001,12 @@ func (p *FieldQuery) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetField = true
+			issetResults = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+			issetExhaustive = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -13727,29 +15019,55 @@ func (p *FieldQuery) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetField {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Field is not set"))
+	if !issetResults {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Results is not set"))
+	}
+	if !issetExhaustive {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Exhaustive is not set"))
 	}
 	return nil
 }
 
-func (p *FieldQuery) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.Field = v
+func (p *QueryResult_) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*QueryResultElement, 0, size)
+	p.Results = tSlice
+	for i := 0; i < size; i++ {
+		_elem30 := &QueryResultElement{}
+		if err := _elem30.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem30), err)
+		}
+		p.Results = append(p.Results, _elem30)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *FieldQuery) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("FieldQuery"); err != nil {
+func (p *QueryResult_) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Exhaustive = v
+	}
+	return nil
+}
+
+func (p *QueryResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("QueryResult"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -13760,143 +15078,81 @@ func (p *FieldQuery) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *FieldQuery) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("field", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:field: ", p), err)
-	}
-	if err := oprot.WriteString(string(p.Field)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.field (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:field: ", p), err)
+func (p *QueryResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("results", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:results: ", p), err)
 	}
-	return err
-}
-
-func (p *FieldQuery) String() string {
-	if p == nil {
-		return "<nil>"
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Results)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
 	}
-	return fmt.Sprintf("FieldQuery(%+v)", *p)
-}
-
-// Attributes:
-//  - Term
-//  - Regexp
-//  - Negation
-//  - Conjunction
-//  - Disjunction
-//  - All
-//  - Field
-type Query struct {
-	Term        *TermQuery        `thrift:"term,1" db:"term" json:"term,omitempty"`
-	Regexp      *RegexpQuery      `thrift:"regexp,2" db:"regexp" json:"regexp,omitempty"`
-	Negation    *NegationQuery    `thrift:"negation,3" db:"negation" json:"negation,omitempty"`
-	Conjunction *ConjunctionQuery `thrift:"conjunction,4" db:"conjunction" json:"conjunction,omitempty"`
-	Disjunction *DisjunctionQuery `thrift:"disjunction,5" db:"disjunction" json:"disjunction,omitempty"`
-	All         *AllQuery         `thrift:"all,6" db:"all" json:"all,omitempty"`
-	Field       *FieldQuery       `thrift:"field,7" db:"field" json:"field,omitempty"`
-}
-
-func NewQuery() *Query {
-	return &Query{}
-}
-
-var Query_Term_DEFAULT *TermQuery
-
-func (p *Query) GetTerm() *TermQuery {
-	if !p.IsSetTerm() {
-		return Query_Term_DEFAULT
+	for _, v := range p.Results {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
 	}
-	return p.Term
-}
-
-var Query_Regexp_DEFAULT *RegexpQuery
-
-func (p *Query) GetRegexp() *RegexpQuery {
-	if !p.IsSetRegexp() {
-		return Query_Regexp_DEFAULT
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
 	}
-	return p.Regexp
-}
-
-var Query_Negation_DEFAULT *NegationQuery
-
-func (p *Query) GetNegation() *NegationQuery {
-	if !p.IsSetNegation() {
-		return Query_Negation_DEFAULT
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:results: ", p), err)
 	}
-	return p.Negation
+	return err
 }
 
-var Query_Conjunction_DEFAULT *ConjunctionQuery
-
-func (p *Query) GetConjunction() *ConjunctionQuery {
-	if !p.IsSetConjunction() {
-		return Query_Conjunction_DEFAULT
+func (p *QueryResult_) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("exhaustive", thrift.BOOL, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:exhaustive: ", p), err)
 	}
-	return p.Conjunction
-}
-
-var Query_Disjunction_DEFAULT *DisjunctionQuery
-
-func (p *Query) GetDisjunction() *DisjunctionQuery {
-	if !p.IsSetDisjunction() {
-		return Query_Disjunction_DEFAULT
+	if err := oprot.WriteBool(bool(p.Exhaustive)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.exhaustive (2) field write error: ", p), err)
 	}
-	return p.Disjunction
-}
-
-var Query_All_DEFAULT *AllQuery
-
-func (p *Query) GetAll() *AllQuery {
-	if !p.IsSetAll() {
-		return Query_All_DEFAULT
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:exhaustive: ", p), err)
 	}
-	return p.All
+	return err
 }
 
-var Query_Field_DEFAULT *FieldQuery
-
-func (p *Query) GetField() *FieldQuery {
-	if !p.IsSetField() {
-		return Query_Field_DEFAULT
+func (p *QueryResult_) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.Field
-}
-func (p *Query) IsSetTerm() bool {
-	return p.Term != nil
-}
-
-func (p *Query) IsSetRegexp() bool {
-	return p.Regexp != nil
+	return fmt.Sprintf("QueryResult_(%+v)", *p)
 }
 
-func (p *Query) IsSetNegation() bool {
-	return p.Negation != nil
+// Attributes:
+//  - ID
+//  - Tags
+//  - Datapoints
+type QueryResultElement struct {
+	ID         string       `thrift:"id,1,required" db:"id" json:"id"`
+	Tags       []*Tag       `thrift:"tags,2,required" db:"tags" json:"tags"`
+	Datapoints []*Datapoint `thrift:"datapoints,3,required" db:"datapoints" json:"datapoints"`
 }
 
-func (p *Query) IsSetConjunction() bool {
-	return p.Conjunction != nil
+func NewQueryResultElement() *QueryResultElement {
+	return &QueryResultElement{}
 }
 
-func (p *Query) IsSetDisjunction() bool {
-	return p.Disjunction != nil
+func (p *QueryResultElement) GetID() string {
+	return p.ID
 }
 
-func (p *Query) IsSetAll() bool {
-	return p.All != nil
+func (p *QueryResultElement) GetTags() []*Tag {
+	return p.Tags
 }
 
-func (p *Query) IsSetField() bool {
-	return p.Field != nil
+func (p *QueryResultElement) GetDatapoints() []*Datapoint {
+	return p.Datapoints
 }
-
-func (p *Query) Read(iprot thrift.TProtocol) error {
+func (p *QueryResultElement) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
+	var issetID bool = false
+	var issetTags bool = false
+	var issetDatapoints bool = false
+
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -13910,30 +15166,17 @@ func (p *Query) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
+			issetID = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
+			issetTags = true
 		case 3:
 			if err := p.ReadField3(iprot); err != nil {
 				return err
 			}
-		case 4:
-			if err := p.ReadField4(iprot); err != nil {
-				return err
-			}
-		case 5:
-			if err := p.ReadField5(iprot); err != nil {
-				return err
-			}
-		case 6:
-			if err := p.ReadField6(iprot); err != nil {
-				return err
-			}
-		case 7:
-			if err := p.ReadField7(iprot); err != nil {
-				return err
-			}
+			issetDatapoints = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -13946,67 +15189,71 @@ func (p *Query) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	return nil
-}
-
-func (p *Query) ReadField1(iprot thrift.TProtocol) error {
-	p.Term = &TermQuery{}
-	if err := p.Term.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Term), err)
+	if !issetID {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
 	}
-	return nil
-}
-
-func (p *Query) ReadField2(iprot thrift.TProtocol) error {
-	p.Regexp = &RegexpQuery{}
-	if err := p.Regexp.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Regexp), err)
+	if !issetTags {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Tags is not set"))
 	}
-	return nil
-}
-
-func (p *Query) ReadField3(iprot thrift.TProtocol) error {
-	p.Negation = &NegationQuery{}
-	if err := p.Negation.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Negation), err)
+	if !issetDatapoints {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Datapoints is not set"))
 	}
 	return nil
 }
 
-func (p *Query) ReadField4(iprot thrift.TProtocol) error {
-	p.Conjunction = &ConjunctionQuery{}
-	if err := p.Conjunction.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Conjunction), err)
+func (p *QueryResultElement) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
 	}
 	return nil
 }
 
-func (p *Query) ReadField5(iprot thrift.TProtocol) error {
-	p.Disjunction = &DisjunctionQuery{}
-	if err := p.Disjunction.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Disjunction), err)
+func (p *QueryResultElement) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	return nil
-}
-
-func (p *Query) ReadField6(iprot thrift.TProtocol) error {
-	p.All = &AllQuery{}
-	if err := p.All.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.All), err)
+	tSlice := make([]*Tag, 0, size)
+	p.Tags = tSlice
+	for i := 0; i < size; i++ {
+		_elem31 := &Tag{}
+		if err := _elem31.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem31), err)
+		}
+		p.Tags = append(p.Tags, _elem31)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *Query) ReadField7(iprot thrift.TProtocol) error {
-	p.Field = &FieldQuery{}
-	if err := p.Field.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Field), err)
+func (p *QueryResultElement) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*Datapoint, 0, size)
+	p.Datapoints = tSlice
+	for i := 0; i < size; i++ {
+		_elem32 := &Datapoint{
+			TimestampTimeType: 0,
+		}
+		if err := _elem32.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem32), err)
+		}
+		p.Datapoints = append(p.Datapoints, _elem32)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *Query) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("Query"); err != nil {
+func (p *QueryResultElement) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("QueryResultElement"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -14019,18 +15266,6 @@ func (p *Query) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField3(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField4(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField5(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField6(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField7(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -14041,179 +15276,94 @@ func (p *Query) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *Query) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTerm() {
-		if err := oprot.WriteFieldBegin("term", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:term: ", p), err)
-		}
-		if err := p.Term.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Term), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:term: ", p), err)
-		}
+func (p *QueryResultElement) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
 	}
-	return err
-}
-
-func (p *Query) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRegexp() {
-		if err := oprot.WriteFieldBegin("regexp", thrift.STRUCT, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:regexp: ", p), err)
-		}
-		if err := p.Regexp.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Regexp), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:regexp: ", p), err)
-		}
+	if err := oprot.WriteString(string(p.ID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
 	}
-	return err
-}
-
-func (p *Query) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetNegation() {
-		if err := oprot.WriteFieldBegin("negation", thrift.STRUCT, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:negation: ", p), err)
-		}
-		if err := p.Negation.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Negation), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:negation: ", p), err)
-		}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
 	}
 	return err
 }
 
-func (p *Query) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetConjunction() {
-		if err := oprot.WriteFieldBegin("conjunction", thrift.STRUCT, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:conjunction: ", p), err)
-		}
-		if err := p.Conjunction.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Conjunction), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:conjunction: ", p), err)
-		}
+func (p *QueryResultElement) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("tags", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:tags: ", p), err)
 	}
-	return err
-}
-
-func (p *Query) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDisjunction() {
-		if err := oprot.WriteFieldBegin("disjunction", thrift.STRUCT, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:disjunction: ", p), err)
-		}
-		if err := p.Disjunction.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Disjunction), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:disjunction: ", p), err)
-		}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Tags)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
 	}
-	return err
-}
-
-func (p *Query) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAll() {
-		if err := oprot.WriteFieldBegin("all", thrift.STRUCT, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:all: ", p), err)
-		}
-		if err := p.All.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.All), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:all: ", p), err)
+	for _, v := range p.Tags {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 		}
 	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:tags: ", p), err)
+	}
 	return err
 }
 
-func (p *Query) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetField() {
-		if err := oprot.WriteFieldBegin("field", thrift.STRUCT, 7); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:field: ", p), err)
-		}
-		if err := p.Field.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Field), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:field: ", p), err)
+func (p *QueryResultElement) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("datapoints", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:datapoints: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Datapoints)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Datapoints {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 		}
 	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:datapoints: ", p), err)
+	}
 	return err
 }
 
-func (p *Query) String() string {
+func (p *QueryResultElement) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Query(%+v)", *p)
+	return fmt.Sprintf("QueryResultElement(%+v)", *p)
 }
 
 // Attributes:
-//  - SourceNamespace
-//  - TargetNamespace
-//  - RangeStart
-//  - RangeEnd
-//  - Step
-//  - RangeType
-type AggregateTilesRequest struct {
-	SourceNamespace string   `thrift:"sourceNamespace,1,required" db:"sourceNamespace" json:"sourceNamespace"`
-	TargetNamespace string   `thrift:"targetNamespace,2,required" db:"targetNamespace" json:"targetNamespace"`
-	RangeStart      int64    `thrift:"rangeStart,3,required" db:"rangeStart" json:"rangeStart"`
-	RangeEnd        int64    `thrift:"rangeEnd,4,required" db:"rangeEnd" json:"rangeEnd"`
-	Step            string   `thrift:"step,5,required" db:"step" json:"step"`
-	RangeType       TimeType `thrift:"rangeType,6" db:"rangeType" json:"rangeType,omitempty"`
-}
-
-func NewAggregateTilesRequest() *AggregateTilesRequest {
-	return &AggregateTilesRequest{
-		RangeType: 0,
-	}
-}
-
-func (p *AggregateTilesRequest) GetSourceNamespace() string {
-	return p.SourceNamespace
-}
-
-func (p *AggregateTilesRequest) GetTargetNamespace() string {
-	return p.TargetNamespace
-}
-
-func (p *AggregateTilesRequest) GetRangeStart() int64 {
-	return p.RangeStart
+//  - Field
+//  - Term
+type TermQuery struct {
+	Field string `thrift:"field,1,required" db:"field" json:"field"`
+	Term  string `thrift:"term,2,required" db:"term" json:"term"`
 }
 
-func (p *AggregateTilesRequest) GetRangeEnd() int64 {
-	return p.RangeEnd
+func NewTermQuery() *TermQuery {
+	return &TermQuery{}
 }
 
-func (p *AggregateTilesRequest) GetStep() string {
-	return p.Step
+func (p *TermQuery) GetField() string {
+	return p.Field
 }
 
-var AggregateTilesRequest_RangeType_DEFAULT TimeType = 0
-
-func (p *AggregateTilesRequest) GetRangeType() TimeType {
-	return p.RangeType
-}
-func (p *AggregateTilesRequest) IsSetRangeType() bool {
-	return p.RangeType != AggregateTilesRequest_RangeType_DEFAULT
+func (p *TermQuery) GetTerm() string {
+	return p.Term
 }
-
-func (p *AggregateTilesRequest) Read(iprot thrift.TProtocol) error {
+func (p *TermQuery) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetSourceNamespace bool = false
-	var issetTargetNamespace bool = false
-	var issetRangeStart bool = false
-	var issetRangeEnd bool = false
-	var issetStep bool = false
+	var issetField bool = false
+	var issetTerm bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -14228,31 +15378,12 @@ func (p *AggregateTilesRequest) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetSourceNamespace = true
+			issetField = true
 		case 2:
 			if err := p.ReadField2(iprot); err != nil {
 				return err
 			}
-			issetTargetNamespace = true
-		case 3:
-			if err := p.ReadField3(iprot); err != nil {
-				return err
-			}
-			issetRangeStart = true
-		case 4:
-			if err := p.ReadField4(iprot); err != nil {
-				return err
-			}
-			issetRangeEnd = true
-		case 5:
-			if err := p.ReadField5(iprot); err != nil {
-				return err
-			}
-			issetStep = true
-		case 6:
-			if err := p.ReadField6(iprot); err != nil {
-				return err
-			}
+			issetTerm = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -14265,81 +15396,35 @@ func (p *AggregateTilesRequest) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetSourceNamespace {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field SourceNamespace is not set"))
-	}
-	if !issetTargetNamespace {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TargetNamespace is not set"))
+	if !issetField {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Field is not set"))
 	}
-	if !issetRangeStart {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RangeStart is not set"))
-	}
-	if !issetRangeEnd {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RangeEnd is not set"))
-	}
-	if !issetStep {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Step is not set"))
+	if !issetTerm {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Term is not set"))
 	}
 	return nil
 }
 
-func (p *AggregateTilesRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TermQuery) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.SourceNamespace = v
+		p.Field = v
 	}
 	return nil
 }
 
-func (p *AggregateTilesRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TermQuery) ReadField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.TargetNamespace = v
-	}
-	return nil
-}
-
-func (p *AggregateTilesRequest) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.RangeStart = v
-	}
-	return nil
-}
-
-func (p *AggregateTilesRequest) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		p.RangeEnd = v
-	}
-	return nil
-}
-
-func (p *AggregateTilesRequest) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 5: ", err)
-	} else {
-		p.Step = v
-	}
-	return nil
-}
-
-func (p *AggregateTilesRequest) ReadField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
-	} else {
-		temp := TimeType(v)
-		p.RangeType = temp
+		p.Term = v
 	}
 	return nil
 }
 
-func (p *AggregateTilesRequest) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("AggregateTilesRequest"); err != nil {
+func (p *TermQuery) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("TermQuery"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -14349,18 +15434,6 @@ func (p *AggregateTilesRequest) Write(oprot thrift.TProtocol) error {
 		if err := p.writeField2(oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField4(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField5(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField6(oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -14371,112 +15444,65 @@ func (p *AggregateTilesRequest) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AggregateTilesRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("sourceNamespace", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:sourceNamespace: ", p), err)
-	}
-	if err := oprot.WriteString(string(p.SourceNamespace)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.sourceNamespace (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:sourceNamespace: ", p), err)
-	}
-	return err
-}
-
-func (p *AggregateTilesRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("targetNamespace", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:targetNamespace: ", p), err)
-	}
-	if err := oprot.WriteString(string(p.TargetNamespace)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.targetNamespace (2) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:targetNamespace: ", p), err)
-	}
-	return err
-}
-
-func (p *AggregateTilesRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("rangeStart", thrift.I64, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:rangeStart: ", p), err)
-	}
-	if err := oprot.WriteI64(int64(p.RangeStart)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.rangeStart (3) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:rangeStart: ", p), err)
-	}
-	return err
-}
-
-func (p *AggregateTilesRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("rangeEnd", thrift.I64, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:rangeEnd: ", p), err)
+func (p *TermQuery) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("field", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:field: ", p), err)
 	}
-	if err := oprot.WriteI64(int64(p.RangeEnd)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.rangeEnd (4) field write error: ", p), err)
+	if err := oprot.WriteString(string(p.Field)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.field (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:rangeEnd: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:field: ", p), err)
 	}
 	return err
 }
 
-func (p *AggregateTilesRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("step", thrift.STRING, 5); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:step: ", p), err)
+func (p *TermQuery) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("term", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:term: ", p), err)
 	}
-	if err := oprot.WriteString(string(p.Step)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.step (5) field write error: ", p), err)
+	if err := oprot.WriteString(string(p.Term)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.term (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:step: ", p), err)
-	}
-	return err
-}
-
-func (p *AggregateTilesRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRangeType() {
-		if err := oprot.WriteFieldBegin("rangeType", thrift.I32, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:rangeType: ", p), err)
-		}
-		if err := oprot.WriteI32(int32(p.RangeType)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.rangeType (6) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:rangeType: ", p), err)
-		}
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:term: ", p), err)
 	}
 	return err
 }
 
-func (p *AggregateTilesRequest) String() string {
+func (p *TermQuery) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AggregateTilesRequest(%+v)", *p)
+	return fmt.Sprintf("TermQuery(%+v)", *p)
 }
 
 // Attributes:
-//  - ProcessedTileCount
-type AggregateTilesResult_ struct {
-	ProcessedTileCount int64 `thrift:"processedTileCount,1,required" db:"processedTileCount" json:"processedTileCount"`
+//  - Field
+//  - Regexp
+type RegexpQuery struct {
+	Field  string `thrift:"field,1,required" db:"field" json:"field"`
+	Regexp string `thrift:"regexp,2,required" db:"regexp" json:"regexp"`
 }
 
-func NewAggregateTilesResult_() *AggregateTilesResult_ {
-	return &AggregateTilesResult_{}
+func NewRegexpQuery() *RegexpQuery {
+	return &RegexpQuery{}
 }
 
-func (p *AggregateTilesResult_) GetProcessedTileCount() int64 {
-	return p.ProcessedTileCount
+func (p *RegexpQuery) GetField() string {
+	return p.Field
 }
-func (p *AggregateTilesResult_) Read(iprot thrift.TProtocol) error {
+
+func (p *RegexpQuery) GetRegexp() string {
+	return p.Regexp
+}
+func (p *RegexpQuery) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetProcessedTileCount bool = false
+	var issetField bool = false
+	var issetRegexp bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -14491,7 +15517,12 @@ func (p *AggregateTilesResult_) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetProcessedTileCount = true
+			issetField = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+			issetRegexp = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -14504,29 +15535,44 @@ func (p *AggregateTilesResult_) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetProcessedTileCount {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ProcessedTileCount is not set"))
+	if !issetField {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Field is not set"))
+	}
+	if !issetRegexp {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Regexp is not set"))
 	}
 	return nil
 }
 
-func (p *AggregateTilesResult_) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *RegexpQuery) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.ProcessedTileCount = v
+		p.Field = v
 	}
 	return nil
 }
 
-func (p *AggregateTilesResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("AggregateTilesResult"); err != nil {
+func (p *RegexpQuery) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Regexp = v
+	}
+	return nil
+}
+
+func (p *RegexpQuery) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("RegexpQuery"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -14537,142 +15583,165 @@ func (p *AggregateTilesResult_) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AggregateTilesResult_) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("processedTileCount", thrift.I64, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:processedTileCount: ", p), err)
+func (p *RegexpQuery) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("field", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:field: ", p), err)
 	}
-	if err := oprot.WriteI64(int64(p.ProcessedTileCount)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.processedTileCount (1) field write error: ", p), err)
+	if err := oprot.WriteString(string(p.Field)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.field (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:processedTileCount: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:field: ", p), err)
 	}
 	return err
 }
 
-func (p *AggregateTilesResult_) String() string {
+func (p *RegexpQuery) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("regexp", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:regexp: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.Regexp)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.regexp (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:regexp: ", p), err)
+	}
+	return err
+}
+
+func (p *RegexpQuery) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AggregateTilesResult_(%+v)", *p)
+	return fmt.Sprintf("RegexpQuery(%+v)", *p)
 }
 
 // Attributes:
-//  - Name
-//  - FilePathTemplate
-//  - Interval
-//  - Duration
-//  - Debug
-//  - ConditionalNumGoroutinesGreaterThan
-//  - ConditionalNumGoroutinesLessThan
-//  - ConditionalIsOverloaded
-type DebugProfileStartRequest struct {
-	Name                                string  `thrift:"name,1,required" db:"name" json:"name"`
-	FilePathTemplate                    string  `thrift:"filePathTemplate,2,required" db:"filePathTemplate" json:"filePathTemplate"`
-	Interval                            *string `thrift:"interval,3" db:"interval" json:"interval,omitempty"`
-	Duration                            *string `thrift:"duration,4" db:"duration" json:"duration,omitempty"`
-	Debug                               *int64  `thrift:"debug,5" db:"debug" json:"debug,omitempty"`
-	ConditionalNumGoroutinesGreaterThan *int64  `thrift:"conditionalNumGoroutinesGreaterThan,6" db:"conditionalNumGoroutinesGreaterThan" json:"conditionalNumGoroutinesGreaterThan,omitempty"`
-	ConditionalNumGoroutinesLessThan    *int64  `thrift:"conditionalNumGoroutinesLessThan,7" db:"conditionalNumGoroutinesLessThan" json:"conditionalNumGoroutinesLessThan,omitempty"`
-	ConditionalIsOverloaded             *bool   `thrift:"conditionalIsOverloaded,8" db:"conditionalIsOverloaded" json:"conditionalIsOverloaded,omitempty"`
-}
-
-func NewDebugProfileStartRequest() *DebugProfileStartRequest {
-	return &DebugProfileStartRequest{}
-}
-
-func (p *DebugProfileStartRequest) GetName() string {
-	return p.Name
+//  - Query
+type NegationQuery struct {
+	Query *Query `thrift:"query,1,required" db:"query" json:"query"`
 }
 
-func (p *DebugProfileStartRequest) GetFilePathTemplate() string {
-	return p.FilePathTemplate
+func NewNegationQuery() *NegationQuery {
+	return &NegationQuery{}
 }
 
-var DebugProfileStartRequest_Interval_DEFAULT string
+var NegationQuery_Query_DEFAULT *Query
 
-func (p *DebugProfileStartRequest) GetInterval() string {
-	if !p.IsSetInterval() {
-		return DebugProfileStartRequest_Interval_DEFAULT
+func (p *NegationQuery) GetQuery() *Query {
+	if !p.IsSetQuery() {
+		return NegationQuery_Query_DEFAULT
 	}
-	return *p.Interval
+	return p.Query
 }
-
-var DebugProfileStartRequest_Duration_DEFAULT string
-
-func (p *DebugProfileStartRequest) GetDuration() string {
-	if !p.IsSetDuration() {
-		return DebugProfileStartRequest_Duration_DEFAULT
-	}
-	return *p.Duration
+func (p *NegationQuery) IsSetQuery() bool {
+	return p.Query != nil
 }
 
-var DebugProfileStartRequest_Debug_DEFAULT int64
-
-func (p *DebugProfileStartRequest) GetDebug() int64 {
-	if !p.IsSetDebug() {
-		return DebugProfileStartRequest_Debug_DEFAULT
+func (p *NegationQuery) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	return *p.Debug
-}
 
-var DebugProfileStartRequest_ConditionalNumGoroutinesGreaterThan_DEFAULT int64
+	var issetQuery bool = false
 
-func (p *DebugProfileStartRequest) GetConditionalNumGoroutinesGreaterThan() int64 {
-	if !p.IsSetConditionalNumGoroutinesGreaterThan() {
-		return DebugProfileStartRequest_ConditionalNumGoroutinesGreaterThan_DEFAULT
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetQuery = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
 	}
-	return *p.ConditionalNumGoroutinesGreaterThan
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetQuery {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Query is not set"))
+	}
+	return nil
 }
 
-var DebugProfileStartRequest_ConditionalNumGoroutinesLessThan_DEFAULT int64
-
-func (p *DebugProfileStartRequest) GetConditionalNumGoroutinesLessThan() int64 {
-	if !p.IsSetConditionalNumGoroutinesLessThan() {
-		return DebugProfileStartRequest_ConditionalNumGoroutinesLessThan_DEFAULT
+func (p *NegationQuery) ReadField1(iprot thrift.TProtocol) error {
+	p.Query = &Query{}
+	if err := p.Query.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Query), err)
 	}
-	return *p.ConditionalNumGoroutinesLessThan
+	return nil
 }
 
-var DebugProfileStartRequest_ConditionalIsOverloaded_DEFAULT bool
-
-func (p *DebugProfileStartRequest) GetConditionalIsOverloaded() bool {
-	if !p.IsSetConditionalIsOverloaded() {
-		return DebugProfileStartRequest_ConditionalIsOverloaded_DEFAULT
+func (p *NegationQuery) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("NegationQuery"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	return *p.ConditionalIsOverloaded
-}
-func (p *DebugProfileStartRequest) IsSetInterval() bool {
-	return p.Interval != nil
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-func (p *DebugProfileStartRequest) IsSetDuration() bool {
-	return p.Duration != nil
+func (p *NegationQuery) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("query", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:query: ", p), err)
+	}
+	if err := p.Query.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Query), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:query: ", p), err)
+	}
+	return err
 }
 
-func (p *DebugProfileStartRequest) IsSetDebug() bool {
-	return p.Debug != nil
+func (p *NegationQuery) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NegationQuery(%+v)", *p)
 }
 
-func (p *DebugProfileStartRequest) IsSetConditionalNumGoroutinesGreaterThan() bool {
-	return p.ConditionalNumGoroutinesGreaterThan != nil
+// Attributes:
+//  - Queries
+type ConjunctionQuery struct {
+	Queries []*Query `thrift:"queries,1,required" db:"queries" json:"queries"`
 }
 
-func (p *DebugProfileStartRequest) IsSetConditionalNumGoroutinesLessThan() bool {
-	return p.ConditionalNumGoroutinesLessThan != nil
+func NewConjunctionQuery() *ConjunctionQuery {
+	return &ConjunctionQuery{}
 }
 
-func (p *DebugProfileStartRequest) IsSetConditionalIsOverloaded() bool {
-	return p.ConditionalIsOverloaded != nil
+func (p *ConjunctionQuery) GetQueries() []*Query {
+	return p.Queries
 }
-
-func (p *DebugProfileStartRequest) Read(iprot thrift.TProtocol) error {
+func (p *ConjunctionQuery) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetName bool = false
-	var issetFilePathTemplate bool = false
+	var issetQueries bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -14687,36 +15756,7 @@ func (p *DebugProfileStartRequest) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetName = true
-		case 2:
-			if err := p.ReadField2(iprot); err != nil {
-				return err
-			}
-			issetFilePathTemplate = true
-		case 3:
-			if err := p.ReadField3(iprot); err != nil {
-				return err
-			}
-		case 4:
-			if err := p.ReadField4(iprot); err != nil {
-				return err
-			}
-		case 5:
-			if err := p.ReadField5(iprot); err != nil {
-				return err
-			}
-		case 6:
-			if err := p.ReadField6(iprot); err != nil {
-				return err
-			}
-		case 7:
-			if err := p.ReadField7(iprot); err != nil {
-				return err
-			}
-		case 8:
-			if err := p.ReadField8(iprot); err != nil {
-				return err
-			}
+			issetQueries = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -14729,257 +15769,204 @@ func (p *DebugProfileStartRequest) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetName {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Name is not set"))
-	}
-	if !issetFilePathTemplate {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field FilePathTemplate is not set"))
+	if !issetQueries {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Queries is not set"))
 	}
 	return nil
 }
 
-func (p *DebugProfileStartRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.Name = v
+func (p *ConjunctionQuery) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	return nil
-}
-
-func (p *DebugProfileStartRequest) ReadField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.FilePathTemplate = v
+	tSlice := make([]*Query, 0, size)
+	p.Queries = tSlice
+	for i := 0; i < size; i++ {
+		_elem33 := &Query{}
+		if err := _elem33.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem33), err)
+		}
+		p.Queries = append(p.Queries, _elem33)
 	}
-	return nil
-}
-
-func (p *DebugProfileStartRequest) ReadField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.Interval = &v
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *DebugProfileStartRequest) ReadField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		p.Duration = &v
+func (p *ConjunctionQuery) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ConjunctionQuery"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
 	return nil
 }
 
-func (p *DebugProfileStartRequest) ReadField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 5: ", err)
-	} else {
-		p.Debug = &v
+func (p *ConjunctionQuery) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("queries", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:queries: ", p), err)
 	}
-	return nil
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Queries)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Queries {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:queries: ", p), err)
+	}
+	return err
 }
 
-func (p *DebugProfileStartRequest) ReadField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
-	} else {
-		p.ConditionalNumGoroutinesGreaterThan = &v
+func (p *ConjunctionQuery) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
+	return fmt.Sprintf("ConjunctionQuery(%+v)", *p)
 }
 
-func (p *DebugProfileStartRequest) ReadField7(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 7: ", err)
-	} else {
-		p.ConditionalNumGoroutinesLessThan = &v
-	}
-	return nil
+// Attributes:
+//  - Queries
+type DisjunctionQuery struct {
+	Queries []*Query `thrift:"queries,1,required" db:"queries" json:"queries"`
 }
 
-func (p *DebugProfileStartRequest) ReadField8(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(); err != nil {
-		return thrift.PrependError("error reading field 8: ", err)
-	} else {
-		p.ConditionalIsOverloaded = &v
-	}
-	return nil
+func NewDisjunctionQuery() *DisjunctionQuery {
+	return &DisjunctionQuery{}
 }
 
-func (p *DebugProfileStartRequest) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("DebugProfileStartRequest"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+func (p *DisjunctionQuery) GetQueries() []*Query {
+	return p.Queries
+}
+func (p *DisjunctionQuery) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	if p != nil {
-		if err := p.writeField1(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField2(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField3(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField4(oprot); err != nil {
-			return err
-		}
-		if err := p.writeField5(oprot); err != nil {
-			return err
+
+	var issetQueries bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
 		}
-		if err := p.writeField6(oprot); err != nil {
-			return err
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		if err := p.writeField7(oprot); err != nil {
-			return err
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetQueries = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
 		}
-		if err := p.writeField8(oprot); err != nil {
+		if err := iprot.ReadFieldEnd(); err != nil {
 			return err
 		}
 	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
+	if !issetQueries {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Queries is not set"))
 	}
 	return nil
 }
 
-func (p *DebugProfileStartRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err)
+func (p *DisjunctionQuery) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	if err := oprot.WriteString(string(p.Name)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err)
+	tSlice := make([]*Query, 0, size)
+	p.Queries = tSlice
+	for i := 0; i < size; i++ {
+		_elem34 := &Query{}
+		if err := _elem34.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem34), err)
+		}
+		p.Queries = append(p.Queries, _elem34)
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err)
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
-	return err
+	return nil
 }
 
-func (p *DebugProfileStartRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("filePathTemplate", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:filePathTemplate: ", p), err)
+func (p *DisjunctionQuery) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DisjunctionQuery"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err := oprot.WriteString(string(p.FilePathTemplate)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.filePathTemplate (2) field write error: ", p), err)
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:filePathTemplate: ", p), err)
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	return err
-}
-
-func (p *DebugProfileStartRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetInterval() {
-		if err := oprot.WriteFieldBegin("interval", thrift.STRING, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:interval: ", p), err)
-		}
-		if err := oprot.WriteString(string(*p.Interval)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.interval (3) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:interval: ", p), err)
-		}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	return err
+	return nil
 }
 
-func (p *DebugProfileStartRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDuration() {
-		if err := oprot.WriteFieldBegin("duration", thrift.STRING, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:duration: ", p), err)
-		}
-		if err := oprot.WriteString(string(*p.Duration)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.duration (4) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:duration: ", p), err)
-		}
+func (p *DisjunctionQuery) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("queries", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:queries: ", p), err)
 	}
-	return err
-}
-
-func (p *DebugProfileStartRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDebug() {
-		if err := oprot.WriteFieldBegin("debug", thrift.I64, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:debug: ", p), err)
-		}
-		if err := oprot.WriteI64(int64(*p.Debug)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.debug (5) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:debug: ", p), err)
-		}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Queries)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
 	}
-	return err
-}
-
-func (p *DebugProfileStartRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetConditionalNumGoroutinesGreaterThan() {
-		if err := oprot.WriteFieldBegin("conditionalNumGoroutinesGreaterThan", thrift.I64, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:conditionalNumGoroutinesGreaterThan: ", p), err)
-		}
-		if err := oprot.WriteI64(int64(*p.ConditionalNumGoroutinesGreaterThan)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.conditionalNumGoroutinesGreaterThan (6) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:conditionalNumGoroutinesGreaterThan: ", p), err)
+	for _, v := range p.Queries {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 		}
 	}
-	return err
-}
-
-func (p *DebugProfileStartRequest) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetConditionalNumGoroutinesLessThan() {
-		if err := oprot.WriteFieldBegin("conditionalNumGoroutinesLessThan", thrift.I64, 7); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:conditionalNumGoroutinesLessThan: ", p), err)
-		}
-		if err := oprot.WriteI64(int64(*p.ConditionalNumGoroutinesLessThan)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.conditionalNumGoroutinesLessThan (7) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:conditionalNumGoroutinesLessThan: ", p), err)
-		}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
 	}
-	return err
-}
-
-func (p *DebugProfileStartRequest) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetConditionalIsOverloaded() {
-		if err := oprot.WriteFieldBegin("conditionalIsOverloaded", thrift.BOOL, 8); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:conditionalIsOverloaded: ", p), err)
-		}
-		if err := oprot.WriteBool(bool(*p.ConditionalIsOverloaded)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.conditionalIsOverloaded (8) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:conditionalIsOverloaded: ", p), err)
-		}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:queries: ", p), err)
 	}
 	return err
 }
 
-func (p *DebugProfileStartRequest) String() string {
+func (p *DisjunctionQuery) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DebugProfileStartRequest(%+v)", *p)
+	return fmt.Sprintf("DisjunctionQuery(%+v)", *p)
 }
 
-type DebugProfileStartResult_ struct {
+type AllQuery struct {
 }
 
-func NewDebugProfileStartResult_() *DebugProfileStartResult_ {
-	return &DebugProfileStartResult_{}
+func NewAllQuery() *AllQuery {
+	return &AllQuery{}
 }
 
-func (p *DebugProfileStartResult_) Read(iprot thrift.TProtocol) error {
+func (p *AllQuery) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -15005,9 +15992,9 @@ func (p *DebugProfileStartResult_) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DebugProfileStartResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("DebugProfileStartResult"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+func (p *AllQuery) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("AllQuery"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 	}
@@ -15020,32 +16007,32 @@ func (p *DebugProfileStartResult_) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DebugProfileStartResult_) String() string {
+func (p *AllQuery) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DebugProfileStartResult_(%+v)", *p)
+	return fmt.Sprintf("AllQuery(%+v)", *p)
 }
 
 // Attributes:
-//  - Name
-type DebugProfileStopRequest struct {
-	Name string `thrift:"name,1,required" db:"name" json:"name"`
+//  - Field
+type FieldQuery struct {
+	Field string `thrift:"field,1,required" db:"field" json:"field"`
 }
 
-func NewDebugProfileStopRequest() *DebugProfileStopRequest {
-	return &DebugProfileStopRequest{}
+func NewFieldQuery() *FieldQuery {
+	return &FieldQuery{}
 }
 
-func (p *DebugProfileStopRequest) GetName() string {
-	return p.Name
+func (p *FieldQuery) GetField() string {
+	return p.Field
 }
-func (p *DebugProfileStopRequest) Read(iprot thrift.TProtocol) error {
+func (p *FieldQuery) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetName bool = false
+	var issetField bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -15060,7 +16047,7 @@ func (p *DebugProfileStopRequest) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetName = true
+			issetField = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -15073,23 +16060,23 @@ func (p *DebugProfileStopRequest) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetName {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Name is not set"))
+	if !issetField {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Field is not set"))
 	}
 	return nil
 }
 
-func (p *DebugProfileStopRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *FieldQuery) ReadField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.Name = v
+		p.Field = v
 	}
 	return nil
 }
 
-func (p *DebugProfileStopRequest) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("DebugProfileStopRequest"); err != nil {
+func (p *FieldQuery) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("FieldQuery"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -15106,101 +16093,143 @@ func (p *DebugProfileStopRequest) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DebugProfileStopRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err)
+func (p *FieldQuery) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("field", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:field: ", p), err)
 	}
-	if err := oprot.WriteString(string(p.Name)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err)
+	if err := oprot.WriteString(string(p.Field)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.field (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:field: ", p), err)
 	}
 	return err
 }
 
-func (p *DebugProfileStopRequest) String() string {
+func (p *FieldQuery) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DebugProfileStopRequest(%+v)", *p)
+	return fmt.Sprintf("FieldQuery(%+v)", *p)
 }
 
-type DebugProfileStopResult_ struct {
+// Attributes:
+//  - Term
+//  - Regexp
+//  - Negation
+//  - Conjunction
+//  - Disjunction
+//  - All
+//  - Field
+type Query struct {
+	Term        *TermQuery        `thrift:"term,1" db:"term" json:"term,omitempty"`
+	Regexp      *RegexpQuery      `thrift:"regexp,2" db:"regexp" json:"regexp,omitempty"`
+	Negation    *NegationQuery    `thrift:"negation,3" db:"negation" json:"negation,omitempty"`
+	Conjunction *ConjunctionQuery `thrift:"conjunction,4" db:"conjunction" json:"conjunction,omitempty"`
+	Disjunction *DisjunctionQuery `thrift:"disjunction,5" db:"disjunction" json:"disjunction,omitempty"`
+	All         *AllQuery         `thrift:"all,6" db:"all" json:"all,omitempty"`
+	Field       *FieldQuery       `thrift:"field,7" db:"field" json:"field,omitempty"`
 }
 
-func NewDebugProfileStopResult_() *DebugProfileStopResult_ {
-	return &DebugProfileStopResult_{}
+func NewQuery() *Query {
+	return &Query{}
 }
 
-func (p *DebugProfileStopResult_) Read(iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-	}
+var Query_Term_DEFAULT *TermQuery
 
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		if err := iprot.Skip(fieldTypeId); err != nil {
-			return err
-		}
-		if err := iprot.ReadFieldEnd(); err != nil {
-			return err
-		}
+func (p *Query) GetTerm() *TermQuery {
+	if !p.IsSetTerm() {
+		return Query_Term_DEFAULT
 	}
-	if err := iprot.ReadStructEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	return p.Term
+}
+
+var Query_Regexp_DEFAULT *RegexpQuery
+
+func (p *Query) GetRegexp() *RegexpQuery {
+	if !p.IsSetRegexp() {
+		return Query_Regexp_DEFAULT
 	}
-	return nil
+	return p.Regexp
 }
 
-func (p *DebugProfileStopResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("DebugProfileStopResult"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+var Query_Negation_DEFAULT *NegationQuery
+
+func (p *Query) GetNegation() *NegationQuery {
+	if !p.IsSetNegation() {
+		return Query_Negation_DEFAULT
 	}
-	if p != nil {
+	return p.Negation
+}
+
+var Query_Conjunction_DEFAULT *ConjunctionQuery
+
+func (p *Query) GetConjunction() *ConjunctionQuery {
+	if !p.IsSetConjunction() {
+		return Query_Conjunction_DEFAULT
 	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
+	return p.Conjunction
+}
+
+var Query_Disjunction_DEFAULT *DisjunctionQuery
+
+func (p *Query) GetDisjunction() *DisjunctionQuery {
+	if !p.IsSetDisjunction() {
+		return Query_Disjunction_DEFAULT
 	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
+	return p.Disjunction
+}
+
+var Query_All_DEFAULT *AllQuery
+
+func (p *Query) GetAll() *AllQuery {
+	if !p.IsSetAll() {
+		return Query_All_DEFAULT
 	}
-	return nil
+	return p.All
 }
 
-func (p *DebugProfileStopResult_) String() string {
-	if p == nil {
-		return "<nil>"
+var Query_Field_DEFAULT *FieldQuery
+
+func (p *Query) GetField() *FieldQuery {
+	if !p.IsSetField() {
+		return Query_Field_DEFAULT
 	}
-	return fmt.Sprintf("DebugProfileStopResult_(%+v)", *p)
+	return p.Field
+}
+func (p *Query) IsSetTerm() bool {
+	return p.Term != nil
 }
 
-// Attributes:
-//  - Directory
-type DebugIndexMemorySegmentsRequest struct {
-	Directory string `thrift:"directory,1,required" db:"directory" json:"directory"`
+func (p *Query) IsSetRegexp() bool {
+	return p.Regexp != nil
 }
 
-func NewDebugIndexMemorySegmentsRequest() *DebugIndexMemorySegmentsRequest {
-	return &DebugIndexMemorySegmentsRequest{}
+func (p *Query) IsSetNegation() bool {
+	return p.Negation != nil
 }
 
-func (p *DebugIndexMemorySegmentsRequest) GetDirectory() string {
-	return p.Directory
+func (p *Query) IsSetConjunction() bool {
+	return p.Conjunction != nil
 }
-func (p *DebugIndexMemorySegmentsRequest) Read(iprot thrift.TProtocol) error {
+
+func (p *Query) IsSetDisjunction() bool {
+	return p.Disjunction != nil
+}
+
+func (p *Query) IsSetAll() bool {
+	return p.All != nil
+}
+
+func (p *Query) IsSetField() bool {
+	return p.Field != nil
+}
+
+func (p *Query) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetDirectory bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -15214,7 +16243,30 @@ func (p *DebugIndexMemorySegmentsRequest) Read(iprot thrift.TProtocol) error {
 			if err := p.ReadField1(iprot); err != nil {
 				return err
 			}
-			issetDirectory = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.ReadField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.ReadField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.ReadField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.ReadField7(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -15227,97 +16279,91 @@ func (p *DebugIndexMemorySegmentsRequest) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetDirectory {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Directory is not set"))
-	}
 	return nil
 }
 
-func (p *DebugIndexMemorySegmentsRequest) ReadField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.Directory = v
+func (p *Query) ReadField1(iprot thrift.TProtocol) error {
+	p.Term = &TermQuery{}
+	if err := p.Term.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Term), err)
 	}
 	return nil
 }
 
-func (p *DebugIndexMemorySegmentsRequest) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("DebugIndexMemorySegmentsRequest"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
-		if err := p.writeField1(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
-	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
+func (p *Query) ReadField2(iprot thrift.TProtocol) error {
+	p.Regexp = &RegexpQuery{}
+	if err := p.Regexp.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Regexp), err)
 	}
 	return nil
 }
 
-func (p *DebugIndexMemorySegmentsRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("directory", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:directory: ", p), err)
+func (p *Query) ReadField3(iprot thrift.TProtocol) error {
+	p.Negation = &NegationQuery{}
+	if err := p.Negation.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Negation), err)
 	}
-	if err := oprot.WriteString(string(p.Directory)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.directory (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:directory: ", p), err)
-	}
-	return err
+	return nil
 }
 
-func (p *DebugIndexMemorySegmentsRequest) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *Query) ReadField4(iprot thrift.TProtocol) error {
+	p.Conjunction = &ConjunctionQuery{}
+	if err := p.Conjunction.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Conjunction), err)
 	}
-	return fmt.Sprintf("DebugIndexMemorySegmentsRequest(%+v)", *p)
+	return nil
 }
 
-type DebugIndexMemorySegmentsResult_ struct {
+func (p *Query) ReadField5(iprot thrift.TProtocol) error {
+	p.Disjunction = &DisjunctionQuery{}
+	if err := p.Disjunction.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Disjunction), err)
+	}
+	return nil
 }
 
-func NewDebugIndexMemorySegmentsResult_() *DebugIndexMemorySegmentsResult_ {
-	return &DebugIndexMemorySegmentsResult_{}
+func (p *Query) ReadField6(iprot thrift.TProtocol) error {
+	p.All = &AllQuery{}
+	if err := p.All.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.All), err)
+	}
+	return nil
 }
 
-func (p *DebugIndexMemorySegmentsResult_) Read(iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+func (p *Query) ReadField7(iprot thrift.TProtocol) error {
+	p.Field = &FieldQuery{}
+	if err := p.Field.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Field), err)
 	}
+	return nil
+}
 
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+func (p *Query) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("Query"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
 		}
-		if fieldTypeId == thrift.STOP {
-			break
+		if err := p.writeField2(oprot); err != nil {
+			return err
 		}
-		if err := iprot.Skip(fieldTypeId); err != nil {
+		if err := p.writeField3(oprot); err != nil {
 			return err
 		}
-		if err := iprot.ReadFieldEnd(); err != nil {
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(oprot); err != nil {
 			return err
 		}
-	}
-	if err := iprot.ReadStructEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	return nil
-}
-
-func (p *DebugIndexMemorySegmentsResult_) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("DebugIndexMemorySegmentsResult"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if p != nil {
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -15328,142 +16374,2632 @@ func (p *DebugIndexMemorySegmentsResult_) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DebugIndexMemorySegmentsResult_) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *Query) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTerm() {
+		if err := oprot.WriteFieldBegin("term", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:term: ", p), err)
+		}
+		if err := p.Term.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Term), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:term: ", p), err)
+		}
 	}
-	return fmt.Sprintf("DebugIndexMemorySegmentsResult_(%+v)", *p)
-}
-
-type Node interface {
-	// Parameters:
-	//  - Req
-	Query(req *QueryRequest) (r *QueryResult_, err error)
-	// Parameters:
-	//  - Req
-	Aggregate(req *AggregateQueryRequest) (r *AggregateQueryResult_, err error)
-	// Parameters:
-	//  - Req
-	Fetch(req *FetchRequest) (r *FetchResult_, err error)
-	// Parameters:
-	//  - Req
-	Write(req *WriteRequest) (err error)
-	// Parameters:
-	//  - Req
-	WriteTagged(req *WriteTaggedRequest) (err error)
-	// Parameters:
-	//  - Req
-	AggregateRaw(req *AggregateQueryRawRequest) (r *AggregateQueryRawResult_, err error)
-	// Parameters:
-	//  - Req
-	FetchBatchRaw(req *FetchBatchRawRequest) (r *FetchBatchRawResult_, err error)
-	// Parameters:
-	//  - Req
-	FetchBatchRawV2(req *FetchBatchRawV2Request) (r *FetchBatchRawResult_, err error)
-	// Parameters:
-	//  - Req
-	FetchBlocksRaw(req *FetchBlocksRawRequest) (r *FetchBlocksRawResult_, err error)
-	// Parameters:
-	//  - Req
-	FetchTagged(req *FetchTaggedRequest) (r *FetchTaggedResult_, err error)
-	// Parameters:
-	//  - Req
-	FetchBlocksMetadataRawV2(req *FetchBlocksMetadataRawV2Request) (r *FetchBlocksMetadataRawV2Result_, err error)
-	// Parameters:
-	//  - Req
-	WriteBatchRaw(req *WriteBatchRawRequest) (err error)
-	// Parameters:
-	//  - Req
-	WriteBatchRawV2(req *WriteBatchRawV2Request) (err error)
-	// Parameters:
-	//  - Req
-	WriteTaggedBatchRaw(req *WriteTaggedBatchRawRequest) (err error)
-	// Parameters:
-	//  - Req
-	WriteTaggedBatchRawV2(req *WriteTaggedBatchRawV2Request) (err error)
-	Repair() (err error)
-	// Parameters:
-	//  - Req
-	Truncate(req *TruncateRequest) (r *TruncateResult_, err error)
-	// Parameters:
-	//  - Req
-	AggregateTiles(req *AggregateTilesRequest) (r *AggregateTilesResult_, err error)
-	Health() (r *NodeHealthResult_, err error)
-	Bootstrapped() (r *NodeBootstrappedResult_, err error)
-	BootstrappedInPlacementOrNoPlacement() (r *NodeBootstrappedInPlacementOrNoPlacementResult_, err error)
-	GetPersistRateLimit() (r *NodePersistRateLimitResult_, err error)
-	// Parameters:
-	//  - Req
-	SetPersistRateLimit(req *NodeSetPersistRateLimitRequest) (r *NodePersistRateLimitResult_, err error)
-	GetWriteNewSeriesAsync() (r *NodeWriteNewSeriesAsyncResult_, err error)
-	// Parameters:
-	//  - Req
-	SetWriteNewSeriesAsync(req *NodeSetWriteNewSeriesAsyncRequest) (r *NodeWriteNewSeriesAsyncResult_, err error)
-	GetWriteNewSeriesBackoffDuration() (r *NodeWriteNewSeriesBackoffDurationResult_, err error)
-	// Parameters:
-	//  - Req
-	SetWriteNewSeriesBackoffDuration(req *NodeSetWriteNewSeriesBackoffDurationRequest) (r *NodeWriteNewSeriesBackoffDurationResult_, err error)
-	GetWriteNewSeriesLimitPerShardPerSecond() (r *NodeWriteNewSeriesLimitPerShardPerSecondResult_, err error)
-	// Parameters:
-	//  - Req
-	SetWriteNewSeriesLimitPerShardPerSecond(req *NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) (r *NodeWriteNewSeriesLimitPerShardPerSecondResult_, err error)
-	// Parameters:
-	//  - Req
-	DebugProfileStart(req *DebugProfileStartRequest) (r *DebugProfileStartResult_, err error)
-	// Parameters:
-	//  - Req
-	DebugProfileStop(req *DebugProfileStopRequest) (r *DebugProfileStopResult_, err error)
-	// Parameters:
-	//  - Req
-	DebugIndexMemorySegments(req *DebugIndexMemorySegmentsRequest) (r *DebugIndexMemorySegmentsResult_, err error)
+	return err
 }
 
-type NodeClient struct {
-	Transport       thrift.TTransport
-	ProtocolFactory thrift.TProtocolFactory
-	InputProtocol   thrift.TProtocol
-	OutputProtocol  thrift.TProtocol
-	SeqId           int32
+func (p *Query) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRegexp() {
+		if err := oprot.WriteFieldBegin("regexp", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:regexp: ", p), err)
+		}
+		if err := p.Regexp.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Regexp), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:regexp: ", p), err)
+		}
+	}
+	return err
 }
 
-func NewNodeClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *NodeClient {
-	return &NodeClient{Transport: t,
-		ProtocolFactory: f,
-		InputProtocol:   f.GetProtocol(t),
-		OutputProtocol:  f.GetProtocol(t),
-		SeqId:           0,
+func (p *Query) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNegation() {
+		if err := oprot.WriteFieldBegin("negation", thrift.STRUCT, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:negation: ", p), err)
+		}
+		if err := p.Negation.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Negation), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:negation: ", p), err)
+		}
 	}
+	return err
 }
 
-func NewNodeClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *NodeClient {
-	return &NodeClient{Transport: t,
-		ProtocolFactory: nil,
-		InputProtocol:   iprot,
-		OutputProtocol:  oprot,
-		SeqId:           0,
+func (p *Query) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConjunction() {
+		if err := oprot.WriteFieldBegin("conjunction", thrift.STRUCT, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:conjunction: ", p), err)
+		}
+		if err := p.Conjunction.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Conjunction), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:conjunction: ", p), err)
+		}
 	}
+	return err
 }
 
-// Parameters:
-//  - Req
-func (p *NodeClient) Query(req *QueryRequest) (r *QueryResult_, err error) {
-	if err = p.sendQuery(req); err != nil {
-		return
+func (p *Query) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDisjunction() {
+		if err := oprot.WriteFieldBegin("disjunction", thrift.STRUCT, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:disjunction: ", p), err)
+		}
+		if err := p.Disjunction.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Disjunction), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:disjunction: ", p), err)
+		}
 	}
-	return p.recvQuery()
+	return err
 }
 
-func (p *NodeClient) sendQuery(req *QueryRequest) (err error) {
-	oprot := p.OutputProtocol
-	if oprot == nil {
-		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+func (p *Query) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAll() {
+		if err := oprot.WriteFieldBegin("all", thrift.STRUCT, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:all: ", p), err)
+		}
+		if err := p.All.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.All), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:all: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Query) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetField() {
+		if err := oprot.WriteFieldBegin("field", thrift.STRUCT, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:field: ", p), err)
+		}
+		if err := p.Field.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Field), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:field: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Query) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Query(%+v)", *p)
+}
+
+// Attributes:
+//  - SourceNamespace
+//  - TargetNamespace
+//  - RangeStart
+//  - RangeEnd
+//  - Step
+//  - RangeType
+type AggregateTilesRequest struct {
+	SourceNamespace string   `thrift:"sourceNamespace,1,required" db:"sourceNamespace" json:"sourceNamespace"`
+	TargetNamespace string   `thrift:"targetNamespace,2,required" db:"targetNamespace" json:"targetNamespace"`
+	RangeStart      int64    `thrift:"rangeStart,3,required" db:"rangeStart" json:"rangeStart"`
+	RangeEnd        int64    `thrift:"rangeEnd,4,required" db:"rangeEnd" json:"rangeEnd"`
+	Step            string   `thrift:"step,5,required" db:"step" json:"step"`
+	RangeType       TimeType `thrift:"rangeType,6" db:"rangeType" json:"rangeType,omitempty"`
+}
+
+func NewAggregateTilesRequest() *AggregateTilesRequest {
+	return &AggregateTilesRequest{
+		RangeType: 0,
+	}
+}
+
+func (p *AggregateTilesRequest) GetSourceNamespace() string {
+	return p.SourceNamespace
+}
+
+func (p *AggregateTilesRequest) GetTargetNamespace() string {
+	return p.TargetNamespace
+}
+
+func (p *AggregateTilesRequest) GetRangeStart() int64 {
+	return p.RangeStart
+}
+
+func (p *AggregateTilesRequest) GetRangeEnd() int64 {
+	return p.RangeEnd
+}
+
+func (p *AggregateTilesRequest) GetStep() string {
+	return p.Step
+}
+
+var AggregateTilesRequest_RangeType_DEFAULT TimeType = 0
+
+func (p *AggregateTilesRequest) GetRangeType() TimeType {
+	return p.RangeType
+}
+func (p *AggregateTilesRequest) IsSetRangeType() bool {
+	return p.RangeType != AggregateTilesRequest_RangeType_DEFAULT
+}
+
+func (p *AggregateTilesRequest) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetSourceNamespace bool = false
+	var issetTargetNamespace bool = false
+	var issetRangeStart bool = false
+	var issetRangeEnd bool = false
+	var issetStep bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetSourceNamespace = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+			issetTargetNamespace = true
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
+			issetRangeStart = true
+		case 4:
+			if err := p.ReadField4(iprot); err != nil {
+				return err
+			}
+			issetRangeEnd = true
+		case 5:
+			if err := p.ReadField5(iprot); err != nil {
+				return err
+			}
+			issetStep = true
+		case 6:
+			if err := p.ReadField6(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetSourceNamespace {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field SourceNamespace is not set"))
+	}
+	if !issetTargetNamespace {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TargetNamespace is not set"))
+	}
+	if !issetRangeStart {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RangeStart is not set"))
+	}
+	if !issetRangeEnd {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RangeEnd is not set"))
+	}
+	if !issetStep {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Step is not set"))
+	}
+	return nil
+}
+
+func (p *AggregateTilesRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.SourceNamespace = v
+	}
+	return nil
+}
+
+func (p *AggregateTilesRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.TargetNamespace = v
+	}
+	return nil
+}
+
+func (p *AggregateTilesRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.RangeStart = v
+	}
+	return nil
+}
+
+func (p *AggregateTilesRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.RangeEnd = v
+	}
+	return nil
+}
+
+func (p *AggregateTilesRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Step = v
+	}
+	return nil
+}
+
+func (p *AggregateTilesRequest) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		temp := TimeType(v)
+		p.RangeType = temp
+	}
+	return nil
+}
+
+func (p *AggregateTilesRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("AggregateTilesRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AggregateTilesRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("sourceNamespace", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:sourceNamespace: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.SourceNamespace)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.sourceNamespace (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:sourceNamespace: ", p), err)
+	}
+	return err
+}
+
+func (p *AggregateTilesRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("targetNamespace", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:targetNamespace: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.TargetNamespace)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.targetNamespace (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:targetNamespace: ", p), err)
+	}
+	return err
+}
+
+func (p *AggregateTilesRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("rangeStart", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:rangeStart: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.RangeStart)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.rangeStart (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:rangeStart: ", p), err)
+	}
+	return err
+}
+
+func (p *AggregateTilesRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("rangeEnd", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:rangeEnd: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.RangeEnd)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.rangeEnd (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:rangeEnd: ", p), err)
+	}
+	return err
+}
+
+func (p *AggregateTilesRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("step", thrift.STRING, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:step: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.Step)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.step (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:step: ", p), err)
+	}
+	return err
+}
+
+func (p *AggregateTilesRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRangeType() {
+		if err := oprot.WriteFieldBegin("rangeType", thrift.I32, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:rangeType: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(p.RangeType)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.rangeType (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:rangeType: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AggregateTilesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AggregateTilesRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - ProcessedTileCount
+type AggregateTilesResult_ struct {
+	ProcessedTileCount int64 `thrift:"processedTileCount,1,required" db:"processedTileCount" json:"processedTileCount"`
+}
+
+func NewAggregateTilesResult_() *AggregateTilesResult_ {
+	return &AggregateTilesResult_{}
+}
+
+func (p *AggregateTilesResult_) GetProcessedTileCount() int64 {
+	return p.ProcessedTileCount
+}
+func (p *AggregateTilesResult_) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetProcessedTileCount bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetProcessedTileCount = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetProcessedTileCount {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ProcessedTileCount is not set"))
+	}
+	return nil
+}
+
+func (p *AggregateTilesResult_) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ProcessedTileCount = v
+	}
+	return nil
+}
+
+func (p *AggregateTilesResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("AggregateTilesResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AggregateTilesResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("processedTileCount", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:processedTileCount: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.ProcessedTileCount)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.processedTileCount (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:processedTileCount: ", p), err)
+	}
+	return err
+}
+
+func (p *AggregateTilesResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AggregateTilesResult_(%+v)", *p)
+}
+
+// Attributes:
+//  - Statuses
+type AggregateTilesStatusResult_ struct {
+	Statuses []*AggregateTilesStatus `thrift:"statuses,1,required" db:"statuses" json:"statuses"`
+}
+
+func NewAggregateTilesStatusResult_() *AggregateTilesStatusResult_ {
+	return &AggregateTilesStatusResult_{}
+}
+
+func (p *AggregateTilesStatusResult_) GetStatuses() []*AggregateTilesStatus {
+	return p.Statuses
+}
+func (p *AggregateTilesStatusResult_) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetStatuses bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetStatuses = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetStatuses {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Statuses is not set"))
+	}
+	return nil
+}
+
+func (p *AggregateTilesStatusResult_) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*AggregateTilesStatus, 0, size)
+	p.Statuses = tSlice
+	for i := 0; i < size; i++ {
+		_elem35 := &AggregateTilesStatus{}
+		if err := _elem35.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem35), err)
+		}
+		p.Statuses = append(p.Statuses, _elem35)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *AggregateTilesStatusResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("AggregateTilesStatusResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AggregateTilesStatusResult_) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("statuses", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:statuses: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Statuses)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Statuses {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:statuses: ", p), err)
+	}
+	return err
+}
+
+func (p *AggregateTilesStatusResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AggregateTilesStatusResult_(%+v)", *p)
+}
+
+// Attributes:
+//  - SourceNamespace
+//  - TargetNamespace
+//  - Step
+//  - AggregatedUntil
+//  - ProcessedTileCount
+//  - LastRunAt
+//  - LastError
+type AggregateTilesStatus struct {
+	SourceNamespace    string  `thrift:"sourceNamespace,1,required" db:"sourceNamespace" json:"sourceNamespace"`
+	TargetNamespace    string  `thrift:"targetNamespace,2,required" db:"targetNamespace" json:"targetNamespace"`
+	Step               string  `thrift:"step,3,required" db:"step" json:"step"`
+	AggregatedUntil    int64   `thrift:"aggregatedUntil,4,required" db:"aggregatedUntil" json:"aggregatedUntil"`
+	ProcessedTileCount int64   `thrift:"processedTileCount,5,required" db:"processedTileCount" json:"processedTileCount"`
+	LastRunAt          int64   `thrift:"lastRunAt,6,required" db:"lastRunAt" json:"lastRunAt"`
+	LastError          *string `thrift:"lastError,7" db:"lastError" json:"lastError,omitempty"`
+}
+
+func NewAggregateTilesStatus() *AggregateTilesStatus {
+	return &AggregateTilesStatus{}
+}
+
+func (p *AggregateTilesStatus) GetSourceNamespace() string {
+	return p.SourceNamespace
+}
+
+func (p *AggregateTilesStatus) GetTargetNamespace() string {
+	return p.TargetNamespace
+}
+
+func (p *AggregateTilesStatus) GetStep() string {
+	return p.Step
+}
+
+func (p *AggregateTilesStatus) GetAggregatedUntil() int64 {
+	return p.AggregatedUntil
+}
+
+func (p *AggregateTilesStatus) GetProcessedTileCount() int64 {
+	return p.ProcessedTileCount
+}
+
+func (p *AggregateTilesStatus) GetLastRunAt() int64 {
+	return p.LastRunAt
+}
+
+var AggregateTilesStatus_LastError_DEFAULT string
+
+func (p *AggregateTilesStatus) GetLastError() string {
+	if !p.IsSetLastError() {
+		return AggregateTilesStatus_LastError_DEFAULT
+	}
+	return *p.LastError
+}
+func (p *AggregateTilesStatus) IsSetLastError() bool {
+	return p.LastError != nil
+}
+
+func (p *AggregateTilesStatus) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetSourceNamespace bool = false
+	var issetTargetNamespace bool = false
+	var issetStep bool = false
+	var issetAggregatedUntil bool = false
+	var issetProcessedTileCount bool = false
+	var issetLastRunAt bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetSourceNamespace = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+			issetTargetNamespace = true
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
+			issetStep = true
+		case 4:
+			if err := p.ReadField4(iprot); err != nil {
+				return err
+			}
+			issetAggregatedUntil = true
+		case 5:
+			if err := p.ReadField5(iprot); err != nil {
+				return err
+			}
+			issetProcessedTileCount = true
+		case 6:
+			if err := p.ReadField6(iprot); err != nil {
+				return err
+			}
+			issetLastRunAt = true
+		case 7:
+			if err := p.ReadField7(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetSourceNamespace {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field SourceNamespace is not set"))
+	}
+	if !issetTargetNamespace {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TargetNamespace is not set"))
+	}
+	if !issetStep {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Step is not set"))
+	}
+	if !issetAggregatedUntil {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field AggregatedUntil is not set"))
+	}
+	if !issetProcessedTileCount {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ProcessedTileCount is not set"))
+	}
+	if !issetLastRunAt {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field LastRunAt is not set"))
+	}
+	return nil
+}
+
+func (p *AggregateTilesStatus) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.SourceNamespace = v
+	}
+	return nil
+}
+
+func (p *AggregateTilesStatus) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.TargetNamespace = v
+	}
+	return nil
+}
+
+func (p *AggregateTilesStatus) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Step = v
+	}
+	return nil
+}
+
+func (p *AggregateTilesStatus) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.AggregatedUntil = v
+	}
+	return nil
+}
+
+func (p *AggregateTilesStatus) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.ProcessedTileCount = v
+	}
+	return nil
+}
+
+func (p *AggregateTilesStatus) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.LastRunAt = v
+	}
+	return nil
+}
+
+func (p *AggregateTilesStatus) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.LastError = &v
+	}
+	return nil
+}
+
+func (p *AggregateTilesStatus) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("AggregateTilesStatus"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AggregateTilesStatus) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("sourceNamespace", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:sourceNamespace: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.SourceNamespace)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.sourceNamespace (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:sourceNamespace: ", p), err)
+	}
+	return err
+}
+
+func (p *AggregateTilesStatus) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("targetNamespace", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:targetNamespace: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.TargetNamespace)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.targetNamespace (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:targetNamespace: ", p), err)
+	}
+	return err
+}
+
+func (p *AggregateTilesStatus) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("step", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:step: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.Step)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.step (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:step: ", p), err)
+	}
+	return err
+}
+
+func (p *AggregateTilesStatus) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("aggregatedUntil", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:aggregatedUntil: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.AggregatedUntil)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.aggregatedUntil (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:aggregatedUntil: ", p), err)
+	}
+	return err
+}
+
+func (p *AggregateTilesStatus) writeField5(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("processedTileCount", thrift.I64, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:processedTileCount: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.ProcessedTileCount)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.processedTileCount (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:processedTileCount: ", p), err)
+	}
+	return err
+}
+
+func (p *AggregateTilesStatus) writeField6(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("lastRunAt", thrift.I64, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:lastRunAt: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.LastRunAt)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.lastRunAt (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:lastRunAt: ", p), err)
+	}
+	return err
+}
+
+func (p *AggregateTilesStatus) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastError() {
+		if err := oprot.WriteFieldBegin("lastError", thrift.STRING, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:lastError: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.LastError)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.lastError (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:lastError: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AggregateTilesStatus) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AggregateTilesStatus(%+v)", *p)
+}
+
+// Attributes:
+//  - Name
+//  - FilePathTemplate
+//  - Interval
+//  - Duration
+//  - Debug
+//  - ConditionalNumGoroutinesGreaterThan
+//  - ConditionalNumGoroutinesLessThan
+//  - ConditionalIsOverloaded
+type DebugProfileStartRequest struct {
+	Name                                string  `thrift:"name,1,required" db:"name" json:"name"`
+	FilePathTemplate                    string  `thrift:"filePathTemplate,2,required" db:"filePathTemplate" json:"filePathTemplate"`
+	Interval                            *string `thrift:"interval,3" db:"interval" json:"interval,omitempty"`
+	Duration                            *string `thrift:"duration,4" db:"duration" json:"duration,omitempty"`
+	Debug                               *int64  `thrift:"debug,5" db:"debug" json:"debug,omitempty"`
+	ConditionalNumGoroutinesGreaterThan *int64  `thrift:"conditionalNumGoroutinesGreaterThan,6" db:"conditionalNumGoroutinesGreaterThan" json:"conditionalNumGoroutinesGreaterThan,omitempty"`
+	ConditionalNumGoroutinesLessThan    *int64  `thrift:"conditionalNumGoroutinesLessThan,7" db:"conditionalNumGoroutinesLessThan" json:"conditionalNumGoroutinesLessThan,omitempty"`
+	ConditionalIsOverloaded             *bool   `thrift:"conditionalIsOverloaded,8" db:"conditionalIsOverloaded" json:"conditionalIsOverloaded,omitempty"`
+}
+
+func NewDebugProfileStartRequest() *DebugProfileStartRequest {
+	return &DebugProfileStartRequest{}
+}
+
+func (p *DebugProfileStartRequest) GetName() string {
+	return p.Name
+}
+
+func (p *DebugProfileStartRequest) GetFilePathTemplate() string {
+	return p.FilePathTemplate
+}
+
+var DebugProfileStartRequest_Interval_DEFAULT string
+
+func (p *DebugProfileStartRequest) GetInterval() string {
+	if !p.IsSetInterval() {
+		return DebugProfileStartRequest_Interval_DEFAULT
+	}
+	return *p.Interval
+}
+
+var DebugProfileStartRequest_Duration_DEFAULT string
+
+func (p *DebugProfileStartRequest) GetDuration() string {
+	if !p.IsSetDuration() {
+		return DebugProfileStartRequest_Duration_DEFAULT
+	}
+	return *p.Duration
+}
+
+var DebugProfileStartRequest_Debug_DEFAULT int64
+
+func (p *DebugProfileStartRequest) GetDebug() int64 {
+	if !p.IsSetDebug() {
+		return DebugProfileStartRequest_Debug_DEFAULT
+	}
+	return *p.Debug
+}
+
+var DebugProfileStartRequest_ConditionalNumGoroutinesGreaterThan_DEFAULT int64
+
+func (p *DebugProfileStartRequest) GetConditionalNumGoroutinesGreaterThan() int64 {
+	if !p.IsSetConditionalNumGoroutinesGreaterThan() {
+		return DebugProfileStartRequest_ConditionalNumGoroutinesGreaterThan_DEFAULT
+	}
+	return *p.ConditionalNumGoroutinesGreaterThan
+}
+
+var DebugProfileStartRequest_ConditionalNumGoroutinesLessThan_DEFAULT int64
+
+func (p *DebugProfileStartRequest) GetConditionalNumGoroutinesLessThan() int64 {
+	if !p.IsSetConditionalNumGoroutinesLessThan() {
+		return DebugProfileStartRequest_ConditionalNumGoroutinesLessThan_DEFAULT
+	}
+	return *p.ConditionalNumGoroutinesLessThan
+}
+
+var DebugProfileStartRequest_ConditionalIsOverloaded_DEFAULT bool
+
+func (p *DebugProfileStartRequest) GetConditionalIsOverloaded() bool {
+	if !p.IsSetConditionalIsOverloaded() {
+		return DebugProfileStartRequest_ConditionalIsOverloaded_DEFAULT
+	}
+	return *p.ConditionalIsOverloaded
+}
+func (p *DebugProfileStartRequest) IsSetInterval() bool {
+	return p.Interval != nil
+}
+
+func (p *DebugProfileStartRequest) IsSetDuration() bool {
+	return p.Duration != nil
+}
+
+func (p *DebugProfileStartRequest) IsSetDebug() bool {
+	return p.Debug != nil
+}
+
+func (p *DebugProfileStartRequest) IsSetConditionalNumGoroutinesGreaterThan() bool {
+	return p.ConditionalNumGoroutinesGreaterThan != nil
+}
+
+func (p *DebugProfileStartRequest) IsSetConditionalNumGoroutinesLessThan() bool {
+	return p.ConditionalNumGoroutinesLessThan != nil
+}
+
+func (p *DebugProfileStartRequest) IsSetConditionalIsOverloaded() bool {
+	return p.ConditionalIsOverloaded != nil
+}
+
+func (p *DebugProfileStartRequest) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetName bool = false
+	var issetFilePathTemplate bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetName = true
+		case 2:
+			if err := p.ReadField2(iprot); err != nil {
+				return err
+			}
+			issetFilePathTemplate = true
+		case 3:
+			if err := p.ReadField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.ReadField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.ReadField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.ReadField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.ReadField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.ReadField8(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetName {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Name is not set"))
+	}
+	if !issetFilePathTemplate {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field FilePathTemplate is not set"))
+	}
+	return nil
+}
+
+func (p *DebugProfileStartRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+
+func (p *DebugProfileStartRequest) ReadField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.FilePathTemplate = v
+	}
+	return nil
+}
+
+func (p *DebugProfileStartRequest) ReadField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Interval = &v
+	}
+	return nil
+}
+
+func (p *DebugProfileStartRequest) ReadField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Duration = &v
+	}
+	return nil
+}
+
+func (p *DebugProfileStartRequest) ReadField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Debug = &v
+	}
+	return nil
+}
+
+func (p *DebugProfileStartRequest) ReadField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.ConditionalNumGoroutinesGreaterThan = &v
+	}
+	return nil
+}
+
+func (p *DebugProfileStartRequest) ReadField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.ConditionalNumGoroutinesLessThan = &v
+	}
+	return nil
+}
+
+func (p *DebugProfileStartRequest) ReadField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.ConditionalIsOverloaded = &v
+	}
+	return nil
+}
+
+func (p *DebugProfileStartRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DebugProfileStartRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DebugProfileStartRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err)
+	}
+	return err
+}
+
+func (p *DebugProfileStartRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("filePathTemplate", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:filePathTemplate: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.FilePathTemplate)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.filePathTemplate (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:filePathTemplate: ", p), err)
+	}
+	return err
+}
+
+func (p *DebugProfileStartRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInterval() {
+		if err := oprot.WriteFieldBegin("interval", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:interval: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Interval)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.interval (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:interval: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DebugProfileStartRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDuration() {
+		if err := oprot.WriteFieldBegin("duration", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:duration: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Duration)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.duration (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:duration: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DebugProfileStartRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDebug() {
+		if err := oprot.WriteFieldBegin("debug", thrift.I64, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:debug: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.Debug)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.debug (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:debug: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DebugProfileStartRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConditionalNumGoroutinesGreaterThan() {
+		if err := oprot.WriteFieldBegin("conditionalNumGoroutinesGreaterThan", thrift.I64, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:conditionalNumGoroutinesGreaterThan: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.ConditionalNumGoroutinesGreaterThan)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.conditionalNumGoroutinesGreaterThan (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:conditionalNumGoroutinesGreaterThan: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DebugProfileStartRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConditionalNumGoroutinesLessThan() {
+		if err := oprot.WriteFieldBegin("conditionalNumGoroutinesLessThan", thrift.I64, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:conditionalNumGoroutinesLessThan: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.ConditionalNumGoroutinesLessThan)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.conditionalNumGoroutinesLessThan (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:conditionalNumGoroutinesLessThan: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DebugProfileStartRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConditionalIsOverloaded() {
+		if err := oprot.WriteFieldBegin("conditionalIsOverloaded", thrift.BOOL, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:conditionalIsOverloaded: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(*p.ConditionalIsOverloaded)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.conditionalIsOverloaded (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:conditionalIsOverloaded: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DebugProfileStartRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DebugProfileStartRequest(%+v)", *p)
+}
+
+type DebugProfileStartResult_ struct {
+}
+
+func NewDebugProfileStartResult_() *DebugProfileStartResult_ {
+	return &DebugProfileStartResult_{}
+}
+
+func (p *DebugProfileStartResult_) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *DebugProfileStartResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DebugProfileStartResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DebugProfileStartResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DebugProfileStartResult_(%+v)", *p)
+}
+
+// Attributes:
+//  - Name
+type DebugProfileStopRequest struct {
+	Name string `thrift:"name,1,required" db:"name" json:"name"`
+}
+
+func NewDebugProfileStopRequest() *DebugProfileStopRequest {
+	return &DebugProfileStopRequest{}
+}
+
+func (p *DebugProfileStopRequest) GetName() string {
+	return p.Name
+}
+func (p *DebugProfileStopRequest) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetName bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetName = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetName {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Name is not set"))
+	}
+	return nil
+}
+
+func (p *DebugProfileStopRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+
+func (p *DebugProfileStopRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DebugProfileStopRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DebugProfileStopRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err)
+	}
+	return err
+}
+
+func (p *DebugProfileStopRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DebugProfileStopRequest(%+v)", *p)
+}
+
+type DebugProfileStopResult_ struct {
+}
+
+func NewDebugProfileStopResult_() *DebugProfileStopResult_ {
+	return &DebugProfileStopResult_{}
+}
+
+func (p *DebugProfileStopResult_) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *DebugProfileStopResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DebugProfileStopResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DebugProfileStopResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DebugProfileStopResult_(%+v)", *p)
+}
+
+// Attributes:
+//  - Directory
+type DebugIndexMemorySegmentsRequest struct {
+	Directory string `thrift:"directory,1,required" db:"directory" json:"directory"`
+}
+
+func NewDebugIndexMemorySegmentsRequest() *DebugIndexMemorySegmentsRequest {
+	return &DebugIndexMemorySegmentsRequest{}
+}
+
+func (p *DebugIndexMemorySegmentsRequest) GetDirectory() string {
+	return p.Directory
+}
+func (p *DebugIndexMemorySegmentsRequest) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetDirectory bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+			issetDirectory = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetDirectory {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Directory is not set"))
+	}
+	return nil
+}
+
+func (p *DebugIndexMemorySegmentsRequest) ReadField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Directory = v
+	}
+	return nil
+}
+
+func (p *DebugIndexMemorySegmentsRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DebugIndexMemorySegmentsRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DebugIndexMemorySegmentsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("directory", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:directory: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.Directory)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.directory (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:directory: ", p), err)
+	}
+	return err
+}
+
+func (p *DebugIndexMemorySegmentsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DebugIndexMemorySegmentsRequest(%+v)", *p)
+}
+
+type DebugIndexMemorySegmentsResult_ struct {
+}
+
+func NewDebugIndexMemorySegmentsResult_() *DebugIndexMemorySegmentsResult_ {
+	return &DebugIndexMemorySegmentsResult_{}
+}
+
+func (p *DebugIndexMemorySegmentsResult_) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *DebugIndexMemorySegmentsResult_) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DebugIndexMemorySegmentsResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DebugIndexMemorySegmentsResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DebugIndexMemorySegmentsResult_(%+v)", *p)
+}
+
+type Node interface {
+	// Parameters:
+	//  - Req
+	Query(req *QueryRequest) (r *QueryResult_, err error)
+	// Parameters:
+	//  - Req
+	Aggregate(req *AggregateQueryRequest) (r *AggregateQueryResult_, err error)
+	// Parameters:
+	//  - Req
+	Fetch(req *FetchRequest) (r *FetchResult_, err error)
+	// Parameters:
+	//  - Req
+	Write(req *WriteRequest) (err error)
+	// Parameters:
+	//  - Req
+	WriteTagged(req *WriteTaggedRequest) (err error)
+	// Parameters:
+	//  - Req
+	AggregateRaw(req *AggregateQueryRawRequest) (r *AggregateQueryRawResult_, err error)
+	// Parameters:
+	//  - Req
+	FetchBatchRaw(req *FetchBatchRawRequest) (r *FetchBatchRawResult_, err error)
+	// Parameters:
+	//  - Req
+	FetchBatchRawV2(req *FetchBatchRawV2Request) (r *FetchBatchRawResult_, err error)
+	// Parameters:
+	//  - Req
+	FetchBlocksRaw(req *FetchBlocksRawRequest) (r *FetchBlocksRawResult_, err error)
+	// Parameters:
+	//  - Req
+	FetchTagged(req *FetchTaggedRequest) (r *FetchTaggedResult_, err error)
+	// Parameters:
+	//  - Req
+	FetchTaggedStream(req *FetchTaggedStreamRequest) (r *FetchTaggedStreamResult_, err error)
+	// Parameters:
+	//  - Req
+	FetchBlocksMetadataRawV2(req *FetchBlocksMetadataRawV2Request) (r *FetchBlocksMetadataRawV2Result_, err error)
+	// Parameters:
+	//  - Req
+	FetchBlocksMerkleTreeRaw(req *FetchBlocksMerkleTreeRawRequest) (r *FetchBlocksMerkleTreeRawResult_, err error)
+	// Parameters:
+	//  - Req
+	CardinalityRaw(req *CardinalityRawRequest) (r *CardinalityRawResult_, err error)
+	// Parameters:
+	//  - Req
+	WriteBatchRaw(req *WriteBatchRawRequest) (err error)
+	// Parameters:
+	//  - Req
+	WriteBatchRawV2(req *WriteBatchRawV2Request) (err error)
+	// Parameters:
+	//  - Req
+	WriteTaggedBatchRaw(req *WriteTaggedBatchRawRequest) (err error)
+	// Parameters:
+	//  - Req
+	WriteTaggedBatchRawV2(req *WriteTaggedBatchRawV2Request) (err error)
+	Repair() (err error)
+	// Parameters:
+	//  - Req
+	Truncate(req *TruncateRequest) (r *TruncateResult_, err error)
+	// Parameters:
+	//  - Req
+	DeleteTagged(req *DeleteTaggedRequest) (r *DeleteTaggedResult_, err error)
+	// Parameters:
+	//  - Req
+	AggregateTiles(req *AggregateTilesRequest) (r *AggregateTilesResult_, err error)
+	AggregateTilesStatus() (r *AggregateTilesStatusResult_, err error)
+	Health() (r *NodeHealthResult_, err error)
+	Bootstrapped() (r *NodeBootstrappedResult_, err error)
+	BootstrappedInPlacementOrNoPlacement() (r *NodeBootstrappedInPlacementOrNoPlacementResult_, err error)
+	GetPersistRateLimit() (r *NodePersistRateLimitResult_, err error)
+	// Parameters:
+	//  - Req
+	SetPersistRateLimit(req *NodeSetPersistRateLimitRequest) (r *NodePersistRateLimitResult_, err error)
+	GetWriteNewSeriesAsync() (r *NodeWriteNewSeriesAsyncResult_, err error)
+	// Parameters:
+	//  - Req
+	SetWriteNewSeriesAsync(req *NodeSetWriteNewSeriesAsyncRequest) (r *NodeWriteNewSeriesAsyncResult_, err error)
+	GetWriteNewSeriesBackoffDuration() (r *NodeWriteNewSeriesBackoffDurationResult_, err error)
+	// Parameters:
+	//  - Req
+	SetWriteNewSeriesBackoffDuration(req *NodeSetWriteNewSeriesBackoffDurationRequest) (r *NodeWriteNewSeriesBackoffDurationResult_, err error)
+	GetWriteNewSeriesLimitPerShardPerSecond() (r *NodeWriteNewSeriesLimitPerShardPerSecondResult_, err error)
+	// Parameters:
+	//  - Req
+	SetWriteNewSeriesLimitPerShardPerSecond(req *NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) (r *NodeWriteNewSeriesLimitPerShardPerSecondResult_, err error)
+	// Parameters:
+	//  - Req
+	DebugProfileStart(req *DebugProfileStartRequest) (r *DebugProfileStartResult_, err error)
+	// Parameters:
+	//  - Req
+	DebugProfileStop(req *DebugProfileStopRequest) (r *DebugProfileStopResult_, err error)
+	// Parameters:
+	//  - Req
+	DebugIndexMemorySegments(req *DebugIndexMemorySegmentsRequest) (r *DebugIndexMemorySegmentsResult_, err error)
+}
+
+type NodeClient struct {
+	Transport       thrift.TTransport
+	ProtocolFactory thrift.TProtocolFactory
+	InputProtocol   thrift.TProtocol
+	OutputProtocol  thrift.TProtocol
+	SeqId           int32
+}
+
+func NewNodeClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *NodeClient {
+	return &NodeClient{Transport: t,
+		ProtocolFactory: f,
+		InputProtocol:   f.GetProtocol(t),
+		OutputProtocol:  f.GetProtocol(t),
+		SeqId:           0,
+	}
+}
+
+func NewNodeClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *NodeClient {
+	return &NodeClient{Transport: t,
+		ProtocolFactory: nil,
+		InputProtocol:   iprot,
+		OutputProtocol:  oprot,
+		SeqId:           0,
+	}
+}
+
+// Parameters:
+//  - Req
+func (p *NodeClient) Query(req *QueryRequest) (r *QueryResult_, err error) {
+	if err = p.sendQuery(req); err != nil {
+		return
+	}
+	return p.recvQuery()
+}
+
+func (p *NodeClient) sendQuery(req *QueryRequest) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("query", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := NodeQueryArgs{
+		Req: req,
+	}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
+}
+
+func (p *NodeClient) recvQuery() (value *QueryResult_, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if method != "query" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "query failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "query failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error35 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error36 error
+		error36, err = error35.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error36
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "query failed: invalid message type")
+		return
+	}
+	result := NodeQueryResult{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Err != nil {
+		err = result.Err
+		return
+	}
+	value = result.GetSuccess()
+	return
+}
+
+// Parameters:
+//  - Req
+func (p *NodeClient) Aggregate(req *AggregateQueryRequest) (r *AggregateQueryResult_, err error) {
+	if err = p.sendAggregate(req); err != nil {
+		return
+	}
+	return p.recvAggregate()
+}
+
+func (p *NodeClient) sendAggregate(req *AggregateQueryRequest) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("aggregate", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := NodeAggregateArgs{
+		Req: req,
+	}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
+}
+
+func (p *NodeClient) recvAggregate() (value *AggregateQueryResult_, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if method != "aggregate" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "aggregate failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "aggregate failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error37 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error38 error
+		error38, err = error37.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error38
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "aggregate failed: invalid message type")
+		return
+	}
+	result := NodeAggregateResult{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Err != nil {
+		err = result.Err
+		return
+	}
+	value = result.GetSuccess()
+	return
+}
+
+// Parameters:
+//  - Req
+func (p *NodeClient) Fetch(req *FetchRequest) (r *FetchResult_, err error) {
+	if err = p.sendFetch(req); err != nil {
+		return
+	}
+	return p.recvFetch()
+}
+
+func (p *NodeClient) sendFetch(req *FetchRequest) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("fetch", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := NodeFetchArgs{
+		Req: req,
+	}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
+}
+
+func (p *NodeClient) recvFetch() (value *FetchResult_, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if method != "fetch" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetch failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetch failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error39 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error40 error
+		error40, err = error39.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error40
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetch failed: invalid message type")
+		return
+	}
+	result := NodeFetchResult{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Err != nil {
+		err = result.Err
+		return
+	}
+	value = result.GetSuccess()
+	return
+}
+
+// Parameters:
+//  - Req
+func (p *NodeClient) Write(req *WriteRequest) (err error) {
+	if err = p.sendWrite(req); err != nil {
+		return
+	}
+	return p.recvWrite()
+}
+
+func (p *NodeClient) sendWrite(req *WriteRequest) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("write", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := NodeWriteArgs{
+		Req: req,
+	}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
+}
+
+func (p *NodeClient) recvWrite() (err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if method != "write" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "write failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "write failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error41 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error42 error
+		error42, err = error41.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error42
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "write failed: invalid message type")
+		return
+	}
+	result := NodeWriteResult{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Err != nil {
+		err = result.Err
+		return
+	}
+	return
+}
+
+// Parameters:
+//  - Req
+func (p *NodeClient) WriteTagged(req *WriteTaggedRequest) (err error) {
+	if err = p.sendWriteTagged(req); err != nil {
+		return
+	}
+	return p.recvWriteTagged()
+}
+
+func (p *NodeClient) sendWriteTagged(req *WriteTaggedRequest) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("writeTagged", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := NodeWriteTaggedArgs{
+		Req: req,
+	}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
+}
+
+func (p *NodeClient) recvWriteTagged() (err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if method != "writeTagged" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "writeTagged failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "writeTagged failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error43 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error44 error
+		error44, err = error43.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error44
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "writeTagged failed: invalid message type")
+		return
+	}
+	result := NodeWriteTaggedResult{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Err != nil {
+		err = result.Err
+		return
+	}
+	return
+}
+
+// Parameters:
+//  - Req
+func (p *NodeClient) AggregateRaw(req *AggregateQueryRawRequest) (r *AggregateQueryRawResult_, err error) {
+	if err = p.sendAggregateRaw(req); err != nil {
+		return
+	}
+	return p.recvAggregateRaw()
+}
+
+func (p *NodeClient) sendAggregateRaw(req *AggregateQueryRawRequest) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("aggregateRaw", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := NodeAggregateRawArgs{
+		Req: req,
+	}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
+}
+
+func (p *NodeClient) recvAggregateRaw() (value *AggregateQueryRawResult_, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if method != "aggregateRaw" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "aggregateRaw failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "aggregateRaw failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error45 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error46 error
+		error46, err = error45.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error46
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "aggregateRaw failed: invalid message type")
+		return
+	}
+	result := NodeAggregateRawResult{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Err != nil {
+		err = result.Err
+		return
+	}
+	value = result.GetSuccess()
+	return
+}
+
+// Parameters:
+//  - Req
+func (p *NodeClient) FetchBatchRaw(req *FetchBatchRawRequest) (r *FetchBatchRawResult_, err error) {
+	if err = p.sendFetchBatchRaw(req); err != nil {
+		return
+	}
+	return p.recvFetchBatchRaw()
+}
+
+func (p *NodeClient) sendFetchBatchRaw(req *FetchBatchRawRequest) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("fetchBatchRaw", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := NodeFetchBatchRawArgs{
+		Req: req,
+	}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
+}
+
+func (p *NodeClient) recvFetchBatchRaw() (value *FetchBatchRawResult_, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if method != "fetchBatchRaw" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetchBatchRaw failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetchBatchRaw failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error47 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error48 error
+		error48, err = error47.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error48
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetchBatchRaw failed: invalid message type")
+		return
+	}
+	result := NodeFetchBatchRawResult{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Err != nil {
+		err = result.Err
+		return
+	}
+	value = result.GetSuccess()
+	return
+}
+
+// Parameters:
+//  - Req
+func (p *NodeClient) FetchBatchRawV2(req *FetchBatchRawV2Request) (r *FetchBatchRawResult_, err error) {
+	if err = p.sendFetchBatchRawV2(req); err != nil {
+		return
+	}
+	return p.recvFetchBatchRawV2()
+}
+
+func (p *NodeClient) sendFetchBatchRawV2(req *FetchBatchRawV2Request) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("fetchBatchRawV2", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := NodeFetchBatchRawV2Args{
+		Req: req,
+	}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
+}
+
+func (p *NodeClient) recvFetchBatchRawV2() (value *FetchBatchRawResult_, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if method != "fetchBatchRawV2" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetchBatchRawV2 failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetchBatchRawV2 failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error49 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error50 error
+		error50, err = error49.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error50
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetchBatchRawV2 failed: invalid message type")
+		return
+	}
+	result := NodeFetchBatchRawV2Result{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Err != nil {
+		err = result.Err
+		return
+	}
+	value = result.GetSuccess()
+	return
+}
+
+// Parameters:
+//  - Req
+func (p *NodeClient) FetchBlocksRaw(req *FetchBlocksRawRequest) (r *FetchBlocksRawResult_, err error) {
+	if err = p.sendFetchBlocksRaw(req); err != nil {
+		return
+	}
+	return p.recvFetchBlocksRaw()
+}
+
+func (p *NodeClient) sendFetchBlocksRaw(req *FetchBlocksRawRequest) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("fetchBlocksRaw", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := NodeFetchBlocksRawArgs{
+		Req: req,
+	}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
+}
+
+func (p *NodeClient) recvFetchBlocksRaw() (value *FetchBlocksRawResult_, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if method != "fetchBlocksRaw" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetchBlocksRaw failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetchBlocksRaw failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error51 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error52 error
+		error52, err = error51.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error52
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetchBlocksRaw failed: invalid message type")
+		return
+	}
+	result := NodeFetchBlocksRawResult{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Err != nil {
+		err = result.Err
+		return
+	}
+	value = result.GetSuccess()
+	return
+}
+
+// Parameters:
+//  - Req
+func (p *NodeClient) FetchTagged(req *FetchTaggedRequest) (r *FetchTaggedResult_, err error) {
+	if err = p.sendFetchTagged(req); err != nil {
+		return
+	}
+	return p.recvFetchTagged()
+}
+
+func (p *NodeClient) sendFetchTagged(req *FetchTaggedRequest) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("query", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("fetchTagged", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeQueryArgs{
+	args := NodeFetchTaggedArgs{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -15475,7 +19011,7 @@ func (p *NodeClient) sendQuery(req *QueryRequest) (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvQuery() (value *QueryResult_, err error) {
+func (p *NodeClient) recvFetchTagged() (value *FetchTaggedResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -15485,32 +19021,32 @@ func (p *NodeClient) recvQuery() (value *QueryResult_, err error) {
 	if err != nil {
 		return
 	}
-	if method != "query" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "query failed: wrong method name")
+	if method != "fetchTagged" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetchTagged failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "query failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetchTagged failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error35 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error36 error
-		error36, err = error35.Read(iprot)
+		error53 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error54 error
+		error54, err = error53.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error36
+		err = error54
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "query failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetchTagged failed: invalid message type")
 		return
 	}
-	result := NodeQueryResult{}
+	result := NodeFetchTaggedResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -15527,24 +19063,24 @@ func (p *NodeClient) recvQuery() (value *QueryResult_, err error) {
 
 // Parameters:
 //  - Req
-func (p *NodeClient) Aggregate(req *AggregateQueryRequest) (r *AggregateQueryResult_, err error) {
-	if err = p.sendAggregate(req); err != nil {
+func (p *NodeClient) FetchTaggedStream(req *FetchTaggedStreamRequest) (r *FetchTaggedStreamResult_, err error) {
+	if err = p.sendFetchTaggedStream(req); err != nil {
 		return
 	}
-	return p.recvAggregate()
+	return p.recvFetchTaggedStream()
 }
 
-func (p *NodeClient) sendAggregate(req *AggregateQueryRequest) (err error) {
+func (p *NodeClient) sendFetchTaggedStream(req *FetchTaggedStreamRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("aggregate", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("fetchTaggedStream", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeAggregateArgs{
+	args := NodeFetchTaggedStreamArgs{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -15556,7 +19092,169 @@ func (p *NodeClient) sendAggregate(req *AggregateQueryRequest) (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvAggregate() (value *AggregateQueryResult_, err error) {
+func (p *NodeClient) recvFetchTaggedStream() (value *FetchTaggedStreamResult_, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if method != "fetchTaggedStream" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetchTaggedStream failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetchTaggedStream failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error67 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error68 error
+		error68, err = error67.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error68
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetchTaggedStream failed: invalid message type")
+		return
+	}
+	result := NodeFetchTaggedStreamResult{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Err != nil {
+		err = result.Err
+		return
+	}
+	value = result.GetSuccess()
+	return
+}
+
+// Parameters:
+//  - Req
+func (p *NodeClient) FetchBlocksMetadataRawV2(req *FetchBlocksMetadataRawV2Request) (r *FetchBlocksMetadataRawV2Result_, err error) {
+	if err = p.sendFetchBlocksMetadataRawV2(req); err != nil {
+		return
+	}
+	return p.recvFetchBlocksMetadataRawV2()
+}
+
+func (p *NodeClient) sendFetchBlocksMetadataRawV2(req *FetchBlocksMetadataRawV2Request) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("fetchBlocksMetadataRawV2", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := NodeFetchBlocksMetadataRawV2Args{
+		Req: req,
+	}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
+}
+
+func (p *NodeClient) recvFetchBlocksMetadataRawV2() (value *FetchBlocksMetadataRawV2Result_, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if method != "fetchBlocksMetadataRawV2" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetchBlocksMetadataRawV2 failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetchBlocksMetadataRawV2 failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error55 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error56 error
+		error56, err = error55.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error56
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetchBlocksMetadataRawV2 failed: invalid message type")
+		return
+	}
+	result := NodeFetchBlocksMetadataRawV2Result{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Err != nil {
+		err = result.Err
+		return
+	}
+	value = result.GetSuccess()
+	return
+}
+
+// Parameters:
+//  - Req
+func (p *NodeClient) FetchBlocksMerkleTreeRaw(req *FetchBlocksMerkleTreeRawRequest) (r *FetchBlocksMerkleTreeRawResult_, err error) {
+	if err = p.sendFetchBlocksMerkleTreeRaw(req); err != nil {
+		return
+	}
+	return p.recvFetchBlocksMerkleTreeRaw()
+}
+
+func (p *NodeClient) sendFetchBlocksMerkleTreeRaw(req *FetchBlocksMerkleTreeRawRequest) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("fetchBlocksMerkleTreeRaw", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := NodeFetchBlocksMerkleTreeRawArgs{
+		Req: req,
+	}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
+}
+
+func (p *NodeClient) recvFetchBlocksMerkleTreeRaw() (value *FetchBlocksMerkleTreeRawResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -15566,32 +19264,32 @@ func (p *NodeClient) recvAggregate() (value *AggregateQueryResult_, err error) {
 	if err != nil {
 		return
 	}
-	if method != "aggregate" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "aggregate failed: wrong method name")
+	if method != "fetchBlocksMerkleTreeRaw" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetchBlocksMerkleTreeRaw failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "aggregate failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetchBlocksMerkleTreeRaw failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error37 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error38 error
-		error38, err = error37.Read(iprot)
+		error67 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error68 error
+		error68, err = error67.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error38
+		err = error68
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "aggregate failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetchBlocksMerkleTreeRaw failed: invalid message type")
 		return
 	}
-	result := NodeAggregateResult{}
+	result := NodeFetchBlocksMerkleTreeRawResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -15608,24 +19306,24 @@ func (p *NodeClient) recvAggregate() (value *AggregateQueryResult_, err error) {
 
 // Parameters:
 //  - Req
-func (p *NodeClient) Fetch(req *FetchRequest) (r *FetchResult_, err error) {
-	if err = p.sendFetch(req); err != nil {
+func (p *NodeClient) CardinalityRaw(req *CardinalityRawRequest) (r *CardinalityRawResult_, err error) {
+	if err = p.sendCardinalityRaw(req); err != nil {
 		return
 	}
-	return p.recvFetch()
+	return p.recvCardinalityRaw()
 }
 
-func (p *NodeClient) sendFetch(req *FetchRequest) (err error) {
+func (p *NodeClient) sendCardinalityRaw(req *CardinalityRawRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("fetch", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("cardinalityRaw", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeFetchArgs{
+	args := NodeCardinalityRawArgs{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -15637,7 +19335,7 @@ func (p *NodeClient) sendFetch(req *FetchRequest) (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvFetch() (value *FetchResult_, err error) {
+func (p *NodeClient) recvCardinalityRaw() (value *CardinalityRawResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -15647,32 +19345,32 @@ func (p *NodeClient) recvFetch() (value *FetchResult_, err error) {
 	if err != nil {
 		return
 	}
-	if method != "fetch" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetch failed: wrong method name")
+	if method != "cardinalityRaw" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "cardinalityRaw failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetch failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "cardinalityRaw failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error39 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error40 error
-		error40, err = error39.Read(iprot)
+		error67 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error68 error
+		error68, err = error67.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error40
+		err = error68
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetch failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "cardinalityRaw failed: invalid message type")
 		return
 	}
-	result := NodeFetchResult{}
+	result := NodeCardinalityRawResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -15689,24 +19387,24 @@ func (p *NodeClient) recvFetch() (value *FetchResult_, err error) {
 
 // Parameters:
 //  - Req
-func (p *NodeClient) Write(req *WriteRequest) (err error) {
-	if err = p.sendWrite(req); err != nil {
+func (p *NodeClient) WriteBatchRaw(req *WriteBatchRawRequest) (err error) {
+	if err = p.sendWriteBatchRaw(req); err != nil {
 		return
 	}
-	return p.recvWrite()
+	return p.recvWriteBatchRaw()
 }
 
-func (p *NodeClient) sendWrite(req *WriteRequest) (err error) {
+func (p *NodeClient) sendWriteBatchRaw(req *WriteBatchRawRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("write", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("writeBatchRaw", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeWriteArgs{
+	args := NodeWriteBatchRawArgs{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -15718,7 +19416,7 @@ func (p *NodeClient) sendWrite(req *WriteRequest) (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvWrite() (err error) {
+func (p *NodeClient) recvWriteBatchRaw() (err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -15728,32 +19426,32 @@ func (p *NodeClient) recvWrite() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "write" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "write failed: wrong method name")
+	if method != "writeBatchRaw" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "writeBatchRaw failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "write failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "writeBatchRaw failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error41 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error42 error
-		error42, err = error41.Read(iprot)
+		error57 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error58 error
+		error58, err = error57.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error42
+		err = error58
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "write failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "writeBatchRaw failed: invalid message type")
 		return
 	}
-	result := NodeWriteResult{}
+	result := NodeWriteBatchRawResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -15769,24 +19467,24 @@ func (p *NodeClient) recvWrite() (err error) {
 
 // Parameters:
 //  - Req
-func (p *NodeClient) WriteTagged(req *WriteTaggedRequest) (err error) {
-	if err = p.sendWriteTagged(req); err != nil {
+func (p *NodeClient) WriteBatchRawV2(req *WriteBatchRawV2Request) (err error) {
+	if err = p.sendWriteBatchRawV2(req); err != nil {
 		return
 	}
-	return p.recvWriteTagged()
+	return p.recvWriteBatchRawV2()
 }
 
-func (p *NodeClient) sendWriteTagged(req *WriteTaggedRequest) (err error) {
+func (p *NodeClient) sendWriteBatchRawV2(req *WriteBatchRawV2Request) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("writeTagged", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("writeBatchRawV2", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeWriteTaggedArgs{
+	args := NodeWriteBatchRawV2Args{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -15798,7 +19496,7 @@ func (p *NodeClient) sendWriteTagged(req *WriteTaggedRequest) (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvWriteTagged() (err error) {
+func (p *NodeClient) recvWriteBatchRawV2() (err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -15808,32 +19506,32 @@ func (p *NodeClient) recvWriteTagged() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "writeTagged" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "writeTagged failed: wrong method name")
+	if method != "writeBatchRawV2" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "writeBatchRawV2 failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "writeTagged failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "writeBatchRawV2 failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error43 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error44 error
-		error44, err = error43.Read(iprot)
+		error59 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error60 error
+		error60, err = error59.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error44
+		err = error60
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "writeTagged failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "writeBatchRawV2 failed: invalid message type")
 		return
 	}
-	result := NodeWriteTaggedResult{}
+	result := NodeWriteBatchRawV2Result{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -15849,24 +19547,24 @@ func (p *NodeClient) recvWriteTagged() (err error) {
 
 // Parameters:
 //  - Req
-func (p *NodeClient) AggregateRaw(req *AggregateQueryRawRequest) (r *AggregateQueryRawResult_, err error) {
-	if err = p.sendAggregateRaw(req); err != nil {
+func (p *NodeClient) WriteTaggedBatchRaw(req *WriteTaggedBatchRawRequest) (err error) {
+	if err = p.sendWriteTaggedBatchRaw(req); err != nil {
 		return
 	}
-	return p.recvAggregateRaw()
+	return p.recvWriteTaggedBatchRaw()
 }
 
-func (p *NodeClient) sendAggregateRaw(req *AggregateQueryRawRequest) (err error) {
+func (p *NodeClient) sendWriteTaggedBatchRaw(req *WriteTaggedBatchRawRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("aggregateRaw", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("writeTaggedBatchRaw", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeAggregateRawArgs{
+	args := NodeWriteTaggedBatchRawArgs{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -15878,7 +19576,7 @@ func (p *NodeClient) sendAggregateRaw(req *AggregateQueryRawRequest) (err error)
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvAggregateRaw() (value *AggregateQueryRawResult_, err error) {
+func (p *NodeClient) recvWriteTaggedBatchRaw() (err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -15888,32 +19586,32 @@ func (p *NodeClient) recvAggregateRaw() (value *AggregateQueryRawResult_, err er
 	if err != nil {
 		return
 	}
-	if method != "aggregateRaw" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "aggregateRaw failed: wrong method name")
+	if method != "writeTaggedBatchRaw" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "writeTaggedBatchRaw failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "aggregateRaw failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "writeTaggedBatchRaw failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error45 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error46 error
-		error46, err = error45.Read(iprot)
+		error61 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error62 error
+		error62, err = error61.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error46
+		err = error62
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "aggregateRaw failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "writeTaggedBatchRaw failed: invalid message type")
 		return
 	}
-	result := NodeAggregateRawResult{}
+	result := NodeWriteTaggedBatchRawResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -15924,30 +19622,29 @@ func (p *NodeClient) recvAggregateRaw() (value *AggregateQueryRawResult_, err er
 		err = result.Err
 		return
 	}
-	value = result.GetSuccess()
 	return
 }
 
 // Parameters:
 //  - Req
-func (p *NodeClient) FetchBatchRaw(req *FetchBatchRawRequest) (r *FetchBatchRawResult_, err error) {
-	if err = p.sendFetchBatchRaw(req); err != nil {
+func (p *NodeClient) WriteTaggedBatchRawV2(req *WriteTaggedBatchRawV2Request) (err error) {
+	if err = p.sendWriteTaggedBatchRawV2(req); err != nil {
 		return
 	}
-	return p.recvFetchBatchRaw()
+	return p.recvWriteTaggedBatchRawV2()
 }
 
-func (p *NodeClient) sendFetchBatchRaw(req *FetchBatchRawRequest) (err error) {
+func (p *NodeClient) sendWriteTaggedBatchRawV2(req *WriteTaggedBatchRawV2Request) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("fetchBatchRaw", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("writeTaggedBatchRawV2", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeFetchBatchRawArgs{
+	args := NodeWriteTaggedBatchRawV2Args{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -15959,7 +19656,7 @@ func (p *NodeClient) sendFetchBatchRaw(req *FetchBatchRawRequest) (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvFetchBatchRaw() (value *FetchBatchRawResult_, err error) {
+func (p *NodeClient) recvWriteTaggedBatchRawV2() (err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -15969,32 +19666,32 @@ func (p *NodeClient) recvFetchBatchRaw() (value *FetchBatchRawResult_, err error
 	if err != nil {
 		return
 	}
-	if method != "fetchBatchRaw" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetchBatchRaw failed: wrong method name")
+	if method != "writeTaggedBatchRawV2" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "writeTaggedBatchRawV2 failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetchBatchRaw failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "writeTaggedBatchRawV2 failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error47 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error48 error
-		error48, err = error47.Read(iprot)
+		error63 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error64 error
+		error64, err = error63.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error48
+		err = error64
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetchBatchRaw failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "writeTaggedBatchRawV2 failed: invalid message type")
 		return
 	}
-	result := NodeFetchBatchRawResult{}
+	result := NodeWriteTaggedBatchRawV2Result{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -16005,32 +19702,27 @@ func (p *NodeClient) recvFetchBatchRaw() (value *FetchBatchRawResult_, err error
 		err = result.Err
 		return
 	}
-	value = result.GetSuccess()
 	return
 }
 
-// Parameters:
-//  - Req
-func (p *NodeClient) FetchBatchRawV2(req *FetchBatchRawV2Request) (r *FetchBatchRawResult_, err error) {
-	if err = p.sendFetchBatchRawV2(req); err != nil {
+func (p *NodeClient) Repair() (err error) {
+	if err = p.sendRepair(); err != nil {
 		return
 	}
-	return p.recvFetchBatchRawV2()
+	return p.recvRepair()
 }
 
-func (p *NodeClient) sendFetchBatchRawV2(req *FetchBatchRawV2Request) (err error) {
+func (p *NodeClient) sendRepair() (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("fetchBatchRawV2", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("repair", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeFetchBatchRawV2Args{
-		Req: req,
-	}
+	args := NodeRepairArgs{}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -16040,7 +19732,7 @@ func (p *NodeClient) sendFetchBatchRawV2(req *FetchBatchRawV2Request) (err error
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvFetchBatchRawV2() (value *FetchBatchRawResult_, err error) {
+func (p *NodeClient) recvRepair() (err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -16050,32 +19742,32 @@ func (p *NodeClient) recvFetchBatchRawV2() (value *FetchBatchRawResult_, err err
 	if err != nil {
 		return
 	}
-	if method != "fetchBatchRawV2" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetchBatchRawV2 failed: wrong method name")
+	if method != "repair" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "repair failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetchBatchRawV2 failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "repair failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error49 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error50 error
-		error50, err = error49.Read(iprot)
+		error65 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error66 error
+		error66, err = error65.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error50
+		err = error66
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetchBatchRawV2 failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "repair failed: invalid message type")
 		return
 	}
-	result := NodeFetchBatchRawV2Result{}
+	result := NodeRepairResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -16086,30 +19778,29 @@ func (p *NodeClient) recvFetchBatchRawV2() (value *FetchBatchRawResult_, err err
 		err = result.Err
 		return
 	}
-	value = result.GetSuccess()
 	return
 }
 
 // Parameters:
 //  - Req
-func (p *NodeClient) FetchBlocksRaw(req *FetchBlocksRawRequest) (r *FetchBlocksRawResult_, err error) {
-	if err = p.sendFetchBlocksRaw(req); err != nil {
+func (p *NodeClient) Truncate(req *TruncateRequest) (r *TruncateResult_, err error) {
+	if err = p.sendTruncate(req); err != nil {
 		return
 	}
-	return p.recvFetchBlocksRaw()
+	return p.recvTruncate()
 }
 
-func (p *NodeClient) sendFetchBlocksRaw(req *FetchBlocksRawRequest) (err error) {
+func (p *NodeClient) sendTruncate(req *TruncateRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("fetchBlocksRaw", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("truncate", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeFetchBlocksRawArgs{
+	args := NodeTruncateArgs{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -16121,7 +19812,7 @@ func (p *NodeClient) sendFetchBlocksRaw(req *FetchBlocksRawRequest) (err error)
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvFetchBlocksRaw() (value *FetchBlocksRawResult_, err error) {
+func (p *NodeClient) recvTruncate() (value *TruncateResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -16131,32 +19822,32 @@ func (p *NodeClient) recvFetchBlocksRaw() (value *FetchBlocksRawResult_, err err
 	if err != nil {
 		return
 	}
-	if method != "fetchBlocksRaw" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetchBlocksRaw failed: wrong method name")
+	if method != "truncate" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "truncate failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetchBlocksRaw failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "truncate failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error51 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error52 error
-		error52, err = error51.Read(iprot)
+		error67 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error68 error
+		error68, err = error67.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error52
+		err = error68
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetchBlocksRaw failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "truncate failed: invalid message type")
 		return
 	}
-	result := NodeFetchBlocksRawResult{}
+	result := NodeTruncateResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -16173,24 +19864,24 @@ func (p *NodeClient) recvFetchBlocksRaw() (value *FetchBlocksRawResult_, err err
 
 // Parameters:
 //  - Req
-func (p *NodeClient) FetchTagged(req *FetchTaggedRequest) (r *FetchTaggedResult_, err error) {
-	if err = p.sendFetchTagged(req); err != nil {
+func (p *NodeClient) DeleteTagged(req *DeleteTaggedRequest) (r *DeleteTaggedResult_, err error) {
+	if err = p.sendDeleteTagged(req); err != nil {
 		return
 	}
-	return p.recvFetchTagged()
+	return p.recvDeleteTagged()
 }
 
-func (p *NodeClient) sendFetchTagged(req *FetchTaggedRequest) (err error) {
+func (p *NodeClient) sendDeleteTagged(req *DeleteTaggedRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("fetchTagged", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("deleteTagged", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeFetchTaggedArgs{
+	args := NodeDeleteTaggedArgs{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -16202,7 +19893,7 @@ func (p *NodeClient) sendFetchTagged(req *FetchTaggedRequest) (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvFetchTagged() (value *FetchTaggedResult_, err error) {
+func (p *NodeClient) recvDeleteTagged() (value *DeleteTaggedResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -16212,32 +19903,32 @@ func (p *NodeClient) recvFetchTagged() (value *FetchTaggedResult_, err error) {
 	if err != nil {
 		return
 	}
-	if method != "fetchTagged" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetchTagged failed: wrong method name")
+	if method != "deleteTagged" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "deleteTagged failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetchTagged failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "deleteTagged failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error53 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error54 error
-		error54, err = error53.Read(iprot)
+		error67 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error68 error
+		error68, err = error67.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error54
+		err = error68
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetchTagged failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "deleteTagged failed: invalid message type")
 		return
 	}
-	result := NodeFetchTaggedResult{}
+	result := NodeDeleteTaggedResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -16254,24 +19945,24 @@ func (p *NodeClient) recvFetchTagged() (value *FetchTaggedResult_, err error) {
 
 // Parameters:
 //  - Req
-func (p *NodeClient) FetchBlocksMetadataRawV2(req *FetchBlocksMetadataRawV2Request) (r *FetchBlocksMetadataRawV2Result_, err error) {
-	if err = p.sendFetchBlocksMetadataRawV2(req); err != nil {
+func (p *NodeClient) AggregateTiles(req *AggregateTilesRequest) (r *AggregateTilesResult_, err error) {
+	if err = p.sendAggregateTiles(req); err != nil {
 		return
 	}
-	return p.recvFetchBlocksMetadataRawV2()
+	return p.recvAggregateTiles()
 }
 
-func (p *NodeClient) sendFetchBlocksMetadataRawV2(req *FetchBlocksMetadataRawV2Request) (err error) {
+func (p *NodeClient) sendAggregateTiles(req *AggregateTilesRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("fetchBlocksMetadataRawV2", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("aggregateTiles", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeFetchBlocksMetadataRawV2Args{
+	args := NodeAggregateTilesArgs{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -16283,7 +19974,7 @@ func (p *NodeClient) sendFetchBlocksMetadataRawV2(req *FetchBlocksMetadataRawV2R
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvFetchBlocksMetadataRawV2() (value *FetchBlocksMetadataRawV2Result_, err error) {
+func (p *NodeClient) recvAggregateTiles() (value *AggregateTilesResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -16293,32 +19984,32 @@ func (p *NodeClient) recvFetchBlocksMetadataRawV2() (value *FetchBlocksMetadataR
 	if err != nil {
 		return
 	}
-	if method != "fetchBlocksMetadataRawV2" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "fetchBlocksMetadataRawV2 failed: wrong method name")
+	if method != "aggregateTiles" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "aggregateTiles failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "fetchBlocksMetadataRawV2 failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "aggregateTiles failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error55 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error56 error
-		error56, err = error55.Read(iprot)
+		error69 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error70 error
+		error70, err = error69.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error56
+		err = error70
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "fetchBlocksMetadataRawV2 failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "aggregateTiles failed: invalid message type")
 		return
 	}
-	result := NodeFetchBlocksMetadataRawV2Result{}
+	result := NodeAggregateTilesResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -16333,28 +20024,24 @@ func (p *NodeClient) recvFetchBlocksMetadataRawV2() (value *FetchBlocksMetadataR
 	return
 }
 
-// Parameters:
-//  - Req
-func (p *NodeClient) WriteBatchRaw(req *WriteBatchRawRequest) (err error) {
-	if err = p.sendWriteBatchRaw(req); err != nil {
+func (p *NodeClient) AggregateTilesStatus() (r *AggregateTilesStatusResult_, err error) {
+	if err = p.sendAggregateTilesStatus(); err != nil {
 		return
 	}
-	return p.recvWriteBatchRaw()
+	return p.recvAggregateTilesStatus()
 }
 
-func (p *NodeClient) sendWriteBatchRaw(req *WriteBatchRawRequest) (err error) {
+func (p *NodeClient) sendAggregateTilesStatus() (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("writeBatchRaw", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("aggregateTilesStatus", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeWriteBatchRawArgs{
-		Req: req,
-	}
+	args := NodeAggregateTilesStatusArgs{}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -16364,7 +20051,7 @@ func (p *NodeClient) sendWriteBatchRaw(req *WriteBatchRawRequest) (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvWriteBatchRaw() (err error) {
+func (p *NodeClient) recvAggregateTilesStatus() (value *AggregateTilesStatusResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -16374,32 +20061,32 @@ func (p *NodeClient) recvWriteBatchRaw() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "writeBatchRaw" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "writeBatchRaw failed: wrong method name")
+	if method != "aggregateTilesStatus" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "aggregateTilesStatus failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "writeBatchRaw failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "aggregateTilesStatus failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error57 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error58 error
-		error58, err = error57.Read(iprot)
+		error71 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error72 error
+		error72, err = error71.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error58
+		err = error72
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "writeBatchRaw failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "aggregateTilesStatus failed: invalid message type")
 		return
 	}
-	result := NodeWriteBatchRawResult{}
+	result := NodeAggregateTilesStatusResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -16410,31 +20097,28 @@ func (p *NodeClient) recvWriteBatchRaw() (err error) {
 		err = result.Err
 		return
 	}
+	value = result.GetSuccess()
 	return
 }
 
-// Parameters:
-//  - Req
-func (p *NodeClient) WriteBatchRawV2(req *WriteBatchRawV2Request) (err error) {
-	if err = p.sendWriteBatchRawV2(req); err != nil {
+func (p *NodeClient) Health() (r *NodeHealthResult_, err error) {
+	if err = p.sendHealth(); err != nil {
 		return
 	}
-	return p.recvWriteBatchRawV2()
+	return p.recvHealth()
 }
 
-func (p *NodeClient) sendWriteBatchRawV2(req *WriteBatchRawV2Request) (err error) {
+func (p *NodeClient) sendHealth() (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("writeBatchRawV2", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("health", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeWriteBatchRawV2Args{
-		Req: req,
-	}
+	args := NodeHealthArgs{}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -16444,7 +20128,7 @@ func (p *NodeClient) sendWriteBatchRawV2(req *WriteBatchRawV2Request) (err error
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvWriteBatchRawV2() (err error) {
+func (p *NodeClient) recvHealth() (value *NodeHealthResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -16454,32 +20138,32 @@ func (p *NodeClient) recvWriteBatchRawV2() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "writeBatchRawV2" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "writeBatchRawV2 failed: wrong method name")
+	if method != "health" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "health failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "writeBatchRawV2 failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "health failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error59 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error60 error
-		error60, err = error59.Read(iprot)
+		error71 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error72 error
+		error72, err = error71.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error60
+		err = error72
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "writeBatchRawV2 failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "health failed: invalid message type")
 		return
 	}
-	result := NodeWriteBatchRawV2Result{}
+	result := NodeHealthResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -16490,31 +20174,28 @@ func (p *NodeClient) recvWriteBatchRawV2() (err error) {
 		err = result.Err
 		return
 	}
+	value = result.GetSuccess()
 	return
 }
 
-// Parameters:
-//  - Req
-func (p *NodeClient) WriteTaggedBatchRaw(req *WriteTaggedBatchRawRequest) (err error) {
-	if err = p.sendWriteTaggedBatchRaw(req); err != nil {
+func (p *NodeClient) Bootstrapped() (r *NodeBootstrappedResult_, err error) {
+	if err = p.sendBootstrapped(); err != nil {
 		return
 	}
-	return p.recvWriteTaggedBatchRaw()
+	return p.recvBootstrapped()
 }
 
-func (p *NodeClient) sendWriteTaggedBatchRaw(req *WriteTaggedBatchRawRequest) (err error) {
+func (p *NodeClient) sendBootstrapped() (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("writeTaggedBatchRaw", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("bootstrapped", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeWriteTaggedBatchRawArgs{
-		Req: req,
-	}
+	args := NodeBootstrappedArgs{}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -16524,7 +20205,7 @@ func (p *NodeClient) sendWriteTaggedBatchRaw(req *WriteTaggedBatchRawRequest) (e
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvWriteTaggedBatchRaw() (err error) {
+func (p *NodeClient) recvBootstrapped() (value *NodeBootstrappedResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -16534,32 +20215,32 @@ func (p *NodeClient) recvWriteTaggedBatchRaw() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "writeTaggedBatchRaw" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "writeTaggedBatchRaw failed: wrong method name")
+	if method != "bootstrapped" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "bootstrapped failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "writeTaggedBatchRaw failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "bootstrapped failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error61 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error62 error
-		error62, err = error61.Read(iprot)
+		error73 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error74 error
+		error74, err = error73.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error62
+		err = error74
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "writeTaggedBatchRaw failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "bootstrapped failed: invalid message type")
 		return
 	}
-	result := NodeWriteTaggedBatchRawResult{}
+	result := NodeBootstrappedResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -16570,31 +20251,28 @@ func (p *NodeClient) recvWriteTaggedBatchRaw() (err error) {
 		err = result.Err
 		return
 	}
+	value = result.GetSuccess()
 	return
 }
 
-// Parameters:
-//  - Req
-func (p *NodeClient) WriteTaggedBatchRawV2(req *WriteTaggedBatchRawV2Request) (err error) {
-	if err = p.sendWriteTaggedBatchRawV2(req); err != nil {
+func (p *NodeClient) BootstrappedInPlacementOrNoPlacement() (r *NodeBootstrappedInPlacementOrNoPlacementResult_, err error) {
+	if err = p.sendBootstrappedInPlacementOrNoPlacement(); err != nil {
 		return
 	}
-	return p.recvWriteTaggedBatchRawV2()
+	return p.recvBootstrappedInPlacementOrNoPlacement()
 }
 
-func (p *NodeClient) sendWriteTaggedBatchRawV2(req *WriteTaggedBatchRawV2Request) (err error) {
+func (p *NodeClient) sendBootstrappedInPlacementOrNoPlacement() (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("writeTaggedBatchRawV2", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("bootstrappedInPlacementOrNoPlacement", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeWriteTaggedBatchRawV2Args{
-		Req: req,
-	}
+	args := NodeBootstrappedInPlacementOrNoPlacementArgs{}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -16604,7 +20282,7 @@ func (p *NodeClient) sendWriteTaggedBatchRawV2(req *WriteTaggedBatchRawV2Request
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvWriteTaggedBatchRawV2() (err error) {
+func (p *NodeClient) recvBootstrappedInPlacementOrNoPlacement() (value *NodeBootstrappedInPlacementOrNoPlacementResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -16614,32 +20292,32 @@ func (p *NodeClient) recvWriteTaggedBatchRawV2() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "writeTaggedBatchRawV2" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "writeTaggedBatchRawV2 failed: wrong method name")
+	if method != "bootstrappedInPlacementOrNoPlacement" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "bootstrappedInPlacementOrNoPlacement failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "writeTaggedBatchRawV2 failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "bootstrappedInPlacementOrNoPlacement failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error63 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error64 error
-		error64, err = error63.Read(iprot)
+		error75 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error76 error
+		error76, err = error75.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error64
+		err = error76
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "writeTaggedBatchRawV2 failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "bootstrappedInPlacementOrNoPlacement failed: invalid message type")
 		return
 	}
-	result := NodeWriteTaggedBatchRawV2Result{}
+	result := NodeBootstrappedInPlacementOrNoPlacementResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -16650,27 +20328,28 @@ func (p *NodeClient) recvWriteTaggedBatchRawV2() (err error) {
 		err = result.Err
 		return
 	}
+	value = result.GetSuccess()
 	return
 }
 
-func (p *NodeClient) Repair() (err error) {
-	if err = p.sendRepair(); err != nil {
+func (p *NodeClient) GetPersistRateLimit() (r *NodePersistRateLimitResult_, err error) {
+	if err = p.sendGetPersistRateLimit(); err != nil {
 		return
 	}
-	return p.recvRepair()
+	return p.recvGetPersistRateLimit()
 }
 
-func (p *NodeClient) sendRepair() (err error) {
+func (p *NodeClient) sendGetPersistRateLimit() (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("repair", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("getPersistRateLimit", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeRepairArgs{}
+	args := NodeGetPersistRateLimitArgs{}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -16680,7 +20359,7 @@ func (p *NodeClient) sendRepair() (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvRepair() (err error) {
+func (p *NodeClient) recvGetPersistRateLimit() (value *NodePersistRateLimitResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -16690,32 +20369,32 @@ func (p *NodeClient) recvRepair() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "repair" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "repair failed: wrong method name")
+	if method != "getPersistRateLimit" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "getPersistRateLimit failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "repair failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "getPersistRateLimit failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error65 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error66 error
-		error66, err = error65.Read(iprot)
+		error77 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error78 error
+		error78, err = error77.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error66
+		err = error78
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "repair failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "getPersistRateLimit failed: invalid message type")
 		return
 	}
-	result := NodeRepairResult{}
+	result := NodeGetPersistRateLimitResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -16726,29 +20405,30 @@ func (p *NodeClient) recvRepair() (err error) {
 		err = result.Err
 		return
 	}
+	value = result.GetSuccess()
 	return
 }
 
 // Parameters:
 //  - Req
-func (p *NodeClient) Truncate(req *TruncateRequest) (r *TruncateResult_, err error) {
-	if err = p.sendTruncate(req); err != nil {
+func (p *NodeClient) SetPersistRateLimit(req *NodeSetPersistRateLimitRequest) (r *NodePersistRateLimitResult_, err error) {
+	if err = p.sendSetPersistRateLimit(req); err != nil {
 		return
 	}
-	return p.recvTruncate()
+	return p.recvSetPersistRateLimit()
 }
 
-func (p *NodeClient) sendTruncate(req *TruncateRequest) (err error) {
+func (p *NodeClient) sendSetPersistRateLimit(req *NodeSetPersistRateLimitRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("truncate", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("setPersistRateLimit", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeTruncateArgs{
+	args := NodeSetPersistRateLimitArgs{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -16760,7 +20440,7 @@ func (p *NodeClient) sendTruncate(req *TruncateRequest) (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvTruncate() (value *TruncateResult_, err error) {
+func (p *NodeClient) recvSetPersistRateLimit() (value *NodePersistRateLimitResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -16770,32 +20450,32 @@ func (p *NodeClient) recvTruncate() (value *TruncateResult_, err error) {
 	if err != nil {
 		return
 	}
-	if method != "truncate" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "truncate failed: wrong method name")
+	if method != "setPersistRateLimit" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "setPersistRateLimit failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "truncate failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "setPersistRateLimit failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error67 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error68 error
-		error68, err = error67.Read(iprot)
+		error79 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error80 error
+		error80, err = error79.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error68
+		err = error80
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "truncate failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "setPersistRateLimit failed: invalid message type")
 		return
 	}
-	result := NodeTruncateResult{}
+	result := NodeSetPersistRateLimitResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -16810,28 +20490,24 @@ func (p *NodeClient) recvTruncate() (value *TruncateResult_, err error) {
 	return
 }
 
-// Parameters:
-//  - Req
-func (p *NodeClient) AggregateTiles(req *AggregateTilesRequest) (r *AggregateTilesResult_, err error) {
-	if err = p.sendAggregateTiles(req); err != nil {
+func (p *NodeClient) GetWriteNewSeriesAsync() (r *NodeWriteNewSeriesAsyncResult_, err error) {
+	if err = p.sendGetWriteNewSeriesAsync(); err != nil {
 		return
 	}
-	return p.recvAggregateTiles()
+	return p.recvGetWriteNewSeriesAsync()
 }
 
-func (p *NodeClient) sendAggregateTiles(req *AggregateTilesRequest) (err error) {
+func (p *NodeClient) sendGetWriteNewSeriesAsync() (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("aggregateTiles", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("getWriteNewSeriesAsync", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeAggregateTilesArgs{
-		Req: req,
-	}
+	args := NodeGetWriteNewSeriesAsyncArgs{}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -16841,7 +20517,7 @@ func (p *NodeClient) sendAggregateTiles(req *AggregateTilesRequest) (err error)
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvAggregateTiles() (value *AggregateTilesResult_, err error) {
+func (p *NodeClient) recvGetWriteNewSeriesAsync() (value *NodeWriteNewSeriesAsyncResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -16851,32 +20527,32 @@ func (p *NodeClient) recvAggregateTiles() (value *AggregateTilesResult_, err err
 	if err != nil {
 		return
 	}
-	if method != "aggregateTiles" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "aggregateTiles failed: wrong method name")
+	if method != "getWriteNewSeriesAsync" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "getWriteNewSeriesAsync failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "aggregateTiles failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "getWriteNewSeriesAsync failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error69 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error70 error
-		error70, err = error69.Read(iprot)
+		error81 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error82 error
+		error82, err = error81.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error70
+		err = error82
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "aggregateTiles failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "getWriteNewSeriesAsync failed: invalid message type")
 		return
 	}
-	result := NodeAggregateTilesResult{}
+	result := NodeGetWriteNewSeriesAsyncResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -16891,24 +20567,28 @@ func (p *NodeClient) recvAggregateTiles() (value *AggregateTilesResult_, err err
 	return
 }
 
-func (p *NodeClient) Health() (r *NodeHealthResult_, err error) {
-	if err = p.sendHealth(); err != nil {
+// Parameters:
+//  - Req
+func (p *NodeClient) SetWriteNewSeriesAsync(req *NodeSetWriteNewSeriesAsyncRequest) (r *NodeWriteNewSeriesAsyncResult_, err error) {
+	if err = p.sendSetWriteNewSeriesAsync(req); err != nil {
 		return
 	}
-	return p.recvHealth()
+	return p.recvSetWriteNewSeriesAsync()
 }
 
-func (p *NodeClient) sendHealth() (err error) {
+func (p *NodeClient) sendSetWriteNewSeriesAsync(req *NodeSetWriteNewSeriesAsyncRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("health", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("setWriteNewSeriesAsync", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeHealthArgs{}
+	args := NodeSetWriteNewSeriesAsyncArgs{
+		Req: req,
+	}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -16918,7 +20598,7 @@ func (p *NodeClient) sendHealth() (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvHealth() (value *NodeHealthResult_, err error) {
+func (p *NodeClient) recvSetWriteNewSeriesAsync() (value *NodeWriteNewSeriesAsyncResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -16928,32 +20608,32 @@ func (p *NodeClient) recvHealth() (value *NodeHealthResult_, err error) {
 	if err != nil {
 		return
 	}
-	if method != "health" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "health failed: wrong method name")
+	if method != "setWriteNewSeriesAsync" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "setWriteNewSeriesAsync failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "health failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "setWriteNewSeriesAsync failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error71 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error72 error
-		error72, err = error71.Read(iprot)
+		error83 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error84 error
+		error84, err = error83.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error72
+		err = error84
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "health failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "setWriteNewSeriesAsync failed: invalid message type")
 		return
 	}
-	result := NodeHealthResult{}
+	result := NodeSetWriteNewSeriesAsyncResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -16968,24 +20648,24 @@ func (p *NodeClient) recvHealth() (value *NodeHealthResult_, err error) {
 	return
 }
 
-func (p *NodeClient) Bootstrapped() (r *NodeBootstrappedResult_, err error) {
-	if err = p.sendBootstrapped(); err != nil {
+func (p *NodeClient) GetWriteNewSeriesBackoffDuration() (r *NodeWriteNewSeriesBackoffDurationResult_, err error) {
+	if err = p.sendGetWriteNewSeriesBackoffDuration(); err != nil {
 		return
 	}
-	return p.recvBootstrapped()
+	return p.recvGetWriteNewSeriesBackoffDuration()
 }
 
-func (p *NodeClient) sendBootstrapped() (err error) {
+func (p *NodeClient) sendGetWriteNewSeriesBackoffDuration() (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("bootstrapped", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("getWriteNewSeriesBackoffDuration", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeBootstrappedArgs{}
+	args := NodeGetWriteNewSeriesBackoffDurationArgs{}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -16995,7 +20675,7 @@ func (p *NodeClient) sendBootstrapped() (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvBootstrapped() (value *NodeBootstrappedResult_, err error) {
+func (p *NodeClient) recvGetWriteNewSeriesBackoffDuration() (value *NodeWriteNewSeriesBackoffDurationResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -17005,32 +20685,32 @@ func (p *NodeClient) recvBootstrapped() (value *NodeBootstrappedResult_, err err
 	if err != nil {
 		return
 	}
-	if method != "bootstrapped" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "bootstrapped failed: wrong method name")
+	if method != "getWriteNewSeriesBackoffDuration" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "getWriteNewSeriesBackoffDuration failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "bootstrapped failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "getWriteNewSeriesBackoffDuration failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error73 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error74 error
-		error74, err = error73.Read(iprot)
+		error85 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error86 error
+		error86, err = error85.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error74
+		err = error86
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "bootstrapped failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "getWriteNewSeriesBackoffDuration failed: invalid message type")
 		return
 	}
-	result := NodeBootstrappedResult{}
+	result := NodeGetWriteNewSeriesBackoffDurationResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -17045,24 +20725,28 @@ func (p *NodeClient) recvBootstrapped() (value *NodeBootstrappedResult_, err err
 	return
 }
 
-func (p *NodeClient) BootstrappedInPlacementOrNoPlacement() (r *NodeBootstrappedInPlacementOrNoPlacementResult_, err error) {
-	if err = p.sendBootstrappedInPlacementOrNoPlacement(); err != nil {
+// Parameters:
+//  - Req
+func (p *NodeClient) SetWriteNewSeriesBackoffDuration(req *NodeSetWriteNewSeriesBackoffDurationRequest) (r *NodeWriteNewSeriesBackoffDurationResult_, err error) {
+	if err = p.sendSetWriteNewSeriesBackoffDuration(req); err != nil {
 		return
 	}
-	return p.recvBootstrappedInPlacementOrNoPlacement()
+	return p.recvSetWriteNewSeriesBackoffDuration()
 }
 
-func (p *NodeClient) sendBootstrappedInPlacementOrNoPlacement() (err error) {
+func (p *NodeClient) sendSetWriteNewSeriesBackoffDuration(req *NodeSetWriteNewSeriesBackoffDurationRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("bootstrappedInPlacementOrNoPlacement", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("setWriteNewSeriesBackoffDuration", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeBootstrappedInPlacementOrNoPlacementArgs{}
+	args := NodeSetWriteNewSeriesBackoffDurationArgs{
+		Req: req,
+	}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -17072,7 +20756,7 @@ func (p *NodeClient) sendBootstrappedInPlacementOrNoPlacement() (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvBootstrappedInPlacementOrNoPlacement() (value *NodeBootstrappedInPlacementOrNoPlacementResult_, err error) {
+func (p *NodeClient) recvSetWriteNewSeriesBackoffDuration() (value *NodeWriteNewSeriesBackoffDurationResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -17082,32 +20766,32 @@ func (p *NodeClient) recvBootstrappedInPlacementOrNoPlacement() (value *NodeBoot
 	if err != nil {
 		return
 	}
-	if method != "bootstrappedInPlacementOrNoPlacement" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "bootstrappedInPlacementOrNoPlacement failed: wrong method name")
+	if method != "setWriteNewSeriesBackoffDuration" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "setWriteNewSeriesBackoffDuration failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "bootstrappedInPlacementOrNoPlacement failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "setWriteNewSeriesBackoffDuration failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error75 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error76 error
-		error76, err = error75.Read(iprot)
+		error87 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error88 error
+		error88, err = error87.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error76
+		err = error88
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "bootstrappedInPlacementOrNoPlacement failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "setWriteNewSeriesBackoffDuration failed: invalid message type")
 		return
 	}
-	result := NodeBootstrappedInPlacementOrNoPlacementResult{}
+	result := NodeSetWriteNewSeriesBackoffDurationResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -17122,24 +20806,24 @@ func (p *NodeClient) recvBootstrappedInPlacementOrNoPlacement() (value *NodeBoot
 	return
 }
 
-func (p *NodeClient) GetPersistRateLimit() (r *NodePersistRateLimitResult_, err error) {
-	if err = p.sendGetPersistRateLimit(); err != nil {
+func (p *NodeClient) GetWriteNewSeriesLimitPerShardPerSecond() (r *NodeWriteNewSeriesLimitPerShardPerSecondResult_, err error) {
+	if err = p.sendGetWriteNewSeriesLimitPerShardPerSecond(); err != nil {
 		return
 	}
-	return p.recvGetPersistRateLimit()
+	return p.recvGetWriteNewSeriesLimitPerShardPerSecond()
 }
 
-func (p *NodeClient) sendGetPersistRateLimit() (err error) {
+func (p *NodeClient) sendGetWriteNewSeriesLimitPerShardPerSecond() (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("getPersistRateLimit", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("getWriteNewSeriesLimitPerShardPerSecond", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeGetPersistRateLimitArgs{}
+	args := NodeGetWriteNewSeriesLimitPerShardPerSecondArgs{}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -17149,7 +20833,7 @@ func (p *NodeClient) sendGetPersistRateLimit() (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvGetPersistRateLimit() (value *NodePersistRateLimitResult_, err error) {
+func (p *NodeClient) recvGetWriteNewSeriesLimitPerShardPerSecond() (value *NodeWriteNewSeriesLimitPerShardPerSecondResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -17159,32 +20843,32 @@ func (p *NodeClient) recvGetPersistRateLimit() (value *NodePersistRateLimitResul
 	if err != nil {
 		return
 	}
-	if method != "getPersistRateLimit" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "getPersistRateLimit failed: wrong method name")
+	if method != "getWriteNewSeriesLimitPerShardPerSecond" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "getWriteNewSeriesLimitPerShardPerSecond failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "getPersistRateLimit failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "getWriteNewSeriesLimitPerShardPerSecond failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error77 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error78 error
-		error78, err = error77.Read(iprot)
+		error89 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error90 error
+		error90, err = error89.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error78
+		err = error90
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "getPersistRateLimit failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "getWriteNewSeriesLimitPerShardPerSecond failed: invalid message type")
 		return
 	}
-	result := NodeGetPersistRateLimitResult{}
+	result := NodeGetWriteNewSeriesLimitPerShardPerSecondResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -17201,24 +20885,24 @@ func (p *NodeClient) recvGetPersistRateLimit() (value *NodePersistRateLimitResul
 
 // Parameters:
 //  - Req
-func (p *NodeClient) SetPersistRateLimit(req *NodeSetPersistRateLimitRequest) (r *NodePersistRateLimitResult_, err error) {
-	if err = p.sendSetPersistRateLimit(req); err != nil {
+func (p *NodeClient) SetWriteNewSeriesLimitPerShardPerSecond(req *NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) (r *NodeWriteNewSeriesLimitPerShardPerSecondResult_, err error) {
+	if err = p.sendSetWriteNewSeriesLimitPerShardPerSecond(req); err != nil {
 		return
 	}
-	return p.recvSetPersistRateLimit()
+	return p.recvSetWriteNewSeriesLimitPerShardPerSecond()
 }
 
-func (p *NodeClient) sendSetPersistRateLimit(req *NodeSetPersistRateLimitRequest) (err error) {
+func (p *NodeClient) sendSetWriteNewSeriesLimitPerShardPerSecond(req *NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("setPersistRateLimit", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("setWriteNewSeriesLimitPerShardPerSecond", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeSetPersistRateLimitArgs{
+	args := NodeSetWriteNewSeriesLimitPerShardPerSecondArgs{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -17230,7 +20914,7 @@ func (p *NodeClient) sendSetPersistRateLimit(req *NodeSetPersistRateLimitRequest
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvSetPersistRateLimit() (value *NodePersistRateLimitResult_, err error) {
+func (p *NodeClient) recvSetWriteNewSeriesLimitPerShardPerSecond() (value *NodeWriteNewSeriesLimitPerShardPerSecondResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -17240,32 +20924,32 @@ func (p *NodeClient) recvSetPersistRateLimit() (value *NodePersistRateLimitResul
 	if err != nil {
 		return
 	}
-	if method != "setPersistRateLimit" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "setPersistRateLimit failed: wrong method name")
+	if method != "setWriteNewSeriesLimitPerShardPerSecond" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "setWriteNewSeriesLimitPerShardPerSecond failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "setPersistRateLimit failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "setWriteNewSeriesLimitPerShardPerSecond failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error79 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error80 error
-		error80, err = error79.Read(iprot)
+		error91 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error92 error
+		error92, err = error91.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error80
+		err = error92
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "setPersistRateLimit failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "setWriteNewSeriesLimitPerShardPerSecond failed: invalid message type")
 		return
 	}
-	result := NodeSetPersistRateLimitResult{}
+	result := NodeSetWriteNewSeriesLimitPerShardPerSecondResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -17280,24 +20964,28 @@ func (p *NodeClient) recvSetPersistRateLimit() (value *NodePersistRateLimitResul
 	return
 }
 
-func (p *NodeClient) GetWriteNewSeriesAsync() (r *NodeWriteNewSeriesAsyncResult_, err error) {
-	if err = p.sendGetWriteNewSeriesAsync(); err != nil {
+// Parameters:
+//  - Req
+func (p *NodeClient) DebugProfileStart(req *DebugProfileStartRequest) (r *DebugProfileStartResult_, err error) {
+	if err = p.sendDebugProfileStart(req); err != nil {
 		return
 	}
-	return p.recvGetWriteNewSeriesAsync()
+	return p.recvDebugProfileStart()
 }
 
-func (p *NodeClient) sendGetWriteNewSeriesAsync() (err error) {
+func (p *NodeClient) sendDebugProfileStart(req *DebugProfileStartRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("getWriteNewSeriesAsync", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("debugProfileStart", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeGetWriteNewSeriesAsyncArgs{}
+	args := NodeDebugProfileStartArgs{
+		Req: req,
+	}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -17307,7 +20995,7 @@ func (p *NodeClient) sendGetWriteNewSeriesAsync() (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvGetWriteNewSeriesAsync() (value *NodeWriteNewSeriesAsyncResult_, err error) {
+func (p *NodeClient) recvDebugProfileStart() (value *DebugProfileStartResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -17317,32 +21005,32 @@ func (p *NodeClient) recvGetWriteNewSeriesAsync() (value *NodeWriteNewSeriesAsyn
 	if err != nil {
 		return
 	}
-	if method != "getWriteNewSeriesAsync" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "getWriteNewSeriesAsync failed: wrong method name")
+	if method != "debugProfileStart" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "debugProfileStart failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "getWriteNewSeriesAsync failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "debugProfileStart failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error81 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error82 error
-		error82, err = error81.Read(iprot)
+		error93 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error94 error
+		error94, err = error93.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error82
+		err = error94
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "getWriteNewSeriesAsync failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "debugProfileStart failed: invalid message type")
 		return
 	}
-	result := NodeGetWriteNewSeriesAsyncResult{}
+	result := NodeDebugProfileStartResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -17359,24 +21047,24 @@ func (p *NodeClient) recvGetWriteNewSeriesAsync() (value *NodeWriteNewSeriesAsyn
 
 // Parameters:
 //  - Req
-func (p *NodeClient) SetWriteNewSeriesAsync(req *NodeSetWriteNewSeriesAsyncRequest) (r *NodeWriteNewSeriesAsyncResult_, err error) {
-	if err = p.sendSetWriteNewSeriesAsync(req); err != nil {
+func (p *NodeClient) DebugProfileStop(req *DebugProfileStopRequest) (r *DebugProfileStopResult_, err error) {
+	if err = p.sendDebugProfileStop(req); err != nil {
 		return
 	}
-	return p.recvSetWriteNewSeriesAsync()
+	return p.recvDebugProfileStop()
 }
 
-func (p *NodeClient) sendSetWriteNewSeriesAsync(req *NodeSetWriteNewSeriesAsyncRequest) (err error) {
+func (p *NodeClient) sendDebugProfileStop(req *DebugProfileStopRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("setWriteNewSeriesAsync", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("debugProfileStop", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeSetWriteNewSeriesAsyncArgs{
+	args := NodeDebugProfileStopArgs{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -17388,7 +21076,7 @@ func (p *NodeClient) sendSetWriteNewSeriesAsync(req *NodeSetWriteNewSeriesAsyncR
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvSetWriteNewSeriesAsync() (value *NodeWriteNewSeriesAsyncResult_, err error) {
+func (p *NodeClient) recvDebugProfileStop() (value *DebugProfileStopResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -17398,32 +21086,32 @@ func (p *NodeClient) recvSetWriteNewSeriesAsync() (value *NodeWriteNewSeriesAsyn
 	if err != nil {
 		return
 	}
-	if method != "setWriteNewSeriesAsync" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "setWriteNewSeriesAsync failed: wrong method name")
+	if method != "debugProfileStop" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "debugProfileStop failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "setWriteNewSeriesAsync failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "debugProfileStop failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error83 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error84 error
-		error84, err = error83.Read(iprot)
+		error95 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error96 error
+		error96, err = error95.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error84
+		err = error96
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "setWriteNewSeriesAsync failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "debugProfileStop failed: invalid message type")
 		return
 	}
-	result := NodeSetWriteNewSeriesAsyncResult{}
+	result := NodeDebugProfileStopResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -17438,24 +21126,28 @@ func (p *NodeClient) recvSetWriteNewSeriesAsync() (value *NodeWriteNewSeriesAsyn
 	return
 }
 
-func (p *NodeClient) GetWriteNewSeriesBackoffDuration() (r *NodeWriteNewSeriesBackoffDurationResult_, err error) {
-	if err = p.sendGetWriteNewSeriesBackoffDuration(); err != nil {
+// Parameters:
+//  - Req
+func (p *NodeClient) DebugIndexMemorySegments(req *DebugIndexMemorySegmentsRequest) (r *DebugIndexMemorySegmentsResult_, err error) {
+	if err = p.sendDebugIndexMemorySegments(req); err != nil {
 		return
 	}
-	return p.recvGetWriteNewSeriesBackoffDuration()
+	return p.recvDebugIndexMemorySegments()
 }
 
-func (p *NodeClient) sendGetWriteNewSeriesBackoffDuration() (err error) {
+func (p *NodeClient) sendDebugIndexMemorySegments(req *DebugIndexMemorySegmentsRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("getWriteNewSeriesBackoffDuration", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("debugIndexMemorySegments", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := NodeGetWriteNewSeriesBackoffDurationArgs{}
+	args := NodeDebugIndexMemorySegmentsArgs{
+		Req: req,
+	}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -17465,7 +21157,7 @@ func (p *NodeClient) sendGetWriteNewSeriesBackoffDuration() (err error) {
 	return oprot.Flush()
 }
 
-func (p *NodeClient) recvGetWriteNewSeriesBackoffDuration() (value *NodeWriteNewSeriesBackoffDurationResult_, err error) {
+func (p *NodeClient) recvDebugIndexMemorySegments() (value *DebugIndexMemorySegmentsResult_, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -17475,32 +21167,32 @@ func (p *NodeClient) recvGetWriteNewSeriesBackoffDuration() (value *NodeWriteNew
 	if err != nil {
 		return
 	}
-	if method != "getWriteNewSeriesBackoffDuration" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "getWriteNewSeriesBackoffDuration failed: wrong method name")
+	if method != "debugIndexMemorySegments" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "debugIndexMemorySegments failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "getWriteNewSeriesBackoffDuration failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "debugIndexMemorySegments failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error85 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error86 error
-		error86, err = error85.Read(iprot)
+		error97 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error98 error
+		error98, err = error97.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error86
+		err = error98
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "getWriteNewSeriesBackoffDuration failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "debugIndexMemorySegments failed: invalid message type")
 		return
 	}
-	result := NodeGetWriteNewSeriesBackoffDurationResult{}
+	result := NodeDebugIndexMemorySegmentsResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -17515,573 +21207,932 @@ func (p *NodeClient) recvGetWriteNewSeriesBackoffDuration() (value *NodeWriteNew
 	return
 }
 
-// Parameters:
-//  - Req
-func (p *NodeClient) SetWriteNewSeriesBackoffDuration(req *NodeSetWriteNewSeriesBackoffDurationRequest) (r *NodeWriteNewSeriesBackoffDurationResult_, err error) {
-	if err = p.sendSetWriteNewSeriesBackoffDuration(req); err != nil {
-		return
+type NodeProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      Node
+}
+
+func (p *NodeProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *NodeProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *NodeProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewNodeProcessor(handler Node) *NodeProcessor {
+
+	self99 := &NodeProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self99.processorMap["query"] = &nodeProcessorQuery{handler: handler}
+	self99.processorMap["aggregate"] = &nodeProcessorAggregate{handler: handler}
+	self99.processorMap["fetch"] = &nodeProcessorFetch{handler: handler}
+	self99.processorMap["write"] = &nodeProcessorWrite{handler: handler}
+	self99.processorMap["writeTagged"] = &nodeProcessorWriteTagged{handler: handler}
+	self99.processorMap["aggregateRaw"] = &nodeProcessorAggregateRaw{handler: handler}
+	self99.processorMap["fetchBatchRaw"] = &nodeProcessorFetchBatchRaw{handler: handler}
+	self99.processorMap["fetchBatchRawV2"] = &nodeProcessorFetchBatchRawV2{handler: handler}
+	self99.processorMap["fetchBlocksRaw"] = &nodeProcessorFetchBlocksRaw{handler: handler}
+	self99.processorMap["fetchTagged"] = &nodeProcessorFetchTagged{handler: handler}
+	self99.processorMap["fetchTaggedStream"] = &nodeProcessorFetchTaggedStream{handler: handler}
+	self99.processorMap["fetchBlocksMetadataRawV2"] = &nodeProcessorFetchBlocksMetadataRawV2{handler: handler}
+	self99.processorMap["fetchBlocksMerkleTreeRaw"] = &nodeProcessorFetchBlocksMerkleTreeRaw{handler: handler}
+	self99.processorMap["cardinalityRaw"] = &nodeProcessorCardinalityRaw{handler: handler}
+	self99.processorMap["writeBatchRaw"] = &nodeProcessorWriteBatchRaw{handler: handler}
+	self99.processorMap["writeBatchRawV2"] = &nodeProcessorWriteBatchRawV2{handler: handler}
+	self99.processorMap["writeTaggedBatchRaw"] = &nodeProcessorWriteTaggedBatchRaw{handler: handler}
+	self99.processorMap["writeTaggedBatchRawV2"] = &nodeProcessorWriteTaggedBatchRawV2{handler: handler}
+	self99.processorMap["repair"] = &nodeProcessorRepair{handler: handler}
+	self99.processorMap["truncate"] = &nodeProcessorTruncate{handler: handler}
+	self99.processorMap["deleteTagged"] = &nodeProcessorDeleteTagged{handler: handler}
+	self99.processorMap["aggregateTiles"] = &nodeProcessorAggregateTiles{handler: handler}
+	self99.processorMap["aggregateTilesStatus"] = &nodeProcessorAggregateTilesStatus{handler: handler}
+	self99.processorMap["health"] = &nodeProcessorHealth{handler: handler}
+	self99.processorMap["bootstrapped"] = &nodeProcessorBootstrapped{handler: handler}
+	self99.processorMap["bootstrappedInPlacementOrNoPlacement"] = &nodeProcessorBootstrappedInPlacementOrNoPlacement{handler: handler}
+	self99.processorMap["getPersistRateLimit"] = &nodeProcessorGetPersistRateLimit{handler: handler}
+	self99.processorMap["setPersistRateLimit"] = &nodeProcessorSetPersistRateLimit{handler: handler}
+	self99.processorMap["getWriteNewSeriesAsync"] = &nodeProcessorGetWriteNewSeriesAsync{handler: handler}
+	self99.processorMap["setWriteNewSeriesAsync"] = &nodeProcessorSetWriteNewSeriesAsync{handler: handler}
+	self99.processorMap["getWriteNewSeriesBackoffDuration"] = &nodeProcessorGetWriteNewSeriesBackoffDuration{handler: handler}
+	self99.processorMap["setWriteNewSeriesBackoffDuration"] = &nodeProcessorSetWriteNewSeriesBackoffDuration{handler: handler}
+	self99.processorMap["getWriteNewSeriesLimitPerShardPerSecond"] = &nodeProcessorGetWriteNewSeriesLimitPerShardPerSecond{handler: handler}
+	self99.processorMap["setWriteNewSeriesLimitPerShardPerSecond"] = &nodeProcessorSetWriteNewSeriesLimitPerShardPerSecond{handler: handler}
+	self99.processorMap["debugProfileStart"] = &nodeProcessorDebugProfileStart{handler: handler}
+	self99.processorMap["debugProfileStop"] = &nodeProcessorDebugProfileStop{handler: handler}
+	self99.processorMap["debugIndexMemorySegments"] = &nodeProcessorDebugIndexMemorySegments{handler: handler}
+	return self99
+}
+
+func (p *NodeProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
 	}
-	return p.recvSetWriteNewSeriesBackoffDuration()
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x100 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x100.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush()
+	return false, x100
+
 }
 
-func (p *NodeClient) sendSetWriteNewSeriesBackoffDuration(req *NodeSetWriteNewSeriesBackoffDurationRequest) (err error) {
-	oprot := p.OutputProtocol
-	if oprot == nil {
-		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-		p.OutputProtocol = oprot
+type nodeProcessorQuery struct {
+	handler Node
+}
+
+func (p *nodeProcessorQuery) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeQueryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("query", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
 	}
-	p.SeqId++
-	if err = oprot.WriteMessageBegin("setWriteNewSeriesBackoffDuration", thrift.CALL, p.SeqId); err != nil {
-		return
+
+	iprot.ReadMessageEnd()
+	result := NodeQueryResult{}
+	var retval *QueryResult_
+	var err2 error
+	if retval, err2 = p.handler.Query(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing query: "+err2.Error())
+			oprot.WriteMessageBegin("query", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	} else {
+		result.Success = retval
 	}
-	args := NodeSetWriteNewSeriesBackoffDurationArgs{
-		Req: req,
+	if err2 = oprot.WriteMessageBegin("query", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	if err = args.Write(oprot); err != nil {
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	if err = oprot.WriteMessageEnd(); err != nil {
+	return true, err
+}
+
+type nodeProcessorAggregate struct {
+	handler Node
+}
+
+func (p *nodeProcessorAggregate) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeAggregateArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("aggregate", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := NodeAggregateResult{}
+	var retval *AggregateQueryResult_
+	var err2 error
+	if retval, err2 = p.handler.Aggregate(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing aggregate: "+err2.Error())
+			oprot.WriteMessageBegin("aggregate", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("aggregate", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return oprot.Flush()
+	return true, err
 }
 
-func (p *NodeClient) recvSetWriteNewSeriesBackoffDuration() (value *NodeWriteNewSeriesBackoffDurationResult_, err error) {
-	iprot := p.InputProtocol
-	if iprot == nil {
-		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-		p.InputProtocol = iprot
+type nodeProcessorFetch struct {
+	handler Node
+}
+
+func (p *nodeProcessorFetch) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeFetchArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("fetch", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := NodeFetchResult{}
+	var retval *FetchResult_
+	var err2 error
+	if retval, err2 = p.handler.Fetch(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetch: "+err2.Error())
+			oprot.WriteMessageBegin("fetch", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("fetch", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	if method != "setWriteNewSeriesBackoffDuration" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "setWriteNewSeriesBackoffDuration failed: wrong method name")
-		return
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
 	}
-	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "setWriteNewSeriesBackoffDuration failed: out of sequence response")
+	if err != nil {
 		return
 	}
-	if mTypeId == thrift.EXCEPTION {
-		error87 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error88 error
-		error88, err = error87.Read(iprot)
-		if err != nil {
-			return
-		}
-		if err = iprot.ReadMessageEnd(); err != nil {
-			return
+	return true, err
+}
+
+type nodeProcessorWrite struct {
+	handler Node
+}
+
+func (p *nodeProcessorWrite) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeWriteArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("write", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := NodeWriteResult{}
+	var err2 error
+	if err2 = p.handler.Write(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing write: "+err2.Error())
+			oprot.WriteMessageBegin("write", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
 		}
-		err = error88
-		return
 	}
-	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "setWriteNewSeriesBackoffDuration failed: invalid message type")
-		return
+	if err2 = oprot.WriteMessageBegin("write", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	result := NodeSetWriteNewSeriesBackoffDurationResult{}
-	if err = result.Read(iprot); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	if err = iprot.ReadMessageEnd(); err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	if result.Err != nil {
-		err = result.Err
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	value = result.GetSuccess()
-	return
+	return true, err
 }
 
-func (p *NodeClient) GetWriteNewSeriesLimitPerShardPerSecond() (r *NodeWriteNewSeriesLimitPerShardPerSecondResult_, err error) {
-	if err = p.sendGetWriteNewSeriesLimitPerShardPerSecond(); err != nil {
-		return
-	}
-	return p.recvGetWriteNewSeriesLimitPerShardPerSecond()
+type nodeProcessorWriteTagged struct {
+	handler Node
 }
 
-func (p *NodeClient) sendGetWriteNewSeriesLimitPerShardPerSecond() (err error) {
-	oprot := p.OutputProtocol
-	if oprot == nil {
-		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-		p.OutputProtocol = oprot
+func (p *nodeProcessorWriteTagged) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeWriteTaggedArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("writeTagged", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
 	}
-	p.SeqId++
-	if err = oprot.WriteMessageBegin("getWriteNewSeriesLimitPerShardPerSecond", thrift.CALL, p.SeqId); err != nil {
-		return
+
+	iprot.ReadMessageEnd()
+	result := NodeWriteTaggedResult{}
+	var err2 error
+	if err2 = p.handler.WriteTagged(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing writeTagged: "+err2.Error())
+			oprot.WriteMessageBegin("writeTagged", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
 	}
-	args := NodeGetWriteNewSeriesLimitPerShardPerSecondArgs{}
-	if err = args.Write(oprot); err != nil {
-		return
+	if err2 = oprot.WriteMessageBegin("writeTagged", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	if err = oprot.WriteMessageEnd(); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	return oprot.Flush()
-}
-
-func (p *NodeClient) recvGetWriteNewSeriesLimitPerShardPerSecond() (value *NodeWriteNewSeriesLimitPerShardPerSecondResult_, err error) {
-	iprot := p.InputProtocol
-	if iprot == nil {
-		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-		p.InputProtocol = iprot
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
 	}
-	if method != "getWriteNewSeriesLimitPerShardPerSecond" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "getWriteNewSeriesLimitPerShardPerSecond failed: wrong method name")
+	if err != nil {
 		return
 	}
-	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "getWriteNewSeriesLimitPerShardPerSecond failed: out of sequence response")
-		return
+	return true, err
+}
+
+type nodeProcessorAggregateRaw struct {
+	handler Node
+}
+
+func (p *nodeProcessorAggregateRaw) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeAggregateRawArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("aggregateRaw", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
 	}
-	if mTypeId == thrift.EXCEPTION {
-		error89 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error90 error
-		error90, err = error89.Read(iprot)
-		if err != nil {
-			return
-		}
-		if err = iprot.ReadMessageEnd(); err != nil {
-			return
+
+	iprot.ReadMessageEnd()
+	result := NodeAggregateRawResult{}
+	var retval *AggregateQueryRawResult_
+	var err2 error
+	if retval, err2 = p.handler.AggregateRaw(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing aggregateRaw: "+err2.Error())
+			oprot.WriteMessageBegin("aggregateRaw", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
 		}
-		err = error90
-		return
+	} else {
+		result.Success = retval
 	}
-	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "getWriteNewSeriesLimitPerShardPerSecond failed: invalid message type")
-		return
+	if err2 = oprot.WriteMessageBegin("aggregateRaw", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	result := NodeGetWriteNewSeriesLimitPerShardPerSecondResult{}
-	if err = result.Read(iprot); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	if err = iprot.ReadMessageEnd(); err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	if result.Err != nil {
-		err = result.Err
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	value = result.GetSuccess()
-	return
+	return true, err
 }
 
-// Parameters:
-//  - Req
-func (p *NodeClient) SetWriteNewSeriesLimitPerShardPerSecond(req *NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) (r *NodeWriteNewSeriesLimitPerShardPerSecondResult_, err error) {
-	if err = p.sendSetWriteNewSeriesLimitPerShardPerSecond(req); err != nil {
-		return
+type nodeProcessorFetchBatchRaw struct {
+	handler Node
+}
+
+func (p *nodeProcessorFetchBatchRaw) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeFetchBatchRawArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("fetchBatchRaw", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := NodeFetchBatchRawResult{}
+	var retval *FetchBatchRawResult_
+	var err2 error
+	if retval, err2 = p.handler.FetchBatchRaw(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetchBatchRaw: "+err2.Error())
+			oprot.WriteMessageBegin("fetchBatchRaw", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	} else {
+		result.Success = retval
 	}
-	return p.recvSetWriteNewSeriesLimitPerShardPerSecond()
-}
-
-func (p *NodeClient) sendSetWriteNewSeriesLimitPerShardPerSecond(req *NodeSetWriteNewSeriesLimitPerShardPerSecondRequest) (err error) {
-	oprot := p.OutputProtocol
-	if oprot == nil {
-		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-		p.OutputProtocol = oprot
+	if err2 = oprot.WriteMessageBegin("fetchBatchRaw", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	p.SeqId++
-	if err = oprot.WriteMessageBegin("setWriteNewSeriesLimitPerShardPerSecond", thrift.CALL, p.SeqId); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	args := NodeSetWriteNewSeriesLimitPerShardPerSecondArgs{
-		Req: req,
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	if err = args.Write(oprot); err != nil {
-		return
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
 	}
-	if err = oprot.WriteMessageEnd(); err != nil {
+	if err != nil {
 		return
 	}
-	return oprot.Flush()
+	return true, err
 }
 
-func (p *NodeClient) recvSetWriteNewSeriesLimitPerShardPerSecond() (value *NodeWriteNewSeriesLimitPerShardPerSecondResult_, err error) {
-	iprot := p.InputProtocol
-	if iprot == nil {
-		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-		p.InputProtocol = iprot
-	}
-	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return
-	}
-	if method != "setWriteNewSeriesLimitPerShardPerSecond" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "setWriteNewSeriesLimitPerShardPerSecond failed: wrong method name")
-		return
-	}
-	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "setWriteNewSeriesLimitPerShardPerSecond failed: out of sequence response")
-		return
+type nodeProcessorFetchBatchRawV2 struct {
+	handler Node
+}
+
+func (p *nodeProcessorFetchBatchRawV2) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeFetchBatchRawV2Args{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("fetchBatchRawV2", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
 	}
-	if mTypeId == thrift.EXCEPTION {
-		error91 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error92 error
-		error92, err = error91.Read(iprot)
-		if err != nil {
-			return
-		}
-		if err = iprot.ReadMessageEnd(); err != nil {
-			return
+
+	iprot.ReadMessageEnd()
+	result := NodeFetchBatchRawV2Result{}
+	var retval *FetchBatchRawResult_
+	var err2 error
+	if retval, err2 = p.handler.FetchBatchRawV2(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetchBatchRawV2: "+err2.Error())
+			oprot.WriteMessageBegin("fetchBatchRawV2", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
 		}
-		err = error92
-		return
+	} else {
+		result.Success = retval
 	}
-	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "setWriteNewSeriesLimitPerShardPerSecond failed: invalid message type")
-		return
+	if err2 = oprot.WriteMessageBegin("fetchBatchRawV2", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	result := NodeSetWriteNewSeriesLimitPerShardPerSecondResult{}
-	if err = result.Read(iprot); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	if err = iprot.ReadMessageEnd(); err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	if result.Err != nil {
-		err = result.Err
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	value = result.GetSuccess()
-	return
+	return true, err
 }
 
-// Parameters:
-//  - Req
-func (p *NodeClient) DebugProfileStart(req *DebugProfileStartRequest) (r *DebugProfileStartResult_, err error) {
-	if err = p.sendDebugProfileStart(req); err != nil {
-		return
-	}
-	return p.recvDebugProfileStart()
+type nodeProcessorFetchBlocksRaw struct {
+	handler Node
 }
 
-func (p *NodeClient) sendDebugProfileStart(req *DebugProfileStartRequest) (err error) {
-	oprot := p.OutputProtocol
-	if oprot == nil {
-		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-		p.OutputProtocol = oprot
+func (p *nodeProcessorFetchBlocksRaw) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeFetchBlocksRawArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("fetchBlocksRaw", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
 	}
-	p.SeqId++
-	if err = oprot.WriteMessageBegin("debugProfileStart", thrift.CALL, p.SeqId); err != nil {
-		return
+
+	iprot.ReadMessageEnd()
+	result := NodeFetchBlocksRawResult{}
+	var retval *FetchBlocksRawResult_
+	var err2 error
+	if retval, err2 = p.handler.FetchBlocksRaw(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetchBlocksRaw: "+err2.Error())
+			oprot.WriteMessageBegin("fetchBlocksRaw", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	} else {
+		result.Success = retval
 	}
-	args := NodeDebugProfileStartArgs{
-		Req: req,
+	if err2 = oprot.WriteMessageBegin("fetchBlocksRaw", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	if err = args.Write(oprot); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	if err = oprot.WriteMessageEnd(); err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	return oprot.Flush()
-}
-
-func (p *NodeClient) recvDebugProfileStart() (value *DebugProfileStartResult_, err error) {
-	iprot := p.InputProtocol
-	if iprot == nil {
-		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-		p.InputProtocol = iprot
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
 	}
-	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
 	if err != nil {
 		return
 	}
-	if method != "debugProfileStart" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "debugProfileStart failed: wrong method name")
-		return
-	}
-	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "debugProfileStart failed: out of sequence response")
-		return
+	return true, err
+}
+
+type nodeProcessorFetchTagged struct {
+	handler Node
+}
+
+func (p *nodeProcessorFetchTagged) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeFetchTaggedArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("fetchTagged", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
 	}
-	if mTypeId == thrift.EXCEPTION {
-		error93 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error94 error
-		error94, err = error93.Read(iprot)
-		if err != nil {
-			return
-		}
-		if err = iprot.ReadMessageEnd(); err != nil {
-			return
+
+	iprot.ReadMessageEnd()
+	result := NodeFetchTaggedResult{}
+	var retval *FetchTaggedResult_
+	var err2 error
+	if retval, err2 = p.handler.FetchTagged(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetchTagged: "+err2.Error())
+			oprot.WriteMessageBegin("fetchTagged", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
 		}
-		err = error94
-		return
+	} else {
+		result.Success = retval
 	}
-	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "debugProfileStart failed: invalid message type")
-		return
+	if err2 = oprot.WriteMessageBegin("fetchTagged", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	result := NodeDebugProfileStartResult{}
-	if err = result.Read(iprot); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	if err = iprot.ReadMessageEnd(); err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	if result.Err != nil {
-		err = result.Err
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	value = result.GetSuccess()
-	return
+	return true, err
 }
 
-// Parameters:
-//  - Req
-func (p *NodeClient) DebugProfileStop(req *DebugProfileStopRequest) (r *DebugProfileStopResult_, err error) {
-	if err = p.sendDebugProfileStop(req); err != nil {
-		return
-	}
-	return p.recvDebugProfileStop()
+type nodeProcessorFetchTaggedStream struct {
+	handler Node
 }
 
-func (p *NodeClient) sendDebugProfileStop(req *DebugProfileStopRequest) (err error) {
-	oprot := p.OutputProtocol
-	if oprot == nil {
-		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-		p.OutputProtocol = oprot
+func (p *nodeProcessorFetchTaggedStream) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeFetchTaggedStreamArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("fetchTaggedStream", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
 	}
-	p.SeqId++
-	if err = oprot.WriteMessageBegin("debugProfileStop", thrift.CALL, p.SeqId); err != nil {
-		return
+
+	iprot.ReadMessageEnd()
+	result := NodeFetchTaggedStreamResult{}
+	var retval *FetchTaggedStreamResult_
+	var err2 error
+	if retval, err2 = p.handler.FetchTaggedStream(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetchTaggedStream: "+err2.Error())
+			oprot.WriteMessageBegin("fetchTaggedStream", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	} else {
+		result.Success = retval
 	}
-	args := NodeDebugProfileStopArgs{
-		Req: req,
+	if err2 = oprot.WriteMessageBegin("fetchTaggedStream", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	if err = args.Write(oprot); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	if err = oprot.WriteMessageEnd(); err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	return oprot.Flush()
-}
-
-func (p *NodeClient) recvDebugProfileStop() (value *DebugProfileStopResult_, err error) {
-	iprot := p.InputProtocol
-	if iprot == nil {
-		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-		p.InputProtocol = iprot
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
 	}
-	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
 	if err != nil {
 		return
 	}
-	if method != "debugProfileStop" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "debugProfileStop failed: wrong method name")
-		return
-	}
-	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "debugProfileStop failed: out of sequence response")
-		return
+	return true, err
+}
+
+type nodeProcessorFetchBlocksMetadataRawV2 struct {
+	handler Node
+}
+
+func (p *nodeProcessorFetchBlocksMetadataRawV2) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeFetchBlocksMetadataRawV2Args{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("fetchBlocksMetadataRawV2", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
 	}
-	if mTypeId == thrift.EXCEPTION {
-		error95 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error96 error
-		error96, err = error95.Read(iprot)
-		if err != nil {
-			return
-		}
-		if err = iprot.ReadMessageEnd(); err != nil {
-			return
+
+	iprot.ReadMessageEnd()
+	result := NodeFetchBlocksMetadataRawV2Result{}
+	var retval *FetchBlocksMetadataRawV2Result_
+	var err2 error
+	if retval, err2 = p.handler.FetchBlocksMetadataRawV2(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetchBlocksMetadataRawV2: "+err2.Error())
+			oprot.WriteMessageBegin("fetchBlocksMetadataRawV2", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
 		}
-		err = error96
-		return
+	} else {
+		result.Success = retval
 	}
-	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "debugProfileStop failed: invalid message type")
-		return
+	if err2 = oprot.WriteMessageBegin("fetchBlocksMetadataRawV2", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	result := NodeDebugProfileStopResult{}
-	if err = result.Read(iprot); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	if err = iprot.ReadMessageEnd(); err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	if result.Err != nil {
-		err = result.Err
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	value = result.GetSuccess()
-	return
+	return true, err
 }
 
-// Parameters:
-//  - Req
-func (p *NodeClient) DebugIndexMemorySegments(req *DebugIndexMemorySegmentsRequest) (r *DebugIndexMemorySegmentsResult_, err error) {
-	if err = p.sendDebugIndexMemorySegments(req); err != nil {
-		return
-	}
-	return p.recvDebugIndexMemorySegments()
+type nodeProcessorFetchBlocksMerkleTreeRaw struct {
+	handler Node
 }
 
-func (p *NodeClient) sendDebugIndexMemorySegments(req *DebugIndexMemorySegmentsRequest) (err error) {
-	oprot := p.OutputProtocol
-	if oprot == nil {
-		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-		p.OutputProtocol = oprot
+func (p *nodeProcessorFetchBlocksMerkleTreeRaw) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeFetchBlocksMerkleTreeRawArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("fetchBlocksMerkleTreeRaw", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
 	}
-	p.SeqId++
-	if err = oprot.WriteMessageBegin("debugIndexMemorySegments", thrift.CALL, p.SeqId); err != nil {
-		return
+
+	iprot.ReadMessageEnd()
+	result := NodeFetchBlocksMerkleTreeRawResult{}
+	var retval *FetchBlocksMerkleTreeRawResult_
+	var err2 error
+	if retval, err2 = p.handler.FetchBlocksMerkleTreeRaw(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetchBlocksMerkleTreeRaw: "+err2.Error())
+			oprot.WriteMessageBegin("fetchBlocksMerkleTreeRaw", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	} else {
+		result.Success = retval
 	}
-	args := NodeDebugIndexMemorySegmentsArgs{
-		Req: req,
+	if err2 = oprot.WriteMessageBegin("fetchBlocksMerkleTreeRaw", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	if err = args.Write(oprot); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	if err = oprot.WriteMessageEnd(); err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	return oprot.Flush()
-}
-
-func (p *NodeClient) recvDebugIndexMemorySegments() (value *DebugIndexMemorySegmentsResult_, err error) {
-	iprot := p.InputProtocol
-	if iprot == nil {
-		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-		p.InputProtocol = iprot
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
 	}
-	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
 	if err != nil {
 		return
 	}
-	if method != "debugIndexMemorySegments" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "debugIndexMemorySegments failed: wrong method name")
-		return
-	}
-	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "debugIndexMemorySegments failed: out of sequence response")
-		return
+	return true, err
+}
+
+type nodeProcessorCardinalityRaw struct {
+	handler Node
+}
+
+func (p *nodeProcessorCardinalityRaw) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeCardinalityRawArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("cardinalityRaw", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
 	}
-	if mTypeId == thrift.EXCEPTION {
-		error97 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error98 error
-		error98, err = error97.Read(iprot)
-		if err != nil {
-			return
-		}
-		if err = iprot.ReadMessageEnd(); err != nil {
-			return
+
+	iprot.ReadMessageEnd()
+	result := NodeCardinalityRawResult{}
+	var retval *CardinalityRawResult_
+	var err2 error
+	if retval, err2 = p.handler.CardinalityRaw(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *Error:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing cardinalityRaw: "+err2.Error())
+			oprot.WriteMessageBegin("cardinalityRaw", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
 		}
-		err = error98
-		return
+	} else {
+		result.Success = retval
 	}
-	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "debugIndexMemorySegments failed: invalid message type")
-		return
+	if err2 = oprot.WriteMessageBegin("cardinalityRaw", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	result := NodeDebugIndexMemorySegmentsResult{}
-	if err = result.Read(iprot); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	if err = iprot.ReadMessageEnd(); err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
 	}
-	if result.Err != nil {
-		err = result.Err
+	if err != nil {
 		return
 	}
-	value = result.GetSuccess()
-	return
-}
-
-type NodeProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      Node
+	return true, err
 }
 
-func (p *NodeProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+type nodeProcessorWriteBatchRaw struct {
+	handler Node
 }
 
-func (p *NodeProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
-}
+func (p *nodeProcessorWriteBatchRaw) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeWriteBatchRawArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("writeBatchRaw", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
 
-func (p *NodeProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+	iprot.ReadMessageEnd()
+	result := NodeWriteBatchRawResult{}
+	var err2 error
+	if err2 = p.handler.WriteBatchRaw(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *WriteBatchRawErrors:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing writeBatchRaw: "+err2.Error())
+			oprot.WriteMessageBegin("writeBatchRaw", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	}
+	if err2 = oprot.WriteMessageBegin("writeBatchRaw", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-func NewNodeProcessor(handler Node) *NodeProcessor {
-
-	self99 := &NodeProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self99.processorMap["query"] = &nodeProcessorQuery{handler: handler}
-	self99.processorMap["aggregate"] = &nodeProcessorAggregate{handler: handler}
-	self99.processorMap["fetch"] = &nodeProcessorFetch{handler: handler}
-	self99.processorMap["write"] = &nodeProcessorWrite{handler: handler}
-	self99.processorMap["writeTagged"] = &nodeProcessorWriteTagged{handler: handler}
-	self99.processorMap["aggregateRaw"] = &nodeProcessorAggregateRaw{handler: handler}
-	self99.processorMap["fetchBatchRaw"] = &nodeProcessorFetchBatchRaw{handler: handler}
-	self99.processorMap["fetchBatchRawV2"] = &nodeProcessorFetchBatchRawV2{handler: handler}
-	self99.processorMap["fetchBlocksRaw"] = &nodeProcessorFetchBlocksRaw{handler: handler}
-	self99.processorMap["fetchTagged"] = &nodeProcessorFetchTagged{handler: handler}
-	self99.processorMap["fetchBlocksMetadataRawV2"] = &nodeProcessorFetchBlocksMetadataRawV2{handler: handler}
-	self99.processorMap["writeBatchRaw"] = &nodeProcessorWriteBatchRaw{handler: handler}
-	self99.processorMap["writeBatchRawV2"] = &nodeProcessorWriteBatchRawV2{handler: handler}
-	self99.processorMap["writeTaggedBatchRaw"] = &nodeProcessorWriteTaggedBatchRaw{handler: handler}
-	self99.processorMap["writeTaggedBatchRawV2"] = &nodeProcessorWriteTaggedBatchRawV2{handler: handler}
-	self99.processorMap["repair"] = &nodeProcessorRepair{handler: handler}
-	self99.processorMap["truncate"] = &nodeProcessorTruncate{handler: handler}
-	self99.processorMap["aggregateTiles"] = &nodeProcessorAggregateTiles{handler: handler}
-	self99.processorMap["health"] = &nodeProcessorHealth{handler: handler}
-	self99.processorMap["bootstrapped"] = &nodeProcessorBootstrapped{handler: handler}
-	self99.processorMap["bootstrappedInPlacementOrNoPlacement"] = &nodeProcessorBootstrappedInPlacementOrNoPlacement{handler: handler}
-	self99.processorMap["getPersistRateLimit"] = &nodeProcessorGetPersistRateLimit{handler: handler}
-	self99.processorMap["setPersistRateLimit"] = &nodeProcessorSetPersistRateLimit{handler: handler}
-	self99.processorMap["getWriteNewSeriesAsync"] = &nodeProcessorGetWriteNewSeriesAsync{handler: handler}
-	self99.processorMap["setWriteNewSeriesAsync"] = &nodeProcessorSetWriteNewSeriesAsync{handler: handler}
-	self99.processorMap["getWriteNewSeriesBackoffDuration"] = &nodeProcessorGetWriteNewSeriesBackoffDuration{handler: handler}
-	self99.processorMap["setWriteNewSeriesBackoffDuration"] = &nodeProcessorSetWriteNewSeriesBackoffDuration{handler: handler}
-	self99.processorMap["getWriteNewSeriesLimitPerShardPerSecond"] = &nodeProcessorGetWriteNewSeriesLimitPerShardPerSecond{handler: handler}
-	self99.processorMap["setWriteNewSeriesLimitPerShardPerSecond"] = &nodeProcessorSetWriteNewSeriesLimitPerShardPerSecond{handler: handler}
-	self99.processorMap["debugProfileStart"] = &nodeProcessorDebugProfileStart{handler: handler}
-	self99.processorMap["debugProfileStop"] = &nodeProcessorDebugProfileStop{handler: handler}
-	self99.processorMap["debugIndexMemorySegments"] = &nodeProcessorDebugIndexMemorySegments{handler: handler}
-	return self99
+type nodeProcessorWriteBatchRawV2 struct {
+	handler Node
 }
 
-func (p *NodeProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
+func (p *nodeProcessorWriteBatchRawV2) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeWriteBatchRawV2Args{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("writeBatchRawV2", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
 		return false, err
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x100 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x100.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush()
-	return false, x100
 
+	iprot.ReadMessageEnd()
+	result := NodeWriteBatchRawV2Result{}
+	var err2 error
+	if err2 = p.handler.WriteBatchRawV2(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *WriteBatchRawErrors:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing writeBatchRawV2: "+err2.Error())
+			oprot.WriteMessageBegin("writeBatchRawV2", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	}
+	if err2 = oprot.WriteMessageBegin("writeBatchRawV2", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-type nodeProcessorQuery struct {
+type nodeProcessorWriteTaggedBatchRaw struct {
 	handler Node
 }
 
-func (p *nodeProcessorQuery) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeQueryArgs{}
+func (p *nodeProcessorWriteTaggedBatchRaw) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeWriteTaggedBatchRawArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("query", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("writeTaggedBatchRaw", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18089,25 +22140,22 @@ func (p *nodeProcessorQuery) Process(seqId int32, iprot, oprot thrift.TProtocol)
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeQueryResult{}
-	var retval *QueryResult_
+	result := NodeWriteTaggedBatchRawResult{}
 	var err2 error
-	if retval, err2 = p.handler.Query(args.Req); err2 != nil {
+	if err2 = p.handler.WriteTaggedBatchRaw(args.Req); err2 != nil {
 		switch v := err2.(type) {
-		case *Error:
+		case *WriteBatchRawErrors:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing query: "+err2.Error())
-			oprot.WriteMessageBegin("query", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing writeTaggedBatchRaw: "+err2.Error())
+			oprot.WriteMessageBegin("writeTaggedBatchRaw", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
-	} else {
-		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("query", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("writeTaggedBatchRaw", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18125,16 +22173,16 @@ func (p *nodeProcessorQuery) Process(seqId int32, iprot, oprot thrift.TProtocol)
 	return true, err
 }
 
-type nodeProcessorAggregate struct {
+type nodeProcessorWriteTaggedBatchRawV2 struct {
 	handler Node
 }
 
-func (p *nodeProcessorAggregate) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeAggregateArgs{}
+func (p *nodeProcessorWriteTaggedBatchRawV2) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeWriteTaggedBatchRawV2Args{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("aggregate", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("writeTaggedBatchRawV2", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18142,25 +22190,22 @@ func (p *nodeProcessorAggregate) Process(seqId int32, iprot, oprot thrift.TProto
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeAggregateResult{}
-	var retval *AggregateQueryResult_
+	result := NodeWriteTaggedBatchRawV2Result{}
 	var err2 error
-	if retval, err2 = p.handler.Aggregate(args.Req); err2 != nil {
+	if err2 = p.handler.WriteTaggedBatchRawV2(args.Req); err2 != nil {
 		switch v := err2.(type) {
-		case *Error:
+		case *WriteBatchRawErrors:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing aggregate: "+err2.Error())
-			oprot.WriteMessageBegin("aggregate", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing writeTaggedBatchRawV2: "+err2.Error())
+			oprot.WriteMessageBegin("writeTaggedBatchRawV2", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
-	} else {
-		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("aggregate", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("writeTaggedBatchRawV2", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18178,16 +22223,16 @@ func (p *nodeProcessorAggregate) Process(seqId int32, iprot, oprot thrift.TProto
 	return true, err
 }
 
-type nodeProcessorFetch struct {
+type nodeProcessorRepair struct {
 	handler Node
 }
 
-func (p *nodeProcessorFetch) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeFetchArgs{}
+func (p *nodeProcessorRepair) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeRepairArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("fetch", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("repair", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18195,25 +22240,22 @@ func (p *nodeProcessorFetch) Process(seqId int32, iprot, oprot thrift.TProtocol)
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeFetchResult{}
-	var retval *FetchResult_
+	result := NodeRepairResult{}
 	var err2 error
-	if retval, err2 = p.handler.Fetch(args.Req); err2 != nil {
+	if err2 = p.handler.Repair(); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetch: "+err2.Error())
-			oprot.WriteMessageBegin("fetch", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing repair: "+err2.Error())
+			oprot.WriteMessageBegin("repair", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
-	} else {
-		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("fetch", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("repair", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18231,16 +22273,16 @@ func (p *nodeProcessorFetch) Process(seqId int32, iprot, oprot thrift.TProtocol)
 	return true, err
 }
 
-type nodeProcessorWrite struct {
+type nodeProcessorTruncate struct {
 	handler Node
 }
 
-func (p *nodeProcessorWrite) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeWriteArgs{}
+func (p *nodeProcessorTruncate) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeTruncateArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("write", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("truncate", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18248,22 +22290,25 @@ func (p *nodeProcessorWrite) Process(seqId int32, iprot, oprot thrift.TProtocol)
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeWriteResult{}
+	result := NodeTruncateResult{}
+	var retval *TruncateResult_
 	var err2 error
-	if err2 = p.handler.Write(args.Req); err2 != nil {
+	if retval, err2 = p.handler.Truncate(args.Req); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing write: "+err2.Error())
-			oprot.WriteMessageBegin("write", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing truncate: "+err2.Error())
+			oprot.WriteMessageBegin("truncate", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
+	} else {
+		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("write", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("truncate", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18281,16 +22326,16 @@ func (p *nodeProcessorWrite) Process(seqId int32, iprot, oprot thrift.TProtocol)
 	return true, err
 }
 
-type nodeProcessorWriteTagged struct {
+type nodeProcessorDeleteTagged struct {
 	handler Node
 }
 
-func (p *nodeProcessorWriteTagged) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeWriteTaggedArgs{}
+func (p *nodeProcessorDeleteTagged) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeDeleteTaggedArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("writeTagged", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("deleteTagged", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18298,22 +22343,25 @@ func (p *nodeProcessorWriteTagged) Process(seqId int32, iprot, oprot thrift.TPro
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeWriteTaggedResult{}
+	result := NodeDeleteTaggedResult{}
+	var retval *DeleteTaggedResult_
 	var err2 error
-	if err2 = p.handler.WriteTagged(args.Req); err2 != nil {
+	if retval, err2 = p.handler.DeleteTagged(args.Req); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing writeTagged: "+err2.Error())
-			oprot.WriteMessageBegin("writeTagged", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteTagged: "+err2.Error())
+			oprot.WriteMessageBegin("deleteTagged", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
+	} else {
+		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("writeTagged", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("deleteTagged", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18331,16 +22379,16 @@ func (p *nodeProcessorWriteTagged) Process(seqId int32, iprot, oprot thrift.TPro
 	return true, err
 }
 
-type nodeProcessorAggregateRaw struct {
+type nodeProcessorAggregateTiles struct {
 	handler Node
 }
 
-func (p *nodeProcessorAggregateRaw) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeAggregateRawArgs{}
+func (p *nodeProcessorAggregateTiles) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeAggregateTilesArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("aggregateRaw", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("aggregateTiles", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18348,16 +22396,16 @@ func (p *nodeProcessorAggregateRaw) Process(seqId int32, iprot, oprot thrift.TPr
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeAggregateRawResult{}
-	var retval *AggregateQueryRawResult_
+	result := NodeAggregateTilesResult{}
+	var retval *AggregateTilesResult_
 	var err2 error
-	if retval, err2 = p.handler.AggregateRaw(args.Req); err2 != nil {
+	if retval, err2 = p.handler.AggregateTiles(args.Req); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing aggregateRaw: "+err2.Error())
-			oprot.WriteMessageBegin("aggregateRaw", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing aggregateTiles: "+err2.Error())
+			oprot.WriteMessageBegin("aggregateTiles", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
@@ -18366,7 +22414,7 @@ func (p *nodeProcessorAggregateRaw) Process(seqId int32, iprot, oprot thrift.TPr
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("aggregateRaw", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("aggregateTiles", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18384,16 +22432,16 @@ func (p *nodeProcessorAggregateRaw) Process(seqId int32, iprot, oprot thrift.TPr
 	return true, err
 }
 
-type nodeProcessorFetchBatchRaw struct {
+type nodeProcessorAggregateTilesStatus struct {
 	handler Node
 }
 
-func (p *nodeProcessorFetchBatchRaw) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeFetchBatchRawArgs{}
+func (p *nodeProcessorAggregateTilesStatus) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeAggregateTilesStatusArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("fetchBatchRaw", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("aggregateTilesStatus", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18401,16 +22449,16 @@ func (p *nodeProcessorFetchBatchRaw) Process(seqId int32, iprot, oprot thrift.TP
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeFetchBatchRawResult{}
-	var retval *FetchBatchRawResult_
+	result := NodeAggregateTilesStatusResult{}
+	var retval *AggregateTilesStatusResult_
 	var err2 error
-	if retval, err2 = p.handler.FetchBatchRaw(args.Req); err2 != nil {
+	if retval, err2 = p.handler.AggregateTilesStatus(); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetchBatchRaw: "+err2.Error())
-			oprot.WriteMessageBegin("fetchBatchRaw", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing aggregateTilesStatus: "+err2.Error())
+			oprot.WriteMessageBegin("aggregateTilesStatus", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
@@ -18419,7 +22467,7 @@ func (p *nodeProcessorFetchBatchRaw) Process(seqId int32, iprot, oprot thrift.TP
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("fetchBatchRaw", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("aggregateTilesStatus", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18437,16 +22485,16 @@ func (p *nodeProcessorFetchBatchRaw) Process(seqId int32, iprot, oprot thrift.TP
 	return true, err
 }
 
-type nodeProcessorFetchBatchRawV2 struct {
+type nodeProcessorHealth struct {
 	handler Node
 }
 
-func (p *nodeProcessorFetchBatchRawV2) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeFetchBatchRawV2Args{}
+func (p *nodeProcessorHealth) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeHealthArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("fetchBatchRawV2", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("health", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18454,16 +22502,16 @@ func (p *nodeProcessorFetchBatchRawV2) Process(seqId int32, iprot, oprot thrift.
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeFetchBatchRawV2Result{}
-	var retval *FetchBatchRawResult_
+	result := NodeHealthResult{}
+	var retval *NodeHealthResult_
 	var err2 error
-	if retval, err2 = p.handler.FetchBatchRawV2(args.Req); err2 != nil {
+	if retval, err2 = p.handler.Health(); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetchBatchRawV2: "+err2.Error())
-			oprot.WriteMessageBegin("fetchBatchRawV2", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing health: "+err2.Error())
+			oprot.WriteMessageBegin("health", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
@@ -18472,7 +22520,7 @@ func (p *nodeProcessorFetchBatchRawV2) Process(seqId int32, iprot, oprot thrift.
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("fetchBatchRawV2", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("health", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18490,16 +22538,16 @@ func (p *nodeProcessorFetchBatchRawV2) Process(seqId int32, iprot, oprot thrift.
 	return true, err
 }
 
-type nodeProcessorFetchBlocksRaw struct {
+type nodeProcessorBootstrapped struct {
 	handler Node
 }
 
-func (p *nodeProcessorFetchBlocksRaw) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeFetchBlocksRawArgs{}
+func (p *nodeProcessorBootstrapped) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeBootstrappedArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("fetchBlocksRaw", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("bootstrapped", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18507,16 +22555,16 @@ func (p *nodeProcessorFetchBlocksRaw) Process(seqId int32, iprot, oprot thrift.T
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeFetchBlocksRawResult{}
-	var retval *FetchBlocksRawResult_
+	result := NodeBootstrappedResult{}
+	var retval *NodeBootstrappedResult_
 	var err2 error
-	if retval, err2 = p.handler.FetchBlocksRaw(args.Req); err2 != nil {
+	if retval, err2 = p.handler.Bootstrapped(); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetchBlocksRaw: "+err2.Error())
-			oprot.WriteMessageBegin("fetchBlocksRaw", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing bootstrapped: "+err2.Error())
+			oprot.WriteMessageBegin("bootstrapped", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
@@ -18525,7 +22573,7 @@ func (p *nodeProcessorFetchBlocksRaw) Process(seqId int32, iprot, oprot thrift.T
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("fetchBlocksRaw", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("bootstrapped", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18543,16 +22591,16 @@ func (p *nodeProcessorFetchBlocksRaw) Process(seqId int32, iprot, oprot thrift.T
 	return true, err
 }
 
-type nodeProcessorFetchTagged struct {
+type nodeProcessorBootstrappedInPlacementOrNoPlacement struct {
 	handler Node
 }
 
-func (p *nodeProcessorFetchTagged) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeFetchTaggedArgs{}
+func (p *nodeProcessorBootstrappedInPlacementOrNoPlacement) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeBootstrappedInPlacementOrNoPlacementArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("fetchTagged", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("bootstrappedInPlacementOrNoPlacement", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18560,16 +22608,16 @@ func (p *nodeProcessorFetchTagged) Process(seqId int32, iprot, oprot thrift.TPro
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeFetchTaggedResult{}
-	var retval *FetchTaggedResult_
+	result := NodeBootstrappedInPlacementOrNoPlacementResult{}
+	var retval *NodeBootstrappedInPlacementOrNoPlacementResult_
 	var err2 error
-	if retval, err2 = p.handler.FetchTagged(args.Req); err2 != nil {
+	if retval, err2 = p.handler.BootstrappedInPlacementOrNoPlacement(); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetchTagged: "+err2.Error())
-			oprot.WriteMessageBegin("fetchTagged", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing bootstrappedInPlacementOrNoPlacement: "+err2.Error())
+			oprot.WriteMessageBegin("bootstrappedInPlacementOrNoPlacement", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
@@ -18578,7 +22626,7 @@ func (p *nodeProcessorFetchTagged) Process(seqId int32, iprot, oprot thrift.TPro
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("fetchTagged", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("bootstrappedInPlacementOrNoPlacement", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18596,16 +22644,16 @@ func (p *nodeProcessorFetchTagged) Process(seqId int32, iprot, oprot thrift.TPro
 	return true, err
 }
 
-type nodeProcessorFetchBlocksMetadataRawV2 struct {
+type nodeProcessorGetPersistRateLimit struct {
 	handler Node
 }
 
-func (p *nodeProcessorFetchBlocksMetadataRawV2) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeFetchBlocksMetadataRawV2Args{}
+func (p *nodeProcessorGetPersistRateLimit) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeGetPersistRateLimitArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("fetchBlocksMetadataRawV2", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("getPersistRateLimit", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18613,16 +22661,16 @@ func (p *nodeProcessorFetchBlocksMetadataRawV2) Process(seqId int32, iprot, opro
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeFetchBlocksMetadataRawV2Result{}
-	var retval *FetchBlocksMetadataRawV2Result_
+	result := NodeGetPersistRateLimitResult{}
+	var retval *NodePersistRateLimitResult_
 	var err2 error
-	if retval, err2 = p.handler.FetchBlocksMetadataRawV2(args.Req); err2 != nil {
+	if retval, err2 = p.handler.GetPersistRateLimit(); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fetchBlocksMetadataRawV2: "+err2.Error())
-			oprot.WriteMessageBegin("fetchBlocksMetadataRawV2", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getPersistRateLimit: "+err2.Error())
+			oprot.WriteMessageBegin("getPersistRateLimit", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
@@ -18631,7 +22679,7 @@ func (p *nodeProcessorFetchBlocksMetadataRawV2) Process(seqId int32, iprot, opro
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("fetchBlocksMetadataRawV2", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("getPersistRateLimit", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18649,16 +22697,16 @@ func (p *nodeProcessorFetchBlocksMetadataRawV2) Process(seqId int32, iprot, opro
 	return true, err
 }
 
-type nodeProcessorWriteBatchRaw struct {
+type nodeProcessorSetPersistRateLimit struct {
 	handler Node
 }
 
-func (p *nodeProcessorWriteBatchRaw) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeWriteBatchRawArgs{}
+func (p *nodeProcessorSetPersistRateLimit) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeSetPersistRateLimitArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("writeBatchRaw", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("setPersistRateLimit", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18666,22 +22714,25 @@ func (p *nodeProcessorWriteBatchRaw) Process(seqId int32, iprot, oprot thrift.TP
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeWriteBatchRawResult{}
+	result := NodeSetPersistRateLimitResult{}
+	var retval *NodePersistRateLimitResult_
 	var err2 error
-	if err2 = p.handler.WriteBatchRaw(args.Req); err2 != nil {
+	if retval, err2 = p.handler.SetPersistRateLimit(args.Req); err2 != nil {
 		switch v := err2.(type) {
-		case *WriteBatchRawErrors:
+		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing writeBatchRaw: "+err2.Error())
-			oprot.WriteMessageBegin("writeBatchRaw", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing setPersistRateLimit: "+err2.Error())
+			oprot.WriteMessageBegin("setPersistRateLimit", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
+	} else {
+		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("writeBatchRaw", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("setPersistRateLimit", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18699,16 +22750,16 @@ func (p *nodeProcessorWriteBatchRaw) Process(seqId int32, iprot, oprot thrift.TP
 	return true, err
 }
 
-type nodeProcessorWriteBatchRawV2 struct {
+type nodeProcessorGetWriteNewSeriesAsync struct {
 	handler Node
 }
 
-func (p *nodeProcessorWriteBatchRawV2) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeWriteBatchRawV2Args{}
+func (p *nodeProcessorGetWriteNewSeriesAsync) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeGetWriteNewSeriesAsyncArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("writeBatchRawV2", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("getWriteNewSeriesAsync", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18716,22 +22767,25 @@ func (p *nodeProcessorWriteBatchRawV2) Process(seqId int32, iprot, oprot thrift.
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeWriteBatchRawV2Result{}
+	result := NodeGetWriteNewSeriesAsyncResult{}
+	var retval *NodeWriteNewSeriesAsyncResult_
 	var err2 error
-	if err2 = p.handler.WriteBatchRawV2(args.Req); err2 != nil {
+	if retval, err2 = p.handler.GetWriteNewSeriesAsync(); err2 != nil {
 		switch v := err2.(type) {
-		case *WriteBatchRawErrors:
+		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing writeBatchRawV2: "+err2.Error())
-			oprot.WriteMessageBegin("writeBatchRawV2", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getWriteNewSeriesAsync: "+err2.Error())
+			oprot.WriteMessageBegin("getWriteNewSeriesAsync", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
+	} else {
+		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("writeBatchRawV2", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("getWriteNewSeriesAsync", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18749,16 +22803,16 @@ func (p *nodeProcessorWriteBatchRawV2) Process(seqId int32, iprot, oprot thrift.
 	return true, err
 }
 
-type nodeProcessorWriteTaggedBatchRaw struct {
+type nodeProcessorSetWriteNewSeriesAsync struct {
 	handler Node
 }
 
-func (p *nodeProcessorWriteTaggedBatchRaw) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeWriteTaggedBatchRawArgs{}
+func (p *nodeProcessorSetWriteNewSeriesAsync) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeSetWriteNewSeriesAsyncArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("writeTaggedBatchRaw", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("setWriteNewSeriesAsync", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18766,22 +22820,25 @@ func (p *nodeProcessorWriteTaggedBatchRaw) Process(seqId int32, iprot, oprot thr
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeWriteTaggedBatchRawResult{}
+	result := NodeSetWriteNewSeriesAsyncResult{}
+	var retval *NodeWriteNewSeriesAsyncResult_
 	var err2 error
-	if err2 = p.handler.WriteTaggedBatchRaw(args.Req); err2 != nil {
+	if retval, err2 = p.handler.SetWriteNewSeriesAsync(args.Req); err2 != nil {
 		switch v := err2.(type) {
-		case *WriteBatchRawErrors:
+		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing writeTaggedBatchRaw: "+err2.Error())
-			oprot.WriteMessageBegin("writeTaggedBatchRaw", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing setWriteNewSeriesAsync: "+err2.Error())
+			oprot.WriteMessageBegin("setWriteNewSeriesAsync", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
+	} else {
+		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("writeTaggedBatchRaw", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("setWriteNewSeriesAsync", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18799,16 +22856,16 @@ func (p *nodeProcessorWriteTaggedBatchRaw) Process(seqId int32, iprot, oprot thr
 	return true, err
 }
 
-type nodeProcessorWriteTaggedBatchRawV2 struct {
+type nodeProcessorGetWriteNewSeriesBackoffDuration struct {
 	handler Node
 }
 
-func (p *nodeProcessorWriteTaggedBatchRawV2) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeWriteTaggedBatchRawV2Args{}
+func (p *nodeProcessorGetWriteNewSeriesBackoffDuration) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeGetWriteNewSeriesBackoffDurationArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("writeTaggedBatchRawV2", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("getWriteNewSeriesBackoffDuration", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18816,22 +22873,25 @@ func (p *nodeProcessorWriteTaggedBatchRawV2) Process(seqId int32, iprot, oprot t
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeWriteTaggedBatchRawV2Result{}
+	result := NodeGetWriteNewSeriesBackoffDurationResult{}
+	var retval *NodeWriteNewSeriesBackoffDurationResult_
 	var err2 error
-	if err2 = p.handler.WriteTaggedBatchRawV2(args.Req); err2 != nil {
+	if retval, err2 = p.handler.GetWriteNewSeriesBackoffDuration(); err2 != nil {
 		switch v := err2.(type) {
-		case *WriteBatchRawErrors:
+		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing writeTaggedBatchRawV2: "+err2.Error())
-			oprot.WriteMessageBegin("writeTaggedBatchRawV2", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getWriteNewSeriesBackoffDuration: "+err2.Error())
+			oprot.WriteMessageBegin("getWriteNewSeriesBackoffDuration", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
+	} else {
+		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("writeTaggedBatchRawV2", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("getWriteNewSeriesBackoffDuration", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18849,16 +22909,16 @@ func (p *nodeProcessorWriteTaggedBatchRawV2) Process(seqId int32, iprot, oprot t
 	return true, err
 }
 
-type nodeProcessorRepair struct {
+type nodeProcessorSetWriteNewSeriesBackoffDuration struct {
 	handler Node
 }
 
-func (p *nodeProcessorRepair) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeRepairArgs{}
+func (p *nodeProcessorSetWriteNewSeriesBackoffDuration) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeSetWriteNewSeriesBackoffDurationArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("repair", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("setWriteNewSeriesBackoffDuration", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18866,22 +22926,25 @@ func (p *nodeProcessorRepair) Process(seqId int32, iprot, oprot thrift.TProtocol
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeRepairResult{}
+	result := NodeSetWriteNewSeriesBackoffDurationResult{}
+	var retval *NodeWriteNewSeriesBackoffDurationResult_
 	var err2 error
-	if err2 = p.handler.Repair(); err2 != nil {
+	if retval, err2 = p.handler.SetWriteNewSeriesBackoffDuration(args.Req); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing repair: "+err2.Error())
-			oprot.WriteMessageBegin("repair", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing setWriteNewSeriesBackoffDuration: "+err2.Error())
+			oprot.WriteMessageBegin("setWriteNewSeriesBackoffDuration", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
+	} else {
+		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("repair", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("setWriteNewSeriesBackoffDuration", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18899,16 +22962,16 @@ func (p *nodeProcessorRepair) Process(seqId int32, iprot, oprot thrift.TProtocol
 	return true, err
 }
 
-type nodeProcessorTruncate struct {
+type nodeProcessorGetWriteNewSeriesLimitPerShardPerSecond struct {
 	handler Node
 }
 
-func (p *nodeProcessorTruncate) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeTruncateArgs{}
+func (p *nodeProcessorGetWriteNewSeriesLimitPerShardPerSecond) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeGetWriteNewSeriesLimitPerShardPerSecondArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("truncate", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("getWriteNewSeriesLimitPerShardPerSecond", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18916,16 +22979,16 @@ func (p *nodeProcessorTruncate) Process(seqId int32, iprot, oprot thrift.TProtoc
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeTruncateResult{}
-	var retval *TruncateResult_
+	result := NodeGetWriteNewSeriesLimitPerShardPerSecondResult{}
+	var retval *NodeWriteNewSeriesLimitPerShardPerSecondResult_
 	var err2 error
-	if retval, err2 = p.handler.Truncate(args.Req); err2 != nil {
+	if retval, err2 = p.handler.GetWriteNewSeriesLimitPerShardPerSecond(); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing truncate: "+err2.Error())
-			oprot.WriteMessageBegin("truncate", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getWriteNewSeriesLimitPerShardPerSecond: "+err2.Error())
+			oprot.WriteMessageBegin("getWriteNewSeriesLimitPerShardPerSecond", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
@@ -18934,7 +22997,7 @@ func (p *nodeProcessorTruncate) Process(seqId int32, iprot, oprot thrift.TProtoc
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("truncate", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("getWriteNewSeriesLimitPerShardPerSecond", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -18952,16 +23015,16 @@ func (p *nodeProcessorTruncate) Process(seqId int32, iprot, oprot thrift.TProtoc
 	return true, err
 }
 
-type nodeProcessorAggregateTiles struct {
+type nodeProcessorSetWriteNewSeriesLimitPerShardPerSecond struct {
 	handler Node
 }
 
-func (p *nodeProcessorAggregateTiles) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeAggregateTilesArgs{}
+func (p *nodeProcessorSetWriteNewSeriesLimitPerShardPerSecond) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeSetWriteNewSeriesLimitPerShardPerSecondArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("aggregateTiles", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("setWriteNewSeriesLimitPerShardPerSecond", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -18969,16 +23032,16 @@ func (p *nodeProcessorAggregateTiles) Process(seqId int32, iprot, oprot thrift.T
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeAggregateTilesResult{}
-	var retval *AggregateTilesResult_
+	result := NodeSetWriteNewSeriesLimitPerShardPerSecondResult{}
+	var retval *NodeWriteNewSeriesLimitPerShardPerSecondResult_
 	var err2 error
-	if retval, err2 = p.handler.AggregateTiles(args.Req); err2 != nil {
+	if retval, err2 = p.handler.SetWriteNewSeriesLimitPerShardPerSecond(args.Req); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing aggregateTiles: "+err2.Error())
-			oprot.WriteMessageBegin("aggregateTiles", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing setWriteNewSeriesLimitPerShardPerSecond: "+err2.Error())
+			oprot.WriteMessageBegin("setWriteNewSeriesLimitPerShardPerSecond", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
@@ -18987,7 +23050,7 @@ func (p *nodeProcessorAggregateTiles) Process(seqId int32, iprot, oprot thrift.T
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("aggregateTiles", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("setWriteNewSeriesLimitPerShardPerSecond", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -19005,16 +23068,16 @@ func (p *nodeProcessorAggregateTiles) Process(seqId int32, iprot, oprot thrift.T
 	return true, err
 }
 
-type nodeProcessorHealth struct {
+type nodeProcessorDebugProfileStart struct {
 	handler Node
 }
 
-func (p *nodeProcessorHealth) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeHealthArgs{}
+func (p *nodeProcessorDebugProfileStart) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeDebugProfileStartArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("health", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("debugProfileStart", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -19022,16 +23085,16 @@ func (p *nodeProcessorHealth) Process(seqId int32, iprot, oprot thrift.TProtocol
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeHealthResult{}
-	var retval *NodeHealthResult_
+	result := NodeDebugProfileStartResult{}
+	var retval *DebugProfileStartResult_
 	var err2 error
-	if retval, err2 = p.handler.Health(); err2 != nil {
+	if retval, err2 = p.handler.DebugProfileStart(args.Req); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing health: "+err2.Error())
-			oprot.WriteMessageBegin("health", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing debugProfileStart: "+err2.Error())
+			oprot.WriteMessageBegin("debugProfileStart", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
@@ -19040,7 +23103,7 @@ func (p *nodeProcessorHealth) Process(seqId int32, iprot, oprot thrift.TProtocol
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("health", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("debugProfileStart", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -19058,16 +23121,16 @@ func (p *nodeProcessorHealth) Process(seqId int32, iprot, oprot thrift.TProtocol
 	return true, err
 }
 
-type nodeProcessorBootstrapped struct {
+type nodeProcessorDebugProfileStop struct {
 	handler Node
 }
 
-func (p *nodeProcessorBootstrapped) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeBootstrappedArgs{}
+func (p *nodeProcessorDebugProfileStop) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeDebugProfileStopArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("bootstrapped", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("debugProfileStop", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -19075,16 +23138,16 @@ func (p *nodeProcessorBootstrapped) Process(seqId int32, iprot, oprot thrift.TPr
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeBootstrappedResult{}
-	var retval *NodeBootstrappedResult_
+	result := NodeDebugProfileStopResult{}
+	var retval *DebugProfileStopResult_
 	var err2 error
-	if retval, err2 = p.handler.Bootstrapped(); err2 != nil {
+	if retval, err2 = p.handler.DebugProfileStop(args.Req); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing bootstrapped: "+err2.Error())
-			oprot.WriteMessageBegin("bootstrapped", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing debugProfileStop: "+err2.Error())
+			oprot.WriteMessageBegin("debugProfileStop", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
@@ -19093,7 +23156,7 @@ func (p *nodeProcessorBootstrapped) Process(seqId int32, iprot, oprot thrift.TPr
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("bootstrapped", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("debugProfileStop", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -19111,16 +23174,16 @@ func (p *nodeProcessorBootstrapped) Process(seqId int32, iprot, oprot thrift.TPr
 	return true, err
 }
 
-type nodeProcessorBootstrappedInPlacementOrNoPlacement struct {
+type nodeProcessorDebugIndexMemorySegments struct {
 	handler Node
 }
 
-func (p *nodeProcessorBootstrappedInPlacementOrNoPlacement) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeBootstrappedInPlacementOrNoPlacementArgs{}
+func (p *nodeProcessorDebugIndexMemorySegments) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NodeDebugIndexMemorySegmentsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("bootstrappedInPlacementOrNoPlacement", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("debugIndexMemorySegments", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -19128,16 +23191,16 @@ func (p *nodeProcessorBootstrappedInPlacementOrNoPlacement) Process(seqId int32,
 	}
 
 	iprot.ReadMessageEnd()
-	result := NodeBootstrappedInPlacementOrNoPlacementResult{}
-	var retval *NodeBootstrappedInPlacementOrNoPlacementResult_
+	result := NodeDebugIndexMemorySegmentsResult{}
+	var retval *DebugIndexMemorySegmentsResult_
 	var err2 error
-	if retval, err2 = p.handler.BootstrappedInPlacementOrNoPlacement(); err2 != nil {
+	if retval, err2 = p.handler.DebugIndexMemorySegments(args.Req); err2 != nil {
 		switch v := err2.(type) {
 		case *Error:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing bootstrappedInPlacementOrNoPlacement: "+err2.Error())
-			oprot.WriteMessageBegin("bootstrappedInPlacementOrNoPlacement", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing debugIndexMemorySegments: "+err2.Error())
+			oprot.WriteMessageBegin("debugIndexMemorySegments", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
@@ -19146,7 +23209,7 @@ func (p *nodeProcessorBootstrappedInPlacementOrNoPlacement) Process(seqId int32,
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("bootstrappedInPlacementOrNoPlacement", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("debugIndexMemorySegments", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -19161,617 +23224,799 @@ func (p *nodeProcessorBootstrappedInPlacementOrNoPlacement) Process(seqId int32,
 	if err != nil {
 		return
 	}
-	return true, err
+	return true, err
+}
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - Req
+type NodeQueryArgs struct {
+	Req *QueryRequest `thrift:"req,1" db:"req" json:"req"`
+}
+
+func NewNodeQueryArgs() *NodeQueryArgs {
+	return &NodeQueryArgs{}
+}
+
+var NodeQueryArgs_Req_DEFAULT *QueryRequest
+
+func (p *NodeQueryArgs) GetReq() *QueryRequest {
+	if !p.IsSetReq() {
+		return NodeQueryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *NodeQueryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NodeQueryArgs) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *NodeQueryArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &QueryRequest{
+		RangeType: 0,
+
+		ResultTimeType: 0,
+	}
+	if err := p.Req.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
+	}
+	return nil
+}
+
+func (p *NodeQueryArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("query_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *NodeQueryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Req), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:req: ", p), err)
+	}
+	return err
+}
+
+func (p *NodeQueryArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NodeQueryArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type NodeQueryResult struct {
+	Success *QueryResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error        `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewNodeQueryResult() *NodeQueryResult {
+	return &NodeQueryResult{}
+}
+
+var NodeQueryResult_Success_DEFAULT *QueryResult_
+
+func (p *NodeQueryResult) GetSuccess() *QueryResult_ {
+	if !p.IsSetSuccess() {
+		return NodeQueryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var NodeQueryResult_Err_DEFAULT *Error
+
+func (p *NodeQueryResult) GetErr() *Error {
+	if !p.IsSetErr() {
+		return NodeQueryResult_Err_DEFAULT
+	}
+	return p.Err
+}
+func (p *NodeQueryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NodeQueryResult) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *NodeQueryResult) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if err := p.ReadField0(iprot); err != nil {
+				return err
+			}
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *NodeQueryResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &QueryResult_{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
 }
 
-type nodeProcessorGetPersistRateLimit struct {
-	handler Node
+func (p *NodeQueryResult) ReadField1(iprot thrift.TProtocol) error {
+	p.Err = &Error{
+		Type: 0,
+	}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
 }
 
-func (p *nodeProcessorGetPersistRateLimit) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeGetPersistRateLimitArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getPersistRateLimit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+func (p *NodeQueryResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("query_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-
-	iprot.ReadMessageEnd()
-	result := NodeGetPersistRateLimitResult{}
-	var retval *NodePersistRateLimitResult_
-	var err2 error
-	if retval, err2 = p.handler.GetPersistRateLimit(); err2 != nil {
-		switch v := err2.(type) {
-		case *Error:
-			result.Err = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getPersistRateLimit: "+err2.Error())
-			oprot.WriteMessageBegin("getPersistRateLimit", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
+	if p != nil {
+		if err := p.writeField0(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(oprot); err != nil {
+			return err
 		}
-	} else {
-		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("getPersistRateLimit", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *NodeQueryResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
 	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
+	return err
+}
+
+func (p *NodeQueryResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
+		}
+		if err := p.Err.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err)
+		}
 	}
-	if err != nil {
-		return
+	return err
+}
+
+func (p *NodeQueryResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("NodeQueryResult(%+v)", *p)
 }
 
-type nodeProcessorSetPersistRateLimit struct {
-	handler Node
+// Attributes:
+//  - Req
+type NodeAggregateArgs struct {
+	Req *AggregateQueryRequest `thrift:"req,1" db:"req" json:"req"`
 }
 
-func (p *nodeProcessorSetPersistRateLimit) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeSetPersistRateLimitArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("setPersistRateLimit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+func NewNodeAggregateArgs() *NodeAggregateArgs {
+	return &NodeAggregateArgs{}
+}
+
+var NodeAggregateArgs_Req_DEFAULT *AggregateQueryRequest
+
+func (p *NodeAggregateArgs) GetReq() *AggregateQueryRequest {
+	if !p.IsSetReq() {
+		return NodeAggregateArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *NodeAggregateArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NodeAggregateArgs) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	iprot.ReadMessageEnd()
-	result := NodeSetPersistRateLimitResult{}
-	var retval *NodePersistRateLimitResult_
-	var err2 error
-	if retval, err2 = p.handler.SetPersistRateLimit(args.Req); err2 != nil {
-		switch v := err2.(type) {
-		case *Error:
-			result.Err = v
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing setPersistRateLimit: "+err2.Error())
-			oprot.WriteMessageBegin("setPersistRateLimit", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
 		}
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("setPersistRateLimit", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
 	}
-	if err != nil {
-		return
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	return true, err
+	return nil
 }
 
-type nodeProcessorGetWriteNewSeriesAsync struct {
-	handler Node
-}
+func (p *NodeAggregateArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &AggregateQueryRequest{
+		AggregateQueryType: 1,
 
-func (p *nodeProcessorGetWriteNewSeriesAsync) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeGetWriteNewSeriesAsyncArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getWriteNewSeriesAsync", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+		RangeType: 0,
+	}
+	if err := p.Req.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	result := NodeGetWriteNewSeriesAsyncResult{}
-	var retval *NodeWriteNewSeriesAsyncResult_
-	var err2 error
-	if retval, err2 = p.handler.GetWriteNewSeriesAsync(); err2 != nil {
-		switch v := err2.(type) {
-		case *Error:
-			result.Err = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getWriteNewSeriesAsync: "+err2.Error())
-			oprot.WriteMessageBegin("getWriteNewSeriesAsync", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
+func (p *NodeAggregateArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("aggregate_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
 		}
-	} else {
-		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("getWriteNewSeriesAsync", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *NodeAggregateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Req), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:req: ", p), err)
 	}
-	if err != nil {
-		return
+	return err
+}
+
+func (p *NodeAggregateArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("NodeAggregateArgs(%+v)", *p)
 }
 
-type nodeProcessorSetWriteNewSeriesAsync struct {
-	handler Node
+// Attributes:
+//  - Success
+//  - Err
+type NodeAggregateResult struct {
+	Success *AggregateQueryResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error                 `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func (p *nodeProcessorSetWriteNewSeriesAsync) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeSetWriteNewSeriesAsyncArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("setWriteNewSeriesAsync", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
-	}
+func NewNodeAggregateResult() *NodeAggregateResult {
+	return &NodeAggregateResult{}
+}
 
-	iprot.ReadMessageEnd()
-	result := NodeSetWriteNewSeriesAsyncResult{}
-	var retval *NodeWriteNewSeriesAsyncResult_
-	var err2 error
-	if retval, err2 = p.handler.SetWriteNewSeriesAsync(args.Req); err2 != nil {
-		switch v := err2.(type) {
-		case *Error:
-			result.Err = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing setWriteNewSeriesAsync: "+err2.Error())
-			oprot.WriteMessageBegin("setWriteNewSeriesAsync", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
-		}
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("setWriteNewSeriesAsync", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
+var NodeAggregateResult_Success_DEFAULT *AggregateQueryResult_
+
+func (p *NodeAggregateResult) GetSuccess() *AggregateQueryResult_ {
+	if !p.IsSetSuccess() {
+		return NodeAggregateResult_Success_DEFAULT
 	}
-	if err != nil {
-		return
+	return p.Success
+}
+
+var NodeAggregateResult_Err_DEFAULT *Error
+
+func (p *NodeAggregateResult) GetErr() *Error {
+	if !p.IsSetErr() {
+		return NodeAggregateResult_Err_DEFAULT
 	}
-	return true, err
+	return p.Err
+}
+func (p *NodeAggregateResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-type nodeProcessorGetWriteNewSeriesBackoffDuration struct {
-	handler Node
+func (p *NodeAggregateResult) IsSetErr() bool {
+	return p.Err != nil
 }
 
-func (p *nodeProcessorGetWriteNewSeriesBackoffDuration) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeGetWriteNewSeriesBackoffDurationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getWriteNewSeriesBackoffDuration", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+func (p *NodeAggregateResult) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	iprot.ReadMessageEnd()
-	result := NodeGetWriteNewSeriesBackoffDurationResult{}
-	var retval *NodeWriteNewSeriesBackoffDurationResult_
-	var err2 error
-	if retval, err2 = p.handler.GetWriteNewSeriesBackoffDuration(); err2 != nil {
-		switch v := err2.(type) {
-		case *Error:
-			result.Err = v
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if err := p.ReadField0(iprot); err != nil {
+				return err
+			}
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getWriteNewSeriesBackoffDuration: "+err2.Error())
-			oprot.WriteMessageBegin("getWriteNewSeriesBackoffDuration", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
 		}
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("getWriteNewSeriesBackoffDuration", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
 	}
-	if err != nil {
-		return
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	return true, err
+	return nil
 }
 
-type nodeProcessorSetWriteNewSeriesBackoffDuration struct {
-	handler Node
+func (p *NodeAggregateResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &AggregateQueryResult_{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
 }
 
-func (p *nodeProcessorSetWriteNewSeriesBackoffDuration) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeSetWriteNewSeriesBackoffDurationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("setWriteNewSeriesBackoffDuration", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+func (p *NodeAggregateResult) ReadField1(iprot thrift.TProtocol) error {
+	p.Err = &Error{
+		Type: 0,
+	}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
 	}
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	result := NodeSetWriteNewSeriesBackoffDurationResult{}
-	var retval *NodeWriteNewSeriesBackoffDurationResult_
-	var err2 error
-	if retval, err2 = p.handler.SetWriteNewSeriesBackoffDuration(args.Req); err2 != nil {
-		switch v := err2.(type) {
-		case *Error:
-			result.Err = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing setWriteNewSeriesBackoffDuration: "+err2.Error())
-			oprot.WriteMessageBegin("setWriteNewSeriesBackoffDuration", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
+func (p *NodeAggregateResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("aggregate_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(oprot); err != nil {
+			return err
 		}
-	} else {
-		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("setWriteNewSeriesBackoffDuration", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *NodeAggregateResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
 	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
+	return err
+}
+
+func (p *NodeAggregateResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
+		}
+		if err := p.Err.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err)
+		}
 	}
-	if err != nil {
-		return
+	return err
+}
+
+func (p *NodeAggregateResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("NodeAggregateResult(%+v)", *p)
 }
 
-type nodeProcessorGetWriteNewSeriesLimitPerShardPerSecond struct {
-	handler Node
+// Attributes:
+//  - Req
+type NodeFetchArgs struct {
+	Req *FetchRequest `thrift:"req,1" db:"req" json:"req"`
 }
 
-func (p *nodeProcessorGetWriteNewSeriesLimitPerShardPerSecond) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeGetWriteNewSeriesLimitPerShardPerSecondArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getWriteNewSeriesLimitPerShardPerSecond", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+func NewNodeFetchArgs() *NodeFetchArgs {
+	return &NodeFetchArgs{}
+}
+
+var NodeFetchArgs_Req_DEFAULT *FetchRequest
+
+func (p *NodeFetchArgs) GetReq() *FetchRequest {
+	if !p.IsSetReq() {
+		return NodeFetchArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *NodeFetchArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NodeFetchArgs) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	iprot.ReadMessageEnd()
-	result := NodeGetWriteNewSeriesLimitPerShardPerSecondResult{}
-	var retval *NodeWriteNewSeriesLimitPerShardPerSecondResult_
-	var err2 error
-	if retval, err2 = p.handler.GetWriteNewSeriesLimitPerShardPerSecond(); err2 != nil {
-		switch v := err2.(type) {
-		case *Error:
-			result.Err = v
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getWriteNewSeriesLimitPerShardPerSecond: "+err2.Error())
-			oprot.WriteMessageBegin("getWriteNewSeriesLimitPerShardPerSecond", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
 		}
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("getWriteNewSeriesLimitPerShardPerSecond", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
 	}
-	if err != nil {
-		return
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	return true, err
+	return nil
 }
 
-type nodeProcessorSetWriteNewSeriesLimitPerShardPerSecond struct {
-	handler Node
-}
+func (p *NodeFetchArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &FetchRequest{
+		RangeType: 0,
 
-func (p *nodeProcessorSetWriteNewSeriesLimitPerShardPerSecond) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeSetWriteNewSeriesLimitPerShardPerSecondArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("setWriteNewSeriesLimitPerShardPerSecond", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+		ResultTimeType: 0,
+	}
+	if err := p.Req.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	result := NodeSetWriteNewSeriesLimitPerShardPerSecondResult{}
-	var retval *NodeWriteNewSeriesLimitPerShardPerSecondResult_
-	var err2 error
-	if retval, err2 = p.handler.SetWriteNewSeriesLimitPerShardPerSecond(args.Req); err2 != nil {
-		switch v := err2.(type) {
-		case *Error:
-			result.Err = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing setWriteNewSeriesLimitPerShardPerSecond: "+err2.Error())
-			oprot.WriteMessageBegin("setWriteNewSeriesLimitPerShardPerSecond", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
+func (p *NodeFetchArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetch_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
 		}
-	} else {
-		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("setWriteNewSeriesLimitPerShardPerSecond", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *NodeFetchArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Req), err)
 	}
-	if err != nil {
-		return
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:req: ", p), err)
 	}
-	return true, err
+	return err
 }
 
-type nodeProcessorDebugProfileStart struct {
-	handler Node
+func (p *NodeFetchArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NodeFetchArgs(%+v)", *p)
 }
 
-func (p *nodeProcessorDebugProfileStart) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeDebugProfileStartArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("debugProfileStart", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
-	}
+// Attributes:
+//  - Success
+//  - Err
+type NodeFetchResult struct {
+	Success *FetchResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error        `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
 
-	iprot.ReadMessageEnd()
-	result := NodeDebugProfileStartResult{}
-	var retval *DebugProfileStartResult_
-	var err2 error
-	if retval, err2 = p.handler.DebugProfileStart(args.Req); err2 != nil {
-		switch v := err2.(type) {
-		case *Error:
-			result.Err = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing debugProfileStart: "+err2.Error())
-			oprot.WriteMessageBegin("debugProfileStart", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
-		}
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("debugProfileStart", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func NewNodeFetchResult() *NodeFetchResult {
+	return &NodeFetchResult{}
+}
+
+var NodeFetchResult_Success_DEFAULT *FetchResult_
+
+func (p *NodeFetchResult) GetSuccess() *FetchResult_ {
+	if !p.IsSetSuccess() {
+		return NodeFetchResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type nodeProcessorDebugProfileStop struct {
-	handler Node
+var NodeFetchResult_Err_DEFAULT *Error
+
+func (p *NodeFetchResult) GetErr() *Error {
+	if !p.IsSetErr() {
+		return NodeFetchResult_Err_DEFAULT
+	}
+	return p.Err
+}
+func (p *NodeFetchResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *nodeProcessorDebugProfileStop) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeDebugProfileStopArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("debugProfileStop", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+func (p *NodeFetchResult) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *NodeFetchResult) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	iprot.ReadMessageEnd()
-	result := NodeDebugProfileStopResult{}
-	var retval *DebugProfileStopResult_
-	var err2 error
-	if retval, err2 = p.handler.DebugProfileStop(args.Req); err2 != nil {
-		switch v := err2.(type) {
-		case *Error:
-			result.Err = v
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if err := p.ReadField0(iprot); err != nil {
+				return err
+			}
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing debugProfileStop: "+err2.Error())
-			oprot.WriteMessageBegin("debugProfileStop", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
 		}
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("debugProfileStop", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
 	}
-	if err != nil {
-		return
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	return true, err
+	return nil
 }
 
-type nodeProcessorDebugIndexMemorySegments struct {
-	handler Node
+func (p *NodeFetchResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &FetchResult_{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
 }
 
-func (p *nodeProcessorDebugIndexMemorySegments) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NodeDebugIndexMemorySegmentsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("debugIndexMemorySegments", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+func (p *NodeFetchResult) ReadField1(iprot thrift.TProtocol) error {
+	p.Err = &Error{
+		Type: 0,
+	}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
 	}
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	result := NodeDebugIndexMemorySegmentsResult{}
-	var retval *DebugIndexMemorySegmentsResult_
-	var err2 error
-	if retval, err2 = p.handler.DebugIndexMemorySegments(args.Req); err2 != nil {
-		switch v := err2.(type) {
-		case *Error:
-			result.Err = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing debugIndexMemorySegments: "+err2.Error())
-			oprot.WriteMessageBegin("debugIndexMemorySegments", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
-		}
-	} else {
-		result.Success = retval
+func (p *NodeFetchResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetch_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err2 = oprot.WriteMessageBegin("debugIndexMemorySegments", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err := p.writeField0(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
+	return nil
+}
+
+func (p *NodeFetchResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
 	}
-	if err != nil {
-		return
+	return err
+}
+
+func (p *NodeFetchResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
+		}
+		if err := p.Err.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err)
+		}
 	}
-	return true, err
+	return err
 }
 
-// HELPER FUNCTIONS AND STRUCTURES
+func (p *NodeFetchResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NodeFetchResult(%+v)", *p)
+}
 
 // Attributes:
 //  - Req
-type NodeQueryArgs struct {
-	Req *QueryRequest `thrift:"req,1" db:"req" json:"req"`
+type NodeWriteArgs struct {
+	Req *WriteRequest `thrift:"req,1" db:"req" json:"req"`
 }
 
-func NewNodeQueryArgs() *NodeQueryArgs {
-	return &NodeQueryArgs{}
+func NewNodeWriteArgs() *NodeWriteArgs {
+	return &NodeWriteArgs{}
 }
 
-var NodeQueryArgs_Req_DEFAULT *QueryRequest
+var NodeWriteArgs_Req_DEFAULT *WriteRequest
 
-func (p *NodeQueryArgs) GetReq() *QueryRequest {
+func (p *NodeWriteArgs) GetReq() *WriteRequest {
 	if !p.IsSetReq() {
-		return NodeQueryArgs_Req_DEFAULT
+		return NodeWriteArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *NodeQueryArgs) IsSetReq() bool {
+func (p *NodeWriteArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NodeQueryArgs) Read(iprot thrift.TProtocol) error {
+func (p *NodeWriteArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -19804,20 +24049,16 @@ func (p *NodeQueryArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeQueryArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = &QueryRequest{
-		RangeType: 0,
-
-		ResultTimeType: 0,
-	}
+func (p *NodeWriteArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &WriteRequest{}
 	if err := p.Req.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
 	return nil
 }
 
-func (p *NodeQueryArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("query_args"); err != nil {
+func (p *NodeWriteArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("write_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -19834,7 +24075,7 @@ func (p *NodeQueryArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeQueryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeWriteArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
@@ -19847,51 +24088,36 @@ func (p *NodeQueryArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeQueryArgs) String() string {
+func (p *NodeWriteArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeQueryArgs(%+v)", *p)
+	return fmt.Sprintf("NodeWriteArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - Err
-type NodeQueryResult struct {
-	Success *QueryResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
-	Err     *Error        `thrift:"err,1" db:"err" json:"err,omitempty"`
-}
-
-func NewNodeQueryResult() *NodeQueryResult {
-	return &NodeQueryResult{}
+type NodeWriteResult struct {
+	Err *Error `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-var NodeQueryResult_Success_DEFAULT *QueryResult_
-
-func (p *NodeQueryResult) GetSuccess() *QueryResult_ {
-	if !p.IsSetSuccess() {
-		return NodeQueryResult_Success_DEFAULT
-	}
-	return p.Success
+func NewNodeWriteResult() *NodeWriteResult {
+	return &NodeWriteResult{}
 }
 
-var NodeQueryResult_Err_DEFAULT *Error
+var NodeWriteResult_Err_DEFAULT *Error
 
-func (p *NodeQueryResult) GetErr() *Error {
+func (p *NodeWriteResult) GetErr() *Error {
 	if !p.IsSetErr() {
-		return NodeQueryResult_Err_DEFAULT
+		return NodeWriteResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *NodeQueryResult) IsSetSuccess() bool {
-	return p.Success != nil
-}
-
-func (p *NodeQueryResult) IsSetErr() bool {
+func (p *NodeWriteResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *NodeQueryResult) Read(iprot thrift.TProtocol) error {
+func (p *NodeWriteResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -19905,10 +24131,6 @@ func (p *NodeQueryResult) Read(iprot thrift.TProtocol) error {
 			break
 		}
 		switch fieldId {
-		case 0:
-			if err := p.ReadField0(iprot); err != nil {
-				return err
-			}
 		case 1:
 			if err := p.ReadField1(iprot); err != nil {
 				return err
@@ -19928,15 +24150,7 @@ func (p *NodeQueryResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeQueryResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = &QueryResult_{}
-	if err := p.Success.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-	}
-	return nil
-}
-
-func (p *NodeQueryResult) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeWriteResult) ReadField1(iprot thrift.TProtocol) error {
 	p.Err = &Error{
 		Type: 0,
 	}
@@ -19946,14 +24160,11 @@ func (p *NodeQueryResult) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeQueryResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("query_result"); err != nil {
+func (p *NodeWriteResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("write_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
-		if err := p.writeField0(oprot); err != nil {
-			return err
-		}
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
@@ -19967,22 +24178,7 @@ func (p *NodeQueryResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeQueryResult) writeField0(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
-		}
-		if err := p.Success.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *NodeQueryResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeWriteResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
@@ -19997,36 +24193,36 @@ func (p *NodeQueryResult) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeQueryResult) String() string {
+func (p *NodeWriteResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeQueryResult(%+v)", *p)
+	return fmt.Sprintf("NodeWriteResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Req
-type NodeAggregateArgs struct {
-	Req *AggregateQueryRequest `thrift:"req,1" db:"req" json:"req"`
+type NodeWriteTaggedArgs struct {
+	Req *WriteTaggedRequest `thrift:"req,1" db:"req" json:"req"`
 }
 
-func NewNodeAggregateArgs() *NodeAggregateArgs {
-	return &NodeAggregateArgs{}
+func NewNodeWriteTaggedArgs() *NodeWriteTaggedArgs {
+	return &NodeWriteTaggedArgs{}
 }
 
-var NodeAggregateArgs_Req_DEFAULT *AggregateQueryRequest
+var NodeWriteTaggedArgs_Req_DEFAULT *WriteTaggedRequest
 
-func (p *NodeAggregateArgs) GetReq() *AggregateQueryRequest {
+func (p *NodeWriteTaggedArgs) GetReq() *WriteTaggedRequest {
 	if !p.IsSetReq() {
-		return NodeAggregateArgs_Req_DEFAULT
+		return NodeWriteTaggedArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *NodeAggregateArgs) IsSetReq() bool {
+func (p *NodeWriteTaggedArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NodeAggregateArgs) Read(iprot thrift.TProtocol) error {
+func (p *NodeWriteTaggedArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -20059,20 +24255,16 @@ func (p *NodeAggregateArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeAggregateArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = &AggregateQueryRequest{
-		AggregateQueryType: 1,
-
-		RangeType: 0,
-	}
+func (p *NodeWriteTaggedArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &WriteTaggedRequest{}
 	if err := p.Req.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
 	return nil
 }
 
-func (p *NodeAggregateArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("aggregate_args"); err != nil {
+func (p *NodeWriteTaggedArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("writeTagged_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -20089,7 +24281,7 @@ func (p *NodeAggregateArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeAggregateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeWriteTaggedArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
@@ -20102,51 +24294,36 @@ func (p *NodeAggregateArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeAggregateArgs) String() string {
+func (p *NodeWriteTaggedArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeAggregateArgs(%+v)", *p)
+	return fmt.Sprintf("NodeWriteTaggedArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - Err
-type NodeAggregateResult struct {
-	Success *AggregateQueryResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
-	Err     *Error                 `thrift:"err,1" db:"err" json:"err,omitempty"`
-}
-
-func NewNodeAggregateResult() *NodeAggregateResult {
-	return &NodeAggregateResult{}
+type NodeWriteTaggedResult struct {
+	Err *Error `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-var NodeAggregateResult_Success_DEFAULT *AggregateQueryResult_
-
-func (p *NodeAggregateResult) GetSuccess() *AggregateQueryResult_ {
-	if !p.IsSetSuccess() {
-		return NodeAggregateResult_Success_DEFAULT
-	}
-	return p.Success
+func NewNodeWriteTaggedResult() *NodeWriteTaggedResult {
+	return &NodeWriteTaggedResult{}
 }
 
-var NodeAggregateResult_Err_DEFAULT *Error
+var NodeWriteTaggedResult_Err_DEFAULT *Error
 
-func (p *NodeAggregateResult) GetErr() *Error {
+func (p *NodeWriteTaggedResult) GetErr() *Error {
 	if !p.IsSetErr() {
-		return NodeAggregateResult_Err_DEFAULT
+		return NodeWriteTaggedResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *NodeAggregateResult) IsSetSuccess() bool {
-	return p.Success != nil
-}
-
-func (p *NodeAggregateResult) IsSetErr() bool {
+func (p *NodeWriteTaggedResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *NodeAggregateResult) Read(iprot thrift.TProtocol) error {
+func (p *NodeWriteTaggedResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -20160,10 +24337,6 @@ func (p *NodeAggregateResult) Read(iprot thrift.TProtocol) error {
 			break
 		}
 		switch fieldId {
-		case 0:
-			if err := p.ReadField0(iprot); err != nil {
-				return err
-			}
 		case 1:
 			if err := p.ReadField1(iprot); err != nil {
 				return err
@@ -20183,15 +24356,7 @@ func (p *NodeAggregateResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeAggregateResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = &AggregateQueryResult_{}
-	if err := p.Success.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-	}
-	return nil
-}
-
-func (p *NodeAggregateResult) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeWriteTaggedResult) ReadField1(iprot thrift.TProtocol) error {
 	p.Err = &Error{
 		Type: 0,
 	}
@@ -20201,14 +24366,11 @@ func (p *NodeAggregateResult) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeAggregateResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("aggregate_result"); err != nil {
+func (p *NodeWriteTaggedResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("writeTagged_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
-		if err := p.writeField0(oprot); err != nil {
-			return err
-		}
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
@@ -20222,22 +24384,7 @@ func (p *NodeAggregateResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeAggregateResult) writeField0(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
-		}
-		if err := p.Success.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *NodeAggregateResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeWriteTaggedResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
@@ -20252,36 +24399,36 @@ func (p *NodeAggregateResult) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeAggregateResult) String() string {
+func (p *NodeWriteTaggedResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeAggregateResult(%+v)", *p)
+	return fmt.Sprintf("NodeWriteTaggedResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Req
-type NodeFetchArgs struct {
-	Req *FetchRequest `thrift:"req,1" db:"req" json:"req"`
+type NodeAggregateRawArgs struct {
+	Req *AggregateQueryRawRequest `thrift:"req,1" db:"req" json:"req"`
 }
 
-func NewNodeFetchArgs() *NodeFetchArgs {
-	return &NodeFetchArgs{}
+func NewNodeAggregateRawArgs() *NodeAggregateRawArgs {
+	return &NodeAggregateRawArgs{}
 }
 
-var NodeFetchArgs_Req_DEFAULT *FetchRequest
+var NodeAggregateRawArgs_Req_DEFAULT *AggregateQueryRawRequest
 
-func (p *NodeFetchArgs) GetReq() *FetchRequest {
+func (p *NodeAggregateRawArgs) GetReq() *AggregateQueryRawRequest {
 	if !p.IsSetReq() {
-		return NodeFetchArgs_Req_DEFAULT
+		return NodeAggregateRawArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *NodeFetchArgs) IsSetReq() bool {
+func (p *NodeAggregateRawArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NodeFetchArgs) Read(iprot thrift.TProtocol) error {
+func (p *NodeAggregateRawArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -20314,11 +24461,11 @@ func (p *NodeFetchArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = &FetchRequest{
-		RangeType: 0,
+func (p *NodeAggregateRawArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &AggregateQueryRawRequest{
+		AggregateQueryType: 1,
 
-		ResultTimeType: 0,
+		RangeType: 0,
 	}
 	if err := p.Req.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
@@ -20326,8 +24473,8 @@ func (p *NodeFetchArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("fetch_args"); err != nil {
+func (p *NodeAggregateRawArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("aggregateRaw_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -20344,7 +24491,7 @@ func (p *NodeFetchArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeAggregateRawArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
@@ -20357,51 +24504,51 @@ func (p *NodeFetchArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeFetchArgs) String() string {
+func (p *NodeAggregateRawArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeFetchArgs(%+v)", *p)
+	return fmt.Sprintf("NodeAggregateRawArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - Err
-type NodeFetchResult struct {
-	Success *FetchResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
-	Err     *Error        `thrift:"err,1" db:"err" json:"err,omitempty"`
+type NodeAggregateRawResult struct {
+	Success *AggregateQueryRawResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error                    `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewNodeFetchResult() *NodeFetchResult {
-	return &NodeFetchResult{}
+func NewNodeAggregateRawResult() *NodeAggregateRawResult {
+	return &NodeAggregateRawResult{}
 }
 
-var NodeFetchResult_Success_DEFAULT *FetchResult_
+var NodeAggregateRawResult_Success_DEFAULT *AggregateQueryRawResult_
 
-func (p *NodeFetchResult) GetSuccess() *FetchResult_ {
+func (p *NodeAggregateRawResult) GetSuccess() *AggregateQueryRawResult_ {
 	if !p.IsSetSuccess() {
-		return NodeFetchResult_Success_DEFAULT
+		return NodeAggregateRawResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var NodeFetchResult_Err_DEFAULT *Error
+var NodeAggregateRawResult_Err_DEFAULT *Error
 
-func (p *NodeFetchResult) GetErr() *Error {
+func (p *NodeAggregateRawResult) GetErr() *Error {
 	if !p.IsSetErr() {
-		return NodeFetchResult_Err_DEFAULT
+		return NodeAggregateRawResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *NodeFetchResult) IsSetSuccess() bool {
+func (p *NodeAggregateRawResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NodeFetchResult) IsSetErr() bool {
+func (p *NodeAggregateRawResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *NodeFetchResult) Read(iprot thrift.TProtocol) error {
+func (p *NodeAggregateRawResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -20438,15 +24585,15 @@ func (p *NodeFetchResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = &FetchResult_{}
+func (p *NodeAggregateRawResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &AggregateQueryRawResult_{}
 	if err := p.Success.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *NodeFetchResult) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeAggregateRawResult) ReadField1(iprot thrift.TProtocol) error {
 	p.Err = &Error{
 		Type: 0,
 	}
@@ -20456,8 +24603,8 @@ func (p *NodeFetchResult) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("fetch_result"); err != nil {
+func (p *NodeAggregateRawResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("aggregateRaw_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -20477,7 +24624,7 @@ func (p *NodeFetchResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NodeAggregateRawResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
@@ -20492,7 +24639,7 @@ func (p *NodeFetchResult) writeField0(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeFetchResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeAggregateRawResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
@@ -20507,36 +24654,36 @@ func (p *NodeFetchResult) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeFetchResult) String() string {
+func (p *NodeAggregateRawResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeFetchResult(%+v)", *p)
+	return fmt.Sprintf("NodeAggregateRawResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Req
-type NodeWriteArgs struct {
-	Req *WriteRequest `thrift:"req,1" db:"req" json:"req"`
+type NodeFetchBatchRawArgs struct {
+	Req *FetchBatchRawRequest `thrift:"req,1" db:"req" json:"req"`
 }
 
-func NewNodeWriteArgs() *NodeWriteArgs {
-	return &NodeWriteArgs{}
+func NewNodeFetchBatchRawArgs() *NodeFetchBatchRawArgs {
+	return &NodeFetchBatchRawArgs{}
 }
 
-var NodeWriteArgs_Req_DEFAULT *WriteRequest
+var NodeFetchBatchRawArgs_Req_DEFAULT *FetchBatchRawRequest
 
-func (p *NodeWriteArgs) GetReq() *WriteRequest {
+func (p *NodeFetchBatchRawArgs) GetReq() *FetchBatchRawRequest {
 	if !p.IsSetReq() {
-		return NodeWriteArgs_Req_DEFAULT
+		return NodeFetchBatchRawArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *NodeWriteArgs) IsSetReq() bool {
+func (p *NodeFetchBatchRawArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NodeWriteArgs) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchBatchRawArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -20569,16 +24716,18 @@ func (p *NodeWriteArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeWriteArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = &WriteRequest{}
+func (p *NodeFetchBatchRawArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &FetchBatchRawRequest{
+		RangeTimeType: 0,
+	}
 	if err := p.Req.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
 	return nil
 }
 
-func (p *NodeWriteArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("write_args"); err != nil {
+func (p *NodeFetchBatchRawArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchBatchRaw_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -20595,7 +24744,7 @@ func (p *NodeWriteArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeWriteArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchBatchRawArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
@@ -20608,36 +24757,51 @@ func (p *NodeWriteArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeWriteArgs) String() string {
+func (p *NodeFetchBatchRawArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeWriteArgs(%+v)", *p)
+	return fmt.Sprintf("NodeFetchBatchRawArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type NodeFetchBatchRawResult struct {
+	Success *FetchBatchRawResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error                `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-// Attributes:
-//  - Err
-type NodeWriteResult struct {
-	Err *Error `thrift:"err,1" db:"err" json:"err,omitempty"`
+func NewNodeFetchBatchRawResult() *NodeFetchBatchRawResult {
+	return &NodeFetchBatchRawResult{}
 }
 
-func NewNodeWriteResult() *NodeWriteResult {
-	return &NodeWriteResult{}
+var NodeFetchBatchRawResult_Success_DEFAULT *FetchBatchRawResult_
+
+func (p *NodeFetchBatchRawResult) GetSuccess() *FetchBatchRawResult_ {
+	if !p.IsSetSuccess() {
+		return NodeFetchBatchRawResult_Success_DEFAULT
+	}
+	return p.Success
 }
 
-var NodeWriteResult_Err_DEFAULT *Error
+var NodeFetchBatchRawResult_Err_DEFAULT *Error
 
-func (p *NodeWriteResult) GetErr() *Error {
+func (p *NodeFetchBatchRawResult) GetErr() *Error {
 	if !p.IsSetErr() {
-		return NodeWriteResult_Err_DEFAULT
+		return NodeFetchBatchRawResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *NodeWriteResult) IsSetErr() bool {
+func (p *NodeFetchBatchRawResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NodeFetchBatchRawResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *NodeWriteResult) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchBatchRawResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -20651,6 +24815,10 @@ func (p *NodeWriteResult) Read(iprot thrift.TProtocol) error {
 			break
 		}
 		switch fieldId {
+		case 0:
+			if err := p.ReadField0(iprot); err != nil {
+				return err
+			}
 		case 1:
 			if err := p.ReadField1(iprot); err != nil {
 				return err
@@ -20670,7 +24838,15 @@ func (p *NodeWriteResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeWriteResult) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeFetchBatchRawResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &FetchBatchRawResult_{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *NodeFetchBatchRawResult) ReadField1(iprot thrift.TProtocol) error {
 	p.Err = &Error{
 		Type: 0,
 	}
@@ -20680,11 +24856,14 @@ func (p *NodeWriteResult) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeWriteResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("write_result"); err != nil {
+func (p *NodeFetchBatchRawResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchBatchRaw_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
+		if err := p.writeField0(oprot); err != nil {
+			return err
+		}
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
@@ -20698,7 +24877,22 @@ func (p *NodeWriteResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeWriteResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchBatchRawResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *NodeFetchBatchRawResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
@@ -20713,36 +24907,36 @@ func (p *NodeWriteResult) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeWriteResult) String() string {
+func (p *NodeFetchBatchRawResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeWriteResult(%+v)", *p)
+	return fmt.Sprintf("NodeFetchBatchRawResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Req
-type NodeWriteTaggedArgs struct {
-	Req *WriteTaggedRequest `thrift:"req,1" db:"req" json:"req"`
+type NodeFetchBatchRawV2Args struct {
+	Req *FetchBatchRawV2Request `thrift:"req,1" db:"req" json:"req"`
 }
 
-func NewNodeWriteTaggedArgs() *NodeWriteTaggedArgs {
-	return &NodeWriteTaggedArgs{}
+func NewNodeFetchBatchRawV2Args() *NodeFetchBatchRawV2Args {
+	return &NodeFetchBatchRawV2Args{}
 }
 
-var NodeWriteTaggedArgs_Req_DEFAULT *WriteTaggedRequest
+var NodeFetchBatchRawV2Args_Req_DEFAULT *FetchBatchRawV2Request
 
-func (p *NodeWriteTaggedArgs) GetReq() *WriteTaggedRequest {
+func (p *NodeFetchBatchRawV2Args) GetReq() *FetchBatchRawV2Request {
 	if !p.IsSetReq() {
-		return NodeWriteTaggedArgs_Req_DEFAULT
+		return NodeFetchBatchRawV2Args_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *NodeWriteTaggedArgs) IsSetReq() bool {
+func (p *NodeFetchBatchRawV2Args) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NodeWriteTaggedArgs) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchBatchRawV2Args) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -20775,16 +24969,16 @@ func (p *NodeWriteTaggedArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeWriteTaggedArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = &WriteTaggedRequest{}
+func (p *NodeFetchBatchRawV2Args) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &FetchBatchRawV2Request{}
 	if err := p.Req.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
 	return nil
 }
 
-func (p *NodeWriteTaggedArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("writeTagged_args"); err != nil {
+func (p *NodeFetchBatchRawV2Args) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchBatchRawV2_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -20801,7 +24995,7 @@ func (p *NodeWriteTaggedArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeWriteTaggedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchBatchRawV2Args) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
@@ -20814,36 +25008,51 @@ func (p *NodeWriteTaggedArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeWriteTaggedArgs) String() string {
+func (p *NodeFetchBatchRawV2Args) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeWriteTaggedArgs(%+v)", *p)
+	return fmt.Sprintf("NodeFetchBatchRawV2Args(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - Err
-type NodeWriteTaggedResult struct {
-	Err *Error `thrift:"err,1" db:"err" json:"err,omitempty"`
+type NodeFetchBatchRawV2Result struct {
+	Success *FetchBatchRawResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error                `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewNodeWriteTaggedResult() *NodeWriteTaggedResult {
-	return &NodeWriteTaggedResult{}
+func NewNodeFetchBatchRawV2Result() *NodeFetchBatchRawV2Result {
+	return &NodeFetchBatchRawV2Result{}
 }
 
-var NodeWriteTaggedResult_Err_DEFAULT *Error
+var NodeFetchBatchRawV2Result_Success_DEFAULT *FetchBatchRawResult_
 
-func (p *NodeWriteTaggedResult) GetErr() *Error {
+func (p *NodeFetchBatchRawV2Result) GetSuccess() *FetchBatchRawResult_ {
+	if !p.IsSetSuccess() {
+		return NodeFetchBatchRawV2Result_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var NodeFetchBatchRawV2Result_Err_DEFAULT *Error
+
+func (p *NodeFetchBatchRawV2Result) GetErr() *Error {
 	if !p.IsSetErr() {
-		return NodeWriteTaggedResult_Err_DEFAULT
+		return NodeFetchBatchRawV2Result_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *NodeWriteTaggedResult) IsSetErr() bool {
+func (p *NodeFetchBatchRawV2Result) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NodeFetchBatchRawV2Result) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *NodeWriteTaggedResult) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchBatchRawV2Result) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -20857,6 +25066,10 @@ func (p *NodeWriteTaggedResult) Read(iprot thrift.TProtocol) error {
 			break
 		}
 		switch fieldId {
+		case 0:
+			if err := p.ReadField0(iprot); err != nil {
+				return err
+			}
 		case 1:
 			if err := p.ReadField1(iprot); err != nil {
 				return err
@@ -20876,7 +25089,15 @@ func (p *NodeWriteTaggedResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeWriteTaggedResult) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeFetchBatchRawV2Result) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &FetchBatchRawResult_{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *NodeFetchBatchRawV2Result) ReadField1(iprot thrift.TProtocol) error {
 	p.Err = &Error{
 		Type: 0,
 	}
@@ -20886,11 +25107,14 @@ func (p *NodeWriteTaggedResult) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeWriteTaggedResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("writeTagged_result"); err != nil {
+func (p *NodeFetchBatchRawV2Result) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchBatchRawV2_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
+		if err := p.writeField0(oprot); err != nil {
+			return err
+		}
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
@@ -20904,7 +25128,22 @@ func (p *NodeWriteTaggedResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeWriteTaggedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchBatchRawV2Result) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *NodeFetchBatchRawV2Result) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
@@ -20919,36 +25158,36 @@ func (p *NodeWriteTaggedResult) writeField1(oprot thrift.TProtocol) (err error)
 	return err
 }
 
-func (p *NodeWriteTaggedResult) String() string {
+func (p *NodeFetchBatchRawV2Result) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeWriteTaggedResult(%+v)", *p)
+	return fmt.Sprintf("NodeFetchBatchRawV2Result(%+v)", *p)
 }
 
 // Attributes:
 //  - Req
-type NodeAggregateRawArgs struct {
-	Req *AggregateQueryRawRequest `thrift:"req,1" db:"req" json:"req"`
+type NodeFetchBlocksRawArgs struct {
+	Req *FetchBlocksRawRequest `thrift:"req,1" db:"req" json:"req"`
 }
 
-func NewNodeAggregateRawArgs() *NodeAggregateRawArgs {
-	return &NodeAggregateRawArgs{}
+func NewNodeFetchBlocksRawArgs() *NodeFetchBlocksRawArgs {
+	return &NodeFetchBlocksRawArgs{}
 }
 
-var NodeAggregateRawArgs_Req_DEFAULT *AggregateQueryRawRequest
+var NodeFetchBlocksRawArgs_Req_DEFAULT *FetchBlocksRawRequest
 
-func (p *NodeAggregateRawArgs) GetReq() *AggregateQueryRawRequest {
+func (p *NodeFetchBlocksRawArgs) GetReq() *FetchBlocksRawRequest {
 	if !p.IsSetReq() {
-		return NodeAggregateRawArgs_Req_DEFAULT
+		return NodeFetchBlocksRawArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *NodeAggregateRawArgs) IsSetReq() bool {
+func (p *NodeFetchBlocksRawArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NodeAggregateRawArgs) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchBlocksRawArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -20981,20 +25220,16 @@ func (p *NodeAggregateRawArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeAggregateRawArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = &AggregateQueryRawRequest{
-		AggregateQueryType: 1,
-
-		RangeType: 0,
-	}
+func (p *NodeFetchBlocksRawArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &FetchBlocksRawRequest{}
 	if err := p.Req.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
 	return nil
 }
 
-func (p *NodeAggregateRawArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("aggregateRaw_args"); err != nil {
+func (p *NodeFetchBlocksRawArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchBlocksRaw_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -21011,7 +25246,7 @@ func (p *NodeAggregateRawArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeAggregateRawArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchBlocksRawArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
@@ -21024,51 +25259,51 @@ func (p *NodeAggregateRawArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeAggregateRawArgs) String() string {
+func (p *NodeFetchBlocksRawArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeAggregateRawArgs(%+v)", *p)
+	return fmt.Sprintf("NodeFetchBlocksRawArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - Err
-type NodeAggregateRawResult struct {
-	Success *AggregateQueryRawResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
-	Err     *Error                    `thrift:"err,1" db:"err" json:"err,omitempty"`
+type NodeFetchBlocksRawResult struct {
+	Success *FetchBlocksRawResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error                 `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewNodeAggregateRawResult() *NodeAggregateRawResult {
-	return &NodeAggregateRawResult{}
+func NewNodeFetchBlocksRawResult() *NodeFetchBlocksRawResult {
+	return &NodeFetchBlocksRawResult{}
 }
 
-var NodeAggregateRawResult_Success_DEFAULT *AggregateQueryRawResult_
+var NodeFetchBlocksRawResult_Success_DEFAULT *FetchBlocksRawResult_
 
-func (p *NodeAggregateRawResult) GetSuccess() *AggregateQueryRawResult_ {
+func (p *NodeFetchBlocksRawResult) GetSuccess() *FetchBlocksRawResult_ {
 	if !p.IsSetSuccess() {
-		return NodeAggregateRawResult_Success_DEFAULT
+		return NodeFetchBlocksRawResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var NodeAggregateRawResult_Err_DEFAULT *Error
+var NodeFetchBlocksRawResult_Err_DEFAULT *Error
 
-func (p *NodeAggregateRawResult) GetErr() *Error {
+func (p *NodeFetchBlocksRawResult) GetErr() *Error {
 	if !p.IsSetErr() {
-		return NodeAggregateRawResult_Err_DEFAULT
+		return NodeFetchBlocksRawResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *NodeAggregateRawResult) IsSetSuccess() bool {
+func (p *NodeFetchBlocksRawResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NodeAggregateRawResult) IsSetErr() bool {
+func (p *NodeFetchBlocksRawResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *NodeAggregateRawResult) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchBlocksRawResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -21105,15 +25340,15 @@ func (p *NodeAggregateRawResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeAggregateRawResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = &AggregateQueryRawResult_{}
+func (p *NodeFetchBlocksRawResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &FetchBlocksRawResult_{}
 	if err := p.Success.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *NodeAggregateRawResult) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeFetchBlocksRawResult) ReadField1(iprot thrift.TProtocol) error {
 	p.Err = &Error{
 		Type: 0,
 	}
@@ -21123,8 +25358,8 @@ func (p *NodeAggregateRawResult) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeAggregateRawResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("aggregateRaw_result"); err != nil {
+func (p *NodeFetchBlocksRawResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchBlocksRaw_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -21144,7 +25379,7 @@ func (p *NodeAggregateRawResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeAggregateRawResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchBlocksRawResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
@@ -21159,7 +25394,7 @@ func (p *NodeAggregateRawResult) writeField0(oprot thrift.TProtocol) (err error)
 	return err
 }
 
-func (p *NodeAggregateRawResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchBlocksRawResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
@@ -21174,36 +25409,36 @@ func (p *NodeAggregateRawResult) writeField1(oprot thrift.TProtocol) (err error)
 	return err
 }
 
-func (p *NodeAggregateRawResult) String() string {
+func (p *NodeFetchBlocksRawResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeAggregateRawResult(%+v)", *p)
+	return fmt.Sprintf("NodeFetchBlocksRawResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Req
-type NodeFetchBatchRawArgs struct {
-	Req *FetchBatchRawRequest `thrift:"req,1" db:"req" json:"req"`
+type NodeFetchTaggedArgs struct {
+	Req *FetchTaggedRequest `thrift:"req,1" db:"req" json:"req"`
 }
 
-func NewNodeFetchBatchRawArgs() *NodeFetchBatchRawArgs {
-	return &NodeFetchBatchRawArgs{}
+func NewNodeFetchTaggedArgs() *NodeFetchTaggedArgs {
+	return &NodeFetchTaggedArgs{}
 }
 
-var NodeFetchBatchRawArgs_Req_DEFAULT *FetchBatchRawRequest
+var NodeFetchTaggedArgs_Req_DEFAULT *FetchTaggedRequest
 
-func (p *NodeFetchBatchRawArgs) GetReq() *FetchBatchRawRequest {
+func (p *NodeFetchTaggedArgs) GetReq() *FetchTaggedRequest {
 	if !p.IsSetReq() {
-		return NodeFetchBatchRawArgs_Req_DEFAULT
+		return NodeFetchTaggedArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *NodeFetchBatchRawArgs) IsSetReq() bool {
+func (p *NodeFetchTaggedArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NodeFetchBatchRawArgs) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchTaggedArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -21236,9 +25471,11 @@ func (p *NodeFetchBatchRawArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBatchRawArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = &FetchBatchRawRequest{
+func (p *NodeFetchTaggedArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &FetchTaggedRequest{
 		RangeTimeType: 0,
+
+		RequireExhaustive: true,
 	}
 	if err := p.Req.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
@@ -21246,8 +25483,8 @@ func (p *NodeFetchBatchRawArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBatchRawArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("fetchBatchRaw_args"); err != nil {
+func (p *NodeFetchTaggedArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchTagged_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -21264,7 +25501,7 @@ func (p *NodeFetchBatchRawArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBatchRawArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchTaggedArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
@@ -21277,51 +25514,51 @@ func (p *NodeFetchBatchRawArgs) writeField1(oprot thrift.TProtocol) (err error)
 	return err
 }
 
-func (p *NodeFetchBatchRawArgs) String() string {
+func (p *NodeFetchTaggedArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeFetchBatchRawArgs(%+v)", *p)
+	return fmt.Sprintf("NodeFetchTaggedArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - Err
-type NodeFetchBatchRawResult struct {
-	Success *FetchBatchRawResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
-	Err     *Error                `thrift:"err,1" db:"err" json:"err,omitempty"`
+type NodeFetchTaggedResult struct {
+	Success *FetchTaggedResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error              `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewNodeFetchBatchRawResult() *NodeFetchBatchRawResult {
-	return &NodeFetchBatchRawResult{}
+func NewNodeFetchTaggedResult() *NodeFetchTaggedResult {
+	return &NodeFetchTaggedResult{}
 }
 
-var NodeFetchBatchRawResult_Success_DEFAULT *FetchBatchRawResult_
+var NodeFetchTaggedResult_Success_DEFAULT *FetchTaggedResult_
 
-func (p *NodeFetchBatchRawResult) GetSuccess() *FetchBatchRawResult_ {
+func (p *NodeFetchTaggedResult) GetSuccess() *FetchTaggedResult_ {
 	if !p.IsSetSuccess() {
-		return NodeFetchBatchRawResult_Success_DEFAULT
+		return NodeFetchTaggedResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var NodeFetchBatchRawResult_Err_DEFAULT *Error
+var NodeFetchTaggedResult_Err_DEFAULT *Error
 
-func (p *NodeFetchBatchRawResult) GetErr() *Error {
+func (p *NodeFetchTaggedResult) GetErr() *Error {
 	if !p.IsSetErr() {
-		return NodeFetchBatchRawResult_Err_DEFAULT
+		return NodeFetchTaggedResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *NodeFetchBatchRawResult) IsSetSuccess() bool {
+func (p *NodeFetchTaggedResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NodeFetchBatchRawResult) IsSetErr() bool {
+func (p *NodeFetchTaggedResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *NodeFetchBatchRawResult) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchTaggedResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -21358,15 +25595,15 @@ func (p *NodeFetchBatchRawResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBatchRawResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = &FetchBatchRawResult_{}
+func (p *NodeFetchTaggedResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &FetchTaggedResult_{}
 	if err := p.Success.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *NodeFetchBatchRawResult) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeFetchTaggedResult) ReadField1(iprot thrift.TProtocol) error {
 	p.Err = &Error{
 		Type: 0,
 	}
@@ -21376,8 +25613,8 @@ func (p *NodeFetchBatchRawResult) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBatchRawResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("fetchBatchRaw_result"); err != nil {
+func (p *NodeFetchTaggedResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchTagged_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -21397,7 +25634,7 @@ func (p *NodeFetchBatchRawResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBatchRawResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchTaggedResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
@@ -21412,7 +25649,7 @@ func (p *NodeFetchBatchRawResult) writeField0(oprot thrift.TProtocol) (err error
 	return err
 }
 
-func (p *NodeFetchBatchRawResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchTaggedResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
@@ -21427,36 +25664,36 @@ func (p *NodeFetchBatchRawResult) writeField1(oprot thrift.TProtocol) (err error
 	return err
 }
 
-func (p *NodeFetchBatchRawResult) String() string {
+func (p *NodeFetchTaggedResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeFetchBatchRawResult(%+v)", *p)
+	return fmt.Sprintf("NodeFetchTaggedResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Req
-type NodeFetchBatchRawV2Args struct {
-	Req *FetchBatchRawV2Request `thrift:"req,1" db:"req" json:"req"`
+type NodeFetchTaggedStreamArgs struct {
+	Req *FetchTaggedStreamRequest `thrift:"req,1" db:"req" json:"req"`
 }
 
-func NewNodeFetchBatchRawV2Args() *NodeFetchBatchRawV2Args {
-	return &NodeFetchBatchRawV2Args{}
+func NewNodeFetchTaggedStreamArgs() *NodeFetchTaggedStreamArgs {
+	return &NodeFetchTaggedStreamArgs{}
 }
 
-var NodeFetchBatchRawV2Args_Req_DEFAULT *FetchBatchRawV2Request
+var NodeFetchTaggedStreamArgs_Req_DEFAULT *FetchTaggedStreamRequest
 
-func (p *NodeFetchBatchRawV2Args) GetReq() *FetchBatchRawV2Request {
+func (p *NodeFetchTaggedStreamArgs) GetReq() *FetchTaggedStreamRequest {
 	if !p.IsSetReq() {
-		return NodeFetchBatchRawV2Args_Req_DEFAULT
+		return NodeFetchTaggedStreamArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *NodeFetchBatchRawV2Args) IsSetReq() bool {
+func (p *NodeFetchTaggedStreamArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NodeFetchBatchRawV2Args) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchTaggedStreamArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -21489,16 +25726,16 @@ func (p *NodeFetchBatchRawV2Args) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBatchRawV2Args) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = &FetchBatchRawV2Request{}
+func (p *NodeFetchTaggedStreamArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &FetchTaggedStreamRequest{}
 	if err := p.Req.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
 	return nil
 }
 
-func (p *NodeFetchBatchRawV2Args) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("fetchBatchRawV2_args"); err != nil {
+func (p *NodeFetchTaggedStreamArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchTaggedStream_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -21515,7 +25752,7 @@ func (p *NodeFetchBatchRawV2Args) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBatchRawV2Args) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchTaggedStreamArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
@@ -21528,51 +25765,51 @@ func (p *NodeFetchBatchRawV2Args) writeField1(oprot thrift.TProtocol) (err error
 	return err
 }
 
-func (p *NodeFetchBatchRawV2Args) String() string {
+func (p *NodeFetchTaggedStreamArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeFetchBatchRawV2Args(%+v)", *p)
+	return fmt.Sprintf("NodeFetchTaggedStreamArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - Err
-type NodeFetchBatchRawV2Result struct {
-	Success *FetchBatchRawResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
-	Err     *Error                `thrift:"err,1" db:"err" json:"err,omitempty"`
+type NodeFetchTaggedStreamResult struct {
+	Success *FetchTaggedStreamResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error           `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewNodeFetchBatchRawV2Result() *NodeFetchBatchRawV2Result {
-	return &NodeFetchBatchRawV2Result{}
+func NewNodeFetchTaggedStreamResult() *NodeFetchTaggedStreamResult {
+	return &NodeFetchTaggedStreamResult{}
 }
 
-var NodeFetchBatchRawV2Result_Success_DEFAULT *FetchBatchRawResult_
+var NodeFetchTaggedStreamResult_Success_DEFAULT *FetchTaggedStreamResult_
 
-func (p *NodeFetchBatchRawV2Result) GetSuccess() *FetchBatchRawResult_ {
+func (p *NodeFetchTaggedStreamResult) GetSuccess() *FetchTaggedStreamResult_ {
 	if !p.IsSetSuccess() {
-		return NodeFetchBatchRawV2Result_Success_DEFAULT
+		return NodeFetchTaggedStreamResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var NodeFetchBatchRawV2Result_Err_DEFAULT *Error
+var NodeFetchTaggedStreamResult_Err_DEFAULT *Error
 
-func (p *NodeFetchBatchRawV2Result) GetErr() *Error {
+func (p *NodeFetchTaggedStreamResult) GetErr() *Error {
 	if !p.IsSetErr() {
-		return NodeFetchBatchRawV2Result_Err_DEFAULT
+		return NodeFetchTaggedStreamResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *NodeFetchBatchRawV2Result) IsSetSuccess() bool {
+func (p *NodeFetchTaggedStreamResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NodeFetchBatchRawV2Result) IsSetErr() bool {
+func (p *NodeFetchTaggedStreamResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *NodeFetchBatchRawV2Result) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchTaggedStreamResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -21609,15 +25846,15 @@ func (p *NodeFetchBatchRawV2Result) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBatchRawV2Result) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = &FetchBatchRawResult_{}
+func (p *NodeFetchTaggedStreamResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &FetchTaggedStreamResult_{}
 	if err := p.Success.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *NodeFetchBatchRawV2Result) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeFetchTaggedStreamResult) ReadField1(iprot thrift.TProtocol) error {
 	p.Err = &Error{
 		Type: 0,
 	}
@@ -21627,8 +25864,8 @@ func (p *NodeFetchBatchRawV2Result) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBatchRawV2Result) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("fetchBatchRawV2_result"); err != nil {
+func (p *NodeFetchTaggedStreamResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchTaggedStream_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -21648,7 +25885,7 @@ func (p *NodeFetchBatchRawV2Result) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBatchRawV2Result) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchTaggedStreamResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
@@ -21663,7 +25900,7 @@ func (p *NodeFetchBatchRawV2Result) writeField0(oprot thrift.TProtocol) (err err
 	return err
 }
 
-func (p *NodeFetchBatchRawV2Result) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchTaggedStreamResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
@@ -21678,36 +25915,36 @@ func (p *NodeFetchBatchRawV2Result) writeField1(oprot thrift.TProtocol) (err err
 	return err
 }
 
-func (p *NodeFetchBatchRawV2Result) String() string {
+func (p *NodeFetchTaggedStreamResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeFetchBatchRawV2Result(%+v)", *p)
+	return fmt.Sprintf("NodeFetchTaggedStreamResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Req
-type NodeFetchBlocksRawArgs struct {
-	Req *FetchBlocksRawRequest `thrift:"req,1" db:"req" json:"req"`
+type NodeFetchBlocksMetadataRawV2Args struct {
+	Req *FetchBlocksMetadataRawV2Request `thrift:"req,1" db:"req" json:"req"`
 }
 
-func NewNodeFetchBlocksRawArgs() *NodeFetchBlocksRawArgs {
-	return &NodeFetchBlocksRawArgs{}
+func NewNodeFetchBlocksMetadataRawV2Args() *NodeFetchBlocksMetadataRawV2Args {
+	return &NodeFetchBlocksMetadataRawV2Args{}
 }
 
-var NodeFetchBlocksRawArgs_Req_DEFAULT *FetchBlocksRawRequest
+var NodeFetchBlocksMetadataRawV2Args_Req_DEFAULT *FetchBlocksMetadataRawV2Request
 
-func (p *NodeFetchBlocksRawArgs) GetReq() *FetchBlocksRawRequest {
+func (p *NodeFetchBlocksMetadataRawV2Args) GetReq() *FetchBlocksMetadataRawV2Request {
 	if !p.IsSetReq() {
-		return NodeFetchBlocksRawArgs_Req_DEFAULT
+		return NodeFetchBlocksMetadataRawV2Args_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *NodeFetchBlocksRawArgs) IsSetReq() bool {
+func (p *NodeFetchBlocksMetadataRawV2Args) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NodeFetchBlocksRawArgs) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchBlocksMetadataRawV2Args) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -21740,16 +25977,16 @@ func (p *NodeFetchBlocksRawArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBlocksRawArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = &FetchBlocksRawRequest{}
+func (p *NodeFetchBlocksMetadataRawV2Args) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &FetchBlocksMetadataRawV2Request{}
 	if err := p.Req.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
 	return nil
 }
 
-func (p *NodeFetchBlocksRawArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("fetchBlocksRaw_args"); err != nil {
+func (p *NodeFetchBlocksMetadataRawV2Args) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchBlocksMetadataRawV2_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -21766,7 +26003,7 @@ func (p *NodeFetchBlocksRawArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBlocksRawArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchBlocksMetadataRawV2Args) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
@@ -21779,51 +26016,51 @@ func (p *NodeFetchBlocksRawArgs) writeField1(oprot thrift.TProtocol) (err error)
 	return err
 }
 
-func (p *NodeFetchBlocksRawArgs) String() string {
+func (p *NodeFetchBlocksMetadataRawV2Args) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeFetchBlocksRawArgs(%+v)", *p)
+	return fmt.Sprintf("NodeFetchBlocksMetadataRawV2Args(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - Err
-type NodeFetchBlocksRawResult struct {
-	Success *FetchBlocksRawResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
-	Err     *Error                 `thrift:"err,1" db:"err" json:"err,omitempty"`
+type NodeFetchBlocksMetadataRawV2Result struct {
+	Success *FetchBlocksMetadataRawV2Result_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error                           `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewNodeFetchBlocksRawResult() *NodeFetchBlocksRawResult {
-	return &NodeFetchBlocksRawResult{}
+func NewNodeFetchBlocksMetadataRawV2Result() *NodeFetchBlocksMetadataRawV2Result {
+	return &NodeFetchBlocksMetadataRawV2Result{}
 }
 
-var NodeFetchBlocksRawResult_Success_DEFAULT *FetchBlocksRawResult_
+var NodeFetchBlocksMetadataRawV2Result_Success_DEFAULT *FetchBlocksMetadataRawV2Result_
 
-func (p *NodeFetchBlocksRawResult) GetSuccess() *FetchBlocksRawResult_ {
+func (p *NodeFetchBlocksMetadataRawV2Result) GetSuccess() *FetchBlocksMetadataRawV2Result_ {
 	if !p.IsSetSuccess() {
-		return NodeFetchBlocksRawResult_Success_DEFAULT
+		return NodeFetchBlocksMetadataRawV2Result_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var NodeFetchBlocksRawResult_Err_DEFAULT *Error
+var NodeFetchBlocksMetadataRawV2Result_Err_DEFAULT *Error
 
-func (p *NodeFetchBlocksRawResult) GetErr() *Error {
+func (p *NodeFetchBlocksMetadataRawV2Result) GetErr() *Error {
 	if !p.IsSetErr() {
-		return NodeFetchBlocksRawResult_Err_DEFAULT
+		return NodeFetchBlocksMetadataRawV2Result_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *NodeFetchBlocksRawResult) IsSetSuccess() bool {
+func (p *NodeFetchBlocksMetadataRawV2Result) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NodeFetchBlocksRawResult) IsSetErr() bool {
+func (p *NodeFetchBlocksMetadataRawV2Result) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *NodeFetchBlocksRawResult) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchBlocksMetadataRawV2Result) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -21860,15 +26097,15 @@ func (p *NodeFetchBlocksRawResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBlocksRawResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = &FetchBlocksRawResult_{}
+func (p *NodeFetchBlocksMetadataRawV2Result) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &FetchBlocksMetadataRawV2Result_{}
 	if err := p.Success.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *NodeFetchBlocksRawResult) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeFetchBlocksMetadataRawV2Result) ReadField1(iprot thrift.TProtocol) error {
 	p.Err = &Error{
 		Type: 0,
 	}
@@ -21878,8 +26115,8 @@ func (p *NodeFetchBlocksRawResult) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBlocksRawResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("fetchBlocksRaw_result"); err != nil {
+func (p *NodeFetchBlocksMetadataRawV2Result) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchBlocksMetadataRawV2_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -21899,7 +26136,7 @@ func (p *NodeFetchBlocksRawResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBlocksRawResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchBlocksMetadataRawV2Result) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
@@ -21914,7 +26151,7 @@ func (p *NodeFetchBlocksRawResult) writeField0(oprot thrift.TProtocol) (err erro
 	return err
 }
 
-func (p *NodeFetchBlocksRawResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchBlocksMetadataRawV2Result) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
@@ -21929,36 +26166,36 @@ func (p *NodeFetchBlocksRawResult) writeField1(oprot thrift.TProtocol) (err erro
 	return err
 }
 
-func (p *NodeFetchBlocksRawResult) String() string {
+func (p *NodeFetchBlocksMetadataRawV2Result) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeFetchBlocksRawResult(%+v)", *p)
+	return fmt.Sprintf("NodeFetchBlocksMetadataRawV2Result(%+v)", *p)
 }
 
 // Attributes:
 //  - Req
-type NodeFetchTaggedArgs struct {
-	Req *FetchTaggedRequest `thrift:"req,1" db:"req" json:"req"`
+type NodeFetchBlocksMerkleTreeRawArgs struct {
+	Req *FetchBlocksMerkleTreeRawRequest `thrift:"req,1" db:"req" json:"req"`
 }
 
-func NewNodeFetchTaggedArgs() *NodeFetchTaggedArgs {
-	return &NodeFetchTaggedArgs{}
+func NewNodeFetchBlocksMerkleTreeRawArgs() *NodeFetchBlocksMerkleTreeRawArgs {
+	return &NodeFetchBlocksMerkleTreeRawArgs{}
 }
 
-var NodeFetchTaggedArgs_Req_DEFAULT *FetchTaggedRequest
+var NodeFetchBlocksMerkleTreeRawArgs_Req_DEFAULT *FetchBlocksMerkleTreeRawRequest
 
-func (p *NodeFetchTaggedArgs) GetReq() *FetchTaggedRequest {
+func (p *NodeFetchBlocksMerkleTreeRawArgs) GetReq() *FetchBlocksMerkleTreeRawRequest {
 	if !p.IsSetReq() {
-		return NodeFetchTaggedArgs_Req_DEFAULT
+		return NodeFetchBlocksMerkleTreeRawArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *NodeFetchTaggedArgs) IsSetReq() bool {
+func (p *NodeFetchBlocksMerkleTreeRawArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NodeFetchTaggedArgs) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchBlocksMerkleTreeRawArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -21991,20 +26228,16 @@ func (p *NodeFetchTaggedArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchTaggedArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = &FetchTaggedRequest{
-		RangeTimeType: 0,
-
-		RequireExhaustive: true,
-	}
+func (p *NodeFetchBlocksMerkleTreeRawArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &FetchBlocksMerkleTreeRawRequest{}
 	if err := p.Req.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
 	return nil
 }
 
-func (p *NodeFetchTaggedArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("fetchTagged_args"); err != nil {
+func (p *NodeFetchBlocksMerkleTreeRawArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchBlocksMerkleTreeRaw_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -22021,7 +26254,7 @@ func (p *NodeFetchTaggedArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchTaggedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchBlocksMerkleTreeRawArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
@@ -22034,51 +26267,51 @@ func (p *NodeFetchTaggedArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeFetchTaggedArgs) String() string {
+func (p *NodeFetchBlocksMerkleTreeRawArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeFetchTaggedArgs(%+v)", *p)
+	return fmt.Sprintf("NodeFetchBlocksMerkleTreeRawArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - Err
-type NodeFetchTaggedResult struct {
-	Success *FetchTaggedResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
-	Err     *Error              `thrift:"err,1" db:"err" json:"err,omitempty"`
+type NodeFetchBlocksMerkleTreeRawResult struct {
+	Success *FetchBlocksMerkleTreeRawResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error           `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewNodeFetchTaggedResult() *NodeFetchTaggedResult {
-	return &NodeFetchTaggedResult{}
+func NewNodeFetchBlocksMerkleTreeRawResult() *NodeFetchBlocksMerkleTreeRawResult {
+	return &NodeFetchBlocksMerkleTreeRawResult{}
 }
 
-var NodeFetchTaggedResult_Success_DEFAULT *FetchTaggedResult_
+var NodeFetchBlocksMerkleTreeRawResult_Success_DEFAULT *FetchBlocksMerkleTreeRawResult_
 
-func (p *NodeFetchTaggedResult) GetSuccess() *FetchTaggedResult_ {
+func (p *NodeFetchBlocksMerkleTreeRawResult) GetSuccess() *FetchBlocksMerkleTreeRawResult_ {
 	if !p.IsSetSuccess() {
-		return NodeFetchTaggedResult_Success_DEFAULT
+		return NodeFetchBlocksMerkleTreeRawResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var NodeFetchTaggedResult_Err_DEFAULT *Error
+var NodeFetchBlocksMerkleTreeRawResult_Err_DEFAULT *Error
 
-func (p *NodeFetchTaggedResult) GetErr() *Error {
+func (p *NodeFetchBlocksMerkleTreeRawResult) GetErr() *Error {
 	if !p.IsSetErr() {
-		return NodeFetchTaggedResult_Err_DEFAULT
+		return NodeFetchBlocksMerkleTreeRawResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *NodeFetchTaggedResult) IsSetSuccess() bool {
+func (p *NodeFetchBlocksMerkleTreeRawResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NodeFetchTaggedResult) IsSetErr() bool {
+func (p *NodeFetchBlocksMerkleTreeRawResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *NodeFetchTaggedResult) Read(iprot thrift.TProtocol) error {
+func (p *NodeFetchBlocksMerkleTreeRawResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -22115,15 +26348,15 @@ func (p *NodeFetchTaggedResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchTaggedResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = &FetchTaggedResult_{}
+func (p *NodeFetchBlocksMerkleTreeRawResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &FetchBlocksMerkleTreeRawResult_{}
 	if err := p.Success.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *NodeFetchTaggedResult) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeFetchBlocksMerkleTreeRawResult) ReadField1(iprot thrift.TProtocol) error {
 	p.Err = &Error{
 		Type: 0,
 	}
@@ -22133,8 +26366,8 @@ func (p *NodeFetchTaggedResult) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchTaggedResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("fetchTagged_result"); err != nil {
+func (p *NodeFetchBlocksMerkleTreeRawResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("fetchBlocksMerkleTreeRaw_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -22154,7 +26387,7 @@ func (p *NodeFetchTaggedResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchTaggedResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchBlocksMerkleTreeRawResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
@@ -22169,7 +26402,7 @@ func (p *NodeFetchTaggedResult) writeField0(oprot thrift.TProtocol) (err error)
 	return err
 }
 
-func (p *NodeFetchTaggedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeFetchBlocksMerkleTreeRawResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
@@ -22184,36 +26417,36 @@ func (p *NodeFetchTaggedResult) writeField1(oprot thrift.TProtocol) (err error)
 	return err
 }
 
-func (p *NodeFetchTaggedResult) String() string {
+func (p *NodeFetchBlocksMerkleTreeRawResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeFetchTaggedResult(%+v)", *p)
+	return fmt.Sprintf("NodeFetchBlocksMerkleTreeRawResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Req
-type NodeFetchBlocksMetadataRawV2Args struct {
-	Req *FetchBlocksMetadataRawV2Request `thrift:"req,1" db:"req" json:"req"`
+type NodeCardinalityRawArgs struct {
+	Req *CardinalityRawRequest `thrift:"req,1" db:"req" json:"req"`
 }
 
-func NewNodeFetchBlocksMetadataRawV2Args() *NodeFetchBlocksMetadataRawV2Args {
-	return &NodeFetchBlocksMetadataRawV2Args{}
+func NewNodeCardinalityRawArgs() *NodeCardinalityRawArgs {
+	return &NodeCardinalityRawArgs{}
 }
 
-var NodeFetchBlocksMetadataRawV2Args_Req_DEFAULT *FetchBlocksMetadataRawV2Request
+var NodeCardinalityRawArgs_Req_DEFAULT *CardinalityRawRequest
 
-func (p *NodeFetchBlocksMetadataRawV2Args) GetReq() *FetchBlocksMetadataRawV2Request {
+func (p *NodeCardinalityRawArgs) GetReq() *CardinalityRawRequest {
 	if !p.IsSetReq() {
-		return NodeFetchBlocksMetadataRawV2Args_Req_DEFAULT
+		return NodeCardinalityRawArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *NodeFetchBlocksMetadataRawV2Args) IsSetReq() bool {
+func (p *NodeCardinalityRawArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NodeFetchBlocksMetadataRawV2Args) Read(iprot thrift.TProtocol) error {
+func (p *NodeCardinalityRawArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -22246,16 +26479,16 @@ func (p *NodeFetchBlocksMetadataRawV2Args) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBlocksMetadataRawV2Args) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = &FetchBlocksMetadataRawV2Request{}
+func (p *NodeCardinalityRawArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &CardinalityRawRequest{}
 	if err := p.Req.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
 	return nil
 }
 
-func (p *NodeFetchBlocksMetadataRawV2Args) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("fetchBlocksMetadataRawV2_args"); err != nil {
+func (p *NodeCardinalityRawArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("cardinalityRaw_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -22272,7 +26505,7 @@ func (p *NodeFetchBlocksMetadataRawV2Args) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeFetchBlocksMetadataRawV2Args) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeCardinalityRawArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
@@ -22285,51 +26518,51 @@ func (p *NodeFetchBlocksMetadataRawV2Args) writeField1(oprot thrift.TProtocol) (
 	return err
 }
 
-func (p *NodeFetchBlocksMetadataRawV2Args) String() string {
+func (p *NodeCardinalityRawArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeFetchBlocksMetadataRawV2Args(%+v)", *p)
+	return fmt.Sprintf("NodeCardinalityRawArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - Err
-type NodeFetchBlocksMetadataRawV2Result struct {
-	Success *FetchBlocksMetadataRawV2Result_ `thrift:"success,0" db:"success" json:"success,omitempty"`
-	Err     *Error                           `thrift:"err,1" db:"err" json:"err,omitempty"`
+type NodeCardinalityRawResult struct {
+	Success *CardinalityRawResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error           `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewNodeFetchBlocksMetadataRawV2Result() *NodeFetchBlocksMetadataRawV2Result {
-	return &NodeFetchBlocksMetadataRawV2Result{}
+func NewNodeCardinalityRawResult() *NodeCardinalityRawResult {
+	return &NodeCardinalityRawResult{}
 }
 
-var NodeFetchBlocksMetadataRawV2Result_Success_DEFAULT *FetchBlocksMetadataRawV2Result_
+var NodeCardinalityRawResult_Success_DEFAULT *CardinalityRawResult_
 
-func (p *NodeFetchBlocksMetadataRawV2Result) GetSuccess() *FetchBlocksMetadataRawV2Result_ {
+func (p *NodeCardinalityRawResult) GetSuccess() *CardinalityRawResult_ {
 	if !p.IsSetSuccess() {
-		return NodeFetchBlocksMetadataRawV2Result_Success_DEFAULT
+		return NodeCardinalityRawResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var NodeFetchBlocksMetadataRawV2Result_Err_DEFAULT *Error
+var NodeCardinalityRawResult_Err_DEFAULT *Error
 
-func (p *NodeFetchBlocksMetadataRawV2Result) GetErr() *Error {
+func (p *NodeCardinalityRawResult) GetErr() *Error {
 	if !p.IsSetErr() {
-		return NodeFetchBlocksMetadataRawV2Result_Err_DEFAULT
+		return NodeCardinalityRawResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *NodeFetchBlocksMetadataRawV2Result) IsSetSuccess() bool {
+func (p *NodeCardinalityRawResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NodeFetchBlocksMetadataRawV2Result) IsSetErr() bool {
+func (p *NodeCardinalityRawResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *NodeFetchBlocksMetadataRawV2Result) Read(iprot thrift.TProtocol) error {
+func (p *NodeCardinalityRawResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -22366,15 +26599,15 @@ func (p *NodeFetchBlocksMetadataRawV2Result) Read(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *NodeFetchBlocksMetadataRawV2Result) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = &FetchBlocksMetadataRawV2Result_{}
+func (p *NodeCardinalityRawResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &CardinalityRawResult_{}
 	if err := p.Success.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *NodeFetchBlocksMetadataRawV2Result) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeCardinalityRawResult) ReadField1(iprot thrift.TProtocol) error {
 	p.Err = &Error{
 		Type: 0,
 	}
@@ -22384,8 +26617,8 @@ func (p *NodeFetchBlocksMetadataRawV2Result) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *NodeFetchBlocksMetadataRawV2Result) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("fetchBlocksMetadataRawV2_result"); err != nil {
+func (p *NodeCardinalityRawResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("cardinalityRaw_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -22405,7 +26638,7 @@ func (p *NodeFetchBlocksMetadataRawV2Result) Write(oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *NodeFetchBlocksMetadataRawV2Result) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NodeCardinalityRawResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
@@ -22420,7 +26653,7 @@ func (p *NodeFetchBlocksMetadataRawV2Result) writeField0(oprot thrift.TProtocol)
 	return err
 }
 
-func (p *NodeFetchBlocksMetadataRawV2Result) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeCardinalityRawResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
@@ -22435,11 +26668,11 @@ func (p *NodeFetchBlocksMetadataRawV2Result) writeField1(oprot thrift.TProtocol)
 	return err
 }
 
-func (p *NodeFetchBlocksMetadataRawV2Result) String() string {
+func (p *NodeCardinalityRawResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeFetchBlocksMetadataRawV2Result(%+v)", *p)
+	return fmt.Sprintf("NodeCardinalityRawResult(%+v)", *p)
 }
 
 // Attributes:
@@ -23368,7 +27601,240 @@ func (p *NodeRepairResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeRepairResult) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeRepairResult) ReadField1(iprot thrift.TProtocol) error {
+	p.Err = &Error{
+		Type: 0,
+	}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
+}
+
+func (p *NodeRepairResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("repair_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *NodeRepairResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
+		}
+		if err := p.Err.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *NodeRepairResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NodeRepairResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Req
+type NodeTruncateArgs struct {
+	Req *TruncateRequest `thrift:"req,1" db:"req" json:"req"`
+}
+
+func NewNodeTruncateArgs() *NodeTruncateArgs {
+	return &NodeTruncateArgs{}
+}
+
+var NodeTruncateArgs_Req_DEFAULT *TruncateRequest
+
+func (p *NodeTruncateArgs) GetReq() *TruncateRequest {
+	if !p.IsSetReq() {
+		return NodeTruncateArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *NodeTruncateArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NodeTruncateArgs) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *NodeTruncateArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &TruncateRequest{}
+	if err := p.Req.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
+	}
+	return nil
+}
+
+func (p *NodeTruncateArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("truncate_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *NodeTruncateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Req), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:req: ", p), err)
+	}
+	return err
+}
+
+func (p *NodeTruncateArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NodeTruncateArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type NodeTruncateResult struct {
+	Success *TruncateResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error           `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewNodeTruncateResult() *NodeTruncateResult {
+	return &NodeTruncateResult{}
+}
+
+var NodeTruncateResult_Success_DEFAULT *TruncateResult_
+
+func (p *NodeTruncateResult) GetSuccess() *TruncateResult_ {
+	if !p.IsSetSuccess() {
+		return NodeTruncateResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var NodeTruncateResult_Err_DEFAULT *Error
+
+func (p *NodeTruncateResult) GetErr() *Error {
+	if !p.IsSetErr() {
+		return NodeTruncateResult_Err_DEFAULT
+	}
+	return p.Err
+}
+func (p *NodeTruncateResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NodeTruncateResult) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *NodeTruncateResult) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if err := p.ReadField0(iprot); err != nil {
+				return err
+			}
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *NodeTruncateResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &TruncateResult_{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *NodeTruncateResult) ReadField1(iprot thrift.TProtocol) error {
 	p.Err = &Error{
 		Type: 0,
 	}
@@ -23378,11 +27844,14 @@ func (p *NodeRepairResult) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeRepairResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("repair_result"); err != nil {
+func (p *NodeTruncateResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("truncate_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
+		if err := p.writeField0(oprot); err != nil {
+			return err
+		}
 		if err := p.writeField1(oprot); err != nil {
 			return err
 		}
@@ -23396,7 +27865,22 @@ func (p *NodeRepairResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeRepairResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeTruncateResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *NodeTruncateResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
@@ -23411,36 +27895,36 @@ func (p *NodeRepairResult) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeRepairResult) String() string {
+func (p *NodeTruncateResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeRepairResult(%+v)", *p)
+	return fmt.Sprintf("NodeTruncateResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Req
-type NodeTruncateArgs struct {
-	Req *TruncateRequest `thrift:"req,1" db:"req" json:"req"`
+type NodeDeleteTaggedArgs struct {
+	Req *DeleteTaggedRequest `thrift:"req,1" db:"req" json:"req"`
 }
 
-func NewNodeTruncateArgs() *NodeTruncateArgs {
-	return &NodeTruncateArgs{}
+func NewNodeDeleteTaggedArgs() *NodeDeleteTaggedArgs {
+	return &NodeDeleteTaggedArgs{}
 }
 
-var NodeTruncateArgs_Req_DEFAULT *TruncateRequest
+var NodeDeleteTaggedArgs_Req_DEFAULT *DeleteTaggedRequest
 
-func (p *NodeTruncateArgs) GetReq() *TruncateRequest {
+func (p *NodeDeleteTaggedArgs) GetReq() *DeleteTaggedRequest {
 	if !p.IsSetReq() {
-		return NodeTruncateArgs_Req_DEFAULT
+		return NodeDeleteTaggedArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *NodeTruncateArgs) IsSetReq() bool {
+func (p *NodeDeleteTaggedArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *NodeTruncateArgs) Read(iprot thrift.TProtocol) error {
+func (p *NodeDeleteTaggedArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -23473,16 +27957,16 @@ func (p *NodeTruncateArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeTruncateArgs) ReadField1(iprot thrift.TProtocol) error {
-	p.Req = &TruncateRequest{}
+func (p *NodeDeleteTaggedArgs) ReadField1(iprot thrift.TProtocol) error {
+	p.Req = &DeleteTaggedRequest{}
 	if err := p.Req.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
 	return nil
 }
 
-func (p *NodeTruncateArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("truncate_args"); err != nil {
+func (p *NodeDeleteTaggedArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("deleteTagged_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -23499,7 +27983,7 @@ func (p *NodeTruncateArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeTruncateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeDeleteTaggedArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
@@ -23512,51 +27996,51 @@ func (p *NodeTruncateArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeTruncateArgs) String() string {
+func (p *NodeDeleteTaggedArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeTruncateArgs(%+v)", *p)
+	return fmt.Sprintf("NodeDeleteTaggedArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - Err
-type NodeTruncateResult struct {
-	Success *TruncateResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
-	Err     *Error           `thrift:"err,1" db:"err" json:"err,omitempty"`
+type NodeDeleteTaggedResult struct {
+	Success *DeleteTaggedResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error               `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewNodeTruncateResult() *NodeTruncateResult {
-	return &NodeTruncateResult{}
+func NewNodeDeleteTaggedResult() *NodeDeleteTaggedResult {
+	return &NodeDeleteTaggedResult{}
 }
 
-var NodeTruncateResult_Success_DEFAULT *TruncateResult_
+var NodeDeleteTaggedResult_Success_DEFAULT *DeleteTaggedResult_
 
-func (p *NodeTruncateResult) GetSuccess() *TruncateResult_ {
+func (p *NodeDeleteTaggedResult) GetSuccess() *DeleteTaggedResult_ {
 	if !p.IsSetSuccess() {
-		return NodeTruncateResult_Success_DEFAULT
+		return NodeDeleteTaggedResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var NodeTruncateResult_Err_DEFAULT *Error
+var NodeDeleteTaggedResult_Err_DEFAULT *Error
 
-func (p *NodeTruncateResult) GetErr() *Error {
+func (p *NodeDeleteTaggedResult) GetErr() *Error {
 	if !p.IsSetErr() {
-		return NodeTruncateResult_Err_DEFAULT
+		return NodeDeleteTaggedResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *NodeTruncateResult) IsSetSuccess() bool {
+func (p *NodeDeleteTaggedResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NodeTruncateResult) IsSetErr() bool {
+func (p *NodeDeleteTaggedResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *NodeTruncateResult) Read(iprot thrift.TProtocol) error {
+func (p *NodeDeleteTaggedResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -23593,15 +28077,15 @@ func (p *NodeTruncateResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeTruncateResult) ReadField0(iprot thrift.TProtocol) error {
-	p.Success = &TruncateResult_{}
+func (p *NodeDeleteTaggedResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &DeleteTaggedResult_{}
 	if err := p.Success.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *NodeTruncateResult) ReadField1(iprot thrift.TProtocol) error {
+func (p *NodeDeleteTaggedResult) ReadField1(iprot thrift.TProtocol) error {
 	p.Err = &Error{
 		Type: 0,
 	}
@@ -23611,8 +28095,8 @@ func (p *NodeTruncateResult) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeTruncateResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("truncate_result"); err != nil {
+func (p *NodeDeleteTaggedResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("deleteTagged_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -23632,7 +28116,7 @@ func (p *NodeTruncateResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *NodeTruncateResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NodeDeleteTaggedResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
@@ -23647,7 +28131,7 @@ func (p *NodeTruncateResult) writeField0(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeTruncateResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NodeDeleteTaggedResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
@@ -23662,11 +28146,11 @@ func (p *NodeTruncateResult) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *NodeTruncateResult) String() string {
+func (p *NodeDeleteTaggedResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NodeTruncateResult(%+v)", *p)
+	return fmt.Sprintf("NodeDeleteTaggedResult(%+v)", *p)
 }
 
 // Attributes:
@@ -23922,6 +28406,211 @@ func (p *NodeAggregateTilesResult) String() string {
 	return fmt.Sprintf("NodeAggregateTilesResult(%+v)", *p)
 }
 
+type NodeAggregateTilesStatusArgs struct {
+}
+
+func NewNodeAggregateTilesStatusArgs() *NodeAggregateTilesStatusArgs {
+	return &NodeAggregateTilesStatusArgs{}
+}
+
+func (p *NodeAggregateTilesStatusArgs) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *NodeAggregateTilesStatusArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("health_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *NodeAggregateTilesStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NodeAggregateTilesStatusArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type NodeAggregateTilesStatusResult struct {
+	Success *AggregateTilesStatusResult_ `thrift:"success,0" db:"success" json:"success,omitempty"`
+	Err     *Error                       `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewNodeAggregateTilesStatusResult() *NodeAggregateTilesStatusResult {
+	return &NodeAggregateTilesStatusResult{}
+}
+
+var NodeAggregateTilesStatusResult_Success_DEFAULT *AggregateTilesStatusResult_
+
+func (p *NodeAggregateTilesStatusResult) GetSuccess() *AggregateTilesStatusResult_ {
+	if !p.IsSetSuccess() {
+		return NodeAggregateTilesStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var NodeAggregateTilesStatusResult_Err_DEFAULT *Error
+
+func (p *NodeAggregateTilesStatusResult) GetErr() *Error {
+	if !p.IsSetErr() {
+		return NodeAggregateTilesStatusResult_Err_DEFAULT
+	}
+	return p.Err
+}
+func (p *NodeAggregateTilesStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NodeAggregateTilesStatusResult) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *NodeAggregateTilesStatusResult) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if err := p.ReadField0(iprot); err != nil {
+				return err
+			}
+		case 1:
+			if err := p.ReadField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *NodeAggregateTilesStatusResult) ReadField0(iprot thrift.TProtocol) error {
+	p.Success = &AggregateTilesStatusResult_{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *NodeAggregateTilesStatusResult) ReadField1(iprot thrift.TProtocol) error {
+	p.Err = &Error{
+		Type: 0,
+	}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
+}
+
+func (p *NodeAggregateTilesStatusResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("health_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField0(oprot); err != nil {
+			return err
+		}
+		if err := p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *NodeAggregateTilesStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *NodeAggregateTilesStatusResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
+		}
+		if err := p.Err.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *NodeAggregateTilesStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NodeAggregateTilesStatusResult(%+v)", *p)
+}
+
 type NodeHealthArgs struct {
 }
 
@@ -225,6 +225,21 @@ func (mr *MockTChanNodeMockRecorder) AggregateTiles(ctx, req interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateTiles", reflect.TypeOf((*MockTChanNode)(nil).AggregateTiles), ctx, req)
 }
 
+// AggregateTilesStatus mocks base method.
+func (m *MockTChanNode) AggregateTilesStatus(ctx thrift.Context) (*AggregateTilesStatusResult_, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateTilesStatus", ctx)
+	ret0, _ := ret[0].(*AggregateTilesStatusResult_)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateTilesStatus indicates an expected call of AggregateTilesStatus.
+func (mr *MockTChanNodeMockRecorder) AggregateTilesStatus(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateTilesStatus", reflect.TypeOf((*MockTChanNode)(nil).AggregateTilesStatus), ctx)
+}
+
 // Bootstrapped mocks base method.
 func (m *MockTChanNode) Bootstrapped(ctx thrift.Context) (*NodeBootstrappedResult_, error) {
 	m.ctrl.T.Helper()
@@ -255,6 +270,21 @@ func (mr *MockTChanNodeMockRecorder) BootstrappedInPlacementOrNoPlacement(ctx in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrappedInPlacementOrNoPlacement", reflect.TypeOf((*MockTChanNode)(nil).BootstrappedInPlacementOrNoPlacement), ctx)
 }
 
+// CardinalityRaw mocks base method.
+func (m *MockTChanNode) CardinalityRaw(ctx thrift.Context, req *CardinalityRawRequest) (*CardinalityRawResult_, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CardinalityRaw", ctx, req)
+	ret0, _ := ret[0].(*CardinalityRawResult_)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CardinalityRaw indicates an expected call of CardinalityRaw.
+func (mr *MockTChanNodeMockRecorder) CardinalityRaw(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CardinalityRaw", reflect.TypeOf((*MockTChanNode)(nil).CardinalityRaw), ctx, req)
+}
+
 // DebugIndexMemorySegments mocks base method.
 func (m *MockTChanNode) DebugIndexMemorySegments(ctx thrift.Context, req *DebugIndexMemorySegmentsRequest) (*DebugIndexMemorySegmentsResult_, error) {
 	m.ctrl.T.Helper()
@@ -300,6 +330,21 @@ func (mr *MockTChanNodeMockRecorder) DebugProfileStop(ctx, req interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebugProfileStop", reflect.TypeOf((*MockTChanNode)(nil).DebugProfileStop), ctx, req)
 }
 
+// DeleteTagged mocks base method.
+func (m *MockTChanNode) DeleteTagged(ctx thrift.Context, req *DeleteTaggedRequest) (*DeleteTaggedResult_, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagged", ctx, req)
+	ret0, _ := ret[0].(*DeleteTaggedResult_)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagged indicates an expected call of DeleteTagged.
+func (mr *MockTChanNodeMockRecorder) DeleteTagged(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagged", reflect.TypeOf((*MockTChanNode)(nil).DeleteTagged), ctx, req)
+}
+
 // Fetch mocks base method.
 func (m *MockTChanNode) Fetch(ctx thrift.Context, req *FetchRequest) (*FetchResult_, error) {
 	m.ctrl.T.Helper()
@@ -345,6 +390,21 @@ func (mr *MockTChanNodeMockRecorder) FetchBatchRawV2(ctx, req interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBatchRawV2", reflect.TypeOf((*MockTChanNode)(nil).FetchBatchRawV2), ctx, req)
 }
 
+// FetchBlocksMerkleTreeRaw mocks base method.
+func (m *MockTChanNode) FetchBlocksMerkleTreeRaw(ctx thrift.Context, req *FetchBlocksMerkleTreeRawRequest) (*FetchBlocksMerkleTreeRawResult_, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBlocksMerkleTreeRaw", ctx, req)
+	ret0, _ := ret[0].(*FetchBlocksMerkleTreeRawResult_)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBlocksMerkleTreeRaw indicates an expected call of FetchBlocksMerkleTreeRaw.
+func (mr *MockTChanNodeMockRecorder) FetchBlocksMerkleTreeRaw(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMerkleTreeRaw", reflect.TypeOf((*MockTChanNode)(nil).FetchBlocksMerkleTreeRaw), ctx, req)
+}
+
 // FetchBlocksMetadataRawV2 mocks base method.
 func (m *MockTChanNode) FetchBlocksMetadataRawV2(ctx thrift.Context, req *FetchBlocksMetadataRawV2Request) (*FetchBlocksMetadataRawV2Result_, error) {
 	m.ctrl.T.Helper()
@@ -390,6 +450,21 @@ func (mr *MockTChanNodeMockRecorder) FetchTagged(ctx, req interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTagged", reflect.TypeOf((*MockTChanNode)(nil).FetchTagged), ctx, req)
 }
 
+// FetchTaggedStream mocks base method.
+func (m *MockTChanNode) FetchTaggedStream(ctx thrift.Context, req *FetchTaggedStreamRequest) (*FetchTaggedStreamResult_, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaggedStream", ctx, req)
+	ret0, _ := ret[0].(*FetchTaggedStreamResult_)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTaggedStream indicates an expected call of FetchTaggedStream.
+func (mr *MockTChanNodeMockRecorder) FetchTaggedStream(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedStream", reflect.TypeOf((*MockTChanNode)(nil).FetchTaggedStream), ctx, req)
+}
+
 // GetPersistRateLimit mocks base method.
 func (m *MockTChanNode) GetPersistRateLimit(ctx thrift.Context) (*NodePersistRateLimitResult_, error) {
 	m.ctrl.T.Helper()
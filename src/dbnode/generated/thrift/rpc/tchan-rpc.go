@@ -48,17 +48,22 @@ type TChanNode interface {
 	Aggregate(ctx thrift.Context, req *AggregateQueryRequest) (*AggregateQueryResult_, error)
 	AggregateRaw(ctx thrift.Context, req *AggregateQueryRawRequest) (*AggregateQueryRawResult_, error)
 	AggregateTiles(ctx thrift.Context, req *AggregateTilesRequest) (*AggregateTilesResult_, error)
+	AggregateTilesStatus(ctx thrift.Context) (*AggregateTilesStatusResult_, error)
 	Bootstrapped(ctx thrift.Context) (*NodeBootstrappedResult_, error)
 	BootstrappedInPlacementOrNoPlacement(ctx thrift.Context) (*NodeBootstrappedInPlacementOrNoPlacementResult_, error)
+	CardinalityRaw(ctx thrift.Context, req *CardinalityRawRequest) (*CardinalityRawResult_, error)
 	DebugIndexMemorySegments(ctx thrift.Context, req *DebugIndexMemorySegmentsRequest) (*DebugIndexMemorySegmentsResult_, error)
 	DebugProfileStart(ctx thrift.Context, req *DebugProfileStartRequest) (*DebugProfileStartResult_, error)
 	DebugProfileStop(ctx thrift.Context, req *DebugProfileStopRequest) (*DebugProfileStopResult_, error)
+	DeleteTagged(ctx thrift.Context, req *DeleteTaggedRequest) (*DeleteTaggedResult_, error)
 	Fetch(ctx thrift.Context, req *FetchRequest) (*FetchResult_, error)
 	FetchBatchRaw(ctx thrift.Context, req *FetchBatchRawRequest) (*FetchBatchRawResult_, error)
 	FetchBatchRawV2(ctx thrift.Context, req *FetchBatchRawV2Request) (*FetchBatchRawResult_, error)
+	FetchBlocksMerkleTreeRaw(ctx thrift.Context, req *FetchBlocksMerkleTreeRawRequest) (*FetchBlocksMerkleTreeRawResult_, error)
 	FetchBlocksMetadataRawV2(ctx thrift.Context, req *FetchBlocksMetadataRawV2Request) (*FetchBlocksMetadataRawV2Result_, error)
 	FetchBlocksRaw(ctx thrift.Context, req *FetchBlocksRawRequest) (*FetchBlocksRawResult_, error)
 	FetchTagged(ctx thrift.Context, req *FetchTaggedRequest) (*FetchTaggedResult_, error)
+	FetchTaggedStream(ctx thrift.Context, req *FetchTaggedStreamRequest) (*FetchTaggedStreamResult_, error)
 	GetPersistRateLimit(ctx thrift.Context) (*NodePersistRateLimitResult_, error)
 	GetWriteNewSeriesAsync(ctx thrift.Context) (*NodeWriteNewSeriesAsyncResult_, error)
 	GetWriteNewSeriesBackoffDuration(ctx thrift.Context) (*NodeWriteNewSeriesBackoffDurationResult_, error)
@@ -537,6 +542,22 @@ func (c *tchanNodeClient) AggregateTiles(ctx thrift.Context, req *AggregateTiles
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanNodeClient) AggregateTilesStatus(ctx thrift.Context) (*AggregateTilesStatusResult_, error) {
+	var resp NodeAggregateTilesStatusResult
+	args := NodeAggregateTilesStatusArgs{}
+	success, err := c.client.Call(ctx, c.thriftService, "aggregateTilesStatus", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.Err != nil:
+			err = resp.Err
+		default:
+			err = fmt.Errorf("received no result or unknown exception for aggregateTilesStatus")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanNodeClient) Bootstrapped(ctx thrift.Context) (*NodeBootstrappedResult_, error) {
 	var resp NodeBootstrappedResult
 	args := NodeBootstrappedArgs{}
@@ -569,6 +590,24 @@ func (c *tchanNodeClient) BootstrappedInPlacementOrNoPlacement(ctx thrift.Contex
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanNodeClient) CardinalityRaw(ctx thrift.Context, req *CardinalityRawRequest) (*CardinalityRawResult_, error) {
+	var resp NodeCardinalityRawResult
+	args := NodeCardinalityRawArgs{
+		Req: req,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "cardinalityRaw", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.Err != nil:
+			err = resp.Err
+		default:
+			err = fmt.Errorf("received no result or unknown exception for cardinalityRaw")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanNodeClient) DebugIndexMemorySegments(ctx thrift.Context, req *DebugIndexMemorySegmentsRequest) (*DebugIndexMemorySegmentsResult_, error) {
 	var resp NodeDebugIndexMemorySegmentsResult
 	args := NodeDebugIndexMemorySegmentsArgs{
@@ -623,6 +662,24 @@ func (c *tchanNodeClient) DebugProfileStop(ctx thrift.Context, req *DebugProfile
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanNodeClient) DeleteTagged(ctx thrift.Context, req *DeleteTaggedRequest) (*DeleteTaggedResult_, error) {
+	var resp NodeDeleteTaggedResult
+	args := NodeDeleteTaggedArgs{
+		Req: req,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "deleteTagged", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.Err != nil:
+			err = resp.Err
+		default:
+			err = fmt.Errorf("received no result or unknown exception for deleteTagged")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanNodeClient) Fetch(ctx thrift.Context, req *FetchRequest) (*FetchResult_, error) {
 	var resp NodeFetchResult
 	args := NodeFetchArgs{
@@ -677,6 +734,24 @@ func (c *tchanNodeClient) FetchBatchRawV2(ctx thrift.Context, req *FetchBatchRaw
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanNodeClient) FetchBlocksMerkleTreeRaw(ctx thrift.Context, req *FetchBlocksMerkleTreeRawRequest) (*FetchBlocksMerkleTreeRawResult_, error) {
+	var resp NodeFetchBlocksMerkleTreeRawResult
+	args := NodeFetchBlocksMerkleTreeRawArgs{
+		Req: req,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "fetchBlocksMerkleTreeRaw", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.Err != nil:
+			err = resp.Err
+		default:
+			err = fmt.Errorf("received no result or unknown exception for fetchBlocksMerkleTreeRaw")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanNodeClient) FetchBlocksMetadataRawV2(ctx thrift.Context, req *FetchBlocksMetadataRawV2Request) (*FetchBlocksMetadataRawV2Result_, error) {
 	var resp NodeFetchBlocksMetadataRawV2Result
 	args := NodeFetchBlocksMetadataRawV2Args{
@@ -731,6 +806,24 @@ func (c *tchanNodeClient) FetchTagged(ctx thrift.Context, req *FetchTaggedReques
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanNodeClient) FetchTaggedStream(ctx thrift.Context, req *FetchTaggedStreamRequest) (*FetchTaggedStreamResult_, error) {
+	var resp NodeFetchTaggedStreamResult
+	args := NodeFetchTaggedStreamArgs{
+		Req: req,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "fetchTaggedStream", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.Err != nil:
+			err = resp.Err
+		default:
+			err = fmt.Errorf("received no result or unknown exception for fetchTaggedStream")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanNodeClient) GetPersistRateLimit(ctx thrift.Context) (*NodePersistRateLimitResult_, error) {
 	var resp NodeGetPersistRateLimitResult
 	args := NodeGetPersistRateLimitArgs{}
@@ -1064,17 +1157,22 @@ func (s *tchanNodeServer) Methods() []string {
 		"aggregate",
 		"aggregateRaw",
 		"aggregateTiles",
+		"aggregateTilesStatus",
 		"bootstrapped",
 		"bootstrappedInPlacementOrNoPlacement",
+		"cardinalityRaw",
 		"debugIndexMemorySegments",
 		"debugProfileStart",
 		"debugProfileStop",
+		"deleteTagged",
 		"fetch",
 		"fetchBatchRaw",
 		"fetchBatchRawV2",
+		"fetchBlocksMerkleTreeRaw",
 		"fetchBlocksMetadataRawV2",
 		"fetchBlocksRaw",
 		"fetchTagged",
+		"fetchTaggedStream",
 		"getPersistRateLimit",
 		"getWriteNewSeriesAsync",
 		"getWriteNewSeriesBackoffDuration",
@@ -1104,28 +1202,38 @@ func (s *tchanNodeServer) Handle(ctx thrift.Context, methodName string, protocol
 		return s.handleAggregateRaw(ctx, protocol)
 	case "aggregateTiles":
 		return s.handleAggregateTiles(ctx, protocol)
+	case "aggregateTilesStatus":
+		return s.handleAggregateTilesStatus(ctx, protocol)
 	case "bootstrapped":
 		return s.handleBootstrapped(ctx, protocol)
 	case "bootstrappedInPlacementOrNoPlacement":
 		return s.handleBootstrappedInPlacementOrNoPlacement(ctx, protocol)
+	case "cardinalityRaw":
+		return s.handleCardinalityRaw(ctx, protocol)
 	case "debugIndexMemorySegments":
 		return s.handleDebugIndexMemorySegments(ctx, protocol)
 	case "debugProfileStart":
 		return s.handleDebugProfileStart(ctx, protocol)
 	case "debugProfileStop":
 		return s.handleDebugProfileStop(ctx, protocol)
+	case "deleteTagged":
+		return s.handleDeleteTagged(ctx, protocol)
 	case "fetch":
 		return s.handleFetch(ctx, protocol)
 	case "fetchBatchRaw":
 		return s.handleFetchBatchRaw(ctx, protocol)
 	case "fetchBatchRawV2":
 		return s.handleFetchBatchRawV2(ctx, protocol)
+	case "fetchBlocksMerkleTreeRaw":
+		return s.handleFetchBlocksMerkleTreeRaw(ctx, protocol)
 	case "fetchBlocksMetadataRawV2":
 		return s.handleFetchBlocksMetadataRawV2(ctx, protocol)
 	case "fetchBlocksRaw":
 		return s.handleFetchBlocksRaw(ctx, protocol)
 	case "fetchTagged":
 		return s.handleFetchTagged(ctx, protocol)
+	case "fetchTaggedStream":
+		return s.handleFetchTaggedStream(ctx, protocol)
 	case "getPersistRateLimit":
 		return s.handleGetPersistRateLimit(ctx, protocol)
 	case "getWriteNewSeriesAsync":
@@ -1252,6 +1360,34 @@ func (s *tchanNodeServer) handleAggregateTiles(ctx thrift.Context, protocol athr
 	return err == nil, &res, nil
 }
 
+func (s *tchanNodeServer) handleAggregateTilesStatus(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req NodeAggregateTilesStatusArgs
+	var res NodeAggregateTilesStatusResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.AggregateTilesStatus(ctx)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *Error:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for err returned non-nil error type *Error but nil value")
+			}
+			res.Err = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanNodeServer) handleBootstrapped(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req NodeBootstrappedArgs
 	var res NodeBootstrappedResult
@@ -1308,6 +1444,34 @@ func (s *tchanNodeServer) handleBootstrappedInPlacementOrNoPlacement(ctx thrift.
 	return err == nil, &res, nil
 }
 
+func (s *tchanNodeServer) handleCardinalityRaw(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req NodeCardinalityRawArgs
+	var res NodeCardinalityRawResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.CardinalityRaw(ctx, req.Req)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *Error:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for err returned non-nil error type *Error but nil value")
+			}
+			res.Err = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanNodeServer) handleDebugIndexMemorySegments(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req NodeDebugIndexMemorySegmentsArgs
 	var res NodeDebugIndexMemorySegmentsResult
@@ -1392,6 +1556,34 @@ func (s *tchanNodeServer) handleDebugProfileStop(ctx thrift.Context, protocol at
 	return err == nil, &res, nil
 }
 
+func (s *tchanNodeServer) handleDeleteTagged(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req NodeDeleteTaggedArgs
+	var res NodeDeleteTaggedResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.DeleteTagged(ctx, req.Req)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *Error:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for err returned non-nil error type *Error but nil value")
+			}
+			res.Err = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanNodeServer) handleFetch(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req NodeFetchArgs
 	var res NodeFetchResult
@@ -1476,6 +1668,34 @@ func (s *tchanNodeServer) handleFetchBatchRawV2(ctx thrift.Context, protocol ath
 	return err == nil, &res, nil
 }
 
+func (s *tchanNodeServer) handleFetchBlocksMerkleTreeRaw(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req NodeFetchBlocksMerkleTreeRawArgs
+	var res NodeFetchBlocksMerkleTreeRawResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.FetchBlocksMerkleTreeRaw(ctx, req.Req)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *Error:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for err returned non-nil error type *Error but nil value")
+			}
+			res.Err = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanNodeServer) handleFetchBlocksMetadataRawV2(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req NodeFetchBlocksMetadataRawV2Args
 	var res NodeFetchBlocksMetadataRawV2Result
@@ -1560,6 +1780,34 @@ func (s *tchanNodeServer) handleFetchTagged(ctx thrift.Context, protocol athrift
 	return err == nil, &res, nil
 }
 
+func (s *tchanNodeServer) handleFetchTaggedStream(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req NodeFetchTaggedStreamArgs
+	var res NodeFetchTaggedStreamResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.FetchTaggedStream(ctx, req.Req)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *Error:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for err returned non-nil error type *Error but nil value")
+			}
+			res.Err = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanNodeServer) handleGetPersistRateLimit(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req NodeGetPersistRateLimitArgs
 	var res NodeGetPersistRateLimitResult
@@ -21,12 +21,14 @@
 package namespace
 
 import (
+	"errors"
 	"fmt"
 	"time"
 )
 
 type aggregationOptions struct {
-	aggregations []Aggregation
+	aggregations     []Aggregation
+	tileAggregations []TileAggregation
 }
 
 // NewAggregationOptions creates new AggregationOptions.
@@ -44,6 +46,16 @@ func (a *aggregationOptions) Aggregations() []Aggregation {
 	return a.aggregations
 }
 
+func (a *aggregationOptions) SetTileAggregations(value []TileAggregation) AggregationOptions {
+	opts := *a
+	opts.tileAggregations = value
+	return &opts
+}
+
+func (a *aggregationOptions) TileAggregations() []TileAggregation {
+	return a.tileAggregations
+}
+
 func (a *aggregationOptions) Equal(rhs AggregationOptions) bool {
 	if len(a.aggregations) != len(rhs.Aggregations()) {
 		return false
@@ -55,6 +67,16 @@ func (a *aggregationOptions) Equal(rhs AggregationOptions) bool {
 		}
 	}
 
+	if len(a.tileAggregations) != len(rhs.TileAggregations()) {
+		return false
+	}
+
+	for i, agg := range rhs.TileAggregations() {
+		if a.tileAggregations[i] != agg {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -88,3 +110,17 @@ func NewAggregatedAttributes(resolution time.Duration, downsampleOptions Downsam
 func NewDownsampleOptions(all bool) DownsampleOptions {
 	return DownsampleOptions{All: all}
 }
+
+// NewTileAggregation creates a new TileAggregation.
+func NewTileAggregation(targetNamespace string, step time.Duration) (TileAggregation, error) {
+	if targetNamespace == "" {
+		return TileAggregation{}, errors.New("tile aggregation target namespace must be set")
+	}
+	if step <= 0 {
+		return TileAggregation{}, fmt.Errorf("invalid tile aggregation step %v. must be greater than 0", step)
+	}
+	return TileAggregation{
+		TargetNamespace: targetNamespace,
+		Step:            step,
+	}, nil
+}
@@ -57,3 +57,27 @@ func TestAggregationAttributesValidation(t *testing.T) {
 	_, err = NewAggregatedAttributes(-5*time.Minute, NewDownsampleOptions(true))
 	require.Error(t, err)
 }
+
+func TestAggregationEqualTileAggregations(t *testing.T) {
+	tileAgg, err := NewTileAggregation("agg", 5*time.Minute)
+	require.NoError(t, err)
+
+	opts1 := NewAggregationOptions().SetTileAggregations([]TileAggregation{tileAgg})
+	opts2 := NewAggregationOptions().SetTileAggregations([]TileAggregation{tileAgg})
+	opts3 := NewAggregationOptions()
+
+	require.True(t, opts1.Equal(opts2))
+	require.False(t, opts1.Equal(opts3))
+	require.False(t, opts3.Equal(opts1))
+}
+
+func TestTileAggregationValidation(t *testing.T) {
+	_, err := NewTileAggregation("agg", 5*time.Minute)
+	require.NoError(t, err)
+
+	_, err = NewTileAggregation("", 5*time.Minute)
+	require.Error(t, err)
+
+	_, err = NewTileAggregation("agg", 0)
+	require.Error(t, err)
+}
@@ -235,7 +235,21 @@ func ToStagingState(state *nsproto.StagingState) (StagingState, error) {
 // ToAggregationOptions converts nsproto.AggregationOptions to AggregationOptions.
 func ToAggregationOptions(opts *nsproto.AggregationOptions) (AggregationOptions, error) {
 	aggOpts := NewAggregationOptions()
-	if opts == nil || len(opts.Aggregations) == 0 {
+	if opts == nil {
+		return aggOpts, nil
+	}
+	if len(opts.TileAggregations) > 0 {
+		tileAggregations := make([]TileAggregation, 0, len(opts.TileAggregations))
+		for _, agg := range opts.TileAggregations {
+			tileAgg, err := NewTileAggregation(agg.TargetNamespace, time.Duration(agg.StepNanos))
+			if err != nil {
+				return nil, err
+			}
+			tileAggregations = append(tileAggregations, tileAgg)
+		}
+		aggOpts = aggOpts.SetTileAggregations(tileAggregations)
+	}
+	if len(opts.Aggregations) == 0 {
 		return aggOpts, nil
 	}
 	aggregations := make([]Aggregation, 0, len(opts.Aggregations))
@@ -362,10 +376,10 @@ func toProtoStagingState(state StagingState) (*nsproto.StagingState, error) {
 }
 
 func toProtoAggregationOptions(aggOpts AggregationOptions) *nsproto.AggregationOptions {
-	if aggOpts == nil || len(aggOpts.Aggregations()) == 0 {
+	if aggOpts == nil || (len(aggOpts.Aggregations()) == 0 && len(aggOpts.TileAggregations()) == 0) {
 		return nil
 	}
-	protoAggs := make([]*nsproto.Aggregation, 0, len(aggOpts.Aggregations()))
+	var protoAggs []*nsproto.Aggregation
 	for _, agg := range aggOpts.Aggregations() {
 		protoAgg := nsproto.Aggregation{Aggregated: agg.Aggregated}
 		if agg.Aggregated {
@@ -376,7 +390,17 @@ func toProtoAggregationOptions(aggOpts AggregationOptions) *nsproto.AggregationO
 		}
 		protoAggs = append(protoAggs, &protoAgg)
 	}
-	return &nsproto.AggregationOptions{Aggregations: protoAggs}
+	var protoTileAggs []*nsproto.TileAggregation
+	for _, agg := range aggOpts.TileAggregations() {
+		protoTileAggs = append(protoTileAggs, &nsproto.TileAggregation{
+			TargetNamespace: agg.TargetNamespace,
+			StepNanos:       agg.Step.Nanoseconds(),
+		})
+	}
+	return &nsproto.AggregationOptions{
+		Aggregations:     protoAggs,
+		TileAggregations: protoTileAggs,
+	}
 }
 
 // toRuntimeOptions returns the corresponding RuntimeOptions proto.
@@ -364,6 +364,43 @@ func TestToAggregationOptionsInvalid(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestToAggregationOptionsTileAggregations(t *testing.T) {
+	protoOpts := nsproto.AggregationOptions{
+		TileAggregations: []*nsproto.TileAggregation{
+			{TargetNamespace: "agg", StepNanos: toNanos(5)},
+		},
+	}
+	aggOpts, err := namespace.ToAggregationOptions(&protoOpts)
+	require.NoError(t, err)
+
+	require.Equal(t, 0, len(aggOpts.Aggregations()))
+	require.Equal(t, []namespace.TileAggregation{
+		{TargetNamespace: "agg", Step: 5 * time.Minute},
+	}, aggOpts.TileAggregations())
+
+	md, err := namespace.NewMetadata(ident.StringID("ns1"),
+		namespace.NewOptions().SetAggregationOptions(aggOpts))
+	require.NoError(t, err)
+	nsMap, err := namespace.NewMap([]namespace.Metadata{md})
+	require.NoError(t, err)
+
+	reg, err := namespace.ToProto(nsMap)
+	require.NoError(t, err)
+	require.Equal(t, protoOpts, *reg.Namespaces["ns1"].AggregationOptions)
+}
+
+func TestToAggregationOptionsTileAggregationsInvalid(t *testing.T) {
+	for _, tileAgg := range []*nsproto.TileAggregation{
+		{TargetNamespace: "", StepNanos: toNanos(5)},
+		{TargetNamespace: "agg", StepNanos: 0},
+	} {
+		_, err := namespace.ToAggregationOptions(&nsproto.AggregationOptions{
+			TileAggregations: []*nsproto.TileAggregation{tileAgg},
+		})
+		require.Error(t, err)
+	}
+}
+
 func TestAggregationOptsToProto(t *testing.T) {
 	aggOpts, err := namespace.ToAggregationOptions(&validAggregationOpts)
 	require.NoError(t, err)
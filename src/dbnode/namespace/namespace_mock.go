@@ -1563,3 +1563,31 @@ func (mr *MockAggregationOptionsMockRecorder) SetAggregations(value interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAggregations", reflect.TypeOf((*MockAggregationOptions)(nil).SetAggregations), value)
 }
+
+// SetTileAggregations mocks base method.
+func (m *MockAggregationOptions) SetTileAggregations(value []TileAggregation) AggregationOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTileAggregations", value)
+	ret0, _ := ret[0].(AggregationOptions)
+	return ret0
+}
+
+// SetTileAggregations indicates an expected call of SetTileAggregations.
+func (mr *MockAggregationOptionsMockRecorder) SetTileAggregations(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTileAggregations", reflect.TypeOf((*MockAggregationOptions)(nil).SetTileAggregations), value)
+}
+
+// TileAggregations mocks base method.
+func (m *MockAggregationOptions) TileAggregations() []TileAggregation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TileAggregations")
+	ret0, _ := ret[0].([]TileAggregation)
+	return ret0
+}
+
+// TileAggregations indicates an expected call of TileAggregations.
+func (mr *MockAggregationOptionsMockRecorder) TileAggregations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TileAggregations", reflect.TypeOf((*MockAggregationOptions)(nil).TileAggregations))
+}
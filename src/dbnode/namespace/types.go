@@ -340,6 +340,12 @@ type AggregationOptions interface {
 
 	// Aggregations returns the aggregations for this namespace.
 	Aggregations() []Aggregation
+
+	// SetTileAggregations sets the tile aggregations for this namespace.
+	SetTileAggregations(value []TileAggregation) AggregationOptions
+
+	// TileAggregations returns the tile aggregations for this namespace.
+	TileAggregations() []TileAggregation
 }
 
 // Aggregation describes data points within the namespace.
@@ -370,6 +376,16 @@ type DownsampleOptions struct {
 	All bool
 }
 
+// TileAggregation describes a target namespace that data points within the
+// namespace are aggregated into with large tiles once their blocks are sealed.
+type TileAggregation struct {
+	// TargetNamespace is the namespace the aggregated tiles are written to.
+	TargetNamespace string
+
+	// Step is the size of each aggregated tile.
+	Step time.Duration
+}
+
 // StagingStatus is the status of the namespace.
 type StagingStatus uint8
 
@@ -147,11 +147,11 @@ func RegisterHandlers(mux *http.ServeMux, service interface{}, opts ServerOption
 
 			httpMethod := strings.ToUpper(r.Method)
 			if reqIn == nil && httpMethod != "GET" {
-				writeError(w, errRequestMustBeGet)
+				WriteError(w, errRequestMustBeGet)
 				return
 			}
 			if reqIn != nil && httpMethod != "POST" {
-				writeError(w, errRequestMustBePost)
+				WriteError(w, errRequestMustBePost)
 				return
 			}
 
@@ -173,7 +173,7 @@ func RegisterHandlers(mux *http.ServeMux, service interface{}, opts ServerOption
 				}
 				if err := decoder.Decode(in); err != nil {
 					err := fmt.Errorf("invalid request body: %v", err)
-					writeError(w, xerrors.NewInvalidParamsError(err))
+					WriteError(w, xerrors.NewInvalidParamsError(err))
 					return
 				}
 			}
@@ -206,7 +206,7 @@ func RegisterHandlers(mux *http.ServeMux, service interface{}, opts ServerOption
 
 				// Deal with error case
 				if !ret[0].IsNil() {
-					writeError(w, ret[0].Interface())
+					WriteError(w, ret[0].Interface())
 					return
 				}
 				json.NewEncoder(w).Encode(&respSuccess{})
@@ -226,13 +226,13 @@ func RegisterHandlers(mux *http.ServeMux, service interface{}, opts ServerOption
 
 			// Deal with error case
 			if !ret[1].IsNil() {
-				writeError(w, ret[1].Interface())
+				WriteError(w, ret[1].Interface())
 				return
 			}
 
 			buff := bytes.NewBuffer(nil)
 			if err := json.NewEncoder(buff).Encode(ret[0].Interface()); err != nil {
-				writeError(w, fmt.Errorf("failed to encode response body: %v", err))
+				WriteError(w, fmt.Errorf("failed to encode response body: %v", err))
 				return
 			}
 
@@ -243,7 +243,9 @@ func RegisterHandlers(mux *http.ServeMux, service interface{}, opts ServerOption
 	return nil
 }
 
-func writeError(w http.ResponseWriter, errValue interface{}) {
+// WriteError writes an error as a JSON response, the status code is set
+// from the error if it is an Error or invalid params error.
+func WriteError(w http.ResponseWriter, errValue interface{}) {
 	result := respErrorResult{respError{}}
 	if value, ok := errValue.(error); ok {
 		result.Error.Message = value.Error()
@@ -61,6 +61,7 @@ func (s *server) ListenAndServe() (ns.Close, error) {
 	if err := httpjson.RegisterHandlers(mux, s.service, s.opts); err != nil {
 		return nil, err
 	}
+	mux.Handle(TSDBStatusURL, newTSDBStatusHandler(s.service, s.opts))
 
 	listener, err := net.Listen("tcp", s.address)
 	if err != nil {
@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package node
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/uber/tchannel-go/thrift"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/httpjson"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/m3ninx/idx"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+const (
+	// TSDBStatusURL is the url of the TSDB status endpoint, it returns the
+	// cardinality statistics of the series of a namespace indexed by the node
+	// in the format of the Prometheus TSDB status endpoint.
+	TSDBStatusURL = "/api/v1/status/tsdb"
+
+	tsdbStatusNamespaceParam  = "namespace"
+	tsdbStatusLimitParam      = "limit"
+	tsdbStatusMetricNameParam = "metricName"
+	tsdbStatusStartParam      = "start"
+	tsdbStatusEndParam        = "end"
+
+	defaultTSDBStatusLimit      = 10
+	defaultTSDBStatusMetricName = "__name__"
+)
+
+var errTSDBStatusNoNamespace = xerrors.NewInvalidParamsError(
+	errors.New("namespace is required"))
+
+type tsdbStatusHandler struct {
+	service rpc.TChanNode
+	opts    httpjson.ServerOptions
+	nowFn   func() time.Time
+}
+
+func newTSDBStatusHandler(
+	service rpc.TChanNode,
+	opts httpjson.ServerOptions,
+) http.Handler {
+	return &tsdbStatusHandler{
+		service: service,
+		opts:    opts,
+		nowFn:   time.Now,
+	}
+}
+
+type tsdbStatusResponse struct {
+	Status string         `json:"status"`
+	Data   tsdbStatusData `json:"data"`
+}
+
+type tsdbStatusData struct {
+	HeadStats                   tsdbStatusHeadStats `json:"headStats"`
+	SeriesCountByMetricName     []tsdbStatusStat    `json:"seriesCountByMetricName"`
+	LabelValueCountByLabelName  []tsdbStatusStat    `json:"labelValueCountByLabelName"`
+	MemoryInBytesByLabelName    []tsdbStatusStat    `json:"memoryInBytesByLabelName"`
+	SeriesCountByLabelValuePair []tsdbStatusStat    `json:"seriesCountByLabelValuePair"`
+}
+
+type tsdbStatusHeadStats struct {
+	NumSeries     int `json:"numSeries"`
+	NumLabelPairs int `json:"numLabelPairs"`
+}
+
+type tsdbStatusStat struct {
+	Name  string `json:"name"`
+	Value int    `json:"value"`
+}
+
+func (h *tsdbStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method != http.MethodGet {
+		httpjson.WriteError(w, httpjson.NewError(
+			errors.New("request must be GET"), http.StatusMethodNotAllowed))
+		return
+	}
+
+	req, limit, metricName, err := h.parseRequest(r)
+	if err != nil {
+		httpjson.WriteError(w, err)
+		return
+	}
+
+	callContext, _ := thrift.NewContext(h.opts.RequestTimeout())
+	if fn := h.opts.ContextFn(); fn != nil {
+		callContext = fn(callContext, "CardinalityRaw", nil)
+	}
+	if fn := h.opts.PostResponseFn(); fn != nil {
+		defer fn(callContext, "CardinalityRaw", nil)
+	}
+
+	result, err := h.service.CardinalityRaw(callContext, req)
+	if err != nil {
+		httpjson.WriteError(w, err)
+		return
+	}
+
+	results := index.NewCardinalityResults(ident.BytesID(req.NameSpace))
+	convert.FromRPCCardinalityRawResult(result, results)
+	stats := results.Stats([]byte(metricName), limit)
+
+	response := tsdbStatusResponse{
+		Status: "success",
+		Data: tsdbStatusData{
+			HeadStats: tsdbStatusHeadStats{
+				NumSeries:     stats.NumSeries,
+				NumLabelPairs: stats.NumLabelPairs,
+			},
+			SeriesCountByMetricName:     toTSDBStatusStats(stats.SeriesCountByMetricName),
+			LabelValueCountByLabelName:  toTSDBStatusStats(stats.LabelValueCountByLabelName),
+			MemoryInBytesByLabelName:    toTSDBStatusStats(stats.MemoryInBytesByLabelName),
+			SeriesCountByLabelValuePair: toTSDBStatusStats(stats.SeriesCountByLabelValuePair),
+		},
+	}
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		httpjson.WriteError(w, fmt.Errorf("failed to encode response body: %v", err))
+	}
+}
+
+func (h *tsdbStatusHandler) parseRequest(
+	r *http.Request,
+) (*rpc.CardinalityRawRequest, int, string, error) {
+	namespace := r.FormValue(tsdbStatusNamespaceParam)
+	if namespace == "" {
+		return nil, 0, "", errTSDBStatusNoNamespace
+	}
+
+	limit := defaultTSDBStatusLimit
+	if v := r.FormValue(tsdbStatusLimitParam); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 0 {
+			return nil, 0, "", xerrors.NewInvalidParamsError(
+				fmt.Errorf("invalid limit: %s", v))
+		}
+		limit = parsed
+	}
+
+	metricName := defaultTSDBStatusMetricName
+	if v := r.FormValue(tsdbStatusMetricNameParam); v != "" {
+		metricName = v
+	}
+
+	// NB: by default only the active index block is queried, which holds the
+	// series recently written, similar to the head of a Prometheus TSDB.
+	now := h.nowFn()
+	start, err := parseTSDBStatusTime(r.FormValue(tsdbStatusStartParam), now)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	end, err := parseTSDBStatusTime(r.FormValue(tsdbStatusEndParam), now)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	query, err := idx.Marshal(idx.NewAllQuery())
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	req := &rpc.CardinalityRawRequest{
+		NameSpace:  []byte(namespace),
+		Query:      query,
+		RangeStart: start.UnixNano(),
+		RangeEnd:   end.UnixNano(),
+	}
+	if limit > 0 {
+		l := int64(limit)
+		req.Limit = &l
+	}
+	return req, limit, metricName, nil
+}
+
+// parseTSDBStatusTime parses a time given as a unix timestamp in seconds or
+// in the RFC3339 format, returning the default time if not set.
+func parseTSDBStatusTime(v string, defaultTime time.Time) (time.Time, error) {
+	if v == "" {
+		return defaultTime, nil
+	}
+	if secs, err := strconv.ParseFloat(v, 64); err == nil {
+		return time.Unix(0, int64(secs*float64(time.Second))), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return time.Time{}, xerrors.NewInvalidParamsError(
+			fmt.Errorf("invalid time: %s", v))
+	}
+	return t, nil
+}
+
+func toTSDBStatusStats(stats []index.CardinalityStat) []tsdbStatusStat {
+	result := make([]tsdbStatusStat, 0, len(stats))
+	for _, stat := range stats {
+		result = append(result, tsdbStatusStat{
+			Name:  stat.Name,
+			Value: stat.Value,
+		})
+	}
+	return result
+}
@@ -212,7 +212,7 @@ func ToRPCError(err error) *rpc.Error {
 		return rpcErr
 	}
 
-	if limits.IsQueryLimitExceededError(err) {
+	if limits.IsQueryLimitExceededError(err) || limits.IsSeriesLimitExceededError(err) {
 		return tterrors.NewResourceExhaustedError(err)
 	}
 	if xerrors.IsInvalidParams(err) {
@@ -279,6 +279,60 @@ func FromRPCFetchTaggedRequest(
 	return ns, index.Query{Query: q}, opts, req.FetchData, nil
 }
 
+// FromRPCDeleteTaggedRequest converts the rpc request type for
+// DeleteTaggedRequest into corresponding Go types.
+func FromRPCDeleteTaggedRequest(
+	req *rpc.DeleteTaggedRequest,
+) (index.Query, xtime.UnixNano, xtime.UnixNano, error) {
+	start, rangeStartErr := ToTime(req.RangeStart, req.RangeTimeType)
+	if rangeStartErr != nil {
+		return index.Query{}, 0, 0, rangeStartErr
+	}
+
+	end, rangeEndErr := ToTime(req.RangeEnd, req.RangeTimeType)
+	if rangeEndErr != nil {
+		return index.Query{}, 0, 0, rangeEndErr
+	}
+
+	q, err := idx.Unmarshal(req.Query)
+	if err != nil {
+		return index.Query{}, 0, 0, err
+	}
+
+	return index.Query{Query: q}, start, end, nil
+}
+
+// ToRPCDeleteTaggedRequest converts the Go `client/` types into rpc request
+// type for DeleteTaggedRequest.
+func ToRPCDeleteTaggedRequest(
+	ns ident.ID,
+	q index.Query,
+	start, end xtime.UnixNano,
+) (rpc.DeleteTaggedRequest, error) {
+	rangeStart, tsErr := ToValue(start, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.DeleteTaggedRequest{}, tsErr
+	}
+
+	rangeEnd, tsErr := ToValue(end, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.DeleteTaggedRequest{}, tsErr
+	}
+
+	query, queryErr := idx.Marshal(q.Query)
+	if queryErr != nil {
+		return rpc.DeleteTaggedRequest{}, queryErr
+	}
+
+	return rpc.DeleteTaggedRequest{
+		NameSpace:     ns.Bytes(),
+		Query:         query,
+		RangeStart:    rangeStart,
+		RangeEnd:      rangeEnd,
+		RangeTimeType: fetchTaggedTimeType,
+	}, nil
+}
+
 // ToRPCFetchTaggedRequest converts the Go `client/` types into rpc request type
 // for FetchTaggedRequest.
 func ToRPCFetchTaggedRequest(
@@ -513,6 +567,147 @@ func ToRPCAggregateQueryRawRequest(
 	return request, nil
 }
 
+// FromRPCCardinalityRawRequest converts the rpc request type for
+// CardinalityRawRequest into appropriate types.
+func FromRPCCardinalityRawRequest(
+	req *rpc.CardinalityRawRequest,
+	pools FetchTaggedConversionPools,
+) (ident.ID, index.Query, index.CardinalityOptions, error) {
+	start, rangeStartErr := ToTime(req.RangeStart, fetchTaggedTimeType)
+	if rangeStartErr != nil {
+		return nil, index.Query{}, index.CardinalityOptions{}, rangeStartErr
+	}
+
+	end, rangeEndErr := ToTime(req.RangeEnd, fetchTaggedTimeType)
+	if rangeEndErr != nil {
+		return nil, index.Query{}, index.CardinalityOptions{}, rangeEndErr
+	}
+
+	opts := index.CardinalityOptions{
+		QueryOptions: index.QueryOptions{
+			StartInclusive: start,
+			EndExclusive:   end,
+		},
+	}
+	if l := req.Limit; l != nil {
+		opts.Limit = int(*l)
+	}
+
+	query, err := idx.Unmarshal(req.Query)
+	if err != nil {
+		return nil, index.Query{}, index.CardinalityOptions{}, err
+	}
+
+	var ns ident.ID
+	if pools != nil {
+		nsBytes := pools.CheckedBytesWrapper().Get(req.NameSpace)
+		ns = pools.ID().BinaryID(nsBytes)
+	} else {
+		ns = ident.StringID(string(req.NameSpace))
+	}
+	return ns, index.Query{Query: query}, opts, nil
+}
+
+// ToRPCCardinalityRawRequest converts the Go `client/` types into rpc
+// request type for CardinalityRawRequest.
+func ToRPCCardinalityRawRequest(
+	ns ident.ID,
+	q index.Query,
+	opts index.CardinalityOptions,
+) (rpc.CardinalityRawRequest, error) {
+	rangeStart, tsErr := ToValue(opts.StartInclusive, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.CardinalityRawRequest{}, tsErr
+	}
+
+	rangeEnd, tsErr := ToValue(opts.EndExclusive, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.CardinalityRawRequest{}, tsErr
+	}
+
+	query, queryErr := idx.Marshal(q.Query)
+	if queryErr != nil {
+		return rpc.CardinalityRawRequest{}, queryErr
+	}
+
+	request := rpc.CardinalityRawRequest{
+		NameSpace:  ns.Bytes(),
+		Query:      query,
+		RangeStart: rangeStart,
+		RangeEnd:   rangeEnd,
+	}
+	if opts.Limit > 0 {
+		l := int64(opts.Limit)
+		request.Limit = &l
+	}
+
+	return request, nil
+}
+
+// ToRPCCardinalityRawResult converts the cardinality results into the rpc
+// result type for CardinalityRawResult.
+func ToRPCCardinalityRawResult(
+	results index.CardinalityResults,
+) *rpc.CardinalityRawResult_ {
+	response := &rpc.CardinalityRawResult_{
+		NumSeries: int64(results.NumSeries()),
+	}
+
+	var (
+		elem  *rpc.CardinalityRawResultTagNameElement
+		elems = make(map[string]*rpc.CardinalityRawResultTagNameElement)
+	)
+	results.ForEach(func(name, value string, seriesCount int) {
+		// NB: values are iterated in order of tag name, so all values of
+		// a tag name are consecutive.
+		if elem == nil || string(elem.TagName) != name {
+			elem = &rpc.CardinalityRawResultTagNameElement{
+				TagName: []byte(name),
+			}
+			elems[name] = elem
+			response.Results = append(response.Results, elem)
+		}
+		elem.TagValues = append(elem.TagValues, &rpc.CardinalityRawResultTagValueElement{
+			TagValue:    []byte(value),
+			SeriesCount: int64(seriesCount),
+		})
+	})
+
+	results.ForEachTruncated(func(name string, numValues, valuesBytes int) {
+		elem, ok := elems[name]
+		if !ok {
+			elem = &rpc.CardinalityRawResultTagNameElement{
+				TagName:   []byte(name),
+				TagValues: []*rpc.CardinalityRawResultTagValueElement{},
+			}
+			response.Results = append(response.Results, elem)
+		}
+		truncatedValues, truncatedBytes := int64(numValues), int64(valuesBytes)
+		elem.TruncatedTagValues = &truncatedValues
+		elem.TruncatedTagValuesBytes = &truncatedBytes
+	})
+
+	return response
+}
+
+// FromRPCCardinalityRawResult adds the number of series of the rpc result
+// type for CardinalityRawResult to the cardinality results.
+func FromRPCCardinalityRawResult(
+	result *rpc.CardinalityRawResult_,
+	results index.CardinalityResults,
+) {
+	results.AddSeries(int(result.NumSeries))
+	for _, elem := range result.Results {
+		for _, value := range elem.TagValues {
+			results.AddTerm(elem.TagName, value.TagValue, int(value.SeriesCount))
+		}
+		if elem.IsSetTruncatedTagValues() || elem.IsSetTruncatedTagValuesBytes() {
+			results.AddTruncated(elem.TagName, int(elem.GetTruncatedTagValues()),
+				int(elem.GetTruncatedTagValuesBytes()))
+		}
+	}
+}
+
 // ToTagsIter returns a tag iterator over the given request.
 func ToTagsIter(r *rpc.WriteTaggedRequest) (ident.TagIterator, error) {
 	if r == nil {
@@ -0,0 +1,250 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package node
+
+import (
+	goctx "context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/tchannel-go/thrift"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
+	"github.com/m3db/m3/src/x/context"
+)
+
+const (
+	// defaultFetchTaggedStreamBatchSize is the number of series returned per
+	// batch of a stream when the request does not set a batch size.
+	defaultFetchTaggedStreamBatchSize = 1024
+)
+
+var (
+	// errFetchTaggedStreamNotFound is raised when a stream has either been
+	// read to completion, canceled or closed after being idle.
+	errFetchTaggedStreamNotFound = errors.New("fetch tagged stream not found")
+
+	// errFetchTaggedStreamRequestInvalid is raised when a stream request has
+	// neither a fetch request nor a stream ID set.
+	errFetchTaggedStreamRequestInvalid = errors.New(
+		"fetch tagged stream request requires either a fetch request or a stream ID")
+)
+
+// fetchTaggedStreams are the open streams of fetch tagged results. A stream is
+// removed while a batch is read from it so that it is only ever read by a
+// single request at a time.
+type fetchTaggedStreams struct {
+	sync.Mutex
+	streams     map[string]*fetchTaggedStream
+	idleTimeout time.Duration
+}
+
+func newFetchTaggedStreams(idleTimeout time.Duration) *fetchTaggedStreams {
+	return &fetchTaggedStreams{
+		streams:     make(map[string]*fetchTaggedStream),
+		idleTimeout: idleTimeout,
+	}
+}
+
+// take removes the stream with the given ID, returning false if it is not open.
+func (r *fetchTaggedStreams) take(id []byte) (*fetchTaggedStream, bool) {
+	r.Lock()
+	stream, ok := r.streams[string(id)]
+	if ok {
+		delete(r.streams, stream.id)
+	}
+	r.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	// NB: if the timer already fired the expiry is a no-op since the stream
+	// was removed above.
+	stream.timer.Stop()
+	return stream, true
+}
+
+// put returns the stream to be read from by a subsequent request, closing it
+// if it is not read from within the idle timeout.
+func (r *fetchTaggedStreams) put(stream *fetchTaggedStream) {
+	r.Lock()
+	r.streams[stream.id] = stream
+	r.Unlock()
+
+	if stream.timer == nil {
+		id := stream.id
+		stream.timer = time.AfterFunc(r.idleTimeout, func() {
+			r.expire(id)
+		})
+		return
+	}
+	stream.timer.Reset(r.idleTimeout)
+}
+
+func (r *fetchTaggedStreams) expire(id string) {
+	r.Lock()
+	stream, ok := r.streams[id]
+	if ok {
+		delete(r.streams, id)
+	}
+	r.Unlock()
+	if ok {
+		stream.close(nil)
+	}
+}
+
+// fetchTaggedStream is a stream of fetch tagged results read in batches.
+type fetchTaggedStream struct {
+	id    string
+	ctx   context.Context
+	iter  FetchTaggedResultsIter
+	timer *time.Timer
+
+	numRead          int
+	waitedIndex      int
+	waitedSeriesRead int
+}
+
+func (s *fetchTaggedStream) nextBatch(batchSize int) (*rpc.FetchTaggedStreamResult_, error) {
+	size := s.iter.NumIDs() - s.numRead
+	if size > batchSize {
+		size = batchSize
+	}
+
+	result := &rpc.FetchTaggedStreamResult_{
+		Elements:   make([]*rpc.FetchTaggedIDResult_, 0, size),
+		Exhaustive: s.iter.Exhaustive(),
+	}
+	for len(result.Elements) < batchSize && s.iter.Next(s.ctx) {
+		elem, err := fetchTaggedIDResult(s.ctx, s.iter)
+		if err != nil {
+			return nil, err
+		}
+		result.Elements = append(result.Elements, elem)
+	}
+	if err := s.iter.Err(); err != nil {
+		return nil, err
+	}
+	s.numRead += len(result.Elements)
+
+	// NB: waits are reported per batch so that they can be summed by callers.
+	if v := int64(s.iter.WaitedIndex() - s.waitedIndex); v > 0 {
+		result.WaitedIndex = &v
+	}
+	if v := int64(s.iter.WaitedSeriesRead() - s.waitedSeriesRead); v > 0 {
+		result.WaitedSeriesRead = &v
+	}
+	s.waitedIndex = s.iter.WaitedIndex()
+	s.waitedSeriesRead = s.iter.WaitedSeriesRead()
+
+	return result, nil
+}
+
+func (s *fetchTaggedStream) done() bool {
+	return s.numRead >= s.iter.NumIDs()
+}
+
+func (s *fetchTaggedStream) close(err error) {
+	s.iter.Close(err)
+	s.ctx.Close()
+}
+
+func (s *service) FetchTaggedStream(
+	tctx thrift.Context,
+	req *rpc.FetchTaggedStreamRequest,
+) (*rpc.FetchTaggedStreamResult_, error) {
+	var stream *fetchTaggedStream
+	switch {
+	case req.Fetch != nil:
+		created, err := s.newFetchTaggedStream(req.Fetch)
+		if err != nil {
+			return nil, convert.ToRPCError(err)
+		}
+		stream = created
+	case req.StreamID != nil:
+		taken, ok := s.fetchTaggedStreams.take(req.StreamID)
+		if !ok {
+			return nil, tterrors.NewBadRequestError(errFetchTaggedStreamNotFound)
+		}
+		stream = taken
+	default:
+		return nil, tterrors.NewBadRequestError(errFetchTaggedStreamRequestInvalid)
+	}
+
+	if req.GetCancel() {
+		exhaustive := stream.iter.Exhaustive()
+		stream.close(nil)
+		return &rpc.FetchTaggedStreamResult_{
+			Elements:   []*rpc.FetchTaggedIDResult_{},
+			Exhaustive: exhaustive,
+		}, nil
+	}
+
+	batchSize := int(req.BatchSize)
+	if batchSize <= 0 {
+		batchSize = defaultFetchTaggedStreamBatchSize
+	}
+
+	// NB: reads of a batch are bounded by the request rather than the stream
+	// so that waiting on permits respects the request deadline.
+	stream.ctx.SetGoContext(tctx)
+	result, err := stream.nextBatch(batchSize)
+	stream.ctx.SetGoContext(goctx.Background())
+	if err != nil {
+		stream.close(err)
+		return nil, convert.ToRPCError(err)
+	}
+
+	if stream.done() {
+		stream.close(nil)
+		return result, nil
+	}
+
+	result.StreamID = []byte(stream.id)
+	s.fetchTaggedStreams.put(stream)
+	return result, nil
+}
+
+func (s *service) newFetchTaggedStream(req *rpc.FetchTaggedRequest) (*fetchTaggedStream, error) {
+	// NB: the stream outlives the request starting it so it is read with its
+	// own context, closed along with the stream. Data read for the stream is
+	// held until then since the results iterator reads the in-memory blocks
+	// of all series when first advanced.
+	ctx := context.NewWithGoContext(goctx.Background())
+	// NB: series are streamed in the order of their IDs so that clients can
+	// merge the streams of the replicas of a shard as they are read.
+	const sortByID = true
+	iter, err := s.instrumentedFetchTaggedIter(ctx, req, sortByID)
+	if err != nil {
+		ctx.Close()
+		return nil, err
+	}
+
+	return &fetchTaggedStream{
+		id:   uuid.NewRandom().String(),
+		ctx:  ctx,
+		iter: iter,
+	}, nil
+}
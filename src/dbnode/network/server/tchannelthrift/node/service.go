@@ -21,6 +21,7 @@
 package node
 
 import (
+	"bytes"
 	goctx "context"
 	"errors"
 	"fmt"
@@ -108,12 +109,15 @@ type serviceMetrics struct {
 	fetch                   instrument.MethodMetrics
 	fetchTagged             instrument.MethodMetrics
 	aggregate               instrument.MethodMetrics
+	cardinality             instrument.MethodMetrics
 	write                   instrument.MethodMetrics
 	writeTagged             instrument.MethodMetrics
 	fetchBlocks             instrument.MethodMetrics
 	fetchBlocksMetadata     instrument.MethodMetrics
+	fetchBlocksMerkleTree   instrument.MethodMetrics
 	repair                  instrument.MethodMetrics
 	truncate                instrument.MethodMetrics
+	deleteTagged            instrument.MethodMetrics
 	fetchBatchRawRPCS       tally.Counter
 	fetchBatchRaw           instrument.BatchMethodMetrics
 	writeBatchRawRPCs       tally.Counter
@@ -132,12 +136,15 @@ func newServiceMetrics(scope tally.Scope, opts instrument.TimerOptions) serviceM
 		fetch:                   instrument.NewMethodMetrics(scope, "fetch", opts),
 		fetchTagged:             instrument.NewMethodMetrics(scope, "fetchTagged", opts),
 		aggregate:               instrument.NewMethodMetrics(scope, "aggregate", opts),
+		cardinality:             instrument.NewMethodMetrics(scope, "cardinality", opts),
 		write:                   instrument.NewMethodMetrics(scope, "write", opts),
 		writeTagged:             instrument.NewMethodMetrics(scope, "writeTagged", opts),
 		fetchBlocks:             instrument.NewMethodMetrics(scope, "fetchBlocks", opts),
 		fetchBlocksMetadata:     instrument.NewMethodMetrics(scope, "fetchBlocksMetadata", opts),
+		fetchBlocksMerkleTree:   instrument.NewMethodMetrics(scope, "fetchBlocksMerkleTree", opts),
 		repair:                  instrument.NewMethodMetrics(scope, "repair", opts),
 		truncate:                instrument.NewMethodMetrics(scope, "truncate", opts),
+		deleteTagged:            instrument.NewMethodMetrics(scope, "deleteTagged", opts),
 		fetchBatchRawRPCS:       scope.Counter("fetchBatchRaw-rpcs"),
 		fetchBatchRaw:           instrument.NewBatchMethodMetrics(scope, "fetchBatchRaw", opts),
 		writeBatchRawRPCs:       scope.Counter("writeBatchRaw-rpcs"),
@@ -161,12 +168,13 @@ type service struct {
 
 	logger *zap.Logger
 
-	opts              tchannelthrift.Options
-	nowFn             clock.NowFn
-	pools             pools
-	metrics           serviceMetrics
-	queryLimits       limits.QueryLimits
-	seriesReadPermits permits.Manager
+	opts               tchannelthrift.Options
+	nowFn              clock.NowFn
+	pools              pools
+	metrics            serviceMetrics
+	queryLimits        limits.QueryLimits
+	seriesReadPermits  permits.Manager
+	fetchTaggedStreams *fetchTaggedStreams
 }
 
 type serviceState struct {
@@ -344,8 +352,9 @@ func NewService(db storage.Database, opts tchannelthrift.Options) Service {
 			blockMetadataV2:         opts.BlockMetadataV2Pool(),
 			blockMetadataV2Slice:    opts.BlockMetadataV2SlicePool(),
 		},
-		queryLimits:       opts.QueryLimits(),
-		seriesReadPermits: opts.PermitsOptions().SeriesReadPermitsManager(),
+		queryLimits:        opts.QueryLimits(),
+		seriesReadPermits:  opts.PermitsOptions().SeriesReadPermitsManager(),
+		fetchTaggedStreams: newFetchTaggedStreams(opts.FetchTaggedStreamIdleTimeout()),
 	}
 }
 
@@ -644,6 +653,35 @@ func (s *service) aggregateTiles(
 	return processedTileCount, nil
 }
 
+func (s *service) AggregateTilesStatus(tctx thrift.Context) (*rpc.AggregateTilesStatusResult_, error) {
+	db, ok := s.state.DB()
+	if !ok {
+		return nil, convert.ToRPCError(errDatabaseIsNotInitializedYet)
+	}
+
+	statuses := db.TileAggregationStatuses()
+	result := &rpc.AggregateTilesStatusResult_{
+		Statuses: make([]*rpc.AggregateTilesStatus, 0, len(statuses)),
+	}
+	for _, status := range statuses {
+		rpcStatus := &rpc.AggregateTilesStatus{
+			SourceNamespace:    status.SourceNamespace,
+			TargetNamespace:    status.TargetNamespace,
+			Step:               status.Step.String(),
+			AggregatedUntil:    int64(status.AggregatedUntil),
+			ProcessedTileCount: status.ProcessedTileCount,
+			LastRunAt:          int64(status.LastRunAt),
+		}
+		if status.LastError != "" {
+			lastError := status.LastError
+			rpcStatus.LastError = &lastError
+		}
+		result.Statuses = append(result.Statuses, rpcStatus)
+	}
+
+	return result, nil
+}
+
 func (s *service) Fetch(tctx thrift.Context, req *rpc.FetchRequest) (*rpc.FetchResult_, error) {
 	db, err := s.startReadRPCWithDB()
 	if err != nil {
@@ -759,21 +797,11 @@ func (s *service) fetchTaggedResult(ctx context.Context,
 	}
 
 	for iter.Next(ctx) {
-		cur := iter.Current()
-		tagBytes, err := cur.WriteTags(nil)
+		elem, err := fetchTaggedIDResult(ctx, iter)
 		if err != nil {
 			return nil, err
 		}
-		segments, err := cur.WriteSegments(ctx, nil)
-		if err != nil {
-			return nil, err
-		}
-		response.Elements = append(response.Elements, &rpc.FetchTaggedIDResult_{
-			ID:          cur.ID(),
-			NameSpace:   iter.Namespace().Bytes(),
-			EncodedTags: tagBytes,
-			Segments:    segments,
-		})
+		response.Elements = append(response.Elements, elem)
 	}
 	if iter.Err() != nil {
 		return nil, iter.Err()
@@ -789,7 +817,37 @@ func (s *service) fetchTaggedResult(ctx context.Context,
 	return response, nil
 }
 
+func fetchTaggedIDResult(
+	ctx context.Context,
+	iter FetchTaggedResultsIter,
+) (*rpc.FetchTaggedIDResult_, error) {
+	cur := iter.Current()
+	tagBytes, err := cur.WriteTags(nil)
+	if err != nil {
+		return nil, err
+	}
+	segments, err := cur.WriteSegments(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.FetchTaggedIDResult_{
+		ID:          cur.ID(),
+		NameSpace:   iter.Namespace().Bytes(),
+		EncodedTags: tagBytes,
+		Segments:    segments,
+	}, nil
+}
+
 func (s *service) FetchTaggedIter(ctx context.Context, req *rpc.FetchTaggedRequest) (FetchTaggedResultsIter, error) {
+	const sortByID = false
+	return s.instrumentedFetchTaggedIter(ctx, req, sortByID)
+}
+
+func (s *service) instrumentedFetchTaggedIter(
+	ctx context.Context,
+	req *rpc.FetchTaggedRequest,
+	sortByID bool,
+) (FetchTaggedResultsIter, error) {
 	callStart := s.nowFn()
 	ctx = addRequestDataToM3Context(ctx, req.Source, tchannelthrift.FetchTagged)
 	ctx, sp, sampled := ctx.StartSampledTraceSpan(tracepoint.FetchTagged)
@@ -810,7 +868,7 @@ func (s *service) FetchTaggedIter(ctx context.Context, req *rpc.FetchTaggedReque
 
 		s.metrics.fetchTagged.ReportSuccessOrError(err, s.nowFn().Sub(callStart))
 	}
-	iter, err := s.fetchTaggedIter(ctx, req, instrumentClose)
+	iter, err := s.fetchTaggedIter(ctx, req, sortByID, instrumentClose)
 	if err != nil {
 		instrumentClose(err)
 	}
@@ -820,6 +878,7 @@ func (s *service) FetchTaggedIter(ctx context.Context, req *rpc.FetchTaggedReque
 func (s *service) fetchTaggedIter(
 	ctx context.Context,
 	req *rpc.FetchTaggedRequest,
+	sortByID bool,
 	instrumentClose func(error),
 ) (FetchTaggedResultsIter, error) {
 	db, err := s.startReadRPCWithDB()
@@ -861,6 +920,7 @@ func (s *service) fetchTaggedIter(
 		blockPermits:    permits,
 		requireNoWait:   req.RequireNoWait,
 		indexWaited:     queryResult.Waited,
+		sortByID:        sortByID,
 	}), nil
 }
 
@@ -925,6 +985,9 @@ type fetchTaggedResultsIterOpts struct {
 	blockPermits    permits.Permits
 	requireNoWait   bool
 	indexWaited     int
+	// sortByID returns the results in the order of their IDs rather than
+	// in the order of the query results.
+	sortByID bool
 }
 
 func newFetchTaggedResultsIter(opts fetchTaggedResultsIterOpts) FetchTaggedResultsIter { //nolint: gocritic
@@ -981,6 +1044,12 @@ func (i *fetchTaggedResultsIter) Next(ctx context.Context) bool {
 			}
 			i.idResults = append(i.idResults, result)
 		}
+		if i.sortByID {
+			sort.Slice(i.idResults, func(a, b int) bool {
+				return bytes.Compare(i.idResults[a].queryResult.Key(),
+					i.idResults[b].queryResult.Key()) < 0
+			})
+		}
 	} else {
 		// release the permits and memory from the previous block readers.
 		i.releaseQuotaUsed(i.idx - 1)
@@ -1256,6 +1325,47 @@ func (s *service) AggregateRaw(tctx thrift.Context, req *rpc.AggregateQueryRawRe
 	return response, nil
 }
 
+func (s *service) CardinalityRaw(tctx thrift.Context, req *rpc.CardinalityRawRequest) (*rpc.CardinalityRawResult_, error) {
+	db, err := s.startReadRPCWithDB()
+	if err != nil {
+		return nil, err
+	}
+	defer s.readRPCCompleted(tctx)
+
+	callStart := s.nowFn()
+	ctx, sp, sampled := tchannelthrift.Context(tctx).StartSampledTraceSpan(tracepoint.CardinalityRaw)
+	defer sp.Finish()
+
+	if sampled {
+		sp.LogFields(
+			opentracinglog.String("namespace", string(req.NameSpace)),
+			xopentracing.Time("start", time.Unix(0, req.RangeStart)),
+			xopentracing.Time("end", time.Unix(0, req.RangeEnd)),
+		)
+	}
+
+	ns, query, opts, err := convert.FromRPCCardinalityRawRequest(req, s.pools)
+	if err != nil {
+		s.metrics.cardinality.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	queryResult, err := db.CardinalityQuery(ctx, ns, query, opts.QueryOptions)
+	if err != nil {
+		sp.LogFields(opentracinglog.Error(err))
+		s.metrics.cardinality.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	// NB: only the values with the most series of each tag name are returned,
+	// the rest are accounted for as truncated values to bound the response.
+	queryResult.Results.Truncate(opts.Limit)
+
+	response := convert.ToRPCCardinalityRawResult(queryResult.Results)
+	s.metrics.cardinality.ReportSuccess(s.nowFn().Sub(callStart))
+	return response, nil
+}
+
 func encodeTags(
 	enc serialize.TagEncoder,
 	tags ident.TagIterator,
@@ -1574,7 +1684,77 @@ func (s *service) FetchBlocksMetadataRawV2(tctx thrift.Context, req *rpc.FetchBl
 		return nil, convert.ToRPCError(err)
 	}
 
-	ctx.RegisterFinalizer(s.newCloseableMetadataV2Result(result))
+	ctx.RegisterFinalizer(s.newCloseableMetadataV2Result(result.Elements))
+	return result, nil
+}
+
+func (s *service) FetchBlocksMerkleTreeRaw(tctx thrift.Context, req *rpc.FetchBlocksMerkleTreeRawRequest) (*rpc.FetchBlocksMerkleTreeRawResult_, error) {
+	db, err := s.startReadRPCWithDB()
+	if err != nil {
+		return nil, err
+	}
+	defer s.readRPCCompleted(tctx)
+
+	callStart := s.nowFn()
+	defer func() {
+		// No need to report metric anywhere else as we capture all cases here
+		s.metrics.fetchBlocksMerkleTree.ReportSuccessOrError(err, s.nowFn().Sub(callStart))
+	}()
+
+	ctx := tchannelthrift.Context(tctx)
+
+	var (
+		nsID  = s.newID(ctx, req.NameSpace)
+		start = xtime.UnixNano(req.RangeStart)
+		end   = xtime.UnixNano(req.RangeEnd)
+		depth = int(req.Depth)
+	)
+	if len(req.Leaves) == 0 {
+		// Only the tree is returned when no leaves are requested.
+		tree, err := db.FetchBlocksMerkleTree(ctx, nsID, uint32(req.Shard), start, end, depth)
+		if err != nil {
+			return nil, convert.ToRPCError(err)
+		}
+
+		nodes := tree.Nodes()
+		result := rpc.NewFetchBlocksMerkleTreeRawResult_()
+		result.Digests = make([]int64, 0, len(nodes))
+		for _, node := range nodes {
+			result.Digests = append(result.Digests, int64(node))
+		}
+		result.Elements = []*rpc.BlockMetadataV2{}
+		return result, nil
+	}
+
+	if req.Limit <= 0 {
+		return nil, nil
+	}
+
+	leaves := make([]int, 0, len(req.Leaves))
+	for _, leaf := range req.Leaves {
+		leaves = append(leaves, int(leaf))
+	}
+
+	// NB: The fetched metadata is owned and closed by the context.
+	fetchedMetadata, nextPageToken, err := db.FetchBlocksMerkleTreeLeavesMetadata(ctx,
+		nsID, uint32(req.Shard), start, end, depth, leaves, req.Limit, req.PageToken)
+	if err != nil {
+		return nil, convert.ToRPCError(err)
+	}
+
+	opts := block.FetchBlocksMetadataOptions{
+		IncludeSizes:     true,
+		IncludeChecksums: true,
+	}
+	elements, err := s.getBlocksMetadataV2FromResult(ctx, opts, fetchedMetadata)
+	if err != nil {
+		return nil, convert.ToRPCError(err)
+	}
+	ctx.RegisterFinalizer(s.newCloseableMetadataV2Result(elements))
+
+	result := rpc.NewFetchBlocksMerkleTreeRawResult_()
+	result.Elements = elements
+	result.NextPageToken = nextPageToken
 	return result, nil
 }
 
@@ -2211,6 +2391,37 @@ func (s *service) Truncate(tctx thrift.Context, req *rpc.TruncateRequest) (r *rp
 	return res, nil
 }
 
+func (s *service) DeleteTagged(
+	tctx thrift.Context,
+	req *rpc.DeleteTaggedRequest,
+) (*rpc.DeleteTaggedResult_, error) {
+	db, err := s.startRPCWithDB()
+	if err != nil {
+		return nil, err
+	}
+
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+	query, start, end, err := convert.FromRPCDeleteTaggedRequest(req)
+	if err != nil {
+		s.metrics.deleteTagged.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	deleted, err := db.DeleteTagged(ctx, s.newID(ctx, req.NameSpace), query, start, end)
+	if err != nil {
+		s.metrics.deleteTagged.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	res := rpc.NewDeleteTaggedResult_()
+	res.NumSeries = deleted
+
+	s.metrics.deleteTagged.ReportSuccess(s.nowFn().Sub(callStart))
+
+	return res, nil
+}
+
 func (s *service) GetPersistRateLimit(
 	ctx thrift.Context,
 ) (*rpc.NodePersistRateLimitResult_, error) {
@@ -2668,21 +2879,21 @@ func readEncodedResultSegment(
 }
 
 func (s *service) newCloseableMetadataV2Result(
-	res *rpc.FetchBlocksMetadataRawV2Result_,
+	elements []*rpc.BlockMetadataV2,
 ) closeableMetadataV2Result {
-	return closeableMetadataV2Result{s: s, result: res}
+	return closeableMetadataV2Result{s: s, elements: elements}
 }
 
 type closeableMetadataV2Result struct {
-	s      *service
-	result *rpc.FetchBlocksMetadataRawV2Result_
+	s        *service
+	elements []*rpc.BlockMetadataV2
 }
 
 func (c closeableMetadataV2Result) Finalize() {
-	for _, blockMetadata := range c.result.Elements {
+	for _, blockMetadata := range c.elements {
 		c.s.pools.blockMetadataV2.Put(blockMetadata)
 	}
-	c.s.pools.blockMetadataV2Slice.Put(c.result.Elements)
+	c.s.pools.blockMetadataV2Slice.Put(c.elements)
 }
 
 type writeBatchPooledReq struct {
@@ -2794,6 +3005,14 @@ func (r *writeBatchPooledReq) HandleError(index int, err error) {
 		return
 	}
 
+	if limits.IsSeriesLimitExceededError(err) {
+		r.nonRetryableErrors++
+		r.errs = append(
+			r.errs,
+			tterrors.NewResourceExhaustedWriteBatchRawError(index, err))
+		return
+	}
+
 	if xerrors.IsInvalidParams(err) {
 		r.nonRetryableErrors++
 		r.errs = append(
@@ -37,6 +37,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/uber/tchannel-go/thrift"
 
+	"github.com/m3db/m3/src/dbnode/digest"
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
@@ -1486,6 +1487,93 @@ func TestServiceFetchBlocksMetadataEndpointV2Raw(t *testing.T) {
 	}
 }
 
+func TestServiceFetchBlocksMerkleTreeRaw(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	// Setup mock db / service / context
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	var (
+		now      = xtime.Now()
+		start    = now.Truncate(time.Hour)
+		end      = now.Add(4 * time.Hour).Truncate(time.Hour)
+		depth    = 4
+		nsID     = "metrics"
+		checksum = uint32(111)
+	)
+
+	builder, err := digest.NewMerkleTreeBuilder(depth)
+	require.NoError(t, err)
+	leaf := builder.Add([]byte("foo"), int64(start), 16, &checksum)
+	tree := builder.Build()
+
+	blocks := block.NewFetchBlockMetadataResults()
+	blocks.Add(block.FetchBlockMetadataResult{
+		Start:    start,
+		Size:     16,
+		Checksum: &checksum,
+	})
+	mockResult := block.NewFetchBlocksMetadataResults()
+	mockResult.Add(block.NewFetchBlocksMetadataResult(ident.StringID("foo"),
+		ident.EmptyTagIterator, blocks))
+
+	mockDB.EXPECT().
+		FetchBlocksMerkleTree(ctx, ident.NewIDMatcher(nsID), uint32(0), start, end, depth).
+		Return(tree, nil)
+
+	r, err := service.FetchBlocksMerkleTreeRaw(tctx, &rpc.FetchBlocksMerkleTreeRawRequest{
+		NameSpace:  []byte(nsID),
+		Shard:      0,
+		RangeStart: int64(start),
+		RangeEnd:   int64(end),
+		Depth:      int32(depth),
+		Leaves:     []int32{},
+		Limit:      10,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, len(tree.Nodes()), len(r.Digests))
+	for i, node := range tree.Nodes() {
+		require.Equal(t, int64(node), r.Digests[i])
+	}
+	require.Equal(t, 0, len(r.Elements))
+	require.Nil(t, r.NextPageToken)
+
+	// Requesting leaves returns a page of their metadata without the tree.
+	mockDB.EXPECT().IsOverloaded().Return(false)
+	mockDB.EXPECT().
+		FetchBlocksMerkleTreeLeavesMetadata(ctx, ident.NewIDMatcher(nsID), uint32(0),
+			start, end, depth, []int{leaf}, int64(10), storage.PageToken("token")).
+		Return(mockResult, storage.PageToken("next"), nil)
+
+	r, err = service.FetchBlocksMerkleTreeRaw(tctx, &rpc.FetchBlocksMerkleTreeRawRequest{
+		NameSpace:  []byte(nsID),
+		Shard:      0,
+		RangeStart: int64(start),
+		RangeEnd:   int64(end),
+		Depth:      int32(depth),
+		Leaves:     []int32{int32(leaf)},
+		Limit:      10,
+		PageToken:  []byte("token"),
+	})
+	require.NoError(t, err)
+
+	require.Nil(t, r.Digests)
+	require.Equal(t, []byte("next"), r.NextPageToken)
+	require.Equal(t, 1, len(r.Elements))
+	require.Equal(t, []byte("foo"), r.Elements[0].ID)
+	require.Equal(t, int64(start), r.Elements[0].Start)
+	require.Equal(t, int64(16), *r.Elements[0].Size)
+	require.Equal(t, int64(checksum), *r.Elements[0].Checksum)
+}
+
 func TestServiceFetchBlocksMetadataEndpointV2RawIsOverloaded(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -2017,6 +2105,199 @@ func TestServiceFetchTaggedNoData(t *testing.T) {
 	}
 }
 
+func newTestFetchTaggedStreamRequest(
+	t *testing.T,
+	mockDB *storage.MockDatabase,
+	ids ...string,
+) *rpc.FetchTaggedRequest {
+	start := xtime.Now().Add(-2 * time.Hour).Truncate(time.Second)
+	end := start.Add(2 * time.Hour)
+	nsID := "metrics"
+
+	req, err := idx.NewRegexpQuery([]byte("foo"), []byte("b.*"))
+	require.NoError(t, err)
+
+	resMap := index.NewQueryResults(ident.StringID(nsID),
+		index.QueryResultsOptions{}, testIndexOptions)
+	for _, id := range ids {
+		md := doc.Metadata{
+			ID:     ident.BytesID(id),
+			Fields: []doc.Field{},
+		}
+		resMap.Map().Set(md.ID, doc.NewDocumentFromMetadata(md))
+	}
+	mockDB.EXPECT().QueryIDs(
+		gomock.Any(),
+		ident.NewIDMatcher(nsID),
+		index.NewQueryMatcher(index.Query{Query: req}),
+		index.QueryOptions{
+			StartInclusive: start,
+			EndExclusive:   end,
+		}).Return(index.QueryResult{Results: resMap, Exhaustive: true}, nil)
+
+	startNanos, err := convert.ToValue(start, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+	endNanos, err := convert.ToValue(end, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+
+	data, err := idx.Marshal(req)
+	require.NoError(t, err)
+	return &rpc.FetchTaggedRequest{
+		NameSpace:  []byte(nsID),
+		Query:      data,
+		RangeStart: startNanos,
+		RangeEnd:   endNanos,
+		FetchData:  false,
+	}
+}
+
+func TestServiceFetchTaggedStream(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	fetchReq := newTestFetchTaggedStreamRequest(t, mockDB, "foo", "bar", "baz")
+
+	var ids []string
+	r, err := service.FetchTaggedStream(tctx, &rpc.FetchTaggedStreamRequest{
+		BatchSize: 2,
+		Fetch:     fetchReq,
+	})
+	require.NoError(t, err)
+	require.True(t, r.Exhaustive)
+	require.Equal(t, 2, len(r.Elements))
+	require.NotNil(t, r.StreamID)
+	for _, elem := range r.Elements {
+		ids = append(ids, string(elem.ID))
+	}
+
+	streamID := r.StreamID
+	r, err = service.FetchTaggedStream(tctx, &rpc.FetchTaggedStreamRequest{
+		BatchSize: 2,
+		StreamID:  streamID,
+	})
+	require.NoError(t, err)
+	require.True(t, r.Exhaustive)
+	require.Equal(t, 1, len(r.Elements))
+	require.Nil(t, r.StreamID)
+	for _, elem := range r.Elements {
+		ids = append(ids, string(elem.ID))
+	}
+
+	// Series are streamed in the order of their IDs.
+	require.Equal(t, []string{"bar", "baz", "foo"}, ids)
+
+	// The stream is closed once read to completion.
+	_, err = service.FetchTaggedStream(tctx, &rpc.FetchTaggedStreamRequest{
+		BatchSize: 2,
+		StreamID:  streamID,
+	})
+	require.Equal(t, tterrors.NewBadRequestError(errFetchTaggedStreamNotFound), err)
+	require.Equal(t, 0, len(service.fetchTaggedStreams.streams))
+}
+
+func TestServiceFetchTaggedStreamCancel(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	fetchReq := newTestFetchTaggedStreamRequest(t, mockDB, "foo", "bar")
+
+	r, err := service.FetchTaggedStream(tctx, &rpc.FetchTaggedStreamRequest{
+		BatchSize: 1,
+		Fetch:     fetchReq,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(r.Elements))
+	require.NotNil(t, r.StreamID)
+	require.Equal(t, 1, len(service.fetchTaggedStreams.streams))
+
+	cancel := true
+	r, err = service.FetchTaggedStream(tctx, &rpc.FetchTaggedStreamRequest{
+		StreamID: r.StreamID,
+		Cancel:   &cancel,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(r.Elements))
+	require.Nil(t, r.StreamID)
+	require.Equal(t, 0, len(service.fetchTaggedStreams.streams))
+}
+
+func TestServiceFetchTaggedStreamIdleTimeout(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+
+	opts := testTChannelThriftOptions.
+		SetFetchTaggedStreamIdleTimeout(10 * time.Millisecond)
+	service := NewService(mockDB, opts).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	fetchReq := newTestFetchTaggedStreamRequest(t, mockDB, "foo", "bar")
+
+	r, err := service.FetchTaggedStream(tctx, &rpc.FetchTaggedStreamRequest{
+		BatchSize: 1,
+		Fetch:     fetchReq,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, r.StreamID)
+
+	require.Eventually(t, func() bool {
+		service.fetchTaggedStreams.Lock()
+		defer service.fetchTaggedStreams.Unlock()
+		return len(service.fetchTaggedStreams.streams) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = service.FetchTaggedStream(tctx, &rpc.FetchTaggedStreamRequest{
+		BatchSize: 1,
+		StreamID:  r.StreamID,
+	})
+	require.Equal(t, tterrors.NewBadRequestError(errFetchTaggedStreamNotFound), err)
+}
+
+func TestServiceFetchTaggedStreamInvalidRequest(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	_, err := service.FetchTaggedStream(tctx, &rpc.FetchTaggedStreamRequest{
+		BatchSize: 1,
+	})
+	require.Equal(t, tterrors.NewBadRequestError(errFetchTaggedStreamRequestInvalid), err)
+}
+
 func TestServiceFetchTaggedErrs(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -3355,3 +3636,58 @@ func TestServiceAggregateTiles(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, int64(4), result.ProcessedTileCount)
 }
+
+func TestServiceAggregateTilesStatus(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	now := xtime.Now()
+	mockDB.EXPECT().TileAggregationStatuses().Return([]storage.TileAggregationStatus{
+		{
+			SourceNamespace:    "source",
+			TargetNamespace:    "target",
+			Step:               10 * time.Minute,
+			AggregatedUntil:    now.Truncate(time.Hour),
+			ProcessedTileCount: 4,
+			LastRunAt:          now,
+		},
+		{
+			SourceNamespace: "source",
+			TargetNamespace: "missing",
+			Step:            time.Hour,
+			LastRunAt:       now,
+			LastError:       "target namespace missing not found",
+		},
+	})
+
+	result, err := service.AggregateTilesStatus(tctx)
+	require.NoError(t, err)
+
+	lastError := "target namespace missing not found"
+	assert.Equal(t, []*rpc.AggregateTilesStatus{
+		{
+			SourceNamespace:    "source",
+			TargetNamespace:    "target",
+			Step:               "10m0s",
+			AggregatedUntil:    int64(now.Truncate(time.Hour)),
+			ProcessedTileCount: 4,
+			LastRunAt:          int64(now),
+		},
+		{
+			SourceNamespace: "source",
+			TargetNamespace: "missing",
+			Step:            "1h0m0s",
+			LastRunAt:       int64(now),
+			LastError:       &lastError,
+		},
+	}, result.Statuses)
+}
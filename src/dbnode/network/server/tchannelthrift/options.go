@@ -21,6 +21,8 @@
 package tchannelthrift
 
 import (
+	"time"
+
 	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/storage/limits/permits"
 	"github.com/m3db/m3/src/dbnode/topology"
@@ -47,8 +49,13 @@ type options struct {
 	queryLimits                 limits.QueryLimits
 	permitsOptions              permits.Options
 	seriesBlocksPerBatch        int
+	streamIdleTimeout           time.Duration
 }
 
+const (
+	defaultFetchTaggedStreamIdleTimeout = time.Minute
+)
+
 // NewOptions creates new options.
 func NewOptions() Options {
 	// Use a zero size pool by default, override from config.
@@ -84,6 +91,7 @@ func NewOptions() Options {
 		checkedBytesWrapperPool:  bytesWrapperPool,
 		queryLimits:              limits.NoOpQueryLimits(),
 		permitsOptions:           permits.NewOptions(),
+		streamIdleTimeout:        defaultFetchTaggedStreamIdleTimeout,
 	}
 }
 
@@ -216,3 +224,13 @@ func (o *options) SetFetchTaggedSeriesBlocksPerBatch(value int) Options {
 func (o *options) FetchTaggedSeriesBlocksPerBatch() int {
 	return o.seriesBlocksPerBatch
 }
+
+func (o *options) SetFetchTaggedStreamIdleTimeout(value time.Duration) Options {
+	opts := *o
+	opts.streamIdleTimeout = value
+	return &opts
+}
+
+func (o *options) FetchTaggedStreamIdleTimeout() time.Duration {
+	return o.streamIdleTimeout
+}
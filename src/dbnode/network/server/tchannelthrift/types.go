@@ -23,6 +23,8 @@
 package tchannelthrift
 
 import (
+	"time"
+
 	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/storage/limits/permits"
 	"github.com/m3db/m3/src/dbnode/topology"
@@ -140,4 +142,12 @@ type Options interface {
 	// SetFetchTaggedSeriesBlocksPerBatch sets the series blocks allowed to be read
 	// per permit acquired.
 	SetFetchTaggedSeriesBlocksPerBatch(value int) Options
+
+	// SetFetchTaggedStreamIdleTimeout sets the duration after which streams of
+	// fetch tagged results that are not read from are closed.
+	SetFetchTaggedStreamIdleTimeout(value time.Duration) Options
+
+	// FetchTaggedStreamIdleTimeout returns the duration after which streams of
+	// fetch tagged results that are not read from are closed.
+	FetchTaggedStreamIdleTimeout() time.Duration
 }
@@ -0,0 +1,163 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backfill
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	timestampColumn = "timestamp"
+	valueColumn     = "value"
+)
+
+var errCSVMissingColumns = errors.New("csv header must have timestamp and value columns")
+
+// Label is a label of a series.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample is a datapoint of a series read from an input.
+type Sample struct {
+	// Labels are the labels of the series sorted by name.
+	Labels    []Label
+	Timestamp xtime.UnixNano
+	Value     float64
+}
+
+// SampleFn is called with each sample read from an input.
+type SampleFn func(s Sample) error
+
+// ReadCSV reads samples from CSV with a header row. The timestamp column
+// holds Unix timestamps in milliseconds, the value column holds the values
+// and every other column is a label, empty label values are omitted.
+func ReadCSV(r io.Reader, fn SampleFn) error {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("could not read csv header: %w", err)
+	}
+	header = append([]string(nil), header...)
+
+	timestampIdx, valueIdx := -1, -1
+	for i, column := range header {
+		switch column {
+		case timestampColumn:
+			timestampIdx = i
+		case valueColumn:
+			valueIdx = i
+		}
+	}
+	if timestampIdx < 0 || valueIdx < 0 {
+		return errCSVMissingColumns
+	}
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		timestamp, err := strconv.ParseInt(record[timestampIdx], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid timestamp on line %d: %w", line, err)
+		}
+		value, err := strconv.ParseFloat(record[valueIdx], 64)
+		if err != nil {
+			return fmt.Errorf("invalid value on line %d: %w", line, err)
+		}
+
+		labels := make([]Label, 0, len(header)-2)
+		for i, column := range header {
+			if i == timestampIdx || i == valueIdx || record[i] == "" {
+				continue
+			}
+			labels = append(labels, Label{Name: column, Value: record[i]})
+		}
+		if err := fn(newSample(labels, timestamp, value)); err != nil {
+			return err
+		}
+	}
+}
+
+type jsonSample struct {
+	Labels    map[string]string `json:"labels"`
+	Timestamp *int64            `json:"timestamp"`
+	Value     *float64          `json:"value"`
+}
+
+// ReadJSON reads samples from newline delimited JSON objects of the form
+// {"labels":{"__name__":"up"},"timestamp":1600000000000,"value":1} where the
+// timestamp is a Unix timestamp in milliseconds.
+func ReadJSON(r io.Reader, fn SampleFn) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var s jsonSample
+		if err := json.Unmarshal(scanner.Bytes(), &s); err != nil {
+			return fmt.Errorf("invalid sample on line %d: %w", line, err)
+		}
+		if s.Timestamp == nil || s.Value == nil {
+			return fmt.Errorf("sample on line %d must have a timestamp and value", line)
+		}
+
+		labels := make([]Label, 0, len(s.Labels))
+		for name, value := range s.Labels {
+			labels = append(labels, Label{Name: name, Value: value})
+		}
+		if err := fn(newSample(labels, *s.Timestamp, *s.Value)); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func newSample(labels []Label, timestampMillis int64, value float64) Sample {
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].Name < labels[j].Name
+	})
+	return Sample{
+		Labels:    labels,
+		Timestamp: xtime.UnixNano(timestampMillis * int64(time.Millisecond)),
+		Value:     value,
+	}
+}
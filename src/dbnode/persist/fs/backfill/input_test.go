@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backfill
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestReadCSV(t *testing.T) {
+	input := `__name__,timestamp,host,value
+cpu,1600000000000,a,1.5
+cpu,1600000001000,,2
+`
+	var samples []Sample
+	require.NoError(t, ReadCSV(strings.NewReader(input), func(s Sample) error {
+		samples = append(samples, s)
+		return nil
+	}))
+	require.Equal(t, []Sample{
+		{
+			Labels:    []Label{{"__name__", "cpu"}, {"host", "a"}},
+			Timestamp: xtime.FromSeconds(1600000000),
+			Value:     1.5,
+		},
+		{
+			Labels:    []Label{{"__name__", "cpu"}},
+			Timestamp: xtime.FromSeconds(1600000001),
+			Value:     2,
+		},
+	}, samples)
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	noop := func(Sample) error { return nil }
+	require.Error(t, ReadCSV(strings.NewReader("__name__,value\ncpu,1\n"), noop))
+	require.Error(t, ReadCSV(strings.NewReader("timestamp,value\nnow,1\n"), noop))
+	require.Error(t, ReadCSV(strings.NewReader("timestamp,value\n1,one\n"), noop))
+}
+
+func TestReadJSON(t *testing.T) {
+	input := `{"labels":{"host":"a","__name__":"cpu"},"timestamp":1600000000500,"value":1.5}
+
+{"labels":{"__name__":"cpu"},"timestamp":1600000001000,"value":2}
+`
+	var samples []Sample
+	require.NoError(t, ReadJSON(strings.NewReader(input), func(s Sample) error {
+		samples = append(samples, s)
+		return nil
+	}))
+	require.Equal(t, []Sample{
+		{
+			Labels:    []Label{{"__name__", "cpu"}, {"host", "a"}},
+			Timestamp: xtime.FromSeconds(1600000000).Add(500 * time.Millisecond),
+			Value:     1.5,
+		},
+		{
+			Labels:    []Label{{"__name__", "cpu"}},
+			Timestamp: xtime.FromSeconds(1600000001),
+			Value:     2,
+		},
+	}, samples)
+
+	require.Error(t, ReadJSON(strings.NewReader(`{"labels":{}}`), func(Sample) error {
+		return nil
+	}))
+}
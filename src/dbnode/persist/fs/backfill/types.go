@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package backfill writes historical datapoints directly to data and index
+// filesets, so that they can be adopted by a namespace without being written
+// through the commit log and series buffers.
+package backfill
+
+import (
+	"errors"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+)
+
+var (
+	errNamespaceNotSet         = errors.New("backfill namespace is not set")
+	errNumShardsNotSet         = errors.New("backfill number of shards must be positive")
+	errFilesystemOptionsNotSet = errors.New("backfill filesystem options are not set")
+)
+
+// Options are the options for writing backfill filesets.
+type Options struct {
+	// Namespace is the namespace the filesets are written for, its block
+	// sizes determine the blocks the datapoints are written to.
+	Namespace namespace.Metadata
+	// NumShards is the number of shards of the cluster the filesets will be
+	// adopted by, series are sharded the same way as the cluster shards them.
+	NumShards int
+	// Shards restricts writing to the given shards, datapoints of series
+	// belonging to other shards are dropped. All shards are written if empty.
+	Shards []uint32
+	// FilesystemOptions are the options of the filesystem the filesets are
+	// written to, its file path prefix should not be that of a running node.
+	FilesystemOptions fs.Options
+	// EncodingOptions are the encoding options.
+	EncodingOptions encoding.Options
+}
+
+// Validate validates the options.
+func (o Options) Validate() error {
+	if o.Namespace == nil {
+		return errNamespaceNotSet
+	}
+	if o.NumShards <= 0 {
+		return errNumShardsNotSet
+	}
+	if o.FilesystemOptions == nil {
+		return errFilesystemOptionsNotSet
+	}
+	return nil
+}
+
+// Result describes the written filesets.
+type Result struct {
+	// Series is the number of series written across all blocks.
+	Series int
+	// Datapoints is the number of datapoints written.
+	Datapoints int
+	// DataFileSets is the number of data filesets written.
+	DataFileSets int
+	// IndexFileSets is the number of index filesets written.
+	IndexFileSets int
+}
@@ -0,0 +1,320 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backfill
+
+import (
+	"sort"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	"github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/m3ninx/index/segment/builder"
+	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type blockKey struct {
+	shard      uint32
+	blockStart xtime.UnixNano
+}
+
+type series struct {
+	id         ident.ID
+	tags       ident.Tags
+	datapoints []ts.Datapoint
+}
+
+type indexBlock struct {
+	shards map[uint32]struct{}
+	series map[string]*series
+}
+
+// Writer buffers datapoints by shard, block and series in memory and writes
+// them to data filesets, and index filesets if the namespace is indexed, when
+// flushed. Datapoints may be written in any order, of datapoints with the same
+// timestamp the last written is kept.
+type Writer struct {
+	opts      Options
+	hashFn    sharding.HashFn
+	shards    map[uint32]struct{}
+	blockSize time.Duration
+	blocks    map[blockKey]map[string]*series
+}
+
+// NewWriter creates a new backfill writer.
+func NewWriter(opts Options) (*Writer, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.EncodingOptions == nil {
+		opts.EncodingOptions = encoding.NewOptions()
+	}
+
+	shards := make(map[uint32]struct{}, len(opts.Shards))
+	for _, shard := range opts.Shards {
+		shards[shard] = struct{}{}
+	}
+	return &Writer{
+		opts:      opts,
+		hashFn:    sharding.DefaultHashFn(opts.NumShards),
+		shards:    shards,
+		blockSize: opts.Namespace.Options().RetentionOptions().BlockSize(),
+		blocks:    make(map[blockKey]map[string]*series),
+	}, nil
+}
+
+// Write buffers a datapoint of a series, it returns false if the series does
+// not belong to one of the shards being written.
+func (w *Writer) Write(id ident.ID, tags ident.Tags, dp ts.Datapoint) bool {
+	shard := w.hashFn(id)
+	if _, ok := w.shards[shard]; len(w.shards) > 0 && !ok {
+		return false
+	}
+
+	key := blockKey{shard: shard, blockStart: dp.TimestampNanos.Truncate(w.blockSize)}
+	block, ok := w.blocks[key]
+	if !ok {
+		block = make(map[string]*series)
+		w.blocks[key] = block
+	}
+	s, ok := block[id.String()]
+	if !ok {
+		s = &series{
+			id:   ident.BytesID(append([]byte(nil), id.Bytes()...)),
+			tags: cloneTags(tags),
+		}
+		block[s.id.String()] = s
+	}
+	s.datapoints = append(s.datapoints, dp)
+	return true
+}
+
+// Flush writes the buffered datapoints to filesets and resets the writer.
+// Filesets must not already exist for the blocks being written.
+func (w *Writer) Flush() (Result, error) {
+	pm, err := fs.NewPersistManager(w.opts.FilesystemOptions)
+	if err != nil {
+		return Result{}, err
+	}
+	defer pm.Close()
+
+	keys := make([]blockKey, 0, len(w.blocks))
+	for key := range w.blocks {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].blockStart != keys[j].blockStart {
+			return keys[i].blockStart < keys[j].blockStart
+		}
+		return keys[i].shard < keys[j].shard
+	})
+
+	var result Result
+	flush, err := pm.StartFlushPersist()
+	if err != nil {
+		return Result{}, err
+	}
+	for _, key := range keys {
+		if err := w.writeBlock(flush, key, &result); err != nil {
+			return Result{}, err
+		}
+	}
+	if err := flush.DoneFlush(); err != nil {
+		return Result{}, err
+	}
+
+	if w.opts.Namespace.Options().IndexOptions().Enabled() {
+		if err := w.writeIndex(pm, &result); err != nil {
+			return Result{}, err
+		}
+	}
+
+	w.blocks = make(map[blockKey]map[string]*series)
+	return result, nil
+}
+
+func (w *Writer) writeBlock(
+	flush persist.FlushPreparer,
+	key blockKey,
+	result *Result,
+) error {
+	prepared, err := flush.PrepareData(persist.DataPrepareOptions{
+		NamespaceMetadata: w.opts.Namespace,
+		BlockStart:        key.blockStart,
+		Shard:             key.shard,
+		FileSetType:       persist.FileSetFlushType,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, s := range w.blocks[key] {
+		segment, n, err := w.encode(key.blockStart, s.datapoints)
+		if err != nil {
+			return err
+		}
+		metadata := persist.NewMetadataFromIDAndTags(s.id, s.tags,
+			persist.MetadataOptions{})
+		if err := prepared.Persist(metadata, segment, segment.CalculateChecksum()); err != nil {
+			return err
+		}
+		result.Series++
+		result.Datapoints += n
+	}
+	if err := prepared.Close(); err != nil {
+		return err
+	}
+	result.DataFileSets++
+	return nil
+}
+
+func (w *Writer) encode(
+	blockStart xtime.UnixNano,
+	datapoints []ts.Datapoint,
+) (ts.Segment, int, error) {
+	sort.SliceStable(datapoints, func(i, j int) bool {
+		return datapoints[i].TimestampNanos < datapoints[j].TimestampNanos
+	})
+
+	var (
+		enc = m3tsz.NewEncoder(blockStart, nil,
+			m3tsz.DefaultIntOptimizationEnabled, w.opts.EncodingOptions)
+		n = 0
+	)
+	for i, dp := range datapoints {
+		if i+1 < len(datapoints) && datapoints[i+1].TimestampNanos == dp.TimestampNanos {
+			continue
+		}
+		unit := xtime.Millisecond
+		if dp.TimestampNanos%xtime.UnixNano(time.Millisecond) != 0 {
+			unit = xtime.Nanosecond
+		}
+		if err := enc.Encode(dp, unit, nil); err != nil {
+			return ts.Segment{}, 0, err
+		}
+		n++
+	}
+	return enc.Discard(), n, nil
+}
+
+func (w *Writer) writeIndex(pm persist.Manager, result *Result) error {
+	var (
+		indexBlockSize = w.opts.Namespace.Options().IndexOptions().BlockSize()
+		indexBlocks    = make(map[xtime.UnixNano]*indexBlock)
+	)
+	for key, block := range w.blocks {
+		blockStart := key.blockStart.Truncate(indexBlockSize)
+		ib, ok := indexBlocks[blockStart]
+		if !ok {
+			ib = &indexBlock{
+				shards: make(map[uint32]struct{}),
+				series: make(map[string]*series),
+			}
+			indexBlocks[blockStart] = ib
+		}
+		ib.shards[key.shard] = struct{}{}
+		for id, s := range block {
+			ib.series[id] = s
+		}
+	}
+
+	blockStarts := make([]xtime.UnixNano, 0, len(indexBlocks))
+	for blockStart := range indexBlocks {
+		blockStarts = append(blockStarts, blockStart)
+	}
+	sort.Slice(blockStarts, func(i, j int) bool {
+		return blockStarts[i] < blockStarts[j]
+	})
+
+	flush, err := pm.StartIndexPersist()
+	if err != nil {
+		return err
+	}
+	for _, blockStart := range blockStarts {
+		if err := w.writeIndexBlock(flush, blockStart, indexBlocks[blockStart]); err != nil {
+			return err
+		}
+		result.IndexFileSets++
+	}
+	return flush.DoneIndex()
+}
+
+func (w *Writer) writeIndexBlock(
+	flush persist.IndexFlush,
+	blockStart xtime.UnixNano,
+	ib *indexBlock,
+) error {
+	prepared, err := flush.PrepareIndex(persist.IndexPrepareOptions{
+		NamespaceMetadata: w.opts.Namespace,
+		BlockStart:        blockStart,
+		FileSetType:       persist.FileSetFlushType,
+		Shards:            ib.shards,
+		IndexVolumeType:   idxpersist.DefaultIndexVolumeType,
+	})
+	if err != nil {
+		return err
+	}
+
+	b, err := builder.NewBuilderFromDocuments(builder.NewOptions())
+	if err != nil {
+		return err
+	}
+	defer b.Close() // nolint: errcheck
+
+	for _, s := range ib.series {
+		d, err := convert.FromSeriesIDAndTags(s.id, s.tags)
+		if err != nil {
+			return err
+		}
+		if _, err := b.Insert(d); err != nil {
+			return err
+		}
+	}
+	if err := prepared.Persist(b); err != nil {
+		return err
+	}
+
+	segments, err := prepared.Close()
+	if err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		if err := segment.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func cloneTags(tags ident.Tags) ident.Tags {
+	values := tags.Values()
+	cloned := make([]ident.Tag, 0, len(values))
+	for _, tag := range values {
+		cloned = append(cloned, ident.StringTag(tag.Name.String(), tag.Value.String()))
+	}
+	return ident.NewTags(cloned...)
+}
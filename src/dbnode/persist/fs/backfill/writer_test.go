@@ -0,0 +1,190 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backfill
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const testNumShards = 8
+
+var testBlockSize = 2 * time.Hour
+
+func newTestNamespace(t *testing.T) namespace.Metadata {
+	md, err := namespace.NewMetadata(ident.StringID("testns"), namespace.NewOptions().
+		SetRetentionOptions(retention.NewOptions().SetBlockSize(testBlockSize)).
+		SetIndexOptions(namespace.NewIndexOptions().
+			SetEnabled(true).
+			SetBlockSize(2*testBlockSize)))
+	require.NoError(t, err)
+	return md
+}
+
+func TestWriterFlush(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backfill")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		md     = newTestNamespace(t)
+		fsOpts = fs.NewOptions().SetFilePathPrefix(dir)
+		start  = xtime.Now().Truncate(2 * testBlockSize).Add(-4 * testBlockSize)
+		foo    = ident.StringID("foo")
+		bar    = ident.StringID("bar")
+	)
+	w, err := NewWriter(Options{
+		Namespace:         md,
+		NumShards:         testNumShards,
+		FilesystemOptions: fsOpts,
+	})
+	require.NoError(t, err)
+
+	tags := ident.NewTags(ident.StringTag("city", "nyc"))
+	// Out of order and duplicate datapoints, the last written is kept.
+	require.True(t, w.Write(foo, tags, ts.Datapoint{TimestampNanos: start.Add(time.Minute), Value: 2}))
+	require.True(t, w.Write(foo, tags, ts.Datapoint{TimestampNanos: start, Value: 1}))
+	require.True(t, w.Write(foo, tags, ts.Datapoint{TimestampNanos: start.Add(time.Minute), Value: 3}))
+	// A datapoint in the next data block of the same index block.
+	require.True(t, w.Write(foo, tags, ts.Datapoint{TimestampNanos: start.Add(testBlockSize), Value: 4}))
+	require.True(t, w.Write(bar, ident.Tags{}, ts.Datapoint{TimestampNanos: start, Value: 5}))
+
+	result, err := w.Flush()
+	require.NoError(t, err)
+	require.Equal(t, Result{
+		Series:        3,
+		Datapoints:    4,
+		DataFileSets:  numBlocks(foo, bar),
+		IndexFileSets: 1,
+	}, result)
+
+	shard := sharding.DefaultHashFn(testNumShards)(foo)
+	require.Equal(t, []ts.Datapoint{
+		{TimestampNanos: start, Value: 1},
+		{TimestampNanos: start.Add(time.Minute), Value: 3},
+	}, readSeries(t, fsOpts, md, shard, start, foo))
+
+	infoFiles := fs.ReadIndexInfoFiles(fs.ReadIndexInfoFilesOptions{
+		FilePathPrefix:   dir,
+		Namespace:        md.ID(),
+		ReaderBufferSize: fsOpts.InfoReaderBufferSize(),
+	})
+	require.Len(t, infoFiles, 1)
+	require.NoError(t, infoFiles[0].Err.Error())
+	require.Equal(t, start, xtime.UnixNano(infoFiles[0].Info.BlockStart))
+}
+
+func TestWriterShards(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backfill")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		foo   = ident.StringID("foo")
+		shard = sharding.DefaultHashFn(testNumShards)(foo)
+	)
+	w, err := NewWriter(Options{
+		Namespace:         newTestNamespace(t),
+		NumShards:         testNumShards,
+		Shards:            []uint32{(shard + 1) % testNumShards},
+		FilesystemOptions: fs.NewOptions().SetFilePathPrefix(dir),
+	})
+	require.NoError(t, err)
+	require.False(t, w.Write(foo, ident.Tags{}, ts.Datapoint{TimestampNanos: xtime.Now()}))
+
+	result, err := w.Flush()
+	require.NoError(t, err)
+	require.Equal(t, Result{}, result)
+}
+
+// numBlocks returns the number of data filesets written for the series, with
+// foo written to two blocks and bar to the first.
+func numBlocks(foo, bar ident.ID) int {
+	hashFn := sharding.DefaultHashFn(testNumShards)
+	if hashFn(foo) == hashFn(bar) {
+		return 2
+	}
+	return 3
+}
+
+func readSeries(
+	t *testing.T,
+	fsOpts fs.Options,
+	md namespace.Metadata,
+	shard uint32,
+	blockStart xtime.UnixNano,
+	id ident.ID,
+) []ts.Datapoint {
+	r, err := fs.NewReader(nil, fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, r.Open(fs.DataReaderOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  md.ID(),
+			Shard:      shard,
+			BlockStart: blockStart,
+		},
+	}))
+	defer r.Close()
+
+	for {
+		readID, _, data, _, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if !readID.Equal(id) {
+			continue
+		}
+
+		data.IncRef()
+		iter := m3tsz.NewReaderIterator(xio.NewBytesReader64(data.Bytes()),
+			m3tsz.DefaultIntOptimizationEnabled, encoding.NewOptions())
+		var datapoints []ts.Datapoint
+		for iter.Next() {
+			dp, _, _ := iter.Current()
+			datapoints = append(datapoints, ts.Datapoint{
+				TimestampNanos: dp.TimestampNanos,
+				Value:          dp.Value,
+			})
+		}
+		require.NoError(t, iter.Err())
+		data.DecRef()
+		return datapoints
+	}
+	require.FailNow(t, "series not found")
+	return nil
+}
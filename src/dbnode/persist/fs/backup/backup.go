@@ -0,0 +1,309 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// Backup backs up a namespace on a node to the blob store. The latest
+// snapshot and the commit logs from its position onwards are backed up along
+// with the latest complete data filesets and the index filesets so that the
+// namespace can be restored to the point in time of the backup. The manifest
+// is written last, a backup without a manifest is incomplete and is ignored
+// when restoring.
+func Backup(opts Options) (Manifest, error) {
+	if err := opts.Validate(); err != nil {
+		return Manifest{}, err
+	}
+
+	var (
+		fsOpts    = opts.FilesystemOptions
+		prefix    = fsOpts.FilePathPrefix()
+		namespace = opts.Namespace
+		manifest  = Manifest{
+			Version:   manifestVersion,
+			Name:      opts.Name,
+			NodeID:    opts.NodeID,
+			Namespace: namespace.String(),
+			CreatedAt: time.Now().UTC(),
+		}
+	)
+
+	shards := opts.Shards
+	if len(shards) == 0 {
+		var err error
+		shards, err = shardsOnDisk(prefix, opts)
+		if err != nil {
+			return Manifest{}, err
+		}
+	}
+	manifest.Shards = shards
+
+	// Capture the snapshot and commit logs first since they are cleaned up
+	// as soon as a newer snapshot is taken.
+	snapshots, _, err := fs.SortedSnapshotMetadataFiles(fsOpts)
+	if err != nil {
+		return Manifest{}, err
+	}
+	var snapshotID string
+	if n := len(snapshots); n > 0 {
+		snapshot := snapshots[n-1]
+		snapshotID = snapshot.ID.UUID.String()
+		manifest.Snapshot = &SnapshotPosition{
+			ID:             snapshotID,
+			Index:          snapshot.ID.Index,
+			CommitLogIndex: snapshot.CommitlogIdentifier.Index,
+		}
+		for _, filePath := range snapshot.AbsoluteFilePaths() {
+			if err := manifest.backupFile(opts, SnapshotMetadataFileType, 0, filePath); err != nil {
+				return Manifest{}, err
+			}
+		}
+	}
+
+	commitLogOpts := commitlog.NewOptions().SetFilesystemOptions(fsOpts)
+	commitLogs, _, err := commitlog.Files(commitLogOpts)
+	if err != nil {
+		return Manifest{}, err
+	}
+	for _, commitLog := range commitLogs {
+		if manifest.Snapshot != nil && commitLog.Index < manifest.Snapshot.CommitLogIndex {
+			continue
+		}
+		if err := manifest.backupFile(opts, CommitLogFileType, 0, commitLog.FilePath); err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	for _, shard := range shards {
+		if snapshotID != "" {
+			if err := manifest.backupSnapshotFiles(opts, shard, snapshotID); err != nil {
+				return Manifest{}, err
+			}
+		}
+		if err := manifest.backupDataFiles(opts, shard); err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	infoFiles := fs.ReadIndexInfoFiles(fs.ReadIndexInfoFilesOptions{
+		FilePathPrefix:   prefix,
+		Namespace:        namespace,
+		ReaderBufferSize: fsOpts.InfoReaderBufferSize(),
+	})
+	for _, infoFile := range infoFiles {
+		if infoFile.Err.Error() != nil {
+			continue
+		}
+		for _, filePath := range infoFile.AbsoluteFilePaths {
+			if err := manifest.backupFile(opts, IndexFileType, 0, filePath); err != nil {
+				return Manifest{}, err
+			}
+		}
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return Manifest{}, err
+	}
+	key := manifestKey(manifest.Name, manifest.NodeID, manifest.Namespace)
+	if err := opts.Store.Put(key, bytes.NewReader(data)); err != nil {
+		return Manifest{}, err
+	}
+	return manifest, nil
+}
+
+func (m *Manifest) backupSnapshotFiles(opts Options, shard uint32, snapshotID string) error {
+	prefix := opts.FilesystemOptions.FilePathPrefix()
+	files, err := fs.SnapshotFiles(prefix, opts.Namespace, shard)
+	if err != nil {
+		return err
+	}
+	for i := range files {
+		file := &files[i]
+		if !file.HasCompleteCheckpointFile() {
+			continue
+		}
+		_, id, err := file.SnapshotTimeAndID()
+		if err != nil || id.String() != snapshotID {
+			continue
+		}
+		for _, filePath := range file.AbsoluteFilePaths {
+			if err := m.backupFile(opts, SnapshotFileType, shard, filePath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Manifest) backupDataFiles(opts Options, shard uint32) error {
+	var (
+		fsOpts = opts.FilesystemOptions
+		prefix = fsOpts.FilePathPrefix()
+	)
+	files, err := latestDataFiles(prefix, opts, shard)
+	if err != nil {
+		return err
+	}
+
+	// Files evicted after being offloaded to tiered storage must be fetched
+	// back before they can be backed up.
+	fetched := false
+	for _, file := range files {
+		if !file.IsOffloaded() {
+			continue
+		}
+		if err := fs.FetchOffloadedDataFileSet(fsOpts, file.ID); err != nil {
+			return err
+		}
+		fetched = true
+	}
+	if fetched {
+		if files, err = latestDataFiles(prefix, opts, shard); err != nil {
+			return err
+		}
+	}
+
+	for _, file := range files {
+		for _, filePath := range file.AbsoluteFilePaths {
+			if fs.IsOffloadedMarkerFile(filePath) {
+				continue
+			}
+			if err := m.backupFile(opts, DataFileType, shard, filePath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Manifest) backupFile(
+	opts Options,
+	fileType FileType,
+	shard uint32,
+	filePath string,
+) error {
+	fd, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer fd.Close() // nolint: errcheck
+
+	var (
+		counter = &countingReader{reader: fd}
+		reader  = digest.NewReaderWithDigest(counter)
+		file    = File{Type: fileType, Shard: shard, Name: filepath.Base(filePath)}
+	)
+	if err := opts.Store.Put(m.fileKey(file), reader); err != nil {
+		return fmt.Errorf("could not back up %s: %w", filePath, err)
+	}
+	file.Size = counter.n
+	file.Digest = reader.Digest().Sum32()
+	m.Files = append(m.Files, file)
+	return nil
+}
+
+// latestDataFiles returns the latest complete volume of each block.
+func latestDataFiles(prefix string, opts Options, shard uint32) ([]fs.FileSetFile, error) {
+	files, err := fs.DataFiles(prefix, opts.Namespace, shard)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		latest []fs.FileSetFile
+		seen   = make(map[xtime.UnixNano]struct{})
+	)
+	for _, file := range files {
+		blockStart := file.ID.BlockStart
+		if _, ok := seen[blockStart]; ok {
+			continue
+		}
+		seen[blockStart] = struct{}{}
+		if volume, ok := files.LatestVolumeForBlock(blockStart); ok {
+			latest = append(latest, volume)
+		}
+	}
+	return latest, nil
+}
+
+// shardsOnDisk returns the shards with data or snapshot filesets on disk.
+func shardsOnDisk(prefix string, opts Options) ([]uint32, error) {
+	dirs := []string{
+		fs.NamespaceDataDirPath(prefix, opts.Namespace),
+		fs.NamespaceSnapshotsDirPath(prefix, opts.Namespace),
+	}
+	seen := make(map[uint32]struct{})
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			shard, err := strconv.ParseUint(entry.Name(), 10, 32)
+			if err != nil {
+				continue
+			}
+			seen[uint32(shard)] = struct{}{}
+		}
+	}
+
+	shards := make([]uint32, 0, len(seen))
+	for shard := range seen {
+		shards = append(shards, shard)
+	}
+	sort.Slice(shards, func(i, j int) bool {
+		return shards[i] < shards[j]
+	})
+	return shards, nil
+}
+
+type countingReader struct {
+	reader io.Reader
+	n      int64
+}
+
+func (r *countingReader) Read(b []byte) (int, error) {
+	n, err := r.reader.Read(b)
+	r.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/blob"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var testBlockSize = 2 * time.Hour
+
+func TestBackupRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := blob.NewDirectoryStore(filepath.Join(dir, "blobs"))
+	require.NoError(t, err)
+
+	var (
+		src        = fs.NewOptions().SetFilePathPrefix(filepath.Join(dir, "src"))
+		blockStart = xtime.Now().Truncate(testBlockSize).Add(-testBlockSize)
+		namespace  = ident.StringID("testns")
+	)
+	writeTestData(t, src, namespace, 1, blockStart)
+	writeTestData(t, src, namespace, 2, blockStart)
+
+	manifest, err := Backup(Options{
+		Name:              "nightly",
+		NodeID:            "node-a",
+		Namespace:         namespace,
+		Store:             store,
+		FilesystemOptions: src,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint32{1, 2}, manifest.Shards)
+	require.Nil(t, manifest.Snapshot)
+
+	manifests, err := Manifests(store, "nightly")
+	require.NoError(t, err)
+	require.Equal(t, []Manifest{manifest}, manifests)
+
+	// Restoring a subset of the shards into a new namespace skips the index.
+	var (
+		dest     = fs.NewOptions().SetFilePathPrefix(filepath.Join(dir, "dest"))
+		restored = ident.StringID("restored")
+	)
+	result, err := Restore(RestoreOptions{
+		Name:              "nightly",
+		SourceNamespace:   namespace.String(),
+		Namespace:         restored,
+		Shards:            []uint32{2},
+		Store:             store,
+		FilesystemOptions: dest,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"node-a"}, result.NodeIDs)
+	require.Equal(t, []uint32{2}, result.Shards)
+	require.False(t, result.IndexRestored)
+	require.False(t, result.CommitLogsRestored)
+	require.True(t, result.FilesRestored > 0)
+
+	files, err := fs.DataFiles(dest.FilePathPrefix(), restored, 2)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.True(t, files[0].HasCompleteCheckpointFile())
+	require.Equal(t, blockStart, files[0].ID.BlockStart)
+
+	files, err = fs.DataFiles(dest.FilePathPrefix(), restored, 1)
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	// Restoring again does not restore files already present.
+	result, err = Restore(RestoreOptions{
+		Name:              "nightly",
+		SourceNamespace:   namespace.String(),
+		Namespace:         restored,
+		Shards:            []uint32{2},
+		Store:             store,
+		FilesystemOptions: dest,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, result.FilesRestored)
+}
+
+func TestRestoreDigestMismatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := blob.NewDirectoryStore(filepath.Join(dir, "blobs"))
+	require.NoError(t, err)
+
+	var (
+		src        = fs.NewOptions().SetFilePathPrefix(filepath.Join(dir, "src"))
+		blockStart = xtime.Now().Truncate(testBlockSize).Add(-testBlockSize)
+		namespace  = ident.StringID("testns")
+	)
+	writeTestData(t, src, namespace, 1, blockStart)
+
+	manifest, err := Backup(Options{
+		Name:              "nightly",
+		NodeID:            "node-a",
+		Namespace:         namespace,
+		Store:             store,
+		FilesystemOptions: src,
+	})
+	require.NoError(t, err)
+
+	// Corrupt the backed up data file.
+	for _, file := range manifest.Files {
+		if file.Type == DataFileType {
+			path := filepath.Join(dir, "blobs", filepath.FromSlash(manifest.fileKey(file)))
+			require.NoError(t, ioutil.WriteFile(path, []byte("corrupt"), 0o644))
+		}
+	}
+
+	dest := fs.NewOptions().SetFilePathPrefix(filepath.Join(dir, "dest"))
+	_, err = Restore(RestoreOptions{
+		Name:              "nightly",
+		Namespace:         namespace,
+		Store:             store,
+		FilesystemOptions: dest,
+	})
+	require.Error(t, err)
+
+	// Checkpoint files are restored last so nothing is left looking complete.
+	files, err := fs.DataFiles(dest.FilePathPrefix(), namespace, 1)
+	require.NoError(t, err)
+	for _, file := range files {
+		require.False(t, file.HasCompleteCheckpointFile())
+	}
+}
+
+func writeTestData(
+	t *testing.T,
+	opts fs.Options,
+	namespace ident.ID,
+	shard uint32,
+	blockStart xtime.UnixNano,
+) {
+	w, err := fs.NewWriter(opts)
+	require.NoError(t, err)
+	require.NoError(t, w.Open(fs.DataWriterOpenOptions{
+		BlockSize: testBlockSize,
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  namespace,
+			Shard:      shard,
+			BlockStart: blockStart,
+		},
+	}))
+
+	data := checked.NewBytes([]byte("somelongstringofdata"), nil)
+	data.IncRef()
+	defer data.DecRef()
+	for i := 0; i < 10; i++ {
+		id := ident.StringID(fmt.Sprintf("test-series.%d", i))
+		metadata := persist.NewMetadataFromIDAndTags(id, ident.Tags{},
+			persist.MetadataOptions{})
+		require.NoError(t, w.Write(metadata, data, 1234))
+	}
+	require.NoError(t, w.Close())
+}
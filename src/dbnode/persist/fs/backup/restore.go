@@ -0,0 +1,296 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/blob"
+)
+
+type restoreFile struct {
+	manifest  *Manifest
+	file      File
+	localPath string
+}
+
+// Manifests returns the manifests of the complete backups with the given
+// name, sorted by node ID and namespace.
+func Manifests(store blob.Store, name string) ([]Manifest, error) {
+	keys, err := store.List(name + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests []Manifest
+	for _, key := range keys {
+		if path.Base(key) != manifestFileName {
+			continue
+		}
+		manifest, err := readManifest(store, key)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, manifest)
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		if manifests[i].NodeID != manifests[j].NodeID {
+			return manifests[i].NodeID < manifests[j].NodeID
+		}
+		return manifests[i].Namespace < manifests[j].Namespace
+	})
+	return manifests, nil
+}
+
+func readManifest(store blob.Store, key string) (Manifest, error) {
+	r, err := store.Get(key)
+	if err != nil {
+		return Manifest{}, err
+	}
+	defer r.Close() // nolint: errcheck
+
+	var manifest Manifest
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return Manifest{}, fmt.Errorf("could not decode backup manifest %s: %w", key, err)
+	}
+	if manifest.Version != manifestVersion {
+		return Manifest{}, fmt.Errorf("backup manifest %s has unsupported version %d",
+			key, manifest.Version)
+	}
+	return manifest, nil
+}
+
+// Restore restores a namespace from a backup. Each shard is restored from the
+// first node's backup that contains it so that a cluster with a different
+// topology can be restored by restoring all shards it owns on each node.
+// Files already present locally are not restored again, and checkpoint files
+// are restored last so a partially restored fileset is never considered
+// complete.
+func Restore(opts RestoreOptions) (RestoreResult, error) {
+	if err := opts.Validate(); err != nil {
+		return RestoreResult{}, err
+	}
+
+	var (
+		fsOpts          = opts.FilesystemOptions
+		prefix          = fsOpts.FilePathPrefix()
+		sourceNamespace = opts.SourceNamespace
+	)
+	if sourceNamespace == "" {
+		sourceNamespace = opts.Namespace.String()
+	}
+
+	all, err := Manifests(opts.Store, opts.Name)
+	if err != nil {
+		return RestoreResult{}, err
+	}
+
+	var manifests []Manifest
+	for _, manifest := range all {
+		if manifest.Namespace != sourceNamespace {
+			continue
+		}
+		if opts.NodeID != "" && manifest.NodeID != opts.NodeID {
+			continue
+		}
+		manifests = append(manifests, manifest)
+	}
+	if len(manifests) == 0 {
+		return RestoreResult{}, fmt.Errorf("%w: name=%s, namespace=%s",
+			ErrNotFound, opts.Name, sourceNamespace)
+	}
+
+	shardFilter := make(map[uint32]struct{}, len(opts.Shards))
+	for _, shard := range opts.Shards {
+		shardFilter[shard] = struct{}{}
+	}
+
+	var (
+		result   RestoreResult
+		assigned = make(map[uint32]*Manifest)
+		used     []*Manifest
+	)
+	for i := range manifests {
+		manifest := &manifests[i]
+		numAssigned := 0
+		for _, shard := range manifest.Shards {
+			if _, ok := shardFilter[shard]; len(shardFilter) > 0 && !ok {
+				continue
+			}
+			if _, ok := assigned[shard]; ok {
+				continue
+			}
+			assigned[shard] = manifest
+			result.Shards = append(result.Shards, shard)
+			numAssigned++
+		}
+		if numAssigned > 0 {
+			used = append(used, manifest)
+			result.NodeIDs = append(result.NodeIDs, manifest.NodeID)
+		}
+	}
+	sort.Slice(result.Shards, func(i, j int) bool {
+		return result.Shards[i] < result.Shards[j]
+	})
+
+	// The index filesets cover all the shards of the node they were backed
+	// up from, they can only be restored when exactly those shards are
+	// restored, otherwise the index is rebuilt from the data filesets. The
+	// same goes for commit logs which also span namespaces.
+	if len(used) == 1 {
+		allShards := true
+		for _, shard := range used[0].Shards {
+			if assigned[shard] != used[0] {
+				allShards = false
+				break
+			}
+		}
+		result.IndexRestored = allShards
+		result.CommitLogsRestored = opts.IncludeCommitLogs &&
+			sourceNamespace == opts.Namespace.String()
+	}
+
+	var files []restoreFile
+	for _, manifest := range used {
+		for _, file := range manifest.Files {
+			var dir string
+			switch file.Type {
+			case DataFileType:
+				if assigned[file.Shard] != manifest {
+					continue
+				}
+				dir = fs.ShardDataDirPath(prefix, opts.Namespace, file.Shard)
+			case SnapshotFileType:
+				if !result.CommitLogsRestored || assigned[file.Shard] != manifest {
+					continue
+				}
+				dir = fs.ShardSnapshotsDirPath(prefix, opts.Namespace, file.Shard)
+			case IndexFileType:
+				if !result.IndexRestored {
+					continue
+				}
+				dir = fs.NamespaceIndexDataDirPath(prefix, opts.Namespace)
+			case SnapshotMetadataFileType:
+				if !result.CommitLogsRestored {
+					continue
+				}
+				dir = fs.SnapshotDirPath(prefix)
+			case CommitLogFileType:
+				if !result.CommitLogsRestored {
+					continue
+				}
+				dir = fs.CommitLogsDirPath(prefix)
+			default:
+				return RestoreResult{}, fmt.Errorf("unknown backup file type: %s", file.Type)
+			}
+			files = append(files, restoreFile{
+				manifest:  manifest,
+				file:      file,
+				localPath: filepath.Join(dir, file.Name),
+			})
+		}
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		return !isCheckpointFile(files[i].file.Name) && isCheckpointFile(files[j].file.Name)
+	})
+	for _, f := range files {
+		restored, err := restoreFileFromStore(opts, f)
+		if err != nil {
+			return RestoreResult{}, err
+		}
+		if restored {
+			result.FilesRestored++
+		}
+	}
+	return result, nil
+}
+
+func isCheckpointFile(name string) bool {
+	return strings.Contains(name, fs.CheckpointFileSuffix)
+}
+
+func restoreFileFromStore(opts RestoreOptions, f restoreFile) (bool, error) {
+	if _, err := os.Stat(f.localPath); err == nil {
+		return false, nil
+	} else if !os.IsNotExist(err) {
+		return false, err
+	}
+
+	fsOpts := opts.FilesystemOptions
+	dir := filepath.Dir(f.localPath)
+	if err := os.MkdirAll(dir, fsOpts.NewDirectoryMode()); err != nil {
+		return false, err
+	}
+
+	key := f.manifest.fileKey(f.file)
+	r, err := opts.Store.Get(key)
+	if err != nil {
+		return false, fmt.Errorf("could not fetch backup file %s: %w", key, err)
+	}
+	defer r.Close() // nolint: errcheck
+
+	tmp, err := os.CreateTemp(dir, ".restore-*")
+	if err != nil {
+		return false, err
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // nolint: errcheck
+
+	var (
+		counter = &countingReader{reader: r}
+		reader  = digest.NewReaderWithDigest(counter)
+	)
+	_, err = io.Copy(tmp, reader)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if counter.n != f.file.Size {
+		return false, fmt.Errorf("backup file %s size mismatch: expected=%d, actual=%d",
+			key, f.file.Size, counter.n)
+	}
+	if err := reader.Validate(f.file.Digest); err != nil {
+		return false, fmt.Errorf("backup file %s: %w", key, err)
+	}
+	if err := os.Chmod(tmpPath, fsOpts.NewFileMode()); err != nil {
+		return false, err
+	}
+	if err := os.Rename(tmpPath, f.localPath); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package backup backs up the filesets, index filesets, latest snapshot and
+// the commit logs following it for a namespace on a node to a blob store, and
+// restores them from the manifest recorded alongside.
+package backup
+
+import (
+	"errors"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/blob"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+const (
+	manifestVersion  = 1
+	manifestFileName = "manifest.json"
+)
+
+var (
+	// ErrNotFound is returned when restoring a backup that does not exist.
+	ErrNotFound = errors.New("backup not found")
+
+	errNameNotSet              = errors.New("backup name is not set")
+	errNodeIDNotSet            = errors.New("backup node ID is not set")
+	errNamespaceNotSet         = errors.New("backup namespace is not set")
+	errStoreNotSet             = errors.New("backup store is not set")
+	errFilesystemOptionsNotSet = errors.New("backup filesystem options are not set")
+)
+
+// FileType is the type of a backed up file.
+type FileType string
+
+const (
+	// DataFileType is a file of a data fileset.
+	DataFileType FileType = "data"
+	// IndexFileType is a file of an index fileset.
+	IndexFileType FileType = "index"
+	// SnapshotFileType is a file of a data snapshot fileset.
+	SnapshotFileType FileType = "snapshot"
+	// SnapshotMetadataFileType is a snapshot metadata or checkpoint file.
+	SnapshotMetadataFileType FileType = "snapshot-metadata"
+	// CommitLogFileType is a commit log file.
+	CommitLogFileType FileType = "commitlog"
+)
+
+func (t FileType) hasShard() bool {
+	return t == DataFileType || t == SnapshotFileType
+}
+
+// Manifest describes the files backed up for a namespace on a node, it is
+// written once all files are backed up so its presence marks a complete backup.
+type Manifest struct {
+	Version   int       `json:"version"`
+	Name      string    `json:"name"`
+	NodeID    string    `json:"nodeID"`
+	Namespace string    `json:"namespace"`
+	CreatedAt time.Time `json:"createdAt"`
+	Shards    []uint32  `json:"shards"`
+
+	// Snapshot is the snapshot the backup was taken from, the commit logs in
+	// the backup are those from the snapshot's commit log position onwards.
+	Snapshot *SnapshotPosition `json:"snapshot,omitempty"`
+
+	Files []File `json:"files"`
+}
+
+// SnapshotPosition is the position of a snapshot in the commit log.
+type SnapshotPosition struct {
+	ID             string `json:"id"`
+	Index          int64  `json:"index"`
+	CommitLogIndex int64  `json:"commitLogIndex"`
+}
+
+// File is a backed up file.
+type File struct {
+	Type   FileType `json:"type"`
+	Shard  uint32   `json:"shard"`
+	Name   string   `json:"name"`
+	Size   int64    `json:"size"`
+	Digest uint32   `json:"digest"`
+}
+
+func manifestKey(name, nodeID, namespace string) string {
+	return path.Join(name, nodeID, namespace, manifestFileName)
+}
+
+func (m Manifest) fileKey(f File) string {
+	if f.Type.hasShard() {
+		return path.Join(m.Name, m.NodeID, m.Namespace, string(f.Type),
+			strconv.Itoa(int(f.Shard)), f.Name)
+	}
+	return path.Join(m.Name, m.NodeID, m.Namespace, string(f.Type), f.Name)
+}
+
+// Options are the options for backing up a namespace.
+type Options struct {
+	// Name is the name of the backup, shared by all nodes backing up at once.
+	Name string
+	// NodeID identifies the node being backed up.
+	NodeID string
+	// Namespace is the namespace to back up.
+	Namespace ident.ID
+	// Shards restricts the backup to the given shards, all shards present on
+	// disk are backed up if empty.
+	Shards []uint32
+	// Store is the blob store the backup is written to.
+	Store blob.Store
+	// FilesystemOptions are the options of the node's filesystem.
+	FilesystemOptions fs.Options
+}
+
+// Validate validates the options.
+func (o Options) Validate() error {
+	if o.Name == "" {
+		return errNameNotSet
+	}
+	if o.NodeID == "" {
+		return errNodeIDNotSet
+	}
+	if o.Namespace == nil || len(o.Namespace.Bytes()) == 0 {
+		return errNamespaceNotSet
+	}
+	if o.Store == nil {
+		return errStoreNotSet
+	}
+	if o.FilesystemOptions == nil {
+		return errFilesystemOptionsNotSet
+	}
+	return nil
+}
+
+// RestoreOptions are the options for restoring a namespace from a backup.
+type RestoreOptions struct {
+	// Name is the name of the backup to restore.
+	Name string
+	// NodeID restricts the restore to the backup of the given node, the
+	// backups of all nodes are used if empty.
+	NodeID string
+	// SourceNamespace is the backed up namespace, defaults to Namespace.
+	SourceNamespace string
+	// Namespace is the namespace to restore into.
+	Namespace ident.ID
+	// Shards restricts the restore to the given shards, all backed up shards
+	// are restored if empty.
+	Shards []uint32
+	// IncludeCommitLogs restores the snapshot metadata and commit logs, this
+	// must only be done while the node is stopped since the commit log
+	// bootstrapper only replays commit logs present when the node started.
+	IncludeCommitLogs bool
+	// Store is the blob store the backup is read from.
+	Store blob.Store
+	// FilesystemOptions are the options of the node's filesystem.
+	FilesystemOptions fs.Options
+}
+
+// Validate validates the options.
+func (o RestoreOptions) Validate() error {
+	if o.Name == "" {
+		return errNameNotSet
+	}
+	if o.Namespace == nil || len(o.Namespace.Bytes()) == 0 {
+		return errNamespaceNotSet
+	}
+	if o.Store == nil {
+		return errStoreNotSet
+	}
+	if o.FilesystemOptions == nil {
+		return errFilesystemOptionsNotSet
+	}
+	return nil
+}
+
+// RestoreResult describes what was restored.
+type RestoreResult struct {
+	// NodeIDs are the nodes whose backups were restored from.
+	NodeIDs []string
+	// Shards are the restored shards.
+	Shards []uint32
+	// FilesRestored is the number of files restored, files already present
+	// locally are not restored again.
+	FilesRestored int
+	// IndexRestored is whether the index filesets were restored, otherwise
+	// the index is rebuilt from the data filesets when bootstrapping.
+	IndexRestored bool
+	// CommitLogsRestored is whether the snapshot metadata and commit logs
+	// were restored.
+	CommitLogsRestored bool
+}
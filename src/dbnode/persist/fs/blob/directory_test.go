@@ -0,0 +1,62 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package blob
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectoryStore(t *testing.T) {
+	store, err := NewDirectoryStore(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = store.Get("data/foo/0/a.db")
+	require.Equal(t, ErrNotFound, err)
+
+	require.NoError(t, store.Put("data/foo/0/a.db", bytes.NewReader([]byte("a"))))
+	require.NoError(t, store.Put("data/foo/1/b.db", bytes.NewReader([]byte("b"))))
+	require.NoError(t, store.Put("data/bar/0/c.db", bytes.NewReader([]byte("c"))))
+
+	// Overwrite replaces the existing object.
+	require.NoError(t, store.Put("data/foo/0/a.db", bytes.NewReader([]byte("aa"))))
+
+	r, err := store.Get("data/foo/0/a.db")
+	require.NoError(t, err)
+	contents, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "aa", string(contents))
+
+	keys, err := store.List("data/foo/")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"data/foo/0/a.db", "data/foo/1/b.db"}, keys)
+
+	require.NoError(t, store.Delete("data/foo/0/a.db"))
+	require.NoError(t, store.Delete("data/foo/0/a.db"))
+
+	keys, err = store.List("data/")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"data/foo/1/b.db", "data/bar/0/c.db"}, keys)
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package s3 provides a blob store backed by an S3 compatible object store.
+package s3
+
+import (
+	"errors"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs/blob"
+)
+
+var errBucketNotSet = errors.New("s3 bucket is not set")
+
+// Configuration is the configuration for an S3 compatible blob store.
+type Configuration struct {
+	// Bucket is the bucket objects are stored in.
+	Bucket string `yaml:"bucket" validate:"nonzero"`
+
+	// Prefix is prepended to all object keys.
+	Prefix string `yaml:"prefix"`
+
+	// Region is the region of the bucket.
+	Region string `yaml:"region"`
+
+	// Endpoint overrides the default endpoint, for use with S3 compatible
+	// stores such as MinIO or Ceph.
+	Endpoint string `yaml:"endpoint"`
+
+	// ForcePathStyle uses path style addressing rather than virtual hosted
+	// buckets, which most S3 compatible stores require.
+	ForcePathStyle bool `yaml:"forcePathStyle"`
+
+	// AccessKeyID and SecretAccessKey set static credentials, if not set the
+	// default AWS credential chain is used.
+	AccessKeyID     string `yaml:"accessKeyID"`
+	SecretAccessKey string `yaml:"secretAccessKey"`
+}
+
+// NewStore returns a new S3 blob store.
+func (c Configuration) NewStore() (blob.Store, error) {
+	if c.Bucket == "" {
+		return nil, errBucketNotSet
+	}
+
+	cfg := aws.NewConfig().WithS3ForcePathStyle(c.ForcePathStyle)
+	if c.Region != "" {
+		cfg = cfg.WithRegion(c.Region)
+	}
+	if c.Endpoint != "" {
+		cfg = cfg.WithEndpoint(c.Endpoint)
+	}
+	if c.AccessKeyID != "" {
+		cfg = cfg.WithCredentials(credentials.NewStaticCredentials(
+			c.AccessKeyID, c.SecretAccessKey, ""))
+	}
+
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s3.New(sess)
+	return &store{
+		client:   client,
+		uploader: s3manager.NewUploaderWithClient(client),
+		bucket:   c.Bucket,
+		prefix:   strings.Trim(c.Prefix, "/"),
+	}, nil
+}
+
+type store struct {
+	client   *s3.S3
+	uploader *s3manager.Uploader
+	bucket   string
+	prefix   string
+}
+
+func (s *store) key(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return path.Join(s.prefix, key)
+}
+
+func (s *store) Put(key string, r io.Reader) error {
+	_, err := s.uploader.Upload(&s3manager.UploadInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+		Body:   r,
+	})
+	return err
+}
+
+func (s *store) Get(key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+	})
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, blob.ErrNotFound
+		}
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+func (s *store) Delete(key string) error {
+	// NB: S3 deletes are idempotent so a missing key is not an error.
+	_, err := s.client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+	})
+	return err
+}
+
+func (s *store) List(prefix string) ([]string, error) {
+	var (
+		keys      []string
+		keyPrefix = s.key(prefix)
+	)
+	if s.prefix != "" && strings.HasSuffix(prefix, "/") {
+		// Preserve the trailing separator that path.Join removes.
+		keyPrefix += "/"
+	}
+	err := s.client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(keyPrefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, obj := range page.Contents {
+			key := aws.StringValue(obj.Key)
+			if s.prefix != "" {
+				key = strings.TrimPrefix(key, s.prefix+"/")
+			}
+			keys = append(keys, key)
+		}
+		return true
+	})
+	return keys, err
+}
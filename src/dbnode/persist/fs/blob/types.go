@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package blob provides object stores that sealed fileset files can be
+// offloaded to and fetched back from.
+package blob
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned when a key does not exist in the store.
+var ErrNotFound = errors.New("blob not found")
+
+// Store is an object store keyed by slash separated paths.
+type Store interface {
+	// Put writes the contents of the reader to the key, replacing any
+	// existing object. The object must not be visible until fully written.
+	Put(key string, r io.Reader) error
+
+	// Get returns a reader for the object at the key, or ErrNotFound.
+	Get(key string) (io.ReadCloser, error)
+
+	// Delete removes the object at the key, it is not an error if the key
+	// does not exist.
+	Delete(key string) error
+
+	// List returns all keys beginning with the prefix.
+	List(prefix string) ([]string, error)
+}
@@ -22,6 +22,7 @@ package commitlog
 
 import (
 	"bufio"
+	"fmt"
 	"io"
 	"os"
 
@@ -35,6 +36,13 @@ const (
 	checksumSizeEnd   = checksumSizeStart + chunkHeaderSizeLen
 	checksumDataStart = checksumSizeEnd
 	checksumDataEnd   = checksumDataStart + chunkHeaderChecksumDataLen
+
+	fileHeaderMagicStart     = 0
+	fileHeaderMagicEnd       = fileHeaderMagicLen
+	fileHeaderVersionIdx     = fileHeaderMagicEnd
+	fileHeaderCompressionIdx = fileHeaderVersionIdx + fileHeaderVersionLen
+	fileHeaderChecksumStart  = fileHeaderCompressionIdx + fileHeaderCompressionLen + fileHeaderReservedLen
+	fileHeaderChecksumEnd    = fileHeaderChecksumStart + fileHeaderChecksumLen
 )
 
 type chunkReader struct {
@@ -43,6 +51,9 @@ type chunkReader struct {
 	chunkData          []byte
 	chunkDataRemaining int
 	charBuff           []byte
+	compressedData     []byte
+	compression        CompressionType
+	fileHeaderRead     bool
 }
 
 func newChunkReader(bufferLen int) *chunkReader {
@@ -57,9 +68,58 @@ func (r *chunkReader) reset(fd *os.File) {
 	r.fd = fd
 	r.buffer.Reset(fd)
 	r.chunkDataRemaining = 0
+	r.compression = CompressionNone
+	r.fileHeaderRead = false
+}
+
+func (r *chunkReader) readFileHeader() error {
+	r.fileHeaderRead = true
+
+	header, err := r.buffer.Peek(fileHeaderLen)
+	if err != nil {
+		// Too short to have a file header, any error will be returned
+		// when reading the first chunk header.
+		return nil
+	}
+
+	magic := endianness.Uint32(header[fileHeaderMagicStart:fileHeaderMagicEnd])
+	if magic != fileHeaderMagic {
+		// Files with uncompressed chunks do not have a file header.
+		return nil
+	}
+
+	checksum := digest.
+		Buffer(header[fileHeaderChecksumStart:fileHeaderChecksumEnd]).
+		ReadDigest()
+	if digest.Checksum(header[:fileHeaderChecksumStart]) != checksum {
+		return errCommitLogReaderFileHeaderChecksumMismatch
+	}
+
+	if version := header[fileHeaderVersionIdx]; version > fileHeaderVersion {
+		return fmt.Errorf("commit log file header version %d unsupported, max supported version is %d",
+			version, fileHeaderVersion)
+	}
+
+	compression := CompressionType(header[fileHeaderCompressionIdx])
+	if err := compression.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := r.buffer.Discard(fileHeaderLen); err != nil {
+		return err
+	}
+
+	r.compression = compression
+	return nil
 }
 
 func (r *chunkReader) readHeader() error {
+	if !r.fileHeaderRead {
+		if err := r.readFileHeader(); err != nil {
+			return err
+		}
+	}
+
 	header, err := r.buffer.Peek(chunkHeaderLen)
 	if err != nil {
 		return err
@@ -83,36 +143,55 @@ func (r *chunkReader) readHeader() error {
 		return err
 	}
 
-	// Setup a chunk data buffer so that chunk data can be loaded into it.
+	// Setup a chunk data buffer so that chunk data can be loaded into it,
+	// compressed chunk data is loaded into a separate buffer and then
+	// decompressed into the chunk data buffer.
+	chunkData := r.chunkData
+	if r.compression != CompressionNone {
+		chunkData = r.compressedData
+	}
 	chunkDataSize := int(size)
-	if chunkDataSize > cap(r.chunkData) {
+	if chunkDataSize > cap(chunkData) {
 		// Increase chunkData capacity so that it can fit the new chunkData.
-		chunkDataCap := cap(r.chunkData)
+		chunkDataCap := cap(chunkData)
+		if chunkDataCap == 0 {
+			chunkDataCap = chunkDataSize
+		}
 		for chunkDataCap < chunkDataSize {
 			chunkDataCap *= 2
 		}
-		r.chunkData = make([]byte, chunkDataSize, chunkDataCap)
+		chunkData = make([]byte, chunkDataSize, chunkDataCap)
 	} else {
 		// Reuse existing chunk data buffer if possible.
-		r.chunkData = r.chunkData[:chunkDataSize]
+		chunkData = chunkData[:chunkDataSize]
 	}
 
 	// To validate checksum of chunk data all the chunk data needs to be loaded into memory at once. Chunk data size is // not bounded to the flush size so peeking chunk data in order to compute checksum may result in bufio's buffer
 	// full error. To circumnavigate this issue load the chunk data into chunk reader's buffer to compute checksum
 	// instead of trying to compute checksum off of fixed size r.buffer by peeking.
 	// See https://github.com/m3db/m3/pull/2148 for details.
-	_, err = io.ReadFull(r.buffer, r.chunkData)
+	_, err = io.ReadFull(r.buffer, chunkData)
 	if err != nil {
 		return err
 	}
 
 	// Verify data checksum
-	if digest.Checksum(r.chunkData) != checksumData {
+	if digest.Checksum(chunkData) != checksumData {
 		return errCommitLogReaderChunkSizeChecksumMismatch
 	}
 
+	if r.compression == CompressionNone {
+		r.chunkData = chunkData
+	} else {
+		r.compressedData = chunkData
+		r.chunkData, err = decompressChunk(r.compression, r.chunkData, chunkData)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Set remaining data to be consumed
-	r.chunkDataRemaining = int(size)
+	r.chunkDataRemaining = len(r.chunkData)
 
 	return nil
 }
@@ -68,6 +68,9 @@ type commitLogFailFn func(err error)
 type writeOrWriteBatch struct {
 	write      writes.Write
 	writeBatch writes.WriteBatch
+	// tombstone is set when the write deletes the datapoints of the
+	// series within the range rather than writing a datapoint.
+	tombstone xtime.Range
 }
 
 type commitLog struct {
@@ -183,6 +186,7 @@ type commitLogMetrics struct {
 	closeErrors      tally.Counter
 	flushErrors      tally.Counter
 	flushDone        tally.Counter
+	groupCommits     tally.Counter
 }
 
 type eventType int
@@ -193,6 +197,7 @@ const (
 	flushEventType
 	activeLogsEventType
 	rotateLogsEventType
+	syncEventType
 )
 
 type callbackFn func(callbackResult)
@@ -289,6 +294,7 @@ func newCommitLog(opts Options, testOpts testOnlyOpts) (CommitLog, error) {
 			closeErrors:      scope.Counter("writes.close-errors"),
 			flushErrors:      scope.Counter("writes.flush-errors"),
 			flushDone:        scope.Counter("writes.flush-done"),
+			groupCommits:     scope.Counter("writes.group-commits"),
 		},
 		beforeAsyncWriteFn: testOpts.beforeAsyncWriteFn,
 	}
@@ -297,7 +303,7 @@ func newCommitLog(opts Options, testOpts testOnlyOpts) (CommitLog, error) {
 	commitLog.writerState.secondary.commitlog = commitLog
 
 	switch opts.Strategy() {
-	case StrategyWriteWait:
+	case StrategyWriteWait, StrategyWriteGroupCommit:
 		commitLog.writeFn = commitLog.writeWait
 	default:
 		commitLog.writeFn = commitLog.writeBehind
@@ -350,6 +356,10 @@ func (l *commitLog) Open() error {
 		go l.flushEvery(flushInterval)
 	}
 
+	if l.opts.Strategy() == StrategyWriteGroupCommit {
+		go l.syncEvery(l.opts.GroupCommitMaxLatency())
+	}
+
 	return nil
 }
 
@@ -471,6 +481,24 @@ func (l *commitLog) flushEvery(interval time.Duration) {
 	}
 }
 
+func (l *commitLog) syncEvery(interval time.Duration) {
+	// Periodically sync the underlying commit log writer so that the writes
+	// waiting to be group committed are acknowledged within the interval.
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		l.closedState.RLock()
+		if l.closedState.closed {
+			l.closedState.RUnlock()
+			return
+		}
+
+		l.writes <- commitLogWrite{eventType: syncEventType}
+		l.closedState.RUnlock()
+	}
+}
+
 func (l *commitLog) write() {
 	// We use these to make the batch and non-batched write paths the same
 	// by turning non-batched writes into a batch of size one while avoiding
@@ -484,6 +512,16 @@ func (l *commitLog) write() {
 			continue
 		}
 
+		if write.eventType == syncEventType {
+			// Only sync if there are writes waiting to be acknowledged,
+			// errors are handled by the flush callback.
+			if len(l.writerState.primary.pendingFlushFns) > 0 {
+				l.writerState.primary.writer.Flush(true)
+				l.metrics.groupCommits.Inc(1)
+			}
+			continue
+		}
+
 		if write.eventType == activeLogsEventType {
 			write.callbackFn(callbackResult{
 				eventType: write.eventType,
@@ -525,6 +563,18 @@ func (l *commitLog) write() {
 			continue
 		}
 
+		if !write.write.tombstone.IsEmpty() {
+			err := l.writerState.primary.writer.WriteTombstone(
+				write.write.write.Series, write.write.tombstone)
+			if err != nil {
+				l.handleWriteErr(err)
+			} else {
+				l.metrics.success.Inc(1)
+			}
+			atomic.AddInt64(&l.numWritesInQueue, -1)
+			continue
+		}
+
 		var (
 			numWritesSuccess int64
 			numDequeued      int
@@ -770,6 +820,19 @@ func (l *commitLog) Write(
 	})
 }
 
+func (l *commitLog) WriteTombstone(
+	ctx context.Context,
+	series ts.Series,
+	r xtime.Range,
+) error {
+	return l.writeFn(ctx, writeOrWriteBatch{
+		write: writes.Write{
+			Series: series,
+		},
+		tombstone: r,
+	})
+}
+
 func (l *commitLog) WriteBatch(
 	ctx context.Context,
 	writes writes.WriteBatch,
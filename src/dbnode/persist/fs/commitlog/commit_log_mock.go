@@ -165,6 +165,20 @@ func (mr *MockCommitLogMockRecorder) WriteBatch(ctx, writes interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteBatch", reflect.TypeOf((*MockCommitLog)(nil).WriteBatch), ctx, writes)
 }
 
+// WriteTombstone mocks base method.
+func (m *MockCommitLog) WriteTombstone(ctx context.Context, series ts.Series, r time0.Range) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteTombstone", ctx, series, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteTombstone indicates an expected call of WriteTombstone.
+func (mr *MockCommitLogMockRecorder) WriteTombstone(ctx, series, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTombstone", reflect.TypeOf((*MockCommitLog)(nil).WriteTombstone), ctx, series, r)
+}
+
 // MockIterator is a mock of Iterator interface.
 type MockIterator struct {
 	ctrl     *gomock.Controller
@@ -335,6 +349,20 @@ func (mr *MockOptionsMockRecorder) ClockOptions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockOptions", reflect.TypeOf((*MockOptions)(nil).ClockOptions))
 }
 
+// Compression mocks base method.
+func (m *MockOptions) Compression() CompressionType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compression")
+	ret0, _ := ret[0].(CompressionType)
+	return ret0
+}
+
+// Compression indicates an expected call of Compression.
+func (mr *MockOptionsMockRecorder) Compression() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compression", reflect.TypeOf((*MockOptions)(nil).Compression))
+}
+
 // FailureCallback mocks base method.
 func (m *MockOptions) FailureCallback() FailureCallback {
 	m.ctrl.T.Helper()
@@ -405,6 +433,20 @@ func (mr *MockOptionsMockRecorder) FlushSize() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushSize", reflect.TypeOf((*MockOptions)(nil).FlushSize))
 }
 
+// GroupCommitMaxLatency mocks base method.
+func (m *MockOptions) GroupCommitMaxLatency() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupCommitMaxLatency")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GroupCommitMaxLatency indicates an expected call of GroupCommitMaxLatency.
+func (mr *MockOptionsMockRecorder) GroupCommitMaxLatency() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupCommitMaxLatency", reflect.TypeOf((*MockOptions)(nil).GroupCommitMaxLatency))
+}
+
 // IdentifierPool mocks base method.
 func (m *MockOptions) IdentifierPool() ident.Pool {
 	m.ctrl.T.Helper()
@@ -517,6 +559,20 @@ func (mr *MockOptionsMockRecorder) SetClockOptions(value interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClockOptions", reflect.TypeOf((*MockOptions)(nil).SetClockOptions), value)
 }
 
+// SetCompression mocks base method.
+func (m *MockOptions) SetCompression(value CompressionType) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompression", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetCompression indicates an expected call of SetCompression.
+func (mr *MockOptionsMockRecorder) SetCompression(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompression", reflect.TypeOf((*MockOptions)(nil).SetCompression), value)
+}
+
 // SetFailureCallback mocks base method.
 func (m *MockOptions) SetFailureCallback(value FailureCallback) Options {
 	m.ctrl.T.Helper()
@@ -587,6 +643,20 @@ func (mr *MockOptionsMockRecorder) SetFlushSize(value interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFlushSize", reflect.TypeOf((*MockOptions)(nil).SetFlushSize), value)
 }
 
+// SetGroupCommitMaxLatency mocks base method.
+func (m *MockOptions) SetGroupCommitMaxLatency(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGroupCommitMaxLatency", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetGroupCommitMaxLatency indicates an expected call of SetGroupCommitMaxLatency.
+func (mr *MockOptionsMockRecorder) SetGroupCommitMaxLatency(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGroupCommitMaxLatency", reflect.TypeOf((*MockOptions)(nil).SetGroupCommitMaxLatency), value)
+}
+
 // SetIdentifierPool mocks base method.
 func (m *MockOptions) SetIdentifierPool(value ident.Pool) Options {
 	m.ctrl.T.Helper()
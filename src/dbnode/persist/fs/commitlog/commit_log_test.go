@@ -38,6 +38,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/uber-go/tally"
 
+	"github.com/m3db/m3/src/dbnode/digest"
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/ts"
@@ -71,6 +72,7 @@ type overrides struct {
 	flushInterval    *time.Duration
 	backlogQueueSize *int
 	strategy         Strategy
+	compression      CompressionType
 }
 
 var testOpts = NewOptions().
@@ -111,7 +113,8 @@ func newTestOptions(
 		opts = opts.SetBacklogQueueSize(*overrides.backlogQueueSize)
 	}
 
-	opts = opts.SetStrategy(overrides.strategy)
+	opts = opts.SetStrategy(overrides.strategy).
+		SetCompression(overrides.compression)
 
 	return opts, scope
 }
@@ -196,11 +199,12 @@ func snapshotCounterValue(
 }
 
 type mockCommitLogWriter struct {
-	openFn       func() (persist.CommitLogFile, error)
-	writeFn      func(ts.Series, ts.Datapoint, xtime.Unit, ts.Annotation) error
-	flushFn      func(sync bool) error
-	closeFn      func() error
-	setOnFlushFn func(f func(err error))
+	openFn           func() (persist.CommitLogFile, error)
+	writeFn          func(ts.Series, ts.Datapoint, xtime.Unit, ts.Annotation) error
+	writeTombstoneFn func(ts.Series, xtime.Range) error
+	flushFn          func(sync bool) error
+	closeFn          func() error
+	setOnFlushFn     func(f func(err error))
 }
 
 func newMockCommitLogWriter() *mockCommitLogWriter {
@@ -211,6 +215,9 @@ func newMockCommitLogWriter() *mockCommitLogWriter {
 		writeFn: func(ts.Series, ts.Datapoint, xtime.Unit, ts.Annotation) error {
 			return nil
 		},
+		writeTombstoneFn: func(ts.Series, xtime.Range) error {
+			return nil
+		},
 		flushFn: func(sync bool) error {
 			return nil
 		},
@@ -235,6 +242,10 @@ func (w *mockCommitLogWriter) Write(
 	return w.writeFn(series, datapoint, unit, annotation)
 }
 
+func (w *mockCommitLogWriter) WriteTombstone(series ts.Series, r xtime.Range) error {
+	return w.writeTombstoneFn(series, r)
+}
+
 func (w *mockCommitLogWriter) Flush(sync bool) error {
 	return w.flushFn(sync)
 }
@@ -495,6 +506,174 @@ func TestCommitLogWrite(t *testing.T) {
 	}
 }
 
+func TestCommitLogWriteCompressed(t *testing.T) {
+	for _, compression := range []CompressionType{CompressionSnappy, CompressionZstd} {
+		t.Run(compression.String(), func(t *testing.T) {
+			opts, scope := newTestOptions(t, overrides{
+				strategy:    StrategyWriteWait,
+				compression: compression,
+			})
+			defer cleanup(t, opts)
+
+			commitLog := newTestCommitLog(t, opts)
+			files, err := commitLog.ActiveLogs()
+			require.NoError(t, err)
+
+			writes := []testWrite{
+				{
+					testSeries(t, opts, 0, "foo.bar", ident.NewTags(ident.StringTag("name1", "val1")), 127),
+					xtime.Now(), 123.456, xtime.Second, []byte{1, 2, 3}, nil,
+				},
+				{
+					testSeries(t, opts, 1, "foo.baz", ident.NewTags(ident.StringTag("name2", "val2")), 150),
+					xtime.Now(), 456.789, xtime.Second, randomByteSlice(3 * opts.FlushSize()), nil,
+				},
+				{
+					testSeries(t, opts, 0, "foo.bar", ident.NewTags(ident.StringTag("name1", "val1")), 127),
+					xtime.Now(), 789.123, xtime.Second, bytes.Repeat([]byte{4}, opts.FlushSize()), nil,
+				},
+			}
+			writeCommitLogs(t, scope, commitLog, writes).Wait()
+			require.NoError(t, commitLog.Close())
+
+			// Assert the file is written with a versioned file header.
+			data, err := ioutil.ReadFile(files[0].FilePath)
+			require.NoError(t, err)
+			require.True(t, len(data) > fileHeaderLen)
+			require.Equal(t, newFileHeader(compression), data[:fileHeaderLen])
+
+			index, err := ReadLogInfo(files[0].FilePath, opts)
+			require.NoError(t, err)
+			require.Equal(t, files[0].Index, index)
+
+			assertCommitLogWritesByIterating(t, commitLog, writes)
+		})
+	}
+}
+
+func TestCommitLogWriteTombstone(t *testing.T) {
+	opts, _ := newTestOptions(t, overrides{
+		strategy: StrategyWriteWait,
+	})
+	defer cleanup(t, opts)
+
+	commitLog := newTestCommitLog(t, opts)
+
+	var (
+		ctx    = context.NewBackground()
+		series = testSeries(t, opts, 0, "foo.bar", ident.NewTags(ident.StringTag("name1", "val1")), 127)
+		now    = xtime.Now()
+		r      = xtime.Range{Start: now.Add(-time.Hour), End: now}
+	)
+	defer ctx.Close()
+
+	require.NoError(t, commitLog.Write(ctx, series, ts.Datapoint{
+		TimestampNanos: now.Add(-time.Minute),
+		Value:          42,
+	}, xtime.Second, nil))
+	require.NoError(t, commitLog.WriteTombstone(ctx, series, r))
+	require.NoError(t, commitLog.Close())
+
+	iter, corruptFiles, err := NewIterator(IteratorOpts{
+		CommitLogOptions:    opts,
+		FileFilterPredicate: ReadAllPredicate(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(corruptFiles))
+	defer iter.Close()
+
+	require.True(t, iter.Next())
+	entry := iter.Current()
+	require.Equal(t, "foo.bar", entry.Series.ID.String())
+	require.True(t, entry.Tombstone.IsEmpty())
+	require.Equal(t, 42.0, entry.Datapoint.Value)
+
+	require.True(t, iter.Next())
+	entry = iter.Current()
+	require.Equal(t, "foo.bar", entry.Series.ID.String())
+	require.Equal(t, r, entry.Tombstone)
+	require.Equal(t, ts.Datapoint{}, entry.Datapoint)
+
+	require.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+}
+
+func TestCommitLogReadUnsupportedFileHeaderVersion(t *testing.T) {
+	opts, scope := newTestOptions(t, overrides{
+		strategy:    StrategyWriteWait,
+		compression: CompressionSnappy,
+	})
+	defer cleanup(t, opts)
+
+	commitLog := newTestCommitLog(t, opts)
+	files, err := commitLog.ActiveLogs()
+	require.NoError(t, err)
+
+	writes := []testWrite{
+		{
+			testSeries(t, opts, 0, "foo.bar", ident.NewTags(ident.StringTag("name1", "val1")), 127),
+			xtime.Now(), 123.456, xtime.Second, nil, nil,
+		},
+	}
+	writeCommitLogs(t, scope, commitLog, writes).Wait()
+	require.NoError(t, commitLog.Close())
+
+	// Rewrite the file header as a later version.
+	data, err := ioutil.ReadFile(files[0].FilePath)
+	require.NoError(t, err)
+	data[fileHeaderVersionIdx] = fileHeaderVersion + 1
+	digest.
+		Buffer(data[fileHeaderChecksumStart:fileHeaderChecksumEnd]).
+		WriteDigest(digest.Checksum(data[:fileHeaderChecksumStart]))
+	require.NoError(t, ioutil.WriteFile(files[0].FilePath, data, opts.FilesystemOptions().NewFileMode()))
+
+	_, err = ReadLogInfo(files[0].FilePath, opts)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported")
+}
+
+func TestCommitLogWriteGroupCommit(t *testing.T) {
+	flushInterval := time.Hour
+	opts, scope := newTestOptions(t, overrides{
+		strategy:      StrategyWriteGroupCommit,
+		flushInterval: &flushInterval,
+	})
+	opts = opts.SetGroupCommitMaxLatency(10 * time.Millisecond)
+	defer cleanup(t, opts)
+
+	commitLog := newTestCommitLog(t, opts)
+
+	var writes []testWrite
+	for i := 0; i < 100; i++ {
+		writes = append(writes, testWrite{
+			testSeries(t, opts, uint64(i), fmt.Sprintf("foo.%d", i), ident.NewTags(ident.StringTag("name", "val")), 127),
+			xtime.Now(), float64(i), xtime.Second, nil, nil,
+		})
+	}
+
+	// Writes are acknowledged by group commits rather than the flush interval
+	// or closing the commit log.
+	writeCommitLogs(t, scope, commitLog, writes).Wait()
+
+	groupCommits, ok := snapshotCounterValue(scope, "commitlog.writes.group-commits")
+	require.True(t, ok)
+	require.True(t, groupCommits.Value() > 0)
+	require.True(t, groupCommits.Value() <= int64(len(writes)))
+
+	require.NoError(t, commitLog.Close())
+	assertCommitLogWritesByIterating(t, commitLog, writes)
+}
+
+func TestOptionsValidateGroupCommitMaxLatency(t *testing.T) {
+	opts := NewOptions().
+		SetStrategy(StrategyWriteGroupCommit).
+		SetGroupCommitMaxLatency(0)
+	require.Equal(t, errGroupCommitMaxLatency, opts.Validate())
+
+	require.NoError(t, opts.SetStrategy(StrategyWriteBehind).Validate())
+	require.Error(t, NewOptions().SetCompression(CompressionType(100)).Validate())
+}
+
 func TestReadCommitLogMissingMetadata(t *testing.T) {
 	readConc := 4
 	// Make sure we're not leaking goroutines
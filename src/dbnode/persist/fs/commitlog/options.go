@@ -43,6 +43,10 @@ const (
 	// defaultFlushInterval is the default commit log flush interval
 	defaultFlushInterval = time.Second
 
+	// defaultGroupCommitMaxLatency is the default maximum amount of time a
+	// write waits to be fsync'd when using the group commit strategy
+	defaultGroupCommitMaxLatency = 5 * time.Millisecond
+
 	// defaultFlushSize is the default commit log flush size
 	defaultFlushSize = 65536
 
@@ -67,6 +71,7 @@ var (
 
 var (
 	errFlushIntervalNonNegative = errors.New("flush interval must be non-negative")
+	errGroupCommitMaxLatency    = errors.New("group commit max latency must be a positive duration")
 	errBlockSizePositive        = errors.New("block size must be a positive duration")
 	errReadConcurrencyPositive  = errors.New("read concurrency must be a positive integer")
 	errMissingFailureCallback   = errors.New("failure callback must be non-nil if FailureStrategyCallback is used")
@@ -85,6 +90,8 @@ type options struct {
 	strategy                Strategy
 	flushSize               int
 	flushInterval           time.Duration
+	groupCommitMaxLatency   time.Duration
+	compression             CompressionType
 	backlogQueueSize        int
 	backlogQueueChannelSize int
 	bytesPool               pool.CheckedBytesPool
@@ -149,6 +156,7 @@ func NewOptions(setters ...OptionSetter) Options {
 		failureMode:             defaultFailureStrategy,
 		flushSize:               defaultFlushSize,
 		flushInterval:           defaultFlushInterval,
+		groupCommitMaxLatency:   defaultGroupCommitMaxLatency,
 		backlogQueueSize:        defaultBacklogQueueSize,
 		backlogQueueChannelSize: defaultBacklogQueueChannelSize,
 		bytesPool: pool.NewCheckedBytesPool(nil, presetOptions.bytePoolOptions, func(s []pool.Bucket) pool.BytesPool {
@@ -168,6 +176,14 @@ func (o *options) Validate() error {
 		return errFlushIntervalNonNegative
 	}
 
+	if o.Strategy() == StrategyWriteGroupCommit && o.GroupCommitMaxLatency() <= 0 {
+		return errGroupCommitMaxLatency
+	}
+
+	if err := o.Compression().Validate(); err != nil {
+		return err
+	}
+
 	if o.BlockSize() <= 0 {
 		return errBlockSizePositive
 	}
@@ -259,6 +275,26 @@ func (o *options) FlushInterval() time.Duration {
 	return o.flushInterval
 }
 
+func (o *options) SetGroupCommitMaxLatency(value time.Duration) Options {
+	opts := *o
+	opts.groupCommitMaxLatency = value
+	return &opts
+}
+
+func (o *options) GroupCommitMaxLatency() time.Duration {
+	return o.groupCommitMaxLatency
+}
+
+func (o *options) SetCompression(value CompressionType) Options {
+	opts := *o
+	opts.compression = value
+	return &opts
+}
+
+func (o *options) Compression() CompressionType {
+	return o.compression
+}
+
 func (o *options) SetBacklogQueueSize(value int) Options {
 	opts := *o
 	opts.backlogQueueSize = value
@@ -50,9 +50,10 @@ var (
 var (
 	emptyLogInfo schema.LogInfo
 
-	errCommitLogReaderChunkSizeChecksumMismatch = errors.New("commit log reader encountered chunk size checksum mismatch")
-	errCommitLogReaderIsNotReusable             = errors.New("commit log reader is not reusable")
-	errCommitLogReaderMissingMetadata           = errors.New("commit log reader encountered a datapoint without corresponding metadata")
+	errCommitLogReaderChunkSizeChecksumMismatch  = errors.New("commit log reader encountered chunk size checksum mismatch")
+	errCommitLogReaderFileHeaderChecksumMismatch = errors.New("commit log reader encountered file header checksum mismatch")
+	errCommitLogReaderIsNotReusable              = errors.New("commit log reader is not reusable")
+	errCommitLogReaderMissingMetadata            = errors.New("commit log reader encountered a datapoint without corresponding metadata")
 )
 
 // Reader reads a commit log file.
@@ -177,18 +178,25 @@ func (r *reader) Read() (LogEntry, error) {
 
 	result := LogEntry{
 		Series: metadata,
-		Datapoint: ts.Datapoint{
-			TimestampNanos: xtime.UnixNano(entry.Timestamp),
-			Value:          entry.Value,
-		},
-		Unit:       xtime.Unit(entry.Unit),
-		Annotation: entry.Annotation,
 		Metadata: LogEntryMetadata{
 			FileReadID:        r.fileReadID,
 			SeriesUniqueIndex: entry.Index,
 		},
 	}
+	if entry.TombstoneEnd != 0 {
+		result.Tombstone = xtime.Range{
+			Start: xtime.UnixNano(entry.Timestamp),
+			End:   xtime.UnixNano(entry.TombstoneEnd),
+		}
+		return result, nil
+	}
 
+	result.Datapoint = ts.Datapoint{
+		TimestampNanos: xtime.UnixNano(entry.Timestamp),
+		Value:          entry.Value,
+	}
+	result.Unit = xtime.Unit(entry.Unit)
+	result.Annotation = entry.Annotation
 	return result, nil
 }
 
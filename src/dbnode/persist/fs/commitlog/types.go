@@ -51,6 +51,12 @@ const (
 	// for the buffered commit log chunk that contains a write to flush
 	// before acknowledging a write
 	StrategyWriteBehind
+
+	// StrategyWriteGroupCommit describes the strategy that waits
+	// for the commit log chunk that contains a write to be fsync'd
+	// before acknowledging a write, fsyncing the writes received within
+	// the group commit max latency together
+	StrategyWriteGroupCommit
 )
 
 const (
@@ -83,6 +89,14 @@ type CommitLog interface {
 		annotation ts.Annotation,
 	) error
 
+	// WriteTombstone will write an entry in the commit log that deletes
+	// the datapoints of a given series within the range.
+	WriteTombstone(
+		ctx context.Context,
+		series ts.Series,
+		r xtime.Range,
+	) error
+
 	// WriteBatch is the same as Write, but in batch.
 	WriteBatch(
 		ctx context.Context,
@@ -111,7 +125,10 @@ type LogEntry struct {
 	Unit      xtime.Unit
 	// Annotation gets invalidates on every read.
 	Annotation ts.Annotation
-	Metadata   LogEntryMetadata
+	// Tombstone is set for entries that delete the datapoints of the series
+	// within the range, in which case the datapoint is unset.
+	Tombstone xtime.Range
+	Metadata  LogEntryMetadata
 }
 
 // LogEntryMetadata is a set of metadata about a commit log entry being read.
@@ -204,6 +221,20 @@ type Options interface {
 	// FlushInterval returns the flush interval.
 	FlushInterval() time.Duration
 
+	// SetGroupCommitMaxLatency sets the maximum amount of time a write waits
+	// to be fsync'd when using the group commit strategy.
+	SetGroupCommitMaxLatency(value time.Duration) Options
+
+	// GroupCommitMaxLatency returns the maximum amount of time a write waits
+	// to be fsync'd when using the group commit strategy.
+	GroupCommitMaxLatency() time.Duration
+
+	// SetCompression sets the compression applied to commit log chunks.
+	SetCompression(value CompressionType) Options
+
+	// Compression returns the compression applied to commit log chunks.
+	Compression() CompressionType
+
 	// SetBacklogQueueSize sets the backlog queue size.
 	SetBacklogQueueSize(value int) Options
 
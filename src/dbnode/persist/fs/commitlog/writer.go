@@ -52,6 +52,26 @@ const (
 		chunkHeaderChecksumSizeLen +
 		chunkHeaderChecksumDataLen
 
+	// The file header written in front of the chunks of commit log files
+	// with compressed chunks, files without it have uncompressed chunks:
+	// - magic uint32
+	// - version uint8
+	// - compression uint8
+	// - reserved uint16
+	// - checksum uint32 of the preceding bytes
+	fileHeaderMagic          uint32 = 0x4c43334d // "M3CL"
+	fileHeaderVersion               = 1
+	fileHeaderMagicLen              = 4
+	fileHeaderVersionLen            = 1
+	fileHeaderCompressionLen        = 1
+	fileHeaderReservedLen           = 2
+	fileHeaderChecksumLen           = 4
+	fileHeaderLen                   = fileHeaderMagicLen +
+		fileHeaderVersionLen +
+		fileHeaderCompressionLen +
+		fileHeaderReservedLen +
+		fileHeaderChecksumLen
+
 	defaultBitSetLength = 65536
 
 	defaultEncoderBuffSize = 16384
@@ -76,6 +96,10 @@ type commitLogWriter interface {
 		annotation ts.Annotation,
 	) error
 
+	// WriteTombstone will write an entry in the commit log that deletes the
+	// datapoints of a given series within the range.
+	WriteTombstone(series ts.Series, r xtime.Range) error
+
 	// Flush will flush any data in the writers buffer to the chunkWriter, essentially forcing
 	// a new chunk to be created. Optionally forces the data to be FSync'd to disk.
 	Flush(sync bool) error
@@ -114,6 +138,7 @@ type writer struct {
 	logEncoder          *msgpack.Encoder
 	logEncoderBuff      []byte
 	metadataEncoderBuff []byte
+	compression         CompressionType
 	opts                Options
 }
 
@@ -121,14 +146,17 @@ func newCommitLogWriter(
 	flushFn flushFn,
 	opts Options,
 ) commitLogWriter {
-	shouldFsync := opts.Strategy() == StrategyWriteWait
+	var (
+		shouldFsync     = opts.Strategy() == StrategyWriteWait
+		shouldAckOnSync = opts.Strategy() == StrategyWriteGroupCommit
+	)
 
 	return &writer{
 		filePathPrefix:      opts.FilesystemOptions().FilePathPrefix(),
 		newFileMode:         opts.FilesystemOptions().NewFileMode(),
 		newDirectoryMode:    opts.FilesystemOptions().NewDirectoryMode(),
 		nowFn:               opts.ClockOptions().NowFn(),
-		chunkWriter:         newChunkWriter(flushFn, shouldFsync),
+		chunkWriter:         newChunkWriter(flushFn, shouldFsync, shouldAckOnSync, opts.Compression()),
 		chunkReserveHeader:  make([]byte, chunkHeaderLen),
 		buffer:              bufio.NewWriterSize(nil, opts.FlushSize()),
 		sizeBuffer:          make([]byte, binary.MaxVarintLen64),
@@ -136,6 +164,7 @@ func newCommitLogWriter(
 		logEncoder:          msgpack.NewEncoder(),
 		logEncoderBuff:      make([]byte, 0, defaultEncoderBuffSize),
 		metadataEncoderBuff: make([]byte, 0, defaultEncoderBuffSize),
+		compression:         opts.Compression(),
 		opts:                opts,
 	}
 }
@@ -174,6 +203,14 @@ func (w *writer) Open() (persist.CommitLogFile, error) {
 	if err != nil {
 		return persist.CommitLogFile{}, err
 	}
+	if w.compression != CompressionNone {
+		// Files with uncompressed chunks are written without a file header
+		// so that they remain readable by versions that predate it.
+		if _, err := fd.Write(newFileHeader(w.compression)); err != nil {
+			fd.Close()
+			return persist.CommitLogFile{}, err
+		}
+	}
 
 	w.chunkWriter.reset(fd)
 	w.buffer.Reset(w.chunkWriter)
@@ -199,6 +236,21 @@ func (w *writer) Write(
 	annotation ts.Annotation,
 ) error {
 	var logEntry schema.LogEntry
+	logEntry.Timestamp = int64(datapoint.TimestampNanos)
+	logEntry.Value = datapoint.Value
+	logEntry.Unit = uint32(unit)
+	logEntry.Annotation = annotation
+	return w.writeLogEntry(series, logEntry)
+}
+
+func (w *writer) WriteTombstone(series ts.Series, r xtime.Range) error {
+	var logEntry schema.LogEntry
+	logEntry.Timestamp = int64(r.Start)
+	logEntry.TombstoneEnd = int64(r.End)
+	return w.writeLogEntry(series, logEntry)
+}
+
+func (w *writer) writeLogEntry(series ts.Series, logEntry schema.LogEntry) error {
 	logEntry.Create = w.nowFn().UnixNano()
 	logEntry.Index = series.UniqueIndex
 
@@ -220,11 +272,6 @@ func (w *writer) Write(
 		logEntry.Metadata = w.metadataEncoderBuff
 	}
 
-	logEntry.Timestamp = int64(datapoint.TimestampNanos)
-	logEntry.Value = datapoint.Value
-	logEntry.Unit = uint32(unit)
-	logEntry.Annotation = annotation
-
 	var err error
 	w.logEncoderBuff, err = msgpack.EncodeLogEntryFast(w.logEncoderBuff[:0], logEntry)
 	if err != nil {
@@ -300,18 +347,39 @@ func (w *writer) write(data []byte) error {
 	return err
 }
 
+func newFileHeader(compression CompressionType) []byte {
+	header := make([]byte, fileHeaderLen)
+	endianness.PutUint32(header[fileHeaderMagicStart:fileHeaderMagicEnd], fileHeaderMagic)
+	header[fileHeaderVersionIdx] = fileHeaderVersion
+	header[fileHeaderCompressionIdx] = byte(compression)
+	digest.
+		Buffer(header[fileHeaderChecksumStart:fileHeaderChecksumEnd]).
+		WriteDigest(digest.Checksum(header[:fileHeaderChecksumStart]))
+	return header
+}
+
 type fsChunkWriter struct {
-	fd      xos.File
-	flushFn flushFn
-	buff    []byte
-	fsync   bool
+	fd          xos.File
+	flushFn     flushFn
+	buff        []byte
+	compressed  []byte
+	fsync       bool
+	ackOnSync   bool
+	compression CompressionType
 }
 
-func newChunkWriter(flushFn flushFn, fsync bool) chunkWriter {
+func newChunkWriter(
+	flushFn flushFn,
+	fsync bool,
+	ackOnSync bool,
+	compression CompressionType,
+) chunkWriter {
 	return &fsChunkWriter{
-		flushFn: flushFn,
-		buff:    make([]byte, chunkHeaderLen),
-		fsync:   fsync,
+		flushFn:     flushFn,
+		buff:        make([]byte, chunkHeaderLen),
+		fsync:       fsync,
+		ackOnSync:   ackOnSync,
+		compression: compression,
 	}
 }
 
@@ -334,14 +402,32 @@ func (w *fsChunkWriter) isOpen() bool {
 }
 
 func (w *fsChunkWriter) sync() error {
-	return w.fd.Sync()
+	err := w.fd.Sync()
+	if w.ackOnSync {
+		// Writes are only acknowledged once they are durable when
+		// group committing.
+		w.flushFn(err)
+	}
+	return err
 }
 
 // Writes a custom header in front of p to a file and returns number of bytes of p successfully written to the file.
 // If the header or p is not fully written to the file, then this method returns number of bytes of p actually written
 // to the file and an error explaining the reason of failure to write fully to the file.
+// Compressed chunks are either fully written or reported as not written at all.
 func (w *fsChunkWriter) Write(p []byte) (int, error) {
-	size := len(p)
+	data := p
+	if w.compression != CompressionNone {
+		var err error
+		w.compressed, err = compressChunk(w.compression, w.compressed, p)
+		if err != nil {
+			w.flushFn(err)
+			return 0, err
+		}
+		data = w.compressed
+	}
+
+	size := len(data)
 
 	sizeStart, sizeEnd :=
 		0, chunkHeaderSizeLen
@@ -355,7 +441,7 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 
 	// Calculate checksums
 	checksumSize := digest.Checksum(w.buff[sizeStart:sizeEnd])
-	checksumData := digest.Checksum(p)
+	checksumData := digest.Checksum(data)
 
 	// Write checksums
 	digest.
@@ -366,7 +452,7 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 		WriteDigest(checksumData)
 
 	// Combine buffers to reduce to a single syscall
-	w.buff = append(w.buff[:chunkHeaderLen], p...)
+	w.buff = append(w.buff[:chunkHeaderLen], data...)
 
 	// Write contents to file descriptor
 	n, err := w.fd.Write(w.buff)
@@ -375,6 +461,12 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 	if pBytesWritten < 0 {
 		pBytesWritten = 0
 	}
+	if w.compression != CompressionNone {
+		pBytesWritten = 0
+		if n == len(w.buff) {
+			pBytesWritten = len(p)
+		}
+	}
 
 	if err != nil {
 		w.flushFn(err)
@@ -386,6 +478,11 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 		err = w.sync()
 	}
 
+	if w.ackOnSync && err == nil {
+		// The flush callback fires once the chunk is synced.
+		return pBytesWritten, nil
+	}
+
 	// Fire flush callback
 	w.flushFn(err)
 	return pBytesWritten, err
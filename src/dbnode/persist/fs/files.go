@@ -59,6 +59,8 @@ const (
 	snapshotDirName   = "snapshots"
 	commitLogsDirName = "commitlogs"
 
+	tileAggregationsDirName = "tile_aggregations"
+
 	// The maximum number of delimeters ('-' or '.') that is expected in a
 	// (base) filename.
 	maxDelimNum = 4
@@ -1516,6 +1518,12 @@ func CommitLogsDirPath(prefix string) string {
 	return path.Join(prefix, commitLogsDirName)
 }
 
+// TileAggregationsDirPath returns the path to the directory tracking the
+// progress of scheduled tile aggregations.
+func TileAggregationsDirPath(prefix string) string {
+	return path.Join(prefix, tileAggregationsDirName)
+}
+
 // DataFileSetExists determines whether data fileset files exist for the given
 // namespace, shard, block start, and volume.
 func DataFileSetExists(
@@ -37,6 +37,7 @@ const (
 	bloomFilterFileSuffix    = "bloomfilter"
 	dataFileSuffix           = "data"
 	metadataFileSuffix       = "metadata"
+	offloadedFileSuffix      = "offloaded"
 	filesetFilePrefix        = "fileset"
 	commitLogFilePrefix      = "commitlog"
 	segmentFileSetFilePrefix = "segment"
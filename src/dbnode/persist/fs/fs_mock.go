@@ -1277,6 +1277,20 @@ func (m *MockMergeWith) EXPECT() *MockMergeWithMockRecorder {
 	return m.recorder
 }
 
+// DeletedRanges mocks base method.
+func (m *MockMergeWith) DeletedRanges(arg0 ident.ID) time.Ranges {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletedRanges", arg0)
+	ret0, _ := ret[0].(time.Ranges)
+	return ret0
+}
+
+// DeletedRanges indicates an expected call of DeletedRanges.
+func (mr *MockMergeWithMockRecorder) DeletedRanges(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletedRanges", reflect.TypeOf((*MockMergeWith)(nil).DeletedRanges), arg0)
+}
+
 // ForEachRemaining mocks base method.
 func (m *MockMergeWith) ForEachRemaining(arg0 context.Context, arg1 time.UnixNano, arg2 ForEachRemainingFn, arg3 namespace.Context) error {
 	m.ctrl.T.Helper()
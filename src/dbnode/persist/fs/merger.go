@@ -197,6 +197,7 @@ func (m *merger) Merge(
 		if hasInMemoryData {
 			segmentReaders = appendBlockReadersToSegmentReaders(segmentReaders, mergeWithData)
 		}
+		deleted := mergeWith.DeletedRanges(id)
 
 		// Inform the writer to finalize the ID and tag iterator once
 		// the volume is written.
@@ -207,9 +208,9 @@ func (m *merger) Merge(
 			})
 
 		// In the special (but common) case that we're just copying the series data from the old file
-		// into the new one without merging or adding any additional data we can avoid recalculating
-		// the checksum.
-		if len(segmentReaders) == 1 && hasInMemoryData == false {
+		// into the new one without merging, adding any additional data or removing deleted data we
+		// can avoid recalculating the checksum.
+		if len(segmentReaders) == 1 && hasInMemoryData == false && deleted == nil {
 			segment, err := segmentReaders[0].Segment()
 			if err != nil {
 				return closer, err
@@ -219,9 +220,15 @@ func (m *merger) Merge(
 				return closer, err
 			}
 		} else {
-			if err := persistSegmentReaders(metadata, segmentReaders, iterResources, prepared.Persist); err != nil {
+			persisted, err := persistSegmentReaders(metadata, segmentReaders, iterResources,
+				deleted, prepared.Persist)
+			if err != nil {
 				return closer, err
 			}
+			if !persisted {
+				// All of the series data in this block has been deleted.
+				metadata.Finalize()
+			}
 		}
 		// Closing the context will finalize the data returned from
 		// mergeWith.Read(), but is safe because it has already been persisted
@@ -239,9 +246,11 @@ func (m *merger) Merge(
 			segmentReaders = appendBlockReadersToSegmentReaders(segmentReaders, mergeWithData.Blocks)
 
 			metadata := persist.NewMetadata(seriesMetadata)
-			err := persistSegmentReaders(metadata, segmentReaders, iterResources, prepared.Persist)
+			deleted := mergeWith.DeletedRanges(ident.BytesID(seriesMetadata.ID))
+			persisted, err := persistSegmentReaders(metadata, segmentReaders, iterResources,
+				deleted, prepared.Persist)
 
-			if err == nil {
+			if err == nil && persisted {
 				err = onFlush.OnFlushNewSeries(persist.OnFlushNewSeriesEvent{
 					Shard:      shard,
 					BlockStart: startTime,
@@ -313,46 +322,63 @@ func segmentReaderFromData(
 	return segReader
 }
 
+// persistSegmentReaders merges and persists the segment readers, dropping any
+// datapoints that fall within the deleted ranges. It returns whether anything
+// was persisted, which is not the case when every datapoint was deleted.
 func persistSegmentReaders(
 	metadata persist.Metadata,
 	segReaders []xio.SegmentReader,
 	ir iterResources,
+	deleted xtime.Ranges,
 	persistFn persist.DataFn,
-) error {
+) (bool, error) {
 	if len(segReaders) == 0 {
-		return nil
+		return false, nil
 	}
 
-	if len(segReaders) == 1 {
-		return persistSegmentReader(metadata, segReaders[0], persistFn)
+	if len(segReaders) == 1 && deleted == nil {
+		return true, persistSegmentReader(metadata, segReaders[0], persistFn)
 	}
 
-	return persistIter(metadata, segReaders, ir, persistFn)
+	return persistIter(metadata, segReaders, ir, deleted, persistFn)
 }
 
 func persistIter(
 	metadata persist.Metadata,
 	segReaders []xio.SegmentReader,
 	ir iterResources,
+	deleted xtime.Ranges,
 	persistFn persist.DataFn,
-) error {
+) (bool, error) {
 	it := ir.multiIter
 	it.Reset(segReaders, ir.blockStart, ir.blockSize, ir.schema)
 	encoder := ir.encoderPool.Get()
 	encoder.Reset(ir.blockStart, ir.blockAllocSize, ir.schema)
 	for it.Next() {
-		if err := encoder.Encode(it.Current()); err != nil {
+		dp, unit, annotation := it.Current()
+		if deleted != nil && deleted.Overlaps(xtime.Range{
+			Start: dp.TimestampNanos,
+			End:   dp.TimestampNanos + 1,
+		}) {
+			continue
+		}
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
 			encoder.Close()
-			return err
+			return false, err
 		}
 	}
 	if err := it.Err(); err != nil {
 		encoder.Close()
-		return err
+		return false, err
+	}
+
+	if encoder.NumEncoded() == 0 {
+		encoder.Close()
+		return false, nil
 	}
 
 	segment := encoder.Discard()
-	return persistSegment(metadata, segment, persistFn)
+	return true, persistSegment(metadata, segment, persistFn)
 }
 
 func persistSegmentReader(
@@ -434,6 +434,58 @@ func TestMergeWithNoData(t *testing.T) {
 	testMergeWith(t, diskData, mergeTargetData, expected)
 }
 
+func TestMergeWithDeletedRanges(t *testing.T) {
+	// This test scenario is when some of the series have had data deleted.
+	// id0 is only on disk and has a partial deletion, id1 is on disk and in
+	// the merge target and is entirely deleted, id2 is only in the merge
+	// target and has a partial deletion.
+	diskData := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	diskData.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{TimestampNanos: startTime.Add(0 * time.Second), Value: 0},
+		{TimestampNanos: startTime.Add(1 * time.Second), Value: 1},
+		{TimestampNanos: startTime.Add(2 * time.Second), Value: 2},
+	}))
+	diskData.Set(id1, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{TimestampNanos: startTime.Add(2 * time.Second), Value: 3},
+		{TimestampNanos: startTime.Add(3 * time.Second), Value: 4},
+	}))
+
+	mergeTargetData := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	mergeTargetData.Set(id1, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{TimestampNanos: startTime.Add(4 * time.Second), Value: 5},
+	}))
+	mergeTargetData.Set(id2, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{TimestampNanos: startTime.Add(3 * time.Second), Value: 6},
+		{TimestampNanos: startTime.Add(6 * time.Second), Value: 7},
+	}))
+
+	deleted := map[string]xtime.Ranges{
+		id0.String(): xtime.NewRanges(xtime.Range{
+			Start: startTime.Add(1 * time.Second),
+			End:   startTime.Add(2 * time.Second),
+		}),
+		id1.String(): xtime.NewRanges(xtime.Range{
+			Start: startTime,
+			End:   startTime.Add(blockSize),
+		}),
+		id2.String(): xtime.NewRanges(xtime.Range{
+			Start: startTime.Add(5 * time.Second),
+			End:   startTime.Add(10 * time.Second),
+		}),
+	}
+
+	expected := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	expected.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{TimestampNanos: startTime.Add(0 * time.Second), Value: 0},
+		{TimestampNanos: startTime.Add(2 * time.Second), Value: 2},
+	}))
+	expected.Set(id2, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{TimestampNanos: startTime.Add(3 * time.Second), Value: 6},
+	}))
+
+	testMergeWithDeletedRanges(t, diskData, mergeTargetData, deleted, expected)
+}
+
 func TestCleanup(t *testing.T) {
 	dir := createTempDir(t)
 	filePathPrefix := filepath.Join(dir, "")
@@ -532,6 +584,16 @@ func testMergeWith(
 	diskData *checkedBytesMap,
 	mergeTargetData *checkedBytesMap,
 	expectedData *checkedBytesMap,
+) {
+	testMergeWithDeletedRanges(t, diskData, mergeTargetData, nil, expectedData)
+}
+
+func testMergeWithDeletedRanges(
+	t *testing.T,
+	diskData *checkedBytesMap,
+	mergeTargetData *checkedBytesMap,
+	deleted map[string]xtime.Ranges,
+	expectedData *checkedBytesMap,
 ) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -570,7 +632,7 @@ func testMergeWith(
 		Shard:      uint32(8),
 		BlockStart: startTime,
 	}
-	mergeWith := mockMergeWithFromData(t, ctrl, diskData, mergeTargetData)
+	mergeWith := mockMergeWithFromData(t, ctrl, diskData, mergeTargetData, deleted)
 	close, err := merger.Merge(fsID, mergeWith, 1, preparer, nsCtx, &persist.NoOpColdFlushNamespace{})
 	require.NoError(t, err)
 	require.False(t, deferClosed)
@@ -655,8 +717,14 @@ func mockMergeWithFromData(
 	ctrl *gomock.Controller,
 	diskData *checkedBytesMap,
 	mergeTargetData *checkedBytesMap,
+	deleted map[string]xtime.Ranges,
 ) *MockMergeWith {
 	mergeWith := NewMockMergeWith(ctrl)
+	mergeWith.EXPECT().DeletedRanges(gomock.Any()).
+		DoAndReturn(func(id ident.ID) xtime.Ranges {
+			return deleted[id.String()]
+		}).
+		AnyTimes()
 
 	// Get the series IDs in the merge target that does not exist in disk data.
 	// This logic is not tested here because it should be part of tests of the
@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// MoveFileSet moves the files of a complete fileset written beneath another
+// file path prefix into the directory as the given volume. The checkpoint file
+// is moved last so that the fileset is only considered complete once all of
+// its files have been moved, and the source and destination directories are
+// synced so that the moves are durable. The directory must be on the same
+// filesystem as the fileset.
+func MoveFileSet(fileset FileSetFile, dir string, volumeIndex int, opts Options) error {
+	if !fileset.HasCompleteCheckpointFile() {
+		return fmt.Errorf("fileset %v is not complete", fileset.ID)
+	}
+	if err := os.MkdirAll(dir, opts.NewDirectoryMode()); err != nil {
+		return err
+	}
+
+	var (
+		blockStart = fileset.ID.BlockStart
+		prefix     = filesetFileForTimeAndVolumeIndex(blockStart, fileset.ID.VolumeIndex, "")
+		checkpoint string
+	)
+	prefix = strings.TrimSuffix(prefix, fileSuffix)
+	for _, filePath := range fileset.AbsoluteFilePaths {
+		name := filepath.Base(filePath)
+		if !strings.HasPrefix(name, prefix) {
+			return fmt.Errorf("unable to move legacy or unknown fileset file: %s", filePath)
+		}
+		suffix := strings.TrimSuffix(strings.TrimPrefix(name, prefix), fileSuffix)
+		dest := FilesetPathFromTimeAndIndex(dir, blockStart, volumeIndex, suffix)
+		if suffix == CheckpointFileSuffix {
+			checkpoint = dest
+			continue
+		}
+		if err := os.Rename(filePath, dest); err != nil {
+			return err
+		}
+	}
+
+	// NB: Sync the directories before moving the checkpoint file so that the
+	// fileset can never be complete without all of its files after a crash.
+	checkpointPath, _ := fileset.filepath(CheckpointFileSuffix)
+	srcDir := filepath.Dir(checkpointPath)
+	if err := syncDirs(srcDir, dir); err != nil {
+		return err
+	}
+	if err := os.Rename(checkpointPath, checkpoint); err != nil {
+		return err
+	}
+	return syncDirs(srcDir, dir)
+}
+
+// syncDirs syncs the directories so that renames of their entries are
+// persisted to disk.
+func syncDirs(dirs ...string) error {
+	for _, dir := range dirs {
+		fd, err := os.Open(dir)
+		if err != nil {
+			return err
+		}
+		if err := fd.Sync(); err != nil {
+			fd.Close()
+			return err
+		}
+		if err := fd.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
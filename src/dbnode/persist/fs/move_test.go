@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+)
+
+func TestMoveFileSet(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	var (
+		srcPrefix  = filepath.Join(dir, "src")
+		destPrefix = filepath.Join(dir, "dest")
+		entries    = []testEntry{
+			{"foo", nil, []byte{1, 2, 3}},
+			{"bar", map[string]string{"baz": "qux"}, []byte{4, 5, 6}},
+		}
+	)
+	w := newTestWriter(t, srcPrefix)
+	writeTestDataWithVolume(t, w, 0, testWriterStart, 2, entries, persist.FileSetFlushType)
+
+	filesets, err := DataFiles(srcPrefix, testNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, filesets, 1)
+
+	opts := testDefaultOpts.
+		SetFilePathPrefix(destPrefix).
+		SetInfoReaderBufferSize(testReaderBufferSize).
+		SetDataReaderBufferSize(testReaderBufferSize)
+	destDir := ShardDataDirPath(destPrefix, testNs1ID, 0)
+	require.NoError(t, MoveFileSet(filesets[0], destDir, 0, opts))
+
+	filesets, err = DataFiles(srcPrefix, testNs1ID, 0)
+	require.NoError(t, err)
+	require.Empty(t, filesets)
+
+	filesets, err = DataFiles(destPrefix, testNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, filesets, 1)
+	require.Equal(t, 0, filesets[0].ID.VolumeIndex)
+	require.True(t, filesets[0].HasCompleteCheckpointFile())
+
+	r, err := NewReader(testBytesPool, opts)
+	require.NoError(t, err)
+	readTestData(t, r, 0, testWriterStart, entries)
+}
+
+func TestMoveFileSetIncomplete(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	srcPrefix := filepath.Join(dir, "src")
+	w := newTestWriter(t, srcPrefix)
+	writeTestData(t, w, 0, testWriterStart, []testEntry{
+		{"foo", nil, []byte{1, 2, 3}},
+	}, persist.FileSetFlushType)
+
+	filesets, err := DataFiles(srcPrefix, testNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, filesets, 1)
+	checkpoint, ok := filesets[0].filepath(CheckpointFileSuffix)
+	require.True(t, ok)
+	require.NoError(t, os.Remove(checkpoint))
+
+	filesets, err = DataFiles(srcPrefix, testNs1ID, 0)
+	require.NoError(t, err)
+	destDir := ShardDataDirPath(filepath.Join(dir, "dest"), testNs1ID, 0)
+	require.Error(t, MoveFileSet(filesets[0], destDir, 0, testDefaultOpts))
+}
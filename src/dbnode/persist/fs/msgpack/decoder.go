@@ -182,6 +182,7 @@ func (dec *Decoder) DecodeLogEntry() (schema.LogEntry, error) {
 type DecodeLogEntryRemainingToken struct {
 	numFieldsToSkip1 int
 	numFieldsToSkip2 int
+	numFields        int
 }
 
 // DecodeLogEntryUniqueIndex decodes a log entry as much as is required to return
@@ -193,7 +194,7 @@ func (dec *Decoder) DecodeLogEntryUniqueIndex() (DecodeLogEntryRemainingToken, u
 	}
 
 	_, numFieldsToSkip1 := dec.decodeRootObject(logEntryVersion, logEntryType)
-	numFieldsToSkip2, _, ok := dec.checkNumFieldsFor(logEntryType, checkNumFieldsOptions{})
+	numFieldsToSkip2, numFields, ok := dec.checkNumFieldsFor(logEntryType, checkNumFieldsOptions{})
 	if !ok {
 		return emptyLogEntryRemainingToken, 0, errorUnableToDetermineNumFieldsToSkip
 	}
@@ -202,6 +203,7 @@ func (dec *Decoder) DecodeLogEntryUniqueIndex() (DecodeLogEntryRemainingToken, u
 	token := DecodeLogEntryRemainingToken{
 		numFieldsToSkip1: numFieldsToSkip1,
 		numFieldsToSkip2: numFieldsToSkip2,
+		numFields:        numFields,
 	}
 	return token, idx, nil
 }
@@ -221,6 +223,9 @@ func (dec *Decoder) DecodeLogEntryRemaining(token DecodeLogEntryRemainingToken,
 	logEntry.Value = dec.decodeFloat64()
 	logEntry.Unit = uint32(dec.decodeVarUint())
 	logEntry.Annotation, _, _ = dec.decodeBytes()
+	if token.numFields > minNumLogEntryFields {
+		logEntry.TombstoneEnd = dec.decodeVarint()
+	}
 
 	dec.skip(token.numFieldsToSkip1)
 	if dec.err != nil {
@@ -493,7 +498,7 @@ func (dec *Decoder) decodeLogInfo() schema.LogInfo {
 }
 
 func (dec *Decoder) decodeLogEntry() schema.LogEntry {
-	numFieldsToSkip, _, ok := dec.checkNumFieldsFor(logEntryType, checkNumFieldsOptions{})
+	numFieldsToSkip, numFields, ok := dec.checkNumFieldsFor(logEntryType, checkNumFieldsOptions{})
 	if !ok {
 		return emptyLogEntry
 	}
@@ -505,6 +510,9 @@ func (dec *Decoder) decodeLogEntry() schema.LogEntry {
 	logEntry.Value = dec.decodeFloat64()
 	logEntry.Unit = uint32(dec.decodeVarUint())
 	logEntry.Annotation, _, _ = dec.decodeBytes()
+	if numFields > minNumLogEntryFields {
+		logEntry.TombstoneEnd = dec.decodeVarint()
+	}
 	dec.skip(numFieldsToSkip)
 	if dec.err != nil {
 		return emptyLogEntry
@@ -69,9 +69,14 @@ func DecodeLogEntryFast(b []byte) (schema.LogEntry, error) {
 		return schema, notEnoughBytesError(
 			decodeLogEntryFuncName, len(logEntryHeader), len(b))
 	}
-	b = b[len(logEntryHeader):]
 
-	var err error
+	// The number of fields is the last part of the header, decode it so that
+	// entries written before the tombstone field was added remain readable.
+	numFields, b, err := decodeArrayLen(b[len(logEntryHeader)-1:])
+	if err != nil {
+		return empty, err
+	}
+
 	schema.Index, b, err = decodeUint(b)
 	if err != nil {
 		return empty, err
@@ -108,6 +113,13 @@ func DecodeLogEntryFast(b []byte) (schema.LogEntry, error) {
 		return empty, err
 	}
 
+	if numFields > minNumLogEntryFields {
+		schema.TombstoneEnd, _, err = decodeInt(b)
+		if err != nil {
+			return empty, err
+		}
+	}
+
 	return schema, err
 }
 
@@ -11,6 +11,41 @@ import (
 )
 
 func TestDecodeLogEntryFastSuccess(t *testing.T) {
+	expected := schema.LogEntry{
+		Index:        123,
+		Create:       456,
+		Metadata:     []byte("meta"),
+		Timestamp:    789,
+		Value:        3.14,
+		Unit:         2,
+		Annotation:   []byte("annot"),
+		TombstoneEnd: 1011,
+	}
+
+	var encoded []byte
+	encoded = append(encoded, logEntryHeader...)
+
+	encode := func(v interface{}) {
+		b, err := vmmsgpack.Marshal(v)
+		require.NoError(t, err)
+		encoded = append(encoded, b...)
+	}
+
+	encode(expected.Index)
+	encode(expected.Create)
+	encode(expected.Metadata)
+	encode(expected.Timestamp)
+	encode(expected.Value)
+	encode(uint64(expected.Unit))
+	encode(expected.Annotation)
+	encode(expected.TombstoneEnd)
+
+	result, err := DecodeLogEntryFast(encoded)
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestDecodeLogEntryFastWithoutTombstone(t *testing.T) {
 	expected := schema.LogEntry{
 		Index:      123,
 		Create:     456,
@@ -21,8 +56,12 @@ func TestDecodeLogEntryFastSuccess(t *testing.T) {
 		Annotation: []byte("annot"),
 	}
 
-	var encoded []byte
-	encoded = append(encoded, make([]byte, len(logEntryHeader))...)
+	// Entries written before the tombstone field was added only have the
+	// minimum number of fields.
+	encoder := NewEncoder()
+	encoder.encodeRootObject(logEntryVersion, logEntryType)
+	encoder.encodeArrayLenFn(minNumLogEntryFields)
+	encoded := encoder.Bytes()
 
 	encode := func(v interface{}) {
 		b, err := vmmsgpack.Marshal(v)
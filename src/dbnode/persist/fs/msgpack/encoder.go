@@ -367,6 +367,7 @@ func (enc *Encoder) encodeLogEntry(entry schema.LogEntry) {
 	enc.encodeFloat64Fn(entry.Value)
 	enc.encodeVarUintFn(uint64(entry.Unit))
 	enc.encodeBytesFn(entry.Annotation)
+	enc.encodeVarintFn(entry.TombstoneEnd)
 }
 
 func (enc *Encoder) encodeLogMetadata(metadata schema.LogMetadata) {
@@ -179,15 +179,17 @@ func genLogEntry() gopter.Gen {
 		gen.Float64(),
 		gen.UInt32(),
 		genByteSlice(),
+		gen.Int64(),
 	).Map(func(inputs []interface{}) schema.LogEntry {
 		return schema.LogEntry{
-			Index:      inputs[0].(uint64),
-			Create:     inputs[1].(int64),
-			Metadata:   inputs[2].([]byte),
-			Timestamp:  inputs[3].(int64),
-			Value:      inputs[4].(float64),
-			Unit:       inputs[5].(uint32),
-			Annotation: inputs[6].([]byte),
+			Index:        inputs[0].(uint64),
+			Create:       inputs[1].(int64),
+			Metadata:     inputs[2].([]byte),
+			Timestamp:    inputs[3].(int64),
+			Value:        inputs[4].(float64),
+			Unit:         inputs[5].(uint32),
+			Annotation:   inputs[6].([]byte),
+			TombstoneEnd: inputs[7].(int64),
 		}
 	})
 }
@@ -63,6 +63,7 @@ func EncodeLogEntryFast(b []byte, entry schema.LogEntry) ([]byte, error) {
 	b = encodeFloat64(b, entry.Value)
 	b = encodeVarUint64(b, uint64(entry.Unit))
 	b = encodeBytes(b, entry.Annotation)
+	b = encodeVarInt64(b, entry.TombstoneEnd)
 
 	return b, nil
 }
@@ -147,6 +147,7 @@ func testExpectedResultForLogEntry(t *testing.T, logEntry schema.LogEntry) []int
 		logEntry.Value,
 		uint64(logEntry.Unit),
 		logEntry.Annotation,
+		logEntry.TombstoneEnd,
 	}
 }
 
@@ -83,6 +83,14 @@ var (
 		Annotation: []byte("testAnnotation"),
 	}
 
+	testTombstoneLogEntry = schema.LogEntry{
+		Create:       time.Now().UnixNano(),
+		Index:        9346,
+		Metadata:     []byte("testMetadata"),
+		Timestamp:    time.Now().Add(-time.Hour).UnixNano(),
+		TombstoneEnd: time.Now().UnixNano(),
+	}
+
 	testLogMetadata = schema.LogMetadata{
 		ID:          []byte("testLogMetadata"),
 		Namespace:   []byte("testNamespace"),
@@ -556,6 +564,24 @@ func TestLogEntryRoundtripUniqueIndexAndRemaining(t *testing.T) {
 	require.Equal(t, testLogEntry, res)
 }
 
+func TestLogEntryTombstoneRoundtrip(t *testing.T) {
+	var (
+		enc = NewEncoder()
+		dec = NewDecoder(nil)
+	)
+	require.NoError(t, enc.EncodeLogEntry(testTombstoneLogEntry))
+	dec.Reset(NewByteDecoderStream(enc.Bytes()))
+	res, err := dec.DecodeLogEntry()
+	require.NoError(t, err)
+	require.Equal(t, testTombstoneLogEntry, res)
+
+	encoded, err := EncodeLogEntryFast(nil, testTombstoneLogEntry)
+	require.NoError(t, err)
+	res, err = DecodeLogEntryFast(encoded)
+	require.NoError(t, err)
+	require.Equal(t, testTombstoneLogEntry, res)
+}
+
 func TestLogMetadataRoundtrip(t *testing.T) {
 	var (
 		enc = NewEncoder()
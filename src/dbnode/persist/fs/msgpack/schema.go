@@ -105,7 +105,7 @@ const (
 	currNumIndexEntryFields           = 7
 	currNumIndexSummaryFields         = 3
 	currNumLogInfoFields              = 3
-	currNumLogEntryFields             = 7
+	currNumLogEntryFields             = 8
 	currNumLogMetadataFields          = 3
 )
 
@@ -53,3 +53,7 @@ func (m *noopMergeWith) ForEachRemaining(
 ) error {
 	return nil
 }
+
+func (m *noopMergeWith) DeletedRanges(_ ident.ID) xtime.Ranges {
+	return nil
+}
@@ -0,0 +1,417 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/persist/fs/blob"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const offloadTempFilePrefix = ".offload-"
+
+var errTieredStorageNotEnabled = errors.New("tiered storage is not enabled")
+
+// offloadedFileSuffixes are the suffixes of the data fileset files that are
+// offloaded to the blob store, the remaining files are small and are always
+// kept locally so that filesets can be listed and validated without fetching.
+var offloadedFileSuffixes = []string{indexFileSuffix, dataFileSuffix}
+
+type offloadFileSet struct {
+	hostID         string
+	filePathPrefix string
+	shardDir       string
+	blockStart     xtime.UnixNano
+	volumeIndex    int
+	isLegacy       bool
+}
+
+func newOffloadFileSet(opts Options, id FileSetFileIdentifier) (offloadFileSet, error) {
+	var (
+		filePathPrefix = opts.FilePathPrefix()
+		shardDir       = ShardDataDirPath(filePathPrefix, id.Namespace, id.Shard)
+		isLegacy       bool
+		err            error
+	)
+	if id.VolumeIndex == 0 {
+		isLegacy, err = isFirstVolumeLegacy(shardDir, id.BlockStart, CheckpointFileSuffix)
+		if err != nil {
+			return offloadFileSet{}, err
+		}
+	}
+	return offloadFileSet{
+		hostID:         opts.TieredStorageOptions().HostID,
+		filePathPrefix: filePathPrefix,
+		shardDir:       shardDir,
+		blockStart:     id.BlockStart,
+		volumeIndex:    id.VolumeIndex,
+		isLegacy:       isLegacy,
+	}, nil
+}
+
+func (f offloadFileSet) path(suffix string) string {
+	return dataFilesetPathFromTimeAndIndex(f.shardDir, f.blockStart, f.volumeIndex, suffix, f.isLegacy)
+}
+
+func (f offloadFileSet) key(suffix string) (string, error) {
+	return offloadKey(f.hostID, f.filePathPrefix, f.path(suffix))
+}
+
+func (f offloadFileSet) isOffloaded() (bool, error) {
+	return FileExists(f.path(offloadedFileSuffix))
+}
+
+// readDigests reads the fileset digests, validating the digest file against
+// the checkpoint file.
+func (f offloadFileSet) readDigests(bufferSize int) (filesetDigests, error) {
+	expectedDigestOfDigest, err := readCheckpointFile(f.path(CheckpointFileSuffix), digest.NewBuffer())
+	if err != nil {
+		return filesetDigests{}, err
+	}
+
+	fd, err := os.Open(f.path(DigestFileSuffix))
+	if err != nil {
+		return filesetDigests{}, err
+	}
+
+	reader := digest.NewFdWithDigestContentsReader(bufferSize)
+	reader.Reset(fd)
+	defer reader.Close()
+
+	digests, err := readFileSetDigests(reader)
+	if err != nil {
+		return filesetDigests{}, err
+	}
+	if err := reader.Validate(expectedDigestOfDigest); err != nil {
+		return filesetDigests{}, err
+	}
+	return digests, nil
+}
+
+func (d filesetDigests) forSuffix(suffix string) uint32 {
+	switch suffix {
+	case indexFileSuffix:
+		return d.indexDigest
+	case dataFileSuffix:
+		return d.dataDigest
+	}
+	return 0
+}
+
+// offloadKey returns the blob store key of a file, which is its path
+// relative to the file path prefix under the host ID.
+func offloadKey(hostID, filePathPrefix, filePath string) (string, error) {
+	rel, err := filepath.Rel(filePathPrefix, filePath)
+	if err != nil {
+		return "", err
+	}
+	return path.Join(hostID, filepath.ToSlash(rel)), nil
+}
+
+// offloadKeyPath returns the local path of the file of a blob store key.
+func offloadKeyPath(hostID, filePathPrefix, key string) string {
+	rel := strings.TrimPrefix(key, hostID+"/")
+	return filepath.Join(filePathPrefix, filepath.FromSlash(rel))
+}
+
+// IsOffloaded returns whether the fileset has been offloaded to the blob store.
+func (f *FileSetFile) IsOffloaded() bool {
+	for _, path := range f.AbsoluteFilePaths {
+		if IsOffloadedMarkerFile(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOffloadedMarkerFile returns whether the path is the marker file written
+// once a fileset has been offloaded to the blob store.
+func IsOffloadedMarkerFile(path string) bool {
+	return strings.HasSuffix(path, separator+offloadedFileSuffix+fileSuffix)
+}
+
+// FetchOffloadedDataFileSet ensures the offloaded files of a data fileset are
+// present locally, fetching them from the blob store if they were evicted.
+func FetchOffloadedDataFileSet(opts Options, id FileSetFileIdentifier) error {
+	fileset, err := newOffloadFileSet(opts, id)
+	if err != nil {
+		return err
+	}
+	return fetchOffloadedDataFiles(opts, fileset)
+}
+
+// OffloadDataFileSet uploads the data and index files of a complete data
+// fileset to the tiered storage blob store. Each upload is read back and
+// verified against the fileset digests before the fileset is marked as
+// offloaded, only then may local copies be deleted.
+func OffloadDataFileSet(opts Options, id FileSetFileIdentifier) error {
+	tieredOpts := opts.TieredStorageOptions()
+	if !tieredOpts.Enabled() {
+		return errTieredStorageNotEnabled
+	}
+
+	fileset, err := newOffloadFileSet(opts, id)
+	if err != nil {
+		return err
+	}
+	offloaded, err := fileset.isOffloaded()
+	if err != nil || offloaded {
+		return err
+	}
+
+	digests, err := fileset.readDigests(opts.InfoReaderBufferSize())
+	if err != nil {
+		return err
+	}
+
+	for _, suffix := range offloadedFileSuffixes {
+		key, err := fileset.key(suffix)
+		if err != nil {
+			return err
+		}
+		if err := uploadFile(tieredOpts.Store, key, fileset.path(suffix)); err != nil {
+			return err
+		}
+		if err := verifyBlob(tieredOpts.Store, key, digests.forSuffix(suffix)); err != nil {
+			return fmt.Errorf("offloaded file %s failed verification: %w", key, err)
+		}
+	}
+
+	// NB: Sync the marker since its presence is what allows the local copies
+	// of the fileset to be deleted.
+	marker, err := os.OpenFile(fileset.path(offloadedFileSuffix),
+		os.O_CREATE|os.O_WRONLY|os.O_TRUNC, opts.NewFileMode())
+	if err != nil {
+		return err
+	}
+	if err := marker.Sync(); err != nil {
+		marker.Close()
+		return err
+	}
+	return marker.Close()
+}
+
+// EvictableOffloadedFiles returns the local copies of the offloaded files of
+// a fileset that have not been opened within the tiered storage cache TTL and
+// so can be deleted. Files are only returned once the fileset is offloaded.
+func EvictableOffloadedFiles(
+	opts Options,
+	fileset FileSetFile,
+	now xtime.UnixNano,
+) ([]string, error) {
+	tieredOpts := opts.TieredStorageOptions()
+	if !tieredOpts.Enabled() || !fileset.IsOffloaded() {
+		return nil, nil
+	}
+
+	offloaded, err := newOffloadFileSet(opts, fileset.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		evictable   []string
+		evictBefore = now.Add(-tieredOpts.CacheTTL).ToTime()
+	)
+	for _, suffix := range offloadedFileSuffixes {
+		path := offloaded.path(suffix)
+		info, err := os.Stat(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if info.ModTime().Before(evictBefore) {
+			evictable = append(evictable, path)
+		}
+	}
+	return evictable, nil
+}
+
+// OrphanedOffloadedFiles returns the blob store keys of offloaded files for
+// a shard whose fileset no longer exists locally, for instance because it
+// was compacted by a cold flush or fell out of retention. Only the keys of
+// the local host are considered.
+func OrphanedOffloadedFiles(
+	opts Options,
+	namespace ident.ID,
+	shard uint32,
+) ([]string, error) {
+	tieredOpts := opts.TieredStorageOptions()
+	if !tieredOpts.Enabled() {
+		return nil, nil
+	}
+
+	shardPrefix, err := offloadKey(tieredOpts.HostID, opts.FilePathPrefix(),
+		ShardDataDirPath(opts.FilePathPrefix(), namespace, shard))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := tieredOpts.Store.List(shardPrefix + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	var orphaned []string
+	for _, key := range keys {
+		path := offloadKeyPath(tieredOpts.HostID, opts.FilePathPrefix(), key)
+		checkpointPath, ok := offloadedCheckpointPath(path)
+		if !ok {
+			continue
+		}
+		exists, err := CompleteCheckpointFileExists(checkpointPath)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			orphaned = append(orphaned, key)
+		}
+	}
+	return orphaned, nil
+}
+
+func offloadedCheckpointPath(path string) (string, bool) {
+	for _, suffix := range offloadedFileSuffixes {
+		fileSetSuffix := separator + suffix + fileSuffix
+		if strings.HasSuffix(path, fileSetSuffix) {
+			return strings.TrimSuffix(path, fileSetSuffix) +
+				separator + CheckpointFileSuffix + fileSuffix, true
+		}
+	}
+	return "", false
+}
+
+// fetchOffloadedDataFiles ensures the offloaded files of a data fileset are
+// present locally before it is opened, fetching them from the blob store if
+// they have been evicted and otherwise refreshing their modification time so
+// that they remain cached.
+func fetchOffloadedDataFiles(opts Options, fileset offloadFileSet) error {
+	tieredOpts := opts.TieredStorageOptions()
+	if !tieredOpts.Enabled() {
+		return nil
+	}
+	offloaded, err := fileset.isOffloaded()
+	if err != nil || !offloaded {
+		return err
+	}
+
+	var (
+		now     = opts.ClockOptions().NowFn()()
+		digests filesetDigests
+		read    bool
+	)
+	for _, suffix := range offloadedFileSuffixes {
+		path := fileset.path(suffix)
+		err := os.Chtimes(path, now, now)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		if !read {
+			if digests, err = fileset.readDigests(opts.InfoReaderBufferSize()); err != nil {
+				return err
+			}
+			read = true
+		}
+		key, err := fileset.key(suffix)
+		if err != nil {
+			return err
+		}
+		if err := fetchFile(tieredOpts.Store, key, path, digests.forSuffix(suffix), opts); err != nil {
+			return fmt.Errorf("could not fetch offloaded file %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func uploadFile(store blob.Store, key, path string) error {
+	fd, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+	return store.Put(key, fd)
+}
+
+func verifyBlob(store blob.Store, key string, expectedDigest uint32) error {
+	r, err := store.Get(key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	reader := digest.NewReaderWithDigest(r)
+	if _, err := io.Copy(io.Discard, reader); err != nil {
+		return err
+	}
+	return reader.Validate(expectedDigest)
+}
+
+// fetchFile downloads a blob to a temporary file which is renamed into place
+// once its digest is verified, so that concurrent readers never observe a
+// partially fetched file.
+func fetchFile(
+	store blob.Store,
+	key, path string,
+	expectedDigest uint32,
+	opts Options,
+) error {
+	r, err := store.Get(key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), offloadTempFilePrefix+"*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	reader := digest.NewReaderWithDigest(r)
+	if _, err := io.Copy(tmp, reader); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := reader.Validate(expectedDigest); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), opts.NewFileMode()); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
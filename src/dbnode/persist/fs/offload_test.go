@@ -0,0 +1,189 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs/blob"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestOffloadDataFileSet(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	store, err := blob.NewDirectoryStore(filepath.Join(dir, "blobs"))
+	require.NoError(t, err)
+
+	entries := []testEntry{
+		{"foo", nil, []byte{1, 2, 3}},
+		{"bar", map[string]string{"baz": "qux"}, []byte{4, 5, 6}},
+	}
+
+	w := newTestWriter(t, filePathPrefix)
+	writeTestData(t, w, 0, testWriterStart, entries, persist.FileSetFlushType)
+
+	opts := testDefaultOpts.
+		SetFilePathPrefix(filePathPrefix).
+		SetInfoReaderBufferSize(testReaderBufferSize).
+		SetDataReaderBufferSize(testReaderBufferSize).
+		SetTieredStorageOptions(TieredStorageOptions{
+			Store:        store,
+			HostID:       "host0",
+			OffloadAfter: time.Hour,
+			CacheTTL:     time.Hour,
+		})
+	id := FileSetFileIdentifier{
+		Namespace:   testNs1ID,
+		Shard:       0,
+		BlockStart:  testWriterStart,
+		VolumeIndex: 0,
+	}
+
+	require.NoError(t, OffloadDataFileSet(opts, id))
+
+	filesets, err := DataFiles(filePathPrefix, testNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, filesets, 1)
+	require.True(t, filesets[0].IsOffloaded())
+
+	keys, err := store.List("")
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	for _, key := range keys {
+		require.True(t, strings.HasPrefix(key, "host0/"), key)
+	}
+
+	// Recently written files are retained until the cache TTL expires.
+	now := testWriterStart.Add(time.Minute)
+	require.NoError(t, setFileSetModTimes(filesets[0], now))
+	evictable, err := EvictableOffloadedFiles(opts, filesets[0], now)
+	require.NoError(t, err)
+	require.Empty(t, evictable)
+
+	evictable, err = EvictableOffloadedFiles(opts, filesets[0], now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, evictable, 2)
+	require.NoError(t, DeleteFiles(evictable))
+
+	// Opening the fileset fetches the evicted files from the blob store.
+	r, err := NewReader(testBytesPool, opts)
+	require.NoError(t, err)
+	readTestData(t, r, 0, testWriterStart, entries)
+
+	for _, path := range evictable {
+		exists, err := FileExists(path)
+		require.NoError(t, err)
+		require.True(t, exists)
+	}
+
+	// Opening a seeker also fetches the evicted files from the blob store.
+	require.NoError(t, DeleteFiles(evictable))
+	resources := newTestReusableSeekerResources()
+	s := NewSeeker(filePathPrefix, testReaderBufferSize, testReaderBufferSize,
+		testBytesPool, false, opts)
+	require.NoError(t, s.Open(testNs1ID, 0, testWriterStart, 0, resources))
+	data, err := s.SeekByID(ident.StringID("bar"), resources)
+	require.NoError(t, err)
+	data.IncRef()
+	require.Equal(t, []byte{4, 5, 6}, data.Bytes())
+	data.DecRef()
+	require.NoError(t, s.Close())
+
+	for _, path := range evictable {
+		exists, err := FileExists(path)
+		require.NoError(t, err)
+		require.True(t, exists)
+	}
+
+	orphaned, err := OrphanedOffloadedFiles(opts, testNs1ID, 0)
+	require.NoError(t, err)
+	require.Empty(t, orphaned)
+
+	// The files offloaded by other hosts sharing the store are never orphaned.
+	otherHostKey := "host1/" + strings.TrimPrefix(keys[0], "host0/")
+	require.NoError(t, store.Put(otherHostKey, strings.NewReader("other")))
+
+	// Once the fileset is removed locally its offloaded files are orphaned.
+	require.NoError(t, DeleteFiles(filesets[0].AbsoluteFilePaths))
+	orphaned, err = OrphanedOffloadedFiles(opts, testNs1ID, 0)
+	require.NoError(t, err)
+	require.ElementsMatch(t, keys, orphaned)
+}
+
+func TestOffloadDataFileSetDigestMismatch(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	store, err := blob.NewDirectoryStore(filepath.Join(dir, "blobs"))
+	require.NoError(t, err)
+
+	w := newTestWriter(t, filePathPrefix)
+	writeTestData(t, w, 0, testWriterStart, []testEntry{
+		{"foo", nil, []byte{1, 2, 3}},
+	}, persist.FileSetFlushType)
+
+	opts := testDefaultOpts.
+		SetFilePathPrefix(filePathPrefix).
+		SetTieredStorageOptions(TieredStorageOptions{
+			Store:        corruptingStore{Store: store},
+			OffloadAfter: time.Hour,
+		})
+	err = OffloadDataFileSet(opts, FileSetFileIdentifier{
+		Namespace:  testNs1ID,
+		BlockStart: testWriterStart,
+	})
+	require.Error(t, err)
+
+	filesets, err := DataFiles(filePathPrefix, testNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, filesets, 1)
+	require.False(t, filesets[0].IsOffloaded())
+}
+
+type corruptingStore struct {
+	blob.Store
+}
+
+func (s corruptingStore) Put(key string, r io.Reader) error {
+	return s.Store.Put(key, strings.NewReader("corrupt"))
+}
+
+func setFileSetModTimes(fileset FileSetFile, t xtime.UnixNano) error {
+	for _, path := range fileset.AbsoluteFilePaths {
+		if err := os.Chtimes(path, t.ToTime(), t.ToTime()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
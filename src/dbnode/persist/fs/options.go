@@ -82,6 +82,9 @@ var (
 
 	errTagEncoderPoolNotSet = errors.New("tag encoder pool is not set")
 	errTagDecoderPoolNotSet = errors.New("tag decoder pool is not set")
+
+	errTieredStorageOffloadAfterNotPositive = errors.New("tiered storage offload after must be positive")
+	errTieredStorageCacheTTLNegative        = errors.New("tiered storage cache TTL must not be negative")
 )
 
 type options struct {
@@ -109,6 +112,7 @@ type options struct {
 	mmapReporter                         mmap.Reporter
 	indexReaderAutovalidateIndexSegments bool
 	encodingOptions                      msgpack.LegacyEncodingOptions
+	tieredStorageOptions                 TieredStorageOptions
 }
 
 type optionsInput struct {
@@ -227,6 +231,14 @@ func (o *options) Validate() error {
 	if o.tagDecoderPool == nil {
 		return errTagDecoderPoolNotSet
 	}
+	if o.tieredStorageOptions.Enabled() {
+		if o.tieredStorageOptions.OffloadAfter <= 0 {
+			return errTieredStorageOffloadAfterNotPositive
+		}
+		if o.tieredStorageOptions.CacheTTL < 0 {
+			return errTieredStorageCacheTTLNegative
+		}
+	}
 	return nil
 }
 
@@ -469,3 +481,13 @@ func (o *options) SetEncodingOptions(value msgpack.LegacyEncodingOptions) Option
 func (o *options) EncodingOptions() msgpack.LegacyEncodingOptions {
 	return o.encodingOptions
 }
+
+func (o *options) SetTieredStorageOptions(value TieredStorageOptions) Options {
+	opts := *o
+	opts.tieredStorageOptions = value
+	return &opts
+}
+
+func (o *options) TieredStorageOptions() TieredStorageOptions {
+	return o.tieredStorageOptions
+}
@@ -196,6 +196,19 @@ func (r *reader) Open(opts DataReaderOpenOptions) error {
 		dataFilepath = dataFilesetPathFromTimeAndIndex(
 			shardDir, blockStart, volumeIndex, dataFileSuffix, isLegacy)
 
+		// Fetch the data and index files if the fileset has been offloaded
+		// and they were evicted from the local cache.
+		if err := fetchOffloadedDataFiles(r.opts, offloadFileSet{
+			hostID:         r.opts.TieredStorageOptions().HostID,
+			filePathPrefix: r.filePathPrefix,
+			shardDir:       shardDir,
+			blockStart:     blockStart,
+			volumeIndex:    volumeIndex,
+			isLegacy:       isLegacy,
+		}); err != nil {
+			return err
+		}
+
 	default:
 		return fmt.Errorf("unable to open reader with fileset type: %s", opts.FileSetType)
 	}
@@ -171,6 +171,19 @@ func (s *seeker) Open(
 		}
 	}
 
+	// Fetch the data and index files if the fileset has been offloaded and
+	// they were evicted from the local cache.
+	if err := fetchOffloadedDataFiles(s.opts.opts, offloadFileSet{
+		hostID:         s.opts.opts.TieredStorageOptions().HostID,
+		filePathPrefix: s.opts.filePathPrefix,
+		shardDir:       shardDir,
+		blockStart:     blockStart,
+		volumeIndex:    volumeIndex,
+		isLegacy:       isLegacy,
+	}); err != nil {
+		return err
+	}
+
 	// Open necessary files
 	if err := openFiles(os.Open, map[string]**os.File{
 		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, InfoFileSuffix, isLegacy):        &infoFd,
@@ -29,6 +29,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs/blob"
 	"github.com/m3db/m3/src/dbnode/persist/fs/msgpack"
 	"github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/sharding"
@@ -540,6 +541,36 @@ type Options interface {
 
 	// EncodingOptions returns the encoder options used by the encoder.
 	EncodingOptions() msgpack.LegacyEncodingOptions
+
+	// SetTieredStorageOptions sets the tiered storage options.
+	SetTieredStorageOptions(value TieredStorageOptions) Options
+
+	// TieredStorageOptions returns the tiered storage options.
+	TieredStorageOptions() TieredStorageOptions
+}
+
+// TieredStorageOptions configures offloading sealed data filesets to a blob
+// store, tiered storage is disabled when the store is nil.
+type TieredStorageOptions struct {
+	// Store is the blob store filesets are offloaded to.
+	Store blob.Store
+
+	// HostID is the ID of the host, the blob store keys of offloaded files
+	// are prefixed with it so that hosts sharing a store never overwrite or
+	// clean up the files of another host.
+	HostID string
+
+	// OffloadAfter is how long after a block ends its fileset is offloaded.
+	OffloadAfter time.Duration
+
+	// CacheTTL is how long local copies of offloaded files are retained
+	// after they were last opened before they are deleted.
+	CacheTTL time.Duration
+}
+
+// Enabled returns whether tiered storage is enabled.
+func (o TieredStorageOptions) Enabled() bool {
+	return o.Store != nil
 }
 
 // BlockRetrieverOptions represents the options for block retrieval.
@@ -613,6 +644,10 @@ type MergeWith interface {
 		fn ForEachRemainingFn,
 		nsCtx namespace.Context,
 	) error
+
+	// DeletedRanges returns the time ranges of the series that have been
+	// deleted and must be dropped from the merged fileset, nil if none.
+	DeletedRanges(seriesID ident.ID) xtime.Ranges
 }
 
 // Merger is in charge of merging filesets with some target MergeWith interface.
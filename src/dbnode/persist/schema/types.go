@@ -116,6 +116,9 @@ type LogEntry struct {
 	Value      float64
 	Unit       uint32
 	Annotation []byte
+	// TombstoneEnd is set for entries that delete the series datapoints
+	// in [Timestamp, TombstoneEnd) rather than write a datapoint.
+	TombstoneEnd int64
 }
 
 // LogMetadata stores metadata information about a commit log.
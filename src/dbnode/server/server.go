@@ -481,6 +481,13 @@ func Run(runOpts RunOptions) {
 		SetDiskSeriesReadLimitOpts(diskSeriesReadLimit).
 		SetAggregateDocsLimitOpts(aggDocsLimit).
 		SetInstrumentOptions(iOpts)
+	if seriesLimits := cfg.Limits.SeriesLimits; len(seriesLimits) > 0 {
+		seriesLimitsOpts := make(map[string]limits.SeriesLimitsOptions, len(seriesLimits))
+		for _, nsLimits := range seriesLimits {
+			seriesLimitsOpts[nsLimits.Namespace] = nsLimits.SeriesLimitsOptions()
+		}
+		limitOpts = limitOpts.SetSeriesLimitsOpts(seriesLimitsOpts)
+	}
 	if builder := opts.SourceLoggerBuilder(); builder != nil {
 		limitOpts = limitOpts.SetSourceLoggerBuilder(builder)
 	}
@@ -640,6 +647,21 @@ func Run(runOpts RunOptions) {
 		SetIndexBloomFilterFalsePositivePercent(cfg.Filesystem.BloomFilterFalsePositivePercentOrDefault()).
 		SetMmapReporter(mmapReporter)
 
+	if tieredStorageCfg := cfg.Filesystem.TieredStorage; tieredStorageCfg != nil {
+		tieredStorageOpts, err := tieredStorageCfg.NewOptions()
+		if err != nil {
+			logger.Fatal("could not create tiered storage options", zap.Error(err))
+		}
+		tieredStorageOpts.HostID = hostID
+		if tieredStorageOpts.Enabled() {
+			logger.Info("tiered storage enabled",
+				zap.String("backend", string(tieredStorageCfg.Backend)),
+				zap.Duration("offloadAfter", tieredStorageOpts.OffloadAfter),
+				zap.Duration("cacheTTL", tieredStorageOpts.CacheTTL))
+		}
+		fsopts = fsopts.SetTieredStorageOptions(tieredStorageOpts)
+	}
+
 	var commitLogQueueSize int
 	cfgCommitLog := cfg.CommitLogOrDefault()
 	specified := cfgCommitLog.Queue.Size
@@ -680,14 +702,21 @@ func Run(runOpts RunOptions) {
 	}
 
 	opts = withEncodingAndPoolingOptions(cfg, logger, opts, poolingPolicy)
-	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().
+	commitLogOpts := opts.CommitLogOptions().
 		SetInstrumentOptions(opts.InstrumentOptions()).
 		SetFilesystemOptions(fsopts).
 		SetStrategy(commitlog.StrategyWriteBehind).
 		SetFlushSize(cfgCommitLog.FlushMaxBytes).
 		SetFlushInterval(cfgCommitLog.FlushEvery).
 		SetBacklogQueueSize(commitLogQueueSize).
-		SetBacklogQueueChannelSize(commitLogQueueChannelSize))
+		SetBacklogQueueChannelSize(commitLogQueueChannelSize).
+		SetCompression(cfgCommitLog.Compression)
+	if groupCommit := cfgCommitLog.GroupCommit; groupCommit != nil {
+		commitLogOpts = commitLogOpts.
+			SetStrategy(commitlog.StrategyWriteGroupCommit).
+			SetGroupCommitMaxLatency(groupCommit.MaxLatency)
+	}
+	opts = opts.SetCommitLogOptions(commitLogOpts)
 
 	// Setup the block retriever
 	switch seriesCachePolicy {
@@ -982,7 +1011,8 @@ func Run(runOpts RunOptions) {
 				SetStrategy(repairCfg.Strategy).
 				SetForce(repairCfg.Force).
 				SetResultOptions(rsOpts).
-				SetDebugShadowComparisonsEnabled(cfg.Repair.DebugShadowComparisonsEnabled)
+				SetDebugShadowComparisonsEnabled(cfg.Repair.DebugShadowComparisonsEnabled).
+				SetMerkleTreeEnabled(cfg.Repair.MerkleTreeEnabled)
 			if cfg.Repair.Throttle > 0 {
 				repairOpts = repairOpts.SetRepairThrottle(cfg.Repair.Throttle)
 			}
@@ -992,6 +1022,9 @@ func Run(runOpts RunOptions) {
 			if cfg.Repair.Concurrency > 0 {
 				repairOpts = repairOpts.SetRepairShardConcurrency(cfg.Repair.Concurrency)
 			}
+			if cfg.Repair.MerkleTreeDepth > 0 {
+				repairOpts = repairOpts.SetMerkleTreeDepth(cfg.Repair.MerkleTreeDepth)
+			}
 
 			if cfg.Repair.DebugShadowComparisonsPercentage > 0 {
 				// Set conditionally to avoid stomping on the default value of 1.0.
@@ -1382,6 +1415,22 @@ func updateQueryLimits(
 		if dynamicOpts.MaxRecentlyQueriedMetadataRead != nil {
 			aggDocsLimitOpts = dynamicLimitToLimitOpts(dynamicOpts.MaxRecentlyQueriedMetadataRead)
 		}
+		if len(dynamicOpts.Tenants) > 0 {
+			docsLimitOpts.TenantLimits = make(map[string]int64, len(dynamicOpts.Tenants))
+			bytesReadLimitOpts.TenantLimits = make(map[string]int64, len(dynamicOpts.Tenants))
+			diskSeriesReadLimitOpts.TenantLimits = make(map[string]int64, len(dynamicOpts.Tenants))
+			aggDocsLimitOpts.TenantLimits = make(map[string]int64, len(dynamicOpts.Tenants))
+			for _, tenant := range dynamicOpts.Tenants {
+				if tenant == nil || tenant.Source == "" {
+					logger.Warn("skipping tenant query limits without source")
+					continue
+				}
+				docsLimitOpts.TenantLimits[tenant.Source] = tenant.MaxRecentlyQueriedSeriesBlocks
+				bytesReadLimitOpts.TenantLimits[tenant.Source] = tenant.MaxRecentlyQueriedSeriesDiskBytesRead
+				diskSeriesReadLimitOpts.TenantLimits[tenant.Source] = tenant.MaxRecentlyQueriedSeriesDiskRead
+				aggDocsLimitOpts.TenantLimits[tenant.Source] = tenant.MaxRecentlyQueriedMetadataRead
+			}
+		}
 	}
 
 	if err := updateQueryLimit(docsLimit, docsLimitOpts); err != nil {
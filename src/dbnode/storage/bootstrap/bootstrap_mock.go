@@ -872,6 +872,18 @@ func (m *MockSeriesRef) EXPECT() *MockSeriesRefMockRecorder {
 	return m.recorder
 }
 
+// DeleteRange mocks base method.
+func (m *MockSeriesRef) DeleteRange(r time.Range) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteRange", r)
+}
+
+// DeleteRange indicates an expected call of DeleteRange.
+func (mr *MockSeriesRefMockRecorder) DeleteRange(r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRange", reflect.TypeOf((*MockSeriesRef)(nil).DeleteRange), r)
+}
+
 // LoadBlock mocks base method.
 func (m *MockSeriesRef) LoadBlock(block block.DatabaseBlock, writeType series.WriteType) error {
 	m.ctrl.T.Helper()
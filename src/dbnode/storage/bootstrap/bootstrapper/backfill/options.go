@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package backfill implements adopting filesets written by the backfill tool
+// into namespaces when bootstrapping.
+package backfill
+
+import (
+	"errors"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+var (
+	errNoResultOptions     = errors.New("result options not set")
+	errNoInstrumentOptions = errors.New("instrument options not set")
+	errNoFilesystemOptions = errors.New("filesystem options not set")
+	errNoDirectory         = errors.New("backfill directory not set")
+)
+
+type options struct {
+	resultOpts result.Options
+	iOpts      instrument.Options
+	fsOpts     fs.Options
+	directory  string
+}
+
+// NewOptions creates a new Options.
+func NewOptions() Options {
+	return &options{
+		resultOpts: result.NewOptions(),
+		iOpts:      instrument.NewOptions(),
+		fsOpts:     fs.NewOptions(),
+	}
+}
+
+func (o *options) Validate() error {
+	if o.resultOpts == nil {
+		return errNoResultOptions
+	}
+	if o.iOpts == nil {
+		return errNoInstrumentOptions
+	}
+	if o.fsOpts == nil {
+		return errNoFilesystemOptions
+	}
+	if o.directory == "" {
+		return errNoDirectory
+	}
+	return nil
+}
+
+func (o *options) SetResultOptions(value result.Options) Options {
+	opts := *o
+	opts.resultOpts = value
+	return &opts
+}
+
+func (o *options) ResultOptions() result.Options {
+	return o.resultOpts
+}
+
+func (o *options) SetInstrumentOptions(value instrument.Options) Options {
+	opts := *o
+	opts.iOpts = value
+	return &opts
+}
+
+func (o *options) InstrumentOptions() instrument.Options {
+	return o.iOpts
+}
+
+func (o *options) SetFilesystemOptions(value fs.Options) Options {
+	opts := *o
+	opts.fsOpts = value
+	return &opts
+}
+
+func (o *options) FilesystemOptions() fs.Options {
+	return o.fsOpts
+}
+
+func (o *options) SetDirectory(value string) Options {
+	opts := *o
+	opts.directory = value
+	return &opts
+}
+
+func (o *options) Directory() string {
+	return o.directory
+}
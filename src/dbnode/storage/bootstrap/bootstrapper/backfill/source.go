@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backfill
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/x/context"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// The backfillSource adopts the complete filesets written beneath the
+// backfill directory for the shards owned by the node by moving them into the
+// namespace, it does not load any data itself. All ranges are returned as
+// unfulfilled so that the filesystem bootstrapper which follows it loads the
+// adopted filesets. Blocks that already have a data fileset are never
+// adopted, and index filesets are only adopted when all of their shards'
+// data filesets were, otherwise the index is built from the data filesets.
+type backfillSource struct {
+	opts Options
+	log  *zap.Logger
+}
+
+func newBackfillSource(opts Options) bootstrap.Source {
+	return &backfillSource{
+		opts: opts,
+		log:  opts.InstrumentOptions().Logger(),
+	}
+}
+
+func (s *backfillSource) AvailableData(
+	_ namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+	_ bootstrap.Cache,
+	_ bootstrap.RunOptions,
+) (result.ShardTimeRanges, error) {
+	return shardsTimeRanges, nil
+}
+
+func (s *backfillSource) AvailableIndex(
+	_ namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+	_ bootstrap.Cache,
+	_ bootstrap.RunOptions,
+) (result.ShardTimeRanges, error) {
+	return shardsTimeRanges, nil
+}
+
+func (s *backfillSource) Read(
+	_ context.Context,
+	namespaces bootstrap.Namespaces,
+	cache bootstrap.Cache,
+) (bootstrap.NamespaceResults, error) {
+	results := bootstrap.NamespaceResults{
+		Results: bootstrap.NewNamespaceResultsMap(bootstrap.NamespaceResultsMapOptions{}),
+	}
+
+	adopted := 0
+	for _, elem := range namespaces.Namespaces.Iter() {
+		ns := elem.Value()
+
+		n, err := s.adopt(ns)
+		if err != nil {
+			return bootstrap.NamespaceResults{}, err
+		}
+		adopted += n
+
+		namespaceResult := bootstrap.NamespaceResult{
+			Metadata:   ns.Metadata,
+			Shards:     ns.Shards,
+			DataResult: ns.DataRunOptions.ShardTimeRanges.ToUnfulfilledDataResult(),
+		}
+		if ns.Metadata.Options().IndexOptions().Enabled() {
+			namespaceResult.IndexResult = ns.IndexRunOptions.ShardTimeRanges.ToUnfulfilledIndexResult()
+		}
+		results.Results.Set(ns.Metadata.ID(), namespaceResult)
+	}
+
+	if adopted > 0 {
+		// Make the adopted filesets visible to the following bootstrappers.
+		cache.Evict()
+	}
+	return results, nil
+}
+
+func (s *backfillSource) adopt(ns bootstrap.Namespace) (int, error) {
+	var (
+		fsOpts        = s.opts.FilesystemOptions()
+		prefix        = fsOpts.FilePathPrefix()
+		dir           = s.opts.Directory()
+		id            = ns.Metadata.ID()
+		logger        = s.log.With(zap.String("namespace", id.String()))
+		adopted       = 0
+		adoptedShards = make(map[uint32]bool, len(ns.Shards))
+	)
+	for _, shard := range ns.Shards {
+		staged, err := fs.DataFiles(dir, id, shard)
+		if err != nil {
+			return 0, err
+		}
+		if len(staged) == 0 {
+			continue
+		}
+		existing, err := fs.DataFiles(prefix, id, shard)
+		if err != nil {
+			return 0, err
+		}
+
+		complete := true
+		for _, blockStart := range blockStarts(staged) {
+			fileset, ok := staged.LatestVolumeForBlock(blockStart)
+			if !ok {
+				complete = false
+				continue
+			}
+			if _, ok := existing.LatestVolumeForBlock(blockStart); ok {
+				logger.Warn("not adopting backfill fileset, block already has data",
+					zap.Uint32("shard", shard),
+					zap.Time("blockStart", blockStart.ToTime()))
+				complete = false
+				continue
+			}
+
+			shardDir := fs.ShardDataDirPath(prefix, id, shard)
+			volume := nextVolumeIndex(existing, blockStart)
+			if err := fs.MoveFileSet(fileset, shardDir, volume, fsOpts); err != nil {
+				return 0, err
+			}
+			adopted++
+		}
+		adoptedShards[shard] = complete
+	}
+
+	if ns.Metadata.Options().IndexOptions().Enabled() {
+		infoFiles := fs.ReadIndexInfoFiles(fs.ReadIndexInfoFilesOptions{
+			FilePathPrefix:   dir,
+			Namespace:        id,
+			ReaderBufferSize: fsOpts.InfoReaderBufferSize(),
+		})
+		for _, infoFile := range infoFiles {
+			if infoFile.Err.Error() != nil || !allAdopted(infoFile.Info.Shards, adoptedShards) {
+				continue
+			}
+
+			blockStart := infoFile.ID.BlockStart
+			volume, err := fs.NextIndexFileSetVolumeIndex(prefix, id, blockStart)
+			if err != nil {
+				return 0, err
+			}
+			fileset := fs.FileSetFile{
+				ID:                infoFile.ID,
+				AbsoluteFilePaths: infoFile.AbsoluteFilePaths,
+			}
+			indexDir := fs.NamespaceIndexDataDirPath(prefix, id)
+			if err := fs.MoveFileSet(fileset, indexDir, volume, fsOpts); err != nil {
+				return 0, err
+			}
+			adopted++
+		}
+	}
+
+	if adopted > 0 {
+		logger.Info("adopted backfill filesets", zap.Int("filesets", adopted))
+	}
+	return adopted, nil
+}
+
+func blockStarts(files fs.FileSetFilesSlice) []xtime.UnixNano {
+	var (
+		result []xtime.UnixNano
+		seen   = make(map[xtime.UnixNano]struct{}, len(files))
+	)
+	for _, file := range files {
+		if _, ok := seen[file.ID.BlockStart]; ok {
+			continue
+		}
+		seen[file.ID.BlockStart] = struct{}{}
+		result = append(result, file.ID.BlockStart)
+	}
+	return result
+}
+
+func nextVolumeIndex(files fs.FileSetFilesSlice, blockStart xtime.UnixNano) int {
+	next := 0
+	for _, file := range files {
+		if file.ID.BlockStart == blockStart && file.ID.VolumeIndex >= next {
+			next = file.ID.VolumeIndex + 1
+		}
+	}
+	return next
+}
+
+func allAdopted(shards []uint32, adoptedShards map[uint32]bool) bool {
+	for _, shard := range shards {
+		if !adoptedShards[shard] {
+			return false
+		}
+	}
+	return len(shards) > 0
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backfill
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/backfill"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var (
+	testNamespaceID    = ident.StringID("testnamespace")
+	testDefaultRunOpts = bootstrap.NewRunOptions()
+	testBlockSize      = 2 * time.Hour
+)
+
+func newTestNamespace(t *testing.T) namespace.Metadata {
+	md, err := namespace.NewMetadata(testNamespaceID, namespace.NewOptions().
+		SetRetentionOptions(retention.NewOptions().SetBlockSize(testBlockSize)).
+		SetIndexOptions(namespace.NewIndexOptions().
+			SetEnabled(true).
+			SetBlockSize(testBlockSize)))
+	require.NoError(t, err)
+	return md
+}
+
+func writeTestData(
+	t *testing.T,
+	md namespace.Metadata,
+	fsOpts fs.Options,
+	blockStarts ...xtime.UnixNano,
+) {
+	w, err := backfill.NewWriter(backfill.Options{
+		Namespace:         md,
+		NumShards:         1,
+		FilesystemOptions: fsOpts,
+	})
+	require.NoError(t, err)
+	for _, blockStart := range blockStarts {
+		w.Write(ident.StringID("foo"), ident.NewTags(ident.StringTag("city", "nyc")),
+			ts.Datapoint{TimestampNanos: blockStart, Value: 1})
+	}
+	_, err = w.Flush()
+	require.NoError(t, err)
+}
+
+func TestBackfillSourceRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backfill-bootstrapper")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		md         = newTestNamespace(t)
+		stagedOpts = fs.NewOptions().SetFilePathPrefix(filepath.Join(dir, "backfill"))
+		destOpts   = fs.NewOptions().SetFilePathPrefix(filepath.Join(dir, "data"))
+		start      = xtime.Now().Truncate(testBlockSize).Add(-4 * testBlockSize)
+		next       = start.Add(testBlockSize)
+	)
+	writeTestData(t, md, stagedOpts, start, next)
+	// The first block already has data so it is not adopted.
+	writeTestData(t, md, destOpts, start)
+
+	src := newBackfillSource(NewOptions().
+		SetFilesystemOptions(destOpts).
+		SetDirectory(stagedOpts.FilePathPrefix()))
+
+	ranges := result.NewShardTimeRanges().Set(0, xtime.NewRanges(xtime.Range{
+		Start: start,
+		End:   next.Add(testBlockSize),
+	}))
+	tester := bootstrap.BuildNamespacesTesterWithFilesystemOptions(t,
+		testDefaultRunOpts, ranges, destOpts, md)
+	defer tester.Finish()
+
+	tester.TestReadWith(src)
+	tester.TestUnfulfilledForNamespace(md, ranges, ranges)
+	tester.EnsureNoLoadedBlocks()
+	tester.EnsureNoWrites()
+
+	staged, err := fs.DataFiles(stagedOpts.FilePathPrefix(), testNamespaceID, 0)
+	require.NoError(t, err)
+	require.Len(t, staged, 1)
+	require.Equal(t, start, staged[0].ID.BlockStart)
+
+	files, err := fs.DataFiles(destOpts.FilePathPrefix(), testNamespaceID, 0)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	adopted, ok := files.LatestVolumeForBlock(next)
+	require.True(t, ok)
+	require.Equal(t, 0, adopted.ID.VolumeIndex)
+
+	// Index filesets are not adopted since the shard had a conflicting block.
+	infoFiles := fs.ReadIndexInfoFiles(fs.ReadIndexInfoFilesOptions{
+		FilePathPrefix:   stagedOpts.FilePathPrefix(),
+		Namespace:        testNamespaceID,
+		ReaderBufferSize: stagedOpts.InfoReaderBufferSize(),
+	})
+	require.Len(t, infoFiles, 2)
+
+	// The adopted fileset is visible to the following bootstrappers.
+	infoFilesForShard, err := tester.Cache.InfoFilesForShard(md, 0)
+	require.NoError(t, err)
+	require.Len(t, infoFilesForShard, 2)
+}
+
+func TestBackfillSourceReadAdoptsIndex(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backfill-bootstrapper")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		md         = newTestNamespace(t)
+		stagedOpts = fs.NewOptions().SetFilePathPrefix(filepath.Join(dir, "backfill"))
+		destOpts   = fs.NewOptions().SetFilePathPrefix(filepath.Join(dir, "data"))
+		start      = xtime.Now().Truncate(testBlockSize).Add(-4 * testBlockSize)
+	)
+	writeTestData(t, md, stagedOpts, start)
+
+	src := newBackfillSource(NewOptions().
+		SetFilesystemOptions(destOpts).
+		SetDirectory(stagedOpts.FilePathPrefix()))
+
+	ranges := result.NewShardTimeRanges().Set(0, xtime.NewRanges(xtime.Range{
+		Start: start,
+		End:   start.Add(testBlockSize),
+	}))
+	tester := bootstrap.BuildNamespacesTesterWithFilesystemOptions(t,
+		testDefaultRunOpts, ranges, destOpts, md)
+	defer tester.Finish()
+
+	tester.TestReadWith(src)
+
+	infoFiles := fs.ReadIndexInfoFiles(fs.ReadIndexInfoFilesOptions{
+		FilePathPrefix:   destOpts.FilePathPrefix(),
+		Namespace:        testNamespaceID,
+		ReaderBufferSize: destOpts.InfoReaderBufferSize(),
+	})
+	require.Len(t, infoFiles, 1)
+	require.NoError(t, infoFiles[0].Err.Error())
+	require.Equal(t, []uint32{0}, infoFiles[0].Info.Shards)
+
+	staged, err := fs.DataFiles(stagedOpts.FilePathPrefix(), testNamespaceID, 0)
+	require.NoError(t, err)
+	require.Empty(t, staged)
+}
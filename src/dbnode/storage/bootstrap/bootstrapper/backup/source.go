@@ -0,0 +1,169 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/backup"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/x/context"
+)
+
+const (
+	restoredMarkerDirName    = "backups"
+	restoredMarkerFileSuffix = ".restored"
+)
+
+// The backupSource restores the shards owned by the node from a backup onto
+// the filesystem, it does not load any data itself. All ranges are returned
+// as unfulfilled so that the filesystem bootstrapper which follows it loads
+// the restored filesets. A marker file is written once a namespace has been
+// restored so that it is only ever restored once, otherwise filesets removed
+// by retention would be restored again on each restart.
+type backupSource struct {
+	opts Options
+	log  *zap.Logger
+}
+
+func newBackupSource(opts Options) bootstrap.Source {
+	return &backupSource{
+		opts: opts,
+		log:  opts.InstrumentOptions().Logger(),
+	}
+}
+
+func (s *backupSource) AvailableData(
+	_ namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+	_ bootstrap.Cache,
+	_ bootstrap.RunOptions,
+) (result.ShardTimeRanges, error) {
+	return shardsTimeRanges, nil
+}
+
+func (s *backupSource) AvailableIndex(
+	_ namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+	_ bootstrap.Cache,
+	_ bootstrap.RunOptions,
+) (result.ShardTimeRanges, error) {
+	return shardsTimeRanges, nil
+}
+
+func (s *backupSource) Read(
+	_ context.Context,
+	namespaces bootstrap.Namespaces,
+	cache bootstrap.Cache,
+) (bootstrap.NamespaceResults, error) {
+	results := bootstrap.NamespaceResults{
+		Results: bootstrap.NewNamespaceResultsMap(bootstrap.NamespaceResultsMapOptions{}),
+	}
+
+	restored := false
+	for _, elem := range namespaces.Namespaces.Iter() {
+		ns := elem.Value()
+
+		ok, err := s.restore(ns)
+		if err != nil {
+			return bootstrap.NamespaceResults{}, err
+		}
+		restored = restored || ok
+
+		namespaceResult := bootstrap.NamespaceResult{
+			Metadata:   ns.Metadata,
+			Shards:     ns.Shards,
+			DataResult: ns.DataRunOptions.ShardTimeRanges.ToUnfulfilledDataResult(),
+		}
+		if ns.Metadata.Options().IndexOptions().Enabled() {
+			namespaceResult.IndexResult = ns.IndexRunOptions.ShardTimeRanges.ToUnfulfilledIndexResult()
+		}
+		results.Results.Set(ns.Metadata.ID(), namespaceResult)
+	}
+
+	if restored {
+		// Make the restored filesets visible to the following bootstrappers.
+		cache.Evict()
+	}
+	return results, nil
+}
+
+func (s *backupSource) restore(ns bootstrap.Namespace) (bool, error) {
+	var (
+		fsOpts     = s.opts.FilesystemOptions()
+		id         = ns.Metadata.ID()
+		markerDir  = filepath.Join(fsOpts.FilePathPrefix(), restoredMarkerDirName, s.opts.Name())
+		markerPath = filepath.Join(markerDir, id.String()+restoredMarkerFileSuffix)
+	)
+	exists, err := fs.FileExists(markerPath)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+
+	sourceNamespace := id.String()
+	if source, ok := s.opts.SourceNamespaces()[sourceNamespace]; ok {
+		sourceNamespace = source
+	}
+
+	logger := s.log.With(
+		zap.String("backup", s.opts.Name()),
+		zap.String("namespace", id.String()),
+		zap.String("sourceNamespace", sourceNamespace))
+	logger.Info("restoring namespace from backup", zap.Uint32s("shards", ns.Shards))
+
+	res, err := backup.Restore(backup.RestoreOptions{
+		Name:              s.opts.Name(),
+		SourceNamespace:   sourceNamespace,
+		Namespace:         id,
+		Shards:            ns.Shards,
+		Store:             s.opts.Store(),
+		FilesystemOptions: fsOpts,
+	})
+	if errors.Is(err, backup.ErrNotFound) {
+		logger.Warn("no backup found for namespace, skipping restore")
+	} else if err != nil {
+		return false, err
+	} else {
+		logger.Info("restored namespace from backup",
+			zap.Strings("nodes", res.NodeIDs),
+			zap.Uint32s("shards", res.Shards),
+			zap.Int("filesRestored", res.FilesRestored),
+			zap.Bool("indexRestored", res.IndexRestored))
+	}
+
+	if err := os.MkdirAll(markerDir, fsOpts.NewDirectoryMode()); err != nil {
+		return false, err
+	}
+	if err := os.WriteFile(markerPath, nil, fsOpts.NewFileMode()); err != nil {
+		return false, err
+	}
+	return res.FilesRestored > 0, nil
+}
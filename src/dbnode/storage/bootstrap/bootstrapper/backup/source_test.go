@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/backup"
+	"github.com/m3db/m3/src/dbnode/persist/fs/blob"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var (
+	testNamespaceID    = ident.StringID("testnamespace")
+	testSourceID       = ident.StringID("sourcenamespace")
+	testDefaultRunOpts = bootstrap.NewRunOptions()
+	testBlockSize      = 2 * time.Hour
+)
+
+func TestBackupSourceRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup-bootstrapper")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := blob.NewDirectoryStore(filepath.Join(dir, "blobs"))
+	require.NoError(t, err)
+
+	var (
+		srcOpts    = fs.NewOptions().SetFilePathPrefix(filepath.Join(dir, "src"))
+		destOpts   = fs.NewOptions().SetFilePathPrefix(filepath.Join(dir, "dest"))
+		blockStart = xtime.Now().Truncate(testBlockSize).Add(-testBlockSize)
+	)
+	writeTestData(t, srcOpts, testSourceID, 0, blockStart)
+	writeTestData(t, srcOpts, testSourceID, 1, blockStart)
+
+	_, err = backup.Backup(backup.Options{
+		Name:              "nightly",
+		NodeID:            "node-a",
+		Namespace:         testSourceID,
+		Store:             store,
+		FilesystemOptions: srcOpts,
+	})
+	require.NoError(t, err)
+
+	opts := NewOptions().
+		SetFilesystemOptions(destOpts).
+		SetStore(store).
+		SetName("nightly").
+		SetSourceNamespaces(map[string]string{
+			testNamespaceID.String(): testSourceID.String(),
+		})
+	require.NoError(t, opts.Validate())
+	src := newBackupSource(opts)
+
+	md, err := namespace.NewMetadata(testNamespaceID, namespace.NewOptions())
+	require.NoError(t, err)
+
+	ranges := result.NewShardTimeRanges().Set(0, xtime.NewRanges(xtime.Range{
+		Start: blockStart,
+		End:   blockStart.Add(testBlockSize),
+	}))
+	tester := bootstrap.BuildNamespacesTesterWithFilesystemOptions(t,
+		testDefaultRunOpts, ranges, destOpts, md)
+	defer tester.Finish()
+
+	tester.TestReadWith(src)
+	tester.TestUnfulfilledForNamespace(md, ranges, ranges)
+	tester.EnsureNoLoadedBlocks()
+	tester.EnsureNoWrites()
+
+	// Only the shards owned by the node are restored.
+	files, err := fs.DataFiles(destOpts.FilePathPrefix(), testNamespaceID, 0)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.True(t, files[0].HasCompleteCheckpointFile())
+
+	shard1Files, err := fs.DataFiles(destOpts.FilePathPrefix(), testNamespaceID, 1)
+	require.NoError(t, err)
+	require.Empty(t, shard1Files)
+
+	// The restored filesets are visible to the following bootstrappers.
+	infoFiles, err := tester.Cache.InfoFilesForShard(md, 0)
+	require.NoError(t, err)
+	require.Len(t, infoFiles, 1)
+
+	// A namespace is only ever restored once.
+	require.NoError(t, fs.DeleteFiles(files[0].AbsoluteFilePaths))
+	tester.TestReadWith(src)
+	files, err = fs.DataFiles(destOpts.FilePathPrefix(), testNamespaceID, 0)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestBackupSourceReadNoBackup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup-bootstrapper")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := blob.NewDirectoryStore(filepath.Join(dir, "blobs"))
+	require.NoError(t, err)
+
+	destOpts := fs.NewOptions().SetFilePathPrefix(filepath.Join(dir, "dest"))
+	src := newBackupSource(NewOptions().
+		SetFilesystemOptions(destOpts).
+		SetStore(store).
+		SetName("nightly"))
+
+	md, err := namespace.NewMetadata(testNamespaceID, namespace.NewOptions())
+	require.NoError(t, err)
+
+	start := xtime.Now().Truncate(testBlockSize)
+	ranges := result.NewShardTimeRanges().Set(0, xtime.NewRanges(xtime.Range{
+		Start: start,
+		End:   start.Add(testBlockSize),
+	}))
+	tester := bootstrap.BuildNamespacesTesterWithFilesystemOptions(t,
+		testDefaultRunOpts, ranges, destOpts, md)
+	defer tester.Finish()
+
+	tester.TestReadWith(src)
+	tester.TestUnfulfilledForNamespace(md, ranges, ranges)
+}
+
+func writeTestData(
+	t *testing.T,
+	opts fs.Options,
+	namespace ident.ID,
+	shard uint32,
+	blockStart xtime.UnixNano,
+) {
+	w, err := fs.NewWriter(opts)
+	require.NoError(t, err)
+	require.NoError(t, w.Open(fs.DataWriterOpenOptions{
+		BlockSize: testBlockSize,
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  namespace,
+			Shard:      shard,
+			BlockStart: blockStart,
+		},
+	}))
+
+	data := checked.NewBytes([]byte{1, 2, 3}, nil)
+	data.IncRef()
+	defer data.DecRef()
+	metadata := persist.NewMetadataFromIDAndTags(ident.StringID("foo"),
+		ident.Tags{}, persist.MetadataOptions{})
+	require.NoError(t, w.Write(metadata, data, 1234))
+	require.NoError(t, w.Close())
+}
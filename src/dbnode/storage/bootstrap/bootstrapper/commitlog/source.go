@@ -90,6 +90,7 @@ type accumulateArg struct {
 	shard     uint32
 	dp        ts.Datapoint
 	unit      xtime.Unit
+	tombstone xtime.Range
 
 	// longAnnotation stores the annotation value in case it does not fit in shortAnnotation.
 	longAnnotation ts.Annotation
@@ -674,6 +675,7 @@ func (s *commitLogSource) readCommitLog(namespaces bootstrap.Namespaces, span op
 			shard:     seriesEntry.series.Shard,
 			dp:        entry.Datapoint,
 			unit:      entry.Unit,
+			tombstone: entry.Tombstone,
 		}
 
 		annotationLen := len(entry.Annotation)
@@ -1091,6 +1093,13 @@ func (s *commitLogSource) startAccumulateWorker(worker *accumulateWorker) {
 			continue
 		}
 
+		if !input.tombstone.IsEmpty() {
+			// Tombstones are replayed so that data deleted before the node
+			// restarted stays hidden until the affected blocks are rewritten.
+			ref.DeleteRange(input.tombstone)
+			continue
+		}
+
 		_, _, err = ref.Write(ctx, dp.TimestampNanos, dp.Value,
 			unit, annotation, series.WriteOptions{
 				SchemaDesc:         namespace.namespaceContext.Schema,
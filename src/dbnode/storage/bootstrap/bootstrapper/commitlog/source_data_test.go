@@ -235,6 +235,52 @@ func testReadOrderedValues(t *testing.T, opts Options, md namespace.Metadata, se
 	tester.EnsureNoLoadedBlocks()
 }
 
+func TestReadTombstones(t *testing.T) {
+	opts := testDefaultOpts
+	md := testNsMetadata(t)
+	nsCtx := namespace.NewContextFrom(md)
+
+	src := newCommitLogSource(opts, fs.Inspection{}).(*commitLogSource)
+
+	blockSize := md.Options().RetentionOptions().BlockSize()
+	now := xtime.Now()
+	start := now.Truncate(blockSize).Add(-blockSize)
+	end := now.Truncate(blockSize)
+
+	ranges := xtime.NewRanges(xtime.Range{Start: start, End: end})
+
+	foo := ts.Series{Namespace: nsCtx.ID, Shard: 0, ID: ident.StringID("foo")}
+	deleted := xtime.Range{Start: start, End: start.Add(2 * time.Minute)}
+
+	values := testValues{
+		{foo, start, 1.0, xtime.Second, nil},
+		{foo, deleted.Start, 0, xtime.None, nil},
+		{foo, start.Add(3 * time.Minute), 2.0, xtime.Second, nil},
+	}
+
+	src.newIteratorFn = func(
+		_ commitlog.IteratorOpts,
+	) (commitlog.Iterator, []commitlog.ErrorWithPath, error) {
+		iter := newTestCommitLogIterator(values, nil)
+		iter.tombstones = map[int]xtime.Range{1: deleted}
+		return iter, nil, nil
+	}
+
+	targetRanges := result.NewShardTimeRanges().Set(0, ranges)
+	tester := bootstrap.BuildNamespacesTester(t, testDefaultRunOpts, targetRanges, md)
+	defer tester.Finish()
+
+	tester.TestReadWith(src)
+	tester.TestUnfulfilledForNamespaceIsEmpty(md)
+
+	read := tester.EnsureDumpWritesForNamespace(md)
+	require.Equal(t, 1, len(read))
+	enforceValuesAreCorrect(t, testValues{values[0], values[2]}, read)
+
+	deletes := tester.EnsureDumpDeletesForNamespace(md)
+	require.Equal(t, map[string][]xtime.Range{"foo": {deleted}}, deletes)
+}
+
 func TestReadUnorderedValues(t *testing.T) {
 	opts := testDefaultOpts
 	md := testNsMetadata(t)
@@ -548,6 +594,9 @@ type testCommitLogIterator struct {
 	idx    int
 	err    error
 	closed bool
+	// tombstones are the ranges returned as tombstones instead of
+	// datapoints, keyed by the index of the value.
+	tombstones map[int]xtime.Range
 }
 
 func newTestCommitLogIterator(values testValues, err error) *testCommitLogIterator {
@@ -565,6 +614,16 @@ func (i *testCommitLogIterator) Current() commitlog.LogEntry {
 		idx = 0
 	}
 	v := i.values[idx]
+	if r, ok := i.tombstones[idx]; ok {
+		return commitlog.LogEntry{
+			Series:    v.s,
+			Tombstone: r,
+			Metadata: commitlog.LogEntryMetadata{
+				FileReadID:        uint64(idx) + 1,
+				SeriesUniqueIndex: v.s.UniqueIndex,
+			},
+		}
+	}
 	return commitlog.LogEntry{
 		Series:     v.s,
 		Datapoint:  ts.Datapoint{TimestampNanos: v.t, Value: v.v},
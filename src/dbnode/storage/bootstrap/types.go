@@ -493,6 +493,10 @@ type SeriesRef interface {
 		writeType series.WriteType,
 	) error
 
+	// DeleteRange marks the datapoints of the series within the range
+	// as deleted.
+	DeleteRange(r xtime.Range)
+
 	// UniqueIndex is the unique index for the series.
 	UniqueIndex() uint64
 }
@@ -69,7 +69,9 @@ type TestDataAccumulator struct {
 	schema         namespace.SchemaDescr
 	// writeMap is a map to which values are written directly.
 	writeMap DecodedBlockMap
-	results  map[string]CheckoutSeriesResult
+	// deleteMap is a map to which deleted ranges are written directly.
+	deleteMap map[string][]xtime.Range
+	results   map[string]CheckoutSeriesResult
 }
 
 // DecodedValues is a slice of series datapoints.
@@ -282,6 +284,13 @@ func (a *TestDataAccumulator) checkoutSeriesWithLock(
 				return true, series.WarmWrite, nil
 			}).AnyTimes()
 
+	mockSeries.EXPECT().DeleteRange(gomock.Any()).
+		Do(func(r xtime.Range) {
+			a.Lock()
+			a.deleteMap[stringID] = append(a.deleteMap[stringID], r)
+			a.Unlock()
+		}).AnyTimes()
+
 	result := CheckoutSeriesResult{
 		Shard:    shardID,
 		Resolver: &seriesStaticResolver{series: mockSeries},
@@ -401,6 +410,7 @@ func BuildNamespacesTesterWithReaderIteratorPool(
 			results:        make(map[string]CheckoutSeriesResult),
 			loadedBlockMap: make(ReaderMap),
 			writeMap:       make(DecodedBlockMap),
+			deleteMap:      make(map[string][]xtime.Range),
 			schema:         nsCtx.Schema,
 		}
 
@@ -512,6 +522,23 @@ func (nt *NamespacesTester) EnsureDumpWritesForNamespace(
 	return nil
 }
 
+// EnsureDumpDeletesForNamespace dumps the ranges deleted for each series
+// in a single namespace, and fails if the namespace is not found.
+func (nt *NamespacesTester) EnsureDumpDeletesForNamespace(
+	md namespace.Metadata,
+) map[string][]xtime.Range {
+	id := md.ID().String()
+	for _, acc := range nt.Accumulators {
+		if acc.ns == id {
+			return acc.deleteMap
+		}
+	}
+
+	assert.FailNow(nt.t, fmt.Sprintf("namespace with id %s not found "+
+		"valid namespaces are %v", id, nt.Namespaces))
+	return nil
+}
+
 // EnsureNoWrites ensures that no writes have been written into any of this
 // testers accumulators.
 func (nt *NamespacesTester) EnsureNoWrites() {
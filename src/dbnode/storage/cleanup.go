@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/uber-go/tally"
@@ -52,6 +53,12 @@ type snapshotFilesFn func(
 	filePathPrefix string, namespace ident.ID, shard uint32,
 ) (fs.FileSetFilesSlice, error)
 
+type dataFilesFn func(
+	filePathPrefix string, namespace ident.ID, shard uint32,
+) (fs.FileSetFilesSlice, error)
+
+type offloadDataFileSetFn func(opts fs.Options, id fs.FileSetFileIdentifier) error
+
 type deleteFilesFn func(files []string) error
 
 type deleteInactiveDirectoriesFn func(parentDirPath string, activeDirNames []string) error
@@ -75,6 +82,8 @@ type cleanupManager struct {
 	commitLogFilesFn        commitLogFilesFn
 	snapshotMetadataFilesFn snapshotMetadataFilesFn
 	snapshotFilesFn         snapshotFilesFn
+	dataFilesFn             dataFilesFn
+	offloadDataFileSetFn    offloadDataFileSetFn
 
 	deleteFilesFn               deleteFilesFn
 	deleteInactiveDirectoriesFn deleteInactiveDirectoriesFn
@@ -93,12 +102,17 @@ type cleanupManagerMetrics struct {
 	deletedCommitlogFile        tally.Counter
 	deletedSnapshotFile         tally.Counter
 	deletedSnapshotMetadataFile tally.Counter
+	offloadedFileSet            tally.Counter
+	offloadFileSetErrors        tally.Counter
+	evictedOffloadedFile        tally.Counter
+	deletedOrphanedOffloadFile  tally.Counter
 }
 
 func newCleanupManagerMetrics(scope tally.Scope) cleanupManagerMetrics {
 	clScope := scope.SubScope("commitlog")
 	sScope := scope.SubScope("snapshot")
 	smScope := scope.SubScope("snapshot-metadata")
+	oScope := scope.SubScope("offload")
 	return cleanupManagerMetrics{
 		warmFlushCleanupStatus:      scope.Gauge("warm-flush-cleanup"),
 		coldFlushCleanupStatus:      scope.Gauge("cold-flush-cleanup"),
@@ -108,6 +122,10 @@ func newCleanupManagerMetrics(scope tally.Scope) cleanupManagerMetrics {
 		deletedCommitlogFile:        clScope.Counter("deleted"),
 		deletedSnapshotFile:         sScope.Counter("deleted"),
 		deletedSnapshotMetadataFile: smScope.Counter("deleted"),
+		offloadedFileSet:            oScope.Counter("offloaded"),
+		offloadFileSetErrors:        oScope.Counter("errors"),
+		evictedOffloadedFile:        oScope.Counter("evicted"),
+		deletedOrphanedOffloadFile:  oScope.Counter("deleted-orphaned"),
 	}
 }
 
@@ -128,6 +146,8 @@ func newCleanupManager(
 		commitLogFilesFn:            commitlog.Files,
 		snapshotMetadataFilesFn:     fs.SortedSnapshotMetadataFiles,
 		snapshotFilesFn:             fs.SnapshotFiles,
+		dataFilesFn:                 fs.DataFiles,
+		offloadDataFileSetFn:        fs.OffloadDataFileSet,
 		deleteFilesFn:               fs.DeleteFiles,
 		deleteInactiveDirectoriesFn: fs.DeleteInactiveDirectories,
 		metrics:                     newCleanupManagerMetrics(scope),
@@ -212,6 +232,11 @@ func (m *cleanupManager) ColdFlushCleanup(t xtime.UnixNano) error {
 			"encountered errors when deleting inactive data files for %v: %v", t, err))
 	}
 
+	if err := m.offloadDataFiles(t, namespaces); err != nil {
+		multiErr = multiErr.Add(fmt.Errorf(
+			"encountered errors when offloading data files for %v: %v", t, err))
+	}
+
 	return multiErr.FinalError()
 }
 
@@ -376,6 +401,107 @@ func (m *cleanupManager) cleanupCompactedNamespaceDataFiles(shards []databaseSha
 	return multiErr.FinalError()
 }
 
+// offloadDataFiles uploads sealed data filesets to the tiered storage blob
+// store once their block is older than the offload age, deletes local copies
+// of offloaded files that have not been read within the cache TTL and removes
+// offloaded files whose fileset no longer exists locally. Local copies are
+// only ever deleted once a fileset is marked as offloaded, which happens after
+// its uploads have been verified against the fileset digests.
+func (m *cleanupManager) offloadDataFiles(t xtime.UnixNano, namespaces []databaseNamespace) error {
+	fsOpts := m.opts.CommitLogOptions().FilesystemOptions()
+	tieredOpts := fsOpts.TieredStorageOptions()
+	if !tieredOpts.Enabled() {
+		return nil
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, n := range namespaces {
+		if !n.Options().CleanupEnabled() {
+			continue
+		}
+		var (
+			blockSize     = n.Options().RetentionOptions().BlockSize()
+			offloadBefore = t.Add(-tieredOpts.OffloadAfter)
+		)
+		for _, s := range n.OwnedShards() {
+			if !s.IsBootstrapped() {
+				continue
+			}
+			multiErr = multiErr.Add(m.offloadShardDataFiles(
+				fsOpts, n.ID(), s.ID(), blockSize, offloadBefore, t))
+		}
+	}
+	return multiErr.FinalError()
+}
+
+func (m *cleanupManager) offloadShardDataFiles(
+	fsOpts fs.Options,
+	namespace ident.ID,
+	shard uint32,
+	blockSize time.Duration,
+	offloadBefore xtime.UnixNano,
+	t xtime.UnixNano,
+) error {
+	filesets, err := m.dataFilesFn(m.filePathPrefix, namespace, shard)
+	if err != nil {
+		return err
+	}
+
+	var (
+		multiErr      = xerrors.NewMultiError()
+		filesToDelete []string
+	)
+	for _, fileset := range filesets {
+		// Only the latest complete volume of a block is offloaded, earlier
+		// volumes are removed once a cold flush compacts them.
+		latest, ok := filesets.LatestVolumeForBlock(fileset.ID.BlockStart)
+		if !ok || latest.ID.VolumeIndex != fileset.ID.VolumeIndex {
+			continue
+		}
+
+		if !fileset.IsOffloaded() {
+			if fileset.ID.BlockStart.Add(blockSize).After(offloadBefore) {
+				continue
+			}
+			if err := m.offloadDataFileSetFn(fsOpts, fileset.ID); err != nil {
+				m.metrics.offloadFileSetErrors.Inc(1)
+				multiErr = multiErr.Add(fmt.Errorf(
+					"failed to offload fileset for ns: %s, shard: %d, block: %v, volume: %d: %w",
+					namespace, shard, fileset.ID.BlockStart, fileset.ID.VolumeIndex, err))
+				continue
+			}
+			// NB: The local copies are evicted on a subsequent pass once they
+			// fall out of the cache TTL.
+			m.metrics.offloadedFileSet.Inc(1)
+			continue
+		}
+
+		evictable, err := fs.EvictableOffloadedFiles(fsOpts, fileset, t)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		m.metrics.evictedOffloadedFile.Inc(int64(len(evictable)))
+		filesToDelete = append(filesToDelete, evictable...)
+	}
+	multiErr = multiErr.Add(m.deleteFilesFn(filesToDelete))
+
+	orphaned, err := fs.OrphanedOffloadedFiles(fsOpts, namespace, shard)
+	if err != nil {
+		return multiErr.Add(err).FinalError()
+	}
+	store := fsOpts.TieredStorageOptions().Store
+	for _, key := range orphaned {
+		if err := store.Delete(key); err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		m.metrics.deletedOrphanedOffloadFile.Inc(1)
+	}
+
+	return multiErr.FinalError()
+}
+
 // The goal of the cleanupSnapshotsAndCommitlogs function is to delete all snapshots files, snapshot metadata
 // files, and commitlog files except for those that are currently required for recovery from a node failure.
 // According to the snapshotting / commitlog rotation logic, the files that are required for a complete
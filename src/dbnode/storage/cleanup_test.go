@@ -35,6 +35,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/blob"
 	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
 	"github.com/m3db/m3/src/dbnode/retention"
 	xerrors "github.com/m3db/m3/src/x/errors"
@@ -537,6 +538,81 @@ func TestDeleteInactiveDataAndSnapshotFileSetFiles(t *testing.T) {
 	}
 }
 
+func TestCleanupManagerOffloadDataFiles(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ts := timeFor()
+	rOpts := retentionOptions.
+		SetRetentionPeriod(21600 * time.Second).
+		SetBlockSize(3600 * time.Second)
+	nsOpts := namespaceOptions.
+		SetRetentionOptions(rOpts).
+		SetCleanupEnabled(true)
+
+	shard := NewMockdatabaseShard(ctrl)
+	shard.EXPECT().ID().Return(uint32(0)).AnyTimes()
+	shard.EXPECT().IsBootstrapped().Return(true).AnyTimes()
+
+	ns := NewMockdatabaseNamespace(ctrl)
+	ns.EXPECT().ID().Return(ident.StringID("ns")).AnyTimes()
+	ns.EXPECT().Options().Return(nsOpts).AnyTimes()
+	ns.EXPECT().OwnedShards().Return([]databaseShard{shard}).AnyTimes()
+
+	namespaces := []databaseNamespace{ns}
+	db := newMockdatabase(ctrl, namespaces...)
+	mgr := newCleanupManager(db, newNoopFakeActiveLogs(), tally.NoopScope).(*cleanupManager)
+
+	store, err := blob.NewDirectoryStore(t.TempDir())
+	require.NoError(t, err)
+	fsOpts := mgr.opts.CommitLogOptions().FilesystemOptions().
+		SetTieredStorageOptions(fs.TieredStorageOptions{
+			Store:        store,
+			OffloadAfter: 2 * time.Hour,
+		})
+	mgr.opts = mgr.opts.SetCommitLogOptions(
+		mgr.opts.CommitLogOptions().SetFilesystemOptions(fsOpts))
+
+	newFileSet := func(blockStart xtime.UnixNano, volume int) fs.FileSetFile {
+		return fs.FileSetFile{
+			ID: fs.FileSetFileIdentifier{
+				Namespace:   ident.StringID("ns"),
+				BlockStart:  blockStart,
+				VolumeIndex: volume,
+			},
+			CachedHasCompleteCheckpointFile: fs.EvalTrue,
+		}
+	}
+	var (
+		blockSize = rOpts.BlockSize()
+		oldBlock  = ts.Add(-4 * blockSize)
+		edgeBlock = ts.Add(-3 * blockSize)
+		newBlock  = ts.Add(-2 * blockSize)
+	)
+	mgr.dataFilesFn = func(string, ident.ID, uint32) (fs.FileSetFilesSlice, error) {
+		return fs.FileSetFilesSlice{
+			newFileSet(oldBlock, 0),
+			newFileSet(oldBlock, 1),
+			newFileSet(edgeBlock, 0),
+			newFileSet(newBlock, 0),
+		}, nil
+	}
+
+	var offloaded []fs.FileSetFileIdentifier
+	mgr.offloadDataFileSetFn = func(_ fs.Options, id fs.FileSetFileIdentifier) error {
+		offloaded = append(offloaded, id)
+		return nil
+	}
+
+	require.NoError(t, mgr.offloadDataFiles(ts, namespaces))
+
+	// Only the latest volume of blocks that ended before the offload age are offloaded.
+	require.Len(t, offloaded, 2)
+	require.True(t, oldBlock.Equal(offloaded[0].BlockStart))
+	require.Equal(t, 1, offloaded[0].VolumeIndex)
+	require.True(t, edgeBlock.Equal(offloaded[1].BlockStart))
+}
+
 func TestCleanupManagerPropagatesOwnedNamespacesError(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
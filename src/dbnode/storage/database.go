@@ -34,6 +34,7 @@ import (
 
 	"github.com/m3db/m3/src/cluster/shard"
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/digest"
 	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
@@ -112,6 +113,8 @@ type db struct {
 	mediator databaseMediator
 	repairer databaseRepairer
 
+	tileAggregationScheduler *tileAggregationScheduler
+
 	created    uint64
 	bootstraps int
 
@@ -255,6 +258,12 @@ func NewDatabase(
 		}
 	}
 
+	d.tileAggregationScheduler = newTileAggregationScheduler(d, opts)
+	err = d.mediator.RegisterBackgroundProcess(d.tileAggregationScheduler)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, fn := range opts.BackgroundProcessFns() {
 		process, err := fn(d, opts)
 		if err != nil {
@@ -1065,6 +1074,32 @@ func (d *db) AggregateQuery(
 	return n.AggregateQuery(ctx, query, aggResultOpts)
 }
 
+func (d *db) CardinalityQuery(
+	ctx context.Context,
+	namespace ident.ID,
+	query index.Query,
+	opts index.QueryOptions,
+) (index.CardinalityQueryResult, error) {
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		d.metrics.unknownNamespaceQueryIDs.Inc(1)
+		return index.CardinalityQueryResult{}, err
+	}
+
+	ctx, sp, sampled := ctx.StartSampledTraceSpan(tracepoint.DBCardinalityQuery)
+	if sampled {
+		sp.LogFields(
+			opentracinglog.String("query", query.String()),
+			opentracinglog.String("namespace", namespace.String()),
+			xopentracing.Time("start", opts.StartInclusive.ToTime()),
+			xopentracing.Time("end", opts.EndExclusive.ToTime()),
+		)
+	}
+
+	defer sp.Finish()
+	return n.CardinalityQuery(ctx, query, opts)
+}
+
 func (d *db) ReadEncoded(
 	ctx context.Context,
 	namespace ident.ID,
@@ -1137,6 +1172,92 @@ func (d *db) FetchBlocksMetadataV2(
 		pageToken, opts)
 }
 
+func (d *db) FetchBlocksMerkleTree(
+	ctx context.Context,
+	namespace ident.ID,
+	shardID uint32,
+	start, end xtime.UnixNano,
+	depth int,
+) (digest.MerkleTree, error) {
+	if err := digest.ValidateMerkleTreeDepth(depth); err != nil {
+		return digest.MerkleTree{}, xerrors.NewInvalidParamsError(err)
+	}
+
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		d.metrics.unknownNamespaceFetchBlocksMetadata.Inc(1)
+		return digest.MerkleTree{}, xerrors.NewInvalidParamsError(err)
+	}
+
+	ctx, sp, sampled := ctx.StartSampledTraceSpan(tracepoint.DBFetchBlocksMerkleTree)
+	if sampled {
+		sp.LogFields(
+			opentracinglog.String("namespace", namespace.String()),
+			opentracinglog.Uint32("shardID", shardID),
+			xopentracing.Time("start", start.ToTime()),
+			xopentracing.Time("end", end.ToTime()),
+			opentracinglog.Int("depth", depth),
+		)
+	}
+	defer sp.Finish()
+
+	shard, _, err := n.ReadableShardAt(shardID)
+	if err != nil {
+		return digest.MerkleTree{}, err
+	}
+
+	return shard.FetchBlocksMerkleTree(ctx, start, end, depth)
+}
+
+func (d *db) FetchBlocksMerkleTreeLeavesMetadata(
+	ctx context.Context,
+	namespace ident.ID,
+	shardID uint32,
+	start, end xtime.UnixNano,
+	depth int,
+	leaves []int,
+	limit int64,
+	pageToken PageToken,
+) (block.FetchBlocksMetadataResults, PageToken, error) {
+	if err := digest.ValidateMerkleTreeDepth(depth); err != nil {
+		return nil, nil, xerrors.NewInvalidParamsError(err)
+	}
+
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		d.metrics.unknownNamespaceFetchBlocksMetadata.Inc(1)
+		return nil, nil, xerrors.NewInvalidParamsError(err)
+	}
+
+	ctx, sp, sampled := ctx.StartSampledTraceSpan(tracepoint.DBFetchBlocksMerkleTreeLeavesMetadata)
+	if sampled {
+		sp.LogFields(
+			opentracinglog.String("namespace", namespace.String()),
+			opentracinglog.Uint32("shardID", shardID),
+			xopentracing.Time("start", start.ToTime()),
+			xopentracing.Time("end", end.ToTime()),
+			opentracinglog.Int("depth", depth),
+			opentracinglog.Int64("limit", limit),
+		)
+	}
+	defer sp.Finish()
+
+	opts := block.FetchBlocksMetadataOptions{
+		IncludeSizes:     true,
+		IncludeChecksums: true,
+	}
+	metadata, nextPageToken, err := n.FetchBlocksMetadataV2(ctx, shardID, start, end,
+		limit, pageToken, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// NB: The filtered results share the metadata of the page which is
+	// closed along with the context.
+	ctx.RegisterCloser(metadata)
+	return filterBlocksMetadataByMerkleTreeLeaves(metadata, depth, leaves), nextPageToken, nil
+}
+
 func (d *db) Bootstrap() error {
 	d.Lock()
 	d.bootstraps++
@@ -1220,6 +1341,19 @@ func (d *db) Truncate(namespace ident.ID) (int64, error) {
 	return n.Truncate()
 }
 
+func (d *db) DeleteTagged(
+	ctx context.Context,
+	namespace ident.ID,
+	query index.Query,
+	start, end xtime.UnixNano,
+) (int64, error) {
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		return 0, err
+	}
+	return n.DeleteTagged(ctx, query, start, end)
+}
+
 func (d *db) IsOverloaded() bool {
 	queueSize := float64(d.commitLog.QueueLength())
 	queueCapacity := float64(d.opts.CommitLogOptions().BacklogQueueSize())
@@ -1332,6 +1466,10 @@ func (d *db) AggregateTiles(
 	return processedTileCount, err
 }
 
+func (d *db) TileAggregationStatuses() []TileAggregationStatus {
+	return d.tileAggregationScheduler.Statuses()
+}
+
 func (d *db) nextIndex() uint64 {
 	// Start with index at "1" so that a default "uniqueIndex"
 	// with "0" is invalid (AddUint64 will return the new value).
@@ -54,6 +54,10 @@ type IndexWriter interface {
 	BlockStartForWriteTime(
 		writeTime xtime.UnixNano,
 	) xtime.UnixNano
+
+	// DeleteSeries excludes the series from query results for queries
+	// that fall entirely within the deleted range.
+	DeleteSeries(id ident.ID, r xtime.Range)
 }
 
 // EntryMetrics are metrics for an entry.
@@ -533,6 +537,14 @@ func (entry *Entry) LoadBlock(
 	return entry.Series.LoadBlock(block, writeType)
 }
 
+// DeleteRange marks the datapoints of the series within the range as deleted.
+func (entry *Entry) DeleteRange(r xtime.Range) {
+	entry.Series.DeleteRange(r)
+	if entry.indexWriter != nil {
+		entry.indexWriter.DeleteSeries(entry.ID, r)
+	}
+}
+
 // UniqueIndex is the unique index for the series.
 func (entry *Entry) UniqueIndex() uint64 {
 	return entry.Series.UniqueIndex()
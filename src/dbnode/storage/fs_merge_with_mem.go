@@ -143,3 +143,7 @@ func (m *fsMergeWithMem) ForEachRemaining(
 
 	return nil
 }
+
+func (m *fsMergeWithMem) DeletedRanges(seriesID ident.ID) xtime.Ranges {
+	return m.shard.DeletedRanges(seriesID)
+}
@@ -174,6 +174,11 @@ type nsIndexState struct {
 	shardFilteredForID func(id ident.ID) (uint32, bool)
 
 	shardsAssigned map[uint32]struct{}
+
+	// tombstones are the deleted ranges of series keyed by series ID, series
+	// are excluded from query results when their data has been deleted for
+	// the entire queried range.
+	tombstones map[string]xtime.Ranges
 }
 
 type blockAndBlockStart struct {
@@ -952,6 +957,8 @@ func (i *nsIndex) Tick(
 	result.NumTotalDocs += blockTickResult.NumDocs
 	result.FreeMmap += blockTickResult.FreeMmap
 
+	i.expireTombstones(startTime)
+
 	i.metrics.tick.Inc(1)
 
 	return result, multiErr.FinalError()
@@ -1465,11 +1472,74 @@ func (i *nsIndex) AssignShardSet(shardSet sharding.ShardSet) {
 	i.state.Unlock()
 }
 
-func (i *nsIndex) shardsFilterID() func(id ident.ID) bool {
+func (i *nsIndex) DeleteSeries(id ident.ID, r xtime.Range) {
+	i.state.Lock()
+	defer i.state.Unlock()
+
+	if i.state.tombstones == nil {
+		i.state.tombstones = make(map[string]xtime.Ranges)
+	}
+	key := id.String()
+	ranges, ok := i.state.tombstones[key]
+	if !ok {
+		ranges = xtime.NewRanges()
+		i.state.tombstones[key] = ranges
+	}
+	ranges.AddRange(r)
+}
+
+// queryFilterID returns the filter for query results, which excludes series
+// not owned by this node and series deleted for the entire query range.
+func (i *nsIndex) queryFilterID(opts index.QueryOptions) func(id ident.ID) bool {
 	i.state.RLock()
-	v := i.state.shardsFilterID
+	shardsFilterID := i.state.shardsFilterID
+	hasTombstones := len(i.state.tombstones) > 0
 	i.state.RUnlock()
-	return v
+
+	if !hasTombstones {
+		return shardsFilterID
+	}
+	queryRange := xtime.Range{Start: opts.StartInclusive, End: opts.EndExclusive}
+	return func(id ident.ID) bool {
+		if i.deletedForRange(id, queryRange) {
+			return false
+		}
+		return shardsFilterID == nil || shardsFilterID(id)
+	}
+}
+
+// deletedForRange returns whether the series is deleted for the entire range.
+func (i *nsIndex) deletedForRange(id ident.ID, r xtime.Range) bool {
+	i.state.RLock()
+	defer i.state.RUnlock()
+
+	ranges, ok := i.state.tombstones[string(id.Bytes())]
+	if !ok {
+		return false
+	}
+	it := ranges.Iter()
+	for it.Next() {
+		if it.Value().Contains(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// expireTombstones removes the parts of series tombstones that have fallen
+// out of retention.
+func (i *nsIndex) expireTombstones(startTime xtime.UnixNano) {
+	earliest := retention.FlushTimeStartForRetentionPeriod(i.retentionPeriod,
+		i.blockSize, startTime)
+
+	i.state.Lock()
+	for id, ranges := range i.state.tombstones {
+		ranges.RemoveRange(xtime.Range{End: earliest})
+		if ranges.IsEmpty() {
+			delete(i.state.tombstones, id)
+		}
+	}
+	i.state.Unlock()
 }
 
 func (i *nsIndex) shardForID() func(id ident.ID) (uint32, bool) {
@@ -1504,7 +1574,7 @@ func (i *nsIndex) Query(
 	results := i.resultsPool.Get()
 	results.Reset(i.nsMetadata.ID(), index.QueryResultsOptions{
 		SizeLimit: opts.SeriesLimit,
-		FilterID:  i.shardsFilterID(),
+		FilterID:  i.queryFilterID(opts),
 	})
 	ctx.RegisterFinalizer(results)
 	queryRes, err := i.query(ctx, query, results, opts, i.execBlockQueryFn,
@@ -1580,6 +1650,37 @@ func (i *nsIndex) AggregateQuery(
 	}, nil
 }
 
+func (i *nsIndex) CardinalityQuery(
+	ctx context.Context,
+	query index.Query,
+	opts index.QueryOptions,
+) (index.CardinalityQueryResult, error) {
+	id := i.nsMetadata.ID()
+	logFields := []opentracinglog.Field{
+		opentracinglog.String("query", query.String()),
+		opentracinglog.String("namespace", id.String()),
+		xopentracing.Time("queryStart", opts.StartInclusive.ToTime()),
+		xopentracing.Time("queryEnd", opts.EndExclusive.ToTime()),
+	}
+
+	ctx, sp := ctx.StartTraceSpan(tracepoint.NSIdxCardinalityQuery)
+	sp.LogFields(logFields...)
+	defer sp.Finish()
+
+	results := index.NewCardinalityResults(id)
+	ctx.RegisterFinalizer(results)
+	queryRes, err := i.query(ctx, query, results, opts, i.execBlockCardinalityQueryFn,
+		i.newBlockCardinalityIterFn, logFields)
+	if err != nil {
+		return index.CardinalityQueryResult{}, err
+	}
+	return index.CardinalityQueryResult{
+		Results:    results,
+		Exhaustive: queryRes.exhaustive,
+		Waited:     queryRes.waited,
+	}, nil
+}
+
 type queryResult struct {
 	exhaustive bool
 	waited     int
@@ -1960,6 +2061,75 @@ func (i *nsIndex) execBlockAggregateQueryFn(
 	}
 }
 
+func (i *nsIndex) newBlockCardinalityIterFn(
+	ctx context.Context,
+	block index.Block,
+	query index.Query,
+	_ index.BaseResults,
+) (index.ResultIterator, error) {
+	// NB: cardinality queries iterate every tag name and value of the series
+	// matched by the query, counting the series each of them appears in.
+	aggOpts := index.AggregateResultsOptions{
+		Type:      index.AggregateTagNamesAndValues,
+		CountDocs: true,
+	}
+	if !query.Equal(allQuery) {
+		aggOpts.RestrictByQuery = &query
+	}
+	return block.AggregateIter(ctx, aggOpts)
+}
+
+func (i *nsIndex) execBlockCardinalityQueryFn(
+	ctx context.Context,
+	block index.Block,
+	permit permits.Permit,
+	iter index.ResultIterator,
+	opts index.QueryOptions,
+	state *asyncQueryExecState,
+	results index.BaseResults,
+	logFields []opentracinglog.Field,
+) {
+	logFields = append(logFields,
+		xopentracing.Time("blockStart", block.StartTime().ToTime()),
+		xopentracing.Time("blockEnd", block.EndTime().ToTime()),
+	)
+
+	ctx, sp := ctx.StartTraceSpan(tracepoint.NSIdxBlockCardinalityQuery)
+	sp.LogFields(logFields...)
+	defer sp.Finish()
+
+	cardinalityResults, ok := results.(index.CardinalityResults)
+	if !ok { // should never happen
+		state.addErr(fmt.Errorf("unknown results type [%T] received during cardinality query", results))
+		return
+	}
+	aggIter, ok := iter.(index.AggregateIterator)
+	if !ok { // should never happen
+		state.addErr(fmt.Errorf("unknown results type [%T] received during query", iter))
+		return
+	}
+
+	deadline := time.Now().Add(time.Duration(permit.AllowedQuota()))
+	err := block.AggregateWithIter(ctx, aggIter, opts, cardinalityResults, deadline, logFields)
+	if err == index.ErrUnableToQueryBlockClosed {
+		// NB: Because we query this block outside of the results lock, it's
+		// possible this block may get closed if it slides out of retention, in
+		// that case those results are no longer considered valid and outside of
+		// retention regardless, so this is a non-issue.
+		err = nil
+	}
+
+	if err != nil {
+		sp.LogFields(opentracinglog.Error(err))
+		state.addErr(err)
+		return
+	}
+
+	if aggIter.Done() {
+		cardinalityResults.AddSeries(aggIter.MatchedDocsCount())
+	}
+}
+
 func (i *nsIndex) overriddenOptsForQueryWithRLock(
 	opts index.QueryOptions,
 ) index.QueryOptions {
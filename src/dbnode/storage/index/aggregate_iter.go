@@ -43,6 +43,8 @@ type aggregateIter struct {
 	done                   bool
 	currField, currTerm    []byte
 	nextField, nextTerm    []byte
+	currDocs, nextDocs     int
+	matchedDocs            int
 	docsCount, seriesCount int
 }
 
@@ -58,19 +60,23 @@ func (it *aggregateIter) Next(ctx context.Context) bool {
 				return false
 			}
 			it.iters = append(it.iters, iter)
+			if it.iterateOpts.countDocs {
+				it.matchedDocs += iter.MatchedDocsCount()
+			}
 		}
 		if !it.next() {
 			it.done = true
 			return false
 		}
-		it.nextField, it.nextTerm = it.current()
+		it.nextField, it.nextTerm, it.nextDocs = it.current()
 	}
 	// the fieldAndTermsIterator mutates the underlying byte slice, so we need to copy to preserve the value.
 	it.currField = append(it.currField[:0], it.nextField...)
 	it.currTerm = append(it.currTerm[:0], it.nextTerm...)
+	it.currDocs = it.nextDocs
 
 	if it.next() {
-		it.nextField, it.nextTerm = it.current()
+		it.nextField, it.nextTerm, it.nextDocs = it.current()
 	} else {
 		// the iterators have been exhausted. mark done so the next call Done returns true. Still return true from
 		// this call so the caller can retrieve the last element with Current.
@@ -106,8 +112,13 @@ func (it *aggregateIter) next() bool {
 	return true
 }
 
-func (it *aggregateIter) current() (field, term []byte) {
-	return it.iters[it.idx].Current()
+func (it *aggregateIter) current() (field, term []byte, docs int) {
+	iter := it.iters[it.idx]
+	field, term = iter.Current()
+	if it.iterateOpts.countDocs {
+		docs = iter.CurrentDocsCount()
+	}
+	return field, term, docs
 }
 
 func (it *aggregateIter) Err() error {
@@ -122,6 +133,14 @@ func (it *aggregateIter) Current() (field, term []byte) {
 	return it.currField, it.currTerm
 }
 
+func (it *aggregateIter) CurrentDocsCount() int {
+	return it.currDocs
+}
+
+func (it *aggregateIter) MatchedDocsCount() int {
+	return it.matchedDocs
+}
+
 func (it *aggregateIter) fieldsAndTermsIteratorOpts() fieldsAndTermsIteratorOpts {
 	return it.iterateOpts
 }
@@ -125,7 +125,7 @@ func (s shardRangesSegmentsByVolumeType) forEachSegmentGroup(cb func(group block
 
 type addAggregateResultsFn func(
 	ctx context.Context,
-	results AggregateResults,
+	results AggregateFieldsResults,
 	batch []AggregateResultsEntry,
 	source []byte,
 ) ([]AggregateResultsEntry, int, int, error)
@@ -677,6 +677,7 @@ func (b *block) AggregateIter(ctx context.Context, aggOpts AggregateResultsOptio
 	iterateOpts := fieldsAndTermsIteratorOpts{
 		restrictByQuery: aggOpts.RestrictByQuery,
 		iterateTerms:    aggOpts.Type == AggregateTagNamesAndValues,
+		countDocs:       aggOpts.CountDocs,
 		allowFn: func(field []byte) bool {
 			// skip any field names that we shouldn't allow.
 			if bytes.Equal(field, doc.IDReservedFieldName) {
@@ -727,7 +728,7 @@ func (b *block) AggregateWithIter(
 	ctx context.Context,
 	iter AggregateIterator,
 	opts QueryOptions,
-	results AggregateResults,
+	results AggregateFieldsResults,
 	deadline time.Time,
 	logFields []opentracinglog.Field,
 ) error {
@@ -752,7 +753,7 @@ func (b *block) aggregateWithSpan(
 	ctx context.Context,
 	iter AggregateIterator,
 	opts QueryOptions,
-	results AggregateResults,
+	results AggregateFieldsResults,
 	deadline time.Time,
 ) error {
 	var (
@@ -762,6 +763,7 @@ func (b *block) aggregateWithSpan(
 		docsCount     = results.TotalDocsCount()
 		batch         = b.opts.AggregateResultsEntryArrayPool().Get()
 		maxBatch      = cap(batch)
+		iterateOpts   = iter.fieldsAndTermsIteratorOpts()
 		fieldAppended bool
 		termAppended  bool
 		lastField     []byte
@@ -835,12 +837,16 @@ func (b *block) aggregateWithSpan(
 		}
 
 		batch, fieldAppended, termAppended = b.appendFieldAndTermToBatch(batch, field, term,
-			iter.fieldsAndTermsIteratorOpts().iterateTerms)
+			iterateOpts.iterateTerms)
 		if fieldAppended {
 			currFields++
 		}
 		if termAppended {
 			currTerms++
+			if iterateOpts.countDocs {
+				last := &batch[len(batch)-1]
+				last.DocsCounts = append(last.DocsCounts, iter.CurrentDocsCount())
+			}
 		}
 		// continue appending to the batch until we hit our max batch size.
 		if currFields+currTerms < maxBatch {
@@ -956,7 +962,7 @@ func (b *block) pooledID(id []byte) ident.ID {
 // to the provided results and resets the batch to be reused.
 func (b *block) addAggregateResults(
 	ctx context.Context,
-	results AggregateResults,
+	results AggregateFieldsResults,
 	batch []AggregateResultsEntry,
 	source []byte,
 ) ([]AggregateResultsEntry, int, int, error) {
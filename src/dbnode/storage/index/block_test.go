@@ -2661,7 +2661,7 @@ func TestBlockAggregateBatching(t *testing.T) {
 			addAggregateResultsFn := b.addAggregateResultsFn
 			b.addAggregateResultsFn = func(
 				ctx context.Context,
-				results AggregateResults,
+				results AggregateFieldsResults,
 				batch []AggregateResultsEntry,
 				source []byte,
 			) ([]AggregateResultsEntry, int, int, error) {
@@ -0,0 +1,295 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/m3db/m3/src/x/ident"
+)
+
+type cardinalityResults struct {
+	sync.RWMutex
+
+	nsID           ident.ID
+	numSeries      int
+	size           int
+	totalDocsCount int
+	terms          map[string]map[string]int
+	truncated      map[string]cardinalityTruncatedValues
+}
+
+// cardinalityTruncatedValues accounts for the values of a tag name that were
+// truncated from the results.
+type cardinalityTruncatedValues struct {
+	numValues   int
+	valuesBytes int
+}
+
+// NewCardinalityResults returns a new cardinality results object.
+func NewCardinalityResults(namespaceID ident.ID) CardinalityResults {
+	return &cardinalityResults{
+		nsID:      namespaceID,
+		terms:     make(map[string]map[string]int),
+		truncated: make(map[string]cardinalityTruncatedValues),
+	}
+}
+
+func (r *cardinalityResults) Namespace() ident.ID {
+	r.RLock()
+	v := r.nsID
+	r.RUnlock()
+	return v
+}
+
+func (r *cardinalityResults) Size() int {
+	r.RLock()
+	v := r.size
+	r.RUnlock()
+	return v
+}
+
+func (r *cardinalityResults) TotalDocsCount() int {
+	r.RLock()
+	v := r.totalDocsCount
+	r.RUnlock()
+	return v
+}
+
+func (r *cardinalityResults) EnforceLimits() bool {
+	return true
+}
+
+func (r *cardinalityResults) AddFields(batch []AggregateResultsEntry) (int, int) {
+	r.Lock()
+	defer r.Unlock()
+
+	for _, entry := range batch {
+		// NB: each entry counts as a doc for the field as well as each term,
+		// the same as aggregate results.
+		r.totalDocsCount += 1 + len(entry.Terms)
+		for idx, term := range entry.Terms {
+			var seriesCount int
+			if idx < len(entry.DocsCounts) {
+				seriesCount = entry.DocsCounts[idx]
+			}
+			r.addTermWithLock(entry.Field.Bytes(), term.Bytes(), seriesCount)
+			term.Finalize()
+		}
+		entry.Field.Finalize()
+	}
+
+	return r.size, r.totalDocsCount
+}
+
+func (r *cardinalityResults) AddSeries(count int) {
+	r.Lock()
+	r.numSeries += count
+	r.Unlock()
+}
+
+func (r *cardinalityResults) AddTerm(name, value []byte, seriesCount int) {
+	r.Lock()
+	r.addTermWithLock(name, value, seriesCount)
+	r.Unlock()
+}
+
+func (r *cardinalityResults) addTermWithLock(name, value []byte, seriesCount int) {
+	values, ok := r.terms[string(name)]
+	if !ok {
+		values = make(map[string]int)
+		r.terms[string(name)] = values
+	}
+	if _, ok := values[string(value)]; !ok {
+		r.size++
+	}
+	values[string(value)] += seriesCount
+}
+
+func (r *cardinalityResults) AddTruncated(name []byte, numValues, valuesBytes int) {
+	r.Lock()
+	truncated := r.truncated[string(name)]
+	truncated.numValues += numValues
+	truncated.valuesBytes += valuesBytes
+	r.truncated[string(name)] = truncated
+	r.Unlock()
+}
+
+func (r *cardinalityResults) Truncate(limit int) {
+	if limit <= 0 {
+		return
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	for name, values := range r.terms {
+		if len(values) <= limit {
+			continue
+		}
+
+		counts := make([]CardinalityStat, 0, len(values))
+		for value, seriesCount := range values {
+			counts = append(counts, CardinalityStat{
+				Name:  value,
+				Value: seriesCount,
+			})
+		}
+
+		truncated := r.truncated[name]
+		for _, count := range topCardinalityStats(counts, 0)[limit:] {
+			truncated.numValues++
+			truncated.valuesBytes += len(count.Name) * count.Value
+			delete(values, count.Name)
+			r.size--
+		}
+		r.truncated[name] = truncated
+	}
+}
+
+func (r *cardinalityResults) NumSeries() int {
+	r.RLock()
+	v := r.numSeries
+	r.RUnlock()
+	return v
+}
+
+func (r *cardinalityResults) ForEach(fn func(name, value string, seriesCount int)) {
+	r.RLock()
+	defer r.RUnlock()
+
+	names := make([]string, 0, len(r.terms))
+	for name := range r.terms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		values := r.terms[name]
+		sortedValues := make([]string, 0, len(values))
+		for value := range values {
+			sortedValues = append(sortedValues, value)
+		}
+		sort.Strings(sortedValues)
+
+		for _, value := range sortedValues {
+			fn(name, value, values[value])
+		}
+	}
+}
+
+func (r *cardinalityResults) ForEachTruncated(fn func(name string, numValues, valuesBytes int)) {
+	r.RLock()
+	defer r.RUnlock()
+
+	names := make([]string, 0, len(r.truncated))
+	for name := range r.truncated {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		truncated := r.truncated[name]
+		fn(name, truncated.numValues, truncated.valuesBytes)
+	}
+}
+
+func (r *cardinalityResults) Stats(metricName []byte, limit int) CardinalityStats {
+	r.RLock()
+	defer r.RUnlock()
+
+	stats := CardinalityStats{
+		NumSeries:     r.numSeries,
+		NumLabelPairs: r.size,
+	}
+
+	var (
+		valueCounts  = make(map[string]int, len(r.terms))
+		memoryCounts = make(map[string]int, len(r.terms))
+		pairCounts   = make([]CardinalityStat, 0, r.size)
+	)
+	for name, values := range r.terms {
+		memory := 0
+		for value, seriesCount := range values {
+			memory += len(value) * seriesCount
+			pairCounts = append(pairCounts, CardinalityStat{
+				Name:  name + "=" + value,
+				Value: seriesCount,
+			})
+		}
+
+		valueCounts[name] += len(values)
+		memoryCounts[name] += memory
+	}
+	for name, truncated := range r.truncated {
+		stats.NumLabelPairs += truncated.numValues
+		valueCounts[name] += truncated.numValues
+		memoryCounts[name] += truncated.valuesBytes
+	}
+
+	metricNames := r.terms[string(metricName)]
+	nameCounts := make([]CardinalityStat, 0, len(metricNames))
+	for value, seriesCount := range metricNames {
+		nameCounts = append(nameCounts, CardinalityStat{
+			Name:  value,
+			Value: seriesCount,
+		})
+	}
+
+	stats.SeriesCountByMetricName = topCardinalityStats(nameCounts, limit)
+	stats.LabelValueCountByLabelName = topCardinalityStats(
+		toCardinalityStats(valueCounts), limit)
+	stats.MemoryInBytesByLabelName = topCardinalityStats(
+		toCardinalityStats(memoryCounts), limit)
+	stats.SeriesCountByLabelValuePair = topCardinalityStats(pairCounts, limit)
+	return stats
+}
+
+func (r *cardinalityResults) Finalize() {
+	// NB: cardinality results are not pooled.
+}
+
+func toCardinalityStats(counts map[string]int) []CardinalityStat {
+	stats := make([]CardinalityStat, 0, len(counts))
+	for name, value := range counts {
+		stats = append(stats, CardinalityStat{
+			Name:  name,
+			Value: value,
+		})
+	}
+	return stats
+}
+
+// topCardinalityStats returns the stats with the highest values, breaking
+// ties by name so results are deterministic.
+func topCardinalityStats(stats []CardinalityStat, limit int) []CardinalityStat {
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Value != stats[j].Value {
+			return stats[i].Value > stats[j].Value
+		}
+		return stats[i].Name < stats[j].Name
+	})
+	if limit > 0 && len(stats) > limit {
+		stats = stats[:limit]
+	}
+	return stats
+}
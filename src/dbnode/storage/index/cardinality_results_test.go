@@ -0,0 +1,185 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/x/ident"
+)
+
+type cardinalityTerm struct {
+	name        string
+	value       string
+	seriesCount int
+}
+
+func TestCardinalityResultsAddTerm(t *testing.T) {
+	res := NewCardinalityResults(ident.StringID("ns"))
+	res.AddSeries(2)
+	res.AddSeries(1)
+	res.AddTerm([]byte("job"), []byte("b"), 1)
+	res.AddTerm([]byte("__name__"), []byte("foo"), 2)
+	res.AddTerm([]byte("job"), []byte("a"), 2)
+	res.AddTerm([]byte("job"), []byte("b"), 1)
+
+	assert.Equal(t, "ns", res.Namespace().String())
+	assert.Equal(t, 3, res.NumSeries())
+	assert.Equal(t, 0, res.TotalDocsCount())
+	assert.Equal(t, 3, res.Size())
+
+	var terms []cardinalityTerm
+	res.ForEach(func(name, value string, seriesCount int) {
+		terms = append(terms, cardinalityTerm{
+			name:        name,
+			value:       value,
+			seriesCount: seriesCount,
+		})
+	})
+	require.Equal(t, []cardinalityTerm{
+		{name: "__name__", value: "foo", seriesCount: 2},
+		{name: "job", value: "a", seriesCount: 2},
+		{name: "job", value: "b", seriesCount: 2},
+	}, terms)
+}
+
+func TestCardinalityResultsStats(t *testing.T) {
+	res := NewCardinalityResults(ident.StringID("ns"))
+	res.AddSeries(4)
+	res.AddTerm([]byte("__name__"), []byte("foo"), 3)
+	res.AddTerm([]byte("__name__"), []byte("bar"), 1)
+	res.AddTerm([]byte("job"), []byte("a"), 2)
+	res.AddTerm([]byte("job"), []byte("bb"), 2)
+	res.AddTerm([]byte("instance"), []byte("x"), 4)
+
+	stats := res.Stats([]byte("__name__"), 0)
+	assert.Equal(t, 4, stats.NumSeries)
+	assert.Equal(t, 5, stats.NumLabelPairs)
+	assert.Equal(t, []CardinalityStat{
+		{Name: "foo", Value: 3},
+		{Name: "bar", Value: 1},
+	}, stats.SeriesCountByMetricName)
+	assert.Equal(t, []CardinalityStat{
+		{Name: "__name__", Value: 2},
+		{Name: "job", Value: 2},
+		{Name: "instance", Value: 1},
+	}, stats.LabelValueCountByLabelName)
+	assert.Equal(t, []CardinalityStat{
+		{Name: "__name__", Value: 12},
+		{Name: "job", Value: 6},
+		{Name: "instance", Value: 4},
+	}, stats.MemoryInBytesByLabelName)
+	assert.Equal(t, []CardinalityStat{
+		{Name: "instance=x", Value: 4},
+		{Name: "__name__=foo", Value: 3},
+		{Name: "job=a", Value: 2},
+		{Name: "job=bb", Value: 2},
+		{Name: "__name__=bar", Value: 1},
+	}, stats.SeriesCountByLabelValuePair)
+
+	limited := res.Stats([]byte("__name__"), 1)
+	assert.Equal(t, []CardinalityStat{{Name: "foo", Value: 3}},
+		limited.SeriesCountByMetricName)
+	assert.Equal(t, []CardinalityStat{{Name: "instance=x", Value: 4}},
+		limited.SeriesCountByLabelValuePair)
+}
+
+func TestCardinalityResultsAddFields(t *testing.T) {
+	res := NewCardinalityResults(ident.StringID("ns"))
+	size, docsCount := res.AddFields([]AggregateResultsEntry{
+		{
+			Field:      ident.StringID("job"),
+			Terms:      []ident.ID{ident.StringID("a"), ident.StringID("b")},
+			DocsCounts: []int{2, 1},
+		},
+		{
+			Field:      ident.StringID("__name__"),
+			Terms:      []ident.ID{ident.StringID("foo")},
+			DocsCounts: []int{3},
+		},
+	})
+	assert.Equal(t, 3, size)
+	assert.Equal(t, 5, docsCount)
+
+	size, docsCount = res.AddFields([]AggregateResultsEntry{
+		{
+			Field:      ident.StringID("job"),
+			Terms:      []ident.ID{ident.StringID("a")},
+			DocsCounts: []int{1},
+		},
+	})
+	assert.Equal(t, 3, size)
+	assert.Equal(t, 7, docsCount)
+
+	var terms []cardinalityTerm
+	res.ForEach(func(name, value string, seriesCount int) {
+		terms = append(terms, cardinalityTerm{
+			name:        name,
+			value:       value,
+			seriesCount: seriesCount,
+		})
+	})
+	require.Equal(t, []cardinalityTerm{
+		{name: "__name__", value: "foo", seriesCount: 3},
+		{name: "job", value: "a", seriesCount: 3},
+		{name: "job", value: "b", seriesCount: 1},
+	}, terms)
+}
+
+func TestCardinalityResultsTruncate(t *testing.T) {
+	res := NewCardinalityResults(ident.StringID("ns"))
+	res.AddSeries(4)
+	res.AddTerm([]byte("__name__"), []byte("foo"), 3)
+	res.AddTerm([]byte("__name__"), []byte("bar"), 1)
+	res.AddTerm([]byte("job"), []byte("a"), 2)
+	res.AddTerm([]byte("job"), []byte("bb"), 2)
+	res.AddTerm([]byte("instance"), []byte("x"), 4)
+
+	expected := res.Stats([]byte("__name__"), 1)
+
+	res.Truncate(1)
+	assert.Equal(t, 3, res.Size())
+
+	type truncatedValues struct {
+		name                   string
+		numValues, valuesBytes int
+	}
+	var truncated []truncatedValues
+	res.ForEachTruncated(func(name string, numValues, valuesBytes int) {
+		truncated = append(truncated, truncatedValues{
+			name:        name,
+			numValues:   numValues,
+			valuesBytes: valuesBytes,
+		})
+	})
+	require.Equal(t, []truncatedValues{
+		{name: "__name__", numValues: 1, valuesBytes: 3},
+		{name: "job", numValues: 1, valuesBytes: 4},
+	}, truncated)
+
+	// NB: the statistics limited to the same number of entries are unchanged
+	// by the truncation.
+	assert.Equal(t, expected, res.Stats([]byte("__name__"), 1))
+	assert.Equal(t, 5, res.Stats([]byte("__name__"), 0).NumLabelPairs)
+}
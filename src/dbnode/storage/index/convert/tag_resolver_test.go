@@ -68,6 +68,30 @@ func TestTagsMetadataResolver(t *testing.T) {
 	assertFieldValue(t, metadata, "__name__", "foo")
 }
 
+func TestMetadataResolverTagValue(t *testing.T) {
+	encodedTags, err := base64.StdEncoding.DecodeString(encodedTagSample)
+	require.NoError(t, err)
+
+	tags := ident.NewTags(
+		ident.StringTag("__name__", "diskio"),
+		ident.StringTag("host", "localhost"))
+	resolvers := []TagMetadataResolver{
+		NewEncodedTagsMetadataResolver(encodedTags),
+		NewTagsIterMetadataResolver(ident.NewTagsIterator(tags)),
+		NewTagsMetadataResolver(tags),
+	}
+	for _, sut := range resolvers {
+		value, ok, err := sut.TagValue([]byte("__name__"))
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, "diskio", string(value))
+
+		_, ok, err = sut.TagValue([]byte("unknown"))
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+}
+
 func assertFieldValue(t *testing.T, metadata doc.Metadata, expectedFieldName, expectedValue string) {
 	val, ok := metadata.Get([]byte(expectedFieldName))
 	require.True(t, ok)
@@ -40,6 +40,7 @@ var errUnpackBitmapFromPostingsList = errors.New("unable to unpack bitmap from p
 type fieldsAndTermsIteratorOpts struct {
 	restrictByQuery *Query
 	iterateTerms    bool
+	countDocs       bool
 	allowFn         allowFn
 	fieldIterFn     newFieldIterFn
 }
@@ -71,12 +72,14 @@ type fieldsAndTermsIter struct {
 	termIter  segment.TermsIterator
 
 	current struct {
-		field    []byte
-		term     []byte
-		postings postings.List
+		field     []byte
+		term      []byte
+		postings  postings.List
+		docsCount int
 	}
 
 	restrictByPostings *pilosaroaring.Bitmap
+	matchedDocsCount   int
 }
 
 var fieldsAndTermsIterZeroed fieldsAndTermsIter
@@ -106,6 +109,13 @@ func newFieldsAndTermsIterator(
 
 	if opts.restrictByQuery == nil {
 		// No need to restrict results by query.
+		if opts.countDocs {
+			all, err := reader.MatchAll()
+			if err != nil {
+				return nil, err
+			}
+			iter.matchedDocsCount = all.Len()
+		}
 		return iter, nil
 	}
 
@@ -137,6 +147,9 @@ func newFieldsAndTermsIterator(
 	}
 
 	iter.restrictByPostings = bitmap
+	if opts.countDocs {
+		iter.matchedDocsCount = int(bitmap.Count())
+	}
 	return iter, nil
 }
 
@@ -154,6 +167,9 @@ func (fti *fieldsAndTermsIter) setNextField() bool {
 		if fti.restrictByPostings == nil {
 			// No restrictions.
 			fti.current.field = field
+			if fti.opts.countDocs {
+				fti.current.docsCount = pl.Len()
+			}
 			return true
 		}
 
@@ -168,13 +184,15 @@ func (fti *fieldsAndTermsIter) setNextField() bool {
 		// count.
 		// Note: IntersectionCount is significantly faster than intersecting and
 		// counting results and also does not allocate.
-		if n := fti.restrictByPostings.IntersectionCount(bitmap); n < 1 {
+		n := fti.restrictByPostings.IntersectionCount(bitmap)
+		if n < 1 {
 			// No match, not next result.
 			continue
 		}
 
 		// Matches, this is next result.
 		fti.current.field = field
+		fti.current.docsCount = int(n)
 		return true
 	}
 
@@ -230,6 +248,9 @@ func (fti *fieldsAndTermsIter) nextTermsIterResult() (bool, error) {
 		fti.current.term, fti.current.postings = fti.termIter.Current()
 		if fti.restrictByPostings == nil {
 			// No restrictions.
+			if fti.opts.countDocs {
+				fti.current.docsCount = fti.current.postings.Len()
+			}
 			return true, nil
 		}
 
@@ -245,6 +266,7 @@ func (fti *fieldsAndTermsIter) nextTermsIterResult() (bool, error) {
 		// counting results and also does not allocate.
 		if n := fti.restrictByPostings.IntersectionCount(bitmap); n > 0 {
 			// Matches, this is next result.
+			fti.current.docsCount = int(n)
 			return true, nil
 		}
 	}
@@ -275,6 +297,14 @@ func (fti *fieldsAndTermsIter) Current() (field, term []byte) {
 	return fti.current.field, fti.current.term
 }
 
+func (fti *fieldsAndTermsIter) CurrentDocsCount() int {
+	return fti.current.docsCount
+}
+
+func (fti *fieldsAndTermsIter) MatchedDocsCount() int {
+	return fti.matchedDocsCount
+}
+
 func (fti *fieldsAndTermsIter) Err() error {
 	return fti.err
 }
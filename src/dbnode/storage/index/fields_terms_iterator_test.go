@@ -271,6 +271,69 @@ func TestFieldsTermsIteratorIterateTermsAndRestrictByQuery(t *testing.T) {
 	}, slice)
 }
 
+func TestFieldsTermsIteratorCountDocsRestrictByQuery(t *testing.T) {
+	ctx := context.NewBackground()
+
+	testDocs := []doc.Metadata{
+		{
+			Fields: []doc.Field{
+				{Name: []byte("fruit"), Value: []byte("banana")},
+				{Name: []byte("color"), Value: []byte("yellow")},
+			},
+		},
+		{
+			Fields: []doc.Field{
+				{Name: []byte("fruit"), Value: []byte("apple")},
+				{Name: []byte("color"), Value: []byte("red")},
+			},
+		},
+		{
+			Fields: []doc.Field{
+				{Name: []byte("fruit"), Value: []byte("pineapple")},
+				{Name: []byte("color"), Value: []byte("yellow")},
+			},
+		},
+	}
+
+	seg, err := mem.NewSegment(mem.NewOptions())
+	require.NoError(t, err)
+
+	require.NoError(t, seg.InsertBatch(m3ninxindex.Batch{
+		Docs:                testDocs,
+		AllowPartialUpdates: true,
+	}))
+
+	require.NoError(t, seg.Seal())
+
+	reader, err := seg.Reader()
+	require.NoError(t, err)
+
+	iter, err := newFieldsAndTermsIterator(ctx, reader, fieldsAndTermsIteratorOpts{
+		iterateTerms: true,
+		countDocs:    true,
+		restrictByQuery: &Query{
+			Query: idx.NewTermQuery([]byte("color"), []byte("yellow")),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, iter.MatchedDocsCount())
+
+	counts := make(map[pair]int)
+	for iter.Next() {
+		n, v := iter.Current()
+		if bytes.Equal(n, doc.IDReservedFieldName) {
+			continue
+		}
+		counts[pair{Name: string(n), Value: string(v)}] = iter.CurrentDocsCount()
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, map[pair]int{
+		{Name: "color", Value: "yellow"}:    2,
+		{Name: "fruit", Value: "banana"}:    1,
+		{Name: "fruit", Value: "pineapple"}: 1,
+	}, counts)
+}
+
 type terms struct {
 	values   []term
 	postings postings.List
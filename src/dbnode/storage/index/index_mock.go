@@ -585,6 +585,112 @@ func (mr *MockAggregateResultsMockRecorder) TotalDocsCount() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalDocsCount", reflect.TypeOf((*MockAggregateResults)(nil).TotalDocsCount))
 }
 
+// MockAggregateFieldsResults is a mock of AggregateFieldsResults interface.
+type MockAggregateFieldsResults struct {
+	ctrl     *gomock.Controller
+	recorder *MockAggregateFieldsResultsMockRecorder
+}
+
+// MockAggregateFieldsResultsMockRecorder is the mock recorder for MockAggregateFieldsResults.
+type MockAggregateFieldsResultsMockRecorder struct {
+	mock *MockAggregateFieldsResults
+}
+
+// NewMockAggregateFieldsResults creates a new mock instance.
+func NewMockAggregateFieldsResults(ctrl *gomock.Controller) *MockAggregateFieldsResults {
+	mock := &MockAggregateFieldsResults{ctrl: ctrl}
+	mock.recorder = &MockAggregateFieldsResultsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAggregateFieldsResults) EXPECT() *MockAggregateFieldsResultsMockRecorder {
+	return m.recorder
+}
+
+// AddFields mocks base method.
+func (m *MockAggregateFieldsResults) AddFields(batch []AggregateResultsEntry) (int, int) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFields", batch)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	return ret0, ret1
+}
+
+// AddFields indicates an expected call of AddFields.
+func (mr *MockAggregateFieldsResultsMockRecorder) AddFields(batch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFields", reflect.TypeOf((*MockAggregateFieldsResults)(nil).AddFields), batch)
+}
+
+// EnforceLimits mocks base method.
+func (m *MockAggregateFieldsResults) EnforceLimits() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnforceLimits")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// EnforceLimits indicates an expected call of EnforceLimits.
+func (mr *MockAggregateFieldsResultsMockRecorder) EnforceLimits() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnforceLimits", reflect.TypeOf((*MockAggregateFieldsResults)(nil).EnforceLimits))
+}
+
+// Finalize mocks base method.
+func (m *MockAggregateFieldsResults) Finalize() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Finalize")
+}
+
+// Finalize indicates an expected call of Finalize.
+func (mr *MockAggregateFieldsResultsMockRecorder) Finalize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finalize", reflect.TypeOf((*MockAggregateFieldsResults)(nil).Finalize))
+}
+
+// Namespace mocks base method.
+func (m *MockAggregateFieldsResults) Namespace() ident.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Namespace")
+	ret0, _ := ret[0].(ident.ID)
+	return ret0
+}
+
+// Namespace indicates an expected call of Namespace.
+func (mr *MockAggregateFieldsResultsMockRecorder) Namespace() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Namespace", reflect.TypeOf((*MockAggregateFieldsResults)(nil).Namespace))
+}
+
+// Size mocks base method.
+func (m *MockAggregateFieldsResults) Size() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockAggregateFieldsResultsMockRecorder) Size() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockAggregateFieldsResults)(nil).Size))
+}
+
+// TotalDocsCount mocks base method.
+func (m *MockAggregateFieldsResults) TotalDocsCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalDocsCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// TotalDocsCount indicates an expected call of TotalDocsCount.
+func (mr *MockAggregateFieldsResultsMockRecorder) TotalDocsCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalDocsCount", reflect.TypeOf((*MockAggregateFieldsResults)(nil).TotalDocsCount))
+}
+
 // MockAggregateUsageMetrics is a mock of AggregateUsageMetrics interface.
 type MockAggregateUsageMetrics struct {
 	ctrl     *gomock.Controller
@@ -843,7 +949,7 @@ func (mr *MockBlockMockRecorder) AggregateIter(ctx, aggOpts interface{}) *gomock
 }
 
 // AggregateWithIter mocks base method.
-func (m *MockBlock) AggregateWithIter(ctx context.Context, iter AggregateIterator, opts QueryOptions, results AggregateResults, deadline time.Time, logFields []log.Field) error {
+func (m *MockBlock) AggregateWithIter(ctx context.Context, iter AggregateIterator, opts QueryOptions, results AggregateFieldsResults, deadline time.Time, logFields []log.Field) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AggregateWithIter", ctx, iter, opts, results, deadline, logFields)
 	ret0, _ := ret[0].(error)
@@ -1380,6 +1486,20 @@ func (mr *MockAggregateIteratorMockRecorder) Current() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockAggregateIterator)(nil).Current))
 }
 
+// CurrentDocsCount mocks base method.
+func (m *MockAggregateIterator) CurrentDocsCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentDocsCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// CurrentDocsCount indicates an expected call of CurrentDocsCount.
+func (mr *MockAggregateIteratorMockRecorder) CurrentDocsCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentDocsCount", reflect.TypeOf((*MockAggregateIterator)(nil).CurrentDocsCount))
+}
+
 // Done mocks base method.
 func (m *MockAggregateIterator) Done() bool {
 	m.ctrl.T.Helper()
@@ -1408,6 +1528,20 @@ func (mr *MockAggregateIteratorMockRecorder) Err() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Err", reflect.TypeOf((*MockAggregateIterator)(nil).Err))
 }
 
+// MatchedDocsCount mocks base method.
+func (m *MockAggregateIterator) MatchedDocsCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatchedDocsCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// MatchedDocsCount indicates an expected call of MatchedDocsCount.
+func (mr *MockAggregateIteratorMockRecorder) MatchedDocsCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchedDocsCount", reflect.TypeOf((*MockAggregateIterator)(nil).MatchedDocsCount))
+}
+
 // Next mocks base method.
 func (m *MockAggregateIterator) Next(ctx context.Context) bool {
 	m.ctrl.T.Helper()
@@ -1606,6 +1740,20 @@ func (mr *MockfieldsAndTermsIteratorMockRecorder) Current() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockfieldsAndTermsIterator)(nil).Current))
 }
 
+// CurrentDocsCount mocks base method.
+func (m *MockfieldsAndTermsIterator) CurrentDocsCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentDocsCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// CurrentDocsCount indicates an expected call of CurrentDocsCount.
+func (mr *MockfieldsAndTermsIteratorMockRecorder) CurrentDocsCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentDocsCount", reflect.TypeOf((*MockfieldsAndTermsIterator)(nil).CurrentDocsCount))
+}
+
 // Err mocks base method.
 func (m *MockfieldsAndTermsIterator) Err() error {
 	m.ctrl.T.Helper()
@@ -1620,6 +1768,20 @@ func (mr *MockfieldsAndTermsIteratorMockRecorder) Err() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Err", reflect.TypeOf((*MockfieldsAndTermsIterator)(nil).Err))
 }
 
+// MatchedDocsCount mocks base method.
+func (m *MockfieldsAndTermsIterator) MatchedDocsCount() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatchedDocsCount")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// MatchedDocsCount indicates an expected call of MatchedDocsCount.
+func (mr *MockfieldsAndTermsIteratorMockRecorder) MatchedDocsCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchedDocsCount", reflect.TypeOf((*MockfieldsAndTermsIterator)(nil).MatchedDocsCount))
+}
+
 // Next mocks base method.
 func (m *MockfieldsAndTermsIterator) Next() bool {
 	m.ctrl.T.Helper()
@@ -113,6 +113,15 @@ type AggregationOptions struct {
 	Type AggregationType
 }
 
+// CardinalityOptions enables users to specify constraints on cardinality
+// queries.
+type CardinalityOptions struct {
+	QueryOptions
+	// Limit is an optional limit for the number of values with the most series
+	// returned for each tag name.
+	Limit int
+}
+
 // QueryResult is the collection of results for a query.
 type QueryResult struct {
 	// Results are index query results.
@@ -133,6 +142,16 @@ type AggregateQueryResult struct {
 	Waited int
 }
 
+// CardinalityQueryResult is the collection of results for a cardinality query.
+type CardinalityQueryResult struct {
+	// Results are cardinality index query results.
+	Results CardinalityResults
+	// Exhaustive indicates that the query was exhaustive.
+	Exhaustive bool
+	// Waited is a count of the times a query has waited for permits.
+	Waited int
+}
+
 // BaseResults is a collection of basic results for a generic query, it is
 // synchronized when access to the results set is used as documented by the
 // methods.
@@ -241,7 +260,7 @@ type QueryResultsPool interface {
 // synchronized when access to the results set is used as documented by the
 // methods.
 type AggregateResults interface {
-	BaseResults
+	AggregateFieldsResults
 
 	// Reset resets the AggregateResults object to initial state.
 	Reset(
@@ -252,13 +271,6 @@ type AggregateResults interface {
 	// AggregateResultsOptions returns the options for this AggregateResult.
 	AggregateResultsOptions() AggregateResultsOptions
 
-	// AddFields adds the batch of fields to the results set, it will
-	// assume ownership of the idents (and backing bytes) provided to it.
-	// i.e. it is not safe to use/modify the idents once this function returns.
-	AddFields(
-		batch []AggregateResultsEntry,
-	) (size, docsCount int)
-
 	// Map returns a map from tag name -> possible tag values,
 	// comprising aggregate results.
 	// Since a lock is not held when accessing the map after a call to this
@@ -268,6 +280,89 @@ type AggregateResults interface {
 	Map() *AggregateResultsMap
 }
 
+// AggregateFieldsResults is a collection of results that batches of fields
+// and terms aggregated from a block are added to, it is synchronized when
+// access to the results set is used as documented by the methods.
+type AggregateFieldsResults interface {
+	BaseResults
+
+	// AddFields adds the batch of fields to the results set, it will
+	// assume ownership of the idents (and backing bytes) provided to it.
+	// i.e. it is not safe to use/modify the idents once this function returns.
+	AddFields(
+		batch []AggregateResultsEntry,
+	) (size, docsCount int)
+}
+
+// CardinalityResults is a collection of the number of series each tag name
+// and value appears in for a cardinality query, it is synchronized when access
+// to the results set is used as documented by the methods.
+// NB: the counts of each index block queried are summed, a series indexed in
+// more than one of the blocks is counted once per block so the counts are an
+// approximate upper bound when querying more than a single block.
+type CardinalityResults interface {
+	AggregateFieldsResults
+
+	// AddSeries adds to the number of series matched by the query.
+	AddSeries(count int)
+
+	// AddTerm adds to the number of series the tag name and value appears in,
+	// it takes a copy of the name and value provided to it.
+	AddTerm(name, value []byte, seriesCount int)
+
+	// AddTruncated adds to the number of distinct values, and the bytes of
+	// those values summed over the series they appear in, of a tag name whose
+	// values were truncated from the results.
+	AddTruncated(name []byte, numValues, valuesBytes int)
+
+	// Truncate keeps only the given number of values with the most series of
+	// each tag name, the values removed are accounted for as truncated values
+	// so the statistics per tag name remain the same.
+	Truncate(limit int)
+
+	// NumSeries returns the number of series matched by the query.
+	NumSeries() int
+
+	// ForEach calls the provided function with the number of series of each
+	// tag name and value, ordered by tag name and value.
+	ForEach(fn func(name, value string, seriesCount int))
+
+	// ForEachTruncated calls the provided function with the number of distinct
+	// values and the bytes of the values truncated of each tag name, ordered
+	// by tag name.
+	ForEachTruncated(fn func(name string, numValues, valuesBytes int))
+
+	// Stats returns the cardinality statistics of the results, limited to the
+	// given number of entries per statistic and using the given tag name as
+	// the metric name.
+	Stats(metricName []byte, limit int) CardinalityStats
+}
+
+// CardinalityStats are the cardinality statistics of a set of series, they
+// mirror the statistics of the Prometheus TSDB status.
+type CardinalityStats struct {
+	// NumSeries is the number of series.
+	NumSeries int
+	// NumLabelPairs is the number of distinct tag name and value pairs.
+	NumLabelPairs int
+	// SeriesCountByMetricName is the number of series by metric name.
+	SeriesCountByMetricName []CardinalityStat
+	// LabelValueCountByLabelName is the number of values by tag name.
+	LabelValueCountByLabelName []CardinalityStat
+	// MemoryInBytesByLabelName is the size of the values of all series by
+	// tag name.
+	MemoryInBytesByLabelName []CardinalityStat
+	// SeriesCountByLabelValuePair is the number of series by tag name and
+	// value pair, formatted as name=value.
+	SeriesCountByLabelValuePair []CardinalityStat
+}
+
+// CardinalityStat is a single cardinality statistic.
+type CardinalityStat struct {
+	Name  string
+	Value int
+}
+
 // AggregateFieldFilter dictates which fields will appear in the aggregated
 // result; if filter values exist, only those whose fields matches a value in the
 // filter are returned.
@@ -292,6 +387,10 @@ type AggregateResultsOptions struct {
 	// be present for an aggregated term to be returned.
 	RestrictByQuery *Query
 
+	// CountDocs counts the documents each aggregated term appears in, as
+	// well as the documents matched by the query, when iterating.
+	CountDocs bool
+
 	// AggregateUsageMetrics are aggregate usage metrics that track field
 	// and term counts for aggregate queries.
 	AggregateUsageMetrics AggregateUsageMetrics
@@ -346,6 +445,9 @@ type AggregateValuesPool interface {
 type AggregateResultsEntry struct {
 	Field ident.ID
 	Terms []ident.ID
+	// DocsCounts is the number of documents each term appears in, it is only
+	// set when the aggregate counts documents.
+	DocsCounts []int
 }
 
 // Block represents a collection of segments. Each `Block` is a complete reverse
@@ -378,7 +480,7 @@ type Block interface {
 		ctx context.Context,
 		iter AggregateIterator,
 		opts QueryOptions,
-		results AggregateResults,
+		results AggregateFieldsResults,
 		deadline time.Time,
 		logFields []opentracinglog.Field,
 	) error
@@ -921,6 +1023,14 @@ type AggregateIterator interface {
 	// Current returns the current (field, term).
 	Current() (field, term []byte)
 
+	// CurrentDocsCount returns the number of documents the current
+	// (field, term) appears in, only tracked when counting documents.
+	CurrentDocsCount() int
+
+	// MatchedDocsCount returns the number of documents matched by the query
+	// in the segments iterated so far, only tracked when counting documents.
+	MatchedDocsCount() int
+
 	fieldsAndTermsIteratorOpts() fieldsAndTermsIteratorOpts
 }
 
@@ -957,6 +1067,14 @@ type fieldsAndTermsIterator interface {
 	// NB: the element returned is only valid until the subsequent call to Next().
 	Current() (field, term []byte)
 
+	// CurrentDocsCount returns the number of documents the current element
+	// appears in, only tracked when counting documents.
+	CurrentDocsCount() int
+
+	// MatchedDocsCount returns the number of documents in the segment matched
+	// by the query, only tracked when counting documents.
+	MatchedDocsCount() int
+
 	// Err returns any errors encountered during iteration.
 	Err() error
 
@@ -87,6 +87,49 @@ func TestIsQueryLimitExceededError(t *testing.T) {
 	}
 }
 
+func TestIsSeriesLimitExceededError(t *testing.T) {
+	randomErr := xerrors.NewNonRetryableError(errors.New("random error"))
+	limitExceededErr := NewSeriesLimitExceededError("series limit exceeded")
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			"not series limit exceeded",
+			randomErr,
+			false,
+		},
+		{
+			"series limit exceeded",
+			limitExceededErr,
+			true,
+		},
+		{
+			"query limit exceeded",
+			NewQueryLimitExceededError("query limit exceeded"),
+			false,
+		},
+		{
+			"inner series limit exceeded",
+			xerrors.NewInvalidParamsError(limitExceededErr),
+			true,
+		},
+		{
+			"multi error with series limit exceeded",
+			multiError(randomErr, xerrors.NewRetryableError(limitExceededErr)),
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsSeriesLimitExceededError(tt.err))
+		})
+	}
+}
+
 func multiError(errs ...error) error {
 	multiErr := xerrors.NewMultiError()
 	for _, e := range errs {
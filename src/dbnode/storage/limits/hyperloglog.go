@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package limits
+
+import (
+	"math"
+	"math/bits"
+)
+
+const (
+	// hllPrecision is the number of bits of a hash used to select a register,
+	// the standard error of the estimate is 1.04/sqrt(2^hllPrecision) ~= 1.6%.
+	hllPrecision = 12
+	hllRegisters = 1 << hllPrecision
+	// hllMaxSparse is the number of hashes tracked exactly before switching
+	// to registers, so that small sets are exact and use little memory.
+	hllMaxSparse = 256
+)
+
+// hyperLogLog approximates the number of distinct hashes added to it. It is
+// not safe for concurrent use.
+type hyperLogLog struct {
+	sparse    map[uint64]struct{}
+	registers []uint8
+	// sum and zeros are the harmonic sum of the registers and the number of
+	// zero registers, kept up to date so estimating is cheap.
+	sum   float64
+	zeros int
+	count int64
+}
+
+func newHyperLogLog() *hyperLogLog {
+	return &hyperLogLog{
+		sparse: make(map[uint64]struct{}),
+	}
+}
+
+// Count returns the estimated number of distinct hashes.
+func (h *hyperLogLog) Count() int64 {
+	if h == nil {
+		return 0
+	}
+	return h.count
+}
+
+// Add adds the hash.
+func (h *hyperLogLog) Add(hash uint64) {
+	if h.registers != nil {
+		h.addRegister(hash)
+		h.count = h.estimate()
+		return
+	}
+
+	h.sparse[hash] = struct{}{}
+	if len(h.sparse) <= hllMaxSparse {
+		h.count = int64(len(h.sparse))
+		return
+	}
+
+	h.registers = make([]uint8, hllRegisters)
+	h.sum = hllRegisters
+	h.zeros = hllRegisters
+	for hash := range h.sparse {
+		h.addRegister(hash)
+	}
+	h.sparse = nil
+	h.count = h.estimate()
+}
+
+func (h *hyperLogLog) addRegister(hash uint64) {
+	idx, rank := hllRegister(hash)
+	prev := h.registers[idx]
+	if rank <= prev {
+		return
+	}
+	if prev == 0 {
+		h.zeros--
+	}
+	h.sum += math.Ldexp(1, -int(rank)) - math.Ldexp(1, -int(prev))
+	h.registers[idx] = rank
+}
+
+func (h *hyperLogLog) estimate() int64 {
+	m := float64(hllRegisters)
+	estimate := hllAlpha * m * m / h.sum
+	if estimate <= 2.5*m && h.zeros > 0 {
+		// Use linear counting for small cardinalities where the raw estimate
+		// is biased.
+		estimate = m * math.Log(m/float64(h.zeros))
+	}
+	return int64(math.Round(estimate))
+}
+
+// hllAlpha is the bias correction constant for hllRegisters registers.
+var hllAlpha = 0.7213 / (1 + 1.079/float64(hllRegisters))
+
+// hllRegister returns the register of the hash and the position of the first
+// set bit of the remaining bits of the hash.
+func hllRegister(hash uint64) (uint32, uint8) {
+	idx := uint32(hash >> (64 - hllPrecision))
+	rest := hash<<hllPrecision | 1<<(hllPrecision-1)
+	return idx, uint8(bits.LeadingZeros64(rest)) + 1
+}
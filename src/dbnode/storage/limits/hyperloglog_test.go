@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package limits
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func hllTestHash(i int) uint64 {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(i))
+	return xxhash.Sum64(b[:])
+}
+
+func TestHyperLogLogSparseIsExact(t *testing.T) {
+	h := newHyperLogLog()
+	for i := 0; i < hllMaxSparse; i++ {
+		h.Add(hllTestHash(i))
+		// Adding the same hash again does not change the count.
+		h.Add(hllTestHash(i))
+		require.Equal(t, int64(i+1), h.Count())
+	}
+	assert.Equal(t, int64(hllMaxSparse), h.Count())
+	assert.Nil(t, h.registers)
+}
+
+func TestHyperLogLogEstimate(t *testing.T) {
+	for _, n := range []int{1000, 10000, 100000} {
+		h := newHyperLogLog()
+		for i := 0; i < n; i++ {
+			h.Add(hllTestHash(i))
+		}
+		// Adding the same hashes again never changes the estimate.
+		count := h.Count()
+		for i := 0; i < n; i++ {
+			h.Add(hllTestHash(i))
+		}
+		require.Equal(t, count, h.Count())
+
+		relErr := math.Abs(float64(h.Count()-int64(n))) / float64(n)
+		assert.True(t, relErr < 0.05, "n=%d, count=%d", n, h.Count())
+	}
+}
+
+func TestHyperLogLogNil(t *testing.T) {
+	var h *hyperLogLog
+	assert.Equal(t, int64(0), h.Count())
+}
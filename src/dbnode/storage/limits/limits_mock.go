@@ -28,6 +28,7 @@ import (
 	"reflect"
 
 	"github.com/m3db/m3/src/x/instrument"
+	"github.com/m3db/m3/src/x/time"
 
 	"github.com/golang/mock/gomock"
 )
@@ -224,6 +225,110 @@ func (mr *MockLookbackLimitMockRecorder) Update(opts interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLookbackLimit)(nil).Update), opts)
 }
 
+// MockSeriesLimits is a mock of SeriesLimits interface.
+type MockSeriesLimits struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesLimitsMockRecorder
+}
+
+// MockSeriesLimitsMockRecorder is the mock recorder for MockSeriesLimits.
+type MockSeriesLimitsMockRecorder struct {
+	mock *MockSeriesLimits
+}
+
+// NewMockSeriesLimits creates a new mock instance.
+func NewMockSeriesLimits(ctrl *gomock.Controller) *MockSeriesLimits {
+	mock := &MockSeriesLimits{ctrl: ctrl}
+	mock.recorder = &MockSeriesLimitsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeriesLimits) EXPECT() *MockSeriesLimitsMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockSeriesLimits) Add(tags TagValueResolver) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockSeriesLimitsMockRecorder) Add(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockSeriesLimits)(nil).Add), tags)
+}
+
+// Remove mocks base method.
+func (m *MockSeriesLimits) Remove(tags TagValueResolver) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockSeriesLimitsMockRecorder) Remove(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockSeriesLimits)(nil).Remove), tags)
+}
+
+// Reserve mocks base method.
+func (m *MockSeriesLimits) Reserve(id []byte, blockStart time.UnixNano, tags TagValueResolver) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", id, blockStart, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockSeriesLimitsMockRecorder) Reserve(id, blockStart, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockSeriesLimits)(nil).Reserve), id, blockStart, tags)
+}
+
+// MockTagValueResolver is a mock of TagValueResolver interface.
+type MockTagValueResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagValueResolverMockRecorder
+}
+
+// MockTagValueResolverMockRecorder is the mock recorder for MockTagValueResolver.
+type MockTagValueResolverMockRecorder struct {
+	mock *MockTagValueResolver
+}
+
+// NewMockTagValueResolver creates a new mock instance.
+func NewMockTagValueResolver(ctrl *gomock.Controller) *MockTagValueResolver {
+	mock := &MockTagValueResolver{ctrl: ctrl}
+	mock.recorder = &MockTagValueResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagValueResolver) EXPECT() *MockTagValueResolverMockRecorder {
+	return m.recorder
+}
+
+// TagValue mocks base method.
+func (m *MockTagValueResolver) TagValue(name []byte) ([]byte, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagValue", name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TagValue indicates an expected call of TagValue.
+func (mr *MockTagValueResolverMockRecorder) TagValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagValue", reflect.TypeOf((*MockTagValueResolver)(nil).TagValue), name)
+}
+
 // MockSourceLoggerBuilder is a mock of SourceLoggerBuilder interface.
 type MockSourceLoggerBuilder struct {
 	ctrl     *gomock.Controller
@@ -389,6 +494,20 @@ func (mr *MockOptionsMockRecorder) InstrumentOptions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstrumentOptions", reflect.TypeOf((*MockOptions)(nil).InstrumentOptions))
 }
 
+// SeriesLimitsOpts mocks base method.
+func (m *MockOptions) SeriesLimitsOpts() map[string]SeriesLimitsOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesLimitsOpts")
+	ret0, _ := ret[0].(map[string]SeriesLimitsOptions)
+	return ret0
+}
+
+// SeriesLimitsOpts indicates an expected call of SeriesLimitsOpts.
+func (mr *MockOptionsMockRecorder) SeriesLimitsOpts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesLimitsOpts", reflect.TypeOf((*MockOptions)(nil).SeriesLimitsOpts))
+}
+
 // SetAggregateDocsLimitOpts mocks base method.
 func (m *MockOptions) SetAggregateDocsLimitOpts(arg0 LookbackLimitOptions) Options {
 	m.ctrl.T.Helper()
@@ -459,6 +578,20 @@ func (mr *MockOptionsMockRecorder) SetInstrumentOptions(value interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInstrumentOptions", reflect.TypeOf((*MockOptions)(nil).SetInstrumentOptions), value)
 }
 
+// SetSeriesLimitsOpts mocks base method.
+func (m *MockOptions) SetSeriesLimitsOpts(value map[string]SeriesLimitsOptions) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSeriesLimitsOpts", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetSeriesLimitsOpts indicates an expected call of SetSeriesLimitsOpts.
+func (mr *MockOptionsMockRecorder) SetSeriesLimitsOpts(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSeriesLimitsOpts", reflect.TypeOf((*MockOptions)(nil).SetSeriesLimitsOpts), value)
+}
+
 // SetSourceLoggerBuilder mocks base method.
 func (m *MockOptions) SetSourceLoggerBuilder(value SourceLoggerBuilder) Options {
 	m.ctrl.T.Helper()
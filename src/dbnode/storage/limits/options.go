@@ -34,6 +34,7 @@ type limitOpts struct {
 	diskSeriesReadLimitOpts    LookbackLimitOptions
 	diskAggregateDocsLimitOpts LookbackLimitOptions
 	sourceLoggerBuilder        SourceLoggerBuilder
+	seriesLimitsOpts           map[string]SeriesLimitsOptions
 }
 
 // NewOptions creates limit options with default values.
@@ -131,3 +132,15 @@ func (o *limitOpts) SetSourceLoggerBuilder(value SourceLoggerBuilder) Options {
 func (o *limitOpts) SourceLoggerBuilder() SourceLoggerBuilder {
 	return o.sourceLoggerBuilder
 }
+
+// SetSeriesLimitsOpts sets the series limits options keyed by namespace.
+func (o *limitOpts) SetSeriesLimitsOpts(value map[string]SeriesLimitsOptions) Options {
+	opts := *o
+	opts.seriesLimitsOpts = value
+	return &opts
+}
+
+// SeriesLimitsOpts returns the series limits options keyed by namespace.
+func (o *limitOpts) SeriesLimitsOpts() map[string]SeriesLimitsOptions {
+	return o.seriesLimitsOpts
+}
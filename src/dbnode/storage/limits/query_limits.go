@@ -55,6 +55,11 @@ type lookbackLimit struct {
 	stoppedCh chan struct{}
 	lock      sync.RWMutex
 	iOpts     instrument.Options
+
+	// NB: tenants are guarded by a separate lock since they are reset from
+	// the background goroutine which is stopped while holding the main lock.
+	tenants     map[string]*tenantLimit
+	tenantsLock sync.RWMutex
 }
 
 type lookbackLimitMetrics struct {
@@ -66,6 +71,22 @@ type lookbackLimitMetrics struct {
 	exceeded        tally.Counter
 
 	sourceLogger SourceLogger
+
+	metricName  string
+	metricScope tally.Scope
+}
+
+type tenantLimit struct {
+	limit   int64
+	recent  *atomic.Int64
+	metrics tenantLimitMetrics
+}
+
+type tenantLimitMetrics struct {
+	optionsLimit tally.Gauge
+	recentCount  tally.Gauge
+	recentMax    tally.Gauge
+	exceeded     tally.Counter
 }
 
 var (
@@ -164,7 +185,7 @@ func newLookbackLimit(
 		sourceLoggerBuilder,
 	)
 
-	return &lookbackLimit{
+	limit := &lookbackLimit{
 		name:      limitNames.limitName,
 		options:   opts,
 		metrics:   metrics,
@@ -174,6 +195,8 @@ func newLookbackLimit(
 		stoppedCh: make(chan struct{}),
 		iOpts:     instrumentOpts,
 	}
+	limit.tenants = limit.newTenantLimits(opts.TenantLimits)
+	return limit
 }
 
 func newLookbackLimitMetrics(
@@ -200,7 +223,59 @@ func newLookbackLimitMetrics(
 		exceeded:        metricScope.Tagged(map[string]string{"limit": metricName}).Counter("exceeded"),
 
 		sourceLogger: sourceLoggerBuilder.NewSourceLogger(metricName, loggerOpts),
+
+		metricName:  metricName,
+		metricScope: metricScope,
+	}
+}
+
+func newTenantLimitMetrics(
+	metricName string,
+	metricScope tally.Scope,
+	tenant string,
+) tenantLimitMetrics {
+	scope := metricScope.Tagged(map[string]string{"tenant": tenant})
+	return tenantLimitMetrics{
+		optionsLimit: scope.Gauge(fmt.Sprintf("tenant-current-limit-%s", metricName)),
+		recentCount:  scope.Gauge(fmt.Sprintf("tenant-recent-count-%s", metricName)),
+		recentMax:    scope.Gauge(fmt.Sprintf("tenant-recent-max-%s", metricName)),
+		exceeded:     scope.Tagged(map[string]string{"limit": metricName}).Counter("tenant-exceeded"),
+	}
+}
+
+// newTenantLimits builds the per-tenant state for the given limits, carrying
+// over the recent values of tenants that were already being tracked.
+// NB: must be called with the tenants lock held or before the limit is in use.
+func (q *lookbackLimit) newTenantLimits(limits map[string]int64) map[string]*tenantLimit {
+	if len(limits) == 0 {
+		return nil
+	}
+
+	tenants := make(map[string]*tenantLimit, len(limits))
+	for tenant, value := range limits {
+		if value == disabledLimitValue {
+			continue
+		}
+
+		t, ok := q.tenants[tenant]
+		if ok {
+			t = &tenantLimit{
+				limit:   value,
+				recent:  t.recent,
+				metrics: t.metrics,
+			}
+		} else {
+			t = &tenantLimit{
+				limit:  value,
+				recent: atomic.NewInt64(0),
+				metrics: newTenantLimitMetrics(q.metrics.metricName,
+					q.metrics.metricScope, tenant),
+			}
+		}
+		t.metrics.optionsLimit.Update(float64(value))
+		tenants[tenant] = t
 	}
+	return tenants
 }
 
 func (q *queryLimits) FetchDocsLimit() LookbackLimit {
@@ -253,6 +328,9 @@ func (q *lookbackLimit) Update(opts LookbackLimitOptions) error {
 
 	old := q.options
 	q.options = opts
+	q.tenantsLock.Lock()
+	q.tenants = q.newTenantLimits(opts.TenantLimits)
+	q.tenantsLock.Unlock()
 
 	// If the lookback changed, replace the background goroutine that manages the periodic resetting.
 	if q.options.Lookback != old.Lookback {
@@ -273,8 +351,15 @@ func (q *lookbackLimit) Inc(val int, source []byte) error {
 	if val < 0 {
 		return fmt.Errorf("invalid negative query limit inc %d", val)
 	}
+	tenant, ok := q.tenant(source)
 	if val == 0 {
-		return q.exceeded()
+		if err := q.exceeded(); err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		return q.checkTenantLimit(source, tenant, tenant.recent.Load())
 	}
 
 	// Add the new stats to the global state.
@@ -286,14 +371,53 @@ func (q *lookbackLimit) Inc(val int, source []byte) error {
 	q.metrics.total.Inc(valI64)
 	q.metrics.sourceLogger.LogSourceValue(valI64, source)
 
+	var tenantRecent int64
+	if ok {
+		tenantRecent = tenant.recent.Add(valI64)
+		tenant.metrics.recentCount.Update(float64(tenantRecent))
+	}
+
 	// Enforce limit (if specified).
-	return q.checkLimit(recent)
+	if err := q.checkLimit(recent); err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	return q.checkTenantLimit(source, tenant, tenantRecent)
 }
 
 func (q *lookbackLimit) exceeded() error {
 	return q.checkLimit(q.recent.Load())
 }
 
+func (q *lookbackLimit) tenant(source []byte) (*tenantLimit, bool) {
+	if len(source) == 0 {
+		return nil, false
+	}
+
+	q.tenantsLock.RLock()
+	tenant, ok := q.tenants[string(source)]
+	q.tenantsLock.RUnlock()
+	return tenant, ok
+}
+
+func (q *lookbackLimit) checkTenantLimit(
+	source []byte,
+	tenant *tenantLimit,
+	recent int64,
+) error {
+	if recent < tenant.limit {
+		return nil
+	}
+
+	tenant.metrics.exceeded.Inc(1)
+
+	return xerrors.NewInvalidParamsError(NewQueryLimitExceededError(fmt.Sprintf(
+		"query aborted due to tenant limit: name=%s, tenant=%s, limit=%d, current=%d, within=%s",
+		q.name, source, tenant.limit, recent, q.Options().Lookback)))
+}
+
 func (q *lookbackLimit) checkLimit(recent int64) error {
 	q.lock.RLock()
 	currentOpts := q.options
@@ -390,6 +514,14 @@ func (q *lookbackLimit) reset() {
 	// Update the standard recent gauge to reflect drop back to zero.
 	q.metrics.recentCount.Update(0)
 	q.recent.Store(0)
+
+	q.tenantsLock.RLock()
+	for _, tenant := range q.tenants {
+		tenant.metrics.recentMax.Update(float64(tenant.recent.Load()))
+		tenant.metrics.recentCount.Update(0)
+		tenant.recent.Store(0)
+	}
+	q.tenantsLock.RUnlock()
 }
 
 // Equals returns true if the other options match the current.
@@ -397,7 +529,21 @@ func (opts LookbackLimitOptions) Equals(other LookbackLimitOptions) bool {
 	return opts.Limit == other.Limit &&
 		opts.Lookback == other.Lookback &&
 		opts.ForceExceeded == other.ForceExceeded &&
-		opts.ForceWaited == other.ForceWaited
+		opts.ForceWaited == other.ForceWaited &&
+		tenantLimitsEqual(opts.TenantLimits, other.TenantLimits)
+}
+
+func tenantLimitsEqual(a, b map[string]int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for tenant, limit := range a {
+		other, ok := b[tenant]
+		if !ok || limit != other {
+			return false
+		}
+	}
+	return true
 }
 
 func (opts LookbackLimitOptions) validate() error {
@@ -407,5 +553,11 @@ func (opts LookbackLimitOptions) validate() error {
 	if opts.Lookback <= 0 {
 		return fmt.Errorf("query limit requires lookback > 0 (%d)", opts.Lookback)
 	}
+	for tenant, limit := range opts.TenantLimits {
+		if limit < 0 {
+			return fmt.Errorf("query limit requires tenant limit >= 0 (tenant=%s, limit=%d)",
+				tenant, limit)
+		}
+	}
 	return nil
 }
@@ -253,6 +253,65 @@ func verifyLimit(t *testing.T, limit *lookbackLimit, inc int, expectedLimit int6
 	return exceededCount
 }
 
+func TestLookbackLimitTenants(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	iOpts := instrument.NewOptions().SetMetricsScope(scope)
+	opts := LookbackLimitOptions{
+		Limit:    10,
+		Lookback: time.Millisecond * 100,
+		TenantLimits: map[string]int64{
+			"foo": 3,
+			"bar": 0,
+		},
+	}
+	name := "test"
+	limit := newLookbackLimit(limitNames{
+		limitName:  name,
+		metricName: name,
+		metricType: name,
+	}, opts, iOpts, &sourceLoggerBuilder{})
+
+	// Tenant limited source.
+	require.NoError(t, limit.Inc(2, []byte("foo")))
+	err := limit.Inc(1, []byte("foo"))
+	require.Error(t, err)
+	require.True(t, xerrors.IsInvalidParams(err))
+	require.True(t, IsQueryLimitExceededError(err))
+	require.Error(t, limit.Inc(0, []byte("foo")))
+
+	// Other sources are only subject to the global limit.
+	require.NoError(t, limit.Inc(0, []byte("bar")))
+	require.NoError(t, limit.Inc(2, []byte("bar")))
+	require.NoError(t, limit.Inc(2, nil))
+	require.Equal(t, int64(7), limit.current())
+
+	snapshot := scope.Snapshot()
+	tallytest.AssertCounterValue(
+		t, 2, snapshot, "query-limit.tenant-exceeded",
+		map[string]string{"type": "test", "limit": "test", "tenant": "foo"})
+	tallytest.AssertGaugeValue(
+		t, 3, snapshot, "query-limit.tenant-recent-count-test",
+		map[string]string{"type": "test", "tenant": "foo"})
+
+	// Updates retain the recent values of existing tenants.
+	opts.TenantLimits = map[string]int64{"foo": 5, "baz": 1}
+	require.NoError(t, limit.Update(opts))
+	require.NoError(t, limit.Inc(1, []byte("foo")))
+	require.Error(t, limit.Inc(1, []byte("foo")))
+	require.Error(t, limit.Inc(1, []byte("baz")))
+
+	// Resets clear the tenant values.
+	limit.reset()
+	require.NoError(t, limit.Inc(4, []byte("foo")))
+	require.Error(t, limit.Inc(1, []byte("foo")))
+
+	// The global limit is still enforced for tenants.
+	opts.TenantLimits = map[string]int64{"foo": 100}
+	require.NoError(t, limit.Update(opts))
+	require.NoError(t, limit.Inc(4, []byte("foo")))
+	require.Error(t, limit.Inc(1, []byte("foo")))
+}
+
 func TestLookbackReset(t *testing.T) {
 	scope := tally.NewTestScope("", nil)
 	iOpts := instrument.NewOptions().SetMetricsScope(scope)
@@ -283,10 +342,11 @@ func TestLookbackReset(t *testing.T) {
 
 func TestValidateLookbackLimitOptions(t *testing.T) {
 	for _, test := range []struct {
-		name        string
-		max         int64
-		lookback    time.Duration
-		expectError bool
+		name         string
+		max          int64
+		lookback     time.Duration
+		tenantLimits map[string]int64
+		expectError  bool
 	}{
 		{
 			name:     "valid lookback without limit",
@@ -316,11 +376,23 @@ func TestValidateLookbackLimitOptions(t *testing.T) {
 			lookback:    time.Millisecond,
 			expectError: true,
 		},
+		{
+			name:         "valid tenant limit",
+			lookback:     time.Millisecond,
+			tenantLimits: map[string]int64{"foo": 1},
+		},
+		{
+			name:         "negative tenant limit",
+			lookback:     time.Millisecond,
+			tenantLimits: map[string]int64{"foo": -1},
+			expectError:  true,
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			err := LookbackLimitOptions{
-				Limit:    test.max,
-				Lookback: test.lookback,
+				Limit:        test.max,
+				Lookback:     test.lookback,
+				TenantLimits: test.tenantLimits,
 			}.validate()
 			if test.expectError {
 				require.Error(t, err)
@@ -0,0 +1,243 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package limits
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/uber-go/tally"
+
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type seriesLimits struct {
+	sync.Mutex
+
+	namespace   string
+	opts        SeriesLimitsOptions
+	series      int64
+	metricNames map[string]int64
+	tenants     map[string]int64
+	// blockStart and blockSeries estimate the number of distinct series
+	// reserved for the most recent index block, they are only used to report
+	// metrics and never to enforce the limits.
+	blockStart  xtime.UnixNano
+	blockSeries *hyperLogLog
+	metrics     seriesLimitsMetrics
+}
+
+type seriesLimitsMetrics struct {
+	series             tally.Gauge
+	blockSeries        tally.Gauge
+	namespaceExceeded  tally.Counter
+	metricNameExceeded tally.Counter
+	tenantExceeded     tally.Counter
+}
+
+func newSeriesLimitsMetrics(scope tally.Scope) seriesLimitsMetrics {
+	return seriesLimitsMetrics{
+		series:      scope.Gauge("series"),
+		blockSeries: scope.Gauge("estimated-block-series"),
+		namespaceExceeded: scope.Tagged(map[string]string{
+			"limit": "namespace",
+		}).Counter("exceeded"),
+		metricNameExceeded: scope.Tagged(map[string]string{
+			"limit": "metric-name",
+		}).Counter("exceeded"),
+		tenantExceeded: scope.Tagged(map[string]string{
+			"limit": "tenant",
+		}).Counter("exceeded"),
+	}
+}
+
+// NewSeriesLimits returns series limits for the namespace.
+func NewSeriesLimits(
+	namespace string,
+	opts SeriesLimitsOptions,
+	iOpts instrument.Options,
+) SeriesLimits {
+	scope := iOpts.MetricsScope().
+		SubScope("series-limits").
+		Tagged(map[string]string{"namespace": namespace})
+	return &seriesLimits{
+		namespace:   namespace,
+		opts:        opts,
+		metricNames: make(map[string]int64),
+		tenants:     make(map[string]int64),
+		metrics:     newSeriesLimitsMetrics(scope),
+	}
+}
+
+func (l *seriesLimits) Reserve(
+	id []byte,
+	blockStart xtime.UnixNano,
+	tags TagValueResolver,
+) error {
+	// NB: resolve tags before locking since it may require decoding them.
+	limits, err := l.resolve(tags)
+	if err != nil {
+		return err
+	}
+
+	hash := xxhash.Sum64(id)
+
+	l.Lock()
+	defer l.Unlock()
+
+	if exceeded(l.series, l.opts.MaxSeries) {
+		l.metrics.namespaceExceeded.Inc(1)
+		return NewSeriesLimitExceededError(fmt.Sprintf(
+			"series limit exceeded for namespace %s: limit=%d",
+			l.namespace, l.opts.MaxSeries))
+	}
+	if exceeded(l.metricNames[limits.metricName], limits.metricNameLimit) {
+		l.metrics.metricNameExceeded.Inc(1)
+		return NewSeriesLimitExceededError(fmt.Sprintf(
+			"series limit exceeded for metric name %s in namespace %s: limit=%d",
+			limits.metricName, l.namespace, limits.metricNameLimit))
+	}
+	if exceeded(l.tenants[limits.tenant], limits.tenantLimit) {
+		l.metrics.tenantExceeded.Inc(1)
+		return NewSeriesLimitExceededError(fmt.Sprintf(
+			"series limit exceeded for tenant %s in namespace %s: limit=%d",
+			limits.tenant, l.namespace, limits.tenantLimit))
+	}
+
+	l.addWithLock(limits, 1)
+
+	if blockStart.After(l.blockStart) {
+		l.blockStart = blockStart
+		l.blockSeries = newHyperLogLog()
+	}
+	if blockStart.Equal(l.blockStart) {
+		l.blockSeries.Add(hash)
+		l.metrics.blockSeries.Update(float64(l.blockSeries.Count()))
+	}
+
+	return nil
+}
+
+func (l *seriesLimits) Add(tags TagValueResolver) error {
+	limits, err := l.resolve(tags)
+	if err != nil {
+		return err
+	}
+
+	l.Lock()
+	l.addWithLock(limits, 1)
+	l.Unlock()
+	return nil
+}
+
+func (l *seriesLimits) Remove(tags TagValueResolver) error {
+	limits, err := l.resolve(tags)
+	if err != nil {
+		return err
+	}
+
+	l.Lock()
+	l.addWithLock(limits, -1)
+	l.Unlock()
+	return nil
+}
+
+// seriesTagLimits are the values of the tags of a series limited by the
+// series limits and the limits that apply to them.
+type seriesTagLimits struct {
+	metricName      string
+	metricNameLimit int64
+	tenant          string
+	tenantLimit     int64
+}
+
+func (l *seriesLimits) resolve(tags TagValueResolver) (seriesTagLimits, error) {
+	var (
+		limits seriesTagLimits
+		err    error
+	)
+	limits.metricName, limits.metricNameLimit, err = l.tagLimit(tags,
+		l.opts.MetricNameTag, l.opts.MaxSeriesPerMetricName, l.opts.MetricNameLimits)
+	if err != nil {
+		return seriesTagLimits{}, err
+	}
+	limits.tenant, limits.tenantLimit, err = l.tagLimit(tags,
+		l.opts.TenantTag, l.opts.MaxSeriesPerTenant, l.opts.TenantLimits)
+	if err != nil {
+		return seriesTagLimits{}, err
+	}
+	return limits, nil
+}
+
+// tagLimit returns the value of the tag of the series and the limit that
+// applies to it, the limit is zero if the series is not limited by the tag.
+func (l *seriesLimits) tagLimit(
+	tags TagValueResolver,
+	tagName []byte,
+	defaultLimit int64,
+	limits map[string]int64,
+) (string, int64, error) {
+	if len(tagName) == 0 || (defaultLimit <= 0 && len(limits) == 0) {
+		return "", 0, nil
+	}
+
+	value, ok, err := tags.TagValue(tagName)
+	if err != nil || !ok {
+		return "", 0, err
+	}
+
+	if limit, ok := limits[string(value)]; ok {
+		return string(value), limit, nil
+	}
+	return string(value), defaultLimit, nil
+}
+
+// addWithLock adds delta to the number of series counted for the namespace
+// and for the tag values of the series which are limited. Tag values are
+// only counted while they are limited so the memory used is bounded by the
+// number of limited tag values with series in memory.
+func (l *seriesLimits) addWithLock(limits seriesTagLimits, delta int64) {
+	l.series += delta
+	l.metrics.series.Update(float64(l.series))
+	if limits.metricNameLimit > 0 {
+		addTagValue(l.metricNames, limits.metricName, delta)
+	}
+	if limits.tenantLimit > 0 {
+		addTagValue(l.tenants, limits.tenant, delta)
+	}
+}
+
+func addTagValue(series map[string]int64, value string, delta int64) {
+	n := series[value] + delta
+	if n <= 0 {
+		delete(series, value)
+		return
+	}
+	series[value] = n
+}
+
+// exceeded returns whether counting another series would exceed the limit,
+// a limit of zero or less is disabled.
+func exceeded(series int64, limit int64) bool {
+	return limit > 0 && series >= limit
+}
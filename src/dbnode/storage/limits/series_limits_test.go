@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package limits
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type testTags map[string]string
+
+func (t testTags) TagValue(name []byte) ([]byte, bool, error) {
+	v, ok := t[string(name)]
+	return []byte(v), ok, nil
+}
+
+func newTestSeriesLimits(opts SeriesLimitsOptions) (SeriesLimits, tally.TestScope) {
+	scope := tally.NewTestScope("", nil)
+	iOpts := instrument.NewOptions().SetMetricsScope(scope)
+	return NewSeriesLimits("ns", opts, iOpts), scope
+}
+
+func TestSeriesLimitsNamespace(t *testing.T) {
+	limits, scope := newTestSeriesLimits(SeriesLimitsOptions{MaxSeries: 2})
+	blockStart := xtime.Now().Truncate(time.Hour)
+
+	require.NoError(t, limits.Reserve([]byte("a"), blockStart, testTags{}))
+	require.NoError(t, limits.Reserve([]byte("b"), blockStart, testTags{}))
+
+	err := limits.Reserve([]byte("c"), blockStart, testTags{})
+	require.Error(t, err)
+	assert.True(t, IsSeriesLimitExceededError(err))
+	assert.Equal(t, "series limit exceeded for namespace ns: limit=2", err.Error())
+
+	// Removed series no longer count towards the limits.
+	require.NoError(t, limits.Remove(testTags{}))
+	require.NoError(t, limits.Reserve([]byte("c"), blockStart.Add(time.Hour), testTags{}))
+
+	snapshot := scope.Snapshot()
+	counter, ok := snapshot.Counters()["series-limits.exceeded+limit=namespace,namespace=ns"]
+	require.True(t, ok)
+	assert.Equal(t, int64(1), counter.Value())
+	gauge, ok := snapshot.Gauges()["series-limits.series+namespace=ns"]
+	require.True(t, ok)
+	assert.Equal(t, float64(2), gauge.Value())
+	gauge, ok = snapshot.Gauges()["series-limits.estimated-block-series+namespace=ns"]
+	require.True(t, ok)
+	assert.Equal(t, float64(1), gauge.Value())
+}
+
+func TestSeriesLimitsNamespaceExact(t *testing.T) {
+	const maxSeries = 10 * hllMaxSparse
+	limits, _ := newTestSeriesLimits(SeriesLimitsOptions{MaxSeries: maxSeries})
+	blockStart := xtime.Now().Truncate(time.Hour)
+
+	for i := 0; i < maxSeries; i++ {
+		require.NoError(t, limits.Reserve([]byte(fmt.Sprintf("series-%d", i)),
+			blockStart, testTags{}))
+	}
+	err := limits.Reserve([]byte("series-new"), blockStart, testTags{})
+	require.Error(t, err)
+	assert.True(t, IsSeriesLimitExceededError(err))
+}
+
+func TestSeriesLimitsMetricNameAndTenant(t *testing.T) {
+	limits, _ := newTestSeriesLimits(SeriesLimitsOptions{
+		MetricNameTag:          []byte("__name__"),
+		MaxSeriesPerMetricName: 1,
+		MetricNameLimits:       map[string]int64{"big": 3},
+		TenantTag:              []byte("tenant"),
+		TenantLimits:           map[string]int64{"small": 2},
+	})
+	blockStart := xtime.Now().Truncate(time.Hour)
+
+	reserve := func(id, name, tenant string) error {
+		return limits.Reserve([]byte(id), blockStart,
+			testTags{"__name__": name, "tenant": tenant})
+	}
+
+	require.NoError(t, reserve("foo-1", "foo", "large"))
+	err := reserve("foo-2", "foo", "large")
+	require.Error(t, err)
+	assert.Equal(t,
+		"series limit exceeded for metric name foo in namespace ns: limit=1",
+		err.Error())
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, reserve(fmt.Sprintf("big-%d", i), "big", "large"))
+	}
+	require.Error(t, reserve("big-3", "big", "large"))
+
+	// Tenants without a limit are not limited.
+	require.NoError(t, reserve("bar-1", "bar", "small"))
+	require.NoError(t, reserve("baz-1", "baz", "small"))
+	err = reserve("qux-1", "qux", "small")
+	require.Error(t, err)
+	assert.Equal(t,
+		"series limit exceeded for tenant small in namespace ns: limit=2",
+		err.Error())
+
+	// Rejected series are not counted against the metric name.
+	require.NoError(t, reserve("qux-2", "qux", "large"))
+
+	// Removing a series frees capacity for its metric name and tenant.
+	require.NoError(t, limits.Remove(testTags{"__name__": "bar", "tenant": "small"}))
+	require.NoError(t, reserve("qux-3", "quux", "small"))
+}
+
+func TestSeriesLimitsAddNotLimited(t *testing.T) {
+	limits, _ := newTestSeriesLimits(SeriesLimitsOptions{MaxSeries: 1})
+	blockStart := xtime.Now().Truncate(time.Hour)
+
+	// Series added without being reserved are counted regardless of the
+	// limits.
+	require.NoError(t, limits.Add(testTags{}))
+	require.NoError(t, limits.Add(testTags{}))
+	require.Error(t, limits.Reserve([]byte("a"), blockStart, testTags{}))
+
+	require.NoError(t, limits.Remove(testTags{}))
+	require.Error(t, limits.Reserve([]byte("a"), blockStart, testTags{}))
+	require.NoError(t, limits.Remove(testTags{}))
+	require.NoError(t, limits.Reserve([]byte("a"), blockStart, testTags{}))
+}
@@ -18,13 +18,14 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
-// Package limits contains paths to enforce read query limits.
+// Package limits contains paths to enforce read query and series write limits.
 package limits
 
 import (
 	"time"
 
 	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
 )
 
 // Key is a specific string type for context setting.
@@ -79,6 +80,56 @@ type LookbackLimitOptions struct {
 	ForceExceeded bool
 	// ForceWaited, if true, makes all calls to the limit behave as though the caller waited for permits.
 	ForceWaited bool
+	// TenantLimits are limits keyed by the query source, enforced over the
+	// same lookback in addition to the global limit. Zero disables the limit
+	// for a tenant.
+	TenantLimits map[string]int64
+}
+
+// SeriesLimits enforces limits on the number of series of a namespace held
+// in memory. Series are counted exactly as they are inserted and removed by
+// the shards of the namespace, so only writes of new series are checked.
+type SeriesLimits interface {
+	// Reserve counts a new series before it is inserted, it returns an error
+	// and does not count the series if counting it would exceed any of the
+	// limits. The series is also added to the estimate of the number of
+	// distinct series written to the index block.
+	Reserve(id []byte, blockStart xtime.UnixNano, tags TagValueResolver) error
+
+	// Add counts a series inserted without being reserved, such as a series
+	// loaded while bootstrapping, regardless of the limits.
+	Add(tags TagValueResolver) error
+
+	// Remove stops counting a series that was removed from memory, or that
+	// was reserved but not inserted.
+	Remove(tags TagValueResolver) error
+}
+
+// TagValueResolver resolves the value of a tag of a series.
+type TagValueResolver interface {
+	// TagValue returns the value of the tag with the given name.
+	TagValue(name []byte) ([]byte, bool, error)
+}
+
+// SeriesLimitsOptions holds options for the series limits of a namespace,
+// a limit of zero disables the limit.
+type SeriesLimitsOptions struct {
+	// MaxSeries is the maximum number of series of the namespace.
+	MaxSeries int64
+	// MetricNameTag is the name of the tag holding the metric name.
+	MetricNameTag []byte
+	// MaxSeriesPerMetricName is the maximum number of series per metric name.
+	MaxSeriesPerMetricName int64
+	// MetricNameLimits are limits keyed by metric name which override
+	// MaxSeriesPerMetricName.
+	MetricNameLimits map[string]int64
+	// TenantTag is the name of the tag holding the tenant of a series.
+	TenantTag []byte
+	// MaxSeriesPerTenant is the maximum number of series per tenant.
+	MaxSeriesPerTenant int64
+	// TenantLimits are limits keyed by tenant which override
+	// MaxSeriesPerTenant.
+	TenantLimits map[string]int64
 }
 
 // SourceLoggerBuilder builds a SourceLogger given instrument options.
@@ -133,4 +184,10 @@ type Options interface {
 
 	// SourceLogger sets the source logger.
 	SourceLoggerBuilder() SourceLoggerBuilder
+
+	// SetSeriesLimitsOpts sets the series limits options keyed by namespace.
+	SetSeriesLimitsOpts(value map[string]SeriesLimitsOptions) Options
+
+	// SeriesLimitsOpts returns the series limits options keyed by namespace.
+	SeriesLimitsOpts() map[string]SeriesLimitsOptions
 }
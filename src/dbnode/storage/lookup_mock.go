@@ -28,6 +28,7 @@ import (
 	"reflect"
 
 	"github.com/m3db/m3/src/dbnode/ts/writes"
+	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/time"
 
 	"github.com/golang/mock/gomock"
@@ -70,6 +71,18 @@ func (mr *MockIndexWriterMockRecorder) BlockStartForWriteTime(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockStartForWriteTime", reflect.TypeOf((*MockIndexWriter)(nil).BlockStartForWriteTime), arg0)
 }
 
+// DeleteSeries mocks base method.
+func (m *MockIndexWriter) DeleteSeries(arg0 ident.ID, arg1 time.Range) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteSeries", arg0, arg1)
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockIndexWriterMockRecorder) DeleteSeries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockIndexWriter)(nil).DeleteSeries), arg0, arg1)
+}
+
 // WritePending mocks base method.
 func (m *MockIndexWriter) WritePending(arg0 []writes.PendingIndexInsert) error {
 	m.ctrl.T.Helper()
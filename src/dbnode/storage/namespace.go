@@ -42,12 +42,14 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/dbnode/ts/writes"
 	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/m3ninx/index/segment/fst/encoding/docs"
 	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
 	"github.com/m3db/m3/src/x/clock"
 	"github.com/m3db/m3/src/x/context"
@@ -74,35 +76,35 @@ type commitLogWriter interface {
 		unit xtime.Unit,
 		annotation ts.Annotation,
 	) error
+
+	WriteTombstone(
+		ctx context.Context,
+		series ts.Series,
+		r xtime.Range,
+	) error
 }
 
-type commitLogWriterFn func(
-	ctx context.Context,
-	series ts.Series,
-	datapoint ts.Datapoint,
-	unit xtime.Unit,
-	annotation ts.Annotation,
-) error
+type commitLogWriterNoOp struct{}
 
-func (fn commitLogWriterFn) Write(
+func (commitLogWriterNoOp) Write(
 	ctx context.Context,
 	series ts.Series,
 	datapoint ts.Datapoint,
 	unit xtime.Unit,
 	annotation ts.Annotation,
 ) error {
-	return fn(ctx, series, datapoint, unit, annotation)
+	return nil
 }
 
-var commitLogWriteNoOp = commitLogWriter(commitLogWriterFn(func(
+func (commitLogWriterNoOp) WriteTombstone(
 	ctx context.Context,
 	series ts.Series,
-	datapoint ts.Datapoint,
-	unit xtime.Unit,
-	annotation ts.Annotation,
+	r xtime.Range,
 ) error {
 	return nil
-}))
+}
+
+var commitLogWriteNoOp = commitLogWriter(commitLogWriterNoOp{})
 
 type createEmptyWarmIndexIfNotExistsFn func(blockStart xtime.UnixNano) error
 
@@ -138,6 +140,7 @@ type dbNamespace struct {
 	increasingIndex increasingIndex
 	commitLogWriter commitLogWriter
 	reverseIndex    NamespaceIndex
+	seriesLimits    limits.SeriesLimits
 
 	createEmptyWarmIndexIfNotExistsFn createEmptyWarmIndexIfNotExistsFn
 
@@ -174,6 +177,8 @@ type databaseNamespaceMetrics struct {
 	fetchBlocksMetadata instrument.MethodMetrics
 	queryIDs            instrument.MethodMetrics
 	aggregateQuery      instrument.MethodMetrics
+	cardinalityQuery    instrument.MethodMetrics
+	deleteTagged        instrument.MethodMetrics
 
 	unfulfilled             tally.Counter
 	bootstrapStart          tally.Counter
@@ -263,6 +268,8 @@ func newDatabaseNamespaceMetrics(
 		fetchBlocksMetadata: instrument.NewMethodMetrics(scope, "fetchBlocksMetadata", opts),
 		queryIDs:            instrument.NewMethodMetrics(scope, "queryIDs", opts),
 		aggregateQuery:      instrument.NewMethodMetrics(scope, "aggregateQuery", opts),
+		cardinalityQuery:    instrument.NewMethodMetrics(scope, "cardinalityQuery", opts),
+		deleteTagged:        instrument.NewMethodMetrics(scope, "deleteTagged", opts),
 
 		unfulfilled:             bootstrapScope.Counter("unfulfilled"),
 		bootstrapStart:          bootstrapScope.Counter("start"),
@@ -366,6 +373,13 @@ func newDatabaseNamespace(
 		}
 	}
 
+	var seriesLimits limits.SeriesLimits
+	if limitsOpts := opts.LimitsOptions(); limitsOpts != nil {
+		if seriesLimitsOpts, ok := limitsOpts.SeriesLimitsOpts()[id.String()]; ok {
+			seriesLimits = limits.NewSeriesLimits(id.String(), seriesLimitsOpts, iops)
+		}
+	}
+
 	n := &dbNamespace{
 		id:                     id,
 		shutdownCh:             make(chan struct{}),
@@ -382,6 +396,7 @@ func newDatabaseNamespace(
 		increasingIndex:        increasingIndex,
 		commitLogWriter:        commitLogWriter,
 		reverseIndex:           index,
+		seriesLimits:           seriesLimits,
 		tickWorkers:            tickWorkers,
 		tickWorkersConcurrency: tickWorkersConcurrency,
 		metrics:                newDatabaseNamespaceMetrics(scope, iops.TimerOptions()),
@@ -544,7 +559,7 @@ func (n *dbNamespace) assignShardSet(
 		// shard created for this shard ID.
 		n.shards[shard] = newDatabaseShard(metadata, shard, n.blockRetriever,
 			n.namespaceReaderMgr, n.increasingIndex, n.reverseIndex,
-			opts.needsBootstrap, n.opts, n.seriesOpts)
+			n.seriesLimits, opts.needsBootstrap, n.opts, n.seriesOpts)
 		createdShardIds = append(createdShardIds, shard)
 		// NB(bodu): We only record shard add metrics for shards created in non
 		// initial assignments.
@@ -840,6 +855,77 @@ func (n *dbNamespace) QueryIDs(
 	return res, err
 }
 
+func (n *dbNamespace) DeleteTagged(
+	ctx context.Context,
+	query index.Query,
+	start, end xtime.UnixNano,
+) (int64, error) {
+	callStart := n.nowFn()
+	if n.ReadOnly() {
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return 0, errNamespaceReadOnly
+	}
+
+	if n.reverseIndex == nil {
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return 0, errNamespaceIndexingDisabled
+	}
+
+	if !n.reverseIndex.Bootstrapped() {
+		// Similar to reading shard data, return not bootstrapped
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return 0, xerrors.NewRetryableError(errIndexNotBootstrappedToRead)
+	}
+
+	res, err := n.reverseIndex.Query(ctx, query, index.QueryOptions{
+		StartInclusive: start,
+		EndExclusive:   end,
+	})
+	if err != nil {
+		n.metrics.deleteTagged.ReportError(n.nowFn().Sub(callStart))
+		return 0, err
+	}
+
+	var (
+		deleted  int64
+		multiErr xerrors.MultiError
+		r        = xtime.Range{Start: start, End: end}
+		reader   = docs.NewEncodedDocumentReader()
+	)
+	for _, entry := range res.Results.Map().Iter() {
+		metadata, err := docs.MetadataFromDocument(entry.Value(), reader)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+
+		// NB: The shard copies the metadata if the series is not already
+		// in memory so it's safe to reference the query results here.
+		id := ident.BytesID(metadata.ID)
+		shard, _, err := n.shardFor(id)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		series, err := shard.DeleteSeries(metadata, r)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+
+		// Log the tombstone so that the deletion survives a restart.
+		if err := n.commitLogWriter.WriteTombstone(ctx, series, r); err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		deleted++
+	}
+
+	err = multiErr.FinalError()
+	n.metrics.deleteTagged.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
+	return deleted, err
+}
+
 func (n *dbNamespace) AggregateQuery(
 	ctx context.Context,
 	query index.Query,
@@ -863,6 +949,29 @@ func (n *dbNamespace) AggregateQuery(
 	return res, err
 }
 
+func (n *dbNamespace) CardinalityQuery(
+	ctx context.Context,
+	query index.Query,
+	opts index.QueryOptions,
+) (index.CardinalityQueryResult, error) {
+	callStart := n.nowFn()
+	if n.reverseIndex == nil {
+		n.metrics.cardinalityQuery.ReportError(n.nowFn().Sub(callStart))
+		return index.CardinalityQueryResult{}, errNamespaceIndexingDisabled
+	}
+
+	if !n.reverseIndex.Bootstrapped() {
+		// Similar to reading shard data, return not bootstrapped
+		n.metrics.cardinalityQuery.ReportError(n.nowFn().Sub(callStart))
+		return index.CardinalityQueryResult{},
+			xerrors.NewRetryableError(errIndexNotBootstrappedToRead)
+	}
+
+	res, err := n.reverseIndex.CardinalityQuery(ctx, query, opts)
+	n.metrics.cardinalityQuery.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
+	return res, err
+}
+
 func (n *dbNamespace) PrepareBootstrap(ctx context.Context) ([]databaseShard, error) {
 	ctx, span, sampled := ctx.StartSampledTraceSpan(tracepoint.NSPrepareBootstrap)
 	defer span.Finish()
@@ -1380,6 +1489,12 @@ func (n *dbNamespace) Snapshot(
 			log.Debug("skipping snapshot due to shard not bootstrapped yet")
 			continue
 		}
+		// NB: Snapshots run after the commit log is rotated and the commit logs
+		// before the rotation are removed once the snapshot completes, so
+		// tombstones are logged again to keep them in the retained commit logs.
+		if err := n.relogTombstones(shard); err != nil {
+			multiErr = multiErr.Add(err)
+		}
 		snapshotBlockStarts := shard.FilterBlocksNeedSnapshot(blockStarts)
 		if len(snapshotBlockStarts) == 0 {
 			log.Debug("skipping shard snapshot since no blocks need it")
@@ -1403,6 +1518,19 @@ func (n *dbNamespace) Snapshot(
 	return res
 }
 
+func (n *dbNamespace) relogTombstones(shard databaseShard) error {
+	ctx := n.opts.ContextPool().Get()
+	defer ctx.Close()
+
+	var multiErr xerrors.MultiError
+	shard.ForEachTombstone(func(series ts.Series, r xtime.Range) {
+		if err := n.commitLogWriter.WriteTombstone(ctx, series, r); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	})
+	return multiErr.FinalError()
+}
+
 func (n *dbNamespace) NeedsFlush(
 	alignedInclusiveStart xtime.UnixNano,
 	alignedInclusiveEnd xtime.UnixNano,
@@ -50,6 +50,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/tracepoint"
+	"github.com/m3db/m3/src/dbnode/ts"
 	xmetrics "github.com/m3db/m3/src/dbnode/x/metrics"
 	xidx "github.com/m3db/m3/src/m3ninx/idx"
 	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
@@ -831,6 +832,59 @@ func TestNamespaceSnapshotShardBlockAllShardsFiltered(t *testing.T) {
 	require.NoError(t, err)
 }
 
+type testTombstoneCommitLogWriter struct {
+	commitLogWriterNoOp
+
+	tombstones []xtime.Range
+}
+
+func (w *testTombstoneCommitLogWriter) WriteTombstone(
+	ctx context.Context,
+	series ts.Series,
+	r xtime.Range,
+) error {
+	w.tombstones = append(w.tombstones, r)
+	return nil
+}
+
+func TestNamespaceSnapshotRelogsTombstones(t *testing.T) {
+	var (
+		ctrl       = xtest.NewController(t)
+		now        = xtime.Now()
+		ns, closer = newTestNamespaceWithIDOpts(t, defaultTestNs1ID,
+			namespace.NewOptions().SetSnapshotEnabled(true))
+	)
+	defer func() {
+		ctrl.Finish()
+		closer()
+	}()
+
+	var (
+		blockSize = ns.Options().RetentionOptions().BlockSize()
+		blocks    = []xtime.UnixNano{now.Truncate(blockSize)}
+		deleted   = xtime.Range{Start: blocks[0], End: blocks[0].Add(time.Minute)}
+		writer    = &testTombstoneCommitLogWriter{}
+	)
+
+	ns.bootstrapState = Bootstrapped
+	ns.nowFn = func() time.Time { return now.ToTime() }
+	ns.commitLogWriter = writer
+
+	// Tombstones are logged even when the shard has no blocks to snapshot.
+	shard := NewMockdatabaseShard(ctrl)
+	shard.EXPECT().ID().Return(testShardIDs[0].ID()).AnyTimes()
+	shard.EXPECT().IsBootstrapped().Return(true)
+	shard.EXPECT().ForEachTombstone(gomock.Any()).Do(
+		func(fn func(ts.Series, xtime.Range)) {
+			fn(ts.Series{ID: ident.StringID("foo")}, deleted)
+		})
+	shard.EXPECT().FilterBlocksNeedSnapshot(blocks).Return([]xtime.UnixNano{})
+	ns.shards[shard.ID()] = shard
+
+	require.NoError(t, ns.Snapshot(blocks, now, nil))
+	require.Equal(t, []xtime.Range{deleted}, writer.tombstones)
+}
+
 func testSnapshotWithShardSnapshotErrs(
 	t *testing.T,
 	shardMethodResults []snapshotTestCase,
@@ -864,6 +918,7 @@ func testSnapshotWithShardSnapshotErrs(
 		if !tc.isBootstrapped {
 			continue
 		}
+		shard.EXPECT().ForEachTombstone(gomock.Any())
 		shard.EXPECT().
 			FilterBlocksNeedSnapshot([]xtime.UnixNano{blockStart}).
 			Return([]xtime.UnixNano{blockStart})
@@ -1857,5 +1912,6 @@ func newTestShard(ctrl *gomock.Controller) *MockdatabaseShard {
 	shardID := testShardIDs[0].ID()
 	s.EXPECT().ID().Return(shardID).AnyTimes()
 	s.EXPECT().IsBootstrapped().Return(true)
+	s.EXPECT().ForEachTombstone(gomock.Any()).AnyTimes()
 	return s
 }
@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -35,6 +36,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/digest"
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/storage/block"
@@ -75,8 +77,10 @@ type shardRepairer struct {
 }
 
 type shardRepairerMetrics struct {
-	runDefault     tally.Counter
-	runOnlyCompare tally.Counter
+	runDefault                tally.Counter
+	runOnlyCompare            tally.Counter
+	merkleTreeLeaves          tally.Counter
+	merkleTreeLeavesDiffering tally.Counter
 }
 
 func newShardRepairerMetrics(scope tally.Scope) shardRepairerMetrics {
@@ -87,6 +91,8 @@ func newShardRepairerMetrics(scope tally.Scope) shardRepairerMetrics {
 		runOnlyCompare: scope.Tagged(map[string]string{
 			"repair_type": "only_compare",
 		}).Counter("run"),
+		merkleTreeLeaves:          scope.Counter("merkle-tree-leaves"),
+		merkleTreeLeavesDiffering: scope.Counter("merkle-tree-leaves-differing"),
 	}
 }
 
@@ -163,40 +169,9 @@ func (r shardRepairer) Repair(
 	ctx.RegisterFinalizer(metadata)
 
 	// Add local metadata.
-	opts := block.FetchBlocksMetadataOptions{
-		IncludeSizes:     true,
-		IncludeChecksums: true,
-	}
-	var (
-		accumLocalMetadata = block.NewFetchBlocksMetadataResults()
-		pageToken          PageToken
-		err                error
-	)
-	// Safe to register since by the time this function completes we won't be using the metadata
-	// for anything anymore.
-	ctx.RegisterCloser(accumLocalMetadata)
-
-	for {
-		// It's possible for FetchBlocksMetadataV2 to not return all the metadata at once even if
-		// math.MaxInt64 is passed as the limit due to its implementation and the different phases
-		// of the page token. As a result, the only way to ensure that all the metadata has been
-		// fetched is to continue looping until a nil pageToken is returned.
-		var currLocalMetadata block.FetchBlocksMetadataResults
-		currLocalMetadata, pageToken, err = shard.FetchBlocksMetadataV2(ctx, start, end, math.MaxInt64, pageToken, opts)
-		if err != nil {
-			return repair.MetadataComparisonResult{}, err
-		}
-
-		// Merge.
-		if currLocalMetadata != nil {
-			for _, result := range currLocalMetadata.Results() {
-				accumLocalMetadata.Add(result)
-			}
-		}
-
-		if pageToken == nil {
-			break
-		}
+	accumLocalMetadata, err := fetchShardBlocksMetadata(ctx, shard, start, end)
+	if err != nil {
+		return repair.MetadataComparisonResult{}, err
 	}
 
 	if r.rpopts.DebugShadowComparisonsEnabled() {
@@ -212,26 +187,34 @@ func (r shardRepairer) Repair(
 		}
 	}
 
-	localIter := block.NewFilteredBlocksMetadataIter(accumLocalMetadata)
-	err = metadata.AddLocalMetadata(localIter)
-	if err != nil {
-		return repair.MetadataComparisonResult{}, err
-	}
-
 	var (
 		rsOpts = r.opts.RepairOptions().ResultOptions()
 		level  = r.rpopts.RepairConsistencyLevel()
 	)
-	for _, sesTopo := range sessions {
-		// Add peer metadata.
-		peerIter, err := sesTopo.session.FetchBlocksMetadataFromPeers(nsCtx.ID, shard.ID(), start, end,
-			level, rsOpts)
+	if r.rpopts.MerkleTreeEnabled() {
+		err = r.addMerkleTreeLeavesMetadata(ctx, metadata, sessions, nsCtx, shard,
+			start, end, accumLocalMetadata)
 		if err != nil {
 			return repair.MetadataComparisonResult{}, err
 		}
-		if err := metadata.AddPeerMetadata(peerIter); err != nil {
+	} else {
+		localIter := block.NewFilteredBlocksMetadataIter(accumLocalMetadata)
+		err = metadata.AddLocalMetadata(localIter)
+		if err != nil {
 			return repair.MetadataComparisonResult{}, err
 		}
+
+		for _, sesTopo := range sessions {
+			// Add peer metadata.
+			peerIter, err := sesTopo.session.FetchBlocksMetadataFromPeers(nsCtx.ID, shard.ID(), start, end,
+				level, rsOpts)
+			if err != nil {
+				return repair.MetadataComparisonResult{}, err
+			}
+			if err := metadata.AddPeerMetadata(peerIter); err != nil {
+				return repair.MetadataComparisonResult{}, err
+			}
+		}
 	}
 
 	var (
@@ -340,6 +323,153 @@ func (r shardRepairer) Repair(
 	return metadataRes, nil
 }
 
+// addMerkleTreeLeavesMetadata compares the Merkle tree of the local shard with the
+// trees of the peers and adds the local and peer metadata of only the series
+// assigned to the leaves that differ to the comparer.
+func (r shardRepairer) addMerkleTreeLeavesMetadata(
+	ctx context.Context,
+	metadata repair.ReplicaMetadataComparer,
+	sessions []sessionAndTopo,
+	nsCtx namespace.Context,
+	shard databaseShard,
+	start, end xtime.UnixNano,
+	localMetadata block.FetchBlocksMetadataResults,
+) error {
+	var (
+		depth  = r.rpopts.MerkleTreeDepth()
+		level  = r.rpopts.RepairConsistencyLevel()
+		rsOpts = r.opts.RepairOptions().ResultOptions()
+	)
+	localTree, err := shard.FetchBlocksMerkleTree(ctx, start, end, depth)
+	if err != nil {
+		return err
+	}
+
+	differing := make(map[int]struct{})
+	for _, sesTopo := range sessions {
+		peerTrees, err := sesTopo.session.FetchBlocksMerkleTreesFromPeers(nsCtx.ID, shard.ID(),
+			start, end, depth, level)
+		if err != nil {
+			return err
+		}
+		for _, peerTree := range peerTrees {
+			leaves, err := localTree.DifferingLeaves(peerTree.Tree)
+			if err != nil {
+				return fmt.Errorf("error comparing merkle tree of peer %s: %v",
+					peerTree.Host.ID(), err)
+			}
+			for _, leaf := range leaves {
+				differing[leaf] = struct{}{}
+			}
+		}
+	}
+
+	r.metrics.merkleTreeLeaves.Inc(int64(1 << uint(depth)))
+	r.metrics.merkleTreeLeavesDiffering.Inc(int64(len(differing)))
+	if len(differing) == 0 {
+		// All replicas agree, no need to fetch any metadata from peers.
+		return nil
+	}
+
+	leaves := make([]int, 0, len(differing))
+	for leaf := range differing {
+		leaves = append(leaves, leaf)
+	}
+	sort.Ints(leaves)
+
+	localLeavesMetadata := filterBlocksMetadataByMerkleTreeLeaves(localMetadata, depth, leaves)
+	localIter := block.NewFilteredBlocksMetadataIter(localLeavesMetadata)
+	if err := metadata.AddLocalMetadata(localIter); err != nil {
+		return err
+	}
+
+	for _, sesTopo := range sessions {
+		// Peers whose trees match the local tree still need to be fetched from
+		// since the comparison requires the metadata of all replicas.
+		peerIter, err := sesTopo.session.FetchBlocksMerkleTreeLeavesMetadataFromPeers(nsCtx.ID,
+			shard.ID(), start, end, depth, leaves, level, rsOpts)
+		if err != nil {
+			return err
+		}
+		if err := metadata.AddPeerMetadata(peerIter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetchShardBlocksMetadata fetches the blocks metadata including sizes and checksums
+// of a shard for a time range, the results are closed along with the context.
+func fetchShardBlocksMetadata(
+	ctx context.Context,
+	shard databaseShard,
+	start, end xtime.UnixNano,
+) (block.FetchBlocksMetadataResults, error) {
+	opts := block.FetchBlocksMetadataOptions{
+		IncludeSizes:     true,
+		IncludeChecksums: true,
+	}
+	var (
+		accumMetadata = block.NewFetchBlocksMetadataResults()
+		pageToken     PageToken
+		err           error
+	)
+	// Safe to register since by the time the context is closed the metadata
+	// is not used for anything anymore.
+	ctx.RegisterCloser(accumMetadata)
+
+	for {
+		// It's possible for FetchBlocksMetadataV2 to not return all the metadata at once even if
+		// math.MaxInt64 is passed as the limit due to its implementation and the different phases
+		// of the page token. As a result, the only way to ensure that all the metadata has been
+		// fetched is to continue looping until a nil pageToken is returned.
+		var currMetadata block.FetchBlocksMetadataResults
+		currMetadata, pageToken, err = shard.FetchBlocksMetadataV2(ctx, start, end, math.MaxInt64, pageToken, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		// Merge.
+		if currMetadata != nil {
+			for _, result := range currMetadata.Results() {
+				accumMetadata.Add(result)
+			}
+		}
+
+		if pageToken == nil {
+			return accumMetadata, nil
+		}
+	}
+}
+
+// filterBlocksMetadataByMerkleTreeLeaves returns the blocks metadata of the series
+// assigned to the given leaves. The returned results share the metadata with the
+// results being filtered and so must not be closed.
+func filterBlocksMetadataByMerkleTreeLeaves(
+	results block.FetchBlocksMetadataResults,
+	depth int,
+	leaves []int,
+) block.FetchBlocksMetadataResults {
+	filtered := block.NewFetchBlocksMetadataResults()
+	if len(leaves) == 0 {
+		return filtered
+	}
+
+	include := make(map[int]struct{}, len(leaves))
+	for _, leaf := range leaves {
+		include[leaf] = struct{}{}
+	}
+	for _, result := range results.Results() {
+		leaf := digest.MerkleTreeLeaf(result.ID.Bytes(), depth)
+		if _, ok := include[leaf]; ok {
+			filtered.Add(result)
+		}
+	}
+
+	return filtered
+}
+
 // TODO(rartoul): Currently throttling via the MemoryTracker can only occur at the level of an entire
 // block for a given namespace/shard/blockStart. For almost all practical use-cases this is fine, but
 // this could be improved and made more granular by breaking data that is being loaded into the shard
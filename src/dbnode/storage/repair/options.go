@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/digest"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/topology"
 )
@@ -41,6 +42,8 @@ const (
 	defaultRepairShardConcurrency           = 1
 	defaultDebugShadowComparisonsEnabled    = false
 	defaultDebugShadowComparisonsPercentage = 1.0
+	defaultMerkleTreeEnabled                = false
+	defaultMerkleTreeDepth                  = 10
 )
 
 var (
@@ -65,6 +68,8 @@ type options struct {
 	resultOptions                    result.Options
 	debugShadowComparisonsEnabled    bool
 	debugShadowComparisonsPercentage float64
+	merkleTreeEnabled                bool
+	merkleTreeDepth                  int
 }
 
 // NewOptions creates new bootstrap options
@@ -80,6 +85,8 @@ func NewOptions() Options {
 		resultOptions:                    result.NewOptions(),
 		debugShadowComparisonsEnabled:    defaultDebugShadowComparisonsEnabled,
 		debugShadowComparisonsPercentage: defaultDebugShadowComparisonsPercentage,
+		merkleTreeEnabled:                defaultMerkleTreeEnabled,
+		merkleTreeDepth:                  defaultMerkleTreeDepth,
 	}
 }
 
@@ -203,6 +210,26 @@ func (o *options) DebugShadowComparisonsPercentage() float64 {
 	return o.debugShadowComparisonsPercentage
 }
 
+func (o *options) SetMerkleTreeEnabled(value bool) Options {
+	opts := *o
+	opts.merkleTreeEnabled = value
+	return &opts
+}
+
+func (o *options) MerkleTreeEnabled() bool {
+	return o.merkleTreeEnabled
+}
+
+func (o *options) SetMerkleTreeDepth(value int) Options {
+	opts := *o
+	opts.merkleTreeDepth = value
+	return &opts
+}
+
+func (o *options) MerkleTreeDepth() int {
+	return o.merkleTreeDepth
+}
+
 func (o *options) Validate() error {
 	if len(o.adminClients) == 0 {
 		return errNoAdminClient
@@ -239,5 +266,10 @@ func (o *options) Validate() error {
 		o.debugShadowComparisonsPercentage < 0 {
 		return errInvalidDebugShadowComparisonsPercentage
 	}
+	if o.merkleTreeEnabled {
+		if err := digest.ValidateMerkleTreeDepth(o.merkleTreeDepth); err != nil {
+			return err
+		}
+	}
 	return nil
 }
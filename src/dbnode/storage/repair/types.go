@@ -410,6 +410,22 @@ type Options interface {
 	// DebugShadowComparisonsPercentage returns the debug shadow comparisons percentage.
 	DebugShadowComparisonsPercentage() float64
 
+	// SetMerkleTreeEnabled sets whether repairs exchange Merkle trees of the
+	// blocks metadata with peers and only compare the metadata of the series
+	// in the leaves that differ.
+	SetMerkleTreeEnabled(value bool) Options
+
+	// MerkleTreeEnabled returns whether repairs exchange Merkle trees of the
+	// blocks metadata with peers and only compare the metadata of the series
+	// in the leaves that differ.
+	MerkleTreeEnabled() bool
+
+	// SetMerkleTreeDepth sets the depth of the Merkle trees exchanged with peers.
+	SetMerkleTreeDepth(value int) Options
+
+	// MerkleTreeDepth returns the depth of the Merkle trees exchanged with peers.
+	MerkleTreeDepth() int
+
 	// Validate checks if the options are valid.
 	Validate() error
 }
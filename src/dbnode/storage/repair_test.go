@@ -31,6 +31,7 @@ import (
 	"github.com/uber-go/tally"
 
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/digest"
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/storage/block"
@@ -326,6 +327,141 @@ func testDatabaseShardRepairerRepair(t *testing.T, withLimit bool) {
 	}
 }
 
+func TestDatabaseShardRepairerRepairMerkleTree(t *testing.T) {
+	testDatabaseShardRepairerRepairMerkleTree(t, false)
+}
+
+func TestDatabaseShardRepairerRepairMerkleTreeNoDifferences(t *testing.T) {
+	testDatabaseShardRepairerRepairMerkleTree(t, true)
+}
+
+func testDatabaseShardRepairerRepairMerkleTree(t *testing.T, identical bool) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	session := client.NewMockAdminSession(ctrl)
+	session.EXPECT().Origin().Return(topology.NewHost("0", "addr0")).AnyTimes()
+	session.EXPECT().TopologyMap().AnyTimes()
+
+	mockClient := client.NewMockAdminClient(ctrl)
+	mockClient.EXPECT().DefaultAdminSession().Return(session, nil).AnyTimes()
+
+	var (
+		depth  = 8
+		rpOpts = testRepairOptions(ctrl).
+			SetAdminClients([]client.AdminClient{mockClient}).
+			SetType(repair.OnlyCompareRepair).
+			SetMerkleTreeEnabled(true).
+			SetMerkleTreeDepth(depth)
+		now    = xtime.Now()
+		opts   = DefaultTestOptions()
+		rtopts = defaultTestRetentionOpts
+	)
+	opts = opts.
+		SetClockOptions(opts.ClockOptions().SetNowFn(now.ToTime)).
+		SetInstrumentOptions(opts.InstrumentOptions().SetMetricsScope(tally.NoopScope))
+
+	var (
+		namespaceID = ident.StringID("testNamespace")
+		start       = now
+		end         = now.Add(rtopts.BlockSize())
+		blockStart  = now.Add(30 * time.Minute)
+		fetchOpts   = block.FetchBlocksMetadataOptions{
+			IncludeSizes:     true,
+			IncludeChecksums: true,
+		}
+		checksums = []uint32{4, 5, 6}
+		shardID   = uint32(0)
+		shard     = NewMockdatabaseShard(ctrl)
+	)
+
+	newResults := func(barChecksum *uint32) block.FetchBlocksMetadataResults {
+		res := block.NewFetchBlocksMetadataResults()
+		blocks := block.NewFetchBlockMetadataResults()
+		blocks.Add(block.NewFetchBlockMetadataResult(blockStart, 1, &checksums[0], 0, nil))
+		res.Add(block.NewFetchBlocksMetadataResult(ident.StringID("foo"), nil, blocks))
+		blocks = block.NewFetchBlockMetadataResults()
+		blocks.Add(block.NewFetchBlockMetadataResult(blockStart, 2, barChecksum, 0, nil))
+		res.Add(block.NewFetchBlocksMetadataResult(ident.StringID("bar"), nil, blocks))
+		return res
+	}
+
+	newTree := func(results block.FetchBlocksMetadataResults) digest.MerkleTree {
+		builder, err := digest.NewMerkleTreeBuilder(depth)
+		require.NoError(t, err)
+		addBlocksMetadataToMerkleTree(builder, results)
+		return builder.Build()
+	}
+
+	shard.EXPECT().
+		FetchBlocksMetadataV2(gomock.Any(), start, end, gomock.Any(), nil, fetchOpts).
+		Return(newResults(&checksums[1]), nil, nil)
+	shard.EXPECT().
+		FetchBlocksMerkleTree(gomock.Any(), start, end, depth).
+		Return(newTree(newResults(&checksums[1])), nil)
+	shard.EXPECT().ID().Return(shardID).AnyTimes()
+
+	peerChecksum := &checksums[2]
+	if identical {
+		peerChecksum = &checksums[1]
+	}
+	peerTree := newTree(newResults(peerChecksum))
+
+	peerHost := topology.NewHost("1", "addr1")
+	session.EXPECT().
+		FetchBlocksMerkleTreesFromPeers(namespaceID, shardID, start, end, depth,
+			rpOpts.RepairConsistencyLevel()).
+		Return([]client.PeerBlocksMerkleTree{{Host: peerHost, Tree: peerTree}}, nil)
+
+	peerMetadata := block.NewMetadata(ident.StringID("bar"), ident.Tags{},
+		blockStart, 2, peerChecksum, 0)
+	if !identical {
+		peerIter := client.NewMockPeerBlockMetadataIter(ctrl)
+		gomock.InOrder(
+			peerIter.EXPECT().Next().Return(true),
+			peerIter.EXPECT().Current().Return(peerHost, peerMetadata),
+			peerIter.EXPECT().Next().Return(false),
+			peerIter.EXPECT().Err().Return(nil),
+		)
+		leaves := []int{digest.MerkleTreeLeaf([]byte("bar"), depth)}
+		session.EXPECT().
+			FetchBlocksMerkleTreeLeavesMetadataFromPeers(namespaceID, shardID, start, end,
+				depth, leaves, rpOpts.RepairConsistencyLevel(), gomock.Any()).
+			Return(peerIter, nil)
+	}
+
+	var resDiff repair.MetadataComparisonResult
+	repairer := newShardRepairer(opts, rpOpts).(shardRepairer)
+	repairer.record = func(origin topology.Host, nsID ident.ID, shard databaseShard,
+		diffRes repair.MetadataComparisonResult) {
+		resDiff = diffRes
+	}
+
+	var (
+		ctx   = context.NewBackground()
+		nsCtx = namespace.Context{ID: namespaceID}
+	)
+	nsMeta, err := namespace.NewMetadata(namespaceID, namespace.NewOptions())
+	require.NoError(t, err)
+	_, err = repairer.Repair(ctx, nsCtx, nsMeta, xtime.Range{Start: start, End: end}, shard)
+	require.NoError(t, err)
+
+	if identical {
+		// Neither local nor peer metadata is compared when the trees match.
+		require.Equal(t, int64(0), resDiff.NumSeries)
+		require.Equal(t, 0, resDiff.ChecksumDifferences.Series().Len())
+		return
+	}
+
+	// Only the series in the differing leaf are compared.
+	require.Equal(t, int64(1), resDiff.NumSeries)
+	require.Equal(t, int64(1), resDiff.NumBlocks)
+	checksumDiffSeries := resDiff.ChecksumDifferences.Series()
+	require.Equal(t, 1, checksumDiffSeries.Len())
+	_, exists := checksumDiffSeries.Get(ident.StringID("bar"))
+	require.True(t, exists)
+}
+
 type multiSessionTestMock struct {
 	host    topology.Host
 	client  *client.MockAdminClient
@@ -29,6 +29,7 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/m3ninx/doc"
@@ -75,6 +76,13 @@ type dbSeries struct {
 	onRetrieveBlock             block.OnRetrieveBlock
 	blockOnEvictedFromWiredList block.OnEvictedFromWiredList
 	pool                        DatabaseSeriesPool
+
+	// tombstones are the ranges of the series that have been deleted and
+	// tombstonedBlockStarts are the blocks containing deleted data that
+	// have yet to be rewritten by a cold flush. Both are nil until the
+	// series has had data deleted.
+	tombstones            xtime.Ranges
+	tombstonedBlockStarts map[xtime.UnixNano]struct{}
 }
 
 type dbSeriesBootstrap struct {
@@ -150,6 +158,7 @@ func (s *dbSeries) Tick(blockStates ShardBlockStateSnapshot, nsCtx namespace.Con
 	}
 	r.TickStatus = update.TickStatus
 	r.MadeExpiredBlocks, r.MadeUnwiredBlocks = update.madeExpiredBlocks, update.madeUnwiredBlocks
+	hasTombstones := s.expireTombstonesWithLock()
 
 	s.Unlock()
 
@@ -157,6 +166,13 @@ func (s *dbSeries) Tick(blockStates ShardBlockStateSnapshot, nsCtx namespace.Con
 		return r, nil
 	}
 
+	// NB: Series with tombstones must not be purged otherwise the deleted
+	// data would become visible again and the tombstones would no longer be
+	// logged to the commit log when snapshotting.
+	if hasTombstones {
+		return r, nil
+	}
+
 	// Check if any bootstrap writes that hasn't been merged yet.
 	s.bootstrap.Lock()
 	unmergedBootstrapDatapoints := s.bootstrap.buffer != nil
@@ -282,6 +298,67 @@ func (s *dbSeries) updateBlocksWithLock(
 	return result, nil
 }
 
+// expireTombstonesWithLock drops the tombstones and tombstoned block starts
+// that have fallen out of retention, returning whether any remain.
+func (s *dbSeries) expireTombstonesWithLock() bool {
+	if s.tombstones == nil {
+		return false
+	}
+	earliest := retention.FlushTimeStart(s.opts.RetentionOptions(), s.now())
+	s.tombstones.RemoveRange(xtime.Range{End: earliest})
+	for blockStart := range s.tombstonedBlockStarts {
+		if blockStart.Before(earliest) {
+			delete(s.tombstonedBlockStarts, blockStart)
+		}
+	}
+	if s.tombstones.IsEmpty() {
+		s.tombstones = nil
+		s.tombstonedBlockStarts = nil
+		return false
+	}
+	return true
+}
+
+func (s *dbSeries) DeleteRange(r xtime.Range) {
+	var (
+		ropts     = s.opts.RetentionOptions()
+		blockSize = ropts.BlockSize()
+		now       = s.now()
+		earliest  = retention.FlushTimeStart(ropts, now)
+		latest    = now.Add(ropts.BufferFuture()).Truncate(blockSize)
+	)
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.tombstones == nil {
+		s.tombstones = xtime.NewRanges()
+	}
+	s.tombstones.AddRange(r)
+
+	if s.tombstonedBlockStarts == nil {
+		s.tombstonedBlockStarts = make(map[xtime.UnixNano]struct{})
+	}
+	// Only blocks within retention can hold data that needs rewriting.
+	blockStart := r.Start.Truncate(blockSize)
+	if blockStart.Before(earliest) {
+		blockStart = earliest
+	}
+	for ; blockStart.Before(r.End) && !blockStart.After(latest); blockStart = blockStart.Add(blockSize) {
+		s.tombstonedBlockStarts[blockStart] = struct{}{}
+	}
+}
+
+func (s *dbSeries) DeletedRanges() xtime.Ranges {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.tombstones == nil {
+		return nil
+	}
+	return s.tombstones.Clone()
+}
+
 func (s *dbSeries) IsEmpty() bool {
 	s.RLock()
 	blocksLen := s.cachedBlocks.Len()
@@ -393,8 +470,15 @@ func (s *dbSeries) ReadEncoded(
 	s.RLock()
 	reader := NewReaderUsingRetriever(s.id, s.blockRetriever, s.onRetrieveBlock, s, s.opts)
 	iter, err := reader.readersWithBlocksMapAndBuffer(ctx, start, end, s.cachedBlocks, s.buffer, nsCtx)
+	deleted := s.tombstones
+	if deleted != nil {
+		deleted = deleted.Clone()
+	}
 	s.RUnlock()
-	return iter, err
+	if err != nil || deleted == nil {
+		return iter, err
+	}
+	return newDeletedRangesBlockReaderIter(iter, deleted, s.opts, nsCtx), nil
 }
 
 func (s *dbSeries) FetchBlocksForColdFlush(
@@ -407,6 +491,10 @@ func (s *dbSeries) FetchBlocksForColdFlush(
 	// to be modified.
 	s.Lock()
 	result, err := s.buffer.FetchBlocksForColdFlush(ctx, start, version, nsCtx)
+	if err == nil {
+		// The cold flush merges out any deleted data for this block.
+		delete(s.tombstonedBlockStarts, start)
+	}
 	s.Unlock()
 
 	return result, err
@@ -426,8 +514,22 @@ func (s *dbSeries) FetchBlocks(
 	}
 
 	r, err := reader.fetchBlocksWithBlocksMapAndBuffer(ctx, starts, s.cachedBlocks, s.buffer, nsCtx)
+	deleted := s.tombstones
+	if deleted != nil {
+		deleted = deleted.Clone()
+	}
 	s.RUnlock()
-	return r, err
+	if err != nil || deleted == nil {
+		return r, err
+	}
+
+	for i := range r {
+		r[i].Blocks, err = filterDeletedBlockReaders(ctx, r[i].Blocks, deleted, s.opts, nsCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
 }
 
 func (s *dbSeries) FetchBlocksMetadata(
@@ -606,6 +708,8 @@ func (s *dbSeries) WarmFlush(
 	// Need a write lock because the buffer WarmFlush method mutates
 	// state (by performing a pro-active merge).
 	s.Lock()
+	persistFn = newDeletedRangesPersistFn(persistFn, blockStart,
+		s.tombstones, s.opts, nsCtx)
 	outcome, err := s.buffer.WarmFlush(ctx, blockStart,
 		persist.NewMetadata(s.metadata), persistFn, nsCtx)
 	s.Unlock()
@@ -621,6 +725,8 @@ func (s *dbSeries) Snapshot(
 	// Need a write lock because the buffer Snapshot method mutates
 	// state (by performing a pro-active merge).
 	s.Lock()
+	persistFn = newDeletedRangesPersistFn(persistFn, blockStart,
+		s.tombstones, s.opts, nsCtx)
 	result, err := s.buffer.Snapshot(ctx, blockStart,
 		persist.NewMetadata(s.metadata), persistFn, nsCtx)
 	s.Unlock()
@@ -631,7 +737,13 @@ func (s *dbSeries) ColdFlushBlockStarts(blockStates BootstrappedBlockStateSnapsh
 	s.RLock()
 	defer s.RUnlock()
 
-	return s.buffer.ColdFlushBlockStarts(blockStates.Snapshot)
+	blockStarts := s.buffer.ColdFlushBlockStarts(blockStates.Snapshot)
+	for blockStart := range s.tombstonedBlockStarts {
+		if !blockStarts.Contains(blockStart) {
+			blockStarts.Add(blockStart)
+		}
+	}
+	return blockStarts
 }
 
 func (s *dbSeries) Bootstrap(nsCtx namespace.Context) error {
@@ -672,6 +784,8 @@ func (s *dbSeries) Close() {
 	s.id = nil
 	s.metadata = doc.Metadata{}
 	s.uniqueIndex = 0
+	s.tombstones = nil
+	s.tombstonedBlockStarts = nil
 
 	switch s.opts.CachePolicy() {
 	case CacheLRU:
@@ -716,6 +830,8 @@ func (s *dbSeries) Reset(opts DatabaseSeriesOptions) {
 	s.id = opts.ID
 	s.metadata = opts.Metadata
 	s.uniqueIndex = opts.UniqueIndex
+	s.tombstones = nil
+	s.tombstonedBlockStarts = nil
 	s.cachedBlocks.Reset()
 	s.buffer.Reset(databaseBufferResetOptions{
 		BlockRetriever: opts.BlockRetriever,
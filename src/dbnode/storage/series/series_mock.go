@@ -103,6 +103,32 @@ func (mr *MockDatabaseSeriesMockRecorder) ColdFlushBlockStarts(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlushBlockStarts", reflect.TypeOf((*MockDatabaseSeries)(nil).ColdFlushBlockStarts), arg0)
 }
 
+// DeleteRange mocks base method.
+func (m *MockDatabaseSeries) DeleteRange(arg0 time.Range) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteRange", arg0)
+}
+
+// DeleteRange indicates an expected call of DeleteRange.
+func (mr *MockDatabaseSeriesMockRecorder) DeleteRange(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRange", reflect.TypeOf((*MockDatabaseSeries)(nil).DeleteRange), arg0)
+}
+
+// DeletedRanges mocks base method.
+func (m *MockDatabaseSeries) DeletedRanges() time.Ranges {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletedRanges")
+	ret0, _ := ret[0].(time.Ranges)
+	return ret0
+}
+
+// DeletedRanges indicates an expected call of DeletedRanges.
+func (mr *MockDatabaseSeriesMockRecorder) DeletedRanges() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletedRanges", reflect.TypeOf((*MockDatabaseSeries)(nil).DeletedRanges))
+}
+
 // FetchBlocks mocks base method.
 func (m *MockDatabaseSeries) FetchBlocks(arg0 context.Context, arg1 []time.UnixNano, arg2 namespace.Context) ([]block.FetchBlockResult, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,223 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package series
+
+import (
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/context"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// deletedRangesBlockReaderIter wraps a BlockReaderIter, dropping the
+// datapoints that fall within the deleted ranges of a series.
+type deletedRangesBlockReaderIter struct {
+	iter    BlockReaderIter
+	deleted xtime.Ranges
+	opts    Options
+	nsCtx   namespace.Context
+
+	curr []xio.BlockReader
+	err  error
+}
+
+func newDeletedRangesBlockReaderIter(
+	iter BlockReaderIter,
+	deleted xtime.Ranges,
+	opts Options,
+	nsCtx namespace.Context,
+) BlockReaderIter {
+	return &deletedRangesBlockReaderIter{
+		iter:    iter,
+		deleted: deleted,
+		opts:    opts,
+		nsCtx:   nsCtx,
+	}
+}
+
+func (i *deletedRangesBlockReaderIter) Next(ctx context.Context) bool {
+	for i.iter.Next(ctx) {
+		readers, err := filterDeletedBlockReaders(ctx, i.iter.Current(),
+			i.deleted, i.opts, i.nsCtx)
+		if err != nil {
+			i.err = err
+			return false
+		}
+		if len(readers) == 0 {
+			// Entire block has been deleted, move onto the next one.
+			continue
+		}
+		i.curr = readers
+		return true
+	}
+	return false
+}
+
+func (i *deletedRangesBlockReaderIter) Current() []xio.BlockReader {
+	return i.curr
+}
+
+func (i *deletedRangesBlockReaderIter) Err() error {
+	if i.err != nil {
+		return i.err
+	}
+	return i.iter.Err()
+}
+
+func (i *deletedRangesBlockReaderIter) ToSlices(ctx context.Context) ([][]xio.BlockReader, error) {
+	var results [][]xio.BlockReader
+	for i.Next(ctx) {
+		results = append(results, i.Current())
+	}
+	if err := i.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// filterDeletedBlockReaders removes the datapoints that fall within the
+// deleted ranges from a set of readers that share the same block start.
+// Blocks that do not overlap a deleted range are returned untouched, blocks
+// that are entirely deleted are dropped and the rest are re-encoded.
+func filterDeletedBlockReaders(
+	ctx context.Context,
+	readers []xio.BlockReader,
+	deleted xtime.Ranges,
+	opts Options,
+	nsCtx namespace.Context,
+) ([]xio.BlockReader, error) {
+	if len(readers) == 0 || deleted == nil || deleted.IsEmpty() {
+		return readers, nil
+	}
+
+	var (
+		blockStart = readers[0].Start
+		blockSize  = readers[0].BlockSize
+		blockRange = xtime.Range{Start: blockStart, End: blockStart.Add(blockSize)}
+	)
+	if !deleted.Overlaps(blockRange) {
+		return readers, nil
+	}
+	if rangesContain(deleted, blockRange) {
+		return nil, nil
+	}
+
+	streams := make([]xio.SegmentReader, 0, len(readers))
+	for _, reader := range readers {
+		streams = append(streams, reader.SegmentReader)
+	}
+
+	encoder := opts.EncoderPool().Get()
+	encoder.Reset(blockStart, opts.DatabaseBlockOptions().DatabaseBlockAllocSize(),
+		nsCtx.Schema)
+	iter := opts.MultiReaderIteratorPool().Get()
+	defer iter.Close()
+
+	iter.Reset(streams, blockStart, blockSize, nsCtx.Schema)
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		if isDeleted(deleted, dp.TimestampNanos) {
+			continue
+		}
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
+			encoder.Close()
+			return nil, err
+		}
+	}
+	if err := iter.Err(); err != nil {
+		encoder.Close()
+		return nil, err
+	}
+
+	if encoder.NumEncoded() == 0 {
+		encoder.Close()
+		return nil, nil
+	}
+
+	stream := xio.NewSegmentReader(encoder.Discard())
+	ctx.RegisterFinalizer(stream)
+	return []xio.BlockReader{{
+		SegmentReader: stream,
+		Start:         blockStart,
+		BlockSize:     blockSize,
+	}}, nil
+}
+
+// newDeletedRangesPersistFn returns a persist function that drops the
+// datapoints that fall within the deleted ranges before persisting a block.
+func newDeletedRangesPersistFn(
+	persistFn persist.DataFn,
+	blockStart xtime.UnixNano,
+	deleted xtime.Ranges,
+	opts Options,
+	nsCtx namespace.Context,
+) persist.DataFn {
+	blockSize := opts.RetentionOptions().BlockSize()
+	blockRange := xtime.Range{Start: blockStart, End: blockStart.Add(blockSize)}
+	if deleted == nil || !deleted.Overlaps(blockRange) {
+		return persistFn
+	}
+
+	return func(metadata persist.Metadata, segment ts.Segment, checksum uint32) error {
+		// NB: The persist function writes the segment out before returning
+		// so the filtered segment is only held until the context is closed.
+		ctx := opts.ContextPool().Get()
+		defer ctx.Close()
+
+		readers, err := filterDeletedBlockReaders(ctx, []xio.BlockReader{{
+			SegmentReader: xio.NewSegmentReader(segment),
+			Start:         blockStart,
+			BlockSize:     blockSize,
+		}}, deleted, opts, nsCtx)
+		if err != nil {
+			return err
+		}
+		if len(readers) == 0 {
+			// Entire block has been deleted, nothing to persist.
+			return nil
+		}
+
+		filtered, err := readers[0].Segment()
+		if err != nil {
+			return err
+		}
+		return persistFn(metadata, filtered, filtered.CalculateChecksum())
+	}
+}
+
+func isDeleted(deleted xtime.Ranges, t xtime.UnixNano) bool {
+	return deleted.Overlaps(xtime.Range{Start: t, End: t + 1})
+}
+
+// rangesContain returns whether a single range within ranges contains r.
+// Overlapping ranges are merged when added so this catches the common case,
+// anything else falls back to re-encoding the block.
+func rangesContain(ranges xtime.Ranges, r xtime.Range) bool {
+	it := ranges.Iter()
+	for it.Next() {
+		if it.Value().Contains(r) {
+			return true
+		}
+	}
+	return false
+}
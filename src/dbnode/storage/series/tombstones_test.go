@@ -0,0 +1,165 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package series
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestSeriesDeleteRangeHidesDatapoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSeriesTestOptions()
+	blockSize := opts.RetentionOptions().BlockSize()
+	// NB: keep all the datapoints and the deleted range within a single block.
+	step := blockSize / 8
+	curr := xtime.Now().Truncate(blockSize)
+	start := curr
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr.ToTime()
+	}))
+
+	blockRetriever := NewMockQueryableBlockRetriever(ctrl)
+	blockRetriever.EXPECT().
+		IsBlockRetrievable(gomock.Any()).
+		Return(false, nil).
+		AnyTimes()
+
+	series := NewDatabaseSeries(DatabaseSeriesOptions{
+		ID:             ident.StringID("foo"),
+		BlockRetriever: blockRetriever,
+		Options:        opts,
+	}).(*dbSeries)
+
+	data := []DecodedTestValue{
+		{curr.Add(step), 1, xtime.Second, nil},
+		{curr.Add(3 * step), 2, xtime.Second, nil},
+		{curr.Add(5 * step), 3, xtime.Second, nil},
+		{curr.Add(7 * step), 4, xtime.Second, nil},
+	}
+	for _, v := range data {
+		curr = v.Timestamp
+		verifyWriteToSeries(t, series, v)
+	}
+
+	require.Nil(t, series.DeletedRanges())
+	series.DeleteRange(xtime.Range{
+		Start: start.Add(2 * step),
+		End:   start.Add(6 * step),
+	})
+
+	deleted := series.DeletedRanges()
+	require.NotNil(t, deleted)
+	require.True(t, deleted.Overlaps(xtime.Range{
+		Start: start.Add(3 * step),
+		End:   start.Add(4 * step),
+	}))
+	require.Contains(t, series.tombstonedBlockStarts, start)
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+	nsCtx := namespace.Context{}
+
+	iter, err := series.ReadEncoded(ctx, start, start.Add(blockSize), nsCtx)
+	require.NoError(t, err)
+	results, err := iter.ToSlices(ctx)
+	require.NoError(t, err)
+
+	requireReaderValuesEqual(t, []DecodedTestValue{data[0], data[3]},
+		results, opts, nsCtx)
+}
+
+func TestSeriesWarmFlushDropsDeletedDatapoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSeriesTestOptions()
+	blockSize := opts.RetentionOptions().BlockSize()
+	// NB: keep all the datapoints and the deleted range within a single block.
+	step := blockSize / 8
+	curr := xtime.Now().Truncate(blockSize)
+	start := curr
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr.ToTime()
+	}))
+
+	blockRetriever := NewMockQueryableBlockRetriever(ctrl)
+	blockRetriever.EXPECT().
+		IsBlockRetrievable(gomock.Any()).
+		Return(false, nil).
+		AnyTimes()
+
+	series := NewDatabaseSeries(DatabaseSeriesOptions{
+		ID:             ident.StringID("foo"),
+		BlockRetriever: blockRetriever,
+		Options:        opts,
+	}).(*dbSeries)
+
+	data := []DecodedTestValue{
+		{curr.Add(step), 1, xtime.Second, nil},
+		{curr.Add(3 * step), 2, xtime.Second, nil},
+		{curr.Add(5 * step), 3, xtime.Second, nil},
+	}
+	for _, v := range data {
+		curr = v.Timestamp
+		verifyWriteToSeries(t, series, v)
+	}
+
+	series.DeleteRange(xtime.Range{
+		Start: start.Add(2 * step),
+		End:   start.Add(4 * step),
+	})
+
+	var (
+		nsCtx     = namespace.Context{}
+		persisted [][]xio.BlockReader
+	)
+	persistFn := func(_ persist.Metadata, segment ts.Segment, _ uint32) error {
+		persisted = append(persisted, []xio.BlockReader{{
+			SegmentReader: xio.NewSegmentReader(segment.Clone(nil)),
+			Start:         start,
+			BlockSize:     blockSize,
+		}})
+		return nil
+	}
+
+	ctx := context.NewBackground()
+	outcome, err := series.WarmFlush(ctx, start, persistFn, nsCtx)
+	ctx.BlockingClose()
+	require.NoError(t, err)
+	require.Equal(t, FlushOutcomeFlushedToDisk, outcome)
+
+	requireReaderValuesEqual(t, []DecodedTestValue{data[0], data[2]},
+		persisted, opts, nsCtx)
+}
@@ -109,6 +109,15 @@ type DatabaseSeries interface {
 		opts FetchBlocksMetadataOptions,
 	) (block.FetchBlocksMetadataResult, error)
 
+	// DeleteRange marks the datapoints of the series that fall within the
+	// given range as deleted. Deleted datapoints are hidden from reads and
+	// dropped from disk by the next cold flush of each affected block.
+	DeleteRange(r xtime.Range)
+
+	// DeletedRanges returns the ranges of the series that have been deleted,
+	// or nil if nothing has been deleted.
+	DeletedRanges() xtime.Ranges
+
 	// IsEmpty returns whether series is empty (includes both cached blocks and in-mem buffer data).
 	IsEmpty() bool
 
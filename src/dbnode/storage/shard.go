@@ -45,6 +45,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/tracepoint"
@@ -125,6 +126,7 @@ type dbShard struct {
 	increasingIndex       increasingIndex
 	seriesPool            series.DatabaseSeriesPool
 	reverseIndex          NamespaceIndex
+	seriesLimits          limits.SeriesLimits
 	insertQueue           *dbShardInsertQueue
 
 	// protected by dbShard lock.
@@ -145,6 +147,7 @@ type dbShard struct {
 	identifierPool           ident.Pool
 	contextPool              context.Pool
 	flushState               shardFlushState
+	merkleTrees              *shardMerkleTreeCache
 	tickWg                   *sync.WaitGroup
 	runtimeOptsListenClosers []xresource.SimpleCloser
 	currRuntimeOptions       dbShardRuntimeOptions
@@ -254,6 +257,7 @@ func newDatabaseShard(
 	namespaceReaderMgr databaseNamespaceReaderManager,
 	increasingIndex increasingIndex,
 	reverseIndex NamespaceIndex,
+	seriesLimits limits.SeriesLimits,
 	needsBootstrap bool,
 	opts Options,
 	seriesOpts series.Options,
@@ -272,6 +276,7 @@ func newDatabaseShard(
 		increasingIndex:      increasingIndex,
 		seriesPool:           opts.DatabaseSeriesPool(),
 		reverseIndex:         reverseIndex,
+		seriesLimits:         seriesLimits,
 		lookup:               newShardMap(shardMapOptions{}),
 		list:                 list.New(),
 		newMergerFn:          fs.NewMerger,
@@ -285,6 +290,7 @@ func newDatabaseShard(
 		identifierPool:       opts.IdentifierPool(),
 		contextPool:          opts.ContextPool(),
 		flushState:           newShardFlushState(),
+		merkleTrees:          newShardMerkleTreeCache(),
 		tickWg:               &sync.WaitGroup{},
 		coldWritesEnabled:    namespaceMetadata.Options().ColdWritesEnabled(),
 		indexEnabled:         namespaceMetadata.Options().IndexOptions().Enabled(),
@@ -859,6 +865,7 @@ func (s *dbShard) purgeExpiredSeries(expiredEntries []*Entry) {
 		// NB(xichen): if we get here, we are guaranteed that there can be
 		// no more reads/writes to this series while the lock is held, so it's
 		// safe to remove it.
+		s.removeSeriesLimits(entry)
 		series.Close()
 		s.list.Remove(elem)
 		s.lookup.Delete(id)
@@ -921,6 +928,8 @@ func (s *dbShard) writeAndIndex(
 				timestamp:  timestamp,
 				enqueuedAt: s.nowFn(),
 			},
+			reserveSeriesLimits:    true,
+			seriesLimitsBlockStart: s.seriesLimitsBlockStart(timestamp),
 		})
 		if err != nil {
 			return SeriesWrite{}, err
@@ -998,6 +1007,8 @@ func (s *dbShard) writeAndIndex(
 				annotation: annotationClone,
 				opts:       wOpts,
 			},
+			reserveSeriesLimits:    true,
+			seriesLimitsBlockStart: s.seriesLimitsBlockStart(timestamp),
 		})
 		if err != nil {
 			return SeriesWrite{}, err
@@ -1318,10 +1329,19 @@ func (s *dbShard) insertSeriesAsyncBatched(
 		return insertAsyncResult{}, err
 	}
 
+	if opts.reserveSeriesLimits {
+		if err := s.reserveSeriesLimits(entry, opts.seriesLimitsBlockStart); err != nil {
+			return insertAsyncResult{}, err
+		}
+	}
+
 	wg, err := s.insertQueue.Insert(dbShardInsert{
 		entry: entry,
 		opts:  opts,
 	})
+	if err != nil && opts.reserveSeriesLimits {
+		s.removeSeriesLimits(entry)
+	}
 	return insertAsyncResult{
 		wg: wg,
 		// Make sure to return the copied ID from the new series.
@@ -1382,6 +1402,7 @@ func (s *dbShard) insertSeriesSync(
 	}
 
 	s.insertNewShardEntryWithLock(newEntry)
+	s.addSeriesLimits(newEntry)
 
 	// Track unlocking.
 	unlocked = true
@@ -1430,6 +1451,54 @@ func (s *dbShard) insertNewShardEntryWithLock(entry *Entry) {
 	entry.SetInsertTime(s.nowFn())
 }
 
+// seriesLimitsBlockStart returns the index block the series limits estimate
+// the number of series written to for a write at the timestamp.
+func (s *dbShard) seriesLimitsBlockStart(timestamp xtime.UnixNano) xtime.UnixNano {
+	return timestamp.Truncate(s.namespace.Options().IndexOptions().BlockSize())
+}
+
+// reserveSeriesLimits counts a new series against the series limits of the
+// namespace before it is inserted, returning an error if it would exceed any
+// of the limits.
+func (s *dbShard) reserveSeriesLimits(entry *Entry, blockStart xtime.UnixNano) error {
+	if s.seriesLimits == nil {
+		return nil
+	}
+	return s.seriesLimits.Reserve(entry.Series.ID().Bytes(), blockStart,
+		seriesMetadataTags(entry.Series.Metadata()))
+}
+
+// addSeriesLimits counts a series inserted without being reserved.
+func (s *dbShard) addSeriesLimits(entry *Entry) {
+	if s.seriesLimits == nil {
+		return
+	}
+	err := s.seriesLimits.Add(seriesMetadataTags(entry.Series.Metadata()))
+	if err != nil {
+		s.logger.Error("error counting series for series limits", zap.Error(err))
+	}
+}
+
+// removeSeriesLimits stops counting a series removed from the shard, or
+// reserved but not inserted.
+func (s *dbShard) removeSeriesLimits(entry *Entry) {
+	if s.seriesLimits == nil {
+		return
+	}
+	err := s.seriesLimits.Remove(seriesMetadataTags(entry.Series.Metadata()))
+	if err != nil {
+		s.logger.Error("error removing series from series limits", zap.Error(err))
+	}
+}
+
+// seriesMetadataTags resolves the tag values of a series from its metadata.
+type seriesMetadataTags doc.Metadata
+
+func (t seriesMetadataTags) TagValue(name []byte) ([]byte, bool, error) {
+	value, ok := doc.Metadata(t).Get(name)
+	return value, ok, nil
+}
+
 func (s *dbShard) insertSeriesBatch(inserts []dbShardInsert) error {
 	var (
 		anyPendingAction   = false
@@ -1463,6 +1532,10 @@ func (s *dbShard) insertSeriesBatch(inserts []dbShardInsert) error {
 		// for the same ID.
 		entry, err := s.lookupEntryWithLock(inserts[i].entry.Series.ID())
 		if entry != nil {
+			if inserts[i].opts.reserveSeriesLimits {
+				// Release the series reserved for the entry not inserted.
+				s.removeSeriesLimits(inserts[i].entry)
+			}
 			// Already exists so update the entry we're pointed at for this insert.
 			inserts[i].entry = entry
 		}
@@ -1482,6 +1555,11 @@ func (s *dbShard) insertSeriesBatch(inserts []dbShardInsert) error {
 
 		if err != errShardEntryNotFound {
 			// Shard is not taking inserts.
+			for j := i; j < len(inserts); j++ {
+				if inserts[j].opts.reserveSeriesLimits {
+					s.removeSeriesLimits(inserts[j].entry)
+				}
+			}
 			s.Unlock()
 			// FOLLOWUP(prateek): is this an existing bug? why don't we need to release any ref's we've inc'd
 			// on entries in the loop before this point, i.e. in range [0, i). Otherwise, how are those entries
@@ -1492,6 +1570,9 @@ func (s *dbShard) insertSeriesBatch(inserts []dbShardInsert) error {
 		// Insert still pending, perform the insert
 		entry = inserts[i].entry
 		s.insertNewShardEntryWithLock(entry)
+		if !inserts[i].opts.reserveSeriesLimits {
+			s.addSeriesLimits(entry)
+		}
 	}
 	s.Unlock()
 
@@ -2737,6 +2818,59 @@ func (s *dbShard) DocRef(id ident.ID) (doc.Metadata, bool, error) {
 	return emptyDoc, false, err
 }
 
+func (s *dbShard) DeleteSeries(metadata doc.Metadata, r xtime.Range) (ts.Series, error) {
+	// NB: The series is loaded into memory even if it only exists on disk
+	// so that the tombstone is tracked until the affected blocks are
+	// rewritten by a cold flush.
+	id := ident.BytesID(metadata.ID)
+	tagResolver := convert.NewTagsIterMetadataResolver(
+		ident.NewFieldsTagsIterator(metadata.Fields))
+	entry, err := s.writableSeries(id, tagResolver)
+	if err != nil {
+		return ts.Series{}, err
+	}
+
+	entry.DeleteRange(r)
+	entry.DecrementReaderWriterCount()
+	return s.commitLogSeries(entry), nil
+}
+
+func (s *dbShard) DeletedRanges(id ident.ID) xtime.Ranges {
+	s.RLock()
+	entry, err := s.lookupEntryWithLock(id)
+	s.RUnlock()
+	if err != nil {
+		return nil
+	}
+	return entry.Series.DeletedRanges()
+}
+
+func (s *dbShard) ForEachTombstone(fn func(series ts.Series, r xtime.Range)) {
+	s.forEachShardEntry(func(entry *Entry) bool {
+		ranges := entry.Series.DeletedRanges()
+		if ranges == nil {
+			return true
+		}
+		series := s.commitLogSeries(entry)
+		it := ranges.Iter()
+		for it.Next() {
+			fn(series, it.Value())
+		}
+		return true
+	})
+}
+
+// commitLogSeries returns the commit log series for the entry, the entry ID
+// is owned by the entry and lives as long as the series is in the shard.
+func (s *dbShard) commitLogSeries(entry *Entry) ts.Series {
+	return ts.Series{
+		UniqueIndex: entry.Index,
+		Namespace:   s.namespace.ID(),
+		ID:          entry.Series.ID(),
+		Shard:       s.shard,
+	}
+}
+
 func (s *dbShard) LatestVolume(blockStart xtime.UnixNano) (int, error) {
 	return s.namespaceReaderMgr.latestVolume(s.shard, blockStart)
 }
@@ -358,6 +358,12 @@ type dbShardInsertAsyncOptions struct {
 	// It's used to correctly manage the lifecycle of the entry across the
 	// shard -> shard Queue -> shard boundaries.
 	releaseEntryRef bool
+
+	// reserveSeriesLimits indicates the new series is reserved against the
+	// series limits of the namespace before it is enqueued, the reservation
+	// is released if the series turns out to be inserted already.
+	reserveSeriesLimits    bool
+	seriesLimitsBlockStart xtime.UnixNano
 }
 
 type dbShardPendingWrite struct {
@@ -0,0 +1,239 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package storage
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/storage/series"
+	"github.com/m3db/m3/src/x/context"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type shardMerkleTreeCacheKey struct {
+	blockStart xtime.UnixNano
+	depth      int
+}
+
+type shardMerkleTreeCacheEntry struct {
+	volume int
+	leaves []uint32
+}
+
+// shardMerkleTreeCache caches the Merkle tree leaf digests of the flushed
+// blocks of a shard, since a volume is immutable the digests are valid
+// until a new volume of the block is flushed.
+type shardMerkleTreeCache struct {
+	sync.Mutex
+
+	entries map[shardMerkleTreeCacheKey]shardMerkleTreeCacheEntry
+}
+
+func newShardMerkleTreeCache() *shardMerkleTreeCache {
+	return &shardMerkleTreeCache{
+		entries: make(map[shardMerkleTreeCacheKey]shardMerkleTreeCacheEntry),
+	}
+}
+
+func (c *shardMerkleTreeCache) get(
+	key shardMerkleTreeCacheKey,
+	volume int,
+) ([]uint32, bool) {
+	c.Lock()
+	defer c.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || entry.volume != volume {
+		return nil, false
+	}
+	return entry.leaves, true
+}
+
+func (c *shardMerkleTreeCache) set(
+	key shardMerkleTreeCacheKey,
+	volume int,
+	leaves []uint32,
+) {
+	c.Lock()
+	defer c.Unlock()
+	if entry, ok := c.entries[key]; ok && entry.volume > volume {
+		// A newer volume was cached concurrently.
+		return
+	}
+	c.entries[key] = shardMerkleTreeCacheEntry{volume: volume, leaves: leaves}
+}
+
+// expire removes the digests of blocks before the given block start.
+func (c *shardMerkleTreeCache) expire(before xtime.UnixNano) {
+	c.Lock()
+	defer c.Unlock()
+	for key := range c.entries {
+		if key.blockStart.Before(before) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (s *dbShard) FetchBlocksMerkleTree(
+	ctx context.Context,
+	start, end xtime.UnixNano,
+	depth int,
+) (digest.MerkleTree, error) {
+	builder, err := digest.NewMerkleTreeBuilder(depth)
+	if err != nil {
+		return digest.MerkleTree{}, err
+	}
+
+	// Blocks of active series are mutable and so are added on every call,
+	// the same metadata as the active phase of FetchBlocksMetadataV2.
+	opts := series.FetchBlocksMetadataOptions{
+		FetchBlocksMetadataOptions: block.FetchBlocksMetadataOptions{
+			IncludeSizes:     true,
+			IncludeChecksums: true,
+		},
+	}
+	var indexCursor int64
+	for {
+		result, nextIndexCursor, err := s.fetchActiveBlocksMetadata(ctx, start, end,
+			math.MaxInt64, indexCursor, opts)
+		if err != nil {
+			return digest.MerkleTree{}, err
+		}
+		addBlocksMetadataToMerkleTree(builder, result)
+		result.Close()
+
+		if nextIndexCursor == nil {
+			break
+		}
+		indexCursor = *nextIndexCursor
+	}
+
+	// Flushed blocks are immutable so their digests are read from the
+	// fileset once per volume and cached.
+	var (
+		ropts      = s.namespace.Options().RetentionOptions()
+		blockSize  = ropts.BlockSize()
+		flushStart = retention.FlushTimeStart(ropts, xtime.ToUnixNano(s.nowFn()))
+		blockStart = end.Truncate(blockSize).Add(-1 * blockSize)
+	)
+	s.merkleTrees.expire(flushStart)
+	for ; !blockStart.Before(start) && !blockStart.Before(flushStart); blockStart = blockStart.Add(-1 * blockSize) {
+		exists, err := s.namespaceReaderMgr.filesetExistsAt(s.shard, blockStart)
+		if err != nil {
+			return digest.MerkleTree{}, err
+		}
+		if !exists {
+			continue
+		}
+
+		leaves, err := s.flushedBlockMerkleTreeLeaves(blockStart, depth)
+		if err != nil {
+			return digest.MerkleTree{}, err
+		}
+		if err := builder.AddLeaves(leaves); err != nil {
+			return digest.MerkleTree{}, err
+		}
+	}
+
+	return builder.Build(), nil
+}
+
+// flushedBlockMerkleTreeLeaves returns the Merkle tree leaf digests of the
+// latest volume of a flushed block, built from the series checksums of the
+// fileset and cached until a new volume is flushed.
+func (s *dbShard) flushedBlockMerkleTreeLeaves(
+	blockStart xtime.UnixNano,
+	depth int,
+) ([]uint32, error) {
+	volume, err := s.namespaceReaderMgr.latestVolume(s.shard, blockStart)
+	if err != nil {
+		return nil, err
+	}
+
+	key := shardMerkleTreeCacheKey{blockStart: blockStart, depth: depth}
+	if leaves, ok := s.merkleTrees.get(key, volume); ok {
+		return leaves, nil
+	}
+
+	builder, err := digest.NewMerkleTreeBuilder(depth)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := s.namespaceReaderMgr.get(s.shard, blockStart,
+		readerPosition{volume: volume})
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		id, tags, size, checksum, err := reader.ReadMetadata()
+		if err == io.EOF {
+			// Clean end of volume, we can break now.
+			if err := reader.Close(); err != nil {
+				return nil, fmt.Errorf(
+					"could not close metadata reader for block %v: %v",
+					blockStart, err)
+			}
+			break
+		}
+		if err != nil {
+			// Best effort to close the reader on a read error.
+			if err := reader.Close(); err != nil {
+				s.logger.Error("could not close reader on unexpected err", zap.Error(err))
+			}
+			return nil, fmt.Errorf(
+				"could not read metadata for block %v: %v", blockStart, err)
+		}
+
+		builder.Add(id.Bytes(), int64(blockStart), int64(size), &checksum)
+		id.Finalize()
+		tags.Close()
+	}
+
+	// The reader may have been opened for a newer volume than requested.
+	volume = reader.Status().Volume
+	if err := s.namespaceReaderMgr.put(reader); err != nil {
+		return nil, err
+	}
+
+	leaves := builder.Leaves()
+	s.merkleTrees.set(key, volume, leaves)
+	return leaves, nil
+}
+
+func addBlocksMetadataToMerkleTree(
+	builder *digest.MerkleTreeBuilder,
+	results block.FetchBlocksMetadataResults,
+) {
+	for _, result := range results.Results() {
+		id := result.ID.Bytes()
+		for _, b := range result.Blocks.Results() {
+			builder.Add(id, int64(b.Start), b.Size, b.Checksum)
+		}
+	}
+}
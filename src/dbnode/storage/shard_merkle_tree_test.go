@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/storage/series"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestShardFetchBlocksMerkleTreeCachesFlushedBlocks(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	opts := DefaultTestOptions().SetSeriesCachePolicy(series.CacheRecentlyRead)
+	ctx := opts.ContextPool().Get()
+	defer ctx.Close()
+
+	fsOpts := opts.CommitLogOptions().FilesystemOptions()
+
+	shard := testDatabaseShard(t, opts)
+	defer shard.Close()
+
+	var (
+		depth                = 4
+		ropts                = defaultTestRetentionOpts
+		blockSize            = ropts.BlockSize()
+		mostRecentBlockStart = xtime.Now().Truncate(blockSize)
+		start                = mostRecentBlockStart.Add(-2 * blockSize)
+		end                  = mostRecentBlockStart.Add(blockSize)
+		expected, err        = digest.NewMerkleTreeBuilder(depth)
+	)
+	require.NoError(t, err)
+
+	// Write flushed series.
+	for at := start; at.Before(mostRecentBlockStart); at = at.Add(blockSize) {
+		writer, err := fs.NewWriter(fsOpts)
+		require.NoError(t, err)
+
+		err = writer.Open(fs.DataWriterOpenOptions{
+			Identifier: fs.FileSetFileIdentifier{
+				Namespace:  shard.namespace.ID(),
+				Shard:      shard.shard,
+				BlockStart: at,
+			},
+			BlockSize: blockSize,
+		})
+		require.NoError(t, err)
+
+		for i := 0; i < 10; i++ {
+			id := ident.StringID(fmt.Sprintf("series.%d", i))
+			data := []byte(fmt.Sprintf("data.%d.%d", at, i))
+			checksum := digest.Checksum(data)
+
+			bytes := checked.NewBytes(data, nil)
+			bytes.IncRef()
+			meta := persist.NewMetadataFromIDAndTags(id, ident.Tags{},
+				persist.MetadataOptions{})
+			require.NoError(t, writer.Write(meta, bytes, checksum))
+
+			expected.Add(id.Bytes(), int64(at), int64(len(data)), &checksum)
+		}
+
+		require.NoError(t, writer.Close())
+	}
+
+	// Add mock active series.
+	seriesFetchOpts := series.FetchBlocksMetadataOptions{
+		FetchBlocksMetadataOptions: block.FetchBlocksMetadataOptions{
+			IncludeSizes:     true,
+			IncludeChecksums: true,
+		},
+	}
+	for i := 0; i < 5; i++ {
+		name := fmt.Sprintf("series.%d", i)
+		checksum := uint32(i)
+		mockSeries := addMockSeries(ctrl, shard, ident.StringID(name), ident.Tags{}, uint64(i))
+		mockSeries.EXPECT().
+			FetchBlocksMetadata(gomock.Not(nil), start, end, seriesFetchOpts).
+			DoAndReturn(func(
+				context.Context,
+				xtime.UnixNano,
+				xtime.UnixNano,
+				series.FetchBlocksMetadataOptions,
+			) (block.FetchBlocksMetadataResult, error) {
+				blocks := block.NewFetchBlockMetadataResults()
+				blocks.Add(block.NewFetchBlockMetadataResult(mostRecentBlockStart,
+					8, &checksum, 0, nil))
+				return block.NewFetchBlocksMetadataResult(ident.StringID(name), nil, blocks), nil
+			}).
+			Times(2)
+
+		expected.Add([]byte(name), int64(mostRecentBlockStart), 8, &checksum)
+	}
+
+	tree, err := shard.FetchBlocksMerkleTree(ctx, start, end, depth)
+	require.NoError(t, err)
+	require.Equal(t, expected.Build().Nodes(), tree.Nodes())
+	require.Equal(t, 2, len(shard.merkleTrees.entries))
+
+	// The flushed blocks are served from the cache the second time around.
+	tree, err = shard.FetchBlocksMerkleTree(ctx, start, end, depth)
+	require.NoError(t, err)
+	require.Equal(t, expected.Build().Nodes(), tree.Nodes())
+	require.Equal(t, 2, len(shard.merkleTrees.entries))
+}
@@ -46,6 +46,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/index/convert"
+	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/ts"
 	xmetrics "github.com/m3db/m3/src/dbnode/x/metrics"
@@ -54,6 +55,7 @@ import (
 	"github.com/m3db/m3/src/x/checked"
 	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/pool"
 	xtest "github.com/m3db/m3/src/x/test"
 	xtime "github.com/m3db/m3/src/x/time"
@@ -90,7 +92,7 @@ func testDatabaseShardWithIndexFn(
 		SetColdWritesEnabled(coldWritesEnabled)
 
 	return newDatabaseShard(metadata, 0, nil, nsReaderMgr,
-		&testIncreasingIndex{}, idx, true, opts, seriesOpts).(*dbShard)
+		&testIncreasingIndex{}, idx, nil, true, opts, seriesOpts).(*dbShard)
 }
 
 func addMockSeries(ctrl *gomock.Controller, shard *dbShard, id ident.ID, tags ident.Tags, index uint64) *series.MockDatabaseSeries {
@@ -112,7 +114,7 @@ func TestShardDontNeedBootstrap(t *testing.T) {
 	defer closer()
 	seriesOpts := NewSeriesOptionsFromOptions(opts, testNs.Options().RetentionOptions())
 	shard := newDatabaseShard(testNs.metadata, 0, nil, nil,
-		&testIncreasingIndex{}, nil, false, opts, seriesOpts).(*dbShard)
+		&testIncreasingIndex{}, nil, nil, false, opts, seriesOpts).(*dbShard)
 	defer shard.Close()
 
 	require.Equal(t, Bootstrapped, shard.bootstrapState)
@@ -125,7 +127,7 @@ func TestShardErrorIfDoubleBootstrap(t *testing.T) {
 	defer closer()
 	seriesOpts := NewSeriesOptionsFromOptions(opts, testNs.Options().RetentionOptions())
 	shard := newDatabaseShard(testNs.metadata, 0, nil, nil,
-		&testIncreasingIndex{}, nil, false, opts, seriesOpts).(*dbShard)
+		&testIncreasingIndex{}, nil, nil, false, opts, seriesOpts).(*dbShard)
 	defer shard.Close()
 
 	require.Equal(t, Bootstrapped, shard.bootstrapState)
@@ -1438,6 +1440,50 @@ func TestPurgeExpiredSeriesNonEmptySeries(t *testing.T) {
 	require.Equal(t, 0, r.expiredSeries)
 }
 
+func TestShardWriteSeriesLimits(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	opts := DefaultTestOptions()
+	shard := testDatabaseShard(t, opts)
+	retriever := series.NewMockQueryableBlockRetriever(ctrl)
+	retriever.EXPECT().IsBlockRetrievable(gomock.Any()).Return(false, nil).AnyTimes()
+	shard.seriesBlockRetriever = retriever
+	shard.seriesLimits = limits.NewSeriesLimits("testns1",
+		limits.SeriesLimitsOptions{MaxSeries: 2}, instrument.NewOptions())
+	defer shard.Close()
+
+	// Series inserted without a write are counted but not limited.
+	_, err := shard.insertSeriesSync(ident.StringID("foo"),
+		convert.EmptyTagMetadataResolver, insertSyncOptions{})
+	require.NoError(t, err)
+
+	ctx := opts.ContextPool().Get()
+	defer ctx.Close()
+	now := xtime.Now()
+	_, err = shard.Write(ctx, ident.StringID("bar"), now,
+		1.0, xtime.Second, nil, series.WriteOptions{})
+	require.NoError(t, err)
+
+	// New series exceeding the limit are rejected.
+	_, err = shard.Write(ctx, ident.StringID("baz"), now,
+		1.0, xtime.Second, nil, series.WriteOptions{})
+	require.Error(t, err)
+	require.True(t, limits.IsSeriesLimitExceededError(err))
+
+	// Series already inserted are not.
+	_, err = shard.Write(ctx, ident.StringID("bar"), now,
+		2.0, xtime.Second, nil, series.WriteOptions{})
+	require.NoError(t, err)
+
+	// Series purged from memory no longer count towards the limits.
+	_, err = shard.Tick(context.NewNoOpCanncellable(), now, namespace.Context{})
+	require.NoError(t, err)
+	_, err = shard.Write(ctx, ident.StringID("baz"), now,
+		1.0, xtime.Second, nil, series.WriteOptions{})
+	require.NoError(t, err)
+}
+
 // This tests the scenario where a series is empty when series.Tick() is called,
 // but receives writes after tickForEachSeries finishes but before purgeExpiredSeries
 // starts. The expected behavior is not to expire series in this case.
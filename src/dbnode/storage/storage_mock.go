@@ -30,6 +30,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/digest"
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
@@ -46,6 +47,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/limits/permits"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/storage/series"
+	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/dbnode/ts/writes"
 	"github.com/m3db/m3/src/dbnode/x/xio"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
@@ -205,6 +207,21 @@ func (mr *MockDatabaseMockRecorder) BootstrapState() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrapState", reflect.TypeOf((*MockDatabase)(nil).BootstrapState))
 }
 
+// CardinalityQuery mocks base method.
+func (m *MockDatabase) CardinalityQuery(ctx context.Context, namespace ident.ID, query index.Query, opts index.QueryOptions) (index.CardinalityQueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CardinalityQuery", ctx, namespace, query, opts)
+	ret0, _ := ret[0].(index.CardinalityQueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CardinalityQuery indicates an expected call of CardinalityQuery.
+func (mr *MockDatabaseMockRecorder) CardinalityQuery(ctx, namespace, query, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CardinalityQuery", reflect.TypeOf((*MockDatabase)(nil).CardinalityQuery), ctx, namespace, query, opts)
+}
+
 // Close mocks base method.
 func (m *MockDatabase) Close() error {
 	m.ctrl.T.Helper()
@@ -219,6 +236,21 @@ func (mr *MockDatabaseMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDatabase)(nil).Close))
 }
 
+// DeleteTagged mocks base method.
+func (m *MockDatabase) DeleteTagged(ctx context.Context, namespace ident.ID, query index.Query, start time0.UnixNano, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagged", ctx, namespace, query, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagged indicates an expected call of DeleteTagged.
+func (mr *MockDatabaseMockRecorder) DeleteTagged(ctx, namespace, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagged", reflect.TypeOf((*MockDatabase)(nil).DeleteTagged), ctx, namespace, query, start, end)
+}
+
 // FetchBlocks mocks base method.
 func (m *MockDatabase) FetchBlocks(ctx context.Context, namespace ident.ID, shard uint32, id ident.ID, starts []time0.UnixNano) ([]block.FetchBlockResult, error) {
 	m.ctrl.T.Helper()
@@ -234,6 +266,37 @@ func (mr *MockDatabaseMockRecorder) FetchBlocks(ctx, namespace, shard, id, start
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocks", reflect.TypeOf((*MockDatabase)(nil).FetchBlocks), ctx, namespace, shard, id, starts)
 }
 
+// FetchBlocksMerkleTree mocks base method.
+func (m *MockDatabase) FetchBlocksMerkleTree(ctx context.Context, namespace ident.ID, shard uint32, start, end time0.UnixNano, depth int) (digest.MerkleTree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBlocksMerkleTree", ctx, namespace, shard, start, end, depth)
+	ret0, _ := ret[0].(digest.MerkleTree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBlocksMerkleTree indicates an expected call of FetchBlocksMerkleTree.
+func (mr *MockDatabaseMockRecorder) FetchBlocksMerkleTree(ctx, namespace, shard, start, end, depth interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMerkleTree", reflect.TypeOf((*MockDatabase)(nil).FetchBlocksMerkleTree), ctx, namespace, shard, start, end, depth)
+}
+
+// FetchBlocksMerkleTreeLeavesMetadata mocks base method.
+func (m *MockDatabase) FetchBlocksMerkleTreeLeavesMetadata(ctx context.Context, namespace ident.ID, shard uint32, start, end time0.UnixNano, depth int, leaves []int, limit int64, pageToken PageToken) (block.FetchBlocksMetadataResults, PageToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBlocksMerkleTreeLeavesMetadata", ctx, namespace, shard, start, end, depth, leaves, limit, pageToken)
+	ret0, _ := ret[0].(block.FetchBlocksMetadataResults)
+	ret1, _ := ret[1].(PageToken)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchBlocksMerkleTreeLeavesMetadata indicates an expected call of FetchBlocksMerkleTreeLeavesMetadata.
+func (mr *MockDatabaseMockRecorder) FetchBlocksMerkleTreeLeavesMetadata(ctx, namespace, shard, start, end, depth, leaves, limit, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMerkleTreeLeavesMetadata", reflect.TypeOf((*MockDatabase)(nil).FetchBlocksMerkleTreeLeavesMetadata), ctx, namespace, shard, start, end, depth, leaves, limit, pageToken)
+}
+
 // FetchBlocksMetadataV2 mocks base method.
 func (m *MockDatabase) FetchBlocksMetadataV2(ctx context.Context, namespace ident.ID, shard uint32, start, end time0.UnixNano, limit int64, pageToken PageToken, opts block.FetchBlocksMetadataOptions) (block.FetchBlocksMetadataResults, PageToken, error) {
 	m.ctrl.T.Helper()
@@ -436,6 +499,20 @@ func (mr *MockDatabaseMockRecorder) Terminate() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Terminate", reflect.TypeOf((*MockDatabase)(nil).Terminate))
 }
 
+// TileAggregationStatuses mocks base method.
+func (m *MockDatabase) TileAggregationStatuses() []TileAggregationStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TileAggregationStatuses")
+	ret0, _ := ret[0].([]TileAggregationStatus)
+	return ret0
+}
+
+// TileAggregationStatuses indicates an expected call of TileAggregationStatuses.
+func (mr *MockDatabaseMockRecorder) TileAggregationStatuses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TileAggregationStatuses", reflect.TypeOf((*MockDatabase)(nil).TileAggregationStatuses))
+}
+
 // Truncate mocks base method.
 func (m *MockDatabase) Truncate(namespace ident.ID) (int64, error) {
 	m.ctrl.T.Helper()
@@ -615,6 +692,21 @@ func (mr *MockdatabaseMockRecorder) BootstrapState() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrapState", reflect.TypeOf((*Mockdatabase)(nil).BootstrapState))
 }
 
+// CardinalityQuery mocks base method.
+func (m *Mockdatabase) CardinalityQuery(ctx context.Context, namespace ident.ID, query index.Query, opts index.QueryOptions) (index.CardinalityQueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CardinalityQuery", ctx, namespace, query, opts)
+	ret0, _ := ret[0].(index.CardinalityQueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CardinalityQuery indicates an expected call of CardinalityQuery.
+func (mr *MockdatabaseMockRecorder) CardinalityQuery(ctx, namespace, query, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CardinalityQuery", reflect.TypeOf((*Mockdatabase)(nil).CardinalityQuery), ctx, namespace, query, opts)
+}
+
 // Close mocks base method.
 func (m *Mockdatabase) Close() error {
 	m.ctrl.T.Helper()
@@ -629,6 +721,21 @@ func (mr *MockdatabaseMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*Mockdatabase)(nil).Close))
 }
 
+// DeleteTagged mocks base method.
+func (m *Mockdatabase) DeleteTagged(ctx context.Context, namespace ident.ID, query index.Query, start time0.UnixNano, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagged", ctx, namespace, query, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagged indicates an expected call of DeleteTagged.
+func (mr *MockdatabaseMockRecorder) DeleteTagged(ctx, namespace, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagged", reflect.TypeOf((*Mockdatabase)(nil).DeleteTagged), ctx, namespace, query, start, end)
+}
+
 // FetchBlocks mocks base method.
 func (m *Mockdatabase) FetchBlocks(ctx context.Context, namespace ident.ID, shard uint32, id ident.ID, starts []time0.UnixNano) ([]block.FetchBlockResult, error) {
 	m.ctrl.T.Helper()
@@ -644,6 +751,37 @@ func (mr *MockdatabaseMockRecorder) FetchBlocks(ctx, namespace, shard, id, start
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocks", reflect.TypeOf((*Mockdatabase)(nil).FetchBlocks), ctx, namespace, shard, id, starts)
 }
 
+// FetchBlocksMerkleTree mocks base method.
+func (m *Mockdatabase) FetchBlocksMerkleTree(ctx context.Context, namespace ident.ID, shard uint32, start, end time0.UnixNano, depth int) (digest.MerkleTree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBlocksMerkleTree", ctx, namespace, shard, start, end, depth)
+	ret0, _ := ret[0].(digest.MerkleTree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBlocksMerkleTree indicates an expected call of FetchBlocksMerkleTree.
+func (mr *MockdatabaseMockRecorder) FetchBlocksMerkleTree(ctx, namespace, shard, start, end, depth interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMerkleTree", reflect.TypeOf((*Mockdatabase)(nil).FetchBlocksMerkleTree), ctx, namespace, shard, start, end, depth)
+}
+
+// FetchBlocksMerkleTreeLeavesMetadata mocks base method.
+func (m *Mockdatabase) FetchBlocksMerkleTreeLeavesMetadata(ctx context.Context, namespace ident.ID, shard uint32, start, end time0.UnixNano, depth int, leaves []int, limit int64, pageToken PageToken) (block.FetchBlocksMetadataResults, PageToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBlocksMerkleTreeLeavesMetadata", ctx, namespace, shard, start, end, depth, leaves, limit, pageToken)
+	ret0, _ := ret[0].(block.FetchBlocksMetadataResults)
+	ret1, _ := ret[1].(PageToken)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FetchBlocksMerkleTreeLeavesMetadata indicates an expected call of FetchBlocksMerkleTreeLeavesMetadata.
+func (mr *MockdatabaseMockRecorder) FetchBlocksMerkleTreeLeavesMetadata(ctx, namespace, shard, start, end, depth, leaves, limit, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMerkleTreeLeavesMetadata", reflect.TypeOf((*Mockdatabase)(nil).FetchBlocksMerkleTreeLeavesMetadata), ctx, namespace, shard, start, end, depth, leaves, limit, pageToken)
+}
+
 // FetchBlocksMetadataV2 mocks base method.
 func (m *Mockdatabase) FetchBlocksMetadataV2(ctx context.Context, namespace ident.ID, shard uint32, start, end time0.UnixNano, limit int64, pageToken PageToken, opts block.FetchBlocksMetadataOptions) (block.FetchBlocksMetadataResults, PageToken, error) {
 	m.ctrl.T.Helper()
@@ -861,6 +999,20 @@ func (mr *MockdatabaseMockRecorder) Terminate() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Terminate", reflect.TypeOf((*Mockdatabase)(nil).Terminate))
 }
 
+// TileAggregationStatuses mocks base method.
+func (m *Mockdatabase) TileAggregationStatuses() []TileAggregationStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TileAggregationStatuses")
+	ret0, _ := ret[0].([]TileAggregationStatus)
+	return ret0
+}
+
+// TileAggregationStatuses indicates an expected call of TileAggregationStatuses.
+func (mr *MockdatabaseMockRecorder) TileAggregationStatuses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TileAggregationStatuses", reflect.TypeOf((*Mockdatabase)(nil).TileAggregationStatuses))
+}
+
 // Truncate mocks base method.
 func (m *Mockdatabase) Truncate(namespace ident.ID) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1247,6 +1399,21 @@ func (mr *MockdatabaseNamespaceMockRecorder) BootstrapState() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrapState", reflect.TypeOf((*MockdatabaseNamespace)(nil).BootstrapState))
 }
 
+// CardinalityQuery mocks base method.
+func (m *MockdatabaseNamespace) CardinalityQuery(ctx context.Context, query index.Query, opts index.QueryOptions) (index.CardinalityQueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CardinalityQuery", ctx, query, opts)
+	ret0, _ := ret[0].(index.CardinalityQueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CardinalityQuery indicates an expected call of CardinalityQuery.
+func (mr *MockdatabaseNamespaceMockRecorder) CardinalityQuery(ctx, query, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CardinalityQuery", reflect.TypeOf((*MockdatabaseNamespace)(nil).CardinalityQuery), ctx, query, opts)
+}
+
 // Close mocks base method.
 func (m *MockdatabaseNamespace) Close() error {
 	m.ctrl.T.Helper()
@@ -1275,6 +1442,21 @@ func (mr *MockdatabaseNamespaceMockRecorder) ColdFlush(flush interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlush", reflect.TypeOf((*MockdatabaseNamespace)(nil).ColdFlush), flush)
 }
 
+// DeleteTagged mocks base method.
+func (m *MockdatabaseNamespace) DeleteTagged(ctx context.Context, query index.Query, start time0.UnixNano, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagged", ctx, query, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagged indicates an expected call of DeleteTagged.
+func (mr *MockdatabaseNamespaceMockRecorder) DeleteTagged(ctx, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagged", reflect.TypeOf((*MockdatabaseNamespace)(nil).DeleteTagged), ctx, query, start, end)
+}
+
 // DocRef mocks base method.
 func (m *MockdatabaseNamespace) DocRef(id ident.ID) (doc.Metadata, bool, error) {
 	m.ctrl.T.Helper()
@@ -2014,6 +2196,35 @@ func (mr *MockdatabaseShardMockRecorder) ColdFlush(flush, resources, nsCtx, onFl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlush", reflect.TypeOf((*MockdatabaseShard)(nil).ColdFlush), flush, resources, nsCtx, onFlush)
 }
 
+// DeleteSeries mocks base method.
+func (m *MockdatabaseShard) DeleteSeries(metadata doc.Metadata, r time0.Range) (ts.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", metadata, r)
+	ret0, _ := ret[0].(ts.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockdatabaseShardMockRecorder) DeleteSeries(metadata, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockdatabaseShard)(nil).DeleteSeries), metadata, r)
+}
+
+// DeletedRanges mocks base method.
+func (m *MockdatabaseShard) DeletedRanges(id ident.ID) time0.Ranges {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletedRanges", id)
+	ret0, _ := ret[0].(time0.Ranges)
+	return ret0
+}
+
+// DeletedRanges indicates an expected call of DeletedRanges.
+func (mr *MockdatabaseShardMockRecorder) DeletedRanges(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletedRanges", reflect.TypeOf((*MockdatabaseShard)(nil).DeletedRanges), id)
+}
+
 // DocRef mocks base method.
 func (m *MockdatabaseShard) DocRef(id ident.ID) (doc.Metadata, bool, error) {
 	m.ctrl.T.Helper()
@@ -2060,6 +2271,21 @@ func (mr *MockdatabaseShardMockRecorder) FetchBlocksForColdFlush(ctx, seriesID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksForColdFlush", reflect.TypeOf((*MockdatabaseShard)(nil).FetchBlocksForColdFlush), ctx, seriesID, start, version, nsCtx)
 }
 
+// FetchBlocksMerkleTree mocks base method.
+func (m *MockdatabaseShard) FetchBlocksMerkleTree(ctx context.Context, start, end time0.UnixNano, depth int) (digest.MerkleTree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBlocksMerkleTree", ctx, start, end, depth)
+	ret0, _ := ret[0].(digest.MerkleTree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBlocksMerkleTree indicates an expected call of FetchBlocksMerkleTree.
+func (mr *MockdatabaseShardMockRecorder) FetchBlocksMerkleTree(ctx, start, end, depth interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMerkleTree", reflect.TypeOf((*MockdatabaseShard)(nil).FetchBlocksMerkleTree), ctx, start, end, depth)
+}
+
 // FetchBlocksMetadataV2 mocks base method.
 func (m *MockdatabaseShard) FetchBlocksMetadataV2(ctx context.Context, start, end time0.UnixNano, limit int64, pageToken PageToken, opts block.FetchBlocksMetadataOptions) (block.FetchBlocksMetadataResults, PageToken, error) {
 	m.ctrl.T.Helper()
@@ -2105,6 +2331,18 @@ func (mr *MockdatabaseShardMockRecorder) FlushState(blockStart interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushState", reflect.TypeOf((*MockdatabaseShard)(nil).FlushState), blockStart)
 }
 
+// ForEachTombstone mocks base method.
+func (m *MockdatabaseShard) ForEachTombstone(fn func(ts.Series, time0.Range)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ForEachTombstone", fn)
+}
+
+// ForEachTombstone indicates an expected call of ForEachTombstone.
+func (mr *MockdatabaseShardMockRecorder) ForEachTombstone(fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForEachTombstone", reflect.TypeOf((*MockdatabaseShard)(nil).ForEachTombstone), fn)
+}
+
 // ID mocks base method.
 func (m *MockdatabaseShard) ID() uint32 {
 	m.ctrl.T.Helper()
@@ -2532,6 +2770,21 @@ func (mr *MockNamespaceIndexMockRecorder) Bootstrapped() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bootstrapped", reflect.TypeOf((*MockNamespaceIndex)(nil).Bootstrapped))
 }
 
+// CardinalityQuery mocks base method.
+func (m *MockNamespaceIndex) CardinalityQuery(ctx context.Context, query index.Query, opts index.QueryOptions) (index.CardinalityQueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CardinalityQuery", ctx, query, opts)
+	ret0, _ := ret[0].(index.CardinalityQueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CardinalityQuery indicates an expected call of CardinalityQuery.
+func (mr *MockNamespaceIndexMockRecorder) CardinalityQuery(ctx, query, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CardinalityQuery", reflect.TypeOf((*MockNamespaceIndex)(nil).CardinalityQuery), ctx, query, opts)
+}
+
 // CleanupCorruptedFileSets mocks base method.
 func (m *MockNamespaceIndex) CleanupCorruptedFileSets() error {
 	m.ctrl.T.Helper()
@@ -2617,6 +2870,18 @@ func (mr *MockNamespaceIndexMockRecorder) DebugMemorySegments(opts interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebugMemorySegments", reflect.TypeOf((*MockNamespaceIndex)(nil).DebugMemorySegments), opts)
 }
 
+// DeleteSeries mocks base method.
+func (m *MockNamespaceIndex) DeleteSeries(id ident.ID, r time0.Range) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteSeries", id, r)
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockNamespaceIndexMockRecorder) DeleteSeries(id, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockNamespaceIndex)(nil).DeleteSeries), id, r)
+}
+
 // Query mocks base method.
 func (m *MockNamespaceIndex) Query(ctx context.Context, query index.Query, opts index.QueryOptions) (index.QueryResult, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,451 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/x/clock"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	defaultTileAggregationCheckInterval = time.Minute
+	tileAggregationProgressFileSuffix   = ".json"
+)
+
+var errTileAggregationInProgress = errors.New("tile aggregation already in progress")
+
+type tileAggregationKey struct {
+	source string
+	target string
+}
+
+// tileAggregationProgress is the durable progress of a tile aggregation,
+// persisted so that a restarted node resumes where it left off.
+type tileAggregationProgress struct {
+	Step               string `json:"step"`
+	AggregatedUntil    int64  `json:"aggregatedUntil"`
+	ProcessedTileCount int64  `json:"processedTileCount"`
+}
+
+// tileAggregationScheduler runs the tile aggregations configured on the
+// namespaces owned by the database once their source blocks are sealed.
+type tileAggregationScheduler struct {
+	sync.RWMutex
+
+	database      database
+	opts          Options
+	nowFn         clock.NowFn
+	logger        *zap.Logger
+	scope         tally.Scope
+	status        tally.Gauge
+	progressDir   string
+	checkInterval time.Duration
+
+	statuses map[tileAggregationKey]TileAggregationStatus
+	running  int32
+
+	closeOnce sync.Once
+	closedCh  chan struct{}
+}
+
+func newTileAggregationScheduler(database database, opts Options) *tileAggregationScheduler {
+	scope := opts.InstrumentOptions().MetricsScope().SubScope("tile-aggregation")
+	filePathPrefix := opts.CommitLogOptions().FilesystemOptions().FilePathPrefix()
+	return &tileAggregationScheduler{
+		database:      database,
+		opts:          opts,
+		nowFn:         opts.ClockOptions().NowFn(),
+		logger:        opts.InstrumentOptions().Logger(),
+		scope:         scope,
+		status:        scope.Gauge("tile-aggregation"),
+		progressDir:   fs.TileAggregationsDirPath(filePathPrefix),
+		checkInterval: defaultTileAggregationCheckInterval,
+		statuses:      make(map[tileAggregationKey]TileAggregationStatus),
+		closedCh:      make(chan struct{}),
+	}
+}
+
+func (s *tileAggregationScheduler) Start() {
+	go s.run()
+}
+
+func (s *tileAggregationScheduler) Stop() {
+	s.closeOnce.Do(func() {
+		close(s.closedCh)
+	})
+}
+
+func (s *tileAggregationScheduler) Report() {
+	if atomic.LoadInt32(&s.running) == 1 {
+		s.status.Update(1)
+	} else {
+		s.status.Update(0)
+	}
+}
+
+func (s *tileAggregationScheduler) run() {
+	ticker := time.NewTicker(s.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.closedCh:
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.Aggregate(); err != nil {
+			s.logger.Error("error running tile aggregations", zap.Error(err))
+		}
+	}
+}
+
+// Statuses returns the status of every configured tile aggregation sorted by
+// source and target namespace.
+func (s *tileAggregationScheduler) Statuses() []TileAggregationStatus {
+	s.RLock()
+	statuses := make([]TileAggregationStatus, 0, len(s.statuses))
+	for _, status := range s.statuses {
+		statuses = append(statuses, status)
+	}
+	s.RUnlock()
+
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].SourceNamespace != statuses[j].SourceNamespace {
+			return statuses[i].SourceNamespace < statuses[j].SourceNamespace
+		}
+		return statuses[i].TargetNamespace < statuses[j].TargetNamespace
+	})
+	return statuses
+}
+
+// Aggregate runs every configured tile aggregation over the source blocks
+// sealed since its last run.
+func (s *tileAggregationScheduler) Aggregate() error {
+	// Don't attempt to aggregate if the database is not bootstrapped yet.
+	if !s.database.IsBootstrapped() {
+		return nil
+	}
+
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return errTileAggregationInProgress
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	namespaces, err := s.database.OwnedNamespaces()
+	if err != nil {
+		return err
+	}
+
+	namespacesByID := make(map[string]databaseNamespace, len(namespaces))
+	for _, n := range namespaces {
+		namespacesByID[n.ID().String()] = n
+	}
+
+	var (
+		multiErr   = xerrors.NewMultiError()
+		configured = make(map[tileAggregationKey]struct{})
+	)
+	for _, source := range namespaces {
+		aggOpts := source.Options().AggregationOptions()
+		if aggOpts == nil {
+			continue
+		}
+
+		for _, tileAgg := range aggOpts.TileAggregations() {
+			key := tileAggregationKey{
+				source: source.ID().String(),
+				target: tileAgg.TargetNamespace,
+			}
+			configured[key] = struct{}{}
+
+			if err := s.aggregate(key, source, namespacesByID[key.target], tileAgg); err != nil {
+				multiErr = multiErr.Add(fmt.Errorf(
+					"tile aggregation from %s to %s failed: %w", key.source, key.target, err))
+			}
+		}
+	}
+
+	// Forget about tile aggregations that are no longer configured.
+	s.Lock()
+	for key := range s.statuses {
+		if _, ok := configured[key]; !ok {
+			delete(s.statuses, key)
+		}
+	}
+	s.Unlock()
+
+	return multiErr.FinalError()
+}
+
+func (s *tileAggregationScheduler) aggregate(
+	key tileAggregationKey,
+	source, target databaseNamespace,
+	tileAgg namespace.TileAggregation,
+) error {
+	status, err := s.statusFor(key, tileAgg.Step)
+	if err != nil {
+		return err
+	}
+
+	now := xtime.ToUnixNano(s.nowFn())
+	status.LastRunAt = now
+	defer func() {
+		s.Lock()
+		s.statuses[key] = status
+		s.Unlock()
+	}()
+
+	err = s.aggregateSealed(key, source, target, &status, now)
+	if err != nil {
+		status.LastError = err.Error()
+		s.scope.Tagged(key.tags()).Counter("errors").Inc(1)
+		return err
+	}
+
+	status.LastError = ""
+	if !status.AggregatedUntil.IsZero() {
+		s.scope.Tagged(key.tags()).Gauge("seconds-behind").
+			Update(now.Sub(status.AggregatedUntil).Seconds())
+	}
+	return nil
+}
+
+func (s *tileAggregationScheduler) aggregateSealed(
+	key tileAggregationKey,
+	source, target databaseNamespace,
+	status *TileAggregationStatus,
+	now xtime.UnixNano,
+) error {
+	if target == nil {
+		return fmt.Errorf("target namespace %s not found", key.target)
+	}
+
+	// Wait for both namespaces to be bootstrapped, this is not an error
+	// since the aggregation resumes on the next run.
+	if source.BootstrapState() != Bootstrapped || target.BootstrapState() != Bootstrapped {
+		return nil
+	}
+
+	var (
+		sourceROpts     = source.Options().RetentionOptions()
+		targetROpts     = target.Options().RetentionOptions()
+		sourceBlockSize = sourceROpts.BlockSize()
+		targetBlockSize = targetROpts.BlockSize()
+		earliest        = retention.FlushTimeStart(sourceROpts, now)
+		latest          = retention.FlushTimeEnd(sourceROpts, now)
+	)
+	if targetBlockSize%sourceBlockSize != 0 {
+		return fmt.Errorf("target block size %s is not a multiple of source block size %s",
+			targetBlockSize, sourceBlockSize)
+	}
+	if targetEarliest := retention.FlushTimeStart(targetROpts, now); targetEarliest.After(earliest) {
+		earliest = targetEarliest
+	}
+
+	cursor := status.AggregatedUntil
+	if cursor.Before(earliest) {
+		cursor = earliest
+	}
+
+	// Only aggregate the contiguous source blocks that are sealed and flushed
+	// so that the aggregated tiles are never missing data from a block that
+	// is still being written to.
+	sealedUntil := cursor
+	for !sealedUntil.After(latest) {
+		needsFlush, err := source.NeedsFlush(sealedUntil, sealedUntil)
+		if err != nil {
+			return err
+		}
+		if needsFlush {
+			break
+		}
+		sealedUntil = sealedUntil.Add(sourceBlockSize)
+	}
+
+	for cursor.Before(sealedUntil) {
+		// Aggregation writes a new volume of the target block each time so
+		// the window always starts at the beginning of the target block.
+		targetBlockStart := cursor.Truncate(targetBlockSize)
+		end := targetBlockStart.Add(targetBlockSize)
+		if end.After(sealedUntil) {
+			end = sealedUntil
+		}
+
+		processedTileCount, err := s.aggregateTiles(source, target, targetBlockStart, end, status.Step)
+		if err != nil {
+			return err
+		}
+
+		status.AggregatedUntil = end
+		status.ProcessedTileCount += processedTileCount
+		if err := s.writeProgress(key, *status); err != nil {
+			return err
+		}
+		cursor = end
+	}
+
+	return nil
+}
+
+func (s *tileAggregationScheduler) aggregateTiles(
+	source, target databaseNamespace,
+	start, end xtime.UnixNano,
+	step time.Duration,
+) (int64, error) {
+	opts, err := NewAggregateTilesOptions(
+		start, end, step,
+		target.ID(),
+		AggregateTilesRegular,
+		false, false, nil,
+		s.opts.InstrumentOptions())
+	if err != nil {
+		return 0, err
+	}
+
+	ctx := s.opts.ContextPool().Get()
+	defer ctx.Close()
+
+	return s.database.AggregateTiles(ctx, source.ID(), target.ID(), opts)
+}
+
+// statusFor returns the status of a tile aggregation, loading its progress
+// from disk the first time it is seen. Progress recorded with a different
+// step is discarded since the target tiles need to be aggregated again.
+func (s *tileAggregationScheduler) statusFor(
+	key tileAggregationKey,
+	step time.Duration,
+) (TileAggregationStatus, error) {
+	s.RLock()
+	status, ok := s.statuses[key]
+	s.RUnlock()
+	if ok && status.Step == step {
+		return status, nil
+	}
+
+	status = TileAggregationStatus{
+		SourceNamespace: key.source,
+		TargetNamespace: key.target,
+		Step:            step,
+	}
+	progress, ok, err := s.readProgress(key)
+	if err != nil {
+		return TileAggregationStatus{}, err
+	}
+	if ok && progress.Step == step.String() {
+		status.AggregatedUntil = xtime.UnixNano(progress.AggregatedUntil)
+		status.ProcessedTileCount = progress.ProcessedTileCount
+	}
+	return status, nil
+}
+
+func (s *tileAggregationScheduler) progressFilePath(key tileAggregationKey) string {
+	return filepath.Join(s.progressDir, key.source, key.target+tileAggregationProgressFileSuffix)
+}
+
+func (s *tileAggregationScheduler) readProgress(
+	key tileAggregationKey,
+) (tileAggregationProgress, bool, error) {
+	data, err := os.ReadFile(s.progressFilePath(key))
+	if os.IsNotExist(err) {
+		return tileAggregationProgress{}, false, nil
+	}
+	if err != nil {
+		return tileAggregationProgress{}, false, err
+	}
+
+	var progress tileAggregationProgress
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return tileAggregationProgress{}, false, err
+	}
+	return progress, true, nil
+}
+
+// writeProgress durably records the progress of a tile aggregation, writing
+// to a temporary file first so that a crash never leaves a partial file.
+func (s *tileAggregationScheduler) writeProgress(
+	key tileAggregationKey,
+	status TileAggregationStatus,
+) error {
+	data, err := json.Marshal(tileAggregationProgress{
+		Step:               status.Step.String(),
+		AggregatedUntil:    int64(status.AggregatedUntil),
+		ProcessedTileCount: status.ProcessedTileCount,
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		fsOpts = s.opts.CommitLogOptions().FilesystemOptions()
+		path   = s.progressFilePath(key)
+		dir    = filepath.Dir(path)
+	)
+	if err := os.MkdirAll(dir, fsOpts.NewDirectoryMode()); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, key.target+"*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), fsOpts.NewFileMode()); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (k tileAggregationKey) tags() map[string]string {
+	return map[string]string{
+		"source-namespace": k.source,
+		"target-namespace": k.target,
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type testTileAggregationWindow struct {
+	start, end xtime.UnixNano
+}
+
+func newTestTileAggregationNamespaces(
+	ctrl *gomock.Controller,
+	targetBootstrapState BootstrapState,
+) (*MockdatabaseNamespace, *MockdatabaseNamespace) {
+	tileAgg, err := namespace.NewTileAggregation("target", 5*time.Minute)
+	if err != nil {
+		panic(err)
+	}
+
+	sourceOpts := namespace.NewOptions().
+		SetRetentionOptions(retention.NewOptions().
+			SetRetentionPeriod(6 * time.Hour).
+			SetBlockSize(2 * time.Hour)).
+		SetAggregationOptions(namespace.NewAggregationOptions().
+			SetTileAggregations([]namespace.TileAggregation{tileAgg}))
+	targetOpts := namespace.NewOptions().
+		SetRetentionOptions(retention.NewOptions().
+			SetRetentionPeriod(24 * time.Hour).
+			SetBlockSize(4 * time.Hour))
+
+	source := NewMockdatabaseNamespace(ctrl)
+	source.EXPECT().ID().Return(ident.StringID("source")).AnyTimes()
+	source.EXPECT().Options().Return(sourceOpts).AnyTimes()
+	source.EXPECT().BootstrapState().Return(Bootstrapped).AnyTimes()
+
+	target := NewMockdatabaseNamespace(ctrl)
+	target.EXPECT().ID().Return(ident.StringID("target")).AnyTimes()
+	target.EXPECT().Options().Return(targetOpts).AnyTimes()
+	target.EXPECT().BootstrapState().Return(targetBootstrapState).AnyTimes()
+
+	return source, target
+}
+
+func newTestTileAggregationScheduler(
+	db database,
+	dir string,
+	now xtime.UnixNano,
+) *tileAggregationScheduler {
+	opts := DefaultTestOptions()
+	opts = opts.
+		SetClockOptions(opts.ClockOptions().SetNowFn(now.ToTime)).
+		SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(
+			opts.CommitLogOptions().FilesystemOptions().SetFilePathPrefix(dir)))
+	return newTileAggregationScheduler(db, opts)
+}
+
+func expectTestTileAggregations(
+	db *MockdatabaseMockRecorder,
+	windows *[]testTileAggregationWindow,
+) {
+	db.AggregateTiles(gomock.Any(), ident.NewIDMatcher("source"), ident.NewIDMatcher("target"), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ ident.ID, opts AggregateTilesOptions) (int64, error) {
+			*windows = append(*windows, testTileAggregationWindow{start: opts.Start, end: opts.End})
+			return 10, nil
+		}).AnyTimes()
+}
+
+func TestTileAggregationSchedulerAggregatesSealedBlocks(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		dir            = t.TempDir()
+		day            = xtime.ToUnixNano(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		now            = day.Add(12*time.Hour + 20*time.Minute)
+		source, target = newTestTileAggregationNamespaces(ctrl, Bootstrapped)
+		windows        []testTileAggregationWindow
+	)
+
+	db := NewMockdatabase(ctrl)
+	db.EXPECT().IsBootstrapped().Return(true).AnyTimes()
+	db.EXPECT().OwnedNamespaces().Return([]databaseNamespace{source, target}, nil).AnyTimes()
+	expectTestTileAggregations(db.EXPECT(), &windows)
+
+	// The source blocks at 06:00 and 08:00 are sealed, the block at 10:00 is not.
+	gomock.InOrder(
+		source.EXPECT().NeedsFlush(day.Add(6*time.Hour), day.Add(6*time.Hour)).Return(false, nil),
+		source.EXPECT().NeedsFlush(day.Add(8*time.Hour), day.Add(8*time.Hour)).Return(false, nil),
+		source.EXPECT().NeedsFlush(day.Add(10*time.Hour), day.Add(10*time.Hour)).Return(true, nil),
+	)
+
+	scheduler := newTestTileAggregationScheduler(db, dir, now)
+	require.NoError(t, scheduler.Aggregate())
+
+	// Every window starts at the beginning of its target block.
+	require.Equal(t, []testTileAggregationWindow{
+		{start: day.Add(4 * time.Hour), end: day.Add(8 * time.Hour)},
+		{start: day.Add(8 * time.Hour), end: day.Add(10 * time.Hour)},
+	}, windows)
+	require.Equal(t, []TileAggregationStatus{
+		{
+			SourceNamespace:    "source",
+			TargetNamespace:    "target",
+			Step:               5 * time.Minute,
+			AggregatedUntil:    day.Add(10 * time.Hour),
+			ProcessedTileCount: 20,
+			LastRunAt:          now,
+		},
+	}, scheduler.Statuses())
+
+	// A new scheduler resumes from the durable progress once the next
+	// source block is sealed.
+	windows = nil
+	source.EXPECT().NeedsFlush(day.Add(10*time.Hour), day.Add(10*time.Hour)).Return(false, nil)
+
+	scheduler = newTestTileAggregationScheduler(db, dir, now)
+	require.NoError(t, scheduler.Aggregate())
+
+	require.Equal(t, []testTileAggregationWindow{
+		{start: day.Add(8 * time.Hour), end: day.Add(12 * time.Hour)},
+	}, windows)
+	statuses := scheduler.Statuses()
+	require.Len(t, statuses, 1)
+	require.Equal(t, day.Add(12*time.Hour), statuses[0].AggregatedUntil)
+	require.Equal(t, int64(30), statuses[0].ProcessedTileCount)
+}
+
+func TestTileAggregationSchedulerWaitsForBootstrap(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now            = xtime.Now()
+		source, target = newTestTileAggregationNamespaces(ctrl, Bootstrapping)
+	)
+
+	db := NewMockdatabase(ctrl)
+	db.EXPECT().IsBootstrapped().Return(true)
+	db.EXPECT().OwnedNamespaces().Return([]databaseNamespace{source, target}, nil)
+
+	scheduler := newTestTileAggregationScheduler(db, t.TempDir(), now)
+	require.NoError(t, scheduler.Aggregate())
+
+	statuses := scheduler.Statuses()
+	require.Len(t, statuses, 1)
+	require.True(t, statuses[0].AggregatedUntil.IsZero())
+	require.Empty(t, statuses[0].LastError)
+}
+
+func TestTileAggregationSchedulerMissingTargetNamespace(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now       = xtime.Now()
+		source, _ = newTestTileAggregationNamespaces(ctrl, Bootstrapped)
+	)
+
+	db := NewMockdatabase(ctrl)
+	db.EXPECT().IsBootstrapped().Return(true)
+	db.EXPECT().OwnedNamespaces().Return([]databaseNamespace{source}, nil)
+
+	scheduler := newTestTileAggregationScheduler(db, t.TempDir(), now)
+	require.Error(t, scheduler.Aggregate())
+
+	statuses := scheduler.Statuses()
+	require.Len(t, statuses, 1)
+	require.Contains(t, statuses[0].LastError, "target namespace target not found")
+}
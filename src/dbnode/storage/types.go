@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/digest"
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
 	"github.com/m3db/m3/src/dbnode/namespace"
@@ -167,6 +168,15 @@ type Database interface {
 		opts index.AggregationOptions,
 	) (index.AggregateQueryResult, error)
 
+	// CardinalityQuery resolves the given query into the number of series of
+	// each tag name and value.
+	CardinalityQuery(
+		ctx context.Context,
+		namespace ident.ID,
+		query index.Query,
+		opts index.QueryOptions,
+	) (index.CardinalityQueryResult, error)
+
 	// ReadEncoded retrieves encoded segments for an ID.
 	ReadEncoded(
 		ctx context.Context,
@@ -198,6 +208,31 @@ type Database interface {
 		opts block.FetchBlocksMetadataOptions,
 	) (block.FetchBlocksMetadataResults, PageToken, error)
 
+	// FetchBlocksMerkleTree builds a Merkle tree of the given depth from the blocks
+	// metadata of a shard, the digests of flushed blocks are cached per block.
+	FetchBlocksMerkleTree(
+		ctx context.Context,
+		namespace ident.ID,
+		shard uint32,
+		start, end xtime.UnixNano,
+		depth int,
+	) (digest.MerkleTree, error)
+
+	// FetchBlocksMerkleTreeLeavesMetadata retrieves a page of the blocks metadata
+	// of the series of a shard assigned to the given leaves of a Merkle tree of
+	// the given depth, returns the next page token if any. The returned results
+	// are closed along with the context and should not be closed by the caller.
+	FetchBlocksMerkleTreeLeavesMetadata(
+		ctx context.Context,
+		namespace ident.ID,
+		shard uint32,
+		start, end xtime.UnixNano,
+		depth int,
+		leaves []int,
+		limit int64,
+		pageToken PageToken,
+	) (block.FetchBlocksMetadataResults, PageToken, error)
+
 	// Bootstrap bootstraps the database.
 	Bootstrap() error
 
@@ -218,6 +253,16 @@ type Database interface {
 	// Truncate truncates data for the given namespace.
 	Truncate(namespace ident.ID) (int64, error)
 
+	// DeleteTagged deletes datapoints within the given time range for all
+	// series in the namespace matching the query, returning the number of
+	// series affected.
+	DeleteTagged(
+		ctx context.Context,
+		namespace ident.ID,
+		query index.Query,
+		start, end xtime.UnixNano,
+	) (int64, error)
+
 	// BootstrapState captures and returns a snapshot of the databases'
 	// bootstrap state.
 	BootstrapState() DatabaseBootstrapState
@@ -227,6 +272,10 @@ type Database interface {
 
 	// AggregateTiles does large tile aggregation from source namespace to target namespace.
 	AggregateTiles(ctx context.Context, sourceNsID, targetNsID ident.ID, opts AggregateTilesOptions) (int64, error)
+
+	// TileAggregationStatuses returns the status of the tile aggregations
+	// configured on the namespaces owned by the database.
+	TileAggregationStatuses() []TileAggregationStatus
 }
 
 // database is the internal database interface.
@@ -349,6 +398,14 @@ type databaseNamespace interface {
 		opts index.AggregationOptions,
 	) (index.AggregateQueryResult, error)
 
+	// CardinalityQuery resolves the given query into the number of series of
+	// each tag name and value.
+	CardinalityQuery(
+		ctx context.Context,
+		query index.Query,
+		opts index.QueryOptions,
+	) (index.CardinalityQueryResult, error)
+
 	// ReadEncoded reads data for given id within [start, end).
 	ReadEncoded(
 		ctx context.Context,
@@ -403,6 +460,14 @@ type databaseNamespace interface {
 	// Truncate truncates the in-memory data for this namespace.
 	Truncate() (int64, error)
 
+	// DeleteTagged deletes datapoints within the given time range for all
+	// series matching the query, returning the number of series affected.
+	DeleteTagged(
+		ctx context.Context,
+		query index.Query,
+		start, end xtime.UnixNano,
+	) (int64, error)
+
 	// Repair repairs the namespace data for a given time range.
 	Repair(repairer databaseShardRepairer, tr xtime.Range, opts NamespaceRepairOptions) error
 
@@ -540,6 +605,14 @@ type databaseShard interface {
 		opts block.FetchBlocksMetadataOptions,
 	) (block.FetchBlocksMetadataResults, PageToken, error)
 
+	// FetchBlocksMerkleTree builds a Merkle tree of the given depth from the
+	// blocks metadata, the digests of flushed blocks are cached per volume.
+	FetchBlocksMerkleTree(
+		ctx context.Context,
+		start, end xtime.UnixNano,
+		depth int,
+	) (digest.MerkleTree, error)
+
 	// PrepareBootstrap prepares the shard for bootstrapping by ensuring
 	// it knows which flushed files reside on disk.
 	PrepareBootstrap(ctx context.Context) error
@@ -618,6 +691,19 @@ type databaseShard interface {
 	// DocRef returns the doc if already present in a shard series.
 	DocRef(id ident.ID) (doc.Metadata, bool, error)
 
+	// DeleteSeries marks the given time range as deleted for the series,
+	// the deleted datapoints are removed from disk on the next cold flush.
+	// The returned series can be used to log the deletion to the commit log.
+	DeleteSeries(metadata doc.Metadata, r xtime.Range) (ts.Series, error)
+
+	// DeletedRanges returns the deleted time ranges for a series, or nil
+	// if the series has no deletions.
+	DeletedRanges(id ident.ID) xtime.Ranges
+
+	// ForEachTombstone calls the given function for each deleted time range
+	// of each series in the shard.
+	ForEachTombstone(fn func(series ts.Series, r xtime.Range))
+
 	// AggregateTiles does large tile aggregation from source shards into this shard.
 	AggregateTiles(
 		ctx context.Context,
@@ -683,6 +769,18 @@ type NamespaceIndex interface {
 		opts index.AggregationOptions,
 	) (index.AggregateQueryResult, error)
 
+	// CardinalityQuery resolves the given query into the number of series of
+	// each tag name and value.
+	CardinalityQuery(
+		ctx context.Context,
+		query index.Query,
+		opts index.QueryOptions,
+	) (index.CardinalityQueryResult, error)
+
+	// DeleteSeries excludes the series from query results for queries
+	// whose time range falls entirely within the given range.
+	DeleteSeries(id ident.ID, r xtime.Range)
+
 	// Bootstrap bootstraps the index with the provided segments.
 	Bootstrap(
 		bootstrapResults result.IndexResults,
@@ -1458,6 +1556,25 @@ type AggregateTilesOptions struct {
 	MetricTypeByName map[string]annotation.Payload
 }
 
+// TileAggregationStatus is the status of a tile aggregation from a source
+// namespace into a target namespace run by the database.
+type TileAggregationStatus struct {
+	// SourceNamespace is the namespace the tiles are aggregated from.
+	SourceNamespace string
+	// TargetNamespace is the namespace the tiles are aggregated into.
+	TargetNamespace string
+	// Step is the size of each aggregated tile.
+	Step time.Duration
+	// AggregatedUntil is the exclusive end of the source data aggregated so far.
+	AggregatedUntil xtime.UnixNano
+	// ProcessedTileCount is the number of tiles processed so far.
+	ProcessedTileCount int64
+	// LastRunAt is the time the aggregation last ran.
+	LastRunAt xtime.UnixNano
+	// LastError is the error of the last run, empty if it succeeded.
+	LastError string
+}
+
 // TileAggregator is the interface for AggregateTiles.
 type TileAggregator interface {
 	// AggregateTiles does tile aggregation.
@@ -40,6 +40,9 @@ const (
 	// FetchReadSegment is the operation name for the tchannelthrift FetchReadSegment path.
 	FetchReadSegment = "tchannelthrift/node.service.FetchReadSegment"
 
+	// CardinalityRaw is the operation name for the tchannelthrift CardinalityRaw path.
+	CardinalityRaw = "tchannelthrift/node.service.CardinalityRaw"
+
 	// AggregateTiles is the operation name for the tchannelthrift AggregateTiles path.
 	AggregateTiles = "tchannelthrift/node.service.AggregateTiles"
 
@@ -49,12 +52,21 @@ const (
 	// DBAggregateQuery is the operation name for the db AggregateQuery path.
 	DBAggregateQuery = "storage.db.AggregateQuery"
 
+	// DBCardinalityQuery is the operation name for the db CardinalityQuery path.
+	DBCardinalityQuery = "storage.db.CardinalityQuery"
+
 	// DBFetchBlocks is the operation name for the db FetchBlocks path.
 	DBFetchBlocks = "storage.db.FetchBlocks"
 
 	// DBFetchBlocksMetadataV2 is the operation name for the db FetchBlocksMetadataV2 path.
 	DBFetchBlocksMetadataV2 = "storage.db.FetchBlocksMetadataV2"
 
+	// DBFetchBlocksMerkleTree is the operation name for the db FetchBlocksMerkleTree path.
+	DBFetchBlocksMerkleTree = "storage.db.FetchBlocksMerkleTree"
+
+	// DBFetchBlocksMerkleTreeLeavesMetadata is the operation name for the db FetchBlocksMerkleTreeLeavesMetadata path.
+	DBFetchBlocksMerkleTreeLeavesMetadata = "storage.db.FetchBlocksMerkleTreeLeavesMetadata"
+
 	// DBWriteBatch is the operation name for the db WriteBatch path.
 	DBWriteBatch = "storage.db.WriteBatch"
 
@@ -85,6 +97,9 @@ const (
 	// NSIdxAggregateQuery is the operation name for the nsIndex AggregateQuery path.
 	NSIdxAggregateQuery = "storage.nsIndex.AggregateQuery"
 
+	// NSIdxCardinalityQuery is the operation name for the nsIndex CardinalityQuery path.
+	NSIdxCardinalityQuery = "storage.nsIndex.CardinalityQuery"
+
 	// NSIdxQueryHelper is the operation name for the nsIndex query path.
 	NSIdxQueryHelper = "storage.nsIndex.query"
 
@@ -94,6 +109,9 @@ const (
 	// NSIdxBlockAggregateQuery is the operation name for the nsIndex block aggregate query path.
 	NSIdxBlockAggregateQuery = "storage.nsIndex.blockAggregateQuery"
 
+	// NSIdxBlockCardinalityQuery is the operation name for the nsIndex block cardinality query path.
+	NSIdxBlockCardinalityQuery = "storage.nsIndex.blockCardinalityQuery"
+
 	// NSIdxBlockQueryAddDocuments is the operation name for adding documents by batch in the block query path.
 	NSIdxBlockQueryAddDocuments = "storage.nsIndex.blockQueryAddDocuments"
 
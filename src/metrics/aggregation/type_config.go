@@ -38,6 +38,9 @@ type TypesConfiguration struct {
 	// Default aggregation types for gauge metrics.
 	DefaultGaugeAggregationTypes *Types `yaml:"defaultGaugeAggregationTypes"`
 
+	// Default aggregation types for histogram metrics.
+	DefaultHistogramAggregationTypes *Types `yaml:"defaultHistogramAggregationTypes"`
+
 	// CounterTransformFnType configures the type string transformation function for counters.
 	CounterTransformFnType *TransformFnType `yaml:"counterTransformFnType"`
 
@@ -47,6 +50,9 @@ type TypesConfiguration struct {
 	// GaugeTransformFnType configures the type string transformation function for gauges.
 	GaugeTransformFnType *TransformFnType `yaml:"gaugeTransformFnType"`
 
+	// HistogramTransformFnType configures the type string transformation function for histograms.
+	HistogramTransformFnType *TransformFnType `yaml:"histogramTransformFnType"`
+
 	// Pool of aggregation types.
 	AggregationTypesPool pool.ObjectPoolConfiguration `yaml:"aggregationTypesPool"`
 
@@ -66,6 +72,9 @@ func (c TypesConfiguration) NewOptions(instrumentOpts instrument.Options) (Types
 	if c.DefaultTimerAggregationTypes != nil {
 		opts = opts.SetDefaultTimerAggregationTypes(*c.DefaultTimerAggregationTypes)
 	}
+	if c.DefaultHistogramAggregationTypes != nil {
+		opts = opts.SetDefaultHistogramAggregationTypes(*c.DefaultHistogramAggregationTypes)
+	}
 	if c.CounterTransformFnType != nil {
 		fn, err := c.CounterTransformFnType.TransformFn()
 		if err != nil {
@@ -87,6 +96,13 @@ func (c TypesConfiguration) NewOptions(instrumentOpts instrument.Options) (Types
 		}
 		opts = opts.SetGaugeTypeStringTransformFn(fn)
 	}
+	if c.HistogramTransformFnType != nil {
+		fn, err := c.HistogramTransformFnType.TransformFn()
+		if err != nil {
+			return nil, err
+		}
+		opts = opts.SetHistogramTypeStringTransformFn(fn)
+	}
 
 	// Set aggregation types pool.
 	scope := instrumentOpts.MetricsScope()
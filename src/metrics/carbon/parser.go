@@ -22,6 +22,7 @@ package carbon
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -47,16 +48,27 @@ const (
 	floatBitSize    = 64
 	intBase         = 10
 
+	tagSeparatorByte      = ';'
+	tagValueSeparatorByte = '='
+	invalidTagNameChars   = ";!^="
+
 	initScannerBufferSize = 2 << 15 // ~ 65KiB
 	maxScannerBufferSize  = 2 << 17 // ~ 0.25iB
 )
 
 var (
-	errInvalidLine = errors.New("invalid line")
-	errNotUTF8     = errors.New("not valid UTF8 string")
-	mathNan        = math.NaN()
+	errInvalidLine     = errors.New("invalid line")
+	errNotUTF8         = errors.New("not valid UTF8 string")
+	errEmptyTaggedPath = errors.New("tagged name has empty path")
+	mathNan            = math.NaN()
 )
 
+// Tag is a graphite tag parsed from a tagged carbon metric name.
+type Tag struct {
+	Name  []byte
+	Value []byte
+}
+
 // Metric represents a carbon metric.
 type Metric struct {
 	Name []byte
@@ -152,6 +164,56 @@ func ParseName(line []byte) (name []byte, rest []byte, err error) {
 	return
 }
 
+// ParseTaggedName splits a graphite 1.1 style tagged metric name such as
+//
+//	foo.bar;dc=east;host=a
+//
+// into its path (foo.bar) and tags (dc=east, host=a), appending the tags to
+// the provided slice. Names without any tags are returned as the path with
+// no tags appended. Tag names must be non-empty and may not contain any of
+// ";!^=", tag values must be non-empty, may not contain ";" and may not
+// start with "~".
+func ParseTaggedName(name []byte, tags []Tag) (path []byte, _ []Tag, err error) {
+	tagsIdx := bytes.IndexByte(name, tagSeparatorByte)
+	if tagsIdx == -1 {
+		return name, tags, nil
+	}
+
+	path = name[:tagsIdx]
+	if len(path) == 0 {
+		return nil, tags, errEmptyTaggedPath
+	}
+
+	rest := name[tagsIdx+1:]
+	for {
+		var tag []byte
+		if end := bytes.IndexByte(rest, tagSeparatorByte); end == -1 {
+			tag, rest = rest, nil
+		} else {
+			tag, rest = rest[:end], rest[end+1:]
+		}
+
+		eqIdx := bytes.IndexByte(tag, tagValueSeparatorByte)
+		if eqIdx == -1 {
+			return nil, tags, fmt.Errorf("invalid tag %q: missing value", tag)
+		}
+
+		tagName, tagValue := tag[:eqIdx], tag[eqIdx+1:]
+		if len(tagName) == 0 || bytes.ContainsAny(tagName, invalidTagNameChars) {
+			return nil, tags, fmt.Errorf("invalid tag name: %q", tagName)
+		}
+		if len(tagValue) == 0 || tagValue[0] == '~' {
+			return nil, tags, fmt.Errorf("invalid tag value for %s: %q",
+				tagName, tagValue)
+		}
+
+		tags = append(tags, Tag{Name: tagName, Value: tagValue})
+		if rest == nil {
+			return path, tags, nil
+		}
+	}
+}
+
 // ParseRemainder parses a line's components (name and remainder) and returns
 // all but the name and returns the timestamp of the metric, its value, the
 // time it was received and any error encountered.
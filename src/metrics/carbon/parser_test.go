@@ -120,6 +120,36 @@ func TestParseName(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestParseTaggedName(t *testing.T) {
+	path, tags, err := ParseTaggedName([]byte("foo.bar.baz"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "foo.bar.baz", string(path))
+	assert.Empty(t, tags)
+
+	path, tags, err = ParseTaggedName([]byte("foo.bar;dc=east;host=a=b"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "foo.bar", string(path))
+	assert.Equal(t, []Tag{
+		{Name: []byte("dc"), Value: []byte("east")},
+		{Name: []byte("host"), Value: []byte("a=b")},
+	}, tags)
+
+	invalid := []string{
+		";dc=east",
+		"foo;",
+		"foo;dc",
+		"foo;dc=east;",
+		"foo;=east",
+		"foo;d!c=east",
+		"foo;dc=",
+		"foo;dc=~east",
+	}
+	for _, name := range invalid {
+		_, _, err := ParseTaggedName([]byte(name), nil)
+		assert.Error(t, err, name)
+	}
+}
+
 func TestParseErrors(t *testing.T) {
 	assertParseError(t, " ")
 	assertParseError(t, "  ")
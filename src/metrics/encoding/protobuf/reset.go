@@ -46,6 +46,7 @@ func ReuseMetricWithMetadatasProto(pb *metricpb.MetricWithMetadatas) {
 	resetCounterWithMetadatasProto(pb.CounterWithMetadatas)
 	resetBatchTimerWithMetadatasProto(pb.BatchTimerWithMetadatas)
 	resetGaugeWithMetadatasProto(pb.GaugeWithMetadatas)
+	resetHistogramWithMetadatasProto(pb.HistogramWithMetadatas)
 	resetForwardedMetricWithMetadataProto(pb.ForwardedMetricWithMetadata)
 	resetTimedMetricWithMetadataProto(pb.TimedMetricWithMetadata)
 	resetTimedMetricWithMetadatasProto(pb.TimedMetricWithMetadatas)
@@ -86,6 +87,14 @@ func resetGaugeWithMetadatasProto(pb *metricpb.GaugeWithMetadatas) {
 	resetMetadatas(&pb.Metadatas)
 }
 
+func resetHistogramWithMetadatasProto(pb *metricpb.HistogramWithMetadatas) {
+	if pb == nil {
+		return
+	}
+	resetHistogram(&pb.Histogram)
+	resetMetadatas(&pb.Metadatas)
+}
+
 func resetForwardedMetricWithMetadataProto(pb *metricpb.ForwardedMetricWithMetadata) {
 	if pb == nil {
 		return
@@ -149,6 +158,18 @@ func resetGauge(pb *metricpb.Gauge) {
 	pb.ClientTimeNanos = 0
 }
 
+func resetHistogram(pb *metricpb.Histogram) {
+	if pb == nil {
+		return
+	}
+	pb.Id = pb.Id[:0]
+	pb.UpperBounds = pb.UpperBounds[:0]
+	pb.Counts = pb.Counts[:0]
+	pb.Sum = 0.0
+	pb.Annotation = pb.Annotation[:0]
+	pb.ClientTimeNanos = 0
+}
+
 func resetForwardedMetric(pb *metricpb.ForwardedMetric) {
 	if pb == nil {
 		return
@@ -188,6 +209,7 @@ func resetForwardMetadata(pb *metricpb.ForwardMetadata) {
 	pb.NumForwardedTimes = 0
 	pb.ResendEnabled = false
 	pb.RoutingPolicy.Reset()
+	pb.DdsketchEnabled = false
 }
 
 func resetTimedMetadata(pb *metricpb.TimedMetadata) {
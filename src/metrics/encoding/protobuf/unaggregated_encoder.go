@@ -66,6 +66,7 @@ type unaggregatedEncoder struct {
 	tms                 metricpb.TimedMetricWithMetadatas
 	cm                  metricpb.CounterWithMetadatas
 	gm                  metricpb.GaugeWithMetadatas
+	hm                  metricpb.HistogramWithMetadatas
 	buf                 []byte
 	fm                  metricpb.ForwardedMetricWithMetadata
 	pm                  metricpb.TimedMetricWithStoragePolicy
@@ -123,6 +124,8 @@ func (enc *unaggregatedEncoder) EncodeMessage(msg encoding.UnaggregatedMessageUn
 		return enc.encodeBatchTimerWithMetadatas(msg.BatchTimerWithMetadatas)
 	case encoding.GaugeWithMetadatasType:
 		return enc.encodeGaugeWithMetadatas(msg.GaugeWithMetadatas)
+	case encoding.HistogramWithMetadatasType:
+		return enc.encodeHistogramWithMetadatas(msg.HistogramWithMetadatas)
 	case encoding.ForwardedMetricWithMetadataType:
 		return enc.encodeForwardedMetricWithMetadata(msg.ForwardedMetricWithMetadata)
 	case encoding.TimedMetricWithMetadataType:
@@ -169,6 +172,17 @@ func (enc *unaggregatedEncoder) encodeGaugeWithMetadatas(gm unaggregated.GaugeWi
 	return enc.encodeMetricWithMetadatas(mm)
 }
 
+func (enc *unaggregatedEncoder) encodeHistogramWithMetadatas(hm unaggregated.HistogramWithMetadatas) error {
+	if err := hm.ToProto(&enc.hm); err != nil {
+		return fmt.Errorf("histogram with metadatas proto conversion failed: %v", err)
+	}
+	mm := metricpb.MetricWithMetadatas{
+		Type:                   metricpb.MetricWithMetadatas_HISTOGRAM_WITH_METADATAS,
+		HistogramWithMetadatas: &enc.hm,
+	}
+	return enc.encodeMetricWithMetadatas(mm)
+}
+
 func (enc *unaggregatedEncoder) encodeForwardedMetricWithMetadata(fm aggregated.ForwardedMetricWithMetadata) error {
 	if err := fm.ToProto(&enc.fm); err != nil {
 		return fmt.Errorf("forwarded metric with metadata proto conversion failed: %v", err)
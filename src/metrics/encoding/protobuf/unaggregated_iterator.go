@@ -138,6 +138,9 @@ func (it *UnaggregatedIterator) decodeMessage(size int) error {
 	case metricpb.MetricWithMetadatas_GAUGE_WITH_METADATAS:
 		it.msg.Type = encoding.GaugeWithMetadatasType
 		it.err = it.msg.GaugeWithMetadatas.FromProto(it.pb.GaugeWithMetadatas)
+	case metricpb.MetricWithMetadatas_HISTOGRAM_WITH_METADATAS:
+		it.msg.Type = encoding.HistogramWithMetadatasType
+		it.err = it.msg.HistogramWithMetadatas.FromProto(it.pb.HistogramWithMetadatas)
 	case metricpb.MetricWithMetadatas_FORWARDED_METRIC_WITH_METADATA:
 		it.msg.Type = encoding.ForwardedMetricWithMetadataType
 		it.err = it.msg.ForwardedMetricWithMetadata.FromProto(it.pb.ForwardedMetricWithMetadata)
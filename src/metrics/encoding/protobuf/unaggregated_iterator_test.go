@@ -173,6 +173,44 @@ func TestUnaggregatedIteratorDecodeGaugeWithMetadatas(t *testing.T) {
 	require.Equal(t, len(inputs), i)
 }
 
+func TestUnaggregatedIteratorDecodeHistogramWithMetadatas(t *testing.T) {
+	inputs := []unaggregated.HistogramWithMetadatas{
+		{
+			Histogram:       testHistogram1,
+			StagedMetadatas: testStagedMetadatas1,
+		},
+		{
+			Histogram:       testHistogram1,
+			StagedMetadatas: testStagedMetadatas2,
+		},
+	}
+
+	enc := NewUnaggregatedEncoder(NewUnaggregatedOptions())
+	for _, input := range inputs {
+		require.NoError(t, enc.EncodeMessage(encoding.UnaggregatedMessageUnion{
+			Type:                   encoding.HistogramWithMetadatasType,
+			HistogramWithMetadatas: input,
+		}))
+	}
+	dataBuf := enc.Relinquish()
+	defer dataBuf.Close()
+
+	var (
+		i      int
+		stream = bytes.NewReader(dataBuf.Bytes())
+	)
+	it := NewUnaggregatedIterator(stream, NewUnaggregatedOptions())
+	defer it.Close()
+	for it.Next() {
+		res := it.Current()
+		require.Equal(t, encoding.HistogramWithMetadatasType, res.Type)
+		require.Equal(t, inputs[i], res.HistogramWithMetadatas)
+		i++
+	}
+	require.Equal(t, io.EOF, it.Err())
+	require.Equal(t, len(inputs), i)
+}
+
 func TestUnaggregatedIteratorDecodeForwardedMetricWithMetadata(t *testing.T) {
 	inputs := []aggregated.ForwardedMetricWithMetadata{
 		{
@@ -40,6 +40,7 @@ const (
 	TimedMetricWithMetadataType
 	TimedMetricWithMetadatasType
 	PassthroughMetricWithMetadataType
+	HistogramWithMetadatasType
 )
 
 // UnaggregatedMessageUnion is a union of different types of unaggregated messages.
@@ -51,6 +52,7 @@ type UnaggregatedMessageUnion struct {
 	CounterWithMetadatas          unaggregated.CounterWithMetadatas
 	BatchTimerWithMetadatas       unaggregated.BatchTimerWithMetadatas
 	GaugeWithMetadatas            unaggregated.GaugeWithMetadatas
+	HistogramWithMetadatas        unaggregated.HistogramWithMetadatas
 	ForwardedMetricWithMetadata   aggregated.ForwardedMetricWithMetadata
 	TimedMetricWithMetadata       aggregated.TimedMetricWithMetadata
 	TimedMetricWithMetadatas      aggregated.TimedMetricWithMetadatas
@@ -39,6 +39,7 @@
 		TimedMetricWithStoragePolicy
 		AggregatedMetric
 		MetricWithMetadatas
+		HistogramWithMetadatas
 		PipelineMetadata
 		Metadata
 		StagedMetadata
@@ -51,6 +52,7 @@
 		TimedMetric
 		ForwardedMetric
 		Tag
+		Histogram
 */
 package metricpb
 
@@ -84,6 +86,7 @@ const (
 	MetricWithMetadatas_TIMED_METRIC_WITH_METADATA       MetricWithMetadatas_Type = 5
 	MetricWithMetadatas_TIMED_METRIC_WITH_METADATAS      MetricWithMetadatas_Type = 6
 	MetricWithMetadatas_TIMED_METRIC_WITH_STORAGE_POLICY MetricWithMetadatas_Type = 7
+	MetricWithMetadatas_HISTOGRAM_WITH_METADATAS         MetricWithMetadatas_Type = 8
 )
 
 var MetricWithMetadatas_Type_name = map[int32]string{
@@ -95,6 +98,7 @@ var MetricWithMetadatas_Type_name = map[int32]string{
 	5: "TIMED_METRIC_WITH_METADATA",
 	6: "TIMED_METRIC_WITH_METADATAS",
 	7: "TIMED_METRIC_WITH_STORAGE_POLICY",
+	8: "HISTOGRAM_WITH_METADATAS",
 }
 var MetricWithMetadatas_Type_value = map[string]int32{
 	"UNKNOWN":                          0,
@@ -105,6 +109,7 @@ var MetricWithMetadatas_Type_value = map[string]int32{
 	"TIMED_METRIC_WITH_METADATA":       5,
 	"TIMED_METRIC_WITH_METADATAS":      6,
 	"TIMED_METRIC_WITH_STORAGE_POLICY": 7,
+	"HISTOGRAM_WITH_METADATAS":         8,
 }
 
 func (x MetricWithMetadatas_Type) String() string {
@@ -335,6 +340,7 @@ type MetricWithMetadatas struct {
 	TimedMetricWithMetadata      *TimedMetricWithMetadata      `protobuf:"bytes,6,opt,name=timed_metric_with_metadata,json=timedMetricWithMetadata" json:"timed_metric_with_metadata,omitempty"`
 	TimedMetricWithMetadatas     *TimedMetricWithMetadatas     `protobuf:"bytes,7,opt,name=timed_metric_with_metadatas,json=timedMetricWithMetadatas" json:"timed_metric_with_metadatas,omitempty"`
 	TimedMetricWithStoragePolicy *TimedMetricWithStoragePolicy `protobuf:"bytes,8,opt,name=timed_metric_with_storage_policy,json=timedMetricWithStoragePolicy" json:"timed_metric_with_storage_policy,omitempty"`
+	HistogramWithMetadatas       *HistogramWithMetadatas       `protobuf:"bytes,9,opt,name=histogram_with_metadatas,json=histogramWithMetadatas" json:"histogram_with_metadatas,omitempty"`
 }
 
 func (m *MetricWithMetadatas) Reset()                    { *m = MetricWithMetadatas{} }
@@ -398,6 +404,37 @@ func (m *MetricWithMetadatas) GetTimedMetricWithStoragePolicy() *TimedMetricWith
 	return nil
 }
 
+func (m *MetricWithMetadatas) GetHistogramWithMetadatas() *HistogramWithMetadatas {
+	if m != nil {
+		return m.HistogramWithMetadatas
+	}
+	return nil
+}
+
+type HistogramWithMetadatas struct {
+	Histogram Histogram       `protobuf:"bytes,1,opt,name=histogram" json:"histogram"`
+	Metadatas StagedMetadatas `protobuf:"bytes,2,opt,name=metadatas" json:"metadatas"`
+}
+
+func (m *HistogramWithMetadatas) Reset()                    { *m = HistogramWithMetadatas{} }
+func (m *HistogramWithMetadatas) String() string            { return proto.CompactTextString(m) }
+func (*HistogramWithMetadatas) ProtoMessage()               {}
+func (*HistogramWithMetadatas) Descriptor() ([]byte, []int) { return fileDescriptorComposite, []int{9} }
+
+func (m *HistogramWithMetadatas) GetHistogram() Histogram {
+	if m != nil {
+		return m.Histogram
+	}
+	return Histogram{}
+}
+
+func (m *HistogramWithMetadatas) GetMetadatas() StagedMetadatas {
+	if m != nil {
+		return m.Metadatas
+	}
+	return StagedMetadatas{}
+}
+
 func init() {
 	proto.RegisterType((*CounterWithMetadatas)(nil), "metricpb.CounterWithMetadatas")
 	proto.RegisterType((*BatchTimerWithMetadatas)(nil), "metricpb.BatchTimerWithMetadatas")
@@ -408,6 +445,7 @@ func init() {
 	proto.RegisterType((*TimedMetricWithStoragePolicy)(nil), "metricpb.TimedMetricWithStoragePolicy")
 	proto.RegisterType((*AggregatedMetric)(nil), "metricpb.AggregatedMetric")
 	proto.RegisterType((*MetricWithMetadatas)(nil), "metricpb.MetricWithMetadatas")
+	proto.RegisterType((*HistogramWithMetadatas)(nil), "metricpb.HistogramWithMetadatas")
 	proto.RegisterEnum("metricpb.MetricWithMetadatas_Type", MetricWithMetadatas_Type_name, MetricWithMetadatas_Type_value)
 }
 func (m *CounterWithMetadatas) Marshal() (dAtA []byte, err error) {
@@ -777,6 +815,50 @@ func (m *MetricWithMetadatas) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n23
 	}
+	if m.HistogramWithMetadatas != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintComposite(dAtA, i, uint64(m.HistogramWithMetadatas.Size()))
+		n24, err := m.HistogramWithMetadatas.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	return i, nil
+}
+
+func (m *HistogramWithMetadatas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistogramWithMetadatas) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintComposite(dAtA, i, uint64(m.Histogram.Size()))
+	n25, err := m.Histogram.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n25
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintComposite(dAtA, i, uint64(m.Metadatas.Size()))
+	n26, err := m.Metadatas.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n26
 	return i, nil
 }
 
@@ -906,6 +988,20 @@ func (m *MetricWithMetadatas) Size() (n int) {
 		l = m.TimedMetricWithStoragePolicy.Size()
 		n += 1 + l + sovComposite(uint64(l))
 	}
+	if m.HistogramWithMetadatas != nil {
+		l = m.HistogramWithMetadatas.Size()
+		n += 1 + l + sovComposite(uint64(l))
+	}
+	return n
+}
+
+func (m *HistogramWithMetadatas) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Histogram.Size()
+	n += 1 + l + sovComposite(uint64(l))
+	l = m.Metadatas.Size()
+	n += 1 + l + sovComposite(uint64(l))
 	return n
 }
 
@@ -2100,6 +2196,149 @@ func (m *MetricWithMetadatas) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistogramWithMetadatas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComposite
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComposite
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HistogramWithMetadatas == nil {
+				m.HistogramWithMetadatas = &HistogramWithMetadatas{}
+			}
+			if err := m.HistogramWithMetadatas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipComposite(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthComposite
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistogramWithMetadatas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowComposite
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistogramWithMetadatas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistogramWithMetadatas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComposite
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComposite
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadatas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComposite
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComposite
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadatas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipComposite(dAtA[iNdEx:])
@@ -2231,57 +2470,61 @@ func init() {
 }
 
 var fileDescriptorComposite = []byte{
-	// 827 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcd, 0x6e, 0xd3, 0x58,
-	0x14, 0xc7, 0xeb, 0x36, 0x4d, 0x3a, 0x27, 0x9d, 0x4e, 0xe6, 0x4e, 0xa6, 0xc9, 0x24, 0x95, 0xdb,
-	0x5a, 0x33, 0x23, 0x24, 0x44, 0x22, 0x1a, 0x89, 0x0a, 0x55, 0x20, 0x39, 0x1f, 0x4d, 0x23, 0x68,
-	0x52, 0x39, 0xae, 0x22, 0x58, 0xd4, 0xb2, 0x1d, 0xd7, 0x31, 0x22, 0x71, 0x64, 0xdf, 0xa8, 0xaa,
-	0xd8, 0xb0, 0x84, 0x0d, 0x42, 0x42, 0xbc, 0x53, 0x97, 0x3c, 0x01, 0x42, 0x65, 0x05, 0x6b, 0x1e,
-	0x00, 0xd9, 0xbe, 0x8e, 0xbf, 0xe2, 0x02, 0xc9, 0xce, 0x39, 0x1f, 0xbf, 0xf3, 0xef, 0xbd, 0xf7,
-	0xfc, 0x55, 0x68, 0xaa, 0x1a, 0x1e, 0x4c, 0xa4, 0x92, 0xac, 0x0f, 0xcb, 0xc3, 0x4a, 0x5f, 0x2a,
-	0x0f, 0x2b, 0x65, 0xd3, 0x90, 0xcb, 0x43, 0x05, 0x1b, 0x9a, 0x6c, 0x96, 0x55, 0x65, 0xa4, 0x18,
-	0x22, 0x56, 0xfa, 0xe5, 0xb1, 0xa1, 0x63, 0x9d, 0xc4, 0xc7, 0x52, 0x59, 0xd6, 0x87, 0x63, 0xdd,
-	0xd4, 0xb0, 0x52, 0xb2, 0x13, 0x68, 0xcd, 0xcd, 0x14, 0xee, 0xf8, 0x90, 0xaa, 0xae, 0xea, 0x4e,
-	0xa7, 0x34, 0x39, 0xb7, 0x7f, 0x39, 0x18, 0xeb, 0xcb, 0x69, 0x2c, 0xd4, 0xe7, 0x55, 0xe0, 0x7c,
-	0x10, 0xca, 0xe1, 0x02, 0x14, 0xb1, 0x2f, 0x62, 0x71, 0x4e, 0x35, 0x63, 0xfd, 0xb9, 0x26, 0x5f,
-	0x8e, 0x25, 0xf2, 0xe1, 0x50, 0x98, 0x57, 0x14, 0x64, 0x6b, 0xfa, 0x64, 0x84, 0x15, 0xa3, 0xa7,
-	0xe1, 0xc1, 0x31, 0x99, 0x61, 0xa2, 0xbb, 0x90, 0x92, 0x9d, 0x78, 0x9e, 0xda, 0xa1, 0x6e, 0xa5,
-	0xf7, 0xfe, 0x2c, 0xb9, 0x4a, 0x4a, 0xa4, 0xa1, 0x9a, 0xb8, 0xfa, 0xb8, 0xbd, 0xc4, 0xb9, 0x75,
-	0xe8, 0x01, 0xfc, 0xe6, 0x6a, 0x34, 0xf3, 0xcb, 0x76, 0xd3, 0x3f, 0x5e, 0x53, 0x17, 0x8b, 0xaa,
-	0xd2, 0x9f, 0x0e, 0x20, 0xcd, 0x5e, 0x07, 0xf3, 0x9e, 0x82, 0x5c, 0x55, 0xc4, 0xf2, 0x80, 0xd7,
-	0x86, 0x61, 0x35, 0x07, 0x90, 0x96, 0xac, 0x94, 0x80, 0xad, 0x1c, 0x51, 0x94, 0xf5, 0xe0, 0x5e,
-	0x1f, 0xe1, 0x82, 0x34, 0x8d, 0x2c, 0xaa, 0xeb, 0x25, 0x05, 0xa8, 0x29, 0x4e, 0x54, 0x25, 0x28,
-	0xe9, 0x36, 0xac, 0xaa, 0x56, 0x94, 0x88, 0xf9, 0xc3, 0x23, 0xda, 0xc5, 0x84, 0xe3, 0xd4, 0x2c,
-	0x2a, 0xe1, 0x1d, 0x05, 0xc5, 0x43, 0xdd, 0xb8, 0x10, 0x8d, 0xbe, 0x5d, 0x67, 0x68, 0xb2, 0x5f,
-	0x0c, 0xda, 0x87, 0xa4, 0x03, 0x23, 0x62, 0x7c, 0xec, 0x50, 0x1b, 0x61, 0x93, 0x72, 0x74, 0x00,
-	0x6b, 0xee, 0x94, 0xa8, 0x2c, 0xd2, 0xea, 0x4e, 0x21, 0xad, 0xd3, 0x06, 0xe6, 0x35, 0x05, 0x39,
-	0xeb, 0x84, 0x67, 0x29, 0xaa, 0x84, 0x14, 0xfd, 0xed, 0x61, 0x7d, 0x2d, 0x21, 0x35, 0xf7, 0x23,
-	0x6a, 0x72, 0xd1, 0xb6, 0xd9, 0x5a, 0xde, 0x50, 0x90, 0x8f, 0xd1, 0x62, 0xce, 0x27, 0x66, 0xc1,
-	0x2b, 0xfb, 0x4a, 0xc1, 0x56, 0x48, 0x50, 0x17, 0xeb, 0x86, 0xa8, 0x2a, 0x27, 0xf6, 0xfe, 0xa1,
-	0x87, 0xb0, 0x6e, 0x3d, 0xe6, 0xbe, 0xf0, 0xf3, 0xd2, 0xd2, 0xd8, 0x0b, 0xa1, 0x3a, 0x6c, 0x98,
-	0x0e, 0x50, 0x70, 0x36, 0x7a, 0x7a, 0x64, 0xee, 0xa6, 0x97, 0x02, 0x03, 0x09, 0xe3, 0x77, 0x33,
-	0xa0, 0xa2, 0x0e, 0x1b, 0x86, 0x3e, 0xc1, 0xda, 0x48, 0x75, 0x29, 0x2b, 0x61, 0x0a, 0xe7, 0xe4,
-	0x83, 0x14, 0xc3, 0x1f, 0x64, 0x5e, 0x40, 0x86, 0x55, 0x55, 0x43, 0x51, 0x2d, 0xbf, 0x99, 0xea,
-	0x0b, 0x1e, 0xfa, 0xff, 0x33, 0xff, 0xb2, 0xc8, 0xb9, 0x84, 0x6e, 0x61, 0x17, 0xd6, 0x95, 0x91,
-	0xac, 0xf7, 0x15, 0x61, 0x24, 0x8e, 0x74, 0xe7, 0x22, 0x56, 0xb8, 0xb4, 0x13, 0x6b, 0x5b, 0x21,
-	0xe6, 0x4b, 0x0a, 0xfe, 0x9a, 0x75, 0xeb, 0xf7, 0x20, 0x81, 0x2f, 0xc7, 0xce, 0x7e, 0x6e, 0xec,
-	0x31, 0xde, 0xf8, 0x19, 0xc5, 0x25, 0xfe, 0x72, 0xac, 0x70, 0x76, 0x3d, 0xe2, 0x61, 0x93, 0x38,
-	0x9a, 0x70, 0xa1, 0xe1, 0x81, 0x10, 0x7e, 0x05, 0x74, 0xc4, 0x08, 0x03, 0x28, 0x2e, 0x2b, 0xcf,
-	0xf2, 0xd3, 0x33, 0x28, 0xf8, 0x1c, 0x2c, 0x4c, 0x76, 0x0e, 0x7d, 0x77, 0x96, 0xa1, 0x05, 0xe1,
-	0x39, 0x29, 0xc6, 0x21, 0xdb, 0x90, 0xb5, 0xad, 0x26, 0x4c, 0x4e, 0xd8, 0xe4, 0xad, 0x90, 0x3b,
-	0x05, 0xa1, 0x48, 0x8d, 0xda, 0xdb, 0x33, 0xa0, 0xcf, 0x5d, 0xeb, 0x20, 0x4f, 0x34, 0x88, 0xce,
-	0xaf, 0xda, 0xe4, 0xff, 0x62, 0xad, 0xc6, 0xcf, 0xe3, 0x8a, 0xe7, 0x37, 0xd8, 0xd7, 0x19, 0x14,
-	0xfc, 0xab, 0x10, 0x9a, 0x93, 0x0c, 0x9f, 0x4d, 0xcc, 0x9e, 0x73, 0x39, 0x1c, 0x63, 0x46, 0x22,
-	0x14, 0xe3, 0xf9, 0x66, 0x3e, 0x65, 0x0f, 0x60, 0x7e, 0x38, 0xc0, 0xe4, 0xf2, 0x38, 0xce, 0x62,
-	0x46, 0xb0, 0x13, 0x1d, 0x11, 0xda, 0xcf, 0xb5, 0x5f, 0xd9, 0x03, 0x6e, 0x0b, 0xdf, 0x90, 0x65,
-	0xbe, 0x51, 0x90, 0xb0, 0xde, 0x2c, 0x4a, 0x43, 0xea, 0xb4, 0xfd, 0xa8, 0xdd, 0xe9, 0xb5, 0x33,
-	0x4b, 0xa8, 0x00, 0x9b, 0xb5, 0xce, 0x69, 0x9b, 0x6f, 0x70, 0x42, 0xaf, 0xc5, 0x1f, 0x09, 0xc7,
-	0x0d, 0x9e, 0xad, 0xb3, 0x3c, 0xdb, 0xcd, 0x50, 0x88, 0x86, 0x42, 0x95, 0xe5, 0x6b, 0x47, 0x02,
-	0xdf, 0x3a, 0x8e, 0xe6, 0x97, 0x51, 0x1e, 0xb2, 0x4d, 0xf6, 0xb4, 0xd9, 0x08, 0x67, 0x56, 0x10,
-	0x03, 0xf4, 0x61, 0x87, 0xeb, 0xb1, 0x5c, 0xbd, 0x51, 0xb7, 0x12, 0x5c, 0xab, 0x16, 0x2c, 0xca,
-	0x24, 0x2c, 0xba, 0xc5, 0x8d, 0xc9, 0xaf, 0xa2, 0x6d, 0x28, 0xc6, 0xe7, 0xbb, 0x99, 0x24, 0xfa,
-	0x17, 0x76, 0xa2, 0x05, 0x5d, 0xbe, 0xc3, 0xb1, 0xcd, 0x86, 0x70, 0xd2, 0x79, 0xdc, 0xaa, 0x3d,
-	0xc9, 0xa4, 0xaa, 0xad, 0xab, 0x6b, 0x9a, 0xfa, 0x70, 0x4d, 0x53, 0x9f, 0xae, 0x69, 0xea, 0xed,
-	0x67, 0x7a, 0xe9, 0xe9, 0xfe, 0x9c, 0xff, 0x4e, 0x49, 0x49, 0xfb, 0x77, 0xe5, 0x7b, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x6c, 0x42, 0xc7, 0x42, 0x58, 0x0a, 0x00, 0x00,
+	// 886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xdf, 0x8f, 0xe2, 0x54,
+	0x14, 0xc7, 0xa7, 0x33, 0xcc, 0x0c, 0x73, 0x58, 0x47, 0xbc, 0x8b, 0x43, 0x65, 0x48, 0x97, 0x6d,
+	0xd4, 0x98, 0x18, 0x21, 0x2e, 0x89, 0x13, 0xb3, 0xd1, 0xa4, 0xfc, 0x18, 0x20, 0x0a, 0x6c, 0x4a,
+	0x27, 0xc4, 0x7d, 0xb0, 0x69, 0x4b, 0xa7, 0xd4, 0x58, 0x4a, 0xda, 0x4b, 0x36, 0x13, 0x5f, 0x7c,
+	0xd4, 0x17, 0xb3, 0xd1, 0xf8, 0x3f, 0xed, 0xa3, 0x7f, 0x81, 0x31, 0xb3, 0x6f, 0xfe, 0x15, 0xa6,
+	0xed, 0x2d, 0x6d, 0x6f, 0x5b, 0x75, 0xe1, 0xad, 0x9c, 0x1f, 0x9f, 0xf3, 0xed, 0xb9, 0xf7, 0x9c,
+	0x02, 0x03, 0xc3, 0xc4, 0xcb, 0x8d, 0xda, 0xd4, 0x6c, 0xab, 0x65, 0xb5, 0x17, 0x6a, 0xcb, 0x6a,
+	0xb7, 0x5c, 0x47, 0x6b, 0x59, 0x3a, 0x76, 0x4c, 0xcd, 0x6d, 0x19, 0xfa, 0x4a, 0x77, 0x14, 0xac,
+	0x2f, 0x5a, 0x6b, 0xc7, 0xc6, 0x36, 0xb1, 0xaf, 0xd5, 0x96, 0x66, 0x5b, 0x6b, 0xdb, 0x35, 0xb1,
+	0xde, 0xf4, 0x1d, 0xa8, 0x18, 0x7a, 0x6a, 0x9f, 0xc4, 0x90, 0x86, 0x6d, 0xd8, 0x41, 0xa6, 0xba,
+	0xb9, 0xf5, 0x7f, 0x05, 0x18, 0xef, 0x29, 0x48, 0xac, 0xf5, 0x76, 0x55, 0x10, 0x3c, 0x10, 0xca,
+	0xf5, 0x1e, 0x14, 0x65, 0xa1, 0x60, 0x65, 0x47, 0x35, 0x6b, 0xfb, 0x7b, 0x53, 0xbb, 0x5b, 0xab,
+	0xe4, 0x21, 0xa0, 0xf0, 0x3f, 0x31, 0x50, 0xe9, 0xda, 0x9b, 0x15, 0xd6, 0x9d, 0xb9, 0x89, 0x97,
+	0x63, 0x52, 0xc3, 0x45, 0x9f, 0xc2, 0xa9, 0x16, 0xd8, 0x59, 0xa6, 0xc1, 0x7c, 0x54, 0x7a, 0xf2,
+	0x4e, 0x33, 0x54, 0xd2, 0x24, 0x09, 0x9d, 0xc2, 0xab, 0x3f, 0x1f, 0x1d, 0x88, 0x61, 0x1c, 0xfa,
+	0x02, 0xce, 0x42, 0x8d, 0x2e, 0x7b, 0xe8, 0x27, 0xbd, 0x17, 0x25, 0xcd, 0xb0, 0x62, 0xe8, 0x8b,
+	0x6d, 0x01, 0x92, 0x1c, 0x65, 0xf0, 0xbf, 0x33, 0x50, 0xed, 0x28, 0x58, 0x5b, 0x4a, 0xa6, 0x45,
+	0xab, 0x79, 0x0a, 0x25, 0xd5, 0x73, 0xc9, 0xd8, 0xb4, 0xb6, 0x8a, 0x2a, 0x11, 0x3c, 0xca, 0x23,
+	0x5c, 0x50, 0xb7, 0x96, 0x7d, 0x75, 0xfd, 0xc8, 0x00, 0x1a, 0x28, 0x1b, 0x43, 0x4f, 0x4a, 0xfa,
+	0x18, 0x8e, 0x0d, 0xcf, 0x4a, 0xc4, 0xbc, 0x1d, 0x11, 0xfd, 0x60, 0xc2, 0x09, 0x62, 0xf6, 0x95,
+	0xf0, 0x1b, 0x03, 0x97, 0xd7, 0xb6, 0xf3, 0x42, 0x71, 0x16, 0x7e, 0x9c, 0x63, 0x6a, 0x71, 0x31,
+	0xe8, 0x0a, 0x4e, 0x02, 0x18, 0xcb, 0xd0, 0x6c, 0x2a, 0x8d, 0xb0, 0x49, 0x38, 0x7a, 0x0a, 0xc5,
+	0xb0, 0x0a, 0x7b, 0x98, 0x93, 0x1a, 0x56, 0x21, 0xa9, 0xdb, 0x04, 0xfe, 0x67, 0x06, 0xaa, 0x5e,
+	0x87, 0xb3, 0x14, 0xb5, 0x29, 0x45, 0xef, 0x46, 0xd8, 0x58, 0x0a, 0xa5, 0xe6, 0xf3, 0x94, 0x9a,
+	0x6a, 0x3a, 0x2d, 0x5b, 0xcb, 0x2f, 0x0c, 0xb0, 0x39, 0x5a, 0xdc, 0xdd, 0xc4, 0xec, 0x79, 0x64,
+	0x7f, 0x33, 0x50, 0xa7, 0x04, 0xcd, 0xb0, 0xed, 0x28, 0x86, 0xfe, 0xcc, 0x9f, 0x3f, 0xf4, 0x25,
+	0x3c, 0xf0, 0x2e, 0xf3, 0x42, 0xfe, 0xff, 0xd2, 0x4a, 0x38, 0x32, 0xa1, 0x1e, 0x9c, 0xbb, 0x01,
+	0x50, 0x0e, 0x26, 0x7a, 0xdb, 0xb2, 0x70, 0xd2, 0x9b, 0x89, 0x82, 0x84, 0xf1, 0x96, 0x9b, 0x50,
+	0xd1, 0x83, 0x73, 0xc7, 0xde, 0x60, 0x73, 0x65, 0x84, 0x94, 0x23, 0x9a, 0x22, 0x06, 0xfe, 0x24,
+	0xc5, 0x89, 0x1b, 0xf9, 0x1f, 0xa0, 0x2c, 0x18, 0x86, 0xa3, 0x1b, 0x0a, 0x8e, 0xe9, 0x4b, 0x36,
+	0xfd, 0xc3, 0xcc, 0x37, 0x4b, 0xf5, 0x85, 0x3a, 0x85, 0xc7, 0xf0, 0x40, 0x5f, 0x69, 0xf6, 0x42,
+	0x97, 0x57, 0xca, 0xca, 0x0e, 0x0e, 0xe2, 0x48, 0x2c, 0x05, 0xb6, 0x89, 0x67, 0xe2, 0x5f, 0x17,
+	0xe1, 0x61, 0xd6, 0xa9, 0x7f, 0x06, 0x05, 0x7c, 0xb7, 0x0e, 0xe6, 0xf3, 0xfc, 0x09, 0x1f, 0x95,
+	0xcf, 0x08, 0x6e, 0x4a, 0x77, 0x6b, 0x5d, 0xf4, 0xe3, 0x91, 0x04, 0x17, 0x64, 0xa3, 0xc9, 0x2f,
+	0x4c, 0xbc, 0x94, 0xe9, 0x5b, 0xc0, 0xa5, 0x16, 0x61, 0x02, 0x25, 0x56, 0xb4, 0x0c, 0x2b, 0xfa,
+	0x16, 0x6a, 0xb1, 0x0d, 0x46, 0x93, 0x83, 0xa6, 0x3f, 0xce, 0x5a, 0x68, 0x49, 0x78, 0x55, 0xcd,
+	0x76, 0xa0, 0x09, 0x54, 0xfc, 0x55, 0x43, 0x93, 0x0b, 0x3e, 0xb9, 0x4e, 0x6d, 0xa7, 0x24, 0x14,
+	0x19, 0x29, 0x1b, 0xfa, 0x0e, 0xb8, 0xdb, 0x70, 0x75, 0x90, 0x2b, 0x9a, 0x44, 0xb3, 0xc7, 0x3e,
+	0xf9, 0x83, 0xdc, 0x55, 0x13, 0xe7, 0x89, 0x97, 0xb7, 0xf9, 0x4e, 0xaf, 0x37, 0xf1, 0x51, 0xa0,
+	0xea, 0x9c, 0xd0, 0xbd, 0xc9, 0x99, 0x73, 0xb1, 0x8a, 0xb3, 0x1d, 0x48, 0x81, 0xcb, 0x7c, 0xbe,
+	0xcb, 0x9e, 0xfa, 0x05, 0xf8, 0xff, 0x2c, 0xe0, 0x8a, 0x6c, 0x4e, 0x05, 0x17, 0xad, 0xa0, 0x91,
+	0x2e, 0x41, 0xcd, 0x67, 0xf1, 0x4d, 0xe6, 0x40, 0xac, 0xe3, 0x7f, 0xf1, 0xa2, 0xe7, 0xc0, 0x2e,
+	0x4d, 0x17, 0xdb, 0x86, 0xa3, 0x58, 0xf4, 0xfb, 0x9c, 0xf9, 0x75, 0x1a, 0x51, 0x9d, 0x61, 0x18,
+	0x99, 0x7c, 0x9b, 0x8b, 0x65, 0xa6, 0x9d, 0xff, 0xf5, 0x10, 0x0a, 0xde, 0x3c, 0xa0, 0x12, 0x9c,
+	0xde, 0x4c, 0xbe, 0x9a, 0x4c, 0xe7, 0x93, 0xf2, 0x01, 0xaa, 0xc1, 0x45, 0x77, 0x7a, 0x33, 0x91,
+	0xfa, 0xa2, 0x3c, 0x1f, 0x49, 0x43, 0x79, 0xdc, 0x97, 0x84, 0x9e, 0x20, 0x09, 0xb3, 0x32, 0x83,
+	0x38, 0xa8, 0x75, 0x04, 0xa9, 0x3b, 0x94, 0xa5, 0xd1, 0x38, 0xed, 0x3f, 0x44, 0x2c, 0x54, 0x06,
+	0xc2, 0xcd, 0xa0, 0x4f, 0x7b, 0x8e, 0x10, 0x0f, 0xdc, 0xf5, 0x54, 0x9c, 0x0b, 0x62, 0xaf, 0xdf,
+	0xf3, 0x1c, 0xe2, 0xa8, 0x9b, 0x0c, 0x2a, 0x17, 0x3c, 0xba, 0xc7, 0xcd, 0xf1, 0x1f, 0xa3, 0x47,
+	0x70, 0x99, 0xef, 0x9f, 0x95, 0x4f, 0xd0, 0xfb, 0xd0, 0x48, 0x07, 0xcc, 0xa4, 0xa9, 0x28, 0x0c,
+	0xfa, 0xf2, 0xb3, 0xe9, 0xd7, 0xa3, 0xee, 0x37, 0xe5, 0x53, 0x54, 0x07, 0x76, 0x38, 0x9a, 0x49,
+	0xd3, 0x81, 0x28, 0x8c, 0x69, 0x46, 0x91, 0x7f, 0xc9, 0xc0, 0x45, 0x76, 0x1f, 0xd1, 0x15, 0x9c,
+	0x6d, 0x3b, 0x49, 0x96, 0xdd, 0xc3, 0x8c, 0xe6, 0x87, 0xdf, 0x88, 0x6d, 0xec, 0x9e, 0x9f, 0x98,
+	0xce, 0xe8, 0xd5, 0x3d, 0xc7, 0xfc, 0x71, 0xcf, 0x31, 0x7f, 0xdd, 0x73, 0xcc, 0xcb, 0xd7, 0xdc,
+	0xc1, 0xf3, 0xab, 0x1d, 0xff, 0x5b, 0xaa, 0x27, 0xfe, 0xef, 0xf6, 0x3f, 0x03, 0x00, 0x76, 0x37,
+	0xaa, 0xe3, 0x65, 0x0b, 0x00, 0x00,
 }
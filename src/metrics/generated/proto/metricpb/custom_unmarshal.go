@@ -222,6 +222,26 @@ func (m *PipelineMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DdsketchEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DdsketchEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -460,4 +480,5 @@ func (m *PipelineMetadata) reuse() {
 	m.DropPolicy = 0
 	m.ResendEnabled = false
 	m.RoutingPolicy = policypb.RoutingPolicy{}
+	m.DdsketchEnabled = false
 }
@@ -33,12 +33,39 @@ func TestCatchNewFields(t *testing.T) {
 	verifyFields(t, &StagedMetadatas{}, 1)
 	verifyFields(t, &StagedMetadata{}, 3)
 	verifyFields(t, &Metadata{}, 1)
-	verifyFields(t, &PipelineMetadata{}, 6)
+	verifyFields(t, &PipelineMetadata{}, 7)
 	verifyFields(t, &pipelinepb.AppliedPipeline{}, 1)
 	verifyFields(t, &pipelinepb.AppliedPipelineOp{}, 3)
 	verifyFields(t, &pipelinepb.AppliedRollupOp{}, 2)
 }
 
+func TestReuseResetsDDSketchEnabled(t *testing.T) {
+	newStagedMetadatas := func(ddsketchEnabled bool) StagedMetadatas {
+		return StagedMetadatas{
+			Metadatas: []StagedMetadata{{
+				Metadata: Metadata{
+					Pipelines: []PipelineMetadata{{DdsketchEnabled: ddsketchEnabled}},
+				},
+			}},
+		}
+	}
+	withSketch := newStagedMetadatas(true)
+	withSketchBytes, err := withSketch.Marshal()
+	require.NoError(t, err)
+	withoutSketch := newStagedMetadatas(false)
+	withoutSketchBytes, err := withoutSketch.Marshal()
+	require.NoError(t, err)
+
+	var m StagedMetadatas
+	require.NoError(t, m.Unmarshal(withSketchBytes))
+	require.True(t, m.Metadatas[0].Metadata.Pipelines[0].DdsketchEnabled)
+
+	// Reusing the message resets the field before unmarshalling into it.
+	m.Reuse()
+	require.NoError(t, m.Unmarshal(withoutSketchBytes))
+	require.False(t, m.Metadatas[0].Metadata.Pipelines[0].DdsketchEnabled)
+}
+
 func verifyFields(t *testing.T, m descriptor.Message, expectedFieldCount int) {
 	_, d := descriptor.ForMessage(m)
 	require.Len(t, d.Field, expectedFieldCount, "Unexpected number of fields for %s. "+
@@ -45,6 +45,7 @@ type PipelineMetadata struct {
 	DropPolicy      policypb.DropPolicy         `protobuf:"varint,4,opt,name=drop_policy,json=dropPolicy,proto3,enum=policypb.DropPolicy" json:"drop_policy,omitempty"`
 	ResendEnabled   bool                        `protobuf:"varint,5,opt,name=resend_enabled,json=resendEnabled,proto3" json:"resend_enabled,omitempty"`
 	RoutingPolicy   policypb.RoutingPolicy      `protobuf:"bytes,6,opt,name=routing_policy,json=routingPolicy" json:"routing_policy"`
+	DdsketchEnabled bool                        `protobuf:"varint,7,opt,name=ddsketch_enabled,json=ddsketchEnabled,proto3" json:"ddsketch_enabled,omitempty"`
 }
 
 func (m *PipelineMetadata) Reset()                    { *m = PipelineMetadata{} }
@@ -94,6 +95,13 @@ func (m *PipelineMetadata) GetRoutingPolicy() policypb.RoutingPolicy {
 	return policypb.RoutingPolicy{}
 }
 
+func (m *PipelineMetadata) GetDdsketchEnabled() bool {
+	if m != nil {
+		return m.DdsketchEnabled
+	}
+	return false
+}
+
 type Metadata struct {
 	Pipelines []PipelineMetadata `protobuf:"bytes,1,rep,name=pipelines" json:"pipelines"`
 }
@@ -166,6 +174,7 @@ type ForwardMetadata struct {
 	NumForwardedTimes int32                       `protobuf:"varint,5,opt,name=num_forwarded_times,json=numForwardedTimes,proto3" json:"num_forwarded_times,omitempty"`
 	ResendEnabled     bool                        `protobuf:"varint,6,opt,name=resend_enabled,json=resendEnabled,proto3" json:"resend_enabled,omitempty"`
 	RoutingPolicy     policypb.RoutingPolicy      `protobuf:"bytes,7,opt,name=routing_policy,json=routingPolicy" json:"routing_policy"`
+	DdsketchEnabled   bool                        `protobuf:"varint,8,opt,name=ddsketch_enabled,json=ddsketchEnabled,proto3" json:"ddsketch_enabled,omitempty"`
 }
 
 func (m *ForwardMetadata) Reset()                    { *m = ForwardMetadata{} }
@@ -222,6 +231,13 @@ func (m *ForwardMetadata) GetRoutingPolicy() policypb.RoutingPolicy {
 	return policypb.RoutingPolicy{}
 }
 
+func (m *ForwardMetadata) GetDdsketchEnabled() bool {
+	if m != nil {
+		return m.DdsketchEnabled
+	}
+	return false
+}
+
 type TimedMetadata struct {
 	AggregationId aggregationpb.AggregationID `protobuf:"bytes,1,opt,name=aggregation_id,json=aggregationId" json:"aggregation_id"`
 	StoragePolicy policypb.StoragePolicy      `protobuf:"bytes,2,opt,name=storage_policy,json=storagePolicy" json:"storage_policy"`
@@ -328,6 +344,16 @@ func (m *PipelineMetadata) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n3
+	if m.DdsketchEnabled {
+		dAtA[i] = 0x38
+		i++
+		if m.DdsketchEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -499,6 +525,16 @@ func (m *ForwardMetadata) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n8
+	if m.DdsketchEnabled {
+		dAtA[i] = 0x40
+		i++
+		if m.DdsketchEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -574,6 +610,9 @@ func (m *PipelineMetadata) Size() (n int) {
 	}
 	l = m.RoutingPolicy.Size()
 	n += 1 + l + sovMetadata(uint64(l))
+	if m.DdsketchEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -635,6 +674,9 @@ func (m *ForwardMetadata) Size() (n int) {
 	}
 	l = m.RoutingPolicy.Size()
 	n += 1 + l + sovMetadata(uint64(l))
+	if m.DdsketchEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -989,6 +1031,26 @@ func (m *ForwardMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DdsketchEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DdsketchEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -1260,44 +1322,46 @@ func init() {
 }
 
 var fileDescriptorMetadata = []byte{
-	// 621 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x6b, 0xd4, 0x40,
-	0x1c, 0x6d, 0xba, 0x6d, 0xdd, 0x4e, 0x4d, 0x5a, 0x47, 0xc1, 0xd0, 0xca, 0x76, 0x59, 0x11, 0xf6,
-	0x62, 0x02, 0xad, 0x22, 0x88, 0x0a, 0x2d, 0x6b, 0xe9, 0x1e, 0x2c, 0x25, 0xd5, 0x8b, 0x97, 0x90,
-	0x64, 0xa6, 0x71, 0x60, 0x93, 0x09, 0x33, 0x13, 0x65, 0x3f, 0x83, 0x97, 0xde, 0x3d, 0xf9, 0x6d,
-	0x7a, 0xf4, 0x13, 0x88, 0xac, 0xe0, 0xe7, 0x90, 0xcc, 0x9f, 0x24, 0x5b, 0x05, 0x5d, 0x45, 0xf0,
-	0x36, 0xbf, 0xf7, 0x9b, 0xdf, 0xcb, 0x7b, 0xf3, 0x7b, 0xcb, 0x82, 0xa3, 0x94, 0x88, 0x37, 0x65,
-	0xec, 0x25, 0x34, 0xf3, 0xb3, 0x7d, 0x14, 0xfb, 0xd9, 0xbe, 0xcf, 0x59, 0xe2, 0x67, 0x58, 0x30,
-	0x92, 0x70, 0x3f, 0xc5, 0x39, 0x66, 0x91, 0xc0, 0xc8, 0x2f, 0x18, 0x15, 0x54, 0xe3, 0x45, 0x5c,
-	0x1d, 0x22, 0x14, 0x89, 0xc8, 0x93, 0x38, 0xec, 0x9a, 0xc6, 0xf6, 0xfd, 0x16, 0x63, 0x4a, 0x53,
-	0xaa, 0x06, 0xe3, 0xf2, 0x5c, 0x56, 0x8a, 0xa5, 0x3a, 0xa9, 0xc1, 0xed, 0x93, 0x05, 0x05, 0x44,
-	0x69, 0xca, 0x70, 0x1a, 0x09, 0x42, 0xf3, 0x22, 0x6e, 0x57, 0x9a, 0x6f, 0xb4, 0x20, 0x5f, 0x41,
-	0x27, 0x24, 0x99, 0x16, 0xb1, 0x3e, 0x68, 0x96, 0xe3, 0x45, 0x59, 0x48, 0x81, 0x27, 0x24, 0xc7,
-	0x15, 0x8f, 0x3e, 0x2a, 0xa6, 0xc1, 0x45, 0x07, 0x6c, 0x9d, 0x6a, 0xe8, 0x85, 0x7e, 0x33, 0x38,
-	0x06, 0x4e, 0x4b, 0x79, 0x48, 0x90, 0x6b, 0xf5, 0xad, 0xe1, 0xc6, 0xde, 0x1d, 0x6f, 0xce, 0x9e,
-	0x77, 0xd0, 0x54, 0xe3, 0xd1, 0xe1, 0xca, 0xe5, 0xe7, 0xdd, 0xa5, 0xc0, 0x6e, 0x5d, 0x19, 0x23,
-	0x78, 0x0c, 0xb6, 0xb8, 0xa0, 0x2c, 0x4a, 0x71, 0x28, 0x1d, 0x10, 0xcc, 0xdd, 0xe5, 0x7e, 0x67,
-	0xb8, 0xb1, 0x77, 0xdb, 0x33, 0xde, 0xbc, 0x33, 0x75, 0xe3, 0x54, 0xd6, 0x9a, 0x67, 0x93, 0xb7,
-	0x40, 0x82, 0x39, 0x7c, 0x0a, 0xba, 0x46, 0xbb, 0xdb, 0x91, 0x72, 0x76, 0xbc, 0xc6, 0x97, 0x77,
-	0x50, 0x14, 0x13, 0x82, 0x91, 0xf1, 0xa2, 0x59, 0xea, 0x11, 0xf8, 0x10, 0x6c, 0x20, 0x46, 0x0b,
-	0xa5, 0x62, 0xea, 0xae, 0xf4, 0xad, 0xa1, 0xb3, 0x77, 0xab, 0xd1, 0x30, 0x62, 0xb4, 0x50, 0x02,
-	0x02, 0x80, 0xea, 0x33, 0xbc, 0x07, 0x1c, 0x86, 0x39, 0xce, 0x51, 0x88, 0xf3, 0x28, 0x9e, 0x60,
-	0xe4, 0xae, 0xf6, 0xad, 0x61, 0x37, 0xb0, 0x15, 0xfa, 0x5c, 0x81, 0x70, 0x04, 0x1c, 0x46, 0x4b,
-	0x41, 0xf2, 0xd4, 0x7c, 0x60, 0x4d, 0x4a, 0x6c, 0x99, 0x0c, 0x54, 0x7f, 0xce, 0xa4, 0xcd, 0xda,
-	0xe0, 0xe3, 0x95, 0x8b, 0x8f, 0xbb, 0x4b, 0x83, 0x53, 0xd0, 0xad, 0x37, 0xf1, 0x0c, 0xac, 0x1b,
-	0x07, 0xdc, 0xb5, 0xe4, 0xbb, 0x6d, 0x7b, 0x26, 0xcb, 0xde, 0xd5, 0xc5, 0x69, 0xd6, 0x66, 0x44,
-	0x33, 0xbe, 0xb7, 0x80, 0x73, 0x26, 0xa2, 0x14, 0xa3, 0x9a, 0xf8, 0x2e, 0xb0, 0x93, 0x52, 0xd0,
-	0xb7, 0x98, 0x85, 0x79, 0x94, 0x53, 0x2e, 0x37, 0xdc, 0x09, 0xae, 0x6b, 0xf0, 0xa4, 0xc2, 0x60,
-	0x0f, 0x00, 0x41, 0xb3, 0x98, 0x0b, 0x9a, 0x63, 0xe4, 0x2e, 0x4b, 0xe3, 0x2d, 0x04, 0x3e, 0x00,
-	0x5d, 0xf3, 0x3b, 0xd3, 0x2b, 0x81, 0x8d, 0xb8, 0x2b, 0xa2, 0xea, 0x9b, 0x83, 0x57, 0x60, 0x73,
-	0x5e, 0x0c, 0x87, 0x4f, 0xc0, 0xba, 0x69, 0x1b, 0x9b, 0x6e, 0xc3, 0x34, 0x7f, 0xdb, 0x98, 0xac,
-	0x07, 0xb4, 0xc9, 0x0f, 0x1d, 0xb0, 0x79, 0x44, 0xd9, 0xbb, 0x88, 0xa1, 0x7f, 0x11, 0xe4, 0x11,
-	0x70, 0xe6, 0x82, 0x3c, 0x95, 0xef, 0xf1, 0xcb, 0x18, 0xdb, 0xed, 0x18, 0x4f, 0xff, 0x36, 0xc4,
-	0x3b, 0x60, 0x9d, 0xd3, 0x92, 0x25, 0xb8, 0xb2, 0x52, 0x45, 0xd8, 0x0e, 0xba, 0x0a, 0x18, 0x23,
-	0xe8, 0x81, 0x9b, 0x79, 0x99, 0x85, 0xe7, 0xea, 0x0d, 0x30, 0x0a, 0x05, 0xc9, 0x30, 0x97, 0x79,
-	0x5d, 0x0d, 0x6e, 0xe4, 0x65, 0x76, 0x64, 0x3a, 0x2f, 0xab, 0xc6, 0x4f, 0xa2, 0xbd, 0xf6, 0x7b,
-	0xd1, 0xbe, 0xb6, 0x78, 0xb4, 0x07, 0xdf, 0x2c, 0x60, 0x57, 0x9f, 0xfd, 0x8f, 0x77, 0xf3, 0xa3,
-	0xd1, 0xce, 0xe2, 0x46, 0x0f, 0xc7, 0x97, 0xb3, 0x9e, 0xf5, 0x69, 0xd6, 0xb3, 0xbe, 0xcc, 0x7a,
-	0xd6, 0xc5, 0xd7, 0xde, 0xd2, 0xeb, 0x47, 0x7f, 0xf8, 0x1f, 0x16, 0xaf, 0xc9, 0x7a, 0xff, 0x7b,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x16, 0x16, 0xf8, 0x05, 0x05, 0x07, 0x00, 0x00,
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xdd, 0x6a, 0xd4, 0x40,
+	0x18, 0x6d, 0xba, 0xfd, 0x49, 0xbf, 0x9a, 0xdd, 0x3a, 0x0a, 0x86, 0x56, 0xb6, 0xcb, 0x8a, 0xb0,
+	0x5e, 0x98, 0x85, 0x56, 0x11, 0x44, 0x85, 0x96, 0xb5, 0x74, 0x2f, 0x2c, 0x25, 0xd5, 0x1b, 0x6f,
+	0x96, 0x24, 0x33, 0x4d, 0x83, 0x9b, 0x4c, 0x98, 0x99, 0x28, 0xfb, 0x0c, 0xde, 0xf4, 0x15, 0x7c,
+	0x0e, 0xef, 0xa5, 0x97, 0x3e, 0x81, 0x48, 0x05, 0x9f, 0x43, 0x92, 0x99, 0xc9, 0x26, 0x75, 0x2f,
+	0x5c, 0x7f, 0xc0, 0xbb, 0x99, 0x33, 0xf9, 0xce, 0x9c, 0xf3, 0xcd, 0xf9, 0x08, 0x1c, 0x84, 0x91,
+	0x38, 0xcb, 0x7c, 0x27, 0xa0, 0x71, 0x3f, 0xde, 0xc5, 0x7e, 0x3f, 0xde, 0xed, 0x73, 0x16, 0xf4,
+	0x63, 0x22, 0x58, 0x14, 0xf0, 0x7e, 0x48, 0x12, 0xc2, 0x3c, 0x41, 0x70, 0x3f, 0x65, 0x54, 0x50,
+	0x85, 0xa7, 0x7e, 0xbe, 0xf0, 0xb0, 0x27, 0x3c, 0xa7, 0xc0, 0x91, 0xa9, 0x0f, 0x36, 0xef, 0x57,
+	0x18, 0x43, 0x1a, 0x52, 0x59, 0xe8, 0x67, 0xa7, 0xc5, 0x4e, 0xb2, 0xe4, 0x2b, 0x59, 0xb8, 0x79,
+	0x34, 0xa7, 0x00, 0x2f, 0x0c, 0x19, 0x09, 0x3d, 0x11, 0xd1, 0x24, 0xf5, 0xab, 0x3b, 0xc5, 0x37,
+	0x98, 0x93, 0x2f, 0xa5, 0xe3, 0x28, 0x98, 0xa4, 0xbe, 0x5a, 0x28, 0x96, 0xc3, 0x79, 0x59, 0xa2,
+	0x94, 0x8c, 0xa3, 0x84, 0xa4, 0x7e, 0xb9, 0x94, 0x4c, 0xdd, 0x8f, 0x0d, 0xd8, 0x38, 0x56, 0xd0,
+	0x0b, 0xd5, 0x33, 0x34, 0x84, 0x66, 0x45, 0xf9, 0x28, 0xc2, 0xb6, 0xd1, 0x31, 0x7a, 0xeb, 0x3b,
+	0xb7, 0x9d, 0x9a, 0x3d, 0x67, 0x6f, 0xba, 0x1b, 0x0e, 0xf6, 0x97, 0x2e, 0xbe, 0x6c, 0x2f, 0xb8,
+	0x56, 0xe5, 0x93, 0x21, 0x46, 0x87, 0xb0, 0xc1, 0x05, 0x65, 0x5e, 0x48, 0x46, 0x85, 0x83, 0x88,
+	0x70, 0x7b, 0xb1, 0xd3, 0xe8, 0xad, 0xef, 0xdc, 0x72, 0xb4, 0x37, 0xe7, 0x44, 0x7e, 0x71, 0x5c,
+	0xec, 0x15, 0x4f, 0x8b, 0x57, 0xc0, 0x88, 0x70, 0xf4, 0x14, 0x4c, 0xad, 0xdd, 0x6e, 0x14, 0x72,
+	0xb6, 0x9c, 0xa9, 0x2f, 0x67, 0x2f, 0x4d, 0xc7, 0x11, 0xc1, 0xda, 0x8b, 0x62, 0x29, 0x4b, 0xd0,
+	0x43, 0x58, 0xc7, 0x8c, 0xa6, 0x52, 0xc5, 0xc4, 0x5e, 0xea, 0x18, 0xbd, 0xe6, 0xce, 0xcd, 0xa9,
+	0x86, 0x01, 0xa3, 0xa9, 0x14, 0xe0, 0x02, 0x2e, 0xd7, 0xe8, 0x2e, 0x34, 0x19, 0xe1, 0x24, 0xc1,
+	0x23, 0x92, 0x78, 0xfe, 0x98, 0x60, 0x7b, 0xb9, 0x63, 0xf4, 0x4c, 0xd7, 0x92, 0xe8, 0x73, 0x09,
+	0xa2, 0x01, 0x34, 0x19, 0xcd, 0x44, 0x94, 0x84, 0xfa, 0x82, 0x95, 0x8e, 0x51, 0x37, 0xe9, 0xca,
+	0xf3, 0x9a, 0x49, 0x8b, 0x55, 0x41, 0x74, 0x0f, 0x36, 0x30, 0xe6, 0x6f, 0x88, 0x08, 0xce, 0xca,
+	0xeb, 0x56, 0x8b, 0xeb, 0x5a, 0x1a, 0x57, 0x17, 0x3e, 0x5e, 0x3a, 0xff, 0xb0, 0xbd, 0xd0, 0x3d,
+	0x06, 0xb3, 0x7c, 0xb4, 0x67, 0xb0, 0xa6, 0xcd, 0x72, 0xdb, 0x28, 0x5a, 0xbc, 0xe9, 0xe8, 0xd8,
+	0x3b, 0x57, 0xdf, 0x58, 0x09, 0x98, 0x96, 0x28, 0xc6, 0xf7, 0x06, 0x34, 0x4f, 0x84, 0x17, 0x12,
+	0x5c, 0x12, 0xdf, 0x01, 0x2b, 0xc8, 0x04, 0x7d, 0x4b, 0xd8, 0x28, 0xf1, 0x12, 0xca, 0x8b, 0x30,
+	0x34, 0xdc, 0x6b, 0x0a, 0x3c, 0xca, 0x31, 0xd4, 0x06, 0x10, 0x34, 0xf6, 0xb9, 0xa0, 0x09, 0xc1,
+	0xf6, 0x62, 0x21, 0xba, 0x82, 0xa0, 0x07, 0x60, 0xea, 0x91, 0x54, 0xaf, 0x87, 0xa6, 0xe2, 0xae,
+	0x88, 0x2a, 0xbf, 0xec, 0xbe, 0x82, 0x56, 0x5d, 0x0c, 0x47, 0x4f, 0x60, 0x4d, 0x1f, 0x6b, 0x9b,
+	0xf6, 0x94, 0xa9, 0xfe, 0xb5, 0x36, 0x59, 0x16, 0x28, 0x93, 0x9f, 0x1a, 0xd0, 0x3a, 0xa0, 0xec,
+	0x9d, 0xc7, 0xf0, 0xbf, 0xc8, 0xfc, 0x00, 0x9a, 0xb5, 0xcc, 0x4f, 0xec, 0xc5, 0xab, 0x61, 0x98,
+	0x95, 0x78, 0xab, 0x9a, 0xf8, 0xc9, 0x9f, 0xe6, 0x7d, 0x0b, 0xd6, 0x38, 0xcd, 0x58, 0x40, 0x72,
+	0x2b, 0x79, 0xda, 0x2d, 0xd7, 0x94, 0xc0, 0x10, 0x23, 0x07, 0x6e, 0x24, 0x59, 0x3c, 0x3a, 0x95,
+	0x3d, 0x20, 0x78, 0x24, 0xa2, 0x98, 0xf0, 0x22, 0xda, 0xcb, 0xee, 0xf5, 0x24, 0x8b, 0x0f, 0xf4,
+	0xc9, 0xcb, 0xfc, 0x60, 0xc6, 0x14, 0xac, 0xfc, 0xda, 0x14, 0xac, 0xfe, 0xa5, 0x29, 0x30, 0x67,
+	0x4e, 0x41, 0xf7, 0xbb, 0x01, 0x56, 0xae, 0xf0, 0x3f, 0x7e, 0xc6, 0x9f, 0x7b, 0xd2, 0x98, 0xbf,
+	0x27, 0xfb, 0xc3, 0x8b, 0xcb, 0xb6, 0xf1, 0xf9, 0xb2, 0x6d, 0x7c, 0xbd, 0x6c, 0x1b, 0xe7, 0xdf,
+	0xda, 0x0b, 0xaf, 0x1f, 0xfd, 0xe6, 0x9f, 0xd1, 0x5f, 0x29, 0xf6, 0xbb, 0x3f, 0x06, 0x00, 0x90,
+	0x37, 0x3a, 0x47, 0x5b, 0x07, 0x00, 0x00,
 }
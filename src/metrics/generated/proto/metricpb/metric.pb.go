@@ -39,10 +39,11 @@ var _ = math.Inf
 type MetricType int32
 
 const (
-	MetricType_UNKNOWN MetricType = 0
-	MetricType_COUNTER MetricType = 1
-	MetricType_TIMER   MetricType = 2
-	MetricType_GAUGE   MetricType = 3
+	MetricType_UNKNOWN   MetricType = 0
+	MetricType_COUNTER   MetricType = 1
+	MetricType_TIMER     MetricType = 2
+	MetricType_GAUGE     MetricType = 3
+	MetricType_HISTOGRAM MetricType = 4
 )
 
 var MetricType_name = map[int32]string{
@@ -50,12 +51,14 @@ var MetricType_name = map[int32]string{
 	1: "COUNTER",
 	2: "TIMER",
 	3: "GAUGE",
+	4: "HISTOGRAM",
 }
 var MetricType_value = map[string]int32{
-	"UNKNOWN": 0,
-	"COUNTER": 1,
-	"TIMER":   2,
-	"GAUGE":   3,
+	"UNKNOWN":   0,
+	"COUNTER":   1,
+	"TIMER":     2,
+	"GAUGE":     3,
+	"HISTOGRAM": 4,
 }
 
 func (x MetricType) String() string {
@@ -321,6 +324,62 @@ func (m *Tag) GetValue() []byte {
 	return nil
 }
 
+type Histogram struct {
+	Id              []byte    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UpperBounds     []float64 `protobuf:"fixed64,2,rep,packed,name=upper_bounds,json=upperBounds" json:"upper_bounds,omitempty"`
+	Counts          []int64   `protobuf:"varint,3,rep,packed,name=counts" json:"counts,omitempty"`
+	Sum             float64   `protobuf:"fixed64,4,opt,name=sum,proto3" json:"sum,omitempty"`
+	Annotation      []byte    `protobuf:"bytes,5,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	ClientTimeNanos int64     `protobuf:"varint,6,opt,name=client_time_nanos,json=clientTimeNanos,proto3" json:"client_time_nanos,omitempty"`
+}
+
+func (m *Histogram) Reset()                    { *m = Histogram{} }
+func (m *Histogram) String() string            { return proto.CompactTextString(m) }
+func (*Histogram) ProtoMessage()               {}
+func (*Histogram) Descriptor() ([]byte, []int) { return fileDescriptorMetric, []int{6} }
+
+func (m *Histogram) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *Histogram) GetUpperBounds() []float64 {
+	if m != nil {
+		return m.UpperBounds
+	}
+	return nil
+}
+
+func (m *Histogram) GetCounts() []int64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *Histogram) GetSum() float64 {
+	if m != nil {
+		return m.Sum
+	}
+	return 0
+}
+
+func (m *Histogram) GetAnnotation() []byte {
+	if m != nil {
+		return m.Annotation
+	}
+	return nil
+}
+
+func (m *Histogram) GetClientTimeNanos() int64 {
+	if m != nil {
+		return m.ClientTimeNanos
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Counter)(nil), "metricpb.Counter")
 	proto.RegisterType((*BatchTimer)(nil), "metricpb.BatchTimer")
@@ -328,6 +387,7 @@ func init() {
 	proto.RegisterType((*TimedMetric)(nil), "metricpb.TimedMetric")
 	proto.RegisterType((*ForwardedMetric)(nil), "metricpb.ForwardedMetric")
 	proto.RegisterType((*Tag)(nil), "metricpb.Tag")
+	proto.RegisterType((*Histogram)(nil), "metricpb.Histogram")
 	proto.RegisterEnum("metricpb.MetricType", MetricType_name, MetricType_value)
 }
 func (m *Counter) Marshal() (dAtA []byte, err error) {
@@ -597,6 +657,75 @@ func (m *Tag) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Histogram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMetric(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.UpperBounds) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMetric(dAtA, i, uint64(len(m.UpperBounds)*8))
+		for _, num := range m.UpperBounds {
+			f4 := math.Float64bits(float64(num))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f4))
+			i += 8
+		}
+	}
+	if len(m.Counts) > 0 {
+		dAtA6 := make([]byte, len(m.Counts)*10)
+		var j5 int
+		for _, num1 := range m.Counts {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMetric(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA6[:j5])
+	}
+	if m.Sum != 0 {
+		dAtA[i] = 0x21
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Sum))))
+		i += 8
+	}
+	if len(m.Annotation) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMetric(dAtA, i, uint64(len(m.Annotation)))
+		i += copy(dAtA[i:], m.Annotation)
+	}
+	if m.ClientTimeNanos != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMetric(dAtA, i, uint64(m.ClientTimeNanos))
+	}
+	return i, nil
+}
+
 func encodeVarintMetric(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -732,6 +861,36 @@ func (m *Tag) Size() (n int) {
 	return n
 }
 
+func (m *Histogram) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMetric(uint64(l))
+	}
+	if len(m.UpperBounds) > 0 {
+		n += 1 + sovMetric(uint64(len(m.UpperBounds)*8)) + len(m.UpperBounds)*8
+	}
+	if len(m.Counts) > 0 {
+		l = 0
+		for _, e := range m.Counts {
+			l += sovMetric(uint64(e))
+		}
+		n += 1 + sovMetric(uint64(l)) + l
+	}
+	if m.Sum != 0 {
+		n += 9
+	}
+	l = len(m.Annotation)
+	if l > 0 {
+		n += 1 + l + sovMetric(uint64(l))
+	}
+	if m.ClientTimeNanos != 0 {
+		n += 1 + sovMetric(uint64(m.ClientTimeNanos))
+	}
+	return n
+}
+
 func sovMetric(x uint64) (n int) {
 	for {
 		n++
@@ -1748,6 +1907,256 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Histogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetric
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetric
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetric
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.UpperBounds = append(m.UpperBounds, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetric
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetric
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.UpperBounds = append(m.UpperBounds, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperBounds", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetric
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Counts = append(m.Counts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetric
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetric
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetric
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Sum = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetric
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetric
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotation = append(m.Annotation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Annotation == nil {
+				m.Annotation = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientTimeNanos", wireType)
+			}
+			m.ClientTimeNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetric
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientTimeNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetric(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetric
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMetric(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1858,33 +2267,38 @@ func init() {
 }
 
 var fileDescriptorMetric = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x14, 0xec, 0xda, 0x4e, 0x42, 0x5f, 0x4a, 0x1b, 0x56, 0x15, 0xf2, 0x05, 0x13, 0xe5, 0x64, 0xf5,
-	0x60, 0x4b, 0xe4, 0xc0, 0x85, 0x0b, 0x2d, 0x21, 0xaa, 0x50, 0x5d, 0xc9, 0x72, 0x40, 0xe2, 0x12,
-	0xad, 0xed, 0x95, 0xbb, 0x52, 0xbd, 0x6b, 0xad, 0xd7, 0x41, 0x11, 0x17, 0x3e, 0x81, 0x0f, 0xe0,
-	0x83, 0x38, 0xf2, 0x03, 0x48, 0x28, 0xfc, 0x08, 0xf2, 0xda, 0x26, 0x85, 0x8a, 0x1e, 0x50, 0x73,
-	0x7b, 0x33, 0xbb, 0x6f, 0x67, 0xc6, 0x23, 0xc3, 0xab, 0x8c, 0xa9, 0xab, 0x2a, 0xf6, 0x12, 0x91,
-	0xfb, 0xf9, 0x34, 0x8d, 0xfd, 0x7c, 0xea, 0x97, 0x32, 0xf1, 0x73, 0xaa, 0x24, 0x4b, 0x4a, 0x3f,
-	0xa3, 0x9c, 0x4a, 0xa2, 0x68, 0xea, 0x17, 0x52, 0x28, 0xd1, 0xf2, 0x45, 0xdc, 0x0e, 0x9e, 0x66,
-	0xf1, 0x83, 0x8e, 0x9e, 0x7c, 0x84, 0xc1, 0x99, 0xa8, 0xb8, 0xa2, 0x12, 0x1f, 0x82, 0xc1, 0x52,
-	0x1b, 0x8d, 0x91, 0x7b, 0x10, 0x1a, 0x2c, 0xc5, 0xc7, 0xd0, 0x5b, 0x91, 0xeb, 0x8a, 0xda, 0xc6,
-	0x18, 0xb9, 0x66, 0xd8, 0x00, 0xec, 0x00, 0x10, 0xce, 0x85, 0x22, 0x8a, 0x09, 0x6e, 0x9b, 0xfa,
-	0xf6, 0x0d, 0x06, 0x9f, 0xc0, 0xa3, 0xe4, 0x9a, 0x51, 0xae, 0x96, 0x8a, 0xe5, 0x74, 0xc9, 0x09,
-	0x17, 0xa5, 0x6d, 0xe9, 0x17, 0x8e, 0x9a, 0x83, 0x88, 0xe5, 0x34, 0xa8, 0xe9, 0xc9, 0x27, 0x04,
-	0x70, 0x4a, 0x54, 0x72, 0x55, 0x53, 0xb7, 0x0d, 0x3c, 0x86, 0xbe, 0xd6, 0x2c, 0x6d, 0x63, 0x6c,
-	0xba, 0x28, 0x6c, 0xd1, 0xbd, 0x5a, 0x58, 0x43, 0x6f, 0x4e, 0xaa, 0x8c, 0xde, 0x9d, 0x1e, 0xed,
-	0x22, 0xfd, 0x17, 0x04, 0xc3, 0x1a, 0xa5, 0x17, 0xba, 0x0c, 0xec, 0x82, 0xa5, 0xd6, 0x05, 0xd5,
-	0x1e, 0x0e, 0x9f, 0x1d, 0x7b, 0x5d, 0x47, 0x5e, 0x73, 0x1e, 0xad, 0x0b, 0x1a, 0xea, 0x1b, 0xad,
-	0x57, 0xe3, 0xb7, 0xd7, 0x27, 0x00, 0x37, 0xe4, 0x4c, 0x2d, 0xb7, 0xaf, 0x3a, 0xa1, 0x6d, 0x14,
-	0xeb, 0xdf, 0x51, 0x7a, 0x7f, 0x47, 0x99, 0x7c, 0x47, 0x70, 0xf4, 0x5a, 0xc8, 0x0f, 0x44, 0xa6,
-	0xbb, 0xb7, 0xb8, 0xad, 0xda, 0xba, 0xa3, 0xea, 0x5b, 0x26, 0xf1, 0x53, 0x18, 0x16, 0x92, 0xae,
-	0x96, 0xed, 0x72, 0x5f, 0x2f, 0x43, 0x4d, 0xbd, 0x6d, 0x1e, 0xb0, 0x61, 0xb0, 0xa2, 0xb2, 0xac,
-	0xb7, 0x07, 0x63, 0xe4, 0x3e, 0x0c, 0x3b, 0x38, 0xf1, 0xc1, 0x8c, 0x48, 0x86, 0x31, 0x58, 0x9c,
-	0xe4, 0xb4, 0x6d, 0x5e, 0xcf, 0x7f, 0x76, 0x7f, 0xd0, 0x7e, 0xb0, 0x93, 0x17, 0x00, 0xdb, 0x98,
-	0x78, 0x08, 0x83, 0x45, 0xf0, 0x26, 0xb8, 0x7c, 0x17, 0x8c, 0xf6, 0x6a, 0x70, 0x76, 0xb9, 0x08,
-	0xa2, 0x59, 0x38, 0x42, 0x78, 0x1f, 0x7a, 0xd1, 0xf9, 0xc5, 0x2c, 0x1c, 0x19, 0xf5, 0x38, 0x7f,
-	0xb9, 0x98, 0xcf, 0x46, 0xe6, 0xe9, 0xf9, 0xd7, 0x8d, 0x83, 0xbe, 0x6d, 0x1c, 0xf4, 0x63, 0xe3,
-	0xa0, 0xcf, 0x3f, 0x9d, 0xbd, 0xf7, 0xcf, 0xff, 0xf3, 0x57, 0x8e, 0xfb, 0x1a, 0x4f, 0x7f, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x42, 0xaf, 0x37, 0x39, 0x0c, 0x04, 0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0xed, 0xd8, 0x4e, 0xf2, 0x72, 0x93, 0xb6, 0x7e, 0xa3, 0x0a, 0x79, 0x43, 0x08, 0x59, 0x59,
+	0x5d, 0xc4, 0x12, 0x59, 0xb0, 0x6e, 0x4a, 0x48, 0xa3, 0x2a, 0x89, 0x64, 0x1c, 0x90, 0xd8, 0x44,
+	0x13, 0x7b, 0x94, 0x5a, 0xaa, 0x67, 0xac, 0xf1, 0x38, 0x28, 0x62, 0xc3, 0x27, 0xf0, 0x01, 0x7c,
+	0x06, 0x1f, 0xc1, 0x92, 0x1f, 0x40, 0x42, 0xe1, 0x47, 0xd0, 0x4c, 0x6c, 0x52, 0x48, 0x29, 0x12,
+	0xa2, 0xbb, 0x7b, 0xce, 0xcc, 0x9d, 0x7b, 0x4e, 0xce, 0x8d, 0xe1, 0xd9, 0x32, 0x96, 0x57, 0xf9,
+	0xa2, 0x1b, 0xf2, 0xc4, 0x4b, 0x7a, 0xd1, 0xc2, 0x4b, 0x7a, 0x5e, 0x26, 0x42, 0x2f, 0xa1, 0x52,
+	0xc4, 0x61, 0xe6, 0x2d, 0x29, 0xa3, 0x82, 0x48, 0x1a, 0x79, 0xa9, 0xe0, 0x92, 0x17, 0x7c, 0xba,
+	0x28, 0x8a, 0xae, 0x66, 0xf1, 0x7f, 0x25, 0xdd, 0x79, 0x0b, 0xb5, 0x73, 0x9e, 0x33, 0x49, 0x05,
+	0x3e, 0x02, 0x23, 0x8e, 0x1c, 0xd4, 0x46, 0x6e, 0xd3, 0x37, 0xe2, 0x08, 0x9f, 0x40, 0x65, 0x45,
+	0xae, 0x73, 0xea, 0x18, 0x6d, 0xe4, 0x9a, 0xfe, 0x16, 0xe0, 0x16, 0x00, 0x61, 0x8c, 0x4b, 0x22,
+	0x63, 0xce, 0x1c, 0x53, 0xdf, 0xbe, 0xc1, 0xe0, 0x53, 0xf8, 0x3f, 0xbc, 0x8e, 0x29, 0x93, 0x73,
+	0x19, 0x27, 0x74, 0xce, 0x08, 0xe3, 0x99, 0x63, 0xe9, 0x17, 0x8e, 0xb7, 0x07, 0x41, 0x9c, 0xd0,
+	0x89, 0xa2, 0x3b, 0xef, 0x10, 0x40, 0x9f, 0xc8, 0xf0, 0x4a, 0x51, 0xfb, 0x02, 0x1e, 0x40, 0x55,
+	0xcf, 0xcc, 0x1c, 0xa3, 0x6d, 0xba, 0xc8, 0x2f, 0xd0, 0x3f, 0x95, 0xb0, 0x86, 0xca, 0x90, 0xe4,
+	0x4b, 0x7a, 0xb7, 0x7b, 0x74, 0x1f, 0xee, 0x3f, 0x20, 0x68, 0x28, 0x14, 0x8d, 0x75, 0x18, 0xd8,
+	0x05, 0x4b, 0xae, 0x53, 0xaa, 0x35, 0x1c, 0x3d, 0x39, 0xe9, 0x96, 0x19, 0x75, 0xb7, 0xe7, 0xc1,
+	0x3a, 0xa5, 0xbe, 0xbe, 0x51, 0x68, 0x35, 0x7e, 0x68, 0x7d, 0x08, 0x70, 0x63, 0x9c, 0xa9, 0xc7,
+	0xd5, 0x65, 0x39, 0x68, 0x67, 0xc5, 0xfa, 0xbd, 0x95, 0xca, 0xaf, 0x56, 0x3a, 0x5f, 0x10, 0x1c,
+	0x3f, 0xe7, 0xe2, 0x0d, 0x11, 0xd1, 0xfd, 0x4b, 0xdc, 0x45, 0x6d, 0xdd, 0x11, 0xf5, 0x9e, 0x48,
+	0xfc, 0x08, 0x1a, 0xa9, 0xa0, 0xab, 0x79, 0xd1, 0x5c, 0xd5, 0xcd, 0xa0, 0xa8, 0x97, 0xdb, 0x07,
+	0x1c, 0xa8, 0xad, 0xa8, 0xc8, 0x54, 0x77, 0xad, 0x8d, 0xdc, 0x43, 0xbf, 0x84, 0x1d, 0x0f, 0xcc,
+	0x80, 0x2c, 0x31, 0x06, 0x8b, 0x91, 0x84, 0x16, 0xc9, 0xeb, 0xfa, 0xe7, 0xec, 0x9b, 0xc5, 0x0f,
+	0xd6, 0xf9, 0x88, 0xa0, 0x7e, 0x11, 0x67, 0x92, 0x2f, 0x05, 0x49, 0xf6, 0xf6, 0xe5, 0x31, 0x34,
+	0xf3, 0x34, 0xa5, 0x62, 0xbe, 0xe0, 0x39, 0x8b, 0xca, 0x95, 0x6d, 0x68, 0xae, 0xaf, 0x29, 0x65,
+	0x32, 0x54, 0xff, 0x35, 0xe5, 0xdf, 0x74, 0x4d, 0xbf, 0x40, 0xd8, 0x06, 0x33, 0xcb, 0x93, 0x22,
+	0x1d, 0x55, 0xfe, 0xd1, 0xf6, 0xad, 0x6b, 0x56, 0xbd, 0x75, 0xcd, 0x4e, 0x2f, 0x01, 0x76, 0xe9,
+	0xe0, 0x06, 0xd4, 0x66, 0x93, 0xcb, 0xc9, 0xf4, 0xd5, 0xc4, 0x3e, 0x50, 0xe0, 0x7c, 0x3a, 0x9b,
+	0x04, 0x03, 0xdf, 0x46, 0xb8, 0x0e, 0x95, 0x60, 0x34, 0x1e, 0xf8, 0xb6, 0xa1, 0xca, 0xe1, 0xd9,
+	0x6c, 0x38, 0xb0, 0x4d, 0x7c, 0x08, 0xf5, 0x8b, 0xd1, 0x8b, 0x60, 0x3a, 0xf4, 0xcf, 0xc6, 0xb6,
+	0xd5, 0x1f, 0x7d, 0xda, 0xb4, 0xd0, 0xe7, 0x4d, 0x0b, 0x7d, 0xdd, 0xb4, 0xd0, 0xfb, 0x6f, 0xad,
+	0x83, 0xd7, 0x4f, 0xff, 0xf2, 0x83, 0xb4, 0xa8, 0x6a, 0xdc, 0xfb, 0x3e, 0x00, 0xc6, 0x5f, 0x97,
+	0xc1, 0xd2, 0x04, 0x00, 0x00,
 }
@@ -197,6 +197,7 @@ type RollupTargetV2 struct {
 	Pipeline        *pipelinepb.Pipeline      `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
 	StoragePolicies []*policypb.StoragePolicy `protobuf:"bytes,2,rep,name=storage_policies,json=storagePolicies" json:"storage_policies,omitempty"`
 	ResendEnabled   bool                      `protobuf:"varint,3,opt,name=resend_enabled,json=resendEnabled,proto3" json:"resend_enabled,omitempty"`
+	DdsketchEnabled bool                      `protobuf:"varint,4,opt,name=ddsketch_enabled,json=ddsketchEnabled,proto3" json:"ddsketch_enabled,omitempty"`
 }
 
 func (m *RollupTargetV2) Reset()                    { *m = RollupTargetV2{} }
@@ -225,6 +226,13 @@ func (m *RollupTargetV2) GetResendEnabled() bool {
 	return false
 }
 
+func (m *RollupTargetV2) GetDdsketchEnabled() bool {
+	if m != nil {
+		return m.DdsketchEnabled
+	}
+	return false
+}
+
 type RollupRuleSnapshot struct {
 	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Tombstoned   bool   `protobuf:"varint,2,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
@@ -676,6 +684,16 @@ func (m *RollupTargetV2) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.DdsketchEnabled {
+		dAtA[i] = 0x20
+		i++
+		if m.DdsketchEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1020,6 +1038,9 @@ func (m *RollupTargetV2) Size() (n int) {
 	if m.ResendEnabled {
 		n += 2
 	}
+	if m.DdsketchEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -1873,6 +1894,26 @@ func (m *RollupTargetV2) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ResendEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DdsketchEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DdsketchEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRule(dAtA[iNdEx:])
@@ -2698,54 +2739,55 @@ func init() {
 }
 
 var fileDescriptorRule = []byte{
-	// 775 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcb, 0x6e, 0xf3, 0x44,
-	0x14, 0xc6, 0x49, 0x9a, 0xc4, 0x27, 0x97, 0x86, 0x69, 0x29, 0x56, 0x41, 0x51, 0x08, 0x02, 0x65,
-	0x81, 0x1c, 0x70, 0x55, 0xa9, 0xec, 0x68, 0x55, 0x04, 0x12, 0xa2, 0x54, 0xd3, 0xd2, 0x45, 0x85,
-	0x64, 0x8d, 0xe3, 0xc1, 0xb5, 0xf0, 0x65, 0x34, 0x33, 0xae, 0x94, 0xa7, 0x80, 0x17, 0xe1, 0x3d,
-	0x58, 0xf2, 0x08, 0xa8, 0xbc, 0x03, 0x0b, 0x56, 0xbf, 0x3c, 0x63, 0x27, 0x8e, 0xea, 0xa8, 0x4a,
-	0xa5, 0x5f, 0xff, 0x2a, 0x67, 0xce, 0x9c, 0xf9, 0xce, 0xe5, 0xfb, 0x8e, 0x15, 0xf8, 0x26, 0x08,
-	0xe5, 0x43, 0xe6, 0xd9, 0x8b, 0x34, 0x9e, 0xc7, 0x27, 0xbe, 0x37, 0x8f, 0x4f, 0xe6, 0x82, 0x2f,
-	0xe6, 0x31, 0x95, 0x3c, 0x5c, 0x88, 0x79, 0x40, 0x13, 0xca, 0x89, 0xa4, 0xfe, 0x9c, 0xf1, 0x54,
-	0xa6, 0x73, 0x9e, 0x45, 0x94, 0x79, 0xea, 0xc7, 0x56, 0x1e, 0xd4, 0xd6, 0xae, 0xe3, 0xab, 0x1d,
-	0x91, 0x48, 0x10, 0x70, 0x1a, 0x10, 0x19, 0xa6, 0x09, 0xf3, 0xaa, 0x27, 0x8d, 0x7b, 0xfc, 0xfd,
-	0x8e, 0x78, 0x2c, 0x64, 0x34, 0x0a, 0x93, 0xbc, 0xba, 0xd2, 0x2c, 0x90, 0x2e, 0x77, 0x45, 0x4a,
-	0xa3, 0x70, 0xb1, 0xcc, 0x71, 0x94, 0xf1, 0x4a, 0x14, 0xed, 0x67, 0x5e, 0x61, 0x68, 0x94, 0xe9,
-	0xff, 0x4d, 0x38, 0xf8, 0x91, 0x30, 0x16, 0x26, 0x01, 0xce, 0x22, 0x7a, 0x93, 0x10, 0x26, 0x1e,
-	0x52, 0x89, 0x10, 0xb4, 0x12, 0x12, 0x53, 0xcb, 0x98, 0x18, 0x33, 0x13, 0x2b, 0x1b, 0x8d, 0x01,
-	0x64, 0x1a, 0x7b, 0x42, 0xa6, 0x09, 0xf5, 0xad, 0xc6, 0xc4, 0x98, 0x75, 0x71, 0xc5, 0x83, 0x3e,
-	0x85, 0xc1, 0x22, 0x93, 0xe9, 0x23, 0xe5, 0x6e, 0x42, 0x92, 0x54, 0x58, 0xcd, 0x89, 0x31, 0x6b,
-	0xe2, 0x7e, 0xe1, 0xbc, 0xca, 0x7d, 0xe8, 0x08, 0xda, 0xbf, 0x86, 0x91, 0xa4, 0xdc, 0x6a, 0x29,
-	0xe8, 0xe2, 0x84, 0xbe, 0x80, 0xae, 0x6a, 0x2f, 0xa4, 0xc2, 0xda, 0x9b, 0x34, 0x67, 0x3d, 0x67,
-	0x64, 0x97, 0x8d, 0xdb, 0xd7, 0xca, 0xc0, 0xab, 0x08, 0xf4, 0x15, 0x7c, 0x10, 0x11, 0x21, 0xdd,
-	0x8c, 0xf9, 0x79, 0x8b, 0x2e, 0x91, 0x45, 0xca, 0xb6, 0x4a, 0x89, 0xf2, 0xcb, 0x9f, 0xf5, 0xdd,
-	0xb9, 0xd4, 0x89, 0x3f, 0x87, 0xfd, 0x8d, 0x27, 0xde, 0xd2, 0xea, 0xa8, 0x0a, 0x06, 0x95, 0xe0,
-	0x8b, 0x25, 0xfa, 0x01, 0xde, 0xaf, 0x90, 0xef, 0xca, 0x25, 0xa3, 0xc2, 0xea, 0x4e, 0x9a, 0xb3,
-	0xa1, 0x33, 0xb6, 0x37, 0x44, 0x62, 0x9f, 0xaf, 0x4f, 0xb7, 0x4b, 0x46, 0xf1, 0x88, 0x6c, 0x3a,
-	0x04, 0xba, 0x80, 0x91, 0x90, 0x29, 0x27, 0x01, 0x75, 0x57, 0xdd, 0x99, 0xaa, 0xbb, 0x0f, 0xd7,
-	0xdd, 0xdd, 0xe8, 0x88, 0xa2, 0xc9, 0x7d, 0x51, 0x39, 0xe6, 0xbd, 0x9e, 0x42, 0xcf, 0xe7, 0x29,
-	0xd3, 0x00, 0x4b, 0x0b, 0x26, 0xc6, 0x6c, 0xe8, 0x1c, 0xae, 0x9f, 0x5f, 0xf2, 0x94, 0x15, 0x6f,
-	0xc1, 0x5f, 0xd9, 0xe8, 0x13, 0x68, 0x49, 0x12, 0x08, 0xab, 0xa7, 0xd2, 0x0d, 0xec, 0x92, 0x7f,
-	0xfb, 0x96, 0x04, 0x58, 0x5d, 0x4d, 0x7f, 0x81, 0x5e, 0x85, 0xfb, 0x9c, 0xf3, 0x2c, 0x0b, 0xfd,
-	0x92, 0xf3, 0xdc, 0x46, 0x5f, 0x83, 0x29, 0x0a, 0x4d, 0x08, 0xab, 0xa1, 0xa0, 0x3e, 0xb2, 0xf5,
-	0x86, 0xd9, 0x35, 0xba, 0xc1, 0xeb, 0xe8, 0xa9, 0x0f, 0x7d, 0x9c, 0x46, 0x51, 0xc6, 0x6e, 0x09,
-	0x0f, 0x68, 0xbd, 0xa4, 0x50, 0x51, 0x64, 0x8e, 0x6c, 0xea, 0xaa, 0x36, 0x94, 0xd0, 0x7c, 0x49,
-	0x09, 0xd3, 0x3f, 0x0d, 0x18, 0x56, 0xd3, 0xdc, 0x39, 0xe8, 0x4b, 0xe8, 0x96, 0x1b, 0xa7, 0x92,
-	0xf5, 0xf2, 0x69, 0xad, 0xb6, 0xd1, 0xbe, 0x2e, 0x4c, 0xbc, 0x8a, 0xaa, 0xa5, 0xa9, 0xb1, 0x23,
-	0x4d, 0x9f, 0xc1, 0x90, 0x53, 0x41, 0x13, 0xdf, 0xa5, 0x09, 0xf1, 0x22, 0xea, 0x2b, 0xf9, 0x77,
-	0xf1, 0x40, 0x7b, 0xbf, 0xd5, 0xce, 0xe9, 0xef, 0x4d, 0x40, 0xba, 0xde, 0x77, 0xbb, 0x6f, 0x36,
-	0x74, 0xa4, 0x1a, 0x58, 0xb9, 0x6e, 0x87, 0x25, 0xad, 0xd5, 0x69, 0xe2, 0x32, 0xe8, 0x6d, 0x6e,
-	0xdc, 0x29, 0x40, 0x91, 0xc5, 0x7d, 0x74, 0xd4, 0xaa, 0xf5, 0x9c, 0xa3, 0xba, 0x6a, 0xee, 0x1c,
-	0x6c, 0x16, 0x91, 0x77, 0x4e, 0xde, 0xfe, 0x6f, 0x94, 0x32, 0x37, 0xe5, 0x61, 0x10, 0x26, 0x24,
-	0xb2, 0x4c, 0x35, 0xa1, 0x7e, 0xee, 0xfc, 0xa9, 0xf0, 0xad, 0xb6, 0x00, 0xb6, 0x6f, 0xc1, 0x3d,
-	0xc0, 0x9a, 0x90, 0xda, 0x25, 0x38, 0x7b, 0xbe, 0x04, 0xc7, 0x9b, 0xf5, 0x6d, 0xdb, 0x81, 0xff,
-	0x1a, 0xd0, 0x51, 0x77, 0x5a, 0xff, 0xcf, 0x90, 0x3f, 0x06, 0x33, 0xa7, 0x5a, 0x30, 0xb2, 0xa0,
-	0x8a, 0x61, 0x13, 0xaf, 0x1d, 0x68, 0x06, 0xa3, 0x05, 0xa7, 0x9b, 0xe3, 0xd6, 0x1c, 0x0f, 0x0b,
-	0x7f, 0x39, 0xea, 0xad, 0xec, 0xb4, 0xb6, 0xb2, 0xb3, 0xa9, 0xae, 0xbd, 0x97, 0xd5, 0xd5, 0xae,
-	0x51, 0xd7, 0x19, 0x0c, 0x62, 0xfd, 0x15, 0x70, 0xf3, 0x79, 0x08, 0xab, 0xa3, 0xa6, 0x73, 0x50,
-	0xf3, 0x89, 0xc0, 0xfd, 0x78, 0x7d, 0xc8, 0xbf, 0x6a, 0x7d, 0xae, 0x46, 0x57, 0x3c, 0xd4, 0xb4,
-	0xa3, 0xe7, 0x63, 0xc5, 0x3d, 0xbe, 0xb2, 0x6b, 0x35, 0x65, 0xd6, 0x68, 0xea, 0xe2, 0xbb, 0xbf,
-	0x9e, 0xc6, 0xc6, 0xdf, 0x4f, 0x63, 0xe3, 0x9f, 0xa7, 0xb1, 0xf1, 0xc7, 0xbf, 0xe3, 0xf7, 0xee,
-	0x4f, 0x5f, 0xf5, 0xcf, 0xc2, 0x6b, 0xab, 0xd3, 0xc9, 0x9b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66,
-	0x1c, 0x70, 0x44, 0x99, 0x08, 0x00, 0x00,
+	// 791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x5d, 0x8f, 0xdb, 0x44,
+	0x14, 0xc5, 0x9b, 0x34, 0x89, 0x6f, 0x3e, 0x36, 0x4c, 0x4b, 0xb1, 0x16, 0x14, 0x85, 0x20, 0x50,
+	0x90, 0x90, 0x03, 0x5e, 0xad, 0x54, 0xde, 0xe8, 0xaa, 0x08, 0x24, 0x44, 0xa9, 0xa6, 0xcb, 0x3e,
+	0x54, 0x48, 0xd6, 0xd8, 0x1e, 0xbc, 0x56, 0xfd, 0x31, 0x9a, 0x19, 0x57, 0xca, 0xaf, 0x80, 0x9f,
+	0xc5, 0x23, 0x8f, 0x3c, 0xa2, 0xe5, 0x3f, 0xf0, 0xc0, 0x13, 0xf2, 0xcc, 0xd8, 0x71, 0xb4, 0x8e,
+	0xaa, 0x54, 0x42, 0x7d, 0xca, 0x9d, 0x33, 0x77, 0xee, 0xcc, 0xbd, 0xe7, 0x1c, 0x2b, 0xf0, 0x75,
+	0x9c, 0xc8, 0x9b, 0x32, 0x70, 0xc3, 0x22, 0xdb, 0x64, 0xe7, 0x51, 0xb0, 0xc9, 0xce, 0x37, 0x82,
+	0x87, 0x9b, 0x8c, 0x4a, 0x9e, 0x84, 0x62, 0x13, 0xd3, 0x9c, 0x72, 0x22, 0x69, 0xb4, 0x61, 0xbc,
+	0x90, 0xc5, 0x86, 0x97, 0x29, 0x65, 0x81, 0xfa, 0x71, 0x15, 0x82, 0x06, 0x1a, 0x3a, 0x7b, 0x7a,
+	0x64, 0x25, 0x12, 0xc7, 0x9c, 0xc6, 0x44, 0x26, 0x45, 0xce, 0x82, 0xf6, 0x4a, 0xd7, 0x3d, 0xfb,
+	0xee, 0xc8, 0x7a, 0x2c, 0x61, 0x34, 0x4d, 0xf2, 0xea, 0x75, 0x75, 0x68, 0x2a, 0x3d, 0x39, 0xb6,
+	0x52, 0x91, 0x26, 0xe1, 0x96, 0x05, 0x26, 0x78, 0xc3, 0x2a, 0x1a, 0x67, 0x81, 0x09, 0x74, 0x95,
+	0xd5, 0xbf, 0x3d, 0xb8, 0xff, 0x03, 0x61, 0x2c, 0xc9, 0x63, 0x5c, 0xa6, 0xf4, 0x79, 0x4e, 0x98,
+	0xb8, 0x29, 0x24, 0x42, 0xd0, 0xcf, 0x49, 0x46, 0x1d, 0x6b, 0x69, 0xad, 0x6d, 0xac, 0x62, 0xb4,
+	0x00, 0x90, 0x45, 0x16, 0x08, 0x59, 0xe4, 0x34, 0x72, 0x4e, 0x96, 0xd6, 0x7a, 0x84, 0x5b, 0x08,
+	0xfa, 0x18, 0xa6, 0x61, 0x29, 0x8b, 0x57, 0x94, 0xfb, 0x39, 0xc9, 0x0b, 0xe1, 0xf4, 0x96, 0xd6,
+	0xba, 0x87, 0x27, 0x06, 0x7c, 0x5a, 0x61, 0xe8, 0x21, 0x0c, 0x7e, 0x49, 0x52, 0x49, 0xb9, 0xd3,
+	0x57, 0xa5, 0xcd, 0x0a, 0x7d, 0x0e, 0x23, 0xd5, 0x5e, 0x42, 0x85, 0x73, 0x6f, 0xd9, 0x5b, 0x8f,
+	0xbd, 0xb9, 0x5b, 0x37, 0xee, 0x3e, 0x53, 0x01, 0x6e, 0x32, 0xd0, 0x97, 0xf0, 0x5e, 0x4a, 0x84,
+	0xf4, 0x4b, 0x16, 0x55, 0x2d, 0xfa, 0x44, 0x9a, 0x2b, 0x07, 0xea, 0x4a, 0x54, 0x6d, 0xfe, 0xa4,
+	0xf7, 0x1e, 0x4b, 0x7d, 0xf1, 0xa7, 0x70, 0xba, 0x77, 0x24, 0xd8, 0x3a, 0x43, 0xf5, 0x82, 0x69,
+	0x2b, 0xf9, 0x72, 0x8b, 0xbe, 0x87, 0x77, 0x5b, 0xe4, 0xfb, 0x72, 0xcb, 0xa8, 0x70, 0x46, 0xcb,
+	0xde, 0x7a, 0xe6, 0x2d, 0xdc, 0x3d, 0x91, 0xb8, 0x8f, 0x77, 0xab, 0xab, 0x2d, 0xa3, 0x78, 0x4e,
+	0xf6, 0x01, 0x81, 0x2e, 0x61, 0x2e, 0x64, 0xc1, 0x49, 0x4c, 0xfd, 0xa6, 0x3b, 0x5b, 0x75, 0xf7,
+	0xfe, 0xae, 0xbb, 0xe7, 0x3a, 0xc3, 0x34, 0x79, 0x2a, 0x5a, 0xcb, 0xaa, 0xd7, 0x0b, 0x18, 0x47,
+	0xbc, 0x60, 0xba, 0xc0, 0xd6, 0x81, 0xa5, 0xb5, 0x9e, 0x79, 0x0f, 0x76, 0xc7, 0x9f, 0xf0, 0x82,
+	0x99, 0xb3, 0x10, 0x35, 0x31, 0xfa, 0x08, 0xfa, 0x92, 0xc4, 0xc2, 0x19, 0xab, 0xeb, 0xa6, 0x6e,
+	0xcd, 0xbf, 0x7b, 0x45, 0x62, 0xac, 0xb6, 0x56, 0x3f, 0xc3, 0xb8, 0xc5, 0x7d, 0xc5, 0x79, 0x59,
+	0x26, 0x51, 0xcd, 0x79, 0x15, 0xa3, 0xaf, 0xc0, 0x16, 0x46, 0x13, 0xc2, 0x39, 0x51, 0xa5, 0x3e,
+	0x70, 0xb5, 0xc3, 0xdc, 0x0e, 0xdd, 0xe0, 0x5d, 0xf6, 0x2a, 0x82, 0x09, 0x2e, 0xd2, 0xb4, 0x64,
+	0x57, 0x84, 0xc7, 0xb4, 0x5b, 0x52, 0xc8, 0x3c, 0xb2, 0xaa, 0x6c, 0xeb, 0x57, 0xed, 0x29, 0xa1,
+	0xf7, 0x3a, 0x25, 0xac, 0xfe, 0xb4, 0x60, 0xd6, 0xbe, 0xe6, 0xda, 0x43, 0x5f, 0xc0, 0xa8, 0x76,
+	0x9c, 0xba, 0x6c, 0x5c, 0x4d, 0xab, 0x71, 0xa3, 0xfb, 0xcc, 0x84, 0xb8, 0xc9, 0xea, 0xa4, 0xe9,
+	0xe4, 0x48, 0x9a, 0x3e, 0x81, 0x19, 0xa7, 0x82, 0xe6, 0x91, 0x4f, 0x73, 0x12, 0xa4, 0x34, 0x52,
+	0xf2, 0x1f, 0xe1, 0xa9, 0x46, 0xbf, 0xd1, 0x20, 0xfa, 0x0c, 0xe6, 0x51, 0x24, 0x5e, 0x52, 0x19,
+	0xde, 0x34, 0x89, 0x7d, 0x95, 0x78, 0x5a, 0xe3, 0x26, 0x75, 0xf5, 0x6b, 0x0f, 0x90, 0x6e, 0xed,
+	0xed, 0x5a, 0xd3, 0x85, 0xa1, 0x54, 0xb3, 0xad, 0x9d, 0xf9, 0xa0, 0x56, 0x40, 0x7b, 0xf0, 0xb8,
+	0x4e, 0xfa, 0x3f, 0xcd, 0x79, 0x01, 0x60, 0x6e, 0xf1, 0x5f, 0x79, 0xca, 0x95, 0x63, 0xef, 0x61,
+	0xd7, 0x6b, 0xae, 0x3d, 0x6c, 0x9b, 0xcc, 0x6b, 0xaf, 0x6a, 0xff, 0x25, 0xa5, 0xcc, 0x2f, 0x78,
+	0x12, 0x27, 0x39, 0x49, 0x1d, 0x5b, 0x4d, 0x68, 0x52, 0x81, 0x3f, 0x1a, 0xac, 0x31, 0x0c, 0x1c,
+	0x36, 0xcc, 0x0b, 0x80, 0x1d, 0x21, 0x9d, 0x7e, 0x79, 0x74, 0xd7, 0x2f, 0x67, 0xfb, 0xef, 0x3b,
+	0x64, 0x97, 0x7f, 0x4e, 0x60, 0xa8, 0xf6, 0xb4, 0x55, 0xee, 0x54, 0xfe, 0x10, 0xec, 0x8a, 0x6a,
+	0xc1, 0x48, 0x48, 0x15, 0xc3, 0x36, 0xde, 0x01, 0x68, 0x0d, 0xf3, 0x90, 0xd3, 0xfd, 0x71, 0x6b,
+	0x8e, 0x67, 0x06, 0xaf, 0x47, 0x7d, 0x90, 0x9d, 0xfe, 0x41, 0x76, 0xf6, 0xd5, 0x75, 0xef, 0xf5,
+	0xea, 0x1a, 0x74, 0xa8, 0xeb, 0x11, 0x4c, 0x33, 0xfd, 0xc1, 0xf0, 0xab, 0x79, 0x08, 0x67, 0xa8,
+	0xa6, 0x73, 0xbf, 0xe3, 0x6b, 0x82, 0x27, 0xd9, 0x6e, 0x51, 0x7d, 0x00, 0x27, 0x5c, 0x8d, 0xce,
+	0x1c, 0xd4, 0xb4, 0xa3, 0xbb, 0x63, 0xc5, 0x63, 0xde, 0xc4, 0x9d, 0x9a, 0xb2, 0x3b, 0x34, 0x75,
+	0xf9, 0xed, 0xef, 0xb7, 0x0b, 0xeb, 0x8f, 0xdb, 0x85, 0xf5, 0xd7, 0xed, 0xc2, 0xfa, 0xed, 0xef,
+	0xc5, 0x3b, 0x2f, 0x2e, 0xde, 0xe8, 0x4f, 0x48, 0x30, 0x50, 0xab, 0xf3, 0xff, 0x06, 0x00, 0x7c,
+	0x0c, 0x5b, 0xf8, 0xc4, 0x08, 0x00, 0x00,
 }
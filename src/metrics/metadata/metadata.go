@@ -92,13 +92,15 @@ type PipelineMetadata struct {
 	ResendEnabled bool `json:"resendEnabled,omitempty"`
 	// RoutingPolicy is the routing policy to apply to the metric.
 	RoutingPolicy policy.RoutingPolicy `json:"routingPolicy,omitempty"`
+	// DDSketchEnabled is true if timer quantiles of the Pipeline are computed with a mergeable DDSketch.
+	DDSketchEnabled bool `json:"ddsketchEnabled,omitempty"`
 }
 
 func (m PipelineMetadata) String() string {
 	var b bytes.Buffer
 	b.WriteString(fmt.Sprintf(
-		"ResendEnabled: %v, StoragePolicies: %v, Pipeline: %v, RoutingPolicy: %v",
-		m.ResendEnabled, m.StoragePolicies, m.Pipeline, m.RoutingPolicy))
+		"ResendEnabled: %v, DDSketchEnabled: %v, StoragePolicies: %v, Pipeline: %v, RoutingPolicy: %v",
+		m.ResendEnabled, m.DDSketchEnabled, m.StoragePolicies, m.Pipeline, m.RoutingPolicy))
 	return b.String()
 }
 
@@ -108,7 +110,8 @@ func (m PipelineMetadata) Equal(other PipelineMetadata) bool {
 		m.StoragePolicies.Equal(other.StoragePolicies) &&
 		m.Pipeline.Equal(other.Pipeline) &&
 		m.DropPolicy == other.DropPolicy &&
-		m.ResendEnabled == other.ResendEnabled
+		m.ResendEnabled == other.ResendEnabled &&
+		m.DDSketchEnabled == other.DDSketchEnabled
 }
 
 // IsDefault returns whether this is the default standard pipeline metadata.
@@ -117,7 +120,8 @@ func (m PipelineMetadata) IsDefault() bool {
 		len(m.StoragePolicies) == 0 &&
 		m.Pipeline.IsEmpty() &&
 		m.DropPolicy == policy.DefaultDropPolicy &&
-		!m.ResendEnabled
+		!m.ResendEnabled &&
+		!m.DDSketchEnabled
 }
 
 // IsMappingRule returns whether this is a mapping rule.
@@ -153,6 +157,7 @@ func (m PipelineMetadata) Clone() PipelineMetadata {
 		Pipeline:        m.Pipeline.Clone(),
 		ResendEnabled:   m.ResendEnabled,
 		RoutingPolicy:   m.RoutingPolicy.Clone(),
+		DDSketchEnabled: m.DDSketchEnabled,
 	}
 }
 
@@ -178,6 +183,7 @@ func (m PipelineMetadata) ToProto(pb *metricpb.PipelineMetadata) error {
 	pb.RoutingPolicy = policypb.RoutingPolicy{
 		TrafficTypes: m.RoutingPolicy.TrafficTypes,
 	}
+	pb.DdsketchEnabled = m.DDSketchEnabled
 	return nil
 }
 
@@ -203,6 +209,7 @@ func (m *PipelineMetadata) FromProto(pb metricpb.PipelineMetadata) error {
 	m.RoutingPolicy = policy.RoutingPolicy{
 		TrafficTypes: pb.RoutingPolicy.TrafficTypes,
 	}
+	m.DDSketchEnabled = pb.DdsketchEnabled
 	return nil
 }
 
@@ -430,6 +437,8 @@ type ForwardMetadata struct {
 	ResendEnabled bool
 	// RoutingPolicy is the routing policy to apply to the metric.
 	RoutingPolicy policy.RoutingPolicy
+	// DDSketchEnabled is true if timer quantiles are forwarded and merged as a DDSketch.
+	DDSketchEnabled bool
 }
 
 // ToProto converts the forward metadata to a protobuf message in place.
@@ -447,6 +456,7 @@ func (m ForwardMetadata) ToProto(pb *metricpb.ForwardMetadata) error {
 	pb.RoutingPolicy = policypb.RoutingPolicy{
 		TrafficTypes: m.RoutingPolicy.TrafficTypes,
 	}
+	pb.DdsketchEnabled = m.DDSketchEnabled
 	return nil
 }
 
@@ -465,6 +475,7 @@ func (m *ForwardMetadata) FromProto(pb metricpb.ForwardMetadata) error {
 	m.RoutingPolicy = policy.RoutingPolicy{
 		TrafficTypes: pb.RoutingPolicy.TrafficTypes,
 	}
+	m.DDSketchEnabled = pb.DdsketchEnabled
 	return nil
 }
 
@@ -622,6 +633,7 @@ func (sms *StagedMetadatas) FromProto(pb metricpb.StagedMetadatas) error {
 			pipeline.DropPolicy = policy.DropPolicy(pipelinePb.DropPolicy)
 			pipeline.ResendEnabled = pipelinePb.ResendEnabled
 			pipeline.RoutingPolicy = policy.RoutingPolicy{TrafficTypes: pipelinePb.RoutingPolicy.TrafficTypes}
+			pipeline.DDSketchEnabled = pipelinePb.DdsketchEnabled
 
 			if len(pipeline.Tags) > 0 {
 				pipeline.Tags = pipeline.Tags[:0]
@@ -77,6 +77,7 @@ var (
 		}),
 		SourceID:          897,
 		NumForwardedTimes: 2,
+		DDSketchEnabled:   true,
 	}
 	testSmallPipelineMetadata = PipelineMetadata{
 		AggregationID: aggregation.DefaultID,
@@ -113,6 +114,7 @@ var (
 				},
 			},
 		}),
+		DDSketchEnabled: true,
 	}
 	testBadForwardMetadata = ForwardMetadata{
 		StoragePolicy: policy.NewStoragePolicy(10*time.Second, xtime.Unit(101), 6*time.Hour),
@@ -291,6 +293,7 @@ var (
 		},
 		SourceId:          897,
 		NumForwardedTimes: 2,
+		DdsketchEnabled:   true,
 	}
 	testBadForwardMetadataProto    = metricpb.ForwardMetadata{}
 	testSmallPipelineMetadataProto = metricpb.PipelineMetadata{
@@ -356,6 +359,7 @@ var (
 				},
 			},
 		},
+		DdsketchEnabled: true,
 	}
 	testBadPipelineMetadataProto = metricpb.PipelineMetadata{
 		StoragePolicies: []policypb.StoragePolicy{
@@ -37,6 +37,8 @@ var (
 	errNilCounterWithMetadatasProto    = errors.New("nil counter with metadatas proto message")
 	errNilBatchTimerWithMetadatasProto = errors.New("nil batch timer with metadatas proto message")
 	errNilGaugeWithMetadatasProto      = errors.New("nil gauge with metadatas proto message")
+	errNilHistogramWithMetadatasProto  = errors.New("nil histogram with metadatas proto message")
+	errHistogramBucketsMismatch        = errors.New("histogram upper bounds and counts have different lengths")
 )
 
 // Counter is a counter containing the counter ID and the counter value.
@@ -143,6 +145,57 @@ func (g *Gauge) FromProto(pb metricpb.Gauge) {
 	g.ClientTimeNanos = xtime.UnixNano(pb.ClientTimeNanos)
 }
 
+// Histogram is a histogram containing the histogram ID, the upper bounds of its
+// buckets and the number of values observed in each bucket. The counts are per
+// bucket rather than cumulative, i.e. Counts[i] is the number of values greater
+// than UpperBounds[i-1] and less than or equal to UpperBounds[i], so histograms
+// with different bucket layouts can be merged bucket-wise.
+type Histogram struct {
+	ID              id.RawID
+	UpperBounds     []float64
+	Counts          []int64
+	Sum             float64
+	Annotation      []byte
+	ClientTimeNanos xtime.UnixNano
+}
+
+// ToUnion converts the histogram to a metric union.
+func (h Histogram) ToUnion() MetricUnion {
+	return MetricUnion{
+		Type:                 metric.HistogramType,
+		ID:                   h.ID,
+		HistogramUpperBounds: h.UpperBounds,
+		HistogramCounts:      h.Counts,
+		HistogramSum:         h.Sum,
+		Annotation:           h.Annotation,
+		ClientTimeNanos:      h.ClientTimeNanos,
+	}
+}
+
+// ToProto converts the histogram to a protobuf message in place.
+func (h Histogram) ToProto(pb *metricpb.Histogram) {
+	pb.Id = h.ID
+	pb.UpperBounds = h.UpperBounds
+	pb.Counts = h.Counts
+	pb.Sum = h.Sum
+	pb.Annotation = h.Annotation
+	pb.ClientTimeNanos = int64(h.ClientTimeNanos)
+}
+
+// FromProto converts the protobuf message to a histogram in place.
+func (h *Histogram) FromProto(pb metricpb.Histogram) error {
+	if len(pb.UpperBounds) != len(pb.Counts) {
+		return errHistogramBucketsMismatch
+	}
+	h.ID = pb.Id
+	h.UpperBounds = pb.UpperBounds
+	h.Counts = pb.Counts
+	h.Sum = pb.Sum
+	h.Annotation = pb.Annotation
+	h.ClientTimeNanos = xtime.UnixNano(pb.ClientTimeNanos)
+	return nil
+}
+
 // CounterWithPoliciesList is a counter with applicable policies list.
 type CounterWithPoliciesList struct {
 	policy.PoliciesList
@@ -242,20 +295,49 @@ func (gm *GaugeWithMetadatas) FromProto(pb *metricpb.GaugeWithMetadatas) error {
 	return nil
 }
 
+// HistogramWithMetadatas is a histogram with applicable metadatas.
+type HistogramWithMetadatas struct {
+	metadata.StagedMetadatas
+	Histogram
+}
+
+// ToProto converts the histogram with metadatas to a protobuf message in place.
+func (hm HistogramWithMetadatas) ToProto(pb *metricpb.HistogramWithMetadatas) error {
+	if err := hm.StagedMetadatas.ToProto(&pb.Metadatas); err != nil {
+		return err
+	}
+	hm.Histogram.ToProto(&pb.Histogram)
+	return nil
+}
+
+// FromProto converts the protobuf message to a histogram with metadatas in place.
+func (hm *HistogramWithMetadatas) FromProto(pb *metricpb.HistogramWithMetadatas) error {
+	if pb == nil {
+		return errNilHistogramWithMetadatasProto
+	}
+	if err := hm.StagedMetadatas.FromProto(pb.Metadatas); err != nil {
+		return err
+	}
+	return hm.Histogram.FromProto(pb.Histogram)
+}
+
 // MetricUnion is a union of different types of metrics, only one of which is valid
 // at any given time. The actual type of the metric depends on the type field,
 // which determines which value field is valid. Note that if the timer values are
 // allocated from a pool, the TimerValPool should be set to the originating pool,
 // and the caller is responsible for returning the timer values to the pool.
 type MetricUnion struct {
-	TimerValPool    pool.FloatsPool
-	Annotation      []byte
-	ID              id.RawID
-	BatchTimerVal   []float64
-	CounterVal      int64
-	GaugeVal        float64
-	Type            metric.Type
-	ClientTimeNanos xtime.UnixNano
+	TimerValPool         pool.FloatsPool
+	Annotation           []byte
+	ID                   id.RawID
+	BatchTimerVal        []float64
+	HistogramUpperBounds []float64
+	HistogramCounts      []int64
+	CounterVal           int64
+	GaugeVal             float64
+	HistogramSum         float64
+	Type                 metric.Type
+	ClientTimeNanos      xtime.UnixNano
 }
 
 var emptyMetricUnion MetricUnion
@@ -269,6 +351,9 @@ func (m *MetricUnion) String() string {
 		return fmt.Sprintf("{type:%s,id:%s,value:%v}", m.Type, m.ID.String(), m.BatchTimerVal)
 	case metric.GaugeType:
 		return fmt.Sprintf("{type:%s,id:%s,value:%f}", m.Type, m.ID.String(), m.GaugeVal)
+	case metric.HistogramType:
+		return fmt.Sprintf("{type:%s,id:%s,upperBounds:%v,counts:%v,sum:%f}",
+			m.Type, m.ID.String(), m.HistogramUpperBounds, m.HistogramCounts, m.HistogramSum)
 	default:
 		return fmt.Sprintf(
 			"{type:%d,id:%s,counterVal:%d,batchTimerVal:%v,gaugeVal:%f}",
@@ -294,3 +379,15 @@ func (m *MetricUnion) BatchTimer() BatchTimer {
 func (m *MetricUnion) Gauge() Gauge {
 	return Gauge{ID: m.ID, Value: m.GaugeVal, Annotation: m.Annotation, ClientTimeNanos: m.ClientTimeNanos}
 }
+
+// Histogram returns the histogram metric.
+func (m *MetricUnion) Histogram() Histogram {
+	return Histogram{
+		ID:              m.ID,
+		UpperBounds:     m.HistogramUpperBounds,
+		Counts:          m.HistogramCounts,
+		Sum:             m.HistogramSum,
+		Annotation:      m.Annotation,
+		ClientTimeNanos: m.ClientTimeNanos,
+	}
+}
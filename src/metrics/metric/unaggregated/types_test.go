@@ -21,6 +21,7 @@
 package unaggregated
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -69,6 +70,19 @@ var (
 		ID:       []byte("testGauge"),
 		GaugeVal: 45.28,
 	}
+	testHistogram = Histogram{
+		ID:          []byte("testHistogram"),
+		UpperBounds: []float64{0.1, 1, 10, math.Inf(1)},
+		Counts:      []int64{3, 12, 4, 1},
+		Sum:         58.7,
+	}
+	testHistogramUnion = MetricUnion{
+		Type:                 metric.HistogramType,
+		ID:                   []byte("testHistogram"),
+		HistogramUpperBounds: []float64{0.1, 1, 10, math.Inf(1)},
+		HistogramCounts:      []int64{3, 12, 4, 1},
+		HistogramSum:         58.7,
+	}
 	testMetadatas = metadata.StagedMetadatas{
 		{
 			CutoverNanos: 1234,
@@ -163,6 +177,10 @@ var (
 		Gauge:           testGauge,
 		StagedMetadatas: testMetadatas,
 	}
+	testHistogramWithMetadatas = HistogramWithMetadatas{
+		Histogram:       testHistogram,
+		StagedMetadatas: testMetadatas,
+	}
 	testCounterProto = metricpb.Counter{
 		Id:    []byte("testCounter"),
 		Value: 1234,
@@ -175,6 +193,12 @@ var (
 		Id:    []byte("testGauge"),
 		Value: 45.28,
 	}
+	testHistogramProto = metricpb.Histogram{
+		Id:          []byte("testHistogram"),
+		UpperBounds: []float64{0.1, 1, 10, math.Inf(1)},
+		Counts:      []int64{3, 12, 4, 1},
+		Sum:         58.7,
+	}
 	testMetadatasProto = metricpb.StagedMetadatas{
 		Metadatas: []metricpb.StagedMetadata{
 			{
@@ -300,6 +324,10 @@ var (
 		Gauge:     testGaugeProto,
 		Metadatas: testMetadatasProto,
 	}
+	testHistogramWithMetadatasProto = metricpb.HistogramWithMetadatas{
+		Histogram: testHistogramProto,
+		Metadatas: testMetadatasProto,
+	}
 )
 
 func TestCounterToUnion(t *testing.T) {
@@ -380,6 +408,42 @@ func TestGaugeRoundTrip(t *testing.T) {
 	require.Equal(t, testGauge, c)
 }
 
+func TestHistogramToUnion(t *testing.T) {
+	require.Equal(t, testHistogramUnion, testHistogram.ToUnion())
+}
+
+func TestHistogramToProto(t *testing.T) {
+	var pb metricpb.Histogram
+	testHistogram.ToProto(&pb)
+	require.Equal(t, testHistogramProto, pb)
+}
+
+func TestHistogramFromProto(t *testing.T) {
+	var h Histogram
+	require.NoError(t, h.FromProto(testHistogramProto))
+	require.Equal(t, testHistogram, h)
+}
+
+func TestHistogramFromProtoBucketsMismatch(t *testing.T) {
+	var h Histogram
+	badHistogramProto := metricpb.Histogram{
+		Id:          []byte("testHistogram"),
+		UpperBounds: []float64{0.1, 1},
+		Counts:      []int64{3},
+	}
+	require.Equal(t, errHistogramBucketsMismatch, h.FromProto(badHistogramProto))
+}
+
+func TestHistogramRoundTrip(t *testing.T) {
+	var (
+		pb metricpb.Histogram
+		h  Histogram
+	)
+	testHistogram.ToProto(&pb)
+	require.NoError(t, h.FromProto(pb))
+	require.Equal(t, testHistogram, h)
+}
+
 func TestCounterWithMetadatasToProto(t *testing.T) {
 	var pb metricpb.CounterWithMetadatas
 	require.NoError(t, testCounterWithMetadatas.ToProto(&pb))
@@ -514,3 +578,30 @@ func TestGaugeWithMetadatasRoundTrip(t *testing.T) {
 	require.NoError(t, g.FromProto(&pb))
 	require.Equal(t, testGaugeWithMetadatas, g)
 }
+
+func TestHistogramWithMetadatasToProto(t *testing.T) {
+	var pb metricpb.HistogramWithMetadatas
+	require.NoError(t, testHistogramWithMetadatas.ToProto(&pb))
+	require.Equal(t, testHistogramWithMetadatasProto, pb)
+}
+
+func TestHistogramWithMetadatasFromProto(t *testing.T) {
+	var h HistogramWithMetadatas
+	require.NoError(t, h.FromProto(&testHistogramWithMetadatasProto))
+	require.Equal(t, testHistogramWithMetadatas, h)
+}
+
+func TestHistogramWithMetadatasFromProtoNilProto(t *testing.T) {
+	var h HistogramWithMetadatas
+	require.Equal(t, errNilHistogramWithMetadatasProto, h.FromProto(nil))
+}
+
+func TestHistogramWithMetadatasRoundTrip(t *testing.T) {
+	var (
+		pb metricpb.HistogramWithMetadatas
+		h  HistogramWithMetadatas
+	)
+	require.NoError(t, testHistogramWithMetadatas.ToProto(&pb))
+	require.NoError(t, h.FromProto(&pb))
+	require.Equal(t, testHistogramWithMetadatas, h)
+}
@@ -488,6 +488,7 @@ func (as *activeRuleSet) toRollupResults(
 			StoragePolicies: target.StoragePolicies,
 			Pipeline:        applied,
 			ResendEnabled:   target.ResendEnabled,
+			DDSketchEnabled: target.DDSketchEnabled,
 		}
 		if rollupID == nil {
 			// The applied pipeline applies to the incoming ID.
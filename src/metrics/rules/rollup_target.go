@@ -43,6 +43,7 @@ type rollupTarget struct {
 	Pipeline        pipeline.Pipeline
 	StoragePolicies policy.StoragePolicies
 	ResendEnabled   bool
+	DDSketchEnabled bool
 }
 
 // newRollupTargetFromV1Proto creates a new rollup target from v1 proto
@@ -90,6 +91,7 @@ func newRollupTargetFromV2Proto(pb *rulepb.RollupTargetV2) (rollupTarget, error)
 		Pipeline:        pipeline,
 		StoragePolicies: storagePolicies,
 		ResendEnabled:   pb.ResendEnabled,
+		DDSketchEnabled: pb.DdsketchEnabled,
 	}, nil
 }
 
@@ -98,6 +100,7 @@ func newRollupTargetFromView(rtv view.RollupTarget) rollupTarget {
 		Pipeline:        rtv.Pipeline,
 		StoragePolicies: rtv.StoragePolicies,
 		ResendEnabled:   rtv.ResendEnabled,
+		DDSketchEnabled: rtv.DDSketchEnabled,
 	}
 }
 
@@ -106,6 +109,7 @@ func (t rollupTarget) rollupTargetView() view.RollupTarget {
 		Pipeline:        t.Pipeline,
 		StoragePolicies: t.StoragePolicies,
 		ResendEnabled:   t.ResendEnabled,
+		DDSketchEnabled: t.DDSketchEnabled,
 	}
 }
 
@@ -115,6 +119,7 @@ func (t *rollupTarget) clone() rollupTarget {
 		Pipeline:        t.Pipeline.Clone(),
 		StoragePolicies: t.StoragePolicies.Clone(),
 		ResendEnabled:   t.ResendEnabled,
+		DDSketchEnabled: t.DDSketchEnabled,
 	}
 }
 
@@ -132,6 +137,7 @@ func (t *rollupTarget) proto() (*rulepb.RollupTargetV2, error) {
 		Pipeline:        pipeline,
 		StoragePolicies: storagePolicies,
 		ResendEnabled:   t.ResendEnabled,
+		DdsketchEnabled: t.DDSketchEnabled,
 	}, nil
 }
 
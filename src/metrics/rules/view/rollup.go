@@ -31,6 +31,7 @@ type RollupTarget struct {
 	Pipeline        pipeline.Pipeline      `json:"pipeline" validate:"required"`
 	StoragePolicies policy.StoragePolicies `json:"storagePolicies" validate:"required"`
 	ResendEnabled   bool                   `json:"resendEnabled"`
+	DDSketchEnabled bool                   `json:"ddsketchEnabled"`
 }
 
 // Equal determines whether two rollup targets are equal.
@@ -42,7 +43,7 @@ func (t *RollupTarget) Equal(other *RollupTarget) bool {
 		return false
 	}
 	return t.Pipeline.Equal(other.Pipeline) && t.StoragePolicies.Equal(other.StoragePolicies) &&
-		t.ResendEnabled == other.ResendEnabled
+		t.ResendEnabled == other.ResendEnabled && t.DDSketchEnabled == other.DDSketchEnabled
 }
 
 // RollupRule is rollup rule model.
@@ -58,6 +58,15 @@ type bufferMetrics struct {
 	bufferScanBatch   tally.Timer
 	bytesAdded        tally.Counter
 	bytesRemoved      tally.Counter
+	messageSpilled    tally.Counter
+	byteSpilled       tally.Counter
+	spillFull         tally.Counter
+	spillErrors       tally.Counter
+	spillReadErrors   tally.Counter
+	spillByteBuffered tally.Gauge
+	messageReplayed   tally.Counter
+	replayErrors      tally.Counter
+	replayLag         tally.Timer
 }
 
 type counterPerNumRefBuckets struct {
@@ -121,6 +130,15 @@ func newBufferMetrics(
 		bufferScanBatch:   instrument.NewTimer(scope, "buffer-scan-batch", opts),
 		bytesAdded:        scope.Counter("buffer-bytes-added"),
 		bytesRemoved:      scope.Counter("buffer-bytes-removed"),
+		messageSpilled:    scope.Counter("buffer-message-spilled"),
+		byteSpilled:       scope.Counter("buffer-byte-spilled"),
+		spillFull:         scope.Counter("spill-full"),
+		spillErrors:       scope.Counter("spill-errors"),
+		spillReadErrors:   scope.Counter("spill-read-errors"),
+		spillByteBuffered: scope.Gauge("spill-byte-buffered"),
+		messageReplayed:   scope.Counter("spill-message-replayed"),
+		replayErrors:      scope.Counter("spill-replay-errors"),
+		replayLag:         instrument.NewTimer(scope, "spill-replay-lag", opts),
 	}
 }
 
@@ -135,6 +153,8 @@ type buffer struct {
 	maxSpilloverSize uint64
 	maxMessageSize   int
 	onFinalizeFn     producer.OnFinalizeFn
+	replayFn         producer.ReplayFn
+	spill            *spillLog
 	retrier          retry.Retrier
 	m                bufferMetrics
 
@@ -173,6 +193,18 @@ func NewBuffer(opts Options) (producer.Buffer, error) {
 		doneCh:       make(chan struct{}),
 	}
 	b.onFinalizeFn = b.subSize
+	if opts.OnFullStrategy() == SpillToDisk {
+		spill, err := newSpillLog(
+			opts.SpillDir(),
+			opts.MaxSpillSize(),
+			opts.SpillSegmentSize(),
+			opts.MaxMessageSize(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		b.spill = spill
+	}
 	return b, nil
 }
 
@@ -189,6 +221,11 @@ func (b *buffer) Add(m producer.Message) (*producer.RefCountedMessage, error) {
 		return nil, errBufferClosed
 	}
 	messageSize := uint64(s)
+	if b.spill != nil && b.shouldSpill(messageSize) {
+		err := b.spillMessage(m)
+		b.RUnlock()
+		return nil, err
+	}
 	newBufferSize := b.size.Add(messageSize)
 	if newBufferSize > b.maxBufferSize {
 		if err := b.produceOnFull(newBufferSize, messageSize); err != nil {
@@ -229,6 +266,36 @@ func (b *buffer) produceOnFull(newBufferSize uint64, messageSize uint64) error {
 	return nil
 }
 
+// shouldSpill returns true if the message should be spilled to disk, which
+// is the case when the buffer is full or when there are spilled messages
+// that have not been replayed yet so the messages are replayed in order.
+func (b *buffer) shouldSpill(messageSize uint64) bool {
+	return b.size.Load()+messageSize > b.maxBufferSize || !b.spill.Empty()
+}
+
+func (b *buffer) spillMessage(m producer.Message) error {
+	if err := b.spill.Write(m, time.Now()); err != nil {
+		if err == ErrBufferFull {
+			b.m.spillFull.Inc(1)
+		} else {
+			b.m.spillErrors.Inc(1)
+		}
+		return err
+	}
+	b.m.messageSpilled.Inc(1)
+	b.m.byteSpilled.Inc(int64(m.Size()))
+	// NB: the message bytes have been copied to the spill log, the message
+	// will be replayed from there so it can be finalized right away.
+	m.Finalize(producer.Spilled)
+	return nil
+}
+
+func (b *buffer) SetReplayFn(fn producer.ReplayFn) {
+	b.Lock()
+	b.replayFn = fn
+	b.Unlock()
+}
+
 func (b *buffer) Init() {
 	b.wg.Add(1)
 	go func() {
@@ -236,6 +303,18 @@ func (b *buffer) Init() {
 		b.wg.Done()
 	}()
 
+	if b.spill != nil {
+		b.wg.Add(2)
+		go func() {
+			b.flushSpillUntilClose()
+			b.wg.Done()
+		}()
+		go func() {
+			b.replayUntilClose()
+			b.wg.Done()
+		}()
+	}
+
 	if b.opts.OnFullStrategy() != DropOldest {
 		return
 	}
@@ -304,6 +383,9 @@ func (b *buffer) cleanup() error {
 	}
 	b.m.messageBuffered.Update(float64(b.bufferLen()))
 	b.m.byteBuffered.Update(float64(b.size.Load()))
+	if b.spill != nil {
+		b.m.spillByteBuffered.Update(float64(b.spill.Size()))
+	}
 	if totalRemoved == 0 {
 		b.m.cleanupNoProgress.Inc(1)
 		return errCleanupNoProgress
@@ -409,6 +491,84 @@ func (b *buffer) dropOldestBatchUntilTargetWithListLock(
 	return false
 }
 
+func (b *buffer) flushSpillUntilClose() {
+	ticker := time.NewTicker(b.opts.SpillFlushInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := b.spill.Flush(); err != nil {
+				b.m.spillErrors.Inc(1)
+			}
+		case <-b.doneCh:
+			return
+		}
+	}
+}
+
+func (b *buffer) replayUntilClose() {
+	ticker := time.NewTicker(b.opts.SpillReplayInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.replay()
+		case <-b.doneCh:
+			return
+		}
+	}
+}
+
+// replay replays the spilled messages in order for as long as there is room
+// for them in the buffer.
+func (b *buffer) replay() {
+	for b.replayOldest() {
+	}
+	b.m.spillByteBuffered.Update(float64(b.spill.Size()))
+}
+
+// replayOldest replays the oldest spilled message, returning false if there
+// are no spilled messages or no room for the oldest one in the buffer.
+func (b *buffer) replayOldest() bool {
+	b.RLock()
+	if b.isClosed || b.replayFn == nil {
+		b.RUnlock()
+		return false
+	}
+	size := b.size.Load()
+	if size >= b.maxBufferSize {
+		b.RUnlock()
+		return false
+	}
+	record, ok, err := b.spill.Read(int(b.maxBufferSize - size))
+	if err != nil {
+		b.m.spillReadErrors.Inc(1)
+	}
+	if !ok {
+		b.RUnlock()
+		// NB: a segment that failed to be read has been removed, move on
+		// to the next one.
+		return err != nil
+	}
+	b.size.Add(uint64(len(record.data)))
+	rm := producer.NewRefCountedMessage(newSpilledMessage(record), b.onFinalizeFn)
+	b.listLock.Lock()
+	b.bufferList.PushBack(rm)
+	b.listLock.Unlock()
+	replayFn := b.replayFn
+	b.RUnlock()
+
+	b.m.messageReplayed.Inc(1)
+	b.m.replayLag.Record(time.Since(record.writtenAt))
+	if err := replayFn(rm); err != nil {
+		b.m.replayErrors.Inc(1)
+		rm.Drop()
+	}
+	return true
+}
+
 func (b *buffer) Close(ct producer.CloseType) {
 	// Stop taking writes right away.
 	b.Lock()
@@ -425,6 +585,13 @@ func (b *buffer) Close(ct producer.CloseType) {
 	close(b.doneCh)
 	close(b.dropOldestCh)
 	b.wg.Wait()
+	if b.spill != nil {
+		// NB: the spilled messages that have not been replayed are kept on
+		// disk and replayed by the next buffer using the same spill dir.
+		if err := b.spill.Close(); err != nil {
+			b.m.spillErrors.Inc(1)
+		}
+	}
 }
 
 func (b *buffer) waitUntilAllDataConsumed() {
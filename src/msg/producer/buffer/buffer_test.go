@@ -21,6 +21,8 @@
 package buffer
 
 import (
+	"bytes"
+	"os"
 	"sync"
 	"testing"
 	"time"
@@ -48,6 +50,15 @@ func TestOptionsValidation(t *testing.T) {
 
 	opts = opts.SetScanBatchSize(0)
 	require.Equal(t, errInvalidScanBatchSize, opts.Validate())
+
+	opts = NewOptions().SetOnFullStrategy(SpillToDisk)
+	require.Equal(t, errSpillDirNotSet, opts.Validate())
+
+	opts = opts.SetSpillDir("/tmp/spill").SetMaxSpillSize(opts.MaxMessageSize() - 1)
+	require.Equal(t, errInvalidMaxSpillSize, opts.Validate())
+
+	opts = opts.SetMaxSpillSize(opts.MaxMessageSize()).SetSpillSegmentSize(0)
+	require.Equal(t, errInvalidSpillSegmentSize, opts.Validate())
 }
 
 func TestBuffer(t *testing.T) {
@@ -471,6 +482,144 @@ func TestBufferReturnErrorOnFull(t *testing.T) {
 	require.Equal(t, 300, int(b.size.Load()))
 }
 
+func TestBufferSpillToDiskOnFull(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mm := producer.NewMockMessage(ctrl)
+	mm.EXPECT().Size().Return(100).AnyTimes()
+
+	b := mustNewBuffer(t, testSpillOptions(t.TempDir()).
+		SetMaxMessageSize(int(mm.Size())).
+		SetMaxBufferSize(2*int(mm.Size())),
+	)
+	replayedCh := make(chan *producer.RefCountedMessage, 2)
+	b.SetReplayFn(func(rm *producer.RefCountedMessage) error {
+		replayedCh <- rm
+		return nil
+	})
+
+	rd1, err := b.Add(mm)
+	require.NoError(t, err)
+	rd2, err := b.Add(mm)
+	require.NoError(t, err)
+	require.Equal(t, 200, int(b.size.Load()))
+
+	// The buffer is full so the messages are spilled to disk.
+	spilled1 := testSpilledMessage(ctrl, 1, 'a')
+	spilled1.EXPECT().Finalize(producer.Spilled)
+	rd, err := b.Add(spilled1)
+	require.NoError(t, err)
+	require.Nil(t, rd)
+	spilled2 := testSpilledMessage(ctrl, 2, 'b')
+	spilled2.EXPECT().Finalize(producer.Spilled)
+	rd, err = b.Add(spilled2)
+	require.NoError(t, err)
+	require.Nil(t, rd)
+	require.Equal(t, 200, int(b.size.Load()))
+	require.Equal(t, int64(2*(spillRecordHeaderSize+100)), b.spill.Size())
+
+	// Nothing is replayed until there is room in the buffer.
+	b.Init()
+	time.Sleep(200 * time.Millisecond)
+	require.Equal(t, 0, len(replayedCh))
+
+	mm.EXPECT().Finalize(producer.Consumed).Times(2)
+	rd1.IncRef()
+	rd1.DecRef()
+	rd2.IncRef()
+	rd2.DecRef()
+
+	// The spilled messages are replayed in order.
+	for _, expected := range []producer.Message{spilled1, spilled2} {
+		replayed := <-replayedCh
+		require.Equal(t, expected.Shard(), replayed.Shard())
+		require.Equal(t, expected.Bytes(), replayed.Bytes())
+		replayed.IncRef()
+		replayed.DecRef()
+	}
+	require.True(t, b.spill.Empty())
+
+	// New messages are buffered in memory again once everything is replayed.
+	rd, err = b.Add(mm)
+	require.NoError(t, err)
+	require.NotNil(t, rd)
+
+	mm.EXPECT().Finalize(producer.Dropped)
+	b.Close(producer.DropEverything)
+	require.Equal(t, 0, int(b.size.Load()))
+}
+
+func TestBufferSpillToDiskReplayAfterClose(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mm := producer.NewMockMessage(ctrl)
+	mm.EXPECT().Size().Return(100).AnyTimes()
+
+	dir := t.TempDir()
+	opts := testSpillOptions(dir).
+		SetMaxMessageSize(int(mm.Size())).
+		SetMaxBufferSize(int(mm.Size())).
+		SetMaxSpillSize(spillRecordHeaderSize + int(mm.Size()))
+	b := mustNewBuffer(t, opts)
+
+	_, err := b.Add(mm)
+	require.NoError(t, err)
+	spilled := testSpilledMessage(ctrl, 1, 'a')
+	spilled.EXPECT().Finalize(producer.Spilled)
+	_, err = b.Add(spilled)
+	require.NoError(t, err)
+
+	// The spill log is full.
+	_, err = b.Add(testSpilledMessage(ctrl, 2, 'b'))
+	require.Equal(t, ErrBufferFull, err)
+
+	b.Init()
+	mm.EXPECT().Finalize(producer.Dropped)
+	b.Close(producer.DropEverything)
+
+	// The spilled message is replayed by the next buffer using the same dir.
+	b = mustNewBuffer(t, opts)
+	replayedCh := make(chan *producer.RefCountedMessage, 1)
+	b.SetReplayFn(func(rm *producer.RefCountedMessage) error {
+		replayedCh <- rm
+		return nil
+	})
+	b.Init()
+	replayed := <-replayedCh
+	require.Equal(t, spilled.Shard(), replayed.Shard())
+	require.Equal(t, spilled.Bytes(), replayed.Bytes())
+	replayed.IncRef()
+	replayed.DecRef()
+	b.Close(producer.WaitForConsumption)
+	require.Equal(t, 0, int(b.size.Load()))
+
+	// Nothing is replayed again once the spilled messages have been replayed.
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(entries))
+}
+
+func testSpilledMessage(ctrl *gomock.Controller, shard uint32, c byte) *producer.MockMessage {
+	m := producer.NewMockMessage(ctrl)
+	m.EXPECT().Size().Return(100).AnyTimes()
+	m.EXPECT().Shard().Return(shard).AnyTimes()
+	m.EXPECT().Bytes().Return(bytes.Repeat([]byte{c}, 100)).AnyTimes()
+	return m
+}
+
+func testSpillOptions(dir string) Options {
+	return testOptions().
+		SetOnFullStrategy(SpillToDisk).
+		SetSpillDir(dir).
+		SetSpillReplayInterval(100 * time.Millisecond)
+}
+
 func mustNewBuffer(t testing.TB, opts Options) *buffer {
 	b, err := NewBuffer(opts)
 	require.NoError(t, err)
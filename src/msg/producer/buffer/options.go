@@ -37,13 +37,21 @@ const (
 	defaultCleanupInitialBackoff = 10 * time.Second
 	defaultAllowedSpilloverRatio = 0.2
 	defaultCleanupMaxBackoff     = time.Minute
+	defaultMaxSpillSize          = 1024 * 1024 * 1024 // 1GB.
+	defaultSpillSegmentSize      = 64 * 1024 * 1024   // 64MB.
+	defaultSpillReplayInterval   = time.Second
+	defaultSpillFlushInterval    = 100 * time.Millisecond
 )
 
 var (
-	errInvalidScanBatchSize   = errors.New("invalid scan batch size")
-	errInvalidMaxMessageSize  = errors.New("invalid max message size")
-	errNegativeMaxBufferSize  = errors.New("negative max buffer size")
-	errNegativeMaxMessageSize = errors.New("negative max message size")
+	errInvalidScanBatchSize      = errors.New("invalid scan batch size")
+	errInvalidMaxMessageSize     = errors.New("invalid max message size")
+	errNegativeMaxBufferSize     = errors.New("negative max buffer size")
+	errNegativeMaxMessageSize    = errors.New("negative max message size")
+	errSpillDirNotSet            = errors.New("spill dir not set")
+	errInvalidMaxSpillSize       = errors.New("invalid max spill size")
+	errInvalidSpillSegmentSize   = errors.New("invalid spill segment size")
+	errInvalidSpillFlushInterval = errors.New("invalid spill flush interval")
 )
 
 type bufferOptions struct {
@@ -54,6 +62,11 @@ type bufferOptions struct {
 	dropOldestInterval    time.Duration
 	scanBatchSize         int
 	allowedSpilloverRatio float64
+	spillDir              string
+	maxSpillSize          int
+	spillSegmentSize      int
+	spillReplayInterval   time.Duration
+	spillFlushInterval    time.Duration
 	rOpts                 retry.Options
 	iOpts                 instrument.Options
 }
@@ -68,6 +81,10 @@ func NewOptions() Options {
 		dropOldestInterval:    defaultDropOldestInterval,
 		scanBatchSize:         defaultScanBatchSize,
 		allowedSpilloverRatio: defaultAllowedSpilloverRatio,
+		maxSpillSize:          defaultMaxSpillSize,
+		spillSegmentSize:      defaultSpillSegmentSize,
+		spillReplayInterval:   defaultSpillReplayInterval,
+		spillFlushInterval:    defaultSpillFlushInterval,
 		rOpts: retry.NewOptions().
 			SetInitialBackoff(defaultCleanupInitialBackoff).
 			SetMaxBackoff(defaultCleanupMaxBackoff).
@@ -146,6 +163,56 @@ func (opts *bufferOptions) SetAllowedSpilloverRatio(value float64) Options {
 	return &o
 }
 
+func (opts *bufferOptions) SpillDir() string {
+	return opts.spillDir
+}
+
+func (opts *bufferOptions) SetSpillDir(value string) Options {
+	o := *opts
+	o.spillDir = value
+	return &o
+}
+
+func (opts *bufferOptions) MaxSpillSize() int {
+	return opts.maxSpillSize
+}
+
+func (opts *bufferOptions) SetMaxSpillSize(value int) Options {
+	o := *opts
+	o.maxSpillSize = value
+	return &o
+}
+
+func (opts *bufferOptions) SpillSegmentSize() int {
+	return opts.spillSegmentSize
+}
+
+func (opts *bufferOptions) SetSpillSegmentSize(value int) Options {
+	o := *opts
+	o.spillSegmentSize = value
+	return &o
+}
+
+func (opts *bufferOptions) SpillReplayInterval() time.Duration {
+	return opts.spillReplayInterval
+}
+
+func (opts *bufferOptions) SetSpillReplayInterval(value time.Duration) Options {
+	o := *opts
+	o.spillReplayInterval = value
+	return &o
+}
+
+func (opts *bufferOptions) SpillFlushInterval() time.Duration {
+	return opts.spillFlushInterval
+}
+
+func (opts *bufferOptions) SetSpillFlushInterval(value time.Duration) Options {
+	o := *opts
+	o.spillFlushInterval = value
+	return &o
+}
+
 func (opts *bufferOptions) CleanupRetryOptions() retry.Options {
 	return opts.rOpts
 }
@@ -180,5 +247,21 @@ func (opts *bufferOptions) Validate() error {
 		// Max message size can only be as large as max buffer size.
 		return errInvalidMaxMessageSize
 	}
+	if opts.OnFullStrategy() != SpillToDisk {
+		return nil
+	}
+	if opts.SpillDir() == "" {
+		return errSpillDirNotSet
+	}
+	if opts.MaxSpillSize() < opts.MaxMessageSize() {
+		// The spill log must be able to hold at least one message.
+		return errInvalidMaxSpillSize
+	}
+	if opts.SpillSegmentSize() <= 0 {
+		return errInvalidSpillSegmentSize
+	}
+	if opts.SpillFlushInterval() <= 0 {
+		return errInvalidSpillFlushInterval
+	}
 	return nil
 }
@@ -0,0 +1,395 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package buffer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/msg/producer"
+)
+
+const (
+	spillSegmentPrefix = "segment-"
+	spillSegmentSuffix = ".log"
+	spillDirMode       = 0755
+	spillBufferSize    = 64 * 1024
+
+	// Each record is made of a header with the length, shard, write time
+	// and checksum of the message, followed by the message bytes.
+	spillRecordHeaderSize = 20
+)
+
+var errSpillRecordCorrupt = errors.New("spill record corrupt")
+
+type spillSegment struct {
+	path string
+	size int64
+}
+
+type spillRecord struct {
+	shard     uint32
+	writtenAt time.Time
+	data      []byte
+}
+
+// spillLog is a bounded log of messages on disk. Messages are appended to the
+// newest segment and read back in order from the oldest segment, segments are
+// removed once all their messages have been read.
+//
+// Messages are buffered in memory when written, Flush must be called on a
+// regular interval to bound the messages that are lost on a crash.
+//
+// NB: the read position is not persisted, so messages read from a segment that
+// was not fully read before a restart are read again after the restart.
+type spillLog struct {
+	sync.Mutex
+
+	dir            string
+	maxSize        int64
+	segmentSize    int64
+	maxMessageSize int
+
+	segments   []spillSegment
+	nextIndex  uint64
+	size       int64
+	writer     *os.File
+	bufWriter  *bufio.Writer
+	reader     *os.File
+	bufReader  *bufio.Reader
+	readOffset int64
+	dirty      bool
+	header     [spillRecordHeaderSize]byte
+}
+
+// newSpillLog creates a spill log in the given directory, the segments left
+// in the directory by a previous spill log are read first.
+func newSpillLog(
+	dir string,
+	maxSize int,
+	segmentSize int,
+	maxMessageSize int,
+) (*spillLog, error) {
+	if err := os.MkdirAll(dir, spillDirMode); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		indexes  []uint64
+		segments = make(map[uint64]spillSegment, len(entries))
+		size     int64
+	)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() ||
+			!strings.HasPrefix(name, spillSegmentPrefix) ||
+			!strings.HasSuffix(name, spillSegmentSuffix) {
+			continue
+		}
+		index, err := strconv.ParseUint(
+			strings.TrimSuffix(strings.TrimPrefix(name, spillSegmentPrefix), spillSegmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, index)
+		segments[index] = spillSegment{path: filepath.Join(dir, name), size: info.Size()}
+		size += info.Size()
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	l := &spillLog{
+		dir:            dir,
+		maxSize:        int64(maxSize),
+		segmentSize:    int64(segmentSize),
+		maxMessageSize: maxMessageSize,
+		size:           size,
+	}
+	for _, index := range indexes {
+		l.segments = append(l.segments, segments[index])
+		l.nextIndex = index + 1
+	}
+	return l, nil
+}
+
+// Empty returns true if all the messages in the log have been read.
+func (l *spillLog) Empty() bool {
+	return l.Size() == 0
+}
+
+// Size returns the size of the messages in the log that have not been read.
+func (l *spillLog) Size() int64 {
+	l.Lock()
+	size := l.size
+	l.Unlock()
+	return size
+}
+
+// Write appends a message to the log, returning ErrBufferFull if the log
+// has no room for the message.
+func (l *spillLog) Write(m producer.Message, now time.Time) error {
+	data := m.Bytes()
+	recordSize := int64(spillRecordHeaderSize + len(data))
+
+	l.Lock()
+	defer l.Unlock()
+
+	if l.size+recordSize > l.maxSize {
+		return ErrBufferFull
+	}
+	if l.writer == nil || l.activeSegmentWithLock().size+recordSize > l.segmentSize {
+		if err := l.rotateWithLock(); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint32(l.header[0:4], uint32(len(data)))
+	binary.LittleEndian.PutUint32(l.header[4:8], m.Shard())
+	binary.LittleEndian.PutUint64(l.header[8:16], uint64(now.UnixNano()))
+	binary.LittleEndian.PutUint32(l.header[16:20], crc32.ChecksumIEEE(data))
+	if _, err := l.bufWriter.Write(l.header[:]); err != nil {
+		l.closeWriterWithLock()
+		return err
+	}
+	if _, err := l.bufWriter.Write(data); err != nil {
+		// NB: the partially written record is detected as corrupt when read.
+		l.closeWriterWithLock()
+		return err
+	}
+	l.activeSegmentWithLock().size += recordSize
+	l.size += recordSize
+	l.dirty = true
+	return nil
+}
+
+// Read reads the oldest message that has not been read yet if it is no larger
+// than the given size, returning false if there is no such message. A segment
+// that can't be read is removed along with the messages left in it, an error
+// may be returned along with a message that was read successfully.
+func (l *spillLog) Read(maxMessageSize int) (spillRecord, bool, error) {
+	l.Lock()
+	defer l.Unlock()
+
+	for len(l.segments) > 0 {
+		segment := l.segments[0]
+		if l.readOffset >= segment.size {
+			if err := l.removeOldestSegmentWithLock(); err != nil {
+				return spillRecord{}, false, err
+			}
+			continue
+		}
+		if l.writer != nil && len(l.segments) == 1 {
+			// Reading the segment being written to, make sure everything
+			// written so far can be read.
+			if err := l.bufWriter.Flush(); err != nil {
+				return spillRecord{}, false, err
+			}
+		}
+		if l.reader == nil {
+			f, err := os.Open(segment.path)
+			if err != nil {
+				return spillRecord{}, false, l.dropOldestSegmentWithLock(err)
+			}
+			l.reader = f
+			l.bufReader = bufio.NewReaderSize(f, spillBufferSize)
+		}
+		header, err := l.bufReader.Peek(spillRecordHeaderSize)
+		if err != nil {
+			return spillRecord{}, false, l.dropOldestSegmentWithLock(err)
+		}
+		length := int(binary.LittleEndian.Uint32(header[0:4]))
+		if length > l.maxMessageSize {
+			return spillRecord{}, false, l.dropOldestSegmentWithLock(errSpillRecordCorrupt)
+		}
+		if length > maxMessageSize {
+			return spillRecord{}, false, nil
+		}
+		record := spillRecord{
+			shard:     binary.LittleEndian.Uint32(header[4:8]),
+			writtenAt: time.Unix(0, int64(binary.LittleEndian.Uint64(header[8:16]))),
+			data:      make([]byte, length),
+		}
+		checksum := binary.LittleEndian.Uint32(header[16:20])
+		if _, err := l.bufReader.Discard(spillRecordHeaderSize); err != nil {
+			return spillRecord{}, false, l.dropOldestSegmentWithLock(err)
+		}
+		if _, err := io.ReadFull(l.bufReader, record.data); err != nil {
+			return spillRecord{}, false, l.dropOldestSegmentWithLock(err)
+		}
+		if crc32.ChecksumIEEE(record.data) != checksum {
+			return spillRecord{}, false, l.dropOldestSegmentWithLock(errSpillRecordCorrupt)
+		}
+		recordSize := int64(spillRecordHeaderSize + length)
+		l.readOffset += recordSize
+		l.size -= recordSize
+		if l.readOffset >= segment.size {
+			// NB: remove the segment as soon as it has been read so its
+			// messages are not read again after a restart.
+			return record, true, l.removeOldestSegmentWithLock()
+		}
+		return record, true, nil
+	}
+	return spillRecord{}, false, nil
+}
+
+// Flush flushes and syncs the messages written to the log since the last
+// flush to the segment being written to.
+func (l *spillLog) Flush() error {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.writer == nil || !l.dirty {
+		return nil
+	}
+	if err := l.bufWriter.Flush(); err != nil {
+		return err
+	}
+	if err := l.writer.Sync(); err != nil {
+		return err
+	}
+	l.dirty = false
+	return nil
+}
+
+// Close flushes and syncs the segment being written to, the messages that
+// have not been read are kept on disk.
+func (l *spillLog) Close() error {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.reader != nil {
+		l.reader.Close()
+		l.reader = nil
+		l.bufReader = nil
+	}
+	return l.closeWriterWithLock()
+}
+
+func (l *spillLog) activeSegmentWithLock() *spillSegment {
+	return &l.segments[len(l.segments)-1]
+}
+
+func (l *spillLog) rotateWithLock() error {
+	if err := l.closeWriterWithLock(); err != nil {
+		return err
+	}
+	path := filepath.Join(l.dir, fmt.Sprintf("%s%020d%s", spillSegmentPrefix, l.nextIndex, spillSegmentSuffix))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0666)
+	if err != nil {
+		return err
+	}
+	l.nextIndex++
+	l.segments = append(l.segments, spillSegment{path: path})
+	l.writer = f
+	l.bufWriter = bufio.NewWriterSize(f, spillBufferSize)
+	return nil
+}
+
+func (l *spillLog) closeWriterWithLock() error {
+	if l.writer == nil {
+		return nil
+	}
+	var (
+		flushErr = l.bufWriter.Flush()
+		syncErr  = l.writer.Sync()
+		closeErr = l.writer.Close()
+	)
+	l.writer = nil
+	l.bufWriter = nil
+	l.dirty = false
+	if flushErr != nil {
+		return flushErr
+	}
+	if syncErr != nil {
+		return syncErr
+	}
+	return closeErr
+}
+
+// dropOldestSegmentWithLock removes the oldest segment along with the
+// messages left in it after it failed to be read with the given error.
+func (l *spillLog) dropOldestSegmentWithLock(err error) error {
+	if removeErr := l.removeOldestSegmentWithLock(); removeErr != nil {
+		return removeErr
+	}
+	return err
+}
+
+func (l *spillLog) removeOldestSegmentWithLock() error {
+	if len(l.segments) == 1 {
+		// The oldest segment is also the one being written to.
+		l.closeWriterWithLock()
+	}
+	if l.reader != nil {
+		l.reader.Close()
+		l.reader = nil
+		l.bufReader = nil
+	}
+	segment := l.segments[0]
+	l.segments = l.segments[1:]
+	if unread := segment.size - l.readOffset; unread > 0 {
+		l.size -= unread
+	}
+	l.readOffset = 0
+	if err := os.Remove(segment.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+type spilledMessage struct {
+	shard uint32
+	data  []byte
+}
+
+func newSpilledMessage(r spillRecord) producer.Message {
+	return &spilledMessage{shard: r.shard, data: r.data}
+}
+
+func (m *spilledMessage) Shard() uint32 {
+	return m.shard
+}
+
+func (m *spilledMessage) Bytes() []byte {
+	return m.data
+}
+
+func (m *spilledMessage) Size() int {
+	return len(m.data)
+}
+
+func (m *spilledMessage) Finalize(producer.FinalizeReason) {}
@@ -0,0 +1,188 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package buffer
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpillLogWriteRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	recordSize := spillRecordHeaderSize + 100
+	l, err := newSpillLog(t.TempDir(), 3*recordSize, 2*recordSize, 100)
+	require.NoError(t, err)
+	require.True(t, l.Empty())
+
+	now := time.Unix(0, 1234)
+	for i, c := range []byte("abc") {
+		require.NoError(t, l.Write(testSpilledMessage(ctrl, uint32(i), c), now))
+	}
+	require.Equal(t, int64(3*recordSize), l.Size())
+	require.Equal(t, 2, len(l.segments))
+
+	// The log is full.
+	require.Equal(t, ErrBufferFull, l.Write(testSpilledMessage(ctrl, 3, 'd'), now))
+
+	// Messages larger than the given size are not read.
+	_, ok, err := l.Read(99)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	for i, c := range []byte("abc") {
+		r, ok, err := l.Read(100)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, uint32(i), r.shard)
+		require.Equal(t, bytes.Repeat([]byte{c}, 100), r.data)
+		require.True(t, now.Equal(r.writtenAt))
+	}
+	_, ok, err = l.Read(100)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.True(t, l.Empty())
+	require.Equal(t, 0, len(l.segments))
+
+	// The log can be written to again once everything has been read.
+	require.NoError(t, l.Write(testSpilledMessage(ctrl, 4, 'e'), now))
+	r, ok, err := l.Read(100)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint32(4), r.shard)
+	require.NoError(t, l.Close())
+}
+
+func TestSpillLogReopen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir()
+	recordSize := spillRecordHeaderSize + 100
+	l, err := newSpillLog(dir, 10*recordSize, recordSize, 100)
+	require.NoError(t, err)
+	now := time.Now()
+	for i, c := range []byte("abc") {
+		require.NoError(t, l.Write(testSpilledMessage(ctrl, uint32(i), c), now))
+	}
+	r, ok, err := l.Read(100)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint32(0), r.shard)
+	require.NoError(t, l.Close())
+
+	// The messages that have not been read are read by the next log.
+	l, err = newSpillLog(dir, 10*recordSize, recordSize, 100)
+	require.NoError(t, err)
+	require.Equal(t, int64(2*recordSize), l.Size())
+	require.NoError(t, l.Write(testSpilledMessage(ctrl, 3, 'd'), now))
+	for _, shard := range []uint32{1, 2, 3} {
+		r, ok, err := l.Read(100)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, shard, r.shard)
+	}
+	require.True(t, l.Empty())
+	require.NoError(t, l.Close())
+}
+
+func TestSpillLogFlush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	recordSize := spillRecordHeaderSize + 100
+	l, err := newSpillLog(t.TempDir(), 10*recordSize, 10*recordSize, 100)
+	require.NoError(t, err)
+	require.NoError(t, l.Flush())
+
+	// Written messages are buffered until the log is flushed.
+	require.NoError(t, l.Write(testSpilledMessage(ctrl, 1, 'a'), time.Now()))
+	info, err := os.Stat(l.segments[0].path)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), info.Size())
+	require.True(t, l.dirty)
+
+	require.NoError(t, l.Flush())
+	info, err = os.Stat(l.segments[0].path)
+	require.NoError(t, err)
+	require.Equal(t, int64(recordSize), info.Size())
+	require.False(t, l.dirty)
+	require.NoError(t, l.Close())
+}
+
+func TestSpillLogReadCorrupt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir()
+	recordSize := spillRecordHeaderSize + 100
+	l, err := newSpillLog(dir, 10*recordSize, 2*recordSize, 100)
+	require.NoError(t, err)
+	now := time.Now()
+	for i, c := range []byte("abc") {
+		require.NoError(t, l.Write(testSpilledMessage(ctrl, uint32(i), c), now))
+	}
+	require.NoError(t, l.Close())
+
+	// Corrupt the first message of the first segment.
+	path := l.segments[0].path
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	b[spillRecordHeaderSize]++
+	require.NoError(t, os.WriteFile(path, b, 0600))
+
+	// The corrupt segment is dropped along with the messages left in it.
+	l, err = newSpillLog(dir, 10*recordSize, 2*recordSize, 100)
+	require.NoError(t, err)
+	_, ok, err := l.Read(100)
+	require.Equal(t, errSpillRecordCorrupt, err)
+	require.False(t, ok)
+	require.Equal(t, int64(recordSize), l.Size())
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+
+	r, ok, err := l.Read(100)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint32(2), r.shard)
+	require.True(t, l.Empty())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(entries))
+	require.NoError(t, l.Close())
+}
+
+func TestSpillLogIgnoresUnknownFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "foo"), []byte("bar"), 0600))
+	l, err := newSpillLog(dir, 1000, 100, 100)
+	require.NoError(t, err)
+	require.True(t, l.Empty())
+	require.Equal(t, 0, len(l.segments))
+}
@@ -44,6 +44,11 @@ func TestStrategyYamlUnmarshal(t *testing.T) {
 			expectErr:        false,
 			expectedStrategy: ReturnError,
 		},
+		{
+			bytes:            []byte("spillToDisk"),
+			expectErr:        false,
+			expectedStrategy: SpillToDisk,
+		},
 		{
 			bytes:     []byte("bad"),
 			expectErr: true,
@@ -39,6 +39,12 @@ const (
 	// will be dropped to make room for new buffer requests
 	// when the buffer is full.
 	DropOldest OnFullStrategy = "dropOldest"
+
+	// SpillToDisk means new messages will be spilled to a bounded
+	// log on disk when the buffer is full, and replayed in order once
+	// the buffer has room again. An error will be returned on new
+	// buffer requests when the spill log is full as well.
+	SpillToDisk OnFullStrategy = "spillToDisk"
 )
 
 // Options configs the buffer.
@@ -96,6 +102,38 @@ type Options interface {
 	// SetCleanupRetryOptions sets the cleanup retry options.
 	SetCleanupRetryOptions(value retry.Options) Options
 
+	// SpillDir returns the directory the spill log is stored in.
+	SpillDir() string
+
+	// SetSpillDir sets the directory the spill log is stored in.
+	SetSpillDir(value string) Options
+
+	// MaxSpillSize returns the max size of the spill log.
+	MaxSpillSize() int
+
+	// SetMaxSpillSize sets the max size of the spill log.
+	SetMaxSpillSize(value int) Options
+
+	// SpillSegmentSize returns the size of each segment of the spill log.
+	SpillSegmentSize() int
+
+	// SetSpillSegmentSize sets the size of each segment of the spill log.
+	SetSpillSegmentSize(value int) Options
+
+	// SpillReplayInterval returns the interval to replay spilled messages.
+	SpillReplayInterval() time.Duration
+
+	// SetSpillReplayInterval sets the interval to replay spilled messages.
+	SetSpillReplayInterval(value time.Duration) Options
+
+	// SpillFlushInterval returns the interval to flush and sync spilled
+	// messages to disk, which bounds the spilled messages lost on a crash.
+	SpillFlushInterval() time.Duration
+
+	// SetSpillFlushInterval sets the interval to flush and sync spilled
+	// messages to disk, which bounds the spilled messages lost on a crash.
+	SetSpillFlushInterval(value time.Duration) Options
+
 	// InstrumentOptions returns the instrument options.
 	InstrumentOptions() instrument.Options
 
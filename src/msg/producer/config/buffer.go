@@ -38,6 +38,11 @@ type BufferConfiguration struct {
 	ScanBatchSize         *int                   `yaml:"scanBatchSize"`
 	AllowedSpilloverRatio *float64               `yaml:"allowedSpilloverRatio"`
 	CleanupRetry          *retry.Configuration   `yaml:"cleanupRetry"`
+	SpillDir              *string                `yaml:"spillDir"`
+	MaxSpillSize          *int                   `yaml:"maxSpillSize"`
+	SpillSegmentSize      *int                   `yaml:"spillSegmentSize"`
+	SpillReplayInterval   *time.Duration         `yaml:"spillReplayInterval"`
+	SpillFlushInterval    *time.Duration         `yaml:"spillFlushInterval"`
 }
 
 // NewOptions creates new buffer options.
@@ -64,6 +69,21 @@ func (c *BufferConfiguration) NewOptions(iOpts instrument.Options) buffer.Option
 	if c.AllowedSpilloverRatio != nil {
 		opts = opts.SetAllowedSpilloverRatio(*c.AllowedSpilloverRatio)
 	}
+	if c.SpillDir != nil {
+		opts = opts.SetSpillDir(*c.SpillDir)
+	}
+	if c.MaxSpillSize != nil {
+		opts = opts.SetMaxSpillSize(*c.MaxSpillSize)
+	}
+	if c.SpillSegmentSize != nil {
+		opts = opts.SetSpillSegmentSize(*c.SpillSegmentSize)
+	}
+	if c.SpillReplayInterval != nil {
+		opts = opts.SetSpillReplayInterval(*c.SpillReplayInterval)
+	}
+	if c.SpillFlushInterval != nil {
+		opts = opts.SetSpillFlushInterval(*c.SpillFlushInterval)
+	}
 	if c.CleanupRetry != nil {
 		opts = opts.SetCleanupRetryOptions(c.CleanupRetry.NewOptions(iOpts.MetricsScope()))
 	}
@@ -59,6 +59,28 @@ cleanupRetry:
 	require.Equal(t, 2*time.Second, bOpts.CleanupRetryOptions().InitialBackoff())
 }
 
+func TestBufferConfigurationSpillToDisk(t *testing.T) {
+	str := `
+onFullStrategy: spillToDisk
+spillDir: /var/lib/m3/spill
+maxSpillSize: 1000
+spillSegmentSize: 100
+spillReplayInterval: 200ms
+spillFlushInterval: 50ms
+`
+
+	var cfg BufferConfiguration
+	require.NoError(t, yaml.Unmarshal([]byte(str), &cfg))
+
+	bOpts := cfg.NewOptions(instrument.NewOptions())
+	require.Equal(t, buffer.SpillToDisk, bOpts.OnFullStrategy())
+	require.Equal(t, "/var/lib/m3/spill", bOpts.SpillDir())
+	require.Equal(t, 1000, bOpts.MaxSpillSize())
+	require.Equal(t, 100, bOpts.SpillSegmentSize())
+	require.Equal(t, 200*time.Millisecond, bOpts.SpillReplayInterval())
+	require.Equal(t, 50*time.Millisecond, bOpts.SpillFlushInterval())
+}
+
 func TestEmptyBufferConfiguration(t *testing.T) {
 	var cfg BufferConfiguration
 	require.NoError(t, yaml.Unmarshal(nil, &cfg))
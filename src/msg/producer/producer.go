@@ -34,8 +34,14 @@ func NewProducer(opts Options) Producer {
 }
 
 func (p *producer) Init() error {
+	// NB: the writer must be initialized before the buffer starts replaying
+	// spilled messages into it.
+	if err := p.Writer.Init(); err != nil {
+		return err
+	}
+	p.Buffer.SetReplayFn(p.Writer.Write)
 	p.Buffer.Init()
-	return p.Writer.Init()
+	return nil
 }
 
 func (p *producer) Produce(m Message) error {
@@ -43,6 +49,10 @@ func (p *producer) Produce(m Message) error {
 	if err != nil {
 		return err
 	}
+	if rm == nil {
+		// The message was held back by the buffer and will be replayed later.
+		return nil
+	}
 	return p.Writer.Write(rm)
 }
 
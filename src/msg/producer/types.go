@@ -36,6 +36,10 @@ const (
 
 	// Dropped means the message has been dropped.
 	Dropped
+
+	// Spilled means the message has been copied to the buffer's spill log
+	// and will be replayed from there, so it is neither consumed nor dropped.
+	Spilled
 )
 
 // Message contains the data that will be produced by the producer.
@@ -206,11 +210,21 @@ type Options interface {
 	SetWriter(value Writer) Options
 }
 
+// ReplayFn writes a message the buffer held back when it was added.
+type ReplayFn func(rm *RefCountedMessage) error
+
 // Buffer buffers all the messages in the producer.
 type Buffer interface {
 	// Add adds message to the buffer and returns a reference counted message.
+	// If the buffer holds the message back, e.g. by spilling it to disk, a nil
+	// message is returned and the message is passed to the replay function
+	// once there is room in the buffer.
 	Add(m Message) (*RefCountedMessage, error)
 
+	// SetReplayFn sets the function used to write the messages held back by
+	// the buffer, it must be called before the buffer is initialized.
+	SetReplayFn(fn ReplayFn)
+
 	// Init initializes the buffer.
 	Init()
 
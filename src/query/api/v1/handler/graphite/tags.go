@@ -0,0 +1,373 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/graphite/graphite"
+	graphitestorage "github.com/m3db/m3/src/query/graphite/storage"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	"github.com/m3db/m3/src/query/util/json"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/clock"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	// TagsURL is the url for listing graphite tags.
+	TagsURL = route.Prefix + "/graphite/tags"
+	// TagsAutoCompleteTagsURL is the url for auto completing graphite tags.
+	TagsAutoCompleteTagsURL = TagsURL + "/autoComplete/tags"
+	// TagsAutoCompleteValuesURL is the url for auto completing graphite tag
+	// values.
+	TagsAutoCompleteValuesURL = TagsURL + "/autoComplete/values"
+
+	// defaultAutoCompleteLimit matches the graphite-web default limit of
+	// auto complete results.
+	defaultAutoCompleteLimit = 100
+)
+
+// TagsHTTPMethods are the HTTP methods for the tags handlers.
+var TagsHTTPMethods = []string{http.MethodGet, http.MethodPost}
+
+var errNoTag = errors.New("no tag specified")
+
+type tagsHandlerType uint
+
+const (
+	listTagsHandlerType tagsHandlerType = iota
+	autoCompleteTagsHandlerType
+	autoCompleteValuesHandlerType
+)
+
+type graphiteTagsHandler struct {
+	handlerType         tagsHandlerType
+	storage             storage.Storage
+	fetchOptionsBuilder handleroptions.FetchOptionsBuilder
+	instrumentOpts      instrument.Options
+	nowFn               clock.NowFn
+}
+
+// NewTagsHandler returns a new handler which lists the graphite tags of
+// tagged series, i.e. [{"tag": "dc"}, {"tag": "name"}].
+func NewTagsHandler(opts options.HandlerOptions) http.Handler {
+	return newTagsHandler(opts, listTagsHandlerType)
+}
+
+// NewTagsAutoCompleteTagsHandler returns a new handler which auto completes
+// graphite tags of the series matching the given tag expressions.
+func NewTagsAutoCompleteTagsHandler(opts options.HandlerOptions) http.Handler {
+	return newTagsHandler(opts, autoCompleteTagsHandlerType)
+}
+
+// NewTagsAutoCompleteValuesHandler returns a new handler which auto
+// completes the values of a graphite tag of the series matching the given
+// tag expressions.
+func NewTagsAutoCompleteValuesHandler(opts options.HandlerOptions) http.Handler {
+	return newTagsHandler(opts, autoCompleteValuesHandlerType)
+}
+
+func newTagsHandler(
+	opts options.HandlerOptions,
+	handlerType tagsHandlerType,
+) http.Handler {
+	return &graphiteTagsHandler{
+		handlerType:         handlerType,
+		storage:             opts.Storage(),
+		fetchOptionsBuilder: opts.GraphiteFindFetchOptionsBuilder(),
+		instrumentOpts:      opts.InstrumentOpts(),
+		nowFn:               opts.NowFn(),
+	}
+}
+
+type tagsParams struct {
+	matchers models.Matchers
+	// filter is the filter regexp when listing tags.
+	filter *regexp.Regexp
+	// tag is the tag to auto complete values for.
+	tag    string
+	prefix string
+	limit  int
+}
+
+func (h *graphiteTagsHandler) parseParams(r *http.Request) (tagsParams, error) {
+	// NB: FormValue parses the request form so that the repeated expr
+	// values can then be read from the form directly.
+	limitStr := r.FormValue("limit")
+	params := tagsParams{
+		// NB: match all graphite series when no expressions are given.
+		matchers: models.Matchers{{
+			Type:  models.MatchRegexp,
+			Name:  graphite.TagName(0),
+			Value: []byte(".*"),
+		}},
+	}
+	if exprs := r.Form["expr"]; len(exprs) > 0 {
+		matchers, err := graphitestorage.TranslateTagExpressionsToMatchers(exprs)
+		if err != nil {
+			return tagsParams{}, xerrors.NewInvalidParamsError(err)
+		}
+		params.matchers = matchers
+	}
+
+	if h.handlerType != listTagsHandlerType {
+		params.limit = defaultAutoCompleteLimit
+	}
+	if limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 0 {
+			return tagsParams{}, xerrors.NewInvalidParamsError(
+				fmt.Errorf("invalid 'limit': %s", limitStr))
+		}
+		params.limit = limit
+	}
+
+	switch h.handlerType {
+	case listTagsHandlerType:
+		if str := r.FormValue("filter"); str != "" {
+			filter, err := regexp.Compile(str)
+			if err != nil {
+				return tagsParams{}, xerrors.NewInvalidParamsError(
+					fmt.Errorf("invalid 'filter': %v", err))
+			}
+			params.filter = filter
+		}
+	case autoCompleteTagsHandlerType:
+		params.prefix = r.FormValue("tagPrefix")
+	case autoCompleteValuesHandlerType:
+		params.tag = r.FormValue("tag")
+		if params.tag == "" {
+			return tagsParams{}, xerrors.NewInvalidParamsError(errNoTag)
+		}
+		params.prefix = r.FormValue("valuePrefix")
+	}
+
+	return params, nil
+}
+
+func (h *graphiteTagsHandler) ServeHTTP(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx, opts, err := h.fetchOptionsBuilder.NewFetchOptions(r.Context(), r)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	params, err := h.parseParams(r)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	var (
+		end     = h.nowFn()
+		results []string
+		meta    block.ResultMetadata
+	)
+	if h.handlerType == autoCompleteValuesHandlerType &&
+		params.tag == graphitestorage.SeriesByTagNameTag {
+		// The series path is not stored as a single tag so the names need
+		// to be built from the IDs of the matching series.
+		query := &storage.FetchQuery{
+			TagMatchers: params.matchers,
+			Start:       time.Unix(0, 0),
+			End:         end,
+		}
+		result, err := h.storage.SearchSeries(ctx, query, opts)
+		if err != nil {
+			logger.Error("unable to search series", zap.Error(err))
+			xhttp.WriteError(w, err)
+			return
+		}
+
+		meta = result.Metadata
+		results = seriesPaths(result.Metrics, params.prefix)
+	} else {
+		query := &storage.CompleteTagsQuery{
+			CompleteNameOnly: h.handlerType != autoCompleteValuesHandlerType,
+			TagMatchers:      params.matchers,
+			Start:            0,
+			End:              xtime.ToUnixNano(end),
+		}
+		if h.handlerType == autoCompleteValuesHandlerType {
+			query.FilterNameTags = [][]byte{[]byte(params.tag)}
+		}
+
+		result, err := h.storage.CompleteTags(ctx, query, opts)
+		if err != nil {
+			logger.Error("unable to complete tags", zap.Error(err))
+			xhttp.WriteError(w, err)
+			return
+		}
+
+		meta = result.Metadata
+		if h.handlerType == autoCompleteValuesHandlerType {
+			results = tagValues(result.CompletedTags, params.tag, params.prefix)
+		} else {
+			results = tagNames(result.CompletedTags, params.prefix, params.filter)
+		}
+	}
+
+	if params.limit > 0 && len(results) > params.limit {
+		results = results[:params.limit]
+	}
+
+	if err := handleroptions.AddDBResultResponseHeaders(w, meta, opts); err != nil {
+		logger.Error("unable to render tags header", zap.Error(err))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	if err := tagsResultsJSON(w, results, h.handlerType == listTagsHandlerType); err != nil {
+		logger.Error("unable to render tags results", zap.Error(err))
+	}
+}
+
+// tagNames returns the sorted graphite tag names, the __gN__ path tags are
+// reported as the single "name" tag.
+func tagNames(
+	tags []consolidators.CompletedTag,
+	prefix string,
+	filter *regexp.Regexp,
+) []string {
+	var (
+		names   = make([]string, 0, len(tags))
+		hasPath bool
+	)
+	for _, tag := range tags {
+		if _, ok := graphite.TagIndex(tag.Name); ok {
+			hasPath = true
+			continue
+		}
+		names = append(names, string(tag.Name))
+	}
+	if hasPath {
+		names = append(names, graphitestorage.SeriesByTagNameTag)
+	}
+
+	filtered := names[:0]
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if filter != nil && !filter.MatchString(name) {
+			continue
+		}
+		filtered = append(filtered, name)
+	}
+
+	sort.Strings(filtered)
+	return filtered
+}
+
+// tagValues returns the sorted values of the given tag.
+func tagValues(
+	tags []consolidators.CompletedTag,
+	tag string,
+	prefix string,
+) []string {
+	var values []string
+	for _, completed := range tags {
+		if string(completed.Name) != tag {
+			continue
+		}
+		for _, value := range completed.Values {
+			if strings.HasPrefix(string(value), prefix) {
+				values = append(values, string(value))
+			}
+		}
+	}
+
+	sort.Strings(values)
+	return values
+}
+
+// seriesPaths returns the sorted, unique graphite paths of the given series
+// whose IDs are of the form path;tag=value.
+func seriesPaths(metrics models.Metrics, prefix string) []string {
+	seen := make(map[string]struct{}, len(metrics))
+	paths := make([]string, 0, len(metrics))
+	for _, metric := range metrics {
+		path := string(metric.ID)
+		if idx := strings.IndexByte(path, ';'); idx != -1 {
+			path = path[:idx]
+		}
+		if !strings.HasPrefix(path, prefix) {
+			continue
+		}
+		if _, ok := seen[path]; ok {
+			continue
+		}
+		seen[path] = struct{}{}
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+	return paths
+}
+
+// tagsResultsJSON renders the results either as a list of tag objects, as
+// for the graphite /tags endpoint, or as a list of strings, as for the
+// auto complete endpoints.
+func tagsResultsJSON(w io.Writer, results []string, asTagObjects bool) error {
+	jw := json.NewWriter(w)
+	jw.BeginArray()
+
+	for _, result := range results {
+		if !asTagObjects {
+			jw.WriteString(result)
+			continue
+		}
+
+		jw.BeginObject()
+		jw.BeginObjectField("tag")
+		jw.WriteString(result)
+		jw.EndObject()
+	}
+
+	jw.EndArray()
+	return jw.Close()
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/graphite/graphite"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	xtest "github.com/m3db/m3/src/x/test"
+)
+
+func newTestTagsHandlerOptions(
+	t *testing.T,
+	store storage.Storage,
+) options.HandlerOptions {
+	builder, err := handleroptions.NewFetchOptionsBuilder(
+		handleroptions.FetchOptionsBuilderOptions{
+			Timeout: 15 * time.Second,
+		})
+	require.NoError(t, err)
+
+	return options.EmptyHandlerOptions().
+		SetGraphiteFindFetchOptionsBuilder(builder).
+		SetStorage(store)
+}
+
+func serveTagsRequest(h http.Handler, target string, params url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target+"?"+params.Encode(), nil)
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestTagsHandler(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().
+		CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			q *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			assert.True(t, q.CompleteNameOnly)
+			return &consolidators.CompleteTagsResult{
+				CompleteNameOnly: true,
+				CompletedTags: []consolidators.CompletedTag{
+					{Name: graphite.TagName(0)},
+					{Name: graphite.TagName(1)},
+					{Name: []byte("host")},
+					{Name: []byte("dc")},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+
+	h := NewTagsHandler(newTestTagsHandlerOptions(t, store))
+	res := serveTagsRequest(h, TagsURL, url.Values{"filter": []string{"^(dc|name)$"}})
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	assert.JSONEq(t, `[{"tag":"dc"},{"tag":"name"}]`, res.Body.String())
+}
+
+func TestTagsAutoCompleteTagsHandler(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().
+		CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			q *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			assert.Equal(t, models.Matchers{
+				{Type: models.MatchEqual, Name: []byte("dc"), Value: []byte("east")},
+			}, q.TagMatchers)
+			return &consolidators.CompleteTagsResult{
+				CompleteNameOnly: true,
+				CompletedTags: []consolidators.CompletedTag{
+					{Name: graphite.TagName(0)},
+					{Name: []byte("dc")},
+					{Name: []byte("host")},
+					{Name: []byte("hardware")},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+
+	h := NewTagsAutoCompleteTagsHandler(newTestTagsHandlerOptions(t, store))
+	res := serveTagsRequest(h, TagsAutoCompleteTagsURL, url.Values{
+		"expr":      []string{"dc=east"},
+		"tagPrefix": []string{"h"},
+		"limit":     []string{"1"},
+	})
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	assert.JSONEq(t, `["hardware"]`, res.Body.String())
+}
+
+func TestTagsAutoCompleteValuesHandler(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().
+		CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			q *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			assert.False(t, q.CompleteNameOnly)
+			assert.Equal(t, [][]byte{[]byte("dc")}, q.FilterNameTags)
+			return &consolidators.CompleteTagsResult{
+				CompletedTags: []consolidators.CompletedTag{
+					{Name: []byte("dc"), Values: [][]byte{
+						[]byte("west"), []byte("east"), []byte("europe"),
+					}},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+	store.EXPECT().
+		SearchSeries(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&storage.SearchResults{
+			Metrics: models.Metrics{
+				{ID: []byte("foo.bar;dc=east")},
+				{ID: []byte("foo.baz;dc=west")},
+				{ID: []byte("foo.bar;dc=west")},
+				{ID: []byte("qux.bar")},
+			},
+			Metadata: block.NewResultMetadata(),
+		}, nil)
+
+	h := NewTagsAutoCompleteValuesHandler(newTestTagsHandlerOptions(t, store))
+	res := serveTagsRequest(h, TagsAutoCompleteValuesURL, url.Values{
+		"tag":         []string{"dc"},
+		"valuePrefix": []string{"e"},
+	})
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	assert.JSONEq(t, `["east","europe"]`, res.Body.String())
+
+	res = serveTagsRequest(h, TagsAutoCompleteValuesURL, url.Values{
+		"tag":         []string{"name"},
+		"valuePrefix": []string{"foo."},
+	})
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	assert.JSONEq(t, `["foo.bar","foo.baz"]`, res.Body.String())
+
+	res = serveTagsRequest(h, TagsAutoCompleteValuesURL, url.Values{})
+	require.Equal(t, http.StatusBadRequest, res.Code)
+
+	res = serveTagsRequest(h, TagsAutoCompleteValuesURL, url.Values{
+		"tag":  []string{"dc"},
+		"expr": []string{"dc!=east"},
+	})
+	require.Equal(t, http.StatusBadRequest, res.Code)
+}
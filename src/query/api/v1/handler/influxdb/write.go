@@ -375,6 +375,14 @@ func (iwh *ingestWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 
 	opts := ingest.WriteOptions{}
+	tenancy := iwh.handlerOpts.Config().Tenancy.TenancyOptions()
+	if tenant, ok, err := handleroptions.ParseTenant(r, tenancy); err != nil {
+		xhttp.WriteError(w, xhttp.NewError(err, http.StatusBadRequest))
+		return
+	} else if ok {
+		opts.TenantTag = &tenant
+	}
+
 	iter := &ingestIterator{points: points, tagOpts: iwh.tagOpts, promRewriter: iwh.promRewriter, writeTags: writeTags}
 	batchErr := iwh.handlerOpts.DownsamplerAndWriter().WriteBatch(r.Context(), iter, opts)
 	if batchErr == nil {
@@ -28,6 +28,8 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
 	"github.com/m3db/m3/src/query/api/v1/options"
 	"github.com/m3db/m3/src/query/api/v1/route"
 	"github.com/m3db/m3/src/query/models"
@@ -52,17 +54,17 @@ const (
 
 // WriteJSONHandler represents a handler for the write json endpoint
 type WriteJSONHandler struct {
-	opts           options.HandlerOptions
-	store          storage.Storage
-	instrumentOpts instrument.Options
+	opts                 options.HandlerOptions
+	downsamplerAndWriter ingest.DownsamplerAndWriter
+	instrumentOpts       instrument.Options
 }
 
 // NewWriteJSONHandler returns a new instance of handler.
 func NewWriteJSONHandler(opts options.HandlerOptions) http.Handler {
 	return &WriteJSONHandler{
-		opts:           opts,
-		store:          opts.Storage(),
-		instrumentOpts: opts.InstrumentOpts(),
+		opts:                 opts,
+		downsamplerAndWriter: opts.DownsamplerAndWriter(),
+		instrumentOpts:       opts.InstrumentOpts(),
 	}
 }
 
@@ -83,6 +85,13 @@ func (h *WriteJSONHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	tenancy := h.opts.Config().Tenancy.TenancyOptions()
+	tenant, hasTenant, err := handleroptions.ParseTenant(r, tenancy)
+	if err != nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+		return
+	}
+
 	writeQuery, err := h.newWriteQuery(req)
 	if err != nil {
 		logger := logging.WithContext(r.Context(), h.instrumentOpts)
@@ -90,9 +99,18 @@ func (h *WriteJSONHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			zap.String("remoteAddr", r.RemoteAddr),
 			zap.Error(err))
 		xhttp.WriteError(w, err)
+		return
 	}
 
-	if err := h.store.Write(r.Context(), writeQuery); err != nil {
+	// NB: datapoints written via this endpoint are only written unaggregated
+	// so the default downsampling rules are overridden with no rules.
+	opts := ingest.WriteOptions{DownsampleOverride: true}
+	if hasTenant {
+		opts.TenantTag = &tenant
+	}
+	if err := h.downsamplerAndWriter.Write(r.Context(), writeQuery.Tags(),
+		writeQuery.Datapoints(), writeQuery.Unit(), writeQuery.Annotation(),
+		opts, ts.SourceTypePrometheus); err != nil {
 		logger := logging.WithContext(r.Context(), h.instrumentOpts)
 		logger.Error("write error",
 			zap.String("remoteAddr", r.RemoteAddr),
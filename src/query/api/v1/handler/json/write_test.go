@@ -32,9 +32,13 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
 	"github.com/m3db/m3/src/query/api/v1/options"
 	"github.com/m3db/m3/src/query/models"
-	"github.com/m3db/m3/src/query/test/m3"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/x/headers"
+	xtime "github.com/m3db/m3/src/x/time"
 )
 
 func TestFailingJSONWriteParsing(t *testing.T) {
@@ -71,17 +75,16 @@ func TestJSONWrite(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	storage, session := m3.NewStorageAndSession(t, ctrl)
-	session.EXPECT().
-		WriteTagged(gomock.Any(), gomock.Any(), gomock.Any(),
-			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		AnyTimes()
-	session.EXPECT().IteratorPools().
-		Return(nil, nil).AnyTimes()
+	downsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	downsamplerAndWriter.EXPECT().
+		Write(gomock.Any(), gomock.Any(), gomock.Any(), xtime.Millisecond,
+			gomock.Any(), ingest.WriteOptions{DownsampleOverride: true},
+			ts.SourceTypePrometheus).
+		Return(nil)
 
 	opts := options.EmptyHandlerOptions().
 		SetTagOptions(models.NewTagOptions()).
-		SetStorage(storage)
+		SetDownsamplerAndWriter(downsamplerAndWriter)
 	handler := NewWriteJSONHandler(opts).(*WriteJSONHandler)
 
 	jsonReq := generateJSONWriteRequest()
@@ -101,16 +104,15 @@ func TestJSONWriteError(t *testing.T) {
 
 	expectedErr := fmt.Errorf("an error")
 
-	storage, session := m3.NewStorageAndSession(t, ctrl)
-	session.EXPECT().
-		WriteTagged(gomock.Any(), gomock.Any(), gomock.Any(),
-			gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		AnyTimes().
+	downsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	downsamplerAndWriter.EXPECT().
+		Write(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(expectedErr)
-	session.EXPECT().IteratorPools().
-		Return(nil, nil).AnyTimes()
 
-	opts := options.EmptyHandlerOptions().SetStorage(storage)
+	opts := options.EmptyHandlerOptions().
+		SetTagOptions(models.NewTagOptions()).
+		SetDownsamplerAndWriter(downsamplerAndWriter)
 	jsonWrite := NewWriteJSONHandler(opts).(*WriteJSONHandler)
 
 	jsonReq := generateJSONWriteRequest()
@@ -129,3 +131,44 @@ func TestJSONWriteError(t *testing.T) {
 	require.True(t, bytes.Contains(body, []byte(expectedErr.Error())),
 		fmt.Sprintf("body: %s", body))
 }
+
+func TestJSONWriteTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	downsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	downsamplerAndWriter.EXPECT().
+		Write(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), ingest.WriteOptions{
+				DownsampleOverride: true,
+				TenantTag: &models.Tag{
+					Name:  []byte("tenant"),
+					Value: []byte("foo"),
+				},
+			}, gomock.Any()).
+		Return(nil)
+
+	opts := options.EmptyHandlerOptions().
+		SetTagOptions(models.NewTagOptions()).
+		SetDownsamplerAndWriter(downsamplerAndWriter).
+		SetConfig(config.Configuration{
+			Tenancy: config.TenancyConfiguration{Enabled: true},
+		})
+	handler := NewWriteJSONHandler(opts).(*WriteJSONHandler)
+
+	// Requests must specify a tenant.
+	req, err := http.NewRequest(JSONWriteHTTPMethod, WriteJSONURL,
+		strings.NewReader(generateJSONWriteRequest()))
+	require.NoError(t, err)
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+
+	req, err = http.NewRequest(JSONWriteHTTPMethod, WriteJSONURL,
+		strings.NewReader(generateJSONWriteRequest()))
+	require.NoError(t, err)
+	req.Header.Set(headers.TenantHeader, "foo")
+	resp = httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+}
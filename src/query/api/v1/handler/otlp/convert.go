@@ -0,0 +1,448 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	bucketSuffix = "_bucket"
+	countSuffix  = "_count"
+	sumSuffix    = "_sum"
+
+	quantileTagName = "quantile"
+
+	// Exponential histogram scales supported when converting to Prometheus
+	// native histograms, higher scales are downscaled to the maximum scale.
+	minExponentialHistogramScale = -4
+	maxExponentialHistogramScale = 8
+)
+
+var errEmptyMetricName = errors.New("empty metric name")
+
+// series is a single datapoint of a series converted from OTLP metrics.
+type series struct {
+	tags       models.Tags
+	datapoint  ts.Datapoint
+	attributes ts.SeriesAttributes
+	// delta is set for datapoints of metrics with delta temporality, which are
+	// accumulated into cumulative values before being written.
+	delta bool
+}
+
+// converter converts OTLP metrics into series. Resource attributes and data
+// point attributes are mapped to tags with data point attributes taking
+// precedence, and names are sanitized to valid Prometheus names.
+type converter struct {
+	tagOpts models.TagOptions
+	series  []series
+	errs    xerrors.MultiError
+}
+
+func newConverter(tagOpts models.TagOptions) *converter {
+	return &converter{tagOpts: tagOpts}
+}
+
+// convert converts the metrics of an export request, metrics that cannot be
+// converted are skipped and returned as invalid params errors.
+func (c *converter) convert(req *colmetricspb.ExportMetricsServiceRequest) ([]series, error) {
+	for _, rm := range req.GetResourceMetrics() {
+		resourceTags := c.attributesToTags(models.NewTags(0, c.tagOpts),
+			rm.GetResource().GetAttributes())
+		for _, ilm := range rm.GetInstrumentationLibraryMetrics() {
+			for _, m := range ilm.GetMetrics() {
+				if err := c.convertMetric(resourceTags, m); err != nil {
+					c.errs = c.errs.Add(xerrors.NewInvalidParamsError(
+						fmt.Errorf("metric %q: %w", m.GetName(), err)))
+				}
+			}
+		}
+	}
+
+	return c.series, c.errs.FinalError()
+}
+
+func (c *converter) convertMetric(resourceTags models.Tags, m *metricspb.Metric) error {
+	name := sanitizeMetricName(m.GetName())
+	if name == "" {
+		return errEmptyMetricName
+	}
+
+	switch data := m.GetData().(type) {
+	case *metricspb.Metric_Gauge:
+		attrs := ts.SeriesAttributes{PromType: ts.PromMetricTypeGauge}
+		for _, dp := range data.Gauge.GetDataPoints() {
+			tags := c.dataPointTags(resourceTags, name, dp.GetAttributes())
+			c.add(tags, dp.GetTimeUnixNano(), numberValue(dp), attrs, false)
+		}
+
+	case *metricspb.Metric_Sum:
+		attrs := ts.SeriesAttributes{PromType: ts.PromMetricTypeGauge}
+		if data.Sum.GetIsMonotonic() {
+			attrs = ts.SeriesAttributes{
+				PromType:          ts.PromMetricTypeCounter,
+				HandleValueResets: true,
+			}
+		}
+		delta, err := isDelta(data.Sum.GetAggregationTemporality())
+		if err != nil {
+			return err
+		}
+		for _, dp := range data.Sum.GetDataPoints() {
+			tags := c.dataPointTags(resourceTags, name, dp.GetAttributes())
+			c.add(tags, dp.GetTimeUnixNano(), numberValue(dp), attrs, delta)
+		}
+
+	case *metricspb.Metric_Histogram:
+		delta, err := isDelta(data.Histogram.GetAggregationTemporality())
+		if err != nil {
+			return err
+		}
+		for _, dp := range data.Histogram.GetDataPoints() {
+			if err := c.convertHistogramDataPoint(resourceTags, name, dp, delta); err != nil {
+				return err
+			}
+		}
+
+	case *metricspb.Metric_ExponentialHistogram:
+		delta, err := isDelta(data.ExponentialHistogram.GetAggregationTemporality())
+		if err != nil {
+			return err
+		}
+		for _, dp := range data.ExponentialHistogram.GetDataPoints() {
+			if err := c.convertExponentialHistogramDataPoint(resourceTags, name, dp, delta); err != nil {
+				return err
+			}
+		}
+
+	case *metricspb.Metric_Summary:
+		for _, dp := range data.Summary.GetDataPoints() {
+			c.convertSummaryDataPoint(resourceTags, name, dp)
+		}
+
+	default:
+		return fmt.Errorf("unsupported metric type %T", data)
+	}
+
+	return nil
+}
+
+// convertHistogramDataPoint converts an explicit bucket histogram data point
+// into classic Prometheus histogram series.
+func (c *converter) convertHistogramDataPoint(
+	resourceTags models.Tags,
+	name string,
+	dp *metricspb.HistogramDataPoint,
+	delta bool,
+) error {
+	var (
+		bounds = dp.GetExplicitBounds()
+		counts = dp.GetBucketCounts()
+		tags   = c.dataPointTags(resourceTags, name, dp.GetAttributes())
+		t      = dp.GetTimeUnixNano()
+		attrs  = ts.SeriesAttributes{
+			PromType:          ts.PromMetricTypeHistogram,
+			HandleValueResets: true,
+		}
+	)
+	if len(counts) > 0 && len(counts) != len(bounds)+1 {
+		return fmt.Errorf("histogram has %d bucket counts but %d explicit bounds",
+			len(counts), len(bounds))
+	}
+
+	var cumulative uint64
+	if len(counts) > 0 {
+		bucketName := []byte(name + bucketSuffix)
+		for i, bound := range bounds {
+			cumulative += counts[i]
+			bucketTags := tags.Clone().SetName(bucketName).
+				SetBucket([]byte(strconv.FormatFloat(bound, 'g', -1, 64)))
+			c.add(bucketTags, t, float64(cumulative), attrs, delta)
+		}
+		infTags := tags.Clone().SetName(bucketName).SetBucket([]byte("+Inf"))
+		c.add(infTags, t, float64(dp.GetCount()), attrs, delta)
+	}
+
+	c.add(tags.Clone().SetName([]byte(name+countSuffix)), t,
+		float64(dp.GetCount()), attrs, delta)
+	c.add(tags.Clone().SetName([]byte(name+sumSuffix)), t,
+		dp.GetSum(), attrs, delta)
+	return nil
+}
+
+// convertExponentialHistogramDataPoint converts an exponential histogram
+// data point into the series a Prometheus native histogram is stored as.
+func (c *converter) convertExponentialHistogramDataPoint(
+	resourceTags models.Tags,
+	name string,
+	dp *metricspb.ExponentialHistogramDataPoint,
+	delta bool,
+) error {
+	scale := dp.GetScale()
+	if scale < minExponentialHistogramScale {
+		return fmt.Errorf("exponential histogram scale %d is below the minimum %d",
+			scale, minExponentialHistogramScale)
+	}
+
+	var downscale int32
+	if scale > maxExponentialHistogramScale {
+		downscale = scale - maxExponentialHistogramScale
+		scale = maxExponentialHistogramScale
+	}
+
+	negSpans, negCounts := exponentialBucketsToSpans(dp.GetNegative(), downscale)
+	posSpans, posCounts := exponentialBucketsToSpans(dp.GetPositive(), downscale)
+	histogram := prompb.Histogram{
+		CountFloat:     float64(dp.GetCount()),
+		Sum:            dp.GetSum(),
+		Schema:         scale,
+		ZeroCountFloat: float64(dp.GetZeroCount()),
+		NegativeSpans:  negSpans,
+		NegativeCounts: negCounts,
+		PositiveSpans:  posSpans,
+		PositiveCounts: posCounts,
+		Timestamp:      int64(dp.GetTimeUnixNano()) / int64(time.Millisecond),
+	}
+
+	tags := c.dataPointTags(resourceTags, name, dp.GetAttributes())
+	seriesTags, seriesDatapoints, err := storage.PromHistogramsToM3Series(tags,
+		[]prompb.Histogram{histogram})
+	if err != nil {
+		return err
+	}
+
+	attrs := ts.SeriesAttributes{
+		PromType:          ts.PromMetricTypeHistogram,
+		HandleValueResets: true,
+	}
+	for i, datapoints := range seriesDatapoints {
+		for _, dp := range datapoints {
+			c.series = append(c.series, series{
+				tags:       seriesTags[i],
+				datapoint:  dp,
+				attributes: attrs,
+				delta:      delta,
+			})
+		}
+	}
+
+	return nil
+}
+
+// convertSummaryDataPoint converts a summary data point into Prometheus
+// summary series.
+func (c *converter) convertSummaryDataPoint(
+	resourceTags models.Tags,
+	name string,
+	dp *metricspb.SummaryDataPoint,
+) {
+	var (
+		tags  = c.dataPointTags(resourceTags, name, dp.GetAttributes())
+		t     = dp.GetTimeUnixNano()
+		attrs = ts.SeriesAttributes{PromType: ts.PromMetricTypeSummary}
+	)
+	for _, q := range dp.GetQuantileValues() {
+		quantileTags := tags.Clone().AddOrUpdateTag(models.Tag{
+			Name:  []byte(quantileTagName),
+			Value: []byte(strconv.FormatFloat(q.GetQuantile(), 'g', -1, 64)),
+		})
+		c.add(quantileTags, t, q.GetValue(), attrs, false)
+	}
+
+	// NB: the count and sum of summaries are always cumulative.
+	attrs.HandleValueResets = true
+	c.add(tags.Clone().SetName([]byte(name+countSuffix)), t,
+		float64(dp.GetCount()), attrs, false)
+	c.add(tags.Clone().SetName([]byte(name+sumSuffix)), t,
+		dp.GetSum(), attrs, false)
+}
+
+func (c *converter) add(
+	tags models.Tags,
+	timeUnixNano uint64,
+	value float64,
+	attrs ts.SeriesAttributes,
+	delta bool,
+) {
+	c.series = append(c.series, series{
+		tags: tags,
+		datapoint: ts.Datapoint{
+			Timestamp: xtime.UnixNano(timeUnixNano),
+			Value:     value,
+		},
+		attributes: attrs,
+		delta:      delta,
+	})
+}
+
+func (c *converter) dataPointTags(
+	resourceTags models.Tags,
+	name string,
+	attributes []*commonpb.KeyValue,
+) models.Tags {
+	tags := c.attributesToTags(resourceTags.Clone(), attributes)
+	return tags.SetName([]byte(name))
+}
+
+func (c *converter) attributesToTags(
+	tags models.Tags,
+	attributes []*commonpb.KeyValue,
+) models.Tags {
+	for _, attr := range attributes {
+		value, ok := attributeValue(attr.GetValue())
+		if !ok || value == "" {
+			continue
+		}
+
+		name := sanitizeLabelName(attr.GetKey())
+		if name == "" {
+			continue
+		}
+
+		tags = tags.AddOrUpdateTag(models.Tag{
+			Name:  []byte(name),
+			Value: []byte(value),
+		})
+	}
+	return tags
+}
+
+// attributeValue returns the string value of an attribute, array, key value
+// list and bytes attributes are not supported.
+func attributeValue(v *commonpb.AnyValue) (string, bool) {
+	switch v := v.GetValue().(type) {
+	case *commonpb.AnyValue_StringValue:
+		return v.StringValue, true
+	case *commonpb.AnyValue_BoolValue:
+		return strconv.FormatBool(v.BoolValue), true
+	case *commonpb.AnyValue_IntValue:
+		return strconv.FormatInt(v.IntValue, 10), true
+	case *commonpb.AnyValue_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'g', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
+func numberValue(dp *metricspb.NumberDataPoint) float64 {
+	switch v := dp.GetValue().(type) {
+	case *metricspb.NumberDataPoint_AsDouble:
+		return v.AsDouble
+	case *metricspb.NumberDataPoint_AsInt:
+		return float64(v.AsInt)
+	default:
+		return math.NaN()
+	}
+}
+
+func isDelta(temporality metricspb.AggregationTemporality) (bool, error) {
+	switch temporality {
+	case metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE:
+		return false, nil
+	case metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA:
+		return true, nil
+	default:
+		return false, fmt.Errorf("unsupported aggregation temporality %s", temporality)
+	}
+}
+
+// exponentialBucketsToSpans converts exponential histogram buckets into a
+// single native histogram span, merging buckets when downscaling. Bucket i of
+// an exponential histogram covers (base^i, base^(i+1)] whereas bucket i of a
+// native histogram covers (base^(i-1), base^i], hence the offset by one.
+func exponentialBucketsToSpans(
+	buckets *metricspb.ExponentialHistogramDataPoint_Buckets,
+	downscale int32,
+) ([]prompb.BucketSpan, []float64) {
+	counts := buckets.GetBucketCounts()
+	if len(counts) == 0 {
+		return nil, nil
+	}
+
+	var (
+		offset       = buckets.GetOffset()
+		first        = offset >> downscale
+		last         = (offset + int32(len(counts)) - 1) >> downscale
+		mergedCounts = make([]float64, last-first+1)
+	)
+	for i, count := range counts {
+		idx := (offset + int32(i)) >> downscale
+		mergedCounts[idx-first] += float64(count)
+	}
+
+	spans := []prompb.BucketSpan{{
+		Offset: first + 1,
+		Length: uint32(len(mergedCounts)),
+	}}
+	return spans, mergedCounts
+}
+
+// sanitizeMetricName replaces characters that are not valid in Prometheus
+// metric names with underscores.
+func sanitizeMetricName(name string) string {
+	return sanitizeName(name, true)
+}
+
+// sanitizeLabelName replaces characters that are not valid in Prometheus
+// label names with underscores.
+func sanitizeLabelName(name string) string {
+	return sanitizeName(name, false)
+}
+
+func sanitizeName(name string, allowColons bool) string {
+	if name == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	b.Grow(len(name) + 1)
+	if name[0] >= '0' && name[0] <= '9' {
+		b.WriteByte('_')
+	}
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		case r == ':' && allowColons:
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestSanitizeNames(t *testing.T) {
+	assert.Equal(t, "http_server_duration", sanitizeMetricName("http.server.duration"))
+	assert.Equal(t, "job:requests:rate5m", sanitizeMetricName("job:requests:rate5m"))
+	assert.Equal(t, "_2xx_responses", sanitizeMetricName("2xx-responses"))
+	assert.Equal(t, "job_requests", sanitizeLabelName("job:requests"))
+	assert.Equal(t, "k8s_pod_name", sanitizeLabelName("k8s.pod.name"))
+	assert.Equal(t, "", sanitizeLabelName(""))
+}
+
+func TestExponentialBucketsToSpans(t *testing.T) {
+	buckets := &metricspb.ExponentialHistogramDataPoint_Buckets{
+		Offset:       -3,
+		BucketCounts: []uint64{1, 2, 3, 4, 5},
+	}
+
+	spans, counts := exponentialBucketsToSpans(buckets, 0)
+	assert.Equal(t, []prompb.BucketSpan{{Offset: -2, Length: 5}}, spans)
+	assert.Equal(t, []float64{1, 2, 3, 4, 5}, counts)
+
+	// Downscaling by one merges pairs of buckets, indices -3 to 1 map to
+	// indices -2 to 0.
+	spans, counts = exponentialBucketsToSpans(buckets, 1)
+	assert.Equal(t, []prompb.BucketSpan{{Offset: -1, Length: 3}}, spans)
+	assert.Equal(t, []float64{1, 5, 9}, counts)
+
+	spans, counts = exponentialBucketsToSpans(nil, 0)
+	assert.Nil(t, spans)
+	assert.Nil(t, counts)
+}
+
+func TestDeltaToCumulativeExpiry(t *testing.T) {
+	now := time.Unix(0, 0)
+	deltas := newDeltaToCumulative(time.Minute, func() time.Time { return now })
+
+	dp, ok := deltas.convert("foo", ts.Datapoint{Timestamp: xtime.UnixNano(1), Value: 2})
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, dp.Value)
+
+	dp, ok = deltas.convert("foo", ts.Datapoint{Timestamp: xtime.UnixNano(2), Value: 3})
+	assert.True(t, ok)
+	assert.Equal(t, 5.0, dp.Value)
+
+	// The running total restarts once the series expires.
+	now = now.Add(time.Minute)
+	dp, ok = deltas.convert("foo", ts.Datapoint{Timestamp: xtime.UnixNano(3), Value: 1})
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, dp.Value)
+}
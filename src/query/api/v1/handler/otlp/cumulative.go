@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/query/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// cumulativeValue is the running total of a delta temporality series.
+type cumulativeValue struct {
+	value         float64
+	lastTimestamp xtime.UnixNano
+	lastUpdated   time.Time
+}
+
+// deltaToCumulative accumulates the datapoints of delta temporality series
+// into cumulative values so that they can be queried like Prometheus
+// counters and histograms. Running totals are kept in memory, so the delta
+// datapoints of a series must be sent to the same coordinator, and are
+// expired once a series has not been written to for the expiry duration.
+type deltaToCumulative struct {
+	sync.Mutex
+
+	expiry    time.Duration
+	nowFn     func() time.Time
+	values    map[string]*cumulativeValue
+	lastSweep time.Time
+}
+
+func newDeltaToCumulative(expiry time.Duration, nowFn func() time.Time) *deltaToCumulative {
+	return &deltaToCumulative{
+		expiry:    expiry,
+		nowFn:     nowFn,
+		values:    make(map[string]*cumulativeValue),
+		lastSweep: nowFn(),
+	}
+}
+
+// convert returns the cumulative datapoint of a delta datapoint of the series
+// with the given ID, datapoints older than the last datapoint of the series
+// and NaN datapoints are dropped.
+func (d *deltaToCumulative) convert(id string, dp ts.Datapoint) (ts.Datapoint, bool) {
+	if math.IsNaN(dp.Value) {
+		return ts.Datapoint{}, false
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	now := d.nowFn()
+	if now.Sub(d.lastSweep) >= d.expiry {
+		d.sweepWithLock(now)
+	}
+
+	v, ok := d.values[id]
+	if !ok {
+		v = &cumulativeValue{}
+		d.values[id] = v
+	} else if dp.Timestamp <= v.lastTimestamp {
+		return ts.Datapoint{}, false
+	}
+
+	v.value += dp.Value
+	v.lastTimestamp = dp.Timestamp
+	v.lastUpdated = now
+	return ts.Datapoint{Timestamp: dp.Timestamp, Value: v.value}, true
+}
+
+func (d *deltaToCumulative) sweepWithLock(now time.Time) {
+	for id, v := range d.values {
+		if now.Sub(v.lastUpdated) >= d.expiry {
+			delete(d.values, id)
+		}
+	}
+	d.lastSweep = now
+}
@@ -0,0 +1,417 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package otlp contains the handler for OpenTelemetry OTLP/HTTP metrics.
+package otlp
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/uber-go/tally"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	// WriteURL is the OTLP/HTTP metrics write handler URL.
+	WriteURL = route.Prefix + "/otlp/v1/metrics"
+
+	// WriteHTTPMethod is the HTTP method used with this resource.
+	WriteHTTPMethod = http.MethodPost
+
+	protobufContentType = "application/x-protobuf"
+
+	// deltaExpiry is how long the running totals of delta temporality
+	// series are kept after their last write.
+	deltaExpiry = 15 * time.Minute
+)
+
+var (
+	errNoDownsamplerAndWriter = errors.New("no downsampler and writer set")
+	errNoTagOptions           = errors.New("no tag options set")
+	errNoNowFn                = errors.New("no now fn set")
+	errEmptyBody              = errors.New("empty request body")
+
+	defaultValue = ingest.IterValue{
+		Tags:       models.EmptyTags(),
+		Attributes: ts.DefaultSeriesAttributes(),
+		Metadata:   ts.Metadata{},
+	}
+)
+
+type writeMetrics struct {
+	writeSuccess      tally.Counter
+	writeErrorsServer tally.Counter
+	writeErrorsClient tally.Counter
+	deltaDropped      tally.Counter
+}
+
+func newWriteMetrics(scope tally.Scope) writeMetrics {
+	return writeMetrics{
+		writeSuccess:      scope.SubScope("write").Counter("success"),
+		writeErrorsServer: scope.SubScope("write").Tagged(map[string]string{"code": "5XX"}).Counter("errors"),
+		writeErrorsClient: scope.SubScope("write").Tagged(map[string]string{"code": "4XX"}).Counter("errors"),
+		deltaDropped:      scope.SubScope("delta").Counter("dropped"),
+	}
+}
+
+// writeHandler is the handler for OTLP/HTTP metrics export requests.
+type writeHandler struct {
+	downsamplerAndWriter ingest.DownsamplerAndWriter
+	tagOpts              models.TagOptions
+	storeMetricsType     bool
+	deltas               *deltaToCumulative
+	tenancy              handleroptions.TenancyOptions
+	handlerOpts          options.HandlerOptions
+	metrics              writeMetrics
+}
+
+// NewWriteHandler returns a new OTLP/HTTP metrics write handler. Gauges,
+// sums, histograms, exponential histograms and summaries are converted into
+// series, with resource attributes mapped to tags, and written to the
+// downsampler and storage.
+func NewWriteHandler(options options.HandlerOptions) (http.Handler, error) {
+	var (
+		downsamplerAndWriter = options.DownsamplerAndWriter()
+		tagOpts              = options.TagOptions()
+		nowFn                = options.NowFn()
+	)
+	if downsamplerAndWriter == nil {
+		return nil, errNoDownsamplerAndWriter
+	}
+
+	if tagOpts == nil {
+		return nil, errNoTagOptions
+	}
+
+	if nowFn == nil {
+		return nil, errNoNowFn
+	}
+
+	scope := options.InstrumentOpts().
+		MetricsScope().
+		Tagged(map[string]string{"handler": "otlp-write"})
+	return &writeHandler{
+		downsamplerAndWriter: downsamplerAndWriter,
+		tagOpts:              tagOpts,
+		storeMetricsType:     options.StoreMetricsType(),
+		deltas:               newDeltaToCumulative(deltaExpiry, time.Now),
+		tenancy:              options.Config().Tenancy.TenancyOptions(),
+		handlerOpts:          options,
+		metrics:              newWriteMetrics(scope),
+	}, nil
+}
+
+func (h *writeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := h.parseRequest(r)
+	if err != nil {
+		h.metrics.writeErrorsClient.Inc(1)
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	tenant, hasTenant, err := handleroptions.ParseTenant(r, h.tenancy)
+	if err != nil {
+		h.metrics.writeErrorsClient.Inc(1)
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+		return
+	}
+
+	var opts ingest.WriteOptions
+	if hasTenant {
+		opts.TenantTag = &tenant
+	}
+
+	// NB: metrics that cannot be converted are reported once the rest of
+	// the request has been written.
+	converted, convertErr := newConverter(h.tagOpts).convert(req)
+	converted = h.cumulate(converted, opts.TenantTag)
+
+	iter := newSeriesIter(converted, h.storeMetricsType)
+	batchErr := h.downsamplerAndWriter.WriteBatch(r.Context(), iter, opts)
+	if batchErr != nil {
+		h.writeBatchError(w, r, batchErr)
+		return
+	}
+
+	if convertErr != nil {
+		h.metrics.writeErrorsClient.Inc(1)
+		xhttp.WriteError(w, convertErr)
+		return
+	}
+
+	body, err := proto.Marshal(&colmetricspb.ExportMetricsServiceResponse{})
+	if err != nil {
+		h.metrics.writeErrorsServer.Inc(1)
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	h.metrics.writeSuccess.Inc(1)
+	w.Header().Set(xhttp.HeaderContentType, protobufContentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+func (h *writeHandler) parseRequest(
+	r *http.Request,
+) (*colmetricspb.ExportMetricsServiceRequest, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, xerrors.NewInvalidParamsError(errEmptyBody)
+	}
+
+	contentType, _, err := mime.ParseMediaType(r.Header.Get(xhttp.HeaderContentType))
+	if err != nil || contentType != protobufContentType {
+		return nil, xhttp.NewError(fmt.Errorf("unsupported content type %q, expected %q",
+			r.Header.Get(xhttp.HeaderContentType), protobufContentType),
+			http.StatusUnsupportedMediaType)
+	}
+
+	var reader io.ReadCloser = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		reader, err = gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, xerrors.NewInvalidParamsError(err)
+		}
+		defer reader.Close()
+	}
+
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, xerrors.NewInvalidParamsError(err)
+	}
+
+	var req colmetricspb.ExportMetricsServiceRequest
+	if err := proto.Unmarshal(body, &req); err != nil {
+		return nil, xerrors.NewInvalidParamsError(err)
+	}
+
+	return &req, nil
+}
+
+// cumulate converts the datapoints of delta temporality series into
+// cumulative datapoints, dropping datapoints that are out of order.
+// cumulate converts delta datapoints to cumulative datapoints, the deltas of
+// each tenant are accumulated separately.
+func (h *writeHandler) cumulate(converted []series, tenant *models.Tag) []series {
+	result := converted[:0]
+	for _, s := range converted {
+		if s.delta {
+			tags := s.tags
+			if tenant != nil {
+				tags = tags.Clone().AddOrUpdateTag(*tenant)
+			}
+			dp, ok := h.deltas.convert(string(tags.ID()), s.datapoint)
+			if !ok {
+				h.metrics.deltaDropped.Inc(1)
+				continue
+			}
+			s.datapoint = dp
+		}
+		result = append(result, s)
+	}
+	return result
+}
+
+func (h *writeHandler) writeBatchError(
+	w http.ResponseWriter,
+	r *http.Request,
+	batchErr ingest.BatchError,
+) {
+	var (
+		errs              = batchErr.Errors()
+		lastRegularErr    string
+		lastBadRequestErr string
+		numRegular        int
+		numBadRequest     int
+	)
+	for _, err := range errs {
+		switch {
+		case client.IsBadRequestError(err), xerrors.IsInvalidParams(err):
+			numBadRequest++
+			lastBadRequestErr = err.Error()
+		default:
+			numRegular++
+			lastRegularErr = err.Error()
+		}
+	}
+
+	// NB: OTLP exporters only retry on 429, 502, 503 and 504 so server side
+	// errors are returned as unavailable to have the request retried.
+	status := http.StatusServiceUnavailable
+	if numBadRequest == len(errs) {
+		status = http.StatusBadRequest
+		h.metrics.writeErrorsClient.Inc(1)
+	} else {
+		h.metrics.writeErrorsServer.Inc(1)
+	}
+
+	logger := logging.WithContext(r.Context(), h.handlerOpts.InstrumentOpts())
+	logger.Error("write error",
+		zap.String("remoteAddr", r.RemoteAddr),
+		zap.Int("httpResponseStatusCode", status),
+		zap.Int("numRegularErrors", numRegular),
+		zap.Int("numBadRequestErrors", numBadRequest),
+		zap.String("lastRegularError", lastRegularErr),
+		zap.String("lastBadRequestErr", lastBadRequestErr))
+
+	var resultErr string
+	if lastRegularErr != "" {
+		resultErr = fmt.Sprintf("retryable_errors: count=%d, last=%s",
+			numRegular, lastRegularErr)
+	}
+	if lastBadRequestErr != "" {
+		var sep string
+		if lastRegularErr != "" {
+			sep = ", "
+		}
+		resultErr = fmt.Sprintf("%s%sbad_request_errors: count=%d, last=%s",
+			resultErr, sep, numBadRequest, lastBadRequestErr)
+	}
+	xhttp.WriteError(w, xhttp.NewError(errors.New(resultErr), status))
+}
+
+// seriesIter iterates over converted series, each holding a single datapoint.
+type seriesIter struct {
+	idx              int
+	err              error
+	series           []series
+	metadatas        []ts.Metadata
+	annotation       []byte
+	storeMetricsType bool
+}
+
+func newSeriesIter(converted []series, storeMetricsType bool) *seriesIter {
+	return &seriesIter{
+		idx:              -1,
+		series:           converted,
+		storeMetricsType: storeMetricsType,
+	}
+}
+
+func (i *seriesIter) Next() bool {
+	if i.err != nil {
+		return false
+	}
+
+	i.idx++
+	if i.idx >= len(i.series) {
+		return false
+	}
+
+	if !i.storeMetricsType {
+		return true
+	}
+
+	annotationPayload, err := storage.SeriesAttributesToAnnotationPayload(
+		i.series[i.idx].attributes)
+	if err != nil {
+		i.err = err
+		return false
+	}
+
+	i.annotation, err = annotationPayload.Marshal()
+	if err != nil {
+		i.err = err
+		return false
+	}
+
+	if len(i.annotation) == 0 {
+		i.annotation = nil
+	}
+
+	return true
+}
+
+func (i *seriesIter) Current() ingest.IterValue {
+	if i.idx < 0 || i.idx >= len(i.series) {
+		return defaultValue
+	}
+
+	s := i.series[i.idx]
+	value := ingest.IterValue{
+		Tags:       s.tags,
+		Datapoints: ts.Datapoints{s.datapoint},
+		Attributes: s.attributes,
+		Unit:       timeUnit(s.datapoint.Timestamp),
+		Annotation: i.annotation,
+	}
+	if i.idx < len(i.metadatas) {
+		value.Metadata = i.metadatas[i.idx]
+	}
+	return value
+}
+
+func (i *seriesIter) Reset() error {
+	i.idx = -1
+	i.err = nil
+	i.annotation = nil
+	return nil
+}
+
+func (i *seriesIter) Error() error {
+	return i.err
+}
+
+func (i *seriesIter) SetCurrentMetadata(metadata ts.Metadata) {
+	if len(i.metadatas) == 0 {
+		i.metadatas = make([]ts.Metadata, len(i.series))
+	}
+	if i.idx < 0 || i.idx >= len(i.metadatas) {
+		return
+	}
+	i.metadatas[i.idx] = metadata
+}
+
+// timeUnit returns the coarsest unit the timestamp can be encoded with.
+func timeUnit(t xtime.UnixNano) xtime.Unit {
+	ns := int64(t)
+	switch {
+	case ns%int64(time.Second) == 0:
+		return xtime.Second
+	case ns%int64(time.Millisecond) == 0:
+		return xtime.Millisecond
+	case ns%int64(time.Microsecond) == 0:
+		return xtime.Microsecond
+	default:
+		return xtime.Nanosecond
+	}
+}
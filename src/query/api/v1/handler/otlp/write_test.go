@@ -0,0 +1,361 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xtest "github.com/m3db/m3/src/x/test"
+)
+
+var testTime = time.Unix(1000, 0)
+
+func makeOptions(ds ingest.DownsamplerAndWriter) options.HandlerOptions {
+	return options.EmptyHandlerOptions().
+		SetNowFn(time.Now).
+		SetDownsamplerAndWriter(ds).
+		SetTagOptions(models.NewTagOptions())
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+func makeRequest(metrics ...*metricspb.Metric) *colmetricspb.ExportMetricsServiceRequest {
+	return &colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{
+			{
+				Resource: &resourcepb.Resource{
+					Attributes: []*commonpb.KeyValue{
+						stringAttribute("service.name", "api"),
+					},
+				},
+				InstrumentationLibraryMetrics: []*metricspb.InstrumentationLibraryMetrics{
+					{Metrics: metrics},
+				},
+			},
+		},
+	}
+}
+
+func makeHTTPRequest(
+	t *testing.T,
+	req *colmetricspb.ExportMetricsServiceRequest,
+	gzipped bool,
+) *http.Request {
+	body, err := proto.Marshal(req)
+	require.NoError(t, err)
+
+	if gzipped {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		_, err := w.Write(body)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		body = buf.Bytes()
+	}
+
+	httpReq := httptest.NewRequest(WriteHTTPMethod, WriteURL, bytes.NewReader(body))
+	httpReq.Header.Set("Content-Type", protobufContentType)
+	if gzipped {
+		httpReq.Header.Set("Content-Encoding", "gzip")
+	}
+	return httpReq
+}
+
+type writtenSeries struct {
+	tags       string
+	value      float64
+	attributes ts.SeriesAttributes
+}
+
+// expectWrites expects a single write batch and returns the series written.
+func expectWrites(ds *ingest.MockDownsamplerAndWriter) *[]writtenSeries {
+	var written []writtenSeries
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			iter ingest.DownsampleAndWriteIter,
+			_ ingest.WriteOptions,
+		) ingest.BatchError {
+			for iter.Next() {
+				value := iter.Current()
+				for _, dp := range value.Datapoints {
+					written = append(written, writtenSeries{
+						tags:       value.Tags.String(),
+						value:      dp.Value,
+						attributes: value.Attributes,
+					})
+				}
+			}
+			return nil
+		})
+	return &written
+}
+
+func serve(t *testing.T, handler http.Handler, req *http.Request) int {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder.Code
+}
+
+func TestWriteGaugeAndSum(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	written := expectWrites(ds)
+	handler, err := NewWriteHandler(makeOptions(ds))
+	require.NoError(t, err)
+
+	req := makeRequest(
+		&metricspb.Metric{
+			Name: "memory.usage",
+			Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+				DataPoints: []*metricspb.NumberDataPoint{{
+					Attributes:   []*commonpb.KeyValue{stringAttribute("host.name", "a")},
+					TimeUnixNano: uint64(testTime.UnixNano()),
+					Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: 1.5},
+				}},
+			}},
+		},
+		&metricspb.Metric{
+			Name: "http.requests",
+			Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				IsMonotonic:            true,
+				DataPoints: []*metricspb.NumberDataPoint{{
+					Attributes: []*commonpb.KeyValue{
+						stringAttribute("service.name", "override"),
+					},
+					TimeUnixNano: uint64(testTime.UnixNano()),
+					Value:        &metricspb.NumberDataPoint_AsInt{AsInt: 42},
+				}},
+			}},
+		},
+	)
+	require.Equal(t, http.StatusOK, serve(t, handler, makeHTTPRequest(t, req, true)))
+
+	assert.Equal(t, []writtenSeries{
+		{
+			tags:       "__name__: memory_usage, host_name: a, service_name: api",
+			value:      1.5,
+			attributes: ts.SeriesAttributes{PromType: ts.PromMetricTypeGauge},
+		},
+		{
+			tags:  "__name__: http_requests, service_name: override",
+			value: 42,
+			attributes: ts.SeriesAttributes{
+				PromType:          ts.PromMetricTypeCounter,
+				HandleValueResets: true,
+			},
+		},
+	}, *written)
+}
+
+func TestWriteDeltaSum(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	handler, err := NewWriteHandler(makeOptions(ds))
+	require.NoError(t, err)
+
+	deltaRequest := func(offset time.Duration, value int64) *http.Request {
+		return makeHTTPRequest(t, makeRequest(&metricspb.Metric{
+			Name: "requests",
+			Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+				IsMonotonic:            true,
+				DataPoints: []*metricspb.NumberDataPoint{{
+					TimeUnixNano: uint64(testTime.Add(offset).UnixNano()),
+					Value:        &metricspb.NumberDataPoint_AsInt{AsInt: value},
+				}},
+			}},
+		}), false)
+	}
+
+	var values []float64
+	for _, req := range []*http.Request{
+		deltaRequest(0, 3),
+		deltaRequest(time.Minute, 4),
+		// Out of order datapoints are dropped.
+		deltaRequest(30*time.Second, 5),
+		deltaRequest(2*time.Minute, 1),
+	} {
+		written := expectWrites(ds)
+		require.Equal(t, http.StatusOK, serve(t, handler, req))
+		for _, s := range *written {
+			values = append(values, s.value)
+		}
+	}
+
+	assert.Equal(t, []float64{3, 7, 8}, values)
+}
+
+func TestWriteHistogram(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	written := expectWrites(ds)
+	handler, err := NewWriteHandler(makeOptions(ds))
+	require.NoError(t, err)
+
+	req := makeRequest(&metricspb.Metric{
+		Name: "latency",
+		Data: &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			DataPoints: []*metricspb.HistogramDataPoint{{
+				TimeUnixNano:   uint64(testTime.UnixNano()),
+				Count:          6,
+				Sum:            12.5,
+				ExplicitBounds: []float64{0.5, 1},
+				BucketCounts:   []uint64{1, 2, 3},
+			}},
+		}},
+	})
+	require.Equal(t, http.StatusOK, serve(t, handler, makeHTTPRequest(t, req, false)))
+
+	var actual []string
+	for _, s := range *written {
+		actual = append(actual, fmt.Sprintf("%s %v", s.tags, s.value))
+		assert.Equal(t, ts.PromMetricTypeHistogram, s.attributes.PromType)
+	}
+	assert.Equal(t, []string{
+		"__name__: latency_bucket, le: 0.5, service_name: api 1",
+		"__name__: latency_bucket, le: 1, service_name: api 3",
+		"__name__: latency_bucket, le: +Inf, service_name: api 6",
+		"__name__: latency_count, service_name: api 6",
+		"__name__: latency_sum, service_name: api 12.5",
+	}, actual)
+}
+
+func TestWriteExponentialHistogram(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	written := expectWrites(ds)
+	handler, err := NewWriteHandler(makeOptions(ds))
+	require.NoError(t, err)
+
+	req := makeRequest(&metricspb.Metric{
+		Name: "latency",
+		Data: &metricspb.Metric_ExponentialHistogram{ExponentialHistogram: &metricspb.ExponentialHistogram{
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			DataPoints: []*metricspb.ExponentialHistogramDataPoint{{
+				TimeUnixNano: uint64(testTime.UnixNano()),
+				Count:        6,
+				Sum:          10,
+				Scale:        0,
+				ZeroCount:    1,
+				// Buckets (1, 2] and (2, 4].
+				Positive: &metricspb.ExponentialHistogramDataPoint_Buckets{
+					Offset:       0,
+					BucketCounts: []uint64{2, 3},
+				},
+			}},
+		}},
+	})
+	require.Equal(t, http.StatusOK, serve(t, handler, makeHTTPRequest(t, req, false)))
+
+	var actual []string
+	for _, s := range *written {
+		actual = append(actual, fmt.Sprintf("%s %v", s.tags, s.value))
+	}
+	assert.Equal(t, []string{
+		"__name__: latency, le: 0, service_name: api 1",
+		"__name__: latency, le: 2, service_name: api 3",
+		"__name__: latency, le: 4, service_name: api 6",
+		"__name__: latency, le: +Inf, service_name: api 6",
+		"__name__: latency, le: sum, service_name: api 10",
+	}, actual)
+}
+
+func TestWriteInvalidRequests(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	handler, err := NewWriteHandler(makeOptions(ds))
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(WriteHTTPMethod, WriteURL, bytes.NewReader([]byte("{}")))
+	req.Header.Set("Content-Type", "application/json")
+	assert.Equal(t, http.StatusUnsupportedMediaType, serve(t, handler, req))
+
+	req = httptest.NewRequest(WriteHTTPMethod, WriteURL, bytes.NewReader([]byte("invalid")))
+	req.Header.Set("Content-Type", protobufContentType)
+	assert.Equal(t, http.StatusBadRequest, serve(t, handler, req))
+
+	// Histograms with mismatched bounds are rejected once the rest of the
+	// request has been written.
+	written := expectWrites(ds)
+	invalid := makeRequest(
+		&metricspb.Metric{
+			Name: "latency",
+			Data: &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				DataPoints: []*metricspb.HistogramDataPoint{{
+					ExplicitBounds: []float64{1},
+					BucketCounts:   []uint64{1},
+				}},
+			}},
+		},
+		&metricspb.Metric{
+			Name: "memory",
+			Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+				DataPoints: []*metricspb.NumberDataPoint{{
+					TimeUnixNano: uint64(testTime.UnixNano()),
+					Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: 1},
+				}},
+			}},
+		},
+	)
+	assert.Equal(t, http.StatusBadRequest, serve(t, handler, makeHTTPRequest(t, invalid, false)))
+	require.Len(t, *written, 1)
+	assert.Equal(t, "__name__: memory, service_name: api", (*written)[0].tags)
+}
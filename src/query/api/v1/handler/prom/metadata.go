@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prom
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+const (
+	// MetadataURL is the url for the metric metadata endpoint.
+	MetadataURL = route.MetadataURL
+
+	metadataMetricParam = "metric"
+	metadataLimitParam  = "limit"
+)
+
+// MetadataHTTPMethods are the HTTP methods for the metric metadata handler.
+var MetadataHTTPMethods = []string{http.MethodGet}
+
+// metadata is taken from prometheus to ensure the metadata endpoint is
+// consistent with prometheus.
+// https://github.com/prometheus/prometheus/blob/4ef8c7c1d8e4/web/api/v1/api.go#L1081
+type metadata struct {
+	Type string `json:"type"`
+	Help string `json:"help"`
+	Unit string `json:"unit"`
+}
+
+type metadataHandler struct {
+	hOpts  options.HandlerOptions
+	logger *zap.Logger
+}
+
+// NewMetadataHandler returns a handler listing the metadata of metric
+// families received via Prometheus remote write.
+func NewMetadataHandler(hOpts options.HandlerOptions) http.Handler {
+	return &metadataHandler{
+		hOpts:  hOpts,
+		logger: hOpts.InstrumentOpts().Logger(),
+	}
+}
+
+func (h *metadataHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	limit := -1
+	if s := r.FormValue(metadataLimitParam); s != "" {
+		var err error
+		if limit, err = strconv.Atoi(s); err != nil {
+			xhttp.WriteError(w, xerrors.NewInvalidParamsError(
+				fmt.Errorf("invalid limit %q: %w", s, err)))
+			return
+		}
+	}
+
+	// NB: metadata is scoped to the tenant that wrote it.
+	var tenant string
+	tenancy := h.hOpts.Config().Tenancy.TenancyOptions()
+	if tag, ok, err := handleroptions.ParseTenant(r, tenancy); err != nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+		return
+	} else if ok {
+		tenant = string(tag.Value)
+	}
+
+	result := make(map[string][]metadata)
+	if store := h.hOpts.MetricMetadataStore(); store != nil && limit != 0 {
+		families, err := store.Metadata(tenant, r.FormValue(metadataMetricParam), limit)
+		if err != nil {
+			h.logger.Error("unable to fetch metric metadata", zap.Error(err))
+			xhttp.WriteError(w, err)
+			return
+		}
+
+		for _, m := range families {
+			result[m.MetricFamilyName] = []metadata{{
+				Type: metricTypeString(m.Type),
+				Help: m.Help,
+				Unit: m.Unit,
+			}}
+		}
+	}
+
+	if err := Respond(w, result, nil); err != nil {
+		h.logger.Error("error writing metadata response", zap.Error(err))
+	}
+}
+
+// metricTypeString returns the Prometheus text format name of a metric type.
+func metricTypeString(t prompb.MetricType) string {
+	switch t {
+	case prompb.MetricType_COUNTER:
+		return "counter"
+	case prompb.MetricType_GAUGE:
+		return "gauge"
+	case prompb.MetricType_HISTOGRAM:
+		return "histogram"
+	case prompb.MetricType_GAUGE_HISTOGRAM:
+		return "gaugehistogram"
+	case prompb.MetricType_SUMMARY:
+		return "summary"
+	case prompb.MetricType_INFO:
+		return "info"
+	case prompb.MetricType_STATESET:
+		return "stateset"
+	default:
+		return "unknown"
+	}
+}
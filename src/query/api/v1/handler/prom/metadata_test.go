@@ -0,0 +1,189 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prom
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/x/headers"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+type testMetricMetadataStore struct {
+	tenant   string
+	metadata []prompb.MetricMetadata
+}
+
+func (s *testMetricMetadataStore) Write(string, []prompb.MetricMetadata) error { return nil }
+
+func (s *testMetricMetadataStore) Metadata(
+	tenant, metric string,
+	limit int,
+) ([]prompb.MetricMetadata, error) {
+	var result []prompb.MetricMetadata
+	if tenant != s.tenant {
+		return result, nil
+	}
+	for _, m := range s.metadata {
+		if metric != "" && m.MetricFamilyName != metric {
+			continue
+		}
+		if limit > 0 && len(result) == limit {
+			break
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (s *testMetricMetadataStore) Close() {}
+
+func newTestMetadataHandler() http.Handler {
+	return newTestMetadataHandlerWithConfig(config.Configuration{}, "")
+}
+
+func newTestMetadataHandlerWithConfig(
+	cfg config.Configuration,
+	tenant string,
+) http.Handler {
+	store := &testMetricMetadataStore{
+		tenant: tenant,
+		metadata: []prompb.MetricMetadata{
+			{
+				Type:             prompb.MetricType_COUNTER,
+				MetricFamilyName: "http_requests_total",
+				Help:             "Total HTTP requests.",
+			},
+			{
+				Type:             prompb.MetricType_GAUGE,
+				MetricFamilyName: "memory_bytes",
+				Help:             "Memory in use.",
+				Unit:             "bytes",
+			},
+		},
+	}
+	hOpts := options.EmptyHandlerOptions().
+		SetInstrumentOpts(instrument.NewOptions()).
+		SetMetricMetadataStore(store).
+		SetConfig(cfg)
+	return NewMetadataHandler(hOpts)
+}
+
+func TestMetadataHandler(t *testing.T) {
+	handler := newTestMetadataHandler()
+
+	req := httptest.NewRequest(http.MethodGet, MetadataURL, nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	expected := `{
+		"status": "success",
+		"data": {
+			"http_requests_total": [{
+				"type": "counter",
+				"help": "Total HTTP requests.",
+				"unit": ""
+			}],
+			"memory_bytes": [{
+				"type": "gauge",
+				"help": "Memory in use.",
+				"unit": "bytes"
+			}]
+		}
+	}`
+	assert.JSONEq(t, expected, recorder.Body.String())
+}
+
+func TestMetadataHandlerParams(t *testing.T) {
+	handler := newTestMetadataHandler()
+
+	req := httptest.NewRequest(http.MethodGet, MetadataURL+"?metric=memory_bytes", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	assert.NotContains(t, recorder.Body.String(), `"http_requests_total"`)
+	assert.Contains(t, recorder.Body.String(), `"memory_bytes"`)
+
+	req = httptest.NewRequest(http.MethodGet, MetadataURL+"?limit=1", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	assert.Contains(t, recorder.Body.String(), `"http_requests_total"`)
+	assert.NotContains(t, recorder.Body.String(), `"memory_bytes"`)
+
+	req = httptest.NewRequest(http.MethodGet, MetadataURL+"?limit=0", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	assert.JSONEq(t, `{"status":"success","data":{}}`, recorder.Body.String())
+
+	req = httptest.NewRequest(http.MethodGet, MetadataURL+"?limit=foo", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestMetadataHandlerTenant(t *testing.T) {
+	handler := newTestMetadataHandlerWithConfig(config.Configuration{
+		Tenancy: config.TenancyConfiguration{Enabled: true},
+	}, "foo")
+
+	// Requests must specify a tenant.
+	req := httptest.NewRequest(http.MethodGet, MetadataURL, nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	req = httptest.NewRequest(http.MethodGet, MetadataURL, nil)
+	req.Header.Set(headers.TenantHeader, "bar")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	assert.JSONEq(t, `{"status":"success","data":{}}`, recorder.Body.String())
+
+	req = httptest.NewRequest(http.MethodGet, MetadataURL, nil)
+	req.Header.Set(headers.TenantHeader, "foo")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	assert.Contains(t, recorder.Body.String(), `"memory_bytes"`)
+}
+
+func TestMetadataHandlerNoStore(t *testing.T) {
+	hOpts := options.EmptyHandlerOptions().
+		SetInstrumentOpts(instrument.NewOptions())
+	handler := NewMetadataHandler(hOpts)
+
+	req := httptest.NewRequest(http.MethodGet, MetadataURL, nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"status":"success","data":{}}`, recorder.Body.String())
+}
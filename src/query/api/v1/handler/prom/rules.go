@@ -0,0 +1,248 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prom
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/rules"
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+const (
+	// RulesURL is the url for the rules endpoint.
+	RulesURL = route.RulesURL
+
+	// AlertsURL is the url for the alerts endpoint.
+	AlertsURL = route.AlertsURL
+
+	ruleTypeParam  = "type"
+	ruleTypeAlert  = "alert"
+	ruleTypeRecord = "record"
+)
+
+var (
+	// RulesHTTPMethods are the HTTP methods for the rules handler.
+	RulesHTTPMethods = []string{http.MethodGet}
+
+	// AlertsHTTPMethods are the HTTP methods for the alerts handler.
+	AlertsHTTPMethods = []string{http.MethodGet}
+)
+
+// The following types are taken from prometheus to ensure the rules and
+// alerts endpoints are consistent with prometheus.
+// https://github.com/prometheus/prometheus/blob/4ef8c7c1d8e4/web/api/v1/api.go#L1037
+
+type alertDiscovery struct {
+	Alerts []*alert `json:"alerts"`
+}
+
+type alert struct {
+	Labels      labels.Labels `json:"labels"`
+	Annotations labels.Labels `json:"annotations"`
+	State       string        `json:"state"`
+	ActiveAt    *time.Time    `json:"activeAt,omitempty"`
+	Value       string        `json:"value"`
+}
+
+type ruleDiscovery struct {
+	RuleGroups []*ruleGroup `json:"groups"`
+}
+
+type ruleGroup struct {
+	Name string `json:"name"`
+	File string `json:"file"`
+	// Rules is either alertingRule or recordingRule.
+	Rules          []interface{} `json:"rules"`
+	Interval       float64       `json:"interval"`
+	EvaluationTime float64       `json:"evaluationTime"`
+	LastEvaluation time.Time     `json:"lastEvaluation"`
+}
+
+type alertingRule struct {
+	State          string           `json:"state"`
+	Name           string           `json:"name"`
+	Query          string           `json:"query"`
+	Duration       float64          `json:"duration"`
+	Labels         labels.Labels    `json:"labels"`
+	Annotations    labels.Labels    `json:"annotations"`
+	Alerts         []*alert         `json:"alerts"`
+	Health         rules.RuleHealth `json:"health"`
+	LastError      string           `json:"lastError,omitempty"`
+	EvaluationTime float64          `json:"evaluationTime"`
+	LastEvaluation time.Time        `json:"lastEvaluation"`
+	Type           string           `json:"type"`
+}
+
+type recordingRule struct {
+	Name           string           `json:"name"`
+	Query          string           `json:"query"`
+	Labels         labels.Labels    `json:"labels,omitempty"`
+	Health         rules.RuleHealth `json:"health"`
+	LastError      string           `json:"lastError,omitempty"`
+	EvaluationTime float64          `json:"evaluationTime"`
+	LastEvaluation time.Time        `json:"lastEvaluation"`
+	Type           string           `json:"type"`
+}
+
+type rulesHandler struct {
+	hOpts  options.HandlerOptions
+	logger *zap.Logger
+}
+
+// NewRulesHandler returns a handler listing the rule groups evaluated by
+// the coordinator and the state of their rules.
+func NewRulesHandler(hOpts options.HandlerOptions) http.Handler {
+	return &rulesHandler{
+		hOpts:  hOpts,
+		logger: hOpts.InstrumentOpts().Logger(),
+	}
+}
+
+func (h *rulesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	typ := r.FormValue(ruleTypeParam)
+	if typ != "" && typ != ruleTypeAlert && typ != ruleTypeRecord {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(
+			fmt.Errorf("invalid rule type %q", typ)))
+		return
+	}
+
+	var (
+		returnAlerts    = typ == "" || typ == ruleTypeAlert
+		returnRecording = typ == "" || typ == ruleTypeRecord
+		result          = &ruleDiscovery{RuleGroups: []*ruleGroup{}}
+	)
+	if manager := h.hOpts.RulesManager(); manager != nil {
+		for _, group := range manager.RuleGroups() {
+			g := &ruleGroup{
+				Name:           group.Name(),
+				File:           group.File(),
+				Rules:          []interface{}{},
+				Interval:       group.Interval().Seconds(),
+				EvaluationTime: group.GetEvaluationTime().Seconds(),
+				LastEvaluation: group.GetLastEvaluation(),
+			}
+			for _, rule := range group.Rules() {
+				var lastError string
+				if err := rule.LastError(); err != nil {
+					lastError = err.Error()
+				}
+
+				switch rule := rule.(type) {
+				case *rules.AlertingRule:
+					if !returnAlerts {
+						continue
+					}
+					g.Rules = append(g.Rules, alertingRule{
+						State:          rule.State().String(),
+						Name:           rule.Name(),
+						Query:          rule.Query().String(),
+						Duration:       rule.HoldDuration().Seconds(),
+						Labels:         rule.Labels(),
+						Annotations:    rule.Annotations(),
+						Alerts:         rulesAlertsToAPIAlerts(rule.ActiveAlerts()),
+						Health:         rule.Health(),
+						LastError:      lastError,
+						EvaluationTime: rule.GetEvaluationDuration().Seconds(),
+						LastEvaluation: rule.GetEvaluationTimestamp(),
+						Type:           "alerting",
+					})
+				case *rules.RecordingRule:
+					if !returnRecording {
+						continue
+					}
+					g.Rules = append(g.Rules, recordingRule{
+						Name:           rule.Name(),
+						Query:          rule.Query().String(),
+						Labels:         rule.Labels(),
+						Health:         rule.Health(),
+						LastError:      lastError,
+						EvaluationTime: rule.GetEvaluationDuration().Seconds(),
+						LastEvaluation: rule.GetEvaluationTimestamp(),
+						Type:           "recording",
+					})
+				default:
+					xhttp.WriteError(w, fmt.Errorf("rule %q: unsupported type %T",
+						rule.Name(), rule))
+					return
+				}
+			}
+			result.RuleGroups = append(result.RuleGroups, g)
+		}
+	}
+
+	if err := Respond(w, result, nil); err != nil {
+		h.logger.Error("error writing rules response", zap.Error(err))
+	}
+}
+
+type alertsHandler struct {
+	hOpts  options.HandlerOptions
+	logger *zap.Logger
+}
+
+// NewAlertsHandler returns a handler listing the active alerts of the
+// alerting rules evaluated by the coordinator.
+func NewAlertsHandler(hOpts options.HandlerOptions) http.Handler {
+	return &alertsHandler{
+		hOpts:  hOpts,
+		logger: hOpts.InstrumentOpts().Logger(),
+	}
+}
+
+func (h *alertsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	result := &alertDiscovery{Alerts: []*alert{}}
+	if manager := h.hOpts.RulesManager(); manager != nil {
+		for _, rule := range manager.AlertingRules() {
+			result.Alerts = append(result.Alerts,
+				rulesAlertsToAPIAlerts(rule.ActiveAlerts())...)
+		}
+	}
+
+	if err := Respond(w, result, nil); err != nil {
+		h.logger.Error("error writing alerts response", zap.Error(err))
+	}
+}
+
+func rulesAlertsToAPIAlerts(rulesAlerts []*rules.Alert) []*alert {
+	apiAlerts := make([]*alert, 0, len(rulesAlerts))
+	for _, ruleAlert := range rulesAlerts {
+		activeAt := ruleAlert.ActiveAt
+		apiAlerts = append(apiAlerts, &alert{
+			Labels:      ruleAlert.Labels,
+			Annotations: ruleAlert.Annotations,
+			State:       ruleAlert.State.String(),
+			ActiveAt:    &activeAt,
+			Value:       strconv.FormatFloat(ruleAlert.Value, 'e', -1, 64),
+		})
+	}
+
+	return apiAlerts
+}
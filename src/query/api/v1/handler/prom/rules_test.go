@@ -0,0 +1,203 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prom
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/rules"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+type testRulesManager struct {
+	groups []*rules.Group
+}
+
+func (m *testRulesManager) Start() error { return nil }
+
+func (m *testRulesManager) RuleGroups() []*rules.Group { return m.groups }
+
+func (m *testRulesManager) AlertingRules() []*rules.AlertingRule {
+	var result []*rules.AlertingRule
+	for _, g := range m.groups {
+		result = append(result, g.AlertingRules()...)
+	}
+	return result
+}
+
+func (m *testRulesManager) Close() error { return nil }
+
+func newTestRulesManager(t *testing.T) *testRulesManager {
+	recordExpr, err := parser.ParseExpr("sum(up)")
+	require.NoError(t, err)
+	alertExpr, err := parser.ParseExpr("up == 0")
+	require.NoError(t, err)
+
+	recording := rules.NewRecordingRule("job:up:sum", recordExpr,
+		labels.FromStrings("team", "m3"))
+	alerting := rules.NewAlertingRule("InstanceDown", alertExpr, 0,
+		labels.FromStrings("severity", "page"),
+		labels.FromStrings("summary", "instance down"),
+		nil, "", true, log.NewNopLogger())
+
+	// Evaluate the alerting rule so that it has an active alert.
+	ts := time.Unix(1000, 0)
+	queryFn := func(context.Context, string, time.Time) (promql.Vector, error) {
+		return promql.Vector{
+			{
+				Point:  promql.Point{T: ts.UnixNano() / int64(time.Millisecond), V: 0},
+				Metric: labels.FromStrings("__name__", "up", "instance", "a"),
+			},
+		}, nil
+	}
+	_, err = alerting.Eval(context.Background(), ts, queryFn, &url.URL{}, 0)
+	require.NoError(t, err)
+
+	group := rules.NewGroup(rules.GroupOptions{
+		Name:     "group",
+		File:     "rules.yml",
+		Interval: time.Minute,
+		Rules:    []rules.Rule{recording, alerting},
+		Opts:     &rules.ManagerOptions{},
+	})
+	return &testRulesManager{groups: []*rules.Group{group}}
+}
+
+func newTestRulesHandlerOptions(manager *testRulesManager) options.HandlerOptions {
+	hOpts := options.EmptyHandlerOptions().
+		SetInstrumentOpts(instrument.NewOptions())
+	if manager != nil {
+		hOpts = hOpts.SetRulesManager(manager)
+	}
+	return hOpts
+}
+
+func TestRulesHandler(t *testing.T) {
+	handler := NewRulesHandler(newTestRulesHandlerOptions(newTestRulesManager(t)))
+
+	req := httptest.NewRequest(http.MethodGet, RulesURL, nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	expected := `{
+		"status": "success",
+		"data": {
+			"groups": [{
+				"name": "group",
+				"file": "rules.yml",
+				"interval": 60,
+				"evaluationTime": 0,
+				"lastEvaluation": "0001-01-01T00:00:00Z",
+				"rules": [{
+					"name": "job:up:sum",
+					"query": "sum(up)",
+					"labels": {"team": "m3"},
+					"health": "unknown",
+					"evaluationTime": 0,
+					"lastEvaluation": "0001-01-01T00:00:00Z",
+					"type": "recording"
+				}, {
+					"state": "firing",
+					"name": "InstanceDown",
+					"query": "up == 0",
+					"duration": 0,
+					"labels": {"severity": "page"},
+					"annotations": {"summary": "instance down"},
+					"alerts": [{
+						"labels": {"alertname": "InstanceDown", "instance": "a", "severity": "page"},
+						"annotations": {"summary": "instance down"},
+						"state": "firing",
+						"activeAt": "` + time.Unix(1000, 0).Format(time.RFC3339Nano) + `",
+						"value": "0e+00"
+					}],
+					"health": "unknown",
+					"evaluationTime": 0,
+					"lastEvaluation": "0001-01-01T00:00:00Z",
+					"type": "alerting"
+				}]
+			}]
+		}
+	}`
+	assert.JSONEq(t, expected, recorder.Body.String())
+}
+
+func TestRulesHandlerFilterByType(t *testing.T) {
+	handler := NewRulesHandler(newTestRulesHandlerOptions(newTestRulesManager(t)))
+
+	req := httptest.NewRequest(http.MethodGet, RulesURL+"?type=record", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	assert.Contains(t, recorder.Body.String(), `"job:up:sum"`)
+	assert.NotContains(t, recorder.Body.String(), `"InstanceDown"`)
+
+	req = httptest.NewRequest(http.MethodGet, RulesURL+"?type=unknown", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestRulesHandlerNoRules(t *testing.T) {
+	handler := NewRulesHandler(newTestRulesHandlerOptions(nil))
+
+	req := httptest.NewRequest(http.MethodGet, RulesURL, nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"status":"success","data":{"groups":[]}}`, recorder.Body.String())
+}
+
+func TestAlertsHandler(t *testing.T) {
+	handler := NewAlertsHandler(newTestRulesHandlerOptions(newTestRulesManager(t)))
+
+	req := httptest.NewRequest(http.MethodGet, AlertsURL, nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	expected := `{
+		"status": "success",
+		"data": {
+			"alerts": [{
+				"labels": {"alertname": "InstanceDown", "instance": "a", "severity": "page"},
+				"annotations": {"summary": "instance down"},
+				"state": "firing",
+				"activeAt": "` + time.Unix(1000, 0).Format(time.RFC3339Nano) + `",
+				"value": "0e+00"
+			}]
+		}
+	}`
+	assert.JSONEq(t, expected, recorder.Body.String())
+}
@@ -77,6 +77,7 @@ type FetchOptionsBuilder interface {
 type FetchOptionsBuilderOptions struct {
 	Limits        FetchOptionsBuilderLimitsOptions
 	RestrictByTag *storage.RestrictByTag
+	Tenancy       TenancyOptions
 	Timeout       time.Duration
 }
 
@@ -85,6 +86,9 @@ func (o FetchOptionsBuilderOptions) Validate() error {
 	if o.Limits.InstanceMultiple < 0 || (o.Limits.InstanceMultiple > 0 && o.Limits.InstanceMultiple < 1) {
 		return fmt.Errorf("InstanceMultiple must be 0 or >= 1: %v", o.Limits.InstanceMultiple)
 	}
+	if err := o.Tenancy.Validate(); err != nil {
+		return err
+	}
 	return validateTimeout(o.Timeout)
 }
 
@@ -491,6 +495,20 @@ func (b fetchOptionsBuilder) newFetchOptions(
 		fetchOpts.RestrictQueryOptions.RestrictByTag = defaultTagOpts
 	}
 
+	// NB: the tenant restriction is applied last so that it can not be
+	// overridden by the restrict by tags header.
+	if tenant, ok, err := ParseTenant(req, b.opts.Tenancy); err != nil {
+		return nil, nil, err
+	} else if ok {
+		fetchOpts.RestrictQueryOptions = newOrExistingRestrictQueryOptions(fetchOpts)
+		tagOpts, err := fetchOpts.RestrictQueryOptions.RestrictByTag.WithTenant(
+			tenant.Name, tenant.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+		fetchOpts.RestrictQueryOptions.RestrictByTag = tagOpts
+	}
+
 	if restrict := fetchOpts.RestrictQueryOptions; restrict != nil {
 		if err := restrict.Validate(); err != nil {
 			err = fmt.Errorf(
@@ -65,6 +65,7 @@ func TestFetchOptionsBuilder(t *testing.T) {
 		expectedLookback                      *expectedLookback
 		expectedReadConsistencyLevel          *topology.ReadConsistencyLevel
 		expectedIterateEqualTimestampStrategy *encoding.IterateEqualTimestampStrategy
+		tenancy                               TenancyOptions
 		expectedErr                           bool
 	}{
 		{
@@ -244,6 +245,49 @@ func TestFetchOptionsBuilder(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "tenancy without tenant header",
+			tenancy:     TenancyOptions{Enabled: true, TagName: []byte("tenant")},
+			headers:     map[string]string{},
+			expectedErr: true,
+		},
+		{
+			name:    "tenancy with tenant header",
+			tenancy: TenancyOptions{Enabled: true, TagName: []byte("tenant")},
+			headers: map[string]string{
+				headers.TenantHeader: "foo",
+			},
+			expectedRestrict: &storage.RestrictQueryOptions{
+				RestrictByTag: &storage.RestrictByTag{
+					Restrict: models.Matchers{
+						mustMatcher("tenant", "foo", models.MatchEqual),
+					},
+				},
+			},
+		},
+		{
+			name:    "tenancy can not be overridden by restrict by tags json header",
+			tenancy: TenancyOptions{Enabled: true, TagName: []byte("tenant")},
+			headers: map[string]string{
+				headers.TenantHeader: "foo",
+				headers.RestrictByTagsJSONHeader: stripSpace(`{
+					"match":[
+						{"name":"tenant", "value":"bar", "type":"EQUAL"},
+						{"name":"qux", "value":"qaz", "type":"EQUAL"}
+					],
+					"strip":["qux"]
+				}`),
+			},
+			expectedRestrict: &storage.RestrictQueryOptions{
+				RestrictByTag: &storage.RestrictByTag{
+					Restrict: models.Matchers{
+						mustMatcher("qux", "qaz", models.MatchEqual),
+						mustMatcher("tenant", "foo", models.MatchEqual),
+					},
+					Strip: toStrip("qux"),
+				},
+			},
+		},
 		{
 			name: "restrict by policies with metrics type",
 			headers: map[string]string{
@@ -324,6 +368,7 @@ func TestFetchOptionsBuilder(t *testing.T) {
 					SeriesLimit: test.defaultLimit,
 				},
 				RestrictByTag: test.defaultRestrictByTag,
+				Tenancy:       test.tenancy,
 				Timeout:       10 * time.Second,
 			})
 			require.NoError(t, err)
@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package handleroptions
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/headers"
+)
+
+// DefaultTenantTagName is the default name of the tag tenants are stored in.
+const DefaultTenantTagName = "tenant"
+
+var errTenantTagNameEmpty = errors.New("tenant tag name must be set when tenancy is enabled")
+
+// TenancyOptions are the options for tenant isolation of requests.
+type TenancyOptions struct {
+	// Enabled requires requests to specify a tenant.
+	Enabled bool
+	// TagName is the name of the tag the tenant is stored in.
+	TagName []byte
+}
+
+// Validate validates the tenancy options.
+func (o TenancyOptions) Validate() error {
+	if o.Enabled && len(o.TagName) == 0 {
+		return errTenantTagNameEmpty
+	}
+	return nil
+}
+
+// ParseTenant parses the tenant of a request from the tenant header, returning
+// false if tenancy is disabled and an error if tenancy is enabled but the
+// request does not specify a tenant.
+func ParseTenant(req *http.Request, opts TenancyOptions) (models.Tag, bool, error) {
+	if !opts.Enabled {
+		return models.Tag{}, false, nil
+	}
+
+	tenant := strings.TrimSpace(req.Header.Get(headers.TenantHeader))
+	if tenant == "" {
+		return models.Tag{}, false, fmt.Errorf("missing required %s header",
+			headers.TenantHeader)
+	}
+
+	return models.Tag{Name: opts.TagName, Value: []byte(tenant)}, true, nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser/promql"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	// DeleteSeriesURL is the url for deleting series.
+	DeleteSeriesURL = route.Prefix + "/admin/tsdb/delete_series"
+)
+
+var (
+	// DeleteSeriesHTTPMethods are the HTTP methods for this handler.
+	DeleteSeriesHTTPMethods = []string{http.MethodPost, http.MethodPut}
+
+	errDeleteNotSupported = errors.New("session does not support deleting series")
+	errNoClusters         = errors.New("no m3db clusters configured")
+)
+
+// DeleteSeriesHandler represents a handler for the delete series endpoint,
+// it deletes datapoints within the requested time range for all series
+// matching any of the given selectors across all cluster namespaces.
+type DeleteSeriesHandler struct {
+	clusters       m3.Clusters
+	parseOpts      promql.ParseOptions
+	tagOpts        models.TagOptions
+	instrumentOpts instrument.Options
+}
+
+// NewDeleteSeriesHandler returns a new instance of handler.
+func NewDeleteSeriesHandler(opts options.HandlerOptions) http.Handler {
+	return &DeleteSeriesHandler{
+		clusters:       opts.Clusters(),
+		parseOpts:      promql.NewParseOptions().SetNowFn(opts.NowFn()),
+		tagOpts:        opts.TagOptions(),
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+func (h *DeleteSeriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := logging.WithContext(r.Context(), h.instrumentOpts)
+
+	queries, err := prometheus.ParseSeriesMatchQuery(r, h.parseOpts, h.tagOpts)
+	if err != nil {
+		logger.Error("unable to parse delete series request", zap.Error(err))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	if h.clusters == nil {
+		xhttp.WriteError(w, errNoClusters)
+		return
+	}
+
+	var deleted int64
+	for _, query := range queries {
+		n, err := h.deleteSeries(query)
+		if err != nil {
+			logger.Error("unable to delete series",
+				zap.String("query", query.Raw), zap.Error(err))
+			xhttp.WriteError(w, err)
+			return
+		}
+		deleted += n
+	}
+
+	logger.Info("deleted series",
+		zap.Int("queries", len(queries)),
+		zap.Int64("numSeries", deleted))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *DeleteSeriesHandler) deleteSeries(query *storage.FetchQuery) (int64, error) {
+	q, err := storage.FetchQueryToM3Query(query, nil)
+	if err != nil {
+		return 0, xerrors.NewInvalidParamsError(err)
+	}
+
+	var (
+		start   = xtime.ToUnixNano(query.Start)
+		end     = xtime.ToUnixNano(query.End)
+		deleted int64
+	)
+	for _, ns := range h.clusters.ClusterNamespaces() {
+		session, ok := ns.Session().(client.AdminSession)
+		if !ok {
+			return 0, errDeleteNotSupported
+		}
+
+		n, err := session.DeleteTagged(ns.NamespaceID(), q, start, end)
+		if err != nil {
+			return 0, fmt.Errorf("namespace %s: %w", ns.NamespaceID().String(), err)
+		}
+		deleted += n
+	}
+
+	return deleted, nil
+}
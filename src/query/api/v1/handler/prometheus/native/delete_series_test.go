@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestDeleteSeriesHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		start = time.Unix(100, 0)
+		end   = time.Unix(200, 0)
+	)
+
+	session := client.NewMockAdminSession(ctrl)
+	session.EXPECT().
+		DeleteTagged(ident.NewIDMatcher("metrics"), gomock.Any(),
+			xtime.ToUnixNano(start), xtime.ToUnixNano(end)).
+		Return(int64(3), nil)
+
+	ns := m3.NewMockClusterNamespace(ctrl)
+	ns.EXPECT().NamespaceID().Return(ident.StringID("metrics")).AnyTimes()
+	ns.EXPECT().Session().Return(session)
+
+	clusters := m3.NewMockClusters(ctrl)
+	clusters.EXPECT().ClusterNamespaces().Return(m3.ClusterNamespaces{ns})
+
+	opts := options.EmptyHandlerOptions().
+		SetClusters(clusters).
+		SetTagOptions(models.NewTagOptions())
+	handler := NewDeleteSeriesHandler(opts)
+
+	form := url.Values{}
+	form.Add("match[]", `foo{bar="baz"}`)
+	form.Set("start", "100")
+	form.Set("end", "200")
+	req := httptest.NewRequest(http.MethodPost, DeleteSeriesURL, nil)
+	req.URL.RawQuery = form.Encode()
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestDeleteSeriesHandlerMissingMatchers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := options.EmptyHandlerOptions().
+		SetClusters(m3.NewMockClusters(ctrl)).
+		SetTagOptions(models.NewTagOptions())
+	handler := NewDeleteSeriesHandler(opts)
+
+	req := httptest.NewRequest(http.MethodPost, DeleteSeriesURL, nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
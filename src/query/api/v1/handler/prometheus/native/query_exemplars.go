@@ -0,0 +1,201 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	pql "github.com/prometheus/prometheus/promql/parser"
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	"github.com/m3db/m3/src/query/errors"
+	"github.com/m3db/m3/src/query/functions/utils"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser/promql"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/util/json"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+const (
+	// QueryExemplarsURL is the url for the query exemplars endpoint.
+	QueryExemplarsURL = route.QueryExemplarsURL
+)
+
+// QueryExemplarsHTTPMethods are the HTTP methods for this handler.
+var QueryExemplarsHTTPMethods = []string{http.MethodGet, http.MethodPost}
+
+// QueryExemplarsHandler represents a handler for the query exemplars
+// endpoint, it returns the exemplars of all series selected by the query.
+type QueryExemplarsHandler struct {
+	exemplarStorage     storage.ExemplarStorage
+	fetchOptionsBuilder handleroptions.FetchOptionsBuilder
+	parseOpts           promql.ParseOptions
+	instrumentOpts      instrument.Options
+	tagOpts             models.TagOptions
+}
+
+// NewQueryExemplarsHandler returns a new instance of handler.
+func NewQueryExemplarsHandler(opts options.HandlerOptions) http.Handler {
+	return &QueryExemplarsHandler{
+		exemplarStorage:     opts.ExemplarStorage(),
+		fetchOptionsBuilder: opts.FetchOptionsBuilder(),
+		parseOpts:           promql.NewParseOptions().SetNowFn(opts.NowFn()),
+		instrumentOpts:      opts.InstrumentOpts(),
+		tagOpts:             opts.TagOptions(),
+	}
+}
+
+func (h *QueryExemplarsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	ctx, opts, rErr := h.fetchOptionsBuilder.NewFetchOptions(r.Context(), r)
+	if rErr != nil {
+		xhttp.WriteError(w, rErr)
+		return
+	}
+
+	start, end, err := prometheus.ParseStartAndEnd(r, h.parseOpts)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	query := r.FormValue(QueryParam)
+	if query == "" {
+		xhttp.WriteError(w, errors.ErrNoQueryFound)
+		return
+	}
+
+	expr, err := h.parseOpts.ParseFn()(query)
+	if err != nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+		return
+	}
+
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+
+	var (
+		results []storage.SeriesExemplars
+		seen    = make(map[string]struct{})
+	)
+	// NB: Prometheus does not store exemplars unless configured to do so, in
+	// which case it returns no results; do the same when exemplars are not
+	// stored.
+	if h.exemplarStorage != nil {
+		for _, selector := range pql.ExtractSelectors(expr) {
+			matchers, err := promql.LabelMatchersToModelMatcher(selector, h.tagOpts)
+			if err != nil {
+				xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+				return
+			}
+
+			fetchQuery := &storage.FetchQuery{
+				Raw:         query,
+				TagMatchers: matchers,
+				Start:       start,
+				End:         end,
+			}
+
+			series, err := h.exemplarStorage.FetchExemplars(ctx, fetchQuery, opts)
+			if err != nil {
+				logger.Error("unable to fetch exemplars", zap.Error(err))
+				if errors.IsTimeout(err) {
+					err = errors.NewErrQueryTimeout(err)
+				}
+				xhttp.WriteError(w, err)
+				return
+			}
+
+			// Series may be selected by more than one selector of the query.
+			for _, s := range series {
+				id := string(s.Tags.ID())
+				if _, ok := seen[id]; ok {
+					continue
+				}
+
+				seen[id] = struct{}{}
+				results = append(results, s)
+			}
+		}
+	}
+
+	if err := renderExemplarsResultJSON(w, results); err != nil {
+		logger.Error("unable to render exemplars results", zap.Error(err))
+	}
+}
+
+func renderExemplarsResultJSON(w io.Writer, results []storage.SeriesExemplars) error {
+	jw := json.NewWriter(w)
+	jw.BeginObject()
+
+	jw.BeginObjectField("status")
+	jw.WriteString("success")
+
+	jw.BeginObjectField("data")
+	jw.BeginArray()
+
+	for _, series := range results {
+		jw.BeginObject()
+		jw.BeginObjectField("seriesLabels")
+		jw.BeginObject()
+		for _, t := range series.Tags.Tags {
+			jw.BeginObjectBytesField(t.Name)
+			jw.WriteBytesString(t.Value)
+		}
+		jw.EndObject()
+
+		jw.BeginObjectField("exemplars")
+		jw.BeginArray()
+		for _, exemplar := range series.Exemplars {
+			jw.BeginObject()
+			jw.BeginObjectField("labels")
+			jw.BeginObject()
+			for _, t := range exemplar.Labels {
+				jw.BeginObjectBytesField(t.Name)
+				jw.WriteBytesString(t.Value)
+			}
+			jw.EndObject()
+
+			jw.BeginObjectField("value")
+			jw.WriteString(utils.FormatFloat(exemplar.Value))
+
+			jw.BeginObjectField("timestamp")
+			jw.WriteFloat64(float64(exemplar.Timestamp) / float64(time.Second))
+			jw.EndObject()
+		}
+		jw.EndArray()
+		jw.EndObject()
+	}
+
+	jw.EndArray()
+	jw.EndObject()
+	return jw.Close()
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/x/instrument"
+	xjson "github.com/m3db/m3/src/x/json"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type testExemplarStorage struct {
+	queries []*storage.FetchQuery
+	result  []storage.SeriesExemplars
+}
+
+func (s *testExemplarStorage) WriteExemplars(
+	_ context.Context,
+	_ models.Tags,
+	_ []storage.Exemplar,
+) error {
+	return nil
+}
+
+func (s *testExemplarStorage) FetchExemplars(
+	_ context.Context,
+	query *storage.FetchQuery,
+	_ *storage.FetchOptions,
+) ([]storage.SeriesExemplars, error) {
+	s.queries = append(s.queries, query)
+	return s.result, nil
+}
+
+func newTestQueryExemplarsHandler(
+	t *testing.T,
+	exemplarStorage storage.ExemplarStorage,
+) http.Handler {
+	fetchOptsBuilder, err := handleroptions.NewFetchOptionsBuilder(
+		handleroptions.FetchOptionsBuilderOptions{Timeout: 15 * time.Second})
+	require.NoError(t, err)
+
+	opts := options.EmptyHandlerOptions().
+		SetFetchOptionsBuilder(fetchOptsBuilder).
+		SetTagOptions(models.NewTagOptions()).
+		SetInstrumentOpts(instrument.NewOptions()).
+		SetExemplarStorage(exemplarStorage)
+	return NewQueryExemplarsHandler(opts)
+}
+
+func TestQueryExemplars(t *testing.T) {
+	tags := models.NewTags(2, models.NewTagOptions()).
+		AddTag(models.Tag{Name: []byte("__name__"), Value: []byte("foo")}).
+		AddTag(models.Tag{Name: []byte("job"), Value: []byte("bar")})
+	exemplarStorage := &testExemplarStorage{
+		result: []storage.SeriesExemplars{
+			{
+				Tags: tags,
+				Exemplars: []storage.Exemplar{
+					{
+						Labels: []models.Tag{
+							{Name: []byte("trace_id"), Value: []byte("abc")},
+						},
+						Value:     1.5,
+						Timestamp: xtime.UnixNano(1500 * time.Millisecond),
+					},
+				},
+			},
+		},
+	}
+	handler := newTestQueryExemplarsHandler(t, exemplarStorage)
+
+	params := url.Values{}
+	params.Set(QueryParam, `foo or foo{job="bar"}`)
+	params.Set("start", "1")
+	params.Set("end", "2")
+	req := httptest.NewRequest(http.MethodGet, QueryExemplarsURL, nil)
+	req.URL.RawQuery = params.Encode()
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	// Both selectors are fetched but the series is only returned once.
+	require.Len(t, exemplarStorage.queries, 2)
+	assert.Equal(t, time.Unix(1, 0), exemplarStorage.queries[0].Start)
+	assert.Equal(t, time.Unix(2, 0), exemplarStorage.queries[0].End)
+
+	expected := xtest.MustPrettyJSONMap(t, xjson.Map{
+		"status": "success",
+		"data": xjson.Array{
+			xjson.Map{
+				"seriesLabels": xjson.Map{
+					"__name__": "foo",
+					"job":      "bar",
+				},
+				"exemplars": xjson.Array{
+					xjson.Map{
+						"labels":    xjson.Map{"trace_id": "abc"},
+						"value":     "1.5",
+						"timestamp": 1.5,
+					},
+				},
+			},
+		},
+	})
+	actual := xtest.MustPrettyJSONString(t, recorder.Body.String())
+	assert.Equal(t, expected, actual, xtest.Diff(expected, actual))
+}
+
+func TestQueryExemplarsNoStorage(t *testing.T) {
+	handler := newTestQueryExemplarsHandler(t, nil)
+
+	params := url.Values{}
+	params.Set(QueryParam, "foo")
+	req := httptest.NewRequest(http.MethodGet, QueryExemplarsURL, nil)
+	req.URL.RawQuery = params.Encode()
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"status":"success","data":[]}`, recorder.Body.String())
+}
+
+func TestQueryExemplarsMissingQuery(t *testing.T) {
+	handler := newTestQueryExemplarsHandler(t, &testExemplarStorage{})
+
+	req := httptest.NewRequest(http.MethodGet, QueryExemplarsURL, nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -77,6 +77,7 @@ var (
 // promReadHandler represents a handler for prometheus read endpoint.
 type promReadHandler struct {
 	instant         bool
+	parseFn         queryParseFn
 	promReadMetrics promReadMetrics
 	opts            options.HandlerOptions
 }
@@ -98,14 +99,23 @@ func newHandler(opts options.HandlerOptions, instant bool) http.Handler {
 		name = "native-instant-read"
 	}
 
+	return newReadHandler(opts, name, instant, parsePromQLQuery)
+}
+
+func newReadHandler(
+	opts options.HandlerOptions,
+	name string,
+	instant bool,
+	parseFn queryParseFn,
+) *promReadHandler {
 	taggedScope := opts.InstrumentOpts().MetricsScope().
 		Tagged(map[string]string{"handler": name})
-	h := &promReadHandler{
+	return &promReadHandler{
 		promReadMetrics: newPromReadMetrics(taggedScope),
 		opts:            opts,
 		instant:         instant,
+		parseFn:         parseFn,
 	}
-	return h
 }
 
 func (h *promReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -132,7 +142,7 @@ func (h *promReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		zap.Duration("fetchTimeout", parsedOptions.FetchOpts.Timeout),
 	)
 
-	result, err := read(ctx, parsedOptions, h.opts)
+	result, err := read(ctx, parsedOptions, h.opts, h.parseFn)
 	if err != nil {
 		sp := xopentracing.SpanFromContextOrNoop(ctx)
 		sp.LogFields(opentracinglog.Error(err))
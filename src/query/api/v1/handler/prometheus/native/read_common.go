@@ -33,6 +33,7 @@ import (
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
 	"github.com/m3db/m3/src/query/parser/promql"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/ts"
@@ -172,18 +173,33 @@ type ParsedOptions struct {
 	Params    models.RequestParams
 }
 
+// queryParseFn parses the query of a request into a DAG to be executed.
+type queryParseFn func(
+	params models.RequestParams,
+	handlerOpts options.HandlerOptions,
+) (parser.Parser, error)
+
+func parsePromQLQuery(
+	params models.RequestParams,
+	handlerOpts options.HandlerOptions,
+) (parser.Parser, error) {
+	parseOpts := handlerOpts.Engine().Options().ParseOptions()
+	return promql.Parse(params.Query, params.Step,
+		handlerOpts.TagOptions(), parseOpts)
+}
+
 func read(
 	ctx context.Context,
 	parsed ParsedOptions,
 	handlerOpts options.HandlerOptions,
+	parseFn queryParseFn,
 ) (ReadResult, error) {
 	var (
 		opts      = parsed.QueryOpts
 		fetchOpts = parsed.FetchOpts
 		params    = parsed.Params
 
-		tagOpts = handlerOpts.TagOptions()
-		engine  = handlerOpts.Engine()
+		engine = handlerOpts.Engine()
 	)
 	sp := xopentracing.SpanFromContextOrNoop(ctx)
 	sp.LogFields(
@@ -200,13 +216,12 @@ func read(
 	}
 
 	// TODO: Capture timing
-	parseOpts := engine.Options().ParseOptions()
-	parser, err := promql.Parse(params.Query, params.Step, tagOpts, parseOpts)
+	queryParser, err := parseFn(params, handlerOpts)
 	if err != nil {
 		return emptyResult, xerrors.NewInvalidParamsError(err)
 	}
 
-	bl, err := engine.ExecuteExpr(ctx, parser, opts, fetchOpts, params)
+	bl, err := engine.ExecuteExpr(ctx, queryParser, opts, fetchOpts, params)
 	if err != nil {
 		return emptyResult, err
 	}
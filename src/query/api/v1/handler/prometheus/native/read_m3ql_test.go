@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/test"
+)
+
+func TestM3QLReadHandlerRead(t *testing.T) {
+	values, bounds := test.GenerateValuesAndBounds(nil, nil)
+
+	setup := newTestSetup(t, nil)
+	m3qlRead := NewM3QLReadHandler(setup.options).(*promReadHandler)
+
+	seriesMeta := test.NewSeriesMeta("dummy", len(values))
+	m := block.Metadata{
+		Bounds:         bounds,
+		Tags:           models.NewTags(0, models.NewTagOptions()),
+		ResultMetadata: block.NewResultMetadata(),
+	}
+
+	b := test.NewBlockFromValuesWithMetaAndSeriesMeta(m, seriesMeta, values)
+	setup.Storage.SetFetchBlocksResult(block.Result{Blocks: []block.Block{b}}, nil)
+
+	params := defaultParams()
+	params.Set(QueryParam, "fetch name:dummy | abs")
+	req, _ := http.NewRequest("GET", M3QLReadURL, nil)
+	req.URL.RawQuery = params.Encode()
+
+	r, err := testParseParams(req)
+	require.NoError(t, err)
+	parsed := ParsedOptions{
+		QueryOpts: setup.QueryOpts,
+		FetchOpts: setup.FetchOpts,
+		Params:    r,
+	}
+
+	result, err := read(req.Context(), parsed, m3qlRead.opts, m3qlRead.parseFn)
+	require.NoError(t, err)
+	require.Len(t, result.Series, 2)
+
+	s := result.Series[0]
+	assert.Equal(t, 5, s.Values().Len())
+	for i := 0; i < s.Values().Len(); i++ {
+		assert.Equal(t, float64(i), s.Values().ValueAt(i))
+	}
+}
+
+func TestM3QLReadHandlerInvalidQuery(t *testing.T) {
+	setup := newTestSetup(t, nil)
+	handler := NewM3QLReadHandler(setup.options)
+
+	params := defaultParams()
+	params.Set(QueryParam, "sum | abs")
+	req := httptest.NewRequest("GET", M3QLReadURL, nil)
+	req.URL.RawQuery = params.Encode()
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -115,7 +115,7 @@ func TestPromReadHandlerWithTimeout(t *testing.T) {
 		Params:    r,
 	}
 
-	_, err := read(ctx, parsed, promRead.opts)
+	_, err := read(ctx, parsed, promRead.opts, promRead.parseFn)
 	require.Error(t, err)
 	require.Equal(t,
 		"context deadline exceeded",
@@ -153,7 +153,7 @@ func testPromReadHandlerRead(t *testing.T, resultMeta block.ResultMetadata) {
 		Params:    r,
 	}
 
-	result, err := read(ctx, parsed, promRead.opts)
+	result, err := read(ctx, parsed, promRead.opts, promRead.parseFn)
 	require.NoError(t, err)
 	seriesList := result.Series
 
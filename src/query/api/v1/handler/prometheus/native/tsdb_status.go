@@ -0,0 +1,199 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	goerrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/api/v1/route"
+	"github.com/m3db/m3/src/query/errors"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/util"
+	"github.com/m3db/m3/src/query/util/json"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+const (
+	// TSDBStatusURL is the url for the TSDB status endpoint.
+	TSDBStatusURL = route.TSDBStatusURL
+
+	tsdbStatusLimitParam   = "limit"
+	defaultTSDBStatusLimit = 10
+)
+
+// TSDBStatusHTTPMethods are the HTTP methods for this handler.
+var TSDBStatusHTTPMethods = []string{http.MethodGet}
+
+var errCardinalityStorageNotAvailable = xhttp.NewError(
+	goerrors.New("cardinality statistics are not available"),
+	http.StatusNotImplemented)
+
+// TSDBStatusHandler represents a handler for the TSDB status endpoint, it
+// returns the cardinality statistics of the series stored in the
+// unaggregated namespace in the format of the Prometheus TSDB status endpoint.
+type TSDBStatusHandler struct {
+	cardinalityStorage  storage.CardinalityStorage
+	fetchOptionsBuilder handleroptions.FetchOptionsBuilder
+	instrumentOpts      instrument.Options
+	tagOpts             models.TagOptions
+	nowFn               func() time.Time
+}
+
+// NewTSDBStatusHandler returns a new instance of handler.
+func NewTSDBStatusHandler(opts options.HandlerOptions) http.Handler {
+	return &TSDBStatusHandler{
+		cardinalityStorage:  opts.CardinalityStorage(),
+		fetchOptionsBuilder: opts.FetchOptionsBuilder(),
+		instrumentOpts:      opts.InstrumentOpts(),
+		tagOpts:             opts.TagOptions(),
+		nowFn:               opts.NowFn(),
+	}
+}
+
+func (h *TSDBStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	if h.cardinalityStorage == nil {
+		xhttp.WriteError(w, errCardinalityStorageNotAvailable)
+		return
+	}
+
+	ctx, opts, rErr := h.fetchOptionsBuilder.NewFetchOptions(r.Context(), r)
+	if rErr != nil {
+		xhttp.WriteError(w, rErr)
+		return
+	}
+
+	limit := defaultTSDBStatusLimit
+	if v := r.FormValue(tsdbStatusLimitParam); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 0 {
+			xhttp.WriteError(w, xerrors.NewInvalidParamsError(
+				fmt.Errorf("invalid limit: %s", v)))
+			return
+		}
+		limit = parsed
+	}
+
+	// NB: by default only the series recently written are counted, similar
+	// to the head of a Prometheus TSDB.
+	now := h.nowFn()
+	start, err := util.ParseTimeStringWithDefault(r.FormValue("start"), now)
+	if err != nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+		return
+	}
+	end, err := util.ParseTimeStringWithDefault(r.FormValue("end"), now)
+	if err != nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+		return
+	}
+	if start.After(end) {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(
+			fmt.Errorf("start %v must be before end %v", start, end)))
+		return
+	}
+
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+
+	fetchQuery := &storage.FetchQuery{
+		Start: start,
+		End:   end,
+	}
+	results, err := h.cardinalityStorage.FetchCardinality(ctx, fetchQuery, opts, limit)
+	if err != nil {
+		logger.Error("unable to fetch cardinality", zap.Error(err))
+		if errors.IsTimeout(err) {
+			err = errors.NewErrQueryTimeout(err)
+		}
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	stats := results.Stats(h.tagOpts.MetricName(), limit)
+	if err := renderTSDBStatusResultJSON(w, stats); err != nil {
+		logger.Error("unable to render tsdb status results", zap.Error(err))
+	}
+}
+
+func renderTSDBStatusResultJSON(w io.Writer, stats index.CardinalityStats) error {
+	jw := json.NewWriter(w)
+	jw.BeginObject()
+
+	jw.BeginObjectField("status")
+	jw.WriteString("success")
+
+	jw.BeginObjectField("data")
+	jw.BeginObject()
+
+	jw.BeginObjectField("headStats")
+	jw.BeginObject()
+	jw.BeginObjectField("numSeries")
+	jw.WriteInt(stats.NumSeries)
+	jw.BeginObjectField("numLabelPairs")
+	jw.WriteInt(stats.NumLabelPairs)
+	jw.EndObject()
+
+	renderTSDBStatusStatsJSON(jw, "seriesCountByMetricName",
+		stats.SeriesCountByMetricName)
+	renderTSDBStatusStatsJSON(jw, "labelValueCountByLabelName",
+		stats.LabelValueCountByLabelName)
+	renderTSDBStatusStatsJSON(jw, "memoryInBytesByLabelName",
+		stats.MemoryInBytesByLabelName)
+	renderTSDBStatusStatsJSON(jw, "seriesCountByLabelValuePair",
+		stats.SeriesCountByLabelValuePair)
+
+	jw.EndObject()
+	jw.EndObject()
+	return jw.Close()
+}
+
+func renderTSDBStatusStatsJSON(
+	jw json.Writer,
+	field string,
+	stats []index.CardinalityStat,
+) {
+	jw.BeginObjectField(field)
+	jw.BeginArray()
+	for _, stat := range stats {
+		jw.BeginObject()
+		jw.BeginObjectField("name")
+		jw.WriteString(stat.Name)
+		jw.BeginObjectField("value")
+		jw.WriteInt(stat.Value)
+		jw.EndObject()
+	}
+	jw.EndArray()
+}
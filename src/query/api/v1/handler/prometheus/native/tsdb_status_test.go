@@ -0,0 +1,153 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	xjson "github.com/m3db/m3/src/x/json"
+	xtest "github.com/m3db/m3/src/x/test"
+)
+
+type testCardinalityStorage struct {
+	queries []*storage.FetchQuery
+	limits  []int
+	result  index.CardinalityResults
+}
+
+func (s *testCardinalityStorage) FetchCardinality(
+	_ context.Context,
+	query *storage.FetchQuery,
+	_ *storage.FetchOptions,
+	limit int,
+) (index.CardinalityResults, error) {
+	s.queries = append(s.queries, query)
+	s.limits = append(s.limits, limit)
+	return s.result, nil
+}
+
+func newTestTSDBStatusHandler(
+	t *testing.T,
+	cardinalityStorage storage.CardinalityStorage,
+	now time.Time,
+) http.Handler {
+	fetchOptsBuilder, err := handleroptions.NewFetchOptionsBuilder(
+		handleroptions.FetchOptionsBuilderOptions{Timeout: 15 * time.Second})
+	require.NoError(t, err)
+
+	opts := options.EmptyHandlerOptions().
+		SetFetchOptionsBuilder(fetchOptsBuilder).
+		SetTagOptions(models.NewTagOptions()).
+		SetInstrumentOpts(instrument.NewOptions()).
+		SetNowFn(func() time.Time { return now }).
+		SetCardinalityStorage(cardinalityStorage)
+	return NewTSDBStatusHandler(opts)
+}
+
+func TestTSDBStatus(t *testing.T) {
+	results := index.NewCardinalityResults(ident.StringID("ns"))
+	results.AddSeries(3)
+	results.AddTerm([]byte("__name__"), []byte("bar"), 1)
+	results.AddTerm([]byte("__name__"), []byte("foo"), 2)
+	results.AddTerm([]byte("job"), []byte("baz"), 3)
+
+	var (
+		cardinalityStorage = &testCardinalityStorage{result: results}
+		now                = time.Unix(100, 0)
+		handler            = newTestTSDBStatusHandler(t, cardinalityStorage, now)
+	)
+
+	params := url.Values{}
+	params.Set("limit", "1")
+	req := httptest.NewRequest(http.MethodGet, TSDBStatusURL, nil)
+	req.URL.RawQuery = params.Encode()
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	// Only the recently written series are counted by default.
+	require.Len(t, cardinalityStorage.queries, 1)
+	assert.Equal(t, now, cardinalityStorage.queries[0].Start)
+	assert.Equal(t, now, cardinalityStorage.queries[0].End)
+	assert.Equal(t, []int{1}, cardinalityStorage.limits)
+
+	expected := xtest.MustPrettyJSONMap(t, xjson.Map{
+		"status": "success",
+		"data": xjson.Map{
+			"headStats": xjson.Map{
+				"numSeries":     3,
+				"numLabelPairs": 3,
+			},
+			"seriesCountByMetricName": xjson.Array{
+				xjson.Map{"name": "foo", "value": 2},
+			},
+			"labelValueCountByLabelName": xjson.Array{
+				xjson.Map{"name": "__name__", "value": 2},
+			},
+			"memoryInBytesByLabelName": xjson.Array{
+				xjson.Map{"name": "__name__", "value": 9},
+			},
+			"seriesCountByLabelValuePair": xjson.Array{
+				xjson.Map{"name": "job=baz", "value": 3},
+			},
+		},
+	})
+	actual := xtest.MustPrettyJSONString(t, recorder.Body.String())
+	assert.Equal(t, expected, actual, xtest.Diff(expected, actual))
+}
+
+func TestTSDBStatusInvalidLimit(t *testing.T) {
+	handler := newTestTSDBStatusHandler(t, &testCardinalityStorage{}, time.Now())
+
+	params := url.Values{}
+	params.Set("limit", "-1")
+	req := httptest.NewRequest(http.MethodGet, TSDBStatusURL, nil)
+	req.URL.RawQuery = params.Encode()
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestTSDBStatusNoStorage(t *testing.T) {
+	handler := newTestTSDBStatusHandler(t, nil, time.Now())
+
+	req := httptest.NewRequest(http.MethodGet, TSDBStatusURL, nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusNotImplemented, recorder.Code)
+}
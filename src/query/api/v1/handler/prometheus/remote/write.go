@@ -51,6 +51,7 @@ import (
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
+	"github.com/m3db/m3/src/query/storage/metricmetadata"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/util/logging"
 	"github.com/m3db/m3/src/x/clock"
@@ -122,6 +123,9 @@ type PromWriteHandler struct {
 	downsamplerAndWriter   ingest.DownsamplerAndWriter
 	tagOptions             models.TagOptions
 	storeMetricsType       bool
+	exemplarStorage        storage.ExemplarStorage
+	metricMetadataStore    metricmetadata.Store
+	tenancy                handleroptions.TenancyOptions
 	forwarding             handleroptions.PromWriteHandlerForwardingOptions
 	forwardTimeout         time.Duration
 	forwardHTTPClient      *http.Client
@@ -195,6 +199,9 @@ func NewPromWriteHandler(options options.HandlerOptions) (http.Handler, error) {
 		downsamplerAndWriter:   downsamplerAndWriter,
 		tagOptions:             tagOptions,
 		storeMetricsType:       options.StoreMetricsType(),
+		exemplarStorage:        options.ExemplarStorage(),
+		metricMetadataStore:    options.MetricMetadataStore(),
+		tenancy:                options.Config().Tenancy.TenancyOptions(),
 		forwarding:             forwarding,
 		forwardTimeout:         forwardTimeout,
 		forwardHTTPClient:      xhttp.NewHTTPClient(forwardHTTPOpts),
@@ -339,18 +346,19 @@ func (h *PromWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	if batchErr != nil {
 		var (
-			errs                 = batchErr.Errors()
-			lastRegularErr       string
-			lastBadRequestErr    string
-			numRegular           int
-			numBadRequest        int
-			numResourceExhausted int
+			errs                     = batchErr.Errors()
+			lastRegularErr           string
+			lastBadRequestErr        string
+			lastResourceExhaustedErr string
+			numRegular               int
+			numBadRequest            int
+			numResourceExhausted     int
 		)
 		for _, err := range errs {
 			switch {
 			case client.IsResourceExhaustedError(err):
 				numResourceExhausted++
-				lastBadRequestErr = err.Error()
+				lastResourceExhaustedErr = err.Error()
 			case client.IsBadRequestError(err):
 				numBadRequest++
 				lastBadRequestErr = err.Error()
@@ -381,7 +389,8 @@ func (h *PromWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			zap.Int("numRegularErrors", numRegular),
 			zap.Int("numBadRequestErrors", numBadRequest),
 			zap.String("lastRegularError", lastRegularErr),
-			zap.String("lastBadRequestErr", lastBadRequestErr))
+			zap.String("lastBadRequestErr", lastBadRequestErr),
+			zap.String("lastResourceExhaustedErr", lastResourceExhaustedErr))
 
 		var resultErrMessage string
 		if lastRegularErr != "" {
@@ -396,6 +405,17 @@ func (h *PromWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			resultErrMessage = fmt.Sprintf("%s%sbad_request_errors: count=%d, last=%s",
 				resultErrMessage, sep, numBadRequest, lastBadRequestErr)
 		}
+		if lastResourceExhaustedErr != "" {
+			// NB: resource exhausted errors are returned when limits, such as
+			// the series limits of a namespace, are exceeded so include the
+			// error explaining the limit exceeded.
+			var sep string
+			if resultErrMessage != "" {
+				sep = ", "
+			}
+			resultErrMessage = fmt.Sprintf("%s%sresource_exhausted_errors: count=%d, last=%s",
+				resultErrMessage, sep, numResourceExhausted, lastResourceExhaustedErr)
+		}
 
 		resultError := xhttp.NewError(errors.New(resultErrMessage), status)
 		h.metrics.incError(resultError)
@@ -505,6 +525,14 @@ func (h *PromWriteHandler) parseRequest(
 		}
 	}
 
+	// NB: the tenant tag replaces any tag of the same name, including tags
+	// mapped by header, so that the tenant can not be overridden.
+	if tenant, ok, err := handleroptions.ParseTenant(r, h.tenancy); err != nil {
+		return parseRequestResult{}, err
+	} else if ok {
+		opts.TenantTag = &tenant
+	}
+
 	if promType := r.Header.Get(headers.PromTypeHeader); promType != "" {
 		tp, ok := headerToMetricType[strings.ToLower(promType)]
 		if !ok {
@@ -546,7 +574,68 @@ func (h *PromWriteHandler) write(
 		var errs xerrors.MultiError
 		return errs.Add(err)
 	}
-	return h.downsamplerAndWriter.WriteBatch(ctx, iter, opts)
+
+	batchErr := h.downsamplerAndWriter.WriteBatch(ctx, iter, opts)
+	errs := h.writeExemplars(ctx, r, opts)
+	if err := h.writeMetadata(r, opts); err != nil {
+		errs = errs.Add(err)
+	}
+	if errs.Empty() {
+		return batchErr
+	}
+
+	if batchErr != nil {
+		for _, err := range batchErr.Errors() {
+			errs = errs.Add(err)
+		}
+	}
+
+	return errs
+}
+
+func (h *PromWriteHandler) writeExemplars(
+	ctx context.Context,
+	r *prompb.WriteRequest,
+	opts ingest.WriteOptions,
+) xerrors.MultiError {
+	var errs xerrors.MultiError
+	if h.exemplarStorage == nil {
+		// NB: exemplars are dropped if no exemplar storage is configured.
+		return errs
+	}
+
+	for _, promTS := range r.Timeseries {
+		if len(promTS.Exemplars) == 0 {
+			continue
+		}
+
+		tags := storage.PromLabelsToM3Tags(promTS.Labels, h.tagOptions)
+		if opts.TenantTag != nil {
+			tags = tags.AddOrUpdateTag(*opts.TenantTag)
+		}
+		exemplars := storage.PromExemplarsToM3(promTS.Exemplars)
+		if err := h.exemplarStorage.WriteExemplars(ctx, tags, exemplars); err != nil {
+			errs = errs.Add(err)
+		}
+	}
+
+	return errs
+}
+
+func (h *PromWriteHandler) writeMetadata(
+	r *prompb.WriteRequest,
+	opts ingest.WriteOptions,
+) error {
+	if h.metricMetadataStore == nil || len(r.Metadata) == 0 {
+		// NB: metadata is dropped if no metric metadata store is configured.
+		return nil
+	}
+
+	var tenant string
+	if opts.TenantTag != nil {
+		tenant = string(opts.TenantTag.Value)
+	}
+	return h.metricMetadataStore.Write(tenant, r.Metadata)
 }
 
 func (h *PromWriteHandler) forward(
@@ -745,8 +834,30 @@ func newPromTSIter(
 			opts = graphiteTagOpts
 		}
 
+		seriesTags := storage.PromLabelsToM3Tags(promTS.Labels, opts)
+		if len(promTS.Histograms) > 0 {
+			// Native histograms are stored as classic bucketed series.
+			histTags, histDatapoints, err := storage.PromHistogramsToM3Series(
+				seriesTags, promTS.Histograms)
+			if err != nil {
+				return nil, xerrors.NewInvalidParamsError(err)
+			}
+
+			histAttributes := storage.PromHistogramsToSeriesAttributes(
+				attributes, promTS.Histograms)
+			for i := range histTags {
+				seriesAttributes = append(seriesAttributes, histAttributes)
+				tags = append(tags, histTags[i])
+				datapoints = append(datapoints, histDatapoints[i])
+			}
+
+			if len(promTS.Samples) == 0 {
+				continue
+			}
+		}
+
 		seriesAttributes = append(seriesAttributes, attributes)
-		tags = append(tags, storage.PromLabelsToM3Tags(promTS.Labels, opts))
+		tags = append(tags, seriesTags)
 		datapoints = append(datapoints, storage.PromSamplesToM3Datapoints(promTS.Samples))
 	}
 
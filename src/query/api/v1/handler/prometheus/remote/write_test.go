@@ -48,12 +48,14 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/options"
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
 	xclock "github.com/m3db/m3/src/x/clock"
 	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/headers"
 	"github.com/m3db/m3/src/x/instrument"
 	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
 )
 
 func makeOptions(ds ingest.DownsamplerAndWriter) options.HandlerOptions {
@@ -88,6 +90,38 @@ func TestPromWriteParsing(t *testing.T) {
 	require.Equal(t, ingest.WriteOptions{}, r.Options)
 }
 
+func TestPromWriteParsingTenant(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	handlerOpts := makeOptions(mockDownsamplerAndWriter)
+	handlerOpts = handlerOpts.SetConfig(config.Configuration{
+		Tenancy: config.TenancyConfiguration{Enabled: true},
+	})
+	handler, err := NewPromWriteHandler(handlerOpts)
+	require.NoError(t, err)
+
+	promReq := test.GeneratePromWriteRequest()
+	promReqBody := test.GeneratePromWriteRequestBody(t, promReq)
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL, promReqBody)
+
+	// Requests must specify a tenant.
+	_, err = handler.(*PromWriteHandler).parseRequest(req)
+	require.Error(t, err)
+
+	promReqBody = test.GeneratePromWriteRequestBody(t, promReq)
+	req = httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL, promReqBody)
+	req.Header.Set(headers.TenantHeader, "foo")
+
+	r, err := handler.(*PromWriteHandler).parseRequest(req)
+	require.NoError(t, err)
+	require.Equal(t, &models.Tag{
+		Name:  []byte("tenant"),
+		Value: []byte("foo"),
+	}, r.Options.TenantTag)
+}
+
 func TestPromWrite(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -142,6 +176,41 @@ func TestPromWriteError(t *testing.T) {
 	require.True(t, bytes.Contains(body, []byte(batchErr.Error())))
 }
 
+func TestPromWriteResourceExhaustedError(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	limitErr := xerrors.NewResourceExhaustedError(
+		errors.New("series limit exceeded for metric name foo in namespace default: limit=10"))
+	multiErr := xerrors.NewMultiError().
+		Add(limitErr).
+		Add(xerrors.NewInvalidParamsError(errors.New("bad request")))
+	batchErr := ingest.BatchError(multiErr)
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(batchErr)
+
+	opts := makeOptions(mockDownsamplerAndWriter)
+	handler, err := NewPromWriteHandler(opts)
+	require.NoError(t, err)
+
+	promReq := test.GeneratePromWriteRequest()
+	promReqBody := test.GeneratePromWriteRequestBody(t, promReq)
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL, promReqBody)
+
+	writer := httptest.NewRecorder()
+	handler.ServeHTTP(writer, req)
+	resp := writer.Result()
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.True(t, bytes.Contains(body, []byte(
+		"resource_exhausted_errors: count=1, last="+limitErr.Error())), string(body))
+}
+
 func TestWriteErrorMetricCount(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -348,6 +417,231 @@ func TestPromWriteOpenMetricsTypes(t *testing.T) {
 	}, secondAnnotationPayload, "second annotation invalidated")
 }
 
+func TestPromWriteNativeHistograms(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var capturedIter ingest.DownsampleAndWriteIter
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, iter ingest.DownsampleAndWriteIter, _ ingest.WriteOptions) ingest.BatchError {
+			capturedIter = iter
+			return nil
+		})
+
+	opts := makeOptions(mockDownsamplerAndWriter)
+
+	promReq := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels: []prompb.Label{{Name: []byte("__name__"), Value: []byte("foo")}},
+				Histograms: []prompb.Histogram{
+					{
+						CountInt:       3,
+						Sum:            4.5,
+						PositiveSpans:  []prompb.BucketSpan{{Offset: 1, Length: 1}},
+						PositiveDeltas: []int64{3},
+						Timestamp:      1000,
+					},
+				},
+			},
+		},
+	}
+
+	executeWriteRequest(t, opts, promReq)
+
+	values := make(map[string]float64)
+	for i := 0; i < 3; i++ {
+		value := verifyIterValueAnnotation(t, capturedIter,
+			annotation.OpenMetricsFamilyType_HISTOGRAM, true)
+		bucket, ok := value.Tags.Bucket()
+		require.True(t, ok)
+		require.Len(t, value.Datapoints, 1)
+		values[string(bucket)] = value.Datapoints[0].Value
+	}
+
+	require.False(t, capturedIter.Next())
+	require.NoError(t, capturedIter.Error())
+
+	assert.Equal(t, map[string]float64{
+		"2":    3,
+		"+Inf": 3,
+		"sum":  4.5,
+	}, values)
+}
+
+type testExemplarStorage struct {
+	tags      []models.Tags
+	exemplars [][]storage.Exemplar
+}
+
+func (s *testExemplarStorage) WriteExemplars(
+	_ context.Context,
+	tags models.Tags,
+	exemplars []storage.Exemplar,
+) error {
+	s.tags = append(s.tags, tags)
+	s.exemplars = append(s.exemplars, exemplars)
+	return nil
+}
+
+func (s *testExemplarStorage) FetchExemplars(
+	context.Context,
+	*storage.FetchQuery,
+	*storage.FetchOptions,
+) ([]storage.SeriesExemplars, error) {
+	return nil, nil
+}
+
+func TestPromWriteExemplars(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	exemplarStorage := &testExemplarStorage{}
+	opts := makeOptions(mockDownsamplerAndWriter).
+		SetExemplarStorage(exemplarStorage)
+
+	promReq := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels:  []prompb.Label{{Name: []byte("__name__"), Value: []byte("foo")}},
+				Samples: []prompb.Sample{{Value: 1, Timestamp: 1000}},
+				Exemplars: []prompb.Exemplar{
+					{
+						Labels:    []prompb.Label{{Name: []byte("trace_id"), Value: []byte("abc")}},
+						Value:     1,
+						Timestamp: 1000,
+					},
+				},
+			},
+			{
+				Labels:  []prompb.Label{{Name: []byte("__name__"), Value: []byte("bar")}},
+				Samples: []prompb.Sample{{Value: 2, Timestamp: 1000}},
+			},
+		},
+	}
+
+	executeWriteRequest(t, opts, promReq)
+
+	require.Len(t, exemplarStorage.tags, 1)
+	name, ok := exemplarStorage.tags[0].Name()
+	require.True(t, ok)
+	assert.Equal(t, "foo", string(name))
+
+	assert.Equal(t, [][]storage.Exemplar{
+		{
+			{
+				Labels:    []models.Tag{{Name: []byte("trace_id"), Value: []byte("abc")}},
+				Value:     1,
+				Timestamp: xtime.UnixNano(1000 * time.Millisecond),
+			},
+		},
+	}, exemplarStorage.exemplars)
+}
+
+type testMetricMetadataStore struct {
+	metadata map[string][]prompb.MetricMetadata
+}
+
+func newTestMetricMetadataStore() *testMetricMetadataStore {
+	return &testMetricMetadataStore{
+		metadata: make(map[string][]prompb.MetricMetadata),
+	}
+}
+
+func (s *testMetricMetadataStore) Write(tenant string, metadata []prompb.MetricMetadata) error {
+	s.metadata[tenant] = append(s.metadata[tenant], metadata...)
+	return nil
+}
+
+func (s *testMetricMetadataStore) Metadata(tenant, _ string, _ int) ([]prompb.MetricMetadata, error) {
+	return s.metadata[tenant], nil
+}
+
+func (s *testMetricMetadataStore) Close() {}
+
+func TestPromWriteMetricMetadata(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	metadataStore := newTestMetricMetadataStore()
+	opts := makeOptions(mockDownsamplerAndWriter).
+		SetMetricMetadataStore(metadataStore)
+
+	metadata := []prompb.MetricMetadata{
+		{
+			Type:             prompb.MetricType_COUNTER,
+			MetricFamilyName: "http_requests_total",
+			Help:             "Total HTTP requests.",
+		},
+	}
+	promReq := &prompb.WriteRequest{Metadata: metadata}
+
+	executeWriteRequest(t, opts, promReq)
+
+	stored, err := metadataStore.Metadata("", "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, metadata, stored)
+}
+
+func TestPromWriteMetricMetadataTenant(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	metadataStore := newTestMetricMetadataStore()
+	opts := makeOptions(mockDownsamplerAndWriter).
+		SetConfig(config.Configuration{
+			Tenancy: config.TenancyConfiguration{Enabled: true},
+		}).
+		SetMetricMetadataStore(metadataStore)
+	handler, err := NewPromWriteHandler(opts)
+	require.NoError(t, err)
+
+	metadata := []prompb.MetricMetadata{
+		{
+			Type:             prompb.MetricType_COUNTER,
+			MetricFamilyName: "http_requests_total",
+			Help:             "Total HTTP requests.",
+		},
+	}
+	promReq := &prompb.WriteRequest{Metadata: metadata}
+	promReqBody := test.GeneratePromWriteRequestBody(t, promReq)
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL, promReqBody)
+	req.Header.Set(headers.TenantHeader, "foo")
+
+	writer := httptest.NewRecorder()
+	handler.ServeHTTP(writer, req)
+	require.Equal(t, http.StatusOK, writer.Result().StatusCode)
+
+	// The metadata is only stored for the tenant of the request.
+	stored, err := metadataStore.Metadata("foo", "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, metadata, stored)
+	stored, err = metadataStore.Metadata("", "", 0)
+	require.NoError(t, err)
+	assert.Empty(t, stored)
+}
+
 func TestPromWriteGraphiteMetricsTypes(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
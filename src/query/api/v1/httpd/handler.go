@@ -42,6 +42,7 @@ import (
 	m3json "github.com/m3db/m3/src/query/api/v1/handler/json"
 	"github.com/m3db/m3/src/query/api/v1/handler/namespace"
 	"github.com/m3db/m3/src/query/api/v1/handler/openapi"
+	"github.com/m3db/m3/src/query/api/v1/handler/otlp"
 	"github.com/m3db/m3/src/query/api/v1/handler/prom"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/native"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/remote"
@@ -224,6 +225,16 @@ func (h *Handler) RegisterRoutes() error {
 		return err
 	}
 
+	// M3QL endpoints.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:               native.M3QLReadURL,
+		Handler:            native.NewM3QLReadHandler(nativeSourceOpts),
+		Methods:            native.M3QLReadHTTPMethods,
+		MiddlewareOverride: native.WithQueryParams,
+	}); err != nil {
+		return err
+	}
+
 	// Prometheus remote read and write endpoints.
 	if err := h.registry.Register(queryhttp.RegisterOptions{
 		Path:    remote.PromReadURL,
@@ -253,6 +264,20 @@ func (h *Handler) RegisterRoutes() error {
 		return err
 	}
 
+	otlpWriteHandler, err := otlp.NewWriteHandler(h.options)
+	if err != nil {
+		return err
+	}
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    otlp.WriteURL,
+		Handler: otlpWriteHandler,
+		Methods: methods(otlp.WriteHTTPMethod),
+		// Register with no response logging for write calls since so frequent.
+		MiddlewareOverride: middleware.WithNoResponseLogging,
+	}); err != nil {
+		return err
+	}
+
 	// Native M3 search and write endpoints.
 	if err := h.registry.Register(queryhttp.RegisterOptions{
 		Path:    handler.SearchURL,
@@ -332,6 +357,59 @@ func (h *Handler) RegisterRoutes() error {
 		return err
 	}
 
+	// Delete series endpoint.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    native.DeleteSeriesURL,
+		Handler: native.NewDeleteSeriesHandler(h.options),
+		Methods: native.DeleteSeriesHTTPMethods,
+	}); err != nil {
+		return err
+	}
+
+	// Query exemplars endpoint.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:               native.QueryExemplarsURL,
+		Handler:            native.NewQueryExemplarsHandler(h.options),
+		Methods:            native.QueryExemplarsHTTPMethods,
+		MiddlewareOverride: native.WithQueryParams,
+	}); err != nil {
+		return err
+	}
+
+	// TSDB status endpoint.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    native.TSDBStatusURL,
+		Handler: native.NewTSDBStatusHandler(h.options),
+		Methods: native.TSDBStatusHTTPMethods,
+	}); err != nil {
+		return err
+	}
+
+	// Rules and alerts endpoints.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    prom.RulesURL,
+		Handler: prom.NewRulesHandler(h.options),
+		Methods: prom.RulesHTTPMethods,
+	}); err != nil {
+		return err
+	}
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    prom.AlertsURL,
+		Handler: prom.NewAlertsHandler(h.options),
+		Methods: prom.AlertsHTTPMethods,
+	}); err != nil {
+		return err
+	}
+
+	// Metric metadata endpoint.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    prom.MetadataURL,
+		Handler: prom.NewMetadataHandler(h.options),
+		Methods: prom.MetadataHTTPMethods,
+	}); err != nil {
+		return err
+	}
+
 	// Graphite routable endpoints.
 	h.options.GraphiteRenderRouter().Setup(options.GraphiteRenderRouterOptions{
 		RenderHandler: graphite.NewRenderHandler(h.options).ServeHTTP,
@@ -353,6 +431,27 @@ func (h *Handler) RegisterRoutes() error {
 	}); err != nil {
 		return err
 	}
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    graphite.TagsURL,
+		Handler: graphite.NewTagsHandler(h.options),
+		Methods: graphite.TagsHTTPMethods,
+	}); err != nil {
+		return err
+	}
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    graphite.TagsAutoCompleteTagsURL,
+		Handler: graphite.NewTagsAutoCompleteTagsHandler(h.options),
+		Methods: graphite.TagsHTTPMethods,
+	}); err != nil {
+		return err
+	}
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    graphite.TagsAutoCompleteValuesURL,
+		Handler: graphite.NewTagsAutoCompleteValuesHandler(h.options),
+		Methods: graphite.TagsHTTPMethods,
+	}); err != nil {
+		return err
+	}
 
 	placementOpts, err := h.placementOpts()
 	if err != nil {
@@ -227,6 +227,20 @@ func TestPromNativeReadPost(t *testing.T) {
 	}
 }
 
+func TestM3QLReadGet(t *testing.T) {
+	req := httptest.NewRequest("GET", native.M3QLReadURL, nil)
+	res := httptest.NewRecorder()
+	ctrl := gomock.NewController(t)
+	storage, _ := m3.NewStorageAndSession(t, ctrl)
+
+	h, err := setupHandler(storage)
+	require.NoError(t, err, "unable to setup handler")
+	err = h.RegisterRoutes()
+	require.NoError(t, err)
+	h.Router().ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code, "Empty request")
+}
+
 func TestJSONWritePost(t *testing.T) {
 	req := httptest.NewRequest("POST", m3json.WriteJSONURL, nil)
 	res := httptest.NewRecorder()
@@ -43,8 +43,10 @@ import (
 	"github.com/m3db/m3/src/query/executor"
 	graphite "github.com/m3db/m3/src/query/graphite/storage"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/rules"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/storage/metricmetadata"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/x/clock"
 	"github.com/m3db/m3/src/x/instrument"
@@ -264,6 +266,29 @@ type HandlerOptions interface {
 	// StoreMetricsType returns true if storing of metrics type is enabled.
 	StoreMetricsType() bool
 
+	// SetExemplarStorage sets the exemplar storage.
+	SetExemplarStorage(value storage.ExemplarStorage) HandlerOptions
+	// ExemplarStorage returns the exemplar storage, nil if exemplars are
+	// not stored.
+	ExemplarStorage() storage.ExemplarStorage
+
+	// SetCardinalityStorage sets the cardinality storage.
+	SetCardinalityStorage(value storage.CardinalityStorage) HandlerOptions
+	// CardinalityStorage returns the cardinality storage, nil if cardinality
+	// statistics are not available.
+	CardinalityStorage() storage.CardinalityStorage
+
+	// SetMetricMetadataStore sets the metric metadata store.
+	SetMetricMetadataStore(value metricmetadata.Store) HandlerOptions
+	// MetricMetadataStore returns the metric metadata store, nil if metric
+	// metadata is not recorded.
+	MetricMetadataStore() metricmetadata.Store
+
+	// SetRulesManager sets the rules manager.
+	SetRulesManager(value rules.Manager) HandlerOptions
+	// RulesManager returns the rules manager, nil if rules are not evaluated.
+	RulesManager() rules.Manager
+
 	// SetNamespaceValidator sets the NamespaceValidator.
 	SetNamespaceValidator(NamespaceValidator) HandlerOptions
 	// NamespaceValidator returns the NamespaceValidator.
@@ -312,6 +337,10 @@ type handlerOptions struct {
 	m3dbOpts                          m3.Options
 	namespaceValidator                NamespaceValidator
 	storeMetricsType                  bool
+	exemplarStorage                   storage.ExemplarStorage
+	cardinalityStorage                storage.CardinalityStorage
+	metricMetadataStore               metricmetadata.Store
+	rulesManager                      rules.Manager
 	kvStoreProtoParser                KVStoreProtoParser
 	registerMiddleware                middleware.Register
 	graphiteRenderRouter              GraphiteRenderRouter
@@ -357,6 +386,17 @@ func NewHandlerOptions(
 	if cfg.StoreMetricsType != nil {
 		storeMetricsType = *cfg.StoreMetricsType
 	}
+
+	var exemplarStorage storage.ExemplarStorage
+	if cfg.Exemplars.Namespace != "" && m3dbClusters != nil {
+		exemplarStorage = m3.NewExemplarStorage(m3dbClusters,
+			cfg.Exemplars.Namespace, tagOptions)
+	}
+
+	var cardinalityStorage storage.CardinalityStorage
+	if m3dbClusters != nil {
+		cardinalityStorage = m3.NewCardinalityStorage(m3dbClusters)
+	}
 	return &handlerOptions{
 		storage:                           downsamplerAndWriter.Storage(),
 		downsamplerAndWriter:              downsamplerAndWriter,
@@ -382,6 +422,8 @@ func NewHandlerOptions(
 		graphiteStorageOpts:               graphiteStorageOpts,
 		m3dbOpts:                          m3dbOpts,
 		storeMetricsType:                  storeMetricsType,
+		exemplarStorage:                   exemplarStorage,
+		cardinalityStorage:                cardinalityStorage,
 		namespaceValidator:                validators.NamespaceValidator,
 		registerMiddleware:                middleware.Default,
 		graphiteRenderRouter:              graphiteRenderRouter,
@@ -668,6 +710,46 @@ func (o *handlerOptions) StoreMetricsType() bool {
 	return o.storeMetricsType
 }
 
+func (o *handlerOptions) SetExemplarStorage(value storage.ExemplarStorage) HandlerOptions {
+	opts := *o
+	opts.exemplarStorage = value
+	return &opts
+}
+
+func (o *handlerOptions) ExemplarStorage() storage.ExemplarStorage {
+	return o.exemplarStorage
+}
+
+func (o *handlerOptions) SetCardinalityStorage(value storage.CardinalityStorage) HandlerOptions {
+	opts := *o
+	opts.cardinalityStorage = value
+	return &opts
+}
+
+func (o *handlerOptions) CardinalityStorage() storage.CardinalityStorage {
+	return o.cardinalityStorage
+}
+
+func (o *handlerOptions) SetMetricMetadataStore(value metricmetadata.Store) HandlerOptions {
+	opts := *o
+	opts.metricMetadataStore = value
+	return &opts
+}
+
+func (o *handlerOptions) MetricMetadataStore() metricmetadata.Store {
+	return o.metricMetadataStore
+}
+
+func (o *handlerOptions) SetRulesManager(value rules.Manager) HandlerOptions {
+	opts := *o
+	opts.rulesManager = value
+	return &opts
+}
+
+func (o *handlerOptions) RulesManager() rules.Manager {
+	return o.rulesManager
+}
+
 func (o *handlerOptions) SetNamespaceValidator(value NamespaceValidator) HandlerOptions {
 	opts := *o
 	opts.namespaceValidator = value
@@ -38,4 +38,19 @@ const (
 
 	// SeriesMatchURL is the url for remote prom series matcher handler.
 	SeriesMatchURL = Prefix + "/series"
+
+	// QueryExemplarsURL is the url for the query exemplars endpoint.
+	QueryExemplarsURL = Prefix + "/query_exemplars"
+
+	// TSDBStatusURL is the url for the TSDB status endpoint.
+	TSDBStatusURL = Prefix + "/status/tsdb"
+
+	// RulesURL is the url for the rules endpoint.
+	RulesURL = Prefix + "/rules"
+
+	// AlertsURL is the url for the alerts endpoint.
+	AlertsURL = Prefix + "/alerts"
+
+	// MetadataURL is the url for the metric metadata endpoint.
+	MetadataURL = Prefix + "/metadata"
 )
@@ -0,0 +1,179 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package linear
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/functions/utils"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+)
+
+const (
+	// HistogramCountType returns the count of observations of histograms.
+	//
+	// NB: native histograms are stored as bucketed series on ingestion; the
+	// count is read from the series with a bucket tag of +Inf.
+	HistogramCountType = "histogram_count"
+
+	// HistogramSumType returns the sum of observations of native histograms,
+	// read from the series with a bucket tag of models.HistogramSumBucket.
+	HistogramSumType = "histogram_sum"
+)
+
+type bucketMatchFn func(bucket []byte) bool
+
+// NewHistogramSummaryOp creates a new operation selecting a summary series of
+// histograms.
+func NewHistogramSummaryOp(opType string) (parser.Params, error) {
+	var matchFn bucketMatchFn
+	switch opType {
+	case HistogramCountType:
+		matchFn = isHistogramCountBucket
+	case HistogramSumType:
+		matchFn = isHistogramSumBucket
+	default:
+		return nil, fmt.Errorf("operator not supported: %s", opType)
+	}
+
+	return histogramSummaryOp{
+		opType:  opType,
+		matchFn: matchFn,
+	}, nil
+}
+
+func isHistogramCountBucket(bucket []byte) bool {
+	bound, err := strconv.ParseFloat(string(bucket), 64)
+	return err == nil && math.IsInf(bound, 1)
+}
+
+func isHistogramSumBucket(bucket []byte) bool {
+	return string(bucket) == models.HistogramSumBucket
+}
+
+type histogramSummaryOp struct {
+	opType  string
+	matchFn bucketMatchFn
+}
+
+// OpType for the operator.
+func (o histogramSummaryOp) OpType() string {
+	return o.opType
+}
+
+// String representation.
+func (o histogramSummaryOp) String() string {
+	return fmt.Sprintf("type: %s", o.OpType())
+}
+
+// Node creates an execution node.
+func (o histogramSummaryOp) Node(
+	controller *transform.Controller,
+	_ transform.Options,
+) transform.OpNode {
+	return &histogramSummaryNode{
+		op:         o,
+		controller: controller,
+	}
+}
+
+type histogramSummaryNode struct {
+	op         histogramSummaryOp
+	controller *transform.Controller
+}
+
+func (n *histogramSummaryNode) Params() parser.Params {
+	return n.op
+}
+
+// Process the block
+func (n *histogramSummaryNode) Process(
+	queryCtx *models.QueryContext,
+	ID parser.NodeID,
+	b block.Block,
+) error {
+	return transform.ProcessSimpleBlock(n, n.controller, queryCtx, ID, b)
+}
+
+func (n *histogramSummaryNode) ProcessBlock(
+	queryCtx *models.QueryContext,
+	ID parser.NodeID,
+	b block.Block,
+) (block.Block, error) {
+	stepIter, err := b.StepIter()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		meta        = b.Meta()
+		seriesMetas = utils.FlattenMetadata(meta, stepIter.SeriesMeta())
+		indices     = make([]int, 0, len(seriesMetas))
+		metas       = make([]block.SeriesMeta, 0, len(seriesMetas))
+	)
+
+	for i, seriesMeta := range seriesMetas {
+		tags := seriesMeta.Tags
+		bucket, found := tags.Bucket()
+		if !found || !n.op.matchFn(bucket) {
+			// not a summary series of a histogram; drop it from the output.
+			continue
+		}
+
+		excludeTags := [][]byte{tags.Opts.MetricName(), tags.Opts.BucketName()}
+		indices = append(indices, i)
+		metas = append(metas, block.SeriesMeta{
+			Tags: tags.TagsWithoutKeys(excludeTags),
+		})
+	}
+
+	builder, err := n.controller.BlockBuilder(queryCtx, meta, metas)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = builder.AddCols(stepIter.StepCount()); err != nil {
+		return nil, err
+	}
+
+	out := make([]float64, len(indices))
+	for index := 0; stepIter.Next(); index++ {
+		values := stepIter.Current().Values()
+		for i, idx := range indices {
+			out[i] = values[idx]
+		}
+
+		if err := builder.AppendValues(index, out); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = stepIter.Err(); err != nil {
+		return nil, err
+	}
+
+	return builder.Build(), nil
+}
@@ -151,6 +151,33 @@ func (x LabelMatcher_Type) String() string {
 }
 func (LabelMatcher_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorTypes, []int{4, 0} }
 
+type Histogram_ResetHint int32
+
+const (
+	Histogram_UNKNOWN Histogram_ResetHint = 0
+	Histogram_YES     Histogram_ResetHint = 1
+	Histogram_NO      Histogram_ResetHint = 2
+	Histogram_GAUGE   Histogram_ResetHint = 3
+)
+
+var Histogram_ResetHint_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "YES",
+	2: "NO",
+	3: "GAUGE",
+}
+var Histogram_ResetHint_value = map[string]int32{
+	"UNKNOWN": 0,
+	"YES":     1,
+	"NO":      2,
+	"GAUGE":   3,
+}
+
+func (x Histogram_ResetHint) String() string {
+	return proto.EnumName(Histogram_ResetHint_name, int32(x))
+}
+func (Histogram_ResetHint) EnumDescriptor() ([]byte, []int) { return fileDescriptorTypes, []int{5, 0} }
+
 type Sample struct {
 	Value     float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
 	Timestamp int64   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -176,8 +203,10 @@ func (m *Sample) GetTimestamp() int64 {
 }
 
 type TimeSeries struct {
-	Labels  []Label  `protobuf:"bytes,1,rep,name=labels" json:"labels"`
-	Samples []Sample `protobuf:"bytes,2,rep,name=samples" json:"samples"`
+	Labels     []Label     `protobuf:"bytes,1,rep,name=labels" json:"labels"`
+	Samples    []Sample    `protobuf:"bytes,2,rep,name=samples" json:"samples"`
+	Exemplars  []Exemplar  `protobuf:"bytes,3,rep,name=exemplars" json:"exemplars"`
+	Histograms []Histogram `protobuf:"bytes,4,rep,name=histograms" json:"histograms"`
 	// NB: These are custom fields that M3 uses. They start at 101 so that they
 	// should never clash with prometheus fields.
 	M3Type M3Type     `protobuf:"varint,101,opt,name=m3_type,json=m3Type,proto3,enum=m3prometheus.M3Type" json:"m3_type,omitempty"`
@@ -204,6 +233,20 @@ func (m *TimeSeries) GetSamples() []Sample {
 	return nil
 }
 
+func (m *TimeSeries) GetExemplars() []Exemplar {
+	if m != nil {
+		return m.Exemplars
+	}
+	return nil
+}
+
+func (m *TimeSeries) GetHistograms() []Histogram {
+	if m != nil {
+		return m.Histograms
+	}
+	return nil
+}
+
 func (m *TimeSeries) GetM3Type() M3Type {
 	if m != nil {
 		return m.M3Type
@@ -298,16 +341,277 @@ func (m *LabelMatcher) GetValue() []byte {
 	return nil
 }
 
+// Histogram is a native (sparse, exponential bucket) histogram sample.
+//
+// NB: The count and zero_count fields are oneofs in the Prometheus definition,
+// they are flattened here since the wire format is identical. A histogram is
+// a float histogram if any of the float fields or absolute counts are set.
+type Histogram struct {
+	CountInt       uint64              `protobuf:"varint,1,opt,name=count_int,json=countInt,proto3" json:"count_int,omitempty"`
+	CountFloat     float64             `protobuf:"fixed64,2,opt,name=count_float,json=countFloat,proto3" json:"count_float,omitempty"`
+	Sum            float64             `protobuf:"fixed64,3,opt,name=sum,proto3" json:"sum,omitempty"`
+	Schema         int32               `protobuf:"zigzag32,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	ZeroThreshold  float64             `protobuf:"fixed64,5,opt,name=zero_threshold,json=zeroThreshold,proto3" json:"zero_threshold,omitempty"`
+	ZeroCountInt   uint64              `protobuf:"varint,6,opt,name=zero_count_int,json=zeroCountInt,proto3" json:"zero_count_int,omitempty"`
+	ZeroCountFloat float64             `protobuf:"fixed64,7,opt,name=zero_count_float,json=zeroCountFloat,proto3" json:"zero_count_float,omitempty"`
+	NegativeSpans  []BucketSpan        `protobuf:"bytes,8,rep,name=negative_spans,json=negativeSpans" json:"negative_spans"`
+	NegativeDeltas []int64             `protobuf:"zigzag64,9,rep,packed,name=negative_deltas,json=negativeDeltas" json:"negative_deltas,omitempty"`
+	NegativeCounts []float64           `protobuf:"fixed64,10,rep,packed,name=negative_counts,json=negativeCounts" json:"negative_counts,omitempty"`
+	PositiveSpans  []BucketSpan        `protobuf:"bytes,11,rep,name=positive_spans,json=positiveSpans" json:"positive_spans"`
+	PositiveDeltas []int64             `protobuf:"zigzag64,12,rep,packed,name=positive_deltas,json=positiveDeltas" json:"positive_deltas,omitempty"`
+	PositiveCounts []float64           `protobuf:"fixed64,13,rep,packed,name=positive_counts,json=positiveCounts" json:"positive_counts,omitempty"`
+	ResetHint      Histogram_ResetHint `protobuf:"varint,14,opt,name=reset_hint,json=resetHint,proto3,enum=m3prometheus.Histogram_ResetHint" json:"reset_hint,omitempty"`
+	Timestamp      int64               `protobuf:"varint,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Histogram) Reset()                    { *m = Histogram{} }
+func (m *Histogram) String() string            { return proto.CompactTextString(m) }
+func (*Histogram) ProtoMessage()               {}
+func (*Histogram) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{5} }
+
+func (m *Histogram) GetCountInt() uint64 {
+	if m != nil {
+		return m.CountInt
+	}
+	return 0
+}
+
+func (m *Histogram) GetCountFloat() float64 {
+	if m != nil {
+		return m.CountFloat
+	}
+	return 0
+}
+
+func (m *Histogram) GetSum() float64 {
+	if m != nil {
+		return m.Sum
+	}
+	return 0
+}
+
+func (m *Histogram) GetSchema() int32 {
+	if m != nil {
+		return m.Schema
+	}
+	return 0
+}
+
+func (m *Histogram) GetZeroThreshold() float64 {
+	if m != nil {
+		return m.ZeroThreshold
+	}
+	return 0
+}
+
+func (m *Histogram) GetZeroCountInt() uint64 {
+	if m != nil {
+		return m.ZeroCountInt
+	}
+	return 0
+}
+
+func (m *Histogram) GetZeroCountFloat() float64 {
+	if m != nil {
+		return m.ZeroCountFloat
+	}
+	return 0
+}
+
+func (m *Histogram) GetNegativeSpans() []BucketSpan {
+	if m != nil {
+		return m.NegativeSpans
+	}
+	return nil
+}
+
+func (m *Histogram) GetNegativeDeltas() []int64 {
+	if m != nil {
+		return m.NegativeDeltas
+	}
+	return nil
+}
+
+func (m *Histogram) GetNegativeCounts() []float64 {
+	if m != nil {
+		return m.NegativeCounts
+	}
+	return nil
+}
+
+func (m *Histogram) GetPositiveSpans() []BucketSpan {
+	if m != nil {
+		return m.PositiveSpans
+	}
+	return nil
+}
+
+func (m *Histogram) GetPositiveDeltas() []int64 {
+	if m != nil {
+		return m.PositiveDeltas
+	}
+	return nil
+}
+
+func (m *Histogram) GetPositiveCounts() []float64 {
+	if m != nil {
+		return m.PositiveCounts
+	}
+	return nil
+}
+
+func (m *Histogram) GetResetHint() Histogram_ResetHint {
+	if m != nil {
+		return m.ResetHint
+	}
+	return Histogram_UNKNOWN
+}
+
+func (m *Histogram) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// BucketSpan defines a number of consecutive buckets with their offset.
+type BucketSpan struct {
+	Offset int32  `protobuf:"zigzag32,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length uint32 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (m *BucketSpan) Reset()                    { *m = BucketSpan{} }
+func (m *BucketSpan) String() string            { return proto.CompactTextString(m) }
+func (*BucketSpan) ProtoMessage()               {}
+func (*BucketSpan) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{6} }
+
+func (m *BucketSpan) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *BucketSpan) GetLength() uint32 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+// Exemplar is a sample annotated with labels, such as a trace ID, which
+// identify where it was observed.
+type Exemplar struct {
+	// Optional, can be empty.
+	Labels []Label `protobuf:"bytes,1,rep,name=labels" json:"labels"`
+	Value  float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	// timestamp is in ms format.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Exemplar) Reset()                    { *m = Exemplar{} }
+func (m *Exemplar) String() string            { return proto.CompactTextString(m) }
+func (*Exemplar) ProtoMessage()               {}
+func (*Exemplar) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{7} }
+
+func (m *Exemplar) GetLabels() []Label {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Exemplar) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Exemplar) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// MetricMetadata is the metadata of a metric family.
+type MetricMetadata struct {
+	// Represents the metric type, these match the set from Prometheus.
+	// Refer to pkg/textparse/interface.go for details.
+	Type             MetricType `protobuf:"varint,1,opt,name=type,proto3,enum=m3prometheus.MetricType" json:"type,omitempty"`
+	MetricFamilyName string     `protobuf:"bytes,2,opt,name=metric_family_name,json=metricFamilyName,proto3" json:"metric_family_name,omitempty"`
+	Help             string     `protobuf:"bytes,4,opt,name=help,proto3" json:"help,omitempty"`
+	Unit             string     `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+}
+
+func (m *MetricMetadata) Reset()                    { *m = MetricMetadata{} }
+func (m *MetricMetadata) String() string            { return proto.CompactTextString(m) }
+func (*MetricMetadata) ProtoMessage()               {}
+func (*MetricMetadata) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{8} }
+
+func (m *MetricMetadata) GetType() MetricType {
+	if m != nil {
+		return m.Type
+	}
+	return MetricType_UNKNOWN
+}
+
+func (m *MetricMetadata) GetMetricFamilyName() string {
+	if m != nil {
+		return m.MetricFamilyName
+	}
+	return ""
+}
+
+func (m *MetricMetadata) GetHelp() string {
+	if m != nil {
+		return m.Help
+	}
+	return ""
+}
+
+func (m *MetricMetadata) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+// MetricMetadataList is a list of metric metadata, it is used by M3 to store
+// the metadata of metric families.
+type MetricMetadataList struct {
+	Metadata []MetricMetadata `protobuf:"bytes,1,rep,name=metadata" json:"metadata"`
+}
+
+func (m *MetricMetadataList) Reset()                    { *m = MetricMetadataList{} }
+func (m *MetricMetadataList) String() string            { return proto.CompactTextString(m) }
+func (*MetricMetadataList) ProtoMessage()               {}
+func (*MetricMetadataList) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{9} }
+
+func (m *MetricMetadataList) GetMetadata() []MetricMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Sample)(nil), "m3prometheus.Sample")
 	proto.RegisterType((*TimeSeries)(nil), "m3prometheus.TimeSeries")
 	proto.RegisterType((*Label)(nil), "m3prometheus.Label")
 	proto.RegisterType((*Labels)(nil), "m3prometheus.Labels")
 	proto.RegisterType((*LabelMatcher)(nil), "m3prometheus.LabelMatcher")
+	proto.RegisterType((*Histogram)(nil), "m3prometheus.Histogram")
+	proto.RegisterType((*BucketSpan)(nil), "m3prometheus.BucketSpan")
+	proto.RegisterType((*Exemplar)(nil), "m3prometheus.Exemplar")
+	proto.RegisterType((*MetricMetadata)(nil), "m3prometheus.MetricMetadata")
+	proto.RegisterType((*MetricMetadataList)(nil), "m3prometheus.MetricMetadataList")
 	proto.RegisterEnum("m3prometheus.MetricType", MetricType_name, MetricType_value)
 	proto.RegisterEnum("m3prometheus.M3Type", M3Type_name, M3Type_value)
 	proto.RegisterEnum("m3prometheus.Source", Source_name, Source_value)
 	proto.RegisterEnum("m3prometheus.LabelMatcher_Type", LabelMatcher_Type_name, LabelMatcher_Type_value)
+	proto.RegisterEnum("m3prometheus.Histogram_ResetHint", Histogram_ResetHint_name, Histogram_ResetHint_value)
 }
 func (m *Sample) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -377,6 +681,30 @@ func (m *TimeSeries) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Exemplars) > 0 {
+		for _, msg := range m.Exemplars {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Histograms) > 0 {
+		for _, msg := range m.Histograms {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	if m.M3Type != 0 {
 		dAtA[i] = 0xa8
 		i++
@@ -496,111 +824,1042 @@ func (m *LabelMatcher) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *Sample) Size() (n int) {
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 9
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovTypes(uint64(m.Timestamp))
+func (m *Histogram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TimeSeries) Size() (n int) {
+func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for _, e := range m.Labels {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.CountInt != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.CountInt))
 	}
-	if len(m.Samples) > 0 {
-		for _, e := range m.Samples {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.CountFloat != 0 {
+		dAtA[i] = 0x11
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CountFloat))))
+		i += 8
 	}
-	if m.M3Type != 0 {
-		n += 2 + sovTypes(uint64(m.M3Type))
+	if m.Sum != 0 {
+		dAtA[i] = 0x19
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Sum))))
+		i += 8
 	}
-	if m.Source != 0 {
-		n += 2 + sovTypes(uint64(m.Source))
+	if m.Schema != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64((uint32(m.Schema)<<1)^uint32((m.Schema>>31))))
 	}
-	if m.Type != 0 {
-		n += 2 + sovTypes(uint64(m.Type))
+	if m.ZeroThreshold != 0 {
+		dAtA[i] = 0x29
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ZeroThreshold))))
+		i += 8
 	}
-	return n
-}
-
-func (m *Label) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.ZeroCountInt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.ZeroCountInt))
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.ZeroCountFloat != 0 {
+		dAtA[i] = 0x39
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ZeroCountFloat))))
+		i += 8
 	}
-	return n
-}
-
-func (m *Labels) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Labels) > 0 {
-		for _, e := range m.Labels {
+	if len(m.NegativeSpans) > 0 {
+		for _, msg := range m.NegativeSpans {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.NegativeDeltas) > 0 {
+		dAtA1 := make([]byte, len(m.NegativeDeltas)*10)
+		var j2 int
+		for _, num := range m.NegativeDeltas {
+			x3 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x3 >= 1<<7 {
+				dAtA1[j2] = uint8(uint64(x3)&0x7f | 0x80)
+				j2++
+				x3 >>= 7
+			}
+			dAtA1[j2] = uint8(x3)
+			j2++
+		}
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(j2))
+		i += copy(dAtA[i:], dAtA1[:j2])
+	}
+	if len(m.NegativeCounts) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NegativeCounts)*8))
+		for _, num := range m.NegativeCounts {
+			f4 := math.Float64bits(float64(num))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f4))
+			i += 8
+		}
+	}
+	if len(m.PositiveSpans) > 0 {
+		for _, msg := range m.PositiveSpans {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.PositiveDeltas) > 0 {
+		dAtA5 := make([]byte, len(m.PositiveDeltas)*10)
+		var j6 int
+		for _, num := range m.PositiveDeltas {
+			x7 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x7 >= 1<<7 {
+				dAtA5[j6] = uint8(uint64(x7)&0x7f | 0x80)
+				j6++
+				x7 >>= 7
+			}
+			dAtA5[j6] = uint8(x7)
+			j6++
+		}
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(j6))
+		i += copy(dAtA[i:], dAtA5[:j6])
+	}
+	if len(m.PositiveCounts) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PositiveCounts)*8))
+		for _, num := range m.PositiveCounts {
+			f8 := math.Float64bits(float64(num))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f8))
+			i += 8
+		}
+	}
+	if m.ResetHint != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.ResetHint))
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Timestamp))
+	}
+	return i, nil
+}
+
+func (m *BucketSpan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketSpan) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64((uint32(m.Offset)<<1)^uint32((m.Offset>>31))))
+	}
+	if m.Length != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Length))
+	}
+	return i, nil
+}
+
+func (m *Exemplar) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Exemplar) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, msg := range m.Labels {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x11
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i += 8
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Timestamp))
+	}
+	return i, nil
+}
+
+func (m *MetricMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricMetadata) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+	}
+	if len(m.MetricFamilyName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetricFamilyName)))
+		i += copy(dAtA[i:], m.MetricFamilyName)
+	}
+	if len(m.Help) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Help)))
+		i += copy(dAtA[i:], m.Help)
+	}
+	if len(m.Unit) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Unit)))
+		i += copy(dAtA[i:], m.Unit)
+	}
+	return i, nil
+}
+
+func (m *MetricMetadataList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricMetadataList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for _, msg := range m.Metadata {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Sample) Size() (n int) {
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTypes(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *TimeSeries) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Samples) > 0 {
+		for _, e := range m.Samples {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Exemplars) > 0 {
+		for _, e := range m.Exemplars {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Histograms) > 0 {
+		for _, e := range m.Histograms {
 			l = e.Size()
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *LabelMatcher) Size() (n int) {
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovTypes(uint64(m.Type))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
-}
+	if m.M3Type != 0 {
+		n += 2 + sovTypes(uint64(m.M3Type))
+	}
+	if m.Source != 0 {
+		n += 2 + sovTypes(uint64(m.Source))
+	}
+	if m.Type != 0 {
+		n += 2 + sovTypes(uint64(m.Type))
+	}
+	return n
+}
+
+func (m *Label) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Labels) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LabelMatcher) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Histogram) Size() (n int) {
+	var l int
+	_ = l
+	if m.CountInt != 0 {
+		n += 1 + sovTypes(uint64(m.CountInt))
+	}
+	if m.CountFloat != 0 {
+		n += 9
+	}
+	if m.Sum != 0 {
+		n += 9
+	}
+	if m.Schema != 0 {
+		n += 1 + sozTypes(uint64(m.Schema))
+	}
+	if m.ZeroThreshold != 0 {
+		n += 9
+	}
+	if m.ZeroCountInt != 0 {
+		n += 1 + sovTypes(uint64(m.ZeroCountInt))
+	}
+	if m.ZeroCountFloat != 0 {
+		n += 9
+	}
+	if len(m.NegativeSpans) > 0 {
+		for _, e := range m.NegativeSpans {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.NegativeDeltas) > 0 {
+		l = 0
+		for _, e := range m.NegativeDeltas {
+			l += sozTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.NegativeCounts) > 0 {
+		n += 1 + sovTypes(uint64(len(m.NegativeCounts)*8)) + len(m.NegativeCounts)*8
+	}
+	if len(m.PositiveSpans) > 0 {
+		for _, e := range m.PositiveSpans {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.PositiveDeltas) > 0 {
+		l = 0
+		for _, e := range m.PositiveDeltas {
+			l += sozTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.PositiveCounts) > 0 {
+		n += 1 + sovTypes(uint64(len(m.PositiveCounts)*8)) + len(m.PositiveCounts)*8
+	}
+	if m.ResetHint != 0 {
+		n += 1 + sovTypes(uint64(m.ResetHint))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTypes(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *BucketSpan) Size() (n int) {
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sozTypes(uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		n += 1 + sovTypes(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *Exemplar) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTypes(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *MetricMetadata) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.MetricFamilyName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Help)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Unit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MetricMetadataList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Sample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeSeries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeSeries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeSeries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, Label{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Samples = append(m.Samples, Sample{})
+			if err := m.Samples[len(m.Samples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exemplars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exemplars = append(m.Exemplars, Exemplar{})
+			if err := m.Exemplars[len(m.Exemplars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histograms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Histograms = append(m.Histograms, Histogram{})
+			if err := m.Histograms[len(m.Histograms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 101:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M3Type", wireType)
+			}
+			m.M3Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.M3Type |= (M3Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 102:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= (Source(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 1001:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (MetricType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Label) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Label: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Label: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
+			if m.Name == nil {
+				m.Name = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Labels) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Labels: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Labels: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, Label{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Sample) Unmarshal(dAtA []byte) error {
+func (m *LabelMatcher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -623,28 +1882,36 @@ func (m *Sample) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sample: wiretype end group for non-group")
+			return fmt.Errorf("proto: LabelMatcher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sample: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LabelMatcher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (LabelMatcher_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Value = float64(math.Float64frombits(v))
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Timestamp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -654,11 +1921,54 @@ func (m *Sample) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= (int64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
+			if m.Name == nil {
+				m.Name = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -680,7 +1990,7 @@ func (m *Sample) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TimeSeries) Unmarshal(dAtA []byte) error {
+func (m *Histogram) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -703,15 +2013,118 @@ func (m *TimeSeries) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TimeSeries: wiretype end group for non-group")
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimeSeries: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountInt", wireType)
+			}
+			m.CountInt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CountInt |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountFloat", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CountFloat = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Sum = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.Schema = v
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroThreshold", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ZeroThreshold = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroCountInt", wireType)
+			}
+			m.ZeroCountInt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZeroCountInt |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroCountFloat", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ZeroCountFloat = float64(math.Float64frombits(v))
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NegativeSpans", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -735,14 +2148,124 @@ func (m *TimeSeries) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, Label{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NegativeSpans = append(m.NegativeSpans, BucketSpan{})
+			if err := m.NegativeSpans[len(m.NegativeSpans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.NegativeDeltas = append(m.NegativeDeltas, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.NegativeDeltas = append(m.NegativeDeltas, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NegativeDeltas", wireType)
+			}
+		case 10:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v1 := float64(math.Float64frombits(v))
+				m.NegativeCounts = append(m.NegativeCounts, v1)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v1 := float64(math.Float64frombits(v))
+					m.NegativeCounts = append(m.NegativeCounts, v1)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NegativeCounts", wireType)
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositiveSpans", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -766,35 +2289,126 @@ func (m *TimeSeries) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Samples = append(m.Samples, Sample{})
-			if err := m.Samples[len(m.Samples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PositiveSpans = append(m.PositiveSpans, BucketSpan{})
+			if err := m.PositiveSpans[len(m.PositiveSpans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 101:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field M3Type", wireType)
+		case 12:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.PositiveDeltas = append(m.PositiveDeltas, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.PositiveDeltas = append(m.PositiveDeltas, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositiveDeltas", wireType)
 			}
-			m.M3Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
+		case 13:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
 				}
-				if iNdEx >= l {
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.PositiveCounts = append(m.PositiveCounts, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.M3Type |= (M3Type(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.PositiveCounts = append(m.PositiveCounts, v2)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositiveCounts", wireType)
 			}
-		case 102:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetHint", wireType)
 			}
-			m.Source = 0
+			m.ResetHint = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -804,16 +2418,16 @@ func (m *TimeSeries) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= (Source(b) & 0x7F) << shift
+				m.ResetHint |= (Histogram_ResetHint(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 1001:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Type = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -823,7 +2437,7 @@ func (m *TimeSeries) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= (MetricType(b) & 0x7F) << shift
+				m.Timestamp |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -849,7 +2463,7 @@ func (m *TimeSeries) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Label) Unmarshal(dAtA []byte) error {
+func (m *BucketSpan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -872,17 +2486,17 @@ func (m *Label) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Label: wiretype end group for non-group")
+			return fmt.Errorf("proto: BucketSpan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Label: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BucketSpan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var byteLen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -892,28 +2506,18 @@ func (m *Label) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				v |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
-			if m.Name == nil {
-				m.Name = []byte{}
-			}
-			iNdEx = postIndex
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.Offset = v
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			var byteLen int
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -923,23 +2527,11 @@ func (m *Label) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Length |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -961,7 +2553,8 @@ func (m *Label) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Labels) Unmarshal(dAtA []byte) error {
+
+func (m *Exemplar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -984,10 +2577,10 @@ func (m *Labels) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Labels: wiretype end group for non-group")
+			return fmt.Errorf("proto: Exemplar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Labels: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Exemplar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1021,6 +2614,36 @@ func (m *Labels) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1042,7 +2665,7 @@ func (m *Labels) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LabelMatcher) Unmarshal(dAtA []byte) error {
+func (m *MetricMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1065,10 +2688,10 @@ func (m *LabelMatcher) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LabelMatcher: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelMatcher: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1085,16 +2708,16 @@ func (m *LabelMatcher) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= (LabelMatcher_Type(b) & 0x7F) << shift
+				m.Type |= (MetricType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricFamilyName", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1104,28 +2727,55 @@ func (m *LabelMatcher) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
-			if m.Name == nil {
-				m.Name = []byte{}
+			m.MetricFamilyName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Help = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1135,21 +2785,100 @@ func (m *LabelMatcher) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Unit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricMetadataList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricMetadataList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricMetadataList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, MetricMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1283,42 +3012,71 @@ func init() {
 }
 
 var fileDescriptorTypes = []byte{
-	// 584 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xcd, 0x38, 0x89, 0xd3, 0xde, 0xe6, 0xeb, 0x37, 0x9a, 0x76, 0x61, 0x21, 0x94, 0x46, 0x59,
-	0x45, 0x15, 0x8d, 0x55, 0xdc, 0x05, 0x12, 0x48, 0x28, 0xad, 0x86, 0x34, 0xa2, 0xb6, 0xdb, 0xb1,
-	0x23, 0x04, 0x9b, 0xc8, 0x4e, 0xa7, 0x69, 0xa4, 0x4c, 0x6d, 0xfc, 0x83, 0x54, 0x76, 0xbc, 0x01,
-	0x3b, 0x5e, 0xa9, 0x4b, 0x9e, 0x00, 0xa1, 0xb2, 0xe2, 0x2d, 0xd0, 0xcc, 0x04, 0x5c, 0xaa, 0x6e,
-	0xd8, 0xd8, 0x73, 0xcf, 0x3d, 0xe7, 0xce, 0x99, 0x33, 0x1a, 0x78, 0x39, 0x5f, 0x14, 0x97, 0x65,
-	0x3c, 0x98, 0x25, 0xc2, 0x16, 0xce, 0x79, 0x6c, 0x0b, 0xc7, 0xce, 0xb3, 0x99, 0xfd, 0xbe, 0xe4,
-	0xd9, 0xb5, 0x3d, 0xe7, 0x57, 0x3c, 0x8b, 0x0a, 0x7e, 0x6e, 0xa7, 0x59, 0x52, 0x24, 0xf2, 0x2b,
-	0xd2, 0xd8, 0x2e, 0xae, 0x53, 0x9e, 0x0f, 0x14, 0x44, 0xda, 0xc2, 0x91, 0x28, 0x2f, 0x2e, 0x79,
-	0x99, 0x3f, 0xda, 0xbb, 0x33, 0x6e, 0x9e, 0xcc, 0x13, 0xad, 0x8b, 0xcb, 0x0b, 0x55, 0xe9, 0x21,
-	0x72, 0xa5, 0xc5, 0xbd, 0x17, 0x60, 0x06, 0x91, 0x48, 0x97, 0x9c, 0x6c, 0x43, 0xf3, 0x43, 0xb4,
-	0x2c, 0xb9, 0x85, 0xba, 0xa8, 0x8f, 0x98, 0x2e, 0xc8, 0x63, 0x58, 0x2f, 0x16, 0x82, 0xe7, 0x45,
-	0x24, 0x52, 0xcb, 0xe8, 0xa2, 0x7e, 0x9d, 0x55, 0x40, 0xef, 0x93, 0x01, 0x10, 0x2e, 0x04, 0x0f,
-	0x78, 0xb6, 0xe0, 0x39, 0xd9, 0x07, 0x73, 0x19, 0xc5, 0x7c, 0x99, 0x5b, 0xa8, 0x5b, 0xef, 0x6f,
-	0x3c, 0xdd, 0x1a, 0xdc, 0xb5, 0x36, 0x38, 0x91, 0xbd, 0xc3, 0xc6, 0xcd, 0xb7, 0x9d, 0x1a, 0x5b,
-	0x11, 0xc9, 0x01, 0xb4, 0x72, 0xb5, 0x7f, 0x6e, 0x19, 0x4a, 0xb3, 0xfd, 0xb7, 0x46, 0x9b, 0x5b,
-	0x89, 0x7e, 0x53, 0xc9, 0x1e, 0xb4, 0x84, 0x33, 0x95, 0x21, 0x58, 0xbc, 0x8b, 0xfa, 0x9b, 0xf7,
-	0x55, 0xae, 0x13, 0x5e, 0xa7, 0x9c, 0x99, 0x42, 0xfd, 0xc9, 0x13, 0x30, 0xf3, 0xa4, 0xcc, 0x66,
-	0xdc, 0xba, 0x78, 0x88, 0x1d, 0xa8, 0x1e, 0x5b, 0x71, 0xc8, 0x1e, 0x34, 0xd4, 0xe4, 0x9f, 0x2d,
-	0x45, 0xb6, 0xee, 0x8d, 0xe6, 0x45, 0xb6, 0x98, 0xa9, 0xf1, 0x8a, 0xd6, 0xdb, 0x87, 0xa6, 0x3a,
-	0x18, 0x21, 0xd0, 0xb8, 0x8a, 0x84, 0xce, 0xaf, 0xcd, 0xd4, 0xba, 0x0a, 0xd5, 0x50, 0xa0, 0x2e,
-	0x7a, 0xcf, 0xc1, 0x3c, 0xd1, 0xc7, 0xff, 0xf7, 0xc4, 0x7a, 0x5f, 0x10, 0xb4, 0x15, 0xee, 0x46,
-	0xc5, 0xec, 0x92, 0x67, 0xc4, 0x59, 0xf9, 0x45, 0xca, 0xee, 0xce, 0x03, 0x13, 0x56, 0xcc, 0x41,
-	0xe5, 0xfa, 0x8f, 0x59, 0xe3, 0x21, 0xb3, 0xf5, 0xbb, 0x66, 0xfb, 0xd0, 0x50, 0x21, 0x9a, 0x60,
-	0xd0, 0x33, 0x5c, 0x23, 0x2d, 0xa8, 0x7b, 0xf4, 0x0c, 0x23, 0x09, 0x30, 0x8a, 0x0d, 0x05, 0x30,
-	0x8a, 0xeb, 0xbb, 0x1f, 0x01, 0xaa, 0x74, 0xc8, 0x06, 0xb4, 0x26, 0xde, 0x6b, 0xcf, 0x7f, 0xe3,
-	0xe1, 0x9a, 0x2c, 0x8e, 0xfc, 0x89, 0x17, 0x52, 0x86, 0x11, 0x59, 0x87, 0xe6, 0x68, 0x38, 0x19,
-	0x49, 0xed, 0x7f, 0xb0, 0x7e, 0x3c, 0x0e, 0x42, 0x7f, 0xc4, 0x86, 0x2e, 0xae, 0x93, 0x2d, 0xf8,
-	0x5f, 0x75, 0xa6, 0x15, 0xd8, 0x90, 0xda, 0x60, 0xe2, 0xba, 0x43, 0xf6, 0x16, 0x37, 0xc9, 0x1a,
-	0x34, 0xc6, 0xde, 0x2b, 0x1f, 0x9b, 0xa4, 0x0d, 0x6b, 0x41, 0x38, 0x0c, 0x69, 0x40, 0x43, 0xdc,
-	0xda, 0x3d, 0x00, 0x53, 0x5f, 0xba, 0xc4, 0x5d, 0x67, 0xaa, 0x37, 0xa8, 0x91, 0x4d, 0x00, 0xd7,
-	0x99, 0x56, 0x7b, 0xeb, 0x6e, 0x38, 0x76, 0x29, 0xc3, 0xc6, 0xee, 0x33, 0x30, 0xf5, 0xe5, 0x4b,
-	0xde, 0x29, 0xf3, 0x5d, 0x1a, 0x1e, 0xd3, 0x49, 0x80, 0x6b, 0x92, 0x37, 0x62, 0xc3, 0xd3, 0xe3,
-	0x71, 0x48, 0x31, 0x22, 0x18, 0xda, 0xfe, 0x29, 0xf5, 0xa6, 0x2e, 0x0d, 0xd9, 0xf8, 0x28, 0xc0,
-	0xc6, 0xa1, 0x75, 0x73, 0xdb, 0x41, 0x5f, 0x6f, 0x3b, 0xe8, 0xfb, 0x6d, 0x07, 0x7d, 0xfe, 0xd1,
-	0xa9, 0xbd, 0x33, 0xf5, 0xd3, 0x8c, 0x4d, 0xf5, 0xb0, 0x9c, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x08, 0xf9, 0x5a, 0x0c, 0xd8, 0x03, 0x00, 0x00,
+	// 1044 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc7, 0xeb, 0x7c, 0x38, 0xf1, 0x69, 0x9a, 0x7a, 0x67, 0x57, 0x8b, 0x05, 0xab, 0x36, 0x44,
+	0x20, 0xa2, 0xaa, 0x4d, 0xb4, 0xa4, 0x17, 0x08, 0x96, 0x8f, 0xb6, 0xb8, 0x6d, 0x44, 0x93, 0x74,
+	0xc7, 0xae, 0xd0, 0x72, 0x63, 0x39, 0xe9, 0x24, 0xb1, 0xf0, 0xd7, 0x7a, 0x26, 0x2b, 0xba, 0x4f,
+	0xc1, 0x05, 0x12, 0xef, 0xc1, 0x53, 0xec, 0x25, 0x4f, 0x80, 0x50, 0xb9, 0xe2, 0x1d, 0xb8, 0x40,
+	0x33, 0xe3, 0x8f, 0xa4, 0x2a, 0x02, 0xf6, 0xa6, 0x9d, 0xf9, 0x9f, 0xf3, 0x3f, 0xfe, 0x65, 0xe6,
+	0xf8, 0x24, 0xf0, 0xe5, 0xdc, 0x63, 0x8b, 0xe5, 0xa4, 0x3b, 0x8d, 0x82, 0x5e, 0xd0, 0xbf, 0x9e,
+	0xf4, 0x82, 0x7e, 0x8f, 0x26, 0xd3, 0xde, 0xcb, 0x25, 0x49, 0x6e, 0x7a, 0x73, 0x12, 0x92, 0xc4,
+	0x65, 0xe4, 0xba, 0x17, 0x27, 0x11, 0x8b, 0xf8, 0xdf, 0x20, 0x9e, 0xf4, 0xd8, 0x4d, 0x4c, 0x68,
+	0x57, 0x48, 0xa8, 0x11, 0xf4, 0xb9, 0x4a, 0xd8, 0x82, 0x2c, 0xe9, 0xbb, 0x07, 0x2b, 0xe5, 0xe6,
+	0xd1, 0x3c, 0x92, 0xbe, 0xc9, 0x72, 0x26, 0x76, 0xb2, 0x08, 0x5f, 0x49, 0x73, 0xfb, 0x19, 0xa8,
+	0x96, 0x1b, 0xc4, 0x3e, 0x41, 0x8f, 0xa0, 0xfa, 0xca, 0xf5, 0x97, 0xc4, 0x50, 0x5a, 0x4a, 0x47,
+	0xc1, 0x72, 0x83, 0x9e, 0x80, 0xc6, 0xbc, 0x80, 0x50, 0xe6, 0x06, 0xb1, 0x51, 0x6a, 0x29, 0x9d,
+	0x32, 0x2e, 0x84, 0xf6, 0x5f, 0x25, 0x00, 0xdb, 0x0b, 0x88, 0x45, 0x12, 0x8f, 0x50, 0xf4, 0x14,
+	0x54, 0xdf, 0x9d, 0x10, 0x9f, 0x1a, 0x4a, 0xab, 0xdc, 0xd9, 0xfc, 0xf8, 0x61, 0x77, 0x15, 0xad,
+	0x7b, 0xc1, 0x63, 0xc7, 0x95, 0x37, 0xbf, 0xed, 0x6e, 0xe0, 0x34, 0x11, 0x1d, 0x42, 0x8d, 0x8a,
+	0xe7, 0x53, 0xa3, 0x24, 0x3c, 0x8f, 0xd6, 0x3d, 0x12, 0x2e, 0x35, 0x65, 0xa9, 0xe8, 0x53, 0xd0,
+	0xc8, 0x0f, 0x24, 0x88, 0x7d, 0x37, 0xa1, 0x46, 0x59, 0xf8, 0x1e, 0xaf, 0xfb, 0xcc, 0x34, 0x9c,
+	0x3a, 0x8b, 0x74, 0xf4, 0x39, 0xc0, 0xc2, 0xa3, 0x2c, 0x9a, 0x27, 0x6e, 0x40, 0x8d, 0x8a, 0x30,
+	0xbf, 0xb3, 0x6e, 0x3e, 0xcf, 0xe2, 0xa9, 0x7b, 0xc5, 0x80, 0x0e, 0xa0, 0x16, 0xf4, 0x1d, 0x7e,
+	0xfe, 0x06, 0x69, 0x29, 0x9d, 0xe6, 0x5d, 0xe0, 0x61, 0xdf, 0xbe, 0x89, 0x09, 0x56, 0x03, 0xf1,
+	0x1f, 0xed, 0x83, 0x4a, 0xa3, 0x65, 0x32, 0x25, 0xc6, 0xec, 0xbe, 0x6c, 0x4b, 0xc4, 0x70, 0x9a,
+	0x83, 0x0e, 0xa0, 0x22, 0x2a, 0xff, 0x59, 0x13, 0xc9, 0xc6, 0x9d, 0xd2, 0x84, 0x25, 0xde, 0x54,
+	0x94, 0x17, 0x69, 0xed, 0xa7, 0x50, 0x15, 0x67, 0x8a, 0x10, 0x54, 0x42, 0x37, 0x90, 0x57, 0xd7,
+	0xc0, 0x62, 0x5d, 0xdc, 0x67, 0x49, 0x88, 0x72, 0xd3, 0xfe, 0x0c, 0xd4, 0x0b, 0x79, 0xf2, 0xff,
+	0xff, 0xb2, 0xda, 0x3f, 0x2b, 0xd0, 0x10, 0xfa, 0xd0, 0x65, 0xd3, 0x05, 0x49, 0x50, 0x3f, 0xe5,
+	0x55, 0x04, 0xee, 0xee, 0x3d, 0x15, 0xd2, 0xcc, 0x6e, 0x41, 0x9d, 0xc3, 0x96, 0xee, 0x83, 0x2d,
+	0xaf, 0xc2, 0x76, 0xa0, 0x22, 0x0e, 0x51, 0x85, 0x92, 0xf9, 0x5c, 0xdf, 0x40, 0x35, 0x28, 0x8f,
+	0xcc, 0xe7, 0xba, 0xc2, 0x05, 0x6c, 0xea, 0x25, 0x21, 0x60, 0x53, 0x2f, 0xb7, 0x7f, 0xa9, 0x82,
+	0x96, 0xdf, 0x1a, 0x7a, 0x0f, 0xb4, 0x69, 0xb4, 0x0c, 0x99, 0xe3, 0x85, 0x4c, 0xb0, 0x55, 0x70,
+	0x5d, 0x08, 0x83, 0x90, 0xa1, 0x5d, 0xd8, 0x94, 0xc1, 0x99, 0x1f, 0xb9, 0x4c, 0x50, 0x28, 0x18,
+	0x84, 0x74, 0xca, 0x15, 0xa4, 0x43, 0x99, 0x2e, 0x03, 0x41, 0xa2, 0x60, 0xbe, 0x44, 0x8f, 0x41,
+	0xa5, 0xd3, 0x05, 0x09, 0x5c, 0xa3, 0xd2, 0x52, 0x3a, 0x0f, 0x70, 0xba, 0x43, 0x1f, 0x42, 0xf3,
+	0x35, 0x49, 0x22, 0x87, 0x2d, 0x12, 0x42, 0x17, 0x91, 0x7f, 0x6d, 0x54, 0x85, 0x69, 0x8b, 0xab,
+	0x76, 0x26, 0xa2, 0x0f, 0xd2, 0xb4, 0x82, 0x49, 0x15, 0x4c, 0x0d, 0xae, 0x9e, 0x64, 0x5c, 0x1d,
+	0xd0, 0x57, 0xb2, 0x24, 0x5c, 0x4d, 0x94, 0x6b, 0xe6, 0x79, 0x12, 0xd0, 0x84, 0x66, 0x48, 0xe6,
+	0x2e, 0xf3, 0x5e, 0x11, 0x87, 0xc6, 0x6e, 0x48, 0x8d, 0xba, 0xb8, 0xc1, 0x3b, 0xed, 0x72, 0xbc,
+	0x9c, 0x7e, 0x4f, 0x98, 0x15, 0xbb, 0x61, 0x7a, 0x8d, 0x5b, 0x99, 0x8b, 0x6b, 0x14, 0x7d, 0x04,
+	0xdb, 0x79, 0x99, 0x6b, 0xe2, 0x33, 0x97, 0x1a, 0x5a, 0xab, 0xdc, 0x41, 0x38, 0xaf, 0xfe, 0xb5,
+	0x50, 0xd7, 0x12, 0x05, 0x1d, 0x35, 0xa0, 0x55, 0xe6, 0x60, 0x99, 0x2c, 0xe0, 0x28, 0x07, 0x8b,
+	0x23, 0xea, 0xad, 0x80, 0x6d, 0xfe, 0x37, 0xb0, 0xcc, 0x95, 0x83, 0xe5, 0x65, 0x52, 0xb0, 0x86,
+	0x04, 0xcb, 0xe4, 0x02, 0x2c, 0x4f, 0x4c, 0xc1, 0xb6, 0x24, 0x58, 0x26, 0xa7, 0x60, 0x5f, 0x01,
+	0x24, 0x84, 0x12, 0xe6, 0x2c, 0xf8, 0xe9, 0x37, 0x45, 0xb7, 0xbe, 0xff, 0x0f, 0xef, 0x7c, 0x17,
+	0xf3, 0xcc, 0x73, 0x2f, 0x64, 0x58, 0x4b, 0xb2, 0xe5, 0xfa, 0x1c, 0xdc, 0xbe, 0x3b, 0x07, 0x0f,
+	0x41, 0xcb, 0x5d, 0x68, 0x13, 0x6a, 0x57, 0xa3, 0x6f, 0x46, 0xe3, 0x6f, 0x47, 0xb2, 0x65, 0x5f,
+	0x98, 0x96, 0x6c, 0xd9, 0xd1, 0x58, 0x2f, 0x21, 0x0d, 0xaa, 0x67, 0x47, 0x57, 0x67, 0xbc, 0x69,
+	0x9f, 0x01, 0x14, 0x47, 0xc1, 0x9b, 0x2c, 0x9a, 0xcd, 0x28, 0x91, 0x1d, 0xfb, 0x00, 0xa7, 0x3b,
+	0xae, 0xfb, 0x24, 0x9c, 0xb3, 0x85, 0x68, 0xd5, 0x2d, 0x9c, 0xee, 0xda, 0x2f, 0xa1, 0x9e, 0x0d,
+	0xb9, 0xb7, 0x19, 0xbc, 0x6b, 0xe3, 0xe1, 0xfe, 0x71, 0x5f, 0xbe, 0xfb, 0x31, 0x7f, 0x52, 0xa0,
+	0x29, 0x87, 0xd0, 0x90, 0x30, 0xf7, 0xda, 0x65, 0x2e, 0xda, 0x5f, 0x9b, 0x00, 0xff, 0x32, 0xb0,
+	0xd0, 0x3e, 0xa0, 0x40, 0x68, 0xce, 0xcc, 0x0d, 0x3c, 0xff, 0xc6, 0xc9, 0x07, 0x81, 0x86, 0x75,
+	0x19, 0x39, 0x15, 0x81, 0x11, 0x1f, 0x0a, 0x08, 0x2a, 0x0b, 0xe2, 0xc7, 0xe2, 0xa5, 0xd3, 0xb0,
+	0x58, 0x73, 0x6d, 0x19, 0x7a, 0x4c, 0xbc, 0x68, 0x1a, 0x16, 0xeb, 0xb6, 0x0d, 0x68, 0x9d, 0xea,
+	0xc2, 0xa3, 0x0c, 0x7d, 0x01, 0xf5, 0x20, 0xdd, 0xa7, 0xa7, 0xf2, 0xe4, 0x3e, 0xba, 0xcc, 0x93,
+	0x1e, 0x4f, 0xee, 0xd9, 0x7b, 0x0d, 0x50, 0xf0, 0xaf, 0x5f, 0xea, 0x26, 0xd4, 0x4e, 0xc6, 0x57,
+	0x23, 0xdb, 0xc4, 0xba, 0x52, 0x5c, 0x68, 0x09, 0x6d, 0x81, 0x76, 0x3e, 0xb0, 0xec, 0xf1, 0x19,
+	0x3e, 0x1a, 0xea, 0x65, 0xf4, 0x10, 0xb6, 0x45, 0xc4, 0x29, 0xc4, 0x0a, 0xf7, 0x5a, 0x57, 0xc3,
+	0xe1, 0x11, 0x7e, 0xa1, 0x57, 0x51, 0x1d, 0x2a, 0x83, 0xd1, 0xe9, 0x58, 0x57, 0x51, 0x03, 0xea,
+	0x96, 0x7d, 0x64, 0x9b, 0x96, 0x69, 0xeb, 0xb5, 0xbd, 0x43, 0x50, 0xe5, 0xf7, 0x08, 0xd7, 0x87,
+	0x7d, 0x47, 0x3e, 0x60, 0x03, 0x35, 0x01, 0x86, 0x7d, 0xa7, 0x78, 0xb6, 0x8c, 0xda, 0x83, 0xa1,
+	0x89, 0xf5, 0xd2, 0xde, 0x27, 0xa0, 0xca, 0xef, 0x13, 0x9e, 0x77, 0x89, 0xc7, 0x43, 0xd3, 0x3e,
+	0x37, 0xaf, 0x2c, 0x7d, 0x83, 0xe7, 0x9d, 0xe1, 0xa3, 0xcb, 0xf3, 0x81, 0x6d, 0xea, 0x0a, 0xd2,
+	0xa1, 0x31, 0xbe, 0x34, 0x47, 0xce, 0xd0, 0xb4, 0xf1, 0xe0, 0xc4, 0xd2, 0x4b, 0xc7, 0xc6, 0x9b,
+	0xdb, 0x1d, 0xe5, 0xd7, 0xdb, 0x1d, 0xe5, 0xf7, 0xdb, 0x1d, 0xe5, 0xc7, 0x3f, 0x76, 0x36, 0xbe,
+	0x53, 0xe5, 0x0f, 0x8d, 0x89, 0x2a, 0x7e, 0x26, 0xf4, 0xff, 0x1e, 0x00, 0x89, 0xd0, 0x01, 0x62,
+	0xa6, 0x08, 0x00, 0x00,
 }
@@ -2847,6 +2847,7 @@ func init() {
 	MustRegisterFunction(alias)
 	MustRegisterFunction(aliasByMetric)
 	MustRegisterFunction(aliasByNode)
+	MustRegisterFunction(aliasByTags)
 	MustRegisterFunction(aliasSub)
 	MustRegisterFunction(applyByNode).WithDefaultParams(map[uint8]interface{}{
 		4: "", // newName
@@ -2887,6 +2888,7 @@ func init() {
 		3: "average", // fname
 	})
 	MustRegisterFunction(groupByNodes)
+	MustRegisterFunction(groupByTags)
 	MustRegisterFunction(highest).WithDefaultParams(map[uint8]interface{}{
 		2: 1,         // n,
 		3: "average", // f
@@ -2992,6 +2994,7 @@ func init() {
 	})
 	MustRegisterFunction(scale)
 	MustRegisterFunction(scaleToSeconds)
+	MustRegisterFunction(seriesByTag)
 	MustRegisterFunction(sortBy).WithDefaultParams(map[uint8]interface{}{
 		2: "average", // fn
 		3: false,     // reverse
@@ -3047,7 +3050,6 @@ func init() {
 
 	// alias functions - in alpha ordering
 	MustRegisterAliasedFunction("abs", absolute)
-	MustRegisterAliasedFunction("aliasByTags", aliasByNode)
 	MustRegisterAliasedFunction("avg", averageSeries)
 	MustRegisterAliasedFunction("log", logarithm)
 	MustRegisterAliasedFunction("max", maxSeries)
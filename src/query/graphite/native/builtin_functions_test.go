@@ -4616,6 +4616,7 @@ func TestFunctionsRegistered(t *testing.T) {
 		"group",
 		"groupByNode",
 		"groupByNodes",
+		"groupByTags",
 		"highest",
 		"highestAverage",
 		"highestCurrent",
@@ -4667,6 +4668,7 @@ func TestFunctionsRegistered(t *testing.T) {
 		"removeEmptySeries",
 		"scale",
 		"scaleToSeconds",
+		"seriesByTag",
 		"smartSummarize",
 		"sortByMaxima",
 		"sortByMinima",
@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/query/graphite/common"
+	"github.com/m3db/m3/src/query/graphite/storage"
+	"github.com/m3db/m3/src/query/graphite/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+)
+
+const (
+	graphiteTagSeparator      = ";"
+	graphiteTagValueSeparator = "="
+)
+
+var errNoGroupByTags = xerrors.NewInvalidParamsError(
+	errors.New("groupByTags requires at least one tag"))
+
+// seriesByTag returns the series which match all of the given tag
+// expressions, i.e. seriesByTag('name=foo.bar', 'dc=~us-.*', 'host!=a').
+func seriesByTag(ctx *common.Context, tagExpressions ...string) (ts.SeriesList, error) {
+	// Translate the expressions upfront since storage will return an empty
+	// result for a query it cannot translate rather than an error.
+	if _, err := storage.TranslateTagExpressionsToMatchers(tagExpressions); err != nil {
+		return ts.NewSeriesList(), xerrors.NewInvalidParamsError(err)
+	}
+
+	begin := time.Now()
+	query := storage.SeriesByTagQuery(tagExpressions)
+	opts := storage.FetchOptions{
+		StartTime: ctx.StartTime,
+		EndTime:   ctx.EndTime,
+		DataOptions: storage.DataOptions{
+			Timeout: ctx.Timeout,
+		},
+		QueryFetchOpts: ctx.FetchOpts,
+	}
+
+	result, err := ctx.Engine.FetchByQuery(ctx, query, opts)
+	if err != nil {
+		return ts.NewSeriesList(), err
+	}
+
+	if ctx.TracingEnabled() {
+		ctx.Trace(common.Trace{
+			ActivityName: fmt.Sprintf("fetch %s", query),
+			Duration:     time.Since(begin),
+			Outputs:      common.TraceStats{NumSeries: len(result.SeriesList)},
+		})
+	}
+
+	for _, r := range result.SeriesList {
+		r.Specification = query
+	}
+
+	return ts.SeriesList{
+		Values:   result.SeriesList,
+		Metadata: result.Metadata,
+	}, nil
+}
+
+// groupByTags takes a serieslist and maps a callback to subgroups within as
+// defined by the values of the given tags, the resulting series are named
+// after the callback (or the series name when grouping by the "name" tag)
+// followed by the tags they were grouped by, i.e. sum;dc=east;host=a.
+func groupByTags(ctx *common.Context, seriesList singlePathSpec, fname string, tags ...string) (ts.SeriesList, error) {
+	if len(tags) == 0 {
+		return ts.NewSeriesList(), errNoGroupByTags
+	}
+
+	var (
+		groupByName bool
+		groupTags   = make([]string, 0, len(tags))
+	)
+	for _, tag := range tags {
+		if tag == storage.SeriesByTagNameTag {
+			groupByName = true
+			continue
+		}
+		groupTags = append(groupTags, tag)
+	}
+	sort.Strings(groupTags)
+
+	groups := make(map[string][]*ts.Series)
+	for _, series := range seriesList.Values {
+		parsed, err := seriesTags(series)
+		if err != nil {
+			return ts.NewSeriesList(), err
+		}
+
+		name := fname
+		if groupByName {
+			name = parsed[storage.SeriesByTagNameTag]
+		}
+
+		parts := make([]string, 0, len(groupTags)+1)
+		parts = append(parts, name)
+		for _, tag := range groupTags {
+			parts = append(parts, tag+graphiteTagValueSeparator+parsed[tag])
+		}
+
+		key := strings.Join(parts, graphiteTagSeparator)
+		groups[key] = append(groups[key], series)
+	}
+
+	return applyFnToMetaSeries(ctx, seriesList, groups, fname)
+}
+
+// aliasByTags renames a time series result according to the values of the
+// given tags, numeric arguments refer to nodes of the series path as with
+// aliasByNode.
+func aliasByTags(ctx *common.Context, seriesList singlePathSpec, tags ...genericInterface) (ts.SeriesList, error) {
+	renamed := make([]*ts.Series, 0, ts.SeriesList(seriesList).Len())
+	for _, series := range seriesList.Values {
+		parsed, err := seriesTags(series)
+		if err != nil {
+			return ts.NewSeriesList(), err
+		}
+
+		pathParts := strings.Split(parsed[storage.SeriesByTagNameTag], ".")
+		newNameParts := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			var value string
+			switch v := tag.(type) {
+			case string:
+				value = parsed[v]
+			case float64:
+				value = pathNode(pathParts, int(v))
+			case int:
+				value = pathNode(pathParts, v)
+			default:
+				err := fmt.Errorf("invalid tag %v: must be a tag name or node index", tag)
+				return ts.NewSeriesList(), xerrors.NewInvalidParamsError(err)
+			}
+
+			if value != "" {
+				newNameParts = append(newNameParts, value)
+			}
+		}
+
+		newName := strings.Join(newNameParts, ".")
+		renamed = append(renamed, series.RenamedTo(newName))
+	}
+
+	seriesList.Values = renamed
+	return ts.SeriesList(seriesList), nil
+}
+
+// pathNode returns the node at the given index of the path, supporting
+// negative indexes as graphite does.
+func pathNode(pathParts []string, node int) string {
+	if node < 0 {
+		node += len(pathParts)
+	}
+	if node < 0 || node >= len(pathParts) {
+		return ""
+	}
+	return pathParts[node]
+}
+
+// seriesTags returns the graphite tags of a series parsed from its name,
+// i.e. foo.bar;dc=east;host=a, with the series path returned under the
+// "name" tag.
+func seriesTags(series *ts.Series) (map[string]string, error) {
+	name := series.Name()
+	tagsIdx := strings.Index(name, graphiteTagSeparator)
+	if tagsIdx == -1 {
+		path, err := getFirstPathExpression(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]string{storage.SeriesByTagNameTag: path}, nil
+	}
+
+	// The tagged name may have been wrapped by functions, i.e.
+	// scale(foo.bar;dc=east,2), so find the bounds of the tagged name
+	// surrounding the first tag separator.
+	start := strings.LastIndexAny(name[:tagsIdx], "(, ") + 1
+	end := len(name)
+	if idx := strings.IndexAny(name[tagsIdx:], "), "); idx != -1 {
+		end = tagsIdx + idx
+	}
+
+	parts := strings.Split(name[start:end], graphiteTagSeparator)
+	tags := make(map[string]string, len(parts))
+	tags[storage.SeriesByTagNameTag] = parts[0]
+	for _, part := range parts[1:] {
+		idx := strings.Index(part, graphiteTagValueSeparator)
+		if idx <= 0 {
+			continue
+		}
+		tags[part[:idx]] = part[idx+1:]
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/graphite/common"
+	"github.com/m3db/m3/src/query/graphite/storage"
+	"github.com/m3db/m3/src/query/graphite/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xgomock "github.com/m3db/m3/src/x/test"
+)
+
+func TestSeriesByTag(t *testing.T) {
+	ctrl := xgomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	engine := NewEngine(store, CompileOptions{})
+	ctx := common.NewContext(common.ContextOptions{
+		Start:  time.Now().Add(-1 * time.Hour),
+		End:    time.Now(),
+		Engine: engine,
+	})
+	defer ctx.Close()
+
+	stepSize := int((10 * time.Minute) / time.Millisecond)
+	store.EXPECT().
+		FetchByQuery(gomock.Any(), "seriesByTag('name=foo.bar','dc=east')", gomock.Any()).
+		DoAndReturn(buildTestSeriesFn(stepSize,
+			"foo.bar;dc=east;host=a",
+			"foo.bar;dc=east;host=b"))
+
+	expr, err := engine.Compile("aliasByTags(seriesByTag('name=foo.bar', 'dc=east'), 'host', 1)")
+	require.NoError(t, err)
+
+	res, err := expr.Execute(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.Len())
+	assert.Equal(t, "a.bar", res.Values[0].Name())
+	assert.Equal(t, "b.bar", res.Values[1].Name())
+}
+
+func TestSeriesByTagInvalidExpressions(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	_, err := seriesByTag(ctx, "dc!=east")
+	require.Error(t, err)
+	assert.True(t, xerrors.IsInvalidParams(err))
+}
+
+func TestGroupByTags(t *testing.T) {
+	var (
+		start, _ = time.Parse(time.RFC1123, "Mon, 27 Jul 2015 19:41:19 GMT")
+		ctx      = common.NewContext(common.ContextOptions{Start: start, End: start.Add(2 * time.Minute)})
+		inputs   = []*ts.Series{
+			ts.NewSeries(ctx, "cpu.load;dc=east;host=a", start,
+				ts.NewConstantValues(ctx, 2, 12, 10000)),
+			ts.NewSeries(ctx, "scale(cpu.load;dc=east;host=b,2)", start,
+				ts.NewConstantValues(ctx, 4, 12, 10000)),
+			ts.NewSeries(ctx, "cpu.load;dc=west;host=c", start,
+				ts.NewConstantValues(ctx, 6, 12, 10000)),
+			ts.NewSeries(ctx, "cpu.idle;host=d", start,
+				ts.NewConstantValues(ctx, 8, 12, 10000)),
+		}
+	)
+	defer ctx.Close()
+
+	type result struct {
+		name      string
+		sumOfVals float64
+	}
+
+	tests := []struct {
+		fname    string
+		tags     []string
+		expected []result
+	}{
+		{"sum", []string{"dc"}, []result{
+			{"sum;dc=", 8 * 12},
+			{"sum;dc=east", 6 * 12},
+			{"sum;dc=west", 6 * 12},
+		}},
+		{"max", []string{"name", "dc"}, []result{
+			{"cpu.idle;dc=", 8 * 12},
+			{"cpu.load;dc=east", 4 * 12},
+			{"cpu.load;dc=west", 6 * 12},
+		}},
+	}
+
+	for _, test := range tests {
+		outSeries, err := groupByTags(ctx, singlePathSpec{
+			Values: inputs,
+		}, test.fname, test.tags...)
+		require.NoError(t, err)
+		require.Equal(t, len(test.expected), len(outSeries.Values))
+
+		outSeries, _ = sortByName(ctx, singlePathSpec(outSeries), false, false)
+		for i, expected := range test.expected {
+			assert.Equal(t, expected.name, outSeries.Values[i].Name())
+			assert.Equal(t, expected.sumOfVals, outSeries.Values[i].SafeSum())
+		}
+	}
+
+	_, err := groupByTags(ctx, singlePathSpec{Values: inputs}, "sum")
+	require.Error(t, err)
+}
+
+func TestAliasByTags(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	now := time.Now()
+	values := ts.NewConstantValues(ctx, 10.0, 1000, 10)
+	series := []*ts.Series{
+		ts.NewSeries(ctx, "foo.bar.baz;dc=east;host=a", now, values),
+		ts.NewSeries(ctx, "derivative(foo.bar.qux;host=b)", now, values),
+		ts.NewSeries(ctx, "foo.bar.zed", now, values),
+	}
+
+	results, err := aliasByTags(ctx, singlePathSpec{
+		Values: series,
+	}, "dc", "host", -1)
+	require.NoError(t, err)
+	require.Equal(t, len(series), results.Len())
+
+	names := make([]string, 0, results.Len())
+	for _, s := range results.Values {
+		names = append(names, s.Name())
+	}
+	sort.Strings(names)
+	assert.Equal(t, []string{"b.qux", "east.a.baz", "zed"}, names)
+}
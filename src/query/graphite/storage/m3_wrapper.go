@@ -173,7 +173,17 @@ func translateQuery(
 	fetchOpts FetchOptions,
 	opts M3WrappedStorageOptions,
 ) (*storage.FetchQuery, error) {
-	matchers, _, err := TranslateQueryToMatchersWithTerminator(query)
+	var matchers models.Matchers
+	tagExpressions, isSeriesByTag, err := parseSeriesByTagQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if isSeriesByTag {
+		matchers, err = TranslateTagExpressionsToMatchers(tagExpressions)
+	} else {
+		matchers, _, err = TranslateQueryToMatchersWithTerminator(query)
+	}
 	if err != nil {
 		return nil, err
 	}
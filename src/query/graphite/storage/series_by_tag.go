@@ -0,0 +1,301 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/query/graphite/graphite"
+	"github.com/m3db/m3/src/query/models"
+)
+
+const (
+	// SeriesByTagNameTag is the tag used by seriesByTag expressions to refer
+	// to the graphite path of a series.
+	SeriesByTagNameTag = "name"
+
+	seriesByTagPrefix = "seriesByTag("
+	seriesByTagSuffix = ")"
+
+	// NB: order matters here since "!=~" must be checked before "!=" and
+	// "=~" before "=".
+	tagOpNotRegexp = "!=~"
+	tagOpNotEqual  = "!="
+	tagOpRegexp    = "=~"
+	tagOpEqual     = "="
+)
+
+var (
+	seriesByTagEscaper = strings.NewReplacer(`\`, `\\`, "'", `\'`)
+
+	errNoTagExpressions       = errors.New("seriesByTag requires at least one tag expression")
+	errNoPositiveTagExpession = errors.New("seriesByTag requires at least one " +
+		"tag expression that matches a non-empty value")
+)
+
+// SeriesByTagQuery returns the query for a seriesByTag call with the given
+// tag expressions, this is the query that is used as the series path
+// expression and which is understood by FetchByQuery.
+func SeriesByTagQuery(tagExpressions []string) string {
+	var b strings.Builder
+	b.WriteString(seriesByTagPrefix)
+	for i, expr := range tagExpressions {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('\'')
+		b.WriteString(seriesByTagEscaper.Replace(expr))
+		b.WriteByte('\'')
+	}
+	b.WriteString(seriesByTagSuffix)
+	return b.String()
+}
+
+// parseSeriesByTagQuery returns the tag expressions of a seriesByTag query
+// and whether the query was a seriesByTag query at all.
+func parseSeriesByTagQuery(query string) ([]string, bool, error) {
+	if !strings.HasPrefix(query, seriesByTagPrefix) ||
+		!strings.HasSuffix(query, seriesByTagSuffix) {
+		return nil, false, nil
+	}
+
+	var (
+		args  = query[len(seriesByTagPrefix) : len(query)-len(seriesByTagSuffix)]
+		exprs []string
+	)
+	for i := 0; i < len(args); {
+		quote := args[i]
+		if quote != '\'' && quote != '"' {
+			return nil, true, fmt.Errorf("invalid seriesByTag query: %s", query)
+		}
+
+		var (
+			expr   strings.Builder
+			closed bool
+		)
+		for i++; i < len(args); i++ {
+			c := args[i]
+			if c == '\\' && i+1 < len(args) {
+				i++
+				expr.WriteByte(args[i])
+				continue
+			}
+			if c == quote {
+				closed = true
+				i++
+				break
+			}
+			expr.WriteByte(c)
+		}
+		if !closed {
+			return nil, true, fmt.Errorf("invalid seriesByTag query: %s", query)
+		}
+
+		exprs = append(exprs, expr.String())
+		for i < len(args) && args[i] == ' ' {
+			i++
+		}
+		if i < len(args) {
+			if args[i] != ',' {
+				return nil, true, fmt.Errorf("invalid seriesByTag query: %s", query)
+			}
+			i++
+			for i < len(args) && args[i] == ' ' {
+				i++
+			}
+		}
+	}
+
+	return exprs, true, nil
+}
+
+// TranslateTagExpressionsToMatchers converts graphite seriesByTag tag
+// expressions, i.e. tag=value, tag!=value, tag=~regex and tag!=~regex, to
+// tag matchers. The special tag "name" refers to the graphite path of the
+// series. As with graphite, regular expressions are only anchored at the
+// start of the value and an empty value matches series without the tag.
+func TranslateTagExpressionsToMatchers(
+	tagExpressions []string,
+) (models.Matchers, error) {
+	if len(tagExpressions) == 0 {
+		return nil, errNoTagExpressions
+	}
+
+	var (
+		matchers    = make(models.Matchers, 0, len(tagExpressions))
+		hasPositive bool
+	)
+	for _, expr := range tagExpressions {
+		tag, op, value, err := parseTagExpression(expr)
+		if err != nil {
+			return nil, err
+		}
+
+		if tag == SeriesByTagNameTag {
+			nameMatchers, err := nameTagMatchers(op, value)
+			if err != nil {
+				return nil, err
+			}
+
+			matchers = append(matchers, nameMatchers...)
+			hasPositive = hasPositive || op == tagOpEqual || op == tagOpRegexp
+			continue
+		}
+
+		var m models.Matcher
+		switch op {
+		case tagOpEqual:
+			if value == "" {
+				m = models.Matcher{Type: models.MatchNotField, Name: []byte(tag)}
+				break
+			}
+			m = models.Matcher{Type: models.MatchEqual, Name: []byte(tag),
+				Value: []byte(value)}
+			hasPositive = true
+		case tagOpNotEqual:
+			if value == "" {
+				m = models.Matcher{Type: models.MatchField, Name: []byte(tag)}
+				hasPositive = true
+				break
+			}
+			m = models.Matcher{Type: models.MatchNotEqual, Name: []byte(tag),
+				Value: []byte(value)}
+		case tagOpRegexp, tagOpNotRegexp:
+			pattern, anchored, err := prefixRegexp(value)
+			if err != nil {
+				return nil, err
+			}
+			if !anchored {
+				pattern += ".*"
+			}
+
+			matchType := models.MatchRegexp
+			if op == tagOpNotRegexp {
+				matchType = models.MatchNotRegexp
+			} else if matchesEmpty, _ := regexp.MatchString(pattern, ""); !matchesEmpty {
+				hasPositive = true
+			}
+			m = models.Matcher{Type: matchType, Name: []byte(tag),
+				Value: []byte(pattern)}
+		}
+
+		matchers = append(matchers, m)
+	}
+
+	if !hasPositive {
+		return nil, errNoPositiveTagExpession
+	}
+
+	return matchers, nil
+}
+
+func parseTagExpression(expr string) (tag, op, value string, err error) {
+	for _, candidate := range []string{
+		tagOpNotRegexp, tagOpNotEqual, tagOpRegexp, tagOpEqual,
+	} {
+		idx := strings.Index(expr, candidate)
+		if idx == -1 {
+			continue
+		}
+
+		// Make sure the operator found is the first one in the expression,
+		// i.e. "a=b!=c" is "a" equal to "b!=c".
+		if eqIdx := strings.IndexByte(expr, '='); eqIdx < idx {
+			continue
+		}
+
+		tag, op, value = expr[:idx], candidate, expr[idx+len(candidate):]
+		if tag == "" {
+			return "", "", "", fmt.Errorf("invalid tag expression: %s", expr)
+		}
+		return tag, op, value, nil
+	}
+
+	return "", "", "", fmt.Errorf("invalid tag expression: %s", expr)
+}
+
+// prefixRegexp anchors a graphite tag regexp only at the start of the value
+// since tag matcher regexps are anchored at both ends, returning the regexp
+// without any trailing match-all and whether it was explicitly anchored at
+// the end of the value.
+func prefixRegexp(value string) (string, bool, error) {
+	if _, err := regexp.Compile(value); err != nil {
+		return "", false, err
+	}
+
+	if strings.HasSuffix(value, "$") && !strings.HasSuffix(value, `\$`) {
+		return "(?:" + strings.TrimSuffix(value, "$") + ")", true, nil
+	}
+
+	return "(?:" + value + ")", false, nil
+}
+
+func nameTagMatchers(op, value string) (models.Matchers, error) {
+	switch op {
+	case tagOpEqual:
+		if value == "" {
+			return nil, fmt.Errorf("invalid empty value for tag: %s",
+				SeriesByTagNameTag)
+		}
+
+		parts := strings.Split(value, ".")
+		matchers := make(models.Matchers, 0, len(parts)+1)
+		for i, part := range parts {
+			matchers = append(matchers, models.Matcher{
+				Type:  models.MatchEqual,
+				Name:  graphite.TagName(i),
+				Value: []byte(part),
+			})
+		}
+		return append(matchers, matcherTerminator(len(parts))), nil
+	case tagOpNotEqual:
+		return models.Matchers{{
+			Type:  models.MatchNotRegexp,
+			Name:  doc.IDReservedFieldName,
+			Value: []byte(regexp.QuoteMeta(value) + "(?:;.*)?"),
+		}}, nil
+	}
+
+	// The series ID is the path followed by any ;tag=value pairs, so match
+	// the path with the regexp and allow any tags to follow it.
+	pattern, anchored, err := prefixRegexp(value)
+	if err != nil {
+		return nil, err
+	}
+	if !anchored {
+		pattern += "[^;]*"
+	}
+
+	matchType := models.MatchRegexp
+	if op == tagOpNotRegexp {
+		matchType = models.MatchNotRegexp
+	}
+
+	return models.Matchers{{
+		Type:  matchType,
+		Name:  doc.IDReservedFieldName,
+		Value: []byte(pattern + "(?:;.*)?"),
+	}}, nil
+}
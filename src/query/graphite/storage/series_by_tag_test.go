@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/query/graphite/graphite"
+	"github.com/m3db/m3/src/query/models"
+)
+
+func TestSeriesByTagQueryRoundTrip(t *testing.T) {
+	exprs := []string{"name=foo.bar", `dc=~us-.*\.east`, "host!='a'"}
+	query := SeriesByTagQuery(exprs)
+	assert.Equal(t, `seriesByTag('name=foo.bar','dc=~us-.*\\.east','host!=\'a\'')`, query)
+
+	parsed, ok, err := parseSeriesByTagQuery(query)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, exprs, parsed)
+
+	parsed, ok, err = parseSeriesByTagQuery(`seriesByTag("dc=east", 'host=a')`)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, []string{"dc=east", "host=a"}, parsed)
+
+	_, ok, err = parseSeriesByTagQuery("foo.bar.*")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, ok, err = parseSeriesByTagQuery("seriesByTag('dc=east)")
+	require.Error(t, err)
+	require.True(t, ok)
+}
+
+func TestTranslateTagExpressionsToMatchers(t *testing.T) {
+	matchers, err := TranslateTagExpressionsToMatchers([]string{
+		"name=foo.bar",
+		"dc=east",
+		"host!=a",
+		"env=~prod",
+		"role!=~db$",
+		"team=",
+		"owner!=",
+	})
+	require.NoError(t, err)
+
+	expected := models.Matchers{
+		{Type: models.MatchEqual, Name: graphite.TagName(0), Value: []byte("foo")},
+		{Type: models.MatchEqual, Name: graphite.TagName(1), Value: []byte("bar")},
+		{Type: models.MatchNotField, Name: graphite.TagName(2)},
+		{Type: models.MatchEqual, Name: []byte("dc"), Value: []byte("east")},
+		{Type: models.MatchNotEqual, Name: []byte("host"), Value: []byte("a")},
+		{Type: models.MatchRegexp, Name: []byte("env"), Value: []byte("(?:prod).*")},
+		{Type: models.MatchNotRegexp, Name: []byte("role"), Value: []byte("(?:db)")},
+		{Type: models.MatchNotField, Name: []byte("team")},
+		{Type: models.MatchField, Name: []byte("owner")},
+	}
+	assert.Equal(t, expected, matchers)
+}
+
+func TestTranslateTagExpressionsToMatchersName(t *testing.T) {
+	matchers, err := TranslateTagExpressionsToMatchers([]string{"name=~foo\\."})
+	require.NoError(t, err)
+	assert.Equal(t, models.Matchers{{
+		Type:  models.MatchRegexp,
+		Name:  doc.IDReservedFieldName,
+		Value: []byte(`(?:foo\.)[^;]*(?:;.*)?`),
+	}}, matchers)
+}
+
+func TestTranslateTagExpressionsToMatchersErrors(t *testing.T) {
+	for _, exprs := range [][]string{
+		{},
+		{"dc"},
+		{"=east"},
+		{"dc!=east"},
+		{"dc=~.*"},
+		{"dc="},
+		{"dc=~("},
+	} {
+		_, err := TranslateTagExpressionsToMatchers(exprs)
+		assert.Error(t, err, "%v", exprs)
+	}
+}
+
+func TestTranslateQuerySeriesByTag(t *testing.T) {
+	query := SeriesByTagQuery([]string{"name=foo", "dc=east"})
+	translated, err := translateQuery(query, FetchOptions{
+		StartTime: time.Unix(0, 0),
+		EndTime:   time.Unix(60, 0),
+	}, M3WrappedStorageOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, query, translated.Raw)
+	assert.Equal(t, models.Matchers{
+		{Type: models.MatchEqual, Name: graphite.TagName(0), Value: []byte("foo")},
+		{Type: models.MatchNotField, Name: graphite.TagName(1)},
+		{Type: models.MatchEqual, Name: []byte("dc"), Value: []byte("east")},
+	}, translated.TagMatchers)
+}
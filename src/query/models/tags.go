@@ -35,6 +35,9 @@ import (
 
 var (
 	errNoTags = errors.New("no tags")
+
+	graphitePathTagPrefix = []byte("__g")
+	graphitePathTagSuffix = []byte("__")
 )
 
 // NewTags builds a tags with the given size and tag options.
@@ -246,6 +249,16 @@ func (t sortableTagsNumericallyAsc) Swap(i, j int) {
 }
 func (t sortableTagsNumericallyAsc) Less(i, j int) bool {
 	iName, jName := t.Tags[i].Name, t.Tags[j].Name
+	iPath, jPath := isGraphitePathTag(iName), isGraphitePathTag(jName)
+	if iPath != jPath {
+		// Path tags always sort before any Graphite 1.1 style tags.
+		return iPath
+	}
+
+	if !iPath {
+		return bytes.Compare(iName, jName) == -1
+	}
+
 	lenDiff := len(iName) - len(jName)
 	if lenDiff < 0 {
 		return true
@@ -258,6 +271,25 @@ func (t sortableTagsNumericallyAsc) Less(i, j int) bool {
 	return bytes.Compare(iName, jName) == -1
 }
 
+// isGraphitePathTag returns true if the tag name is one of the __gN__ tag
+// names used to store the components of a graphite path.
+func isGraphitePathTag(name []byte) bool {
+	if len(name) < len(graphitePathTagPrefix)+len(graphitePathTagSuffix)+1 ||
+		!bytes.HasPrefix(name, graphitePathTagPrefix) ||
+		!bytes.HasSuffix(name, graphitePathTagSuffix) {
+		return false
+	}
+
+	digits := name[len(graphitePathTagPrefix) : len(name)-len(graphitePathTagSuffix)]
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Normalize normalizes the tags by sorting them in place.
 // In the future, it might also ensure other things like uniqueness.
 func (t Tags) Normalize() Tags {
@@ -209,25 +209,47 @@ func prependMetaLen(t Tags) (int, []int) {
 }
 
 func idLenGraphite(t Tags) int {
-	idLen := t.Len() - 1 // account for separators
-	for _, tag := range t.Tags {
+	idLen := 0
+	for i, tag := range t.Tags {
+		if i > 0 {
+			idLen++ // account for separators
+		}
+
+		if !isGraphitePathTag(tag.Name) {
+			idLen += len(tag.Name) + 1 // account for the name and '='
+		}
+
 		idLen += len(tag.Value)
 	}
 
 	return idLen
 }
 
+// graphiteID joins the path tags with '.', then appends any Graphite 1.1
+// style tags as ';name=value', i.e. foo.bar;dc=east;host=a.
 func graphiteID(t Tags) []byte {
 	// TODO: pool these bytes.
 	id := make([]byte, idLenGraphite(t))
 	idx := 0
-	lastIndex := len(t.Tags) - 1
-	for _, tag := range t.Tags[:lastIndex] {
+	for i, tag := range t.Tags {
+		isPath := isGraphitePathTag(tag.Name)
+		if i > 0 {
+			if isPath {
+				id[idx] = graphiteSep
+			} else {
+				id[idx] = graphiteTagSep
+			}
+			idx++
+		}
+
+		if !isPath {
+			idx += copy(id[idx:], tag.Name)
+			id[idx] = eq
+			idx++
+		}
+
 		idx += copy(id[idx:], tag.Value)
-		id[idx] = graphiteSep
-		idx++
 	}
 
-	copy(id[idx:], t.Tags[lastIndex].Value)
 	return id
 }
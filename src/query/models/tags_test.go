@@ -76,6 +76,21 @@ func TestLongTagNewIDOutOfOrderGraphite(t *testing.T) {
 	assert.Equal(t, []byte("v0.v1.v2.v3.v4.v5.v6.v7.v8.v9.v10.v11.v12"), actual)
 }
 
+func TestTaggedNewIDOutOfOrderGraphite(t *testing.T) {
+	opts := NewTagOptions().SetIDSchemeType(TypeGraphite)
+	tags := NewTags(5, opts).AddTags([]Tag{
+		{Name: []byte("host"), Value: []byte("a")},
+		{Name: graphite.TagName(1), Value: []byte("bar")},
+		{Name: []byte("dc"), Value: []byte("east")},
+		{Name: graphite.TagName(0), Value: []byte("foo")},
+		{Name: []byte("__g_x__"), Value: []byte("baz")},
+	})
+
+	require.NoError(t, tags.Validate())
+	actual := tags.ID()
+	assert.Equal(t, []byte("foo.bar;__g_x__=baz;dc=east;host=a"), actual)
+}
+
 func TestLongTagNewIDOutOfOrderQuotedWithEscape(t *testing.T) {
 	tags := testLongTagIDOutOfOrder(t, TypeQuoted)
 	tags = tags.AddTag(Tag{Name: []byte(`t5""`), Value: []byte(`v"5`)})
@@ -27,12 +27,24 @@ import (
 
 // Separators for tags.
 const (
-	graphiteSep  = byte('.')
-	sep          = byte(',')
-	finish       = byte('!')
-	eq           = byte('=')
-	leftBracket  = byte('{')
-	rightBracket = byte('}')
+	graphiteSep    = byte('.')
+	graphiteTagSep = byte(';')
+	sep            = byte(',')
+	finish         = byte('!')
+	eq             = byte('=')
+	leftBracket    = byte('{')
+	rightBracket   = byte('}')
+)
+
+// Bucket tag values of the series that native histograms are expanded into on
+// ingestion, alongside a series per populated bucket.
+const (
+	// HistogramCountBucket is the bucket tag value of the series holding the
+	// total observation count of a native histogram.
+	HistogramCountBucket = "+Inf"
+	// HistogramSumBucket is the bucket tag value of the series holding the
+	// sum of observations of a native histogram.
+	HistogramSumBucket = "sum"
 )
 
 // IDSchemeType determines the scheme for generating
@@ -0,0 +1,435 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package m3ql parses M3QL pipeline scripts and compiles them into the
+// common query DAG.
+package m3ql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/functions/aggregation"
+	"github.com/m3db/m3/src/query/functions/binary"
+	"github.com/m3db/m3/src/query/functions/scalar"
+	"github.com/m3db/m3/src/query/graphite/graphite"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/parser/promql"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	// FetchType is the M3QL function which fetches series from storage, it
+	// must be the first stage of every pipeline.
+	FetchType = "fetch"
+
+	// nameKeyword is the fetch keyword that matches on the metric name.
+	nameKeyword = "name"
+	// withoutKeyword is the aggregation keyword that excludes the given
+	// tags from grouping rather than grouping by them.
+	withoutKeyword = "without"
+	// boolKeyword is the comparison keyword that returns 0 or 1 rather than
+	// filtering series.
+	boolKeyword = "bool"
+	// globCharacters are the characters that make a fetch pattern a glob
+	// rather than an exact match.
+	globCharacters = "*?[{"
+)
+
+// binaryFunctions maps M3QL binary function names to binary op types.
+var binaryFunctions = map[string]string{
+	"add":                binary.PlusType,
+	"sub":                binary.MinusType,
+	"mul":                binary.MultiplyType,
+	"div":                binary.DivType,
+	"pow":                binary.ExpType,
+	"mod":                binary.ModType,
+	binary.AndType:       binary.AndType,
+	binary.OrType:        binary.OrType,
+	binary.UnlessType:    binary.UnlessType,
+	binary.EqType:        binary.EqType,
+	binary.NotEqType:     binary.NotEqType,
+	binary.GreaterType:   binary.GreaterType,
+	binary.LesserType:    binary.LesserType,
+	binary.GreaterEqType: binary.GreaterEqType,
+	binary.LesserEqType:  binary.LesserEqType,
+}
+
+type m3qlParser struct {
+	query string
+	nodes parser.Nodes
+	edges parser.Edges
+}
+
+// Parse takes an M3QL script and compiles it into a DAG.
+func Parse(
+	q string,
+	tagOpts models.TagOptions,
+) (parser.Parser, error) {
+	s, err := parseScript(q)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &compileState{
+		script:    s,
+		tagOpts:   tagOpts,
+		expanding: make(map[string]struct{}),
+	}
+
+	if _, err := state.compilePipeline(s.pipeline); err != nil {
+		return nil, err
+	}
+
+	return &m3qlParser{
+		query: q,
+		nodes: state.transforms,
+		edges: state.edges,
+	}, nil
+}
+
+func (p *m3qlParser) DAG() (parser.Nodes, parser.Edges, error) {
+	return p.nodes, p.edges, nil
+}
+
+func (p *m3qlParser) String() string {
+	return p.query
+}
+
+type compileState struct {
+	script     script
+	tagOpts    models.TagOptions
+	transforms parser.Nodes
+	edges      parser.Edges
+	// expanding holds the macros currently being expanded, used to detect
+	// recursive macro definitions.
+	expanding map[string]struct{}
+}
+
+func (s *compileState) addTransform(
+	op parser.Params,
+	parents ...parser.NodeID,
+) parser.NodeID {
+	transform := parser.NewTransformFromOperation(op, len(s.transforms))
+	for _, parent := range parents {
+		s.edges = append(s.edges, parser.Edge{
+			ParentID: parent,
+			ChildID:  transform.ID,
+		})
+	}
+
+	s.transforms = append(s.transforms, transform)
+	return transform.ID
+}
+
+func (s *compileState) compilePipeline(p *pipeline) (parser.NodeID, error) {
+	if p == nil || len(p.expressions) == 0 {
+		return "", fmt.Errorf("empty pipeline")
+	}
+
+	id, err := s.compileSource(p.expressions[0])
+	if err != nil {
+		return "", err
+	}
+
+	for _, expr := range p.expressions[1:] {
+		if expr.nested != nil {
+			return "", fmt.Errorf("nested pipeline must be the first stage " +
+				"of a pipeline or a function argument")
+		}
+
+		id, err = s.compileFunction(expr, id)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return id, nil
+}
+
+func (s *compileState) compileSource(expr *expression) (parser.NodeID, error) {
+	if expr.nested != nil {
+		return s.compilePipeline(expr.nested)
+	}
+
+	if macro, ok := s.script.macros[expr.name]; ok {
+		if len(expr.args) > 0 {
+			return "", fmt.Errorf("macro %s does not take arguments", expr.name)
+		}
+
+		if _, ok := s.expanding[expr.name]; ok {
+			return "", fmt.Errorf("macro %s is recursively defined", expr.name)
+		}
+
+		s.expanding[expr.name] = struct{}{}
+		id, err := s.compilePipeline(macro)
+		delete(s.expanding, expr.name)
+		return id, err
+	}
+
+	if expr.name != FetchType {
+		return "", fmt.Errorf("pipeline must begin with %s or a macro, "+
+			"received: %s", FetchType, expr.name)
+	}
+
+	op, err := s.newFetchOp(expr)
+	if err != nil {
+		return "", err
+	}
+
+	return s.addTransform(op), nil
+}
+
+func (s *compileState) newFetchOp(expr *expression) (parser.Params, error) {
+	if len(expr.args) == 0 {
+		return nil, fmt.Errorf("%s requires at least one argument", FetchType)
+	}
+
+	var (
+		name     string
+		matchers = make(models.Matchers, 0, len(expr.args))
+	)
+	for _, arg := range expr.args {
+		if arg.argType != patternArgument && arg.argType != stringLiteralArgument {
+			return nil, fmt.Errorf("%s received invalid %s argument: %s",
+				FetchType, arg.argType, arg.text)
+		}
+
+		tag := []byte(arg.keyword)
+		if arg.keyword == "" || arg.keyword == nameKeyword {
+			tag = s.tagOpts.MetricName()
+			name = arg.text
+		}
+
+		matcher, err := newTagMatcher(tag, arg)
+		if err != nil {
+			return nil, err
+		}
+
+		matchers = append(matchers, matcher)
+	}
+
+	return functions.FetchOp{
+		Name:     name,
+		Matchers: matchers,
+	}, nil
+}
+
+// newTagMatcher converts a fetch argument into a matcher; string literals and
+// patterns without glob characters match exactly while other patterns are
+// treated as graphite-style globs.
+func newTagMatcher(tag []byte, arg argument) (models.Matcher, error) {
+	if arg.argType == stringLiteralArgument ||
+		!strings.ContainsAny(arg.text, globCharacters) {
+		return models.NewMatcher(models.MatchEqual, tag, []byte(arg.text))
+	}
+
+	pattern, isRegex, err := graphite.GlobToRegexPattern(arg.text)
+	if err != nil {
+		return models.Matcher{}, err
+	}
+
+	if !isRegex {
+		return models.NewMatcher(models.MatchEqual, tag, []byte(arg.text))
+	}
+
+	return models.NewMatcher(models.MatchRegexp, tag, pattern)
+}
+
+func (s *compileState) compileFunction(
+	expr *expression,
+	parent parser.NodeID,
+) (parser.NodeID, error) {
+	if expr.name == FetchType {
+		return "", fmt.Errorf("%s must be the first stage of a pipeline", FetchType)
+	}
+
+	if opType, ok := binaryFunctions[expr.name]; ok {
+		return s.compileBinary(opType, expr, parent)
+	}
+
+	switch expr.name {
+	case aggregation.SumType, aggregation.MinType, aggregation.MaxType,
+		aggregation.AverageType, aggregation.StandardDeviationType,
+		aggregation.StandardVarianceType, aggregation.CountType,
+		aggregation.TopKType, aggregation.BottomKType,
+		aggregation.QuantileType:
+		return s.compileAggregation(expr, parent)
+
+	case scalar.TimeType, scalar.VectorType:
+		return "", fmt.Errorf("%s is not supported within a pipeline", expr.name)
+	}
+
+	var (
+		argValues    = make([]interface{}, 0, len(expr.args))
+		stringValues = make([]string, 0, len(expr.args))
+	)
+	for _, arg := range expr.args {
+		switch arg.argType {
+		case numericArgument:
+			argValues = append(argValues, arg.number)
+		case patternArgument:
+			// NB: unquoted arguments which parse as durations are treated as
+			// ranges, e.g. the "5m" in "rate 5m"; quote them to force a string.
+			if d, err := xtime.ParseExtendedDuration(arg.text); err == nil {
+				argValues = append(argValues, d)
+			} else {
+				stringValues = append(stringValues, arg.text)
+			}
+		case stringLiteralArgument:
+			stringValues = append(stringValues, arg.text)
+		default:
+			return "", fmt.Errorf("%s received invalid %s argument: %s",
+				expr.name, arg.argType, arg.text)
+		}
+	}
+
+	op, ok, err := promql.NewFunctionExpr(expr.name, argValues, stringValues,
+		true, "", s.tagOpts)
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		return parent, nil
+	}
+
+	return s.addTransform(op, parent), nil
+}
+
+func (s *compileState) compileAggregation(
+	expr *expression,
+	parent parser.NodeID,
+) (parser.NodeID, error) {
+	var (
+		params   aggregation.NodeParams
+		hasParam bool
+	)
+	for _, arg := range expr.args {
+		switch {
+		case arg.keyword == withoutKeyword && arg.argType == booleanArgument:
+			params.Without = arg.boolean
+		case arg.keyword != "":
+			return "", fmt.Errorf("%s received unknown keyword: %s",
+				expr.name, arg.keyword)
+		case arg.argType == numericArgument && !hasParam:
+			params.Parameter = arg.number
+			params.StringParameter = arg.text
+			hasParam = true
+		case arg.argType == patternArgument || arg.argType == stringLiteralArgument:
+			params.MatchingTags = append(params.MatchingTags, []byte(arg.text))
+		default:
+			return "", fmt.Errorf("%s received invalid %s argument: %s",
+				expr.name, arg.argType, arg.text)
+		}
+	}
+
+	var (
+		op  parser.Params
+		err error
+	)
+	switch expr.name {
+	case aggregation.TopKType, aggregation.BottomKType:
+		if !hasParam {
+			return "", fmt.Errorf("%s requires a numeric argument", expr.name)
+		}
+
+		op, err = aggregation.NewTakeOp(expr.name, params)
+	case aggregation.QuantileType:
+		if !hasParam {
+			return "", fmt.Errorf("%s requires a numeric argument", expr.name)
+		}
+
+		op, err = aggregation.NewAggregationOp(expr.name, params)
+	default:
+		if hasParam {
+			return "", fmt.Errorf("%s does not take a numeric argument", expr.name)
+		}
+
+		op, err = aggregation.NewAggregationOp(expr.name, params)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return s.addTransform(op, parent), nil
+}
+
+func (s *compileState) compileBinary(
+	opType string,
+	expr *expression,
+	parent parser.NodeID,
+) (parser.NodeID, error) {
+	var (
+		returnBool bool
+		rhs        parser.NodeID
+		hasRHS     bool
+	)
+	for _, arg := range expr.args {
+		if arg.keyword == boolKeyword && arg.argType == booleanArgument {
+			returnBool = arg.boolean
+			continue
+		}
+
+		if hasRHS {
+			return "", fmt.Errorf("%s takes a single operand", expr.name)
+		}
+
+		switch arg.argType {
+		case numericArgument:
+			op, err := scalar.NewScalarOp(arg.number, s.tagOpts)
+			if err != nil {
+				return "", err
+			}
+
+			rhs = s.addTransform(op)
+		case pipelineArgument:
+			id, err := s.compilePipeline(arg.pipeline)
+			if err != nil {
+				return "", err
+			}
+
+			rhs = id
+		default:
+			return "", fmt.Errorf("%s received invalid %s argument: %s",
+				expr.name, arg.argType, arg.text)
+		}
+
+		hasRHS = true
+	}
+
+	if !hasRHS {
+		return "", fmt.Errorf("%s requires an operand", expr.name)
+	}
+
+	op, err := binary.NewOp(opType, binary.NodeParams{
+		LNode:      parent,
+		RNode:      rhs,
+		ReturnBool: returnBool,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return s.addTransform(op, parent, rhs), nil
+}
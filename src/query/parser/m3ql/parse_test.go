@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3ql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/functions/aggregation"
+	"github.com/m3db/m3/src/query/functions/binary"
+	"github.com/m3db/m3/src/query/functions/linear"
+	"github.com/m3db/m3/src/query/functions/scalar"
+	"github.com/m3db/m3/src/query/functions/temporal"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+)
+
+func TestParseScript(t *testing.T) {
+	s, err := parseScript(`a = fetch name:foo; a | sum host | add (fetch name:"bar")`)
+	require.NoError(t, err)
+
+	require.Len(t, s.macros, 1)
+	macro := s.macros["a"]
+	require.NotNil(t, macro)
+	require.Len(t, macro.expressions, 1)
+	assert.Equal(t, "fetch", macro.expressions[0].name)
+
+	exprs := s.pipeline.expressions
+	require.Len(t, exprs, 3)
+	assert.Equal(t, "a", exprs[0].name)
+	assert.Equal(t, "sum", exprs[1].name)
+	require.Len(t, exprs[1].args, 1)
+	assert.Equal(t, patternArgument, exprs[1].args[0].argType)
+	assert.Equal(t, "host", exprs[1].args[0].text)
+
+	assert.Equal(t, "add", exprs[2].name)
+	require.Len(t, exprs[2].args, 1)
+	nested := exprs[2].args[0]
+	require.Equal(t, pipelineArgument, nested.argType)
+	require.Len(t, nested.pipeline.expressions, 1)
+	fetch := nested.pipeline.expressions[0]
+	require.Len(t, fetch.args, 1)
+	assert.Equal(t, "name", fetch.args[0].keyword)
+	assert.Equal(t, stringLiteralArgument, fetch.args[0].argType)
+	assert.Equal(t, "bar", fetch.args[0].text)
+}
+
+func TestParseScriptErrors(t *testing.T) {
+	_, err := parseScript("fetch name:foo |")
+	require.Error(t, err)
+
+	_, err = parseScript("a = fetch name:foo; a = fetch name:bar; a")
+	require.Error(t, err)
+}
+
+func TestDAGFetchAndFilter(t *testing.T) {
+	p, err := Parse("fetch name:foo.bar host:a* | >= 5", models.NewTagOptions())
+	require.NoError(t, err)
+
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 3)
+	assert.Equal(t, functions.FetchType, transforms[0].Op.OpType())
+	assert.Equal(t, scalar.ScalarType, transforms[1].Op.OpType())
+	assert.Equal(t, binary.GreaterEqType, transforms[2].Op.OpType())
+
+	fetch, ok := transforms[0].Op.(functions.FetchOp)
+	require.True(t, ok)
+	require.Len(t, fetch.Matchers, 2)
+	assert.Equal(t, models.MatchEqual, fetch.Matchers[0].Type)
+	assert.Equal(t, "__name__", string(fetch.Matchers[0].Name))
+	assert.Equal(t, "foo.bar", string(fetch.Matchers[0].Value))
+	assert.Equal(t, models.MatchRegexp, fetch.Matchers[1].Type)
+	assert.Equal(t, "host", string(fetch.Matchers[1].Name))
+
+	require.Len(t, edges, 2)
+	assert.Equal(t, parser.Edge{ParentID: "0", ChildID: "2"}, edges[0])
+	assert.Equal(t, parser.Edge{ParentID: "1", ChildID: "2"}, edges[1])
+}
+
+func TestDAGPipeline(t *testing.T) {
+	p, err := Parse("fetch name:foo | rate 5m | sum service | abs",
+		models.NewTagOptions())
+	require.NoError(t, err)
+
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 4)
+	assert.Equal(t, functions.FetchType, transforms[0].Op.OpType())
+	assert.Equal(t, temporal.RateType, transforms[1].Op.OpType())
+	assert.Equal(t, aggregation.SumType, transforms[2].Op.OpType())
+	assert.Equal(t, linear.AbsType, transforms[3].Op.OpType())
+
+	require.Len(t, edges, 3)
+	for i, edge := range edges {
+		assert.Equal(t, transforms[i].ID, edge.ParentID)
+		assert.Equal(t, transforms[i+1].ID, edge.ChildID)
+	}
+}
+
+func TestDAGMacrosAndNesting(t *testing.T) {
+	p, err := Parse(
+		"errors = fetch name:errors | sum; "+
+			"(fetch name:requests | sum) | div (errors) | topk 5",
+		models.NewTagOptions())
+	require.NoError(t, err)
+
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 6)
+	assert.Equal(t, functions.FetchType, transforms[0].Op.OpType())
+	assert.Equal(t, aggregation.SumType, transforms[1].Op.OpType())
+	assert.Equal(t, functions.FetchType, transforms[2].Op.OpType())
+	assert.Equal(t, aggregation.SumType, transforms[3].Op.OpType())
+	assert.Equal(t, binary.DivType, transforms[4].Op.OpType())
+	assert.Equal(t, aggregation.TopKType, transforms[5].Op.OpType())
+
+	assert.Equal(t, parser.Edges{
+		{ParentID: "0", ChildID: "1"},
+		{ParentID: "2", ChildID: "3"},
+		{ParentID: "1", ChildID: "4"},
+		{ParentID: "3", ChildID: "4"},
+		{ParentID: "4", ChildID: "5"},
+	}, edges)
+}
+
+func TestDAGErrors(t *testing.T) {
+	tests := []string{
+		"sum",
+		"fetch",
+		"fetch name:foo | fetch name:bar",
+		"fetch name:foo | add",
+		"fetch name:foo | topk",
+		"fetch name:foo | sum 5",
+		"fetch name:foo | unknown_function",
+		"a = a | sum; a",
+	}
+
+	for _, q := range tests {
+		t.Run(q, func(t *testing.T) {
+			_, err := Parse(q, models.NewTagOptions())
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3ql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+type argumentType int
+
+const (
+	booleanArgument argumentType = iota
+	numericArgument
+	patternArgument
+	stringLiteralArgument
+	pipelineArgument
+)
+
+func (t argumentType) String() string {
+	switch t {
+	case booleanArgument:
+		return "boolean"
+	case numericArgument:
+		return "numeric"
+	case patternArgument:
+		return "pattern"
+	case stringLiteralArgument:
+		return "string"
+	case pipelineArgument:
+		return "pipeline"
+	default:
+		return "unknown"
+	}
+}
+
+// argument is a single, optionally keyworded, argument to an expression.
+type argument struct {
+	keyword  string
+	argType  argumentType
+	text     string
+	boolean  bool
+	number   float64
+	pipeline *pipeline
+}
+
+// expression is a single stage in a pipeline; it is either a function call
+// with arguments or a nested pipeline.
+type expression struct {
+	name   string
+	args   []argument
+	nested *pipeline
+
+	// depth is the pipeline depth at which this expression was opened, used
+	// to tell nested pipeline arguments apart from nested pipeline stages.
+	depth int
+	// keyword is the pending keyword for the next argument, if any.
+	keyword string
+}
+
+// pipeline is an ordered set of expressions, each consuming the output of
+// the previous one.
+type pipeline struct {
+	expressions []*expression
+}
+
+// script is a parsed M3QL script: a set of named macros and a root pipeline.
+type script struct {
+	macros   map[string]*pipeline
+	pipeline *pipeline
+}
+
+// builder implements scriptBuilder, building a script as the grammar
+// actions are executed.
+type builder struct {
+	script    script
+	macroName string
+	pipelines []*pipeline
+	exprs     []*expression
+	err       error
+}
+
+var _ scriptBuilder = (*builder)(nil)
+
+func newBuilder() *builder {
+	return &builder{
+		script: script{macros: make(map[string]*pipeline)},
+	}
+}
+
+func (b *builder) setErr(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+func (b *builder) newMacro(name string) {
+	if _, ok := b.script.macros[name]; ok {
+		b.setErr(fmt.Errorf("macro %s defined more than once", name))
+	}
+
+	b.macroName = name
+}
+
+func (b *builder) newPipeline() {
+	b.pipelines = append(b.pipelines, &pipeline{})
+}
+
+func (b *builder) endPipeline() {
+	if len(b.pipelines) == 0 {
+		b.setErr(errors.New("unbalanced pipeline"))
+		return
+	}
+
+	idx := len(b.pipelines) - 1
+	p := b.pipelines[idx]
+	b.pipelines = b.pipelines[:idx]
+
+	// An expression opened at the enclosing depth that is still open means
+	// this pipeline is one of its arguments.
+	if n := len(b.exprs); n > 0 && b.exprs[n-1].depth == idx {
+		b.addArgument(argument{argType: pipelineArgument, pipeline: p})
+		return
+	}
+
+	// Otherwise if there is an enclosing pipeline this pipeline is a nested
+	// stage within it.
+	if idx > 0 {
+		parent := b.pipelines[idx-1]
+		parent.expressions = append(parent.expressions, &expression{nested: p})
+		return
+	}
+
+	if b.macroName != "" {
+		b.script.macros[b.macroName] = p
+		b.macroName = ""
+		return
+	}
+
+	b.script.pipeline = p
+}
+
+func (b *builder) newExpression(name string) {
+	b.exprs = append(b.exprs, &expression{
+		name:  name,
+		depth: len(b.pipelines),
+	})
+}
+
+func (b *builder) endExpression() {
+	if len(b.exprs) == 0 || len(b.pipelines) == 0 {
+		b.setErr(errors.New("unbalanced expression"))
+		return
+	}
+
+	idx := len(b.exprs) - 1
+	expr := b.exprs[idx]
+	b.exprs = b.exprs[:idx]
+
+	p := b.pipelines[len(b.pipelines)-1]
+	p.expressions = append(p.expressions, expr)
+}
+
+func (b *builder) addArgument(arg argument) {
+	if len(b.exprs) == 0 {
+		b.setErr(fmt.Errorf("argument %s outside of expression", arg.text))
+		return
+	}
+
+	expr := b.exprs[len(b.exprs)-1]
+	arg.keyword = expr.keyword
+	expr.keyword = ""
+	expr.args = append(expr.args, arg)
+}
+
+func (b *builder) newBooleanArgument(text string) {
+	val, err := strconv.ParseBool(text)
+	if err != nil {
+		b.setErr(fmt.Errorf("invalid boolean argument %s: %v", text, err))
+	}
+
+	b.addArgument(argument{argType: booleanArgument, text: text, boolean: val})
+}
+
+func (b *builder) newNumericArgument(text string) {
+	val, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		b.setErr(fmt.Errorf("invalid numeric argument %s: %v", text, err))
+	}
+
+	b.addArgument(argument{argType: numericArgument, text: text, number: val})
+}
+
+func (b *builder) newPatternArgument(text string) {
+	b.addArgument(argument{argType: patternArgument, text: text})
+}
+
+func (b *builder) newStringLiteralArgument(text string) {
+	b.addArgument(argument{argType: stringLiteralArgument, text: text})
+}
+
+func (b *builder) newKeywordArgument(keyword string) {
+	if len(b.exprs) == 0 {
+		b.setErr(fmt.Errorf("keyword %s outside of expression", keyword))
+		return
+	}
+
+	b.exprs[len(b.exprs)-1].keyword = keyword
+}
+
+// parseScript parses an M3QL script into its macros and root pipeline.
+func parseScript(q string) (script, error) {
+	b := newBuilder()
+	p := &m3ql{
+		Buffer:        q,
+		scriptBuilder: b,
+	}
+
+	p.Init()
+	if err := p.Parse(); err != nil {
+		return script{}, err
+	}
+
+	p.Execute()
+	if b.err != nil {
+		return script{}, b.err
+	}
+
+	if b.script.pipeline == nil {
+		return script{}, errors.New("script has no pipeline")
+	}
+
+	return b.script, nil
+}
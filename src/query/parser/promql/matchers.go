@@ -251,6 +251,10 @@ func NewFunctionExpr(
 		p, err = linear.NewHistogramQuantileOp(argValues, name)
 		return p, true, err
 
+	case linear.HistogramCountType, linear.HistogramSumType:
+		p, err = linear.NewHistogramSummaryOp(name)
+		return p, true, err
+
 	case linear.RoundType:
 		p, err = linear.NewRoundOp(argValues)
 		return p, true, err
@@ -28,6 +28,7 @@ import (
 
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/promqlengine"
 	xclock "github.com/m3db/m3/src/x/clock"
 )
 
@@ -99,6 +100,9 @@ type parseOptions struct {
 
 // NewParseOptions creates a new parse options.
 func NewParseOptions() ParseOptions {
+	// Functions missing from the Prometheus parser must be registered
+	// before any query is parsed with these options.
+	promqlengine.RegisterFunctions()
 	return &parseOptions{
 		parseFn:     defaultParseFn,
 		selectorFn:  defaultMetricSelectorFn,
@@ -235,6 +235,8 @@ var linearParseTests = []struct {
 	{"year(up)", linear.YearType},
 
 	{"histogram_quantile(1,up)", linear.HistogramQuantileType},
+	{"histogram_count(up)", linear.HistogramCountType},
+	{"histogram_sum(up)", linear.HistogramSumType},
 }
 
 func TestLinearParses(t *testing.T) {
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package promqlengine
+
+import (
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/m3db/m3/src/query/models"
+)
+
+var registerFunctionsOnce sync.Once
+
+// RegisterFunctions registers the PromQL functions missing from the
+// Prometheus parser and engine, it must be called before constructing an
+// engine or parsing queries that use them and is safe to call repeatedly.
+//
+// NB: native histograms are stored as bucketed series on ingestion, so the
+// functions which read native histograms are registered here to operate on
+// those series; the Prometheus version in use predates native histograms.
+func RegisterFunctions() {
+	registerFunctionsOnce.Do(func() {
+		registerFunction("histogram_count", isHistogramCountBucket)
+		registerFunction("histogram_sum", isHistogramSumBucket)
+	})
+}
+
+func registerFunction(name string, matchFn func(bucket string) bool) {
+	if _, ok := parser.Functions[name]; ok {
+		return
+	}
+
+	parser.Functions[name] = &parser.Function{
+		Name:       name,
+		ArgTypes:   []parser.ValueType{parser.ValueTypeVector},
+		ReturnType: parser.ValueTypeVector,
+	}
+
+	promql.FunctionCalls[name] = func(
+		vals []parser.Value,
+		_ parser.Expressions,
+		enh *promql.EvalNodeHelper,
+	) promql.Vector {
+		for _, el := range vals[0].(promql.Vector) {
+			if !matchFn(el.Metric.Get(labels.BucketLabel)) {
+				continue
+			}
+
+			enh.Out = append(enh.Out, promql.Sample{
+				Metric: labels.NewBuilder(el.Metric).
+					Del(labels.MetricName, labels.BucketLabel).
+					Labels(),
+				Point: promql.Point{V: el.V},
+			})
+		}
+
+		return enh.Out
+	}
+}
+
+func isHistogramCountBucket(bucket string) bool {
+	bound, err := strconv.ParseFloat(bucket, 64)
+	return err == nil && math.IsInf(bound, 1)
+}
+
+func isHistogramSumBucket(bucket string) bool {
+	return bucket == models.HistogramSumBucket
+}
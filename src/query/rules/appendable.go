@@ -0,0 +1,158 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+
+	"github.com/prometheus/prometheus/model/exemplar"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/value"
+	promstorage "github.com/prometheus/prometheus/storage"
+
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
+	"github.com/m3db/m3/src/query/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// appendable writes the samples produced by rule evaluations to a storage
+// appender.
+type appendable struct {
+	appender   storage.Appender
+	tagOptions models.TagOptions
+}
+
+func newAppendable(
+	appender storage.Appender,
+	tagOptions models.TagOptions,
+) promstorage.Appendable {
+	return &appendable{
+		appender:   appender,
+		tagOptions: tagOptions,
+	}
+}
+
+func (a *appendable) Appender(ctx context.Context) promstorage.Appender {
+	return &appender{
+		ctx:        ctx,
+		appender:   a.appender,
+		tagOptions: a.tagOptions,
+	}
+}
+
+type sample struct {
+	labels    labels.Labels
+	timestamp int64
+	value     float64
+}
+
+// appender buffers the samples of a single rule group evaluation until they
+// are committed.
+type appender struct {
+	ctx        context.Context
+	appender   storage.Appender
+	tagOptions models.TagOptions
+	samples    []sample
+}
+
+func (a *appender) Append(
+	ref promstorage.SeriesRef,
+	l labels.Labels,
+	t int64,
+	v float64,
+) (promstorage.SeriesRef, error) {
+	// NB: staleness markers are written by Prometheus for series which are no
+	// longer produced by a rule, M3 does not use staleness markers so they are
+	// dropped rather than stored as NaN values.
+	if value.IsStaleNaN(v) {
+		return ref, nil
+	}
+
+	a.samples = append(a.samples, sample{
+		labels:    l,
+		timestamp: t,
+		value:     v,
+	})
+	return ref, nil
+}
+
+func (a *appender) AppendExemplar(
+	ref promstorage.SeriesRef,
+	_ labels.Labels,
+	_ exemplar.Exemplar,
+) (promstorage.SeriesRef, error) {
+	// Rule evaluations do not produce exemplars.
+	return ref, nil
+}
+
+func (a *appender) Commit() error {
+	var (
+		multiErr = xerrors.NewMultiError()
+		attrs    = storagemetadata.Attributes{
+			MetricsType: storagemetadata.UnaggregatedMetricsType,
+		}
+	)
+	for _, s := range a.samples {
+		query, err := storage.NewWriteQuery(storage.WriteQueryOptions{
+			Tags: labelsToTags(s.labels, a.tagOptions),
+			Datapoints: ts.Datapoints{
+				{
+					Timestamp: xtime.ToUnixNano(storage.PromTimestampToTime(s.timestamp)),
+					Value:     s.value,
+				},
+			},
+			Unit:       xtime.Millisecond,
+			Attributes: attrs,
+		})
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+
+		if err := a.appender.Write(a.ctx, query); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	a.samples = nil
+	return multiErr.FinalError()
+}
+
+func (a *appender) Rollback() error {
+	a.samples = nil
+	return nil
+}
+
+func labelsToTags(l labels.Labels, tagOptions models.TagOptions) models.Tags {
+	promLabels := make([]prompb.Label, 0, len(l))
+	for _, label := range l {
+		promLabels = append(promLabels, prompb.Label{
+			Name:  []byte(label.Name),
+			Value: []byte(label.Value),
+		})
+	}
+
+	return storage.PromLabelsToM3Tags(promLabels, tagOptions)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
+	"github.com/m3db/m3/src/query/storage/mock"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestAppenderCommit(t *testing.T) {
+	store := mock.NewMockStorage()
+	app := newAppendable(store, models.NewTagOptions()).Appender(context.Background())
+
+	l := labels.FromStrings("__name__", "job:requests:rate5m", "job", "api")
+	_, err := app.Append(0, l, 1000, 42)
+	require.NoError(t, err)
+	_, err = app.Append(0, l, 2000, math.Float64frombits(value.StaleNaN))
+	require.NoError(t, err)
+
+	// Nothing is written until the samples are committed.
+	assert.Empty(t, store.Writes())
+	require.NoError(t, app.Commit())
+
+	writes := store.Writes()
+	require.Len(t, writes, 1)
+
+	write := writes[0]
+	name, ok := write.Tags().Name()
+	require.True(t, ok)
+	assert.Equal(t, "job:requests:rate5m", string(name))
+	job, ok := write.Tags().Get([]byte("job"))
+	require.True(t, ok)
+	assert.Equal(t, "api", string(job))
+
+	require.Equal(t, 1, write.Datapoints().Len())
+	assert.Equal(t, xtime.UnixNano(1000*1000*1000), write.Datapoints()[0].Timestamp)
+	assert.Equal(t, 42.0, write.Datapoints()[0].Value)
+	assert.Equal(t, xtime.Millisecond, write.Unit())
+	assert.Equal(t, storagemetadata.UnaggregatedMetricsType, write.Attributes().MetricsType)
+}
+
+func TestAppenderRollback(t *testing.T) {
+	store := mock.NewMockStorage()
+	app := newAppendable(store, models.NewTagOptions()).Appender(context.Background())
+
+	_, err := app.Append(0, labels.FromStrings("__name__", "foo"), 1000, 1)
+	require.NoError(t, err)
+	require.NoError(t, app.Rollback())
+	require.NoError(t, app.Commit())
+	assert.Empty(t, store.Writes())
+}
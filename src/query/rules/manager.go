@@ -0,0 +1,244 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	kitlogzap "github.com/go-kit/kit/log/zap"
+	extprom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/rules"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/prometheus"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	// The following defaults match the defaults of Prometheus.
+	defaultOutageTolerance = time.Hour
+	defaultForGracePeriod  = 10 * time.Minute
+	defaultResendDelay     = time.Minute
+)
+
+var (
+	errNoEngine                   = errors.New("no PromQL engine set")
+	errNoStorage                  = errors.New("no storage set")
+	errNoAppender                 = errors.New("no appender set")
+	errInvalidEvalInterval        = errors.New("evaluation interval must be positive")
+	errNoInstrumentOptions        = errors.New("no instrument options set")
+	errInvalidAlertmanagerTimeout = errors.New("alertmanager timeout must be positive")
+)
+
+// Manager evaluates Prometheus recording and alerting rules.
+type Manager interface {
+	// Start loads the rule groups and starts evaluating them.
+	Start() error
+
+	// RuleGroups returns the loaded rule groups.
+	RuleGroups() []*rules.Group
+
+	// AlertingRules returns the loaded alerting rules.
+	AlertingRules() []*rules.AlertingRule
+
+	// Close stops evaluating rules and sending alerts.
+	Close() error
+}
+
+// Options are the options for a rule manager.
+type Options struct {
+	// Files are the Prometheus rule group files to load, file names may
+	// contain glob patterns.
+	Files []string
+	// EvaluationInterval is the default evaluation interval of rule groups.
+	EvaluationInterval time.Duration
+	// ExternalLabels are added to alerts sent to Alertmanager.
+	ExternalLabels labels.Labels
+	// ExternalURL is the URL used for links to the coordinator.
+	ExternalURL *url.URL
+	// AlertmanagerURLs are the base URLs of the Alertmanager instances
+	// alerts are sent to, alerts are not sent if empty.
+	AlertmanagerURLs []string
+	// AlertmanagerTimeout is the timeout for sending alerts to an instance.
+	AlertmanagerTimeout time.Duration
+	// Engine is the PromQL engine rules are evaluated with.
+	Engine *promql.Engine
+	// Storage is the storage rules are evaluated against.
+	Storage storage.Storage
+	// Appender is the appender the results of recording rules and the alert
+	// series of alerting rules are written to.
+	Appender storage.Appender
+	// FetchOptions are the fetch options of rule queries.
+	FetchOptions *storage.FetchOptions
+	// TagOptions are the tag options of written series.
+	TagOptions models.TagOptions
+	// Registerer registers the Prometheus rule evaluation metrics.
+	Registerer extprom.Registerer
+	// InstrumentOptions are the instrument options.
+	InstrumentOptions instrument.Options
+}
+
+// Validate validates the options.
+func (o Options) Validate() error {
+	if o.Engine == nil {
+		return errNoEngine
+	}
+	if o.Storage == nil {
+		return errNoStorage
+	}
+	if o.Appender == nil {
+		return errNoAppender
+	}
+	if o.EvaluationInterval <= 0 {
+		return errInvalidEvalInterval
+	}
+	if len(o.AlertmanagerURLs) > 0 && o.AlertmanagerTimeout <= 0 {
+		return errInvalidAlertmanagerTimeout
+	}
+	if o.InstrumentOptions == nil {
+		return errNoInstrumentOptions
+	}
+	return nil
+}
+
+type manager struct {
+	opts     Options
+	manager  *rules.Manager
+	notifier *notifier
+	cancel   context.CancelFunc
+	logger   *zap.Logger
+}
+
+// NewManager returns a new rule manager.
+func NewManager(opts Options) (Manager, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.ExternalURL == nil {
+		opts.ExternalURL = &url.URL{}
+	}
+	if opts.FetchOptions == nil {
+		opts.FetchOptions = storage.NewFetchOptions()
+	}
+	if opts.TagOptions == nil {
+		opts.TagOptions = models.NewTagOptions()
+	}
+
+	instrumentOpts := opts.InstrumentOptions.SetMetricsScope(
+		opts.InstrumentOptions.MetricsScope().SubScope("rules"))
+
+	// NB: the queryable expects the fetch options and a result metadata
+	// receive function in the context, the context of rule evaluations is
+	// derived from the context of the Prometheus rule manager.
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = context.WithValue(ctx, prometheus.FetchOptionsContextKey, opts.FetchOptions)
+	ctx = context.WithValue(ctx, prometheus.BlockResultMetadataFnKey,
+		func(block.ResultMetadata) {})
+
+	queryable := prometheus.NewPrometheusQueryable(prometheus.PrometheusOptions{
+		Storage:           opts.Storage,
+		InstrumentOptions: instrumentOpts,
+	})
+
+	var (
+		n          *notifier
+		notifyFunc = func(context.Context, string, ...*rules.Alert) {}
+	)
+	if len(opts.AlertmanagerURLs) > 0 {
+		n = newNotifier(opts.AlertmanagerURLs, opts.AlertmanagerTimeout,
+			opts.ExternalURL, opts.ExternalLabels, instrumentOpts)
+		notifyFunc = n.Notify
+	}
+
+	kitLogger := kitlogzap.NewZapSugarLogger(instrumentOpts.Logger(), zapcore.InfoLevel)
+	promManager := rules.NewManager(&rules.ManagerOptions{
+		ExternalURL:     opts.ExternalURL,
+		QueryFunc:       rules.EngineQueryFunc(opts.Engine, queryable),
+		NotifyFunc:      notifyFunc,
+		Context:         ctx,
+		Appendable:      newAppendable(opts.Appender, opts.TagOptions),
+		Queryable:       queryable,
+		Logger:          log.With(kitLogger, "component", "rule_manager"),
+		Registerer:      opts.Registerer,
+		OutageTolerance: defaultOutageTolerance,
+		ForGracePeriod:  defaultForGracePeriod,
+		ResendDelay:     defaultResendDelay,
+	})
+
+	return &manager{
+		opts:     opts,
+		manager:  promManager,
+		notifier: n,
+		cancel:   cancel,
+		logger:   instrumentOpts.Logger(),
+	}, nil
+}
+
+func (m *manager) Start() error {
+	var files []string
+	for _, pattern := range m.opts.Files {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid rule files pattern %s: %w", pattern, err)
+		}
+		files = append(files, matches...)
+	}
+
+	err := m.manager.Update(m.opts.EvaluationInterval, files,
+		m.opts.ExternalLabels, m.opts.ExternalURL.String())
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("starting rule evaluation", zap.Strings("files", files))
+	go m.manager.Run()
+	return nil
+}
+
+func (m *manager) RuleGroups() []*rules.Group {
+	return m.manager.RuleGroups()
+}
+
+func (m *manager) AlertingRules() []*rules.AlertingRule {
+	return m.manager.AlertingRules()
+}
+
+func (m *manager) Close() error {
+	m.manager.Stop()
+	m.cancel()
+	if m.notifier != nil {
+		m.notifier.Close()
+	}
+	return nil
+}
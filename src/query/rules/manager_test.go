@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/storage/mock"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const testRuleGroups = `
+groups:
+  - name: test
+    interval: 10ms
+    rules:
+      - record: job:answer
+        expr: vector(42)
+        labels:
+          job: test
+      - alert: AlwaysFiring
+        expr: vector(1) > 0
+        labels:
+          severity: page
+        annotations:
+          summary: always firing
+`
+
+type testAlertmanager struct {
+	sync.Mutex
+	alerts []alert
+}
+
+func (a *testAlertmanager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil || r.URL.Path != alertmanagerAlertsPath {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var alerts []alert
+	if err := json.Unmarshal(body, &alerts); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	a.Lock()
+	a.alerts = append(a.alerts, alerts...)
+	a.Unlock()
+}
+
+func (a *testAlertmanager) received() []alert {
+	a.Lock()
+	defer a.Unlock()
+	return append([]alert(nil), a.alerts...)
+}
+
+func TestManagerEvaluatesRules(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rules")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "rules.yml"), []byte(testRuleGroups), 0600)
+	require.NoError(t, err)
+
+	alertmanager := &testAlertmanager{}
+	server := httptest.NewServer(alertmanager)
+	defer server.Close()
+
+	store := mock.NewMockStorage()
+	m, err := NewManager(Options{
+		Files:               []string{filepath.Join(dir, "*.yml")},
+		EvaluationInterval:  time.Minute,
+		ExternalLabels:      labels.FromStrings("cluster", "test"),
+		AlertmanagerURLs:    []string{server.URL},
+		AlertmanagerTimeout: time.Second,
+		Engine: promql.NewEngine(promql.EngineOpts{
+			MaxSamples: 1000,
+			Timeout:    time.Minute,
+		}),
+		Storage:           store,
+		Appender:          store,
+		InstrumentOptions: instrument.NewOptions(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+
+	groups := m.RuleGroups()
+	require.Len(t, groups, 1)
+	assert.Equal(t, "test", groups[0].Name())
+	assert.Equal(t, 10*time.Millisecond, groups[0].Interval())
+	require.Len(t, groups[0].Rules(), 2)
+	require.Len(t, m.AlertingRules(), 1)
+
+	require.Eventually(t, func() bool {
+		return len(alertmanager.received()) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, m.Close())
+
+	var recorded bool
+	for _, write := range store.Writes() {
+		name, _ := write.Tags().Name()
+		if string(name) != "job:answer" {
+			continue
+		}
+
+		recorded = true
+		job, ok := write.Tags().Get([]byte("job"))
+		require.True(t, ok)
+		assert.Equal(t, "test", string(job))
+		assert.Equal(t, 42.0, write.Datapoints()[0].Value)
+	}
+	assert.True(t, recorded)
+
+	received := alertmanager.received()[0]
+	assert.Equal(t, labels.FromStrings(
+		"alertname", "AlwaysFiring",
+		"cluster", "test",
+		"severity", "page",
+	), received.Labels)
+	assert.Equal(t, labels.FromStrings("summary", "always firing"), received.Annotations)
+	assert.Contains(t, received.GeneratorURL, "/graph?g0.expr=")
+	assert.False(t, received.StartsAt.IsZero())
+}
+
+func TestNewManagerValidatesOptions(t *testing.T) {
+	_, err := NewManager(Options{})
+	assert.Equal(t, errNoEngine, err)
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/rules"
+	"github.com/prometheus/prometheus/util/strutil"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+const (
+	alertmanagerAlertsPath = "/api/v2/alerts"
+
+	// defaultNotificationQueueCapacity is the number of alert batches that
+	// can be queued before new batches are dropped.
+	defaultNotificationQueueCapacity = 1024
+)
+
+var errorReadingBody = []byte("error reading body")
+
+// alert is an alert in the format accepted by the Alertmanager API.
+type alert struct {
+	Labels       labels.Labels `json:"labels"`
+	Annotations  labels.Labels `json:"annotations"`
+	StartsAt     time.Time     `json:"startsAt,omitempty"`
+	EndsAt       time.Time     `json:"endsAt,omitempty"`
+	GeneratorURL string        `json:"generatorURL,omitempty"`
+}
+
+type notifierMetrics struct {
+	sent    tally.Counter
+	dropped tally.Counter
+	errors  tally.Counter
+}
+
+// notifier sends the alerts of alerting rules to Alertmanager instances.
+// Alerts are queued and sent asynchronously so that slow Alertmanager
+// instances do not delay rule evaluations.
+type notifier struct {
+	client         *http.Client
+	urls           []string
+	externalURL    string
+	externalLabels labels.Labels
+	queue          chan []alert
+	metrics        notifierMetrics
+	logger         *zap.Logger
+	wg             sync.WaitGroup
+}
+
+func newNotifier(
+	urls []string,
+	timeout time.Duration,
+	externalURL *url.URL,
+	externalLabels labels.Labels,
+	instrumentOpts instrument.Options,
+) *notifier {
+	httpOpts := xhttp.DefaultHTTPClientOptions()
+	httpOpts.RequestTimeout = timeout
+
+	alertmanagerURLs := make([]string, 0, len(urls))
+	for _, u := range urls {
+		alertmanagerURLs = append(alertmanagerURLs,
+			strings.TrimSuffix(u, "/")+alertmanagerAlertsPath)
+	}
+
+	scope := instrumentOpts.MetricsScope().SubScope("notifier")
+	n := &notifier{
+		client:         xhttp.NewHTTPClient(httpOpts),
+		urls:           alertmanagerURLs,
+		externalURL:    externalURL.String(),
+		externalLabels: externalLabels,
+		queue:          make(chan []alert, defaultNotificationQueueCapacity),
+		metrics: notifierMetrics{
+			sent:    scope.Counter("alerts-sent"),
+			dropped: scope.Counter("alerts-dropped"),
+			errors:  scope.Counter("errors"),
+		},
+		logger: instrumentOpts.Logger(),
+	}
+
+	n.wg.Add(1)
+	go n.run()
+	return n
+}
+
+// Notify queues the alerts of an alerting rule to be sent, it is the
+// notification function of the Prometheus rule manager.
+func (n *notifier) Notify(_ context.Context, expr string, alerts ...*rules.Alert) {
+	if len(alerts) == 0 {
+		return
+	}
+
+	// NB: alerts are converted the same way Prometheus converts them before
+	// sending them to Alertmanager.
+	batch := make([]alert, 0, len(alerts))
+	for _, a := range alerts {
+		converted := alert{
+			Labels:       n.withExternalLabels(a.Labels),
+			Annotations:  a.Annotations,
+			StartsAt:     a.FiredAt,
+			EndsAt:       a.ValidUntil,
+			GeneratorURL: n.externalURL + strutil.TableLinkForExpression(expr),
+		}
+		if !a.ResolvedAt.IsZero() {
+			converted.EndsAt = a.ResolvedAt
+		}
+		batch = append(batch, converted)
+	}
+
+	select {
+	case n.queue <- batch:
+	default:
+		n.metrics.dropped.Inc(int64(len(batch)))
+		n.logger.Warn("notification queue full, dropping alerts",
+			zap.Int("alerts", len(batch)))
+	}
+}
+
+func (n *notifier) withExternalLabels(l labels.Labels) labels.Labels {
+	if len(n.externalLabels) == 0 {
+		return l
+	}
+
+	b := labels.NewBuilder(l)
+	for _, label := range n.externalLabels {
+		// Labels of the alert take precedence over external labels.
+		if l.Get(label.Name) == "" {
+			b.Set(label.Name, label.Value)
+		}
+	}
+	return b.Labels()
+}
+
+func (n *notifier) run() {
+	defer n.wg.Done()
+
+	for batch := range n.queue {
+		body, err := json.Marshal(batch)
+		if err != nil {
+			n.metrics.errors.Inc(1)
+			n.logger.Error("could not encode alerts", zap.Error(err))
+			continue
+		}
+
+		for _, u := range n.urls {
+			if err := n.send(u, body); err != nil {
+				n.metrics.errors.Inc(1)
+				n.logger.Error("could not send alerts to alertmanager",
+					zap.String("url", u), zap.Error(err))
+				continue
+			}
+			n.metrics.sent.Inc(int64(len(batch)))
+		}
+	}
+}
+
+func (n *notifier) send(address string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, address, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode/100 != 2 {
+		response, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			n.logger.Error("error reading body", zap.Error(err))
+			response = errorReadingBody
+		}
+		return fmt.Errorf("expected status code 2XX: actual=%v, address=%v, resp=%s",
+			resp.StatusCode, address, response)
+	}
+	return nil
+}
+
+// Close stops sending alerts once the queued alerts have been sent.
+func (n *notifier) Close() {
+	close(n.queue)
+	n.wg.Wait()
+}
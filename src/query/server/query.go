@@ -26,6 +26,7 @@ import (
 	"math/rand"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -35,6 +36,7 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
 	extprom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/model/labels"
 	prometheuspromql "github.com/prometheus/prometheus/promql"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -69,15 +71,18 @@ import (
 	"github.com/m3db/m3/src/query/pools"
 	"github.com/m3db/m3/src/query/promqlengine"
 	tsdbremote "github.com/m3db/m3/src/query/remote"
+	"github.com/m3db/m3/src/query/rules"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/fanout"
 	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	"github.com/m3db/m3/src/query/storage/metricmetadata"
 	"github.com/m3db/m3/src/query/storage/promremote"
 	"github.com/m3db/m3/src/query/storage/remote"
 	"github.com/m3db/m3/src/query/stores/m3db"
 	"github.com/m3db/m3/src/x/clock"
 	xconfig "github.com/m3db/m3/src/x/config"
+	"github.com/m3db/m3/src/x/headers"
 	"github.com/m3db/m3/src/x/instrument"
 	xio "github.com/m3db/m3/src/x/io"
 	xnet "github.com/m3db/m3/src/x/net"
@@ -336,6 +341,13 @@ func Run(runOpts RunOptions) RunResult {
 		logger.Fatal("could not parse query restrict tags config", zap.Error(err))
 	}
 
+	tenancyOpts := cfg.Tenancy.TenancyOptions()
+	if tenancyOpts.Enabled {
+		logger.Info("tenancy enabled, requests require a tenant",
+			zap.String("header", headers.TenantHeader),
+			zap.ByteString("tagName", tenancyOpts.TagName))
+	}
+
 	timeout := cfg.Query.TimeoutOrDefault()
 	if runOpts.DBConfig != nil &&
 		runOpts.DBConfig.Client.FetchTimeout != nil &&
@@ -348,6 +360,7 @@ func Run(runOpts RunOptions) RunResult {
 		handleroptions.FetchOptionsBuilderOptions{
 			Limits:        fetchOptsBuilderLimitsOpts,
 			RestrictByTag: storageRestrictByTags,
+			Tenancy:       tenancyOpts,
 			Timeout:       timeout,
 		})
 	if err != nil {
@@ -409,7 +422,8 @@ func Run(runOpts RunOptions) RunResult {
 		SetReadWorkerPool(readWorkerPool).
 		SetWriteWorkerPool(writeWorkerPool).
 		SetSeriesConsolidationMatchOptions(matchOptions).
-		SetPromConvertOptions(promConvertOptions)
+		SetPromConvertOptions(promConvertOptions).
+		SetFetchStreamingEnabled(cfg.Query.Streaming.Enabled)
 
 	if runOpts.ApplyCustomTSDBOptions != nil {
 		tsdbOpts, err = runOpts.ApplyCustomTSDBOptions(tsdbOpts, instrumentOptions)
@@ -629,6 +643,7 @@ func Run(runOpts RunOptions) RunResult {
 				handleroptions.FetchOptionsBuilderOptions{
 					Limits:        fetchOptsBuilderLimitsOpts,
 					RestrictByTag: storageRestrictByTags,
+					Tenancy:       tenancyOpts,
 					Timeout:       timeout,
 				})
 			if err != nil {
@@ -641,6 +656,7 @@ func Run(runOpts RunOptions) RunResult {
 				handleroptions.FetchOptionsBuilderOptions{
 					Limits:        fetchOptsBuilderLimitsOpts,
 					RestrictByTag: storageRestrictByTags,
+					Tenancy:       tenancyOpts,
 					Timeout:       timeout,
 				})
 			if err != nil {
@@ -680,6 +696,34 @@ func Run(runOpts RunOptions) RunResult {
 		logger.Fatal("unable to set up handler options", zap.Error(err))
 	}
 
+	var rulesManager rules.Manager
+	if cfg.Rules != nil {
+		rulesManager, err = newRulesManager(*cfg.Rules, defaultPrometheusEngine,
+			backendStorage, tagOptions, timeout, prometheusEngineRegistry, instrumentOptions)
+		if err != nil {
+			logger.Fatal("unable to create rules manager", zap.Error(err))
+		}
+		handlerOptions = handlerOptions.SetRulesManager(rulesManager)
+	}
+
+	if cfg.MetricMetadata.Enabled {
+		if clusterClient == nil {
+			logger.Fatal("metric metadata requires a cluster client")
+		}
+		metricMetadataStore, err := metricmetadata.NewKVStore(metricmetadata.Options{
+			// NB: the cluster client may be initialized asynchronously so the
+			// KV store is only resolved on first use.
+			KVStoreFn:         clusterClient.KV,
+			Key:               cfg.MetricMetadata.KVKey,
+			InstrumentOptions: instrumentOptions,
+		})
+		if err != nil {
+			logger.Fatal("unable to create metric metadata store", zap.Error(err))
+		}
+		defer metricMetadataStore.Close()
+		handlerOptions = handlerOptions.SetMetricMetadataStore(metricMetadataStore)
+	}
+
 	var customHandlerOpts options.CustomHandlerOptions
 	if runOpts.CustomHandlerOptions != nil {
 		customHandlerOpts, err = runOpts.CustomHandlerOptions(instrumentOptions)
@@ -771,6 +815,13 @@ func Run(runOpts RunOptions) RunResult {
 		defer server.Close()
 	}
 
+	if rulesManager != nil {
+		if err := rulesManager.Start(); err != nil {
+			logger.Fatal("unable to start rules manager", zap.Error(err))
+		}
+		defer rulesManager.Close()
+	}
+
 	// Stop our async watch and now block waiting for the interrupt.
 	intWatchCancel()
 	select {
@@ -1388,9 +1439,48 @@ func newPromQLEngine(
 			},
 		}
 	)
+	promqlengine.RegisterFunctions()
 	return prometheuspromql.NewEngine(opts), nil
 }
 
+func newRulesManager(
+	cfg config.RulesConfiguration,
+	engine *prometheuspromql.Engine,
+	backendStorage storage.Storage,
+	tagOptions models.TagOptions,
+	timeout time.Duration,
+	registry extprom.Registerer,
+	instrumentOpts instrument.Options,
+) (rules.Manager, error) {
+	externalURL, err := url.Parse(cfg.ExternalURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rules external URL: %w", err)
+	}
+
+	fetchOpts := storage.NewFetchOptions()
+	fetchOpts.Timeout = timeout
+
+	opts := rules.Options{
+		Files:              cfg.Files,
+		EvaluationInterval: cfg.EvaluationIntervalOrDefault(),
+		ExternalLabels:     labels.FromMap(cfg.ExternalLabels),
+		ExternalURL:        externalURL,
+		Engine:             engine,
+		Storage:            backendStorage,
+		Appender:           backendStorage,
+		FetchOptions:       fetchOpts,
+		TagOptions:         tagOptions,
+		Registerer:         registry,
+		InstrumentOptions:  instrumentOpts,
+	}
+	if am := cfg.Alertmanager; am != nil {
+		opts.AlertmanagerURLs = am.URLs
+		opts.AlertmanagerTimeout = am.TimeoutOrDefault()
+	}
+
+	return rules.NewManager(opts)
+}
+
 func createEnginesWithResolutionBasedLookbacks(
 	defaultLookback time.Duration,
 	defaultEngine *prometheuspromql.Engine,
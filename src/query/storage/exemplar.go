@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// Exemplar is a sample annotated with labels, such as a trace ID, which
+// identify where it was observed.
+type Exemplar struct {
+	Labels    []models.Tag
+	Value     float64
+	Timestamp xtime.UnixNano
+}
+
+// SeriesExemplars are the exemplars of a single series.
+type SeriesExemplars struct {
+	Tags      models.Tags
+	Exemplars []Exemplar
+}
+
+// ExemplarStorage writes and fetches exemplars.
+type ExemplarStorage interface {
+	// WriteExemplars writes the exemplars of the series with the given tags.
+	WriteExemplars(ctx context.Context, tags models.Tags, exemplars []Exemplar) error
+
+	// FetchExemplars fetches the exemplars of all series matching the query.
+	FetchExemplars(
+		ctx context.Context,
+		query *FetchQuery,
+		options *FetchOptions,
+	) ([]SeriesExemplars, error)
+}
+
+// PromExemplarsToM3 converts Prometheus exemplars to M3 exemplars.
+func PromExemplarsToM3(exemplars []prompb.Exemplar) []Exemplar {
+	result := make([]Exemplar, 0, len(exemplars))
+	for _, exemplar := range exemplars {
+		labels := make([]models.Tag, 0, len(exemplar.Labels))
+		for _, label := range exemplar.Labels {
+			labels = append(labels, models.Tag{Name: label.Name, Value: label.Value})
+		}
+
+		result = append(result, Exemplar{
+			Labels:    labels,
+			Value:     exemplar.Value,
+			Timestamp: promTimestampToUnixNanos(exemplar.Timestamp),
+		})
+	}
+
+	return result
+}
+
+// ExemplarLabelsToAnnotation encodes exemplar labels as a datapoint
+// annotation.
+func ExemplarLabelsToAnnotation(labels []models.Tag) ([]byte, error) {
+	promLabels := prompb.Labels{Labels: make([]prompb.Label, 0, len(labels))}
+	for _, label := range labels {
+		promLabels.Labels = append(promLabels.Labels,
+			prompb.Label{Name: label.Name, Value: label.Value})
+	}
+
+	return promLabels.Marshal()
+}
+
+// AnnotationToExemplarLabels decodes exemplar labels from a datapoint
+// annotation.
+func AnnotationToExemplarLabels(annotation []byte) ([]models.Tag, error) {
+	var promLabels prompb.Labels
+	if err := promLabels.Unmarshal(annotation); err != nil {
+		return nil, err
+	}
+
+	labels := make([]models.Tag, 0, len(promLabels.Labels))
+	for _, label := range promLabels.Labels {
+		labels = append(labels, models.Tag{Name: label.Name, Value: label.Value})
+	}
+
+	return labels, nil
+}
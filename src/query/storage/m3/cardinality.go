@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"context"
+
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/query/storage"
+)
+
+// cardinalityStorage fetches cardinality statistics from the namespace of
+// the unaggregated cluster, since it holds every series that is written.
+type cardinalityStorage struct {
+	clusters Clusters
+}
+
+// NewCardinalityStorage returns cardinality storage backed by the
+// unaggregated cluster namespace.
+func NewCardinalityStorage(clusters Clusters) storage.CardinalityStorage {
+	return &cardinalityStorage{clusters: clusters}
+}
+
+func (s *cardinalityStorage) FetchCardinality(
+	ctx context.Context,
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+	limit int,
+) (index.CardinalityResults, error) {
+	namespace, exists := s.clusters.UnaggregatedClusterNamespace()
+	if !exists {
+		return nil, errUnaggregatedNamespaceUninitialized
+	}
+
+	m3query, err := storage.FetchQueryToM3Query(query, options)
+	if err != nil {
+		return nil, err
+	}
+
+	queryOpts, err := storage.FetchOptionsToM3Options(options, query)
+	if err != nil {
+		return nil, err
+	}
+
+	cardinalityOpts := index.CardinalityOptions{
+		QueryOptions: queryOpts,
+		Limit:        limit,
+	}
+	results, _, err := namespace.Session().Cardinality(ctx,
+		namespace.NamespaceID(), m3query, cardinalityOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
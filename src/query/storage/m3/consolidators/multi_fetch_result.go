@@ -290,6 +290,12 @@ func (r *multiResult) Add(add MultiFetchResults) {
 	}
 }
 
+func (r *multiResult) Done() bool {
+	r.Lock()
+	defer r.Unlock()
+	return !r.err.Empty() || (len(r.seenIters) > 0 && !r.metadata.Exhaustive)
+}
+
 func (r *multiResult) AddWarnings(warnings ...block.Warning) {
 	r.Lock()
 	defer r.Unlock()
@@ -21,6 +21,7 @@
 package consolidators
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -348,3 +349,38 @@ func TestAddWarningsPreservedFollowedByAdd(t *testing.T) {
 
 	assert.NoError(t, r.Close())
 }
+
+func TestDone(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	r := NewMultiFetchResult(NamespaceCoversAllQueryRange,
+		defaultTestOpts, models.NewTagOptions(), LimitOptions{Limit: 1})
+	require.False(t, r.Done())
+
+	for i := 0; i < 2; i++ {
+		iters := encoding.NewSeriesIterators([]encoding.SeriesIterator{
+			encoding.NewSeriesIterator(encoding.SeriesIteratorOptions{
+				ID:        ident.StringID(fmt.Sprint(i)),
+				Namespace: ident.StringID("ns"),
+			}, nil),
+		})
+		r.Add(MultiFetchResults{
+			SeriesIterators: iters,
+			Metadata:        block.NewResultMetadata(),
+			Attrs:           storagemetadata.Attributes{},
+		})
+		// NB: the result is only done once a series is added past the limit.
+		require.Equal(t, i > 0, r.Done())
+	}
+	assert.NoError(t, r.Close())
+
+	r = NewMultiFetchResult(NamespaceCoversAllQueryRange,
+		defaultTestOpts, models.NewTagOptions(), LimitOptions{Limit: 1})
+	r.Add(MultiFetchResults{
+		Metadata: block.NewResultMetadata(),
+		Err:      errors.New("an error"),
+	})
+	require.True(t, r.Done())
+	assert.NoError(t, r.Close())
+}
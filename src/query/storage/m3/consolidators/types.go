@@ -102,6 +102,10 @@ type MultiFetchResult interface {
 	// these results, and any errors encountered.
 	FinalResultWithAttrs() (SeriesFetchResult, []storagemetadata.Attributes, error)
 
+	// Done returns whether any further results added would be discarded,
+	// due to either an error or the series limit having been reached.
+	Done() bool
+
 	// Close releases all resources held by this accumulator.
 	Close() error
 }
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"context"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// exemplarStorage stores exemplars as datapoints of a dedicated namespace in
+// the unaggregated cluster, with exemplar labels kept in the datapoint
+// annotation. Series in the exemplar namespace share the tags of the series
+// the exemplars were observed for, so they can be fetched with the same
+// matchers, and are bounded by the retention of the namespace.
+type exemplarStorage struct {
+	clusters   Clusters
+	namespace  ident.ID
+	tagOptions models.TagOptions
+}
+
+// NewExemplarStorage returns exemplar storage backed by the given namespace
+// of the unaggregated cluster. The namespace should not be configured as a
+// cluster namespace, so that exemplars are not returned by regular queries.
+func NewExemplarStorage(
+	clusters Clusters,
+	namespace string,
+	tagOptions models.TagOptions,
+) storage.ExemplarStorage {
+	return &exemplarStorage{
+		clusters:   clusters,
+		namespace:  ident.StringID(namespace),
+		tagOptions: tagOptions,
+	}
+}
+
+func (s *exemplarStorage) WriteExemplars(
+	_ context.Context,
+	tags models.Tags,
+	exemplars []storage.Exemplar,
+) error {
+	namespace, exists := s.clusters.UnaggregatedClusterNamespace()
+	if !exists {
+		return errUnaggregatedNamespaceUninitialized
+	}
+
+	var (
+		session  = namespace.Session()
+		id       = ident.BytesID(tags.ID())
+		multiErr xerrors.MultiError
+	)
+
+	// Set id to NoFinalize to avoid cloning it in write operations
+	id.NoFinalize()
+
+	for _, exemplar := range exemplars {
+		annotation, err := storage.ExemplarLabelsToAnnotation(exemplar.Labels)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+
+		err = session.WriteTagged(s.namespace, id,
+			storage.TagsToIdentTagIterator(tags), exemplar.Timestamp,
+			exemplar.Value, xtime.Millisecond, annotation)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
+func (s *exemplarStorage) FetchExemplars(
+	ctx context.Context,
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) ([]storage.SeriesExemplars, error) {
+	namespace, exists := s.clusters.UnaggregatedClusterNamespace()
+	if !exists {
+		return nil, errUnaggregatedNamespaceUninitialized
+	}
+
+	m3query, err := storage.FetchQueryToM3Query(query, options)
+	if err != nil {
+		return nil, err
+	}
+
+	queryOpts, err := storage.FetchOptionsToM3Options(options, query)
+	if err != nil {
+		return nil, err
+	}
+
+	iters, _, err := namespace.Session().FetchTagged(ctx, s.namespace,
+		m3query, queryOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	defer iters.Close()
+
+	result := make([]storage.SeriesExemplars, 0, iters.Len())
+	for _, iter := range iters.Iters() {
+		tags, err := consolidators.FromIdentTagIteratorToTags(iter.Tags(),
+			s.tagOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		// NB: clone the tags since the iterators are closed on return.
+		series := storage.SeriesExemplars{Tags: tags.Clone()}
+		for iter.Next() {
+			dp, _, annotation := iter.Current()
+			labels, err := storage.AnnotationToExemplarLabels(annotation)
+			if err != nil {
+				return nil, err
+			}
+
+			series.Exemplars = append(series.Exemplars, storage.Exemplar{
+				Labels:    labels,
+				Value:     dp.Value,
+				Timestamp: dp.TimestampNanos,
+			})
+		}
+
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+
+		if len(series.Exemplars) > 0 {
+			result = append(result, series)
+		}
+	}
+
+	return result, nil
+}
@@ -145,6 +145,7 @@ type encodedBlockOptions struct {
 	adminOptions                  []client.CustomAdminOption
 	promConvertOptions            storage.PromConvertOptions
 	instrumented                  bool
+	fetchStreamingEnabled         bool
 }
 
 func newOptions(
@@ -341,6 +342,16 @@ func (o *encodedBlockOptions) PromConvertOptions() storage.PromConvertOptions {
 	return o.promConvertOptions
 }
 
+func (o *encodedBlockOptions) SetFetchStreamingEnabled(value bool) Options {
+	opts := *o
+	opts.fetchStreamingEnabled = value
+	return &opts
+}
+
+func (o *encodedBlockOptions) FetchStreamingEnabled() bool {
+	return o.fetchStreamingEnabled
+}
+
 func (o *encodedBlockOptions) Validate() error {
 	if o.lookbackDuration < 0 {
 		return errors.New("unable to validate block options; negative lookback")
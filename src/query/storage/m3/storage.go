@@ -35,6 +35,7 @@ import (
 
 	coordmodel "github.com/m3db/m3/src/cmd/services/m3coordinator/model"
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/errors"
@@ -447,6 +448,11 @@ func (s *m3storage) fetchCompressed(
 			session := namespace.Session()
 			namespaceID := namespace.NamespaceID()
 			narrowedQueryOpts := narrowQueryOpts(queryOptions, namespace)
+			if s.opts.FetchStreamingEnabled() {
+				fetchTaggedStream(ctx, namespace, m3query, narrowedQueryOpts, result)
+				return
+			}
+
 			iters, metadata, err := session.FetchTagged(ctx, namespaceID, m3query, narrowedQueryOpts)
 			if err == nil && sampled {
 				span.LogFields(
@@ -458,18 +464,11 @@ func (s *m3storage) fetchCompressed(
 				)
 			}
 
-			blockMeta := block.NewResultMetadata()
-			blockMeta.AddNamespace(namespaceID.String())
-			blockMeta.FetchedResponses = metadata.Responses
-			blockMeta.FetchedBytesEstimate = metadata.EstimateTotalBytes
-			blockMeta.Exhaustive = metadata.Exhaustive
-			blockMeta.WaitedIndex = metadata.WaitedIndex
-			blockMeta.WaitedSeriesRead = metadata.WaitedSeriesRead
 			// Ignore error from getting iterator pools, since operation
 			// will not be dramatically impacted if pools is nil
 			result.Add(consolidators.MultiFetchResults{
 				SeriesIterators: iters,
-				Metadata:        blockMeta,
+				Metadata:        fetchResultMetadata(namespaceID, metadata),
 				Attrs:           namespace.Options().Attributes(),
 				Err:             err,
 			})
@@ -481,6 +480,9 @@ func (s *m3storage) fetchCompressed(
 	// Check if the query was interrupted.
 	select {
 	case <-ctx.Done():
+		// NB: the result is not returned so close it to release the series
+		// added to it before the query was interrupted.
+		result.Close()
 		return nil, index.Query{}, ctx.Err()
 	default:
 	}
@@ -488,6 +490,82 @@ func (s *m3storage) fetchCompressed(
 	return result, m3query, err
 }
 
+// fetchTaggedStream adds the series streamed from the namespace to the result
+// a batch at a time, stopping early once the query is cancelled or the result
+// would discard any further series added to it. A cancelled query is failed by
+// fetchCompressed once every namespace has been fetched.
+func fetchTaggedStream(
+	ctx context.Context,
+	namespace ClusterNamespace,
+	query index.Query,
+	queryOpts index.QueryOptions,
+	result consolidators.MultiFetchResult,
+) {
+	namespaceID := namespace.NamespaceID()
+	attrs := namespace.Options().Attributes()
+	stream, err := namespace.Session().FetchTaggedStream(ctx, namespaceID, query, queryOpts)
+	if err != nil {
+		result.Add(consolidators.MultiFetchResults{
+			Metadata: fetchResultMetadata(namespaceID, client.FetchResponseMetadata{}),
+			Attrs:    attrs,
+			Err:      err,
+		})
+		return
+	}
+
+	// NB: closing the stream cancels it on the hosts if it was not read to
+	// completion, so that they stop holding onto the remaining series.
+	defer stream.Close()
+	var (
+		iters    encoding.SeriesIterators
+		metadata client.FetchResponseMetadata
+	)
+	for !result.Done() && ctx.Err() == nil && stream.Next() {
+		if iters != nil {
+			// NB: only the final batch of a stream can be exhaustive, so a
+			// batch followed by another leaves it to the final batch.
+			metadata.Exhaustive = true
+			result.Add(consolidators.MultiFetchResults{
+				SeriesIterators: iters,
+				Metadata:        fetchResultMetadata(namespaceID, metadata),
+				Attrs:           attrs,
+			})
+		}
+		iters, metadata = stream.Current()
+	}
+	if iters != nil {
+		// NB: the last batch read is not exhaustive unless it is the final
+		// batch of the stream.
+		result.Add(consolidators.MultiFetchResults{
+			SeriesIterators: iters,
+			Metadata:        fetchResultMetadata(namespaceID, metadata),
+			Attrs:           attrs,
+		})
+	}
+
+	if err := stream.Err(); err != nil {
+		result.Add(consolidators.MultiFetchResults{
+			Metadata: fetchResultMetadata(namespaceID, client.FetchResponseMetadata{}),
+			Attrs:    attrs,
+			Err:      err,
+		})
+	}
+}
+
+func fetchResultMetadata(
+	namespaceID ident.ID,
+	metadata client.FetchResponseMetadata,
+) block.ResultMetadata {
+	blockMeta := block.NewResultMetadata()
+	blockMeta.AddNamespace(namespaceID.String())
+	blockMeta.FetchedResponses = metadata.Responses
+	blockMeta.FetchedBytesEstimate = metadata.EstimateTotalBytes
+	blockMeta.Exhaustive = metadata.Exhaustive
+	blockMeta.WaitedIndex = metadata.WaitedIndex
+	blockMeta.WaitedSeriesRead = metadata.WaitedSeriesRead
+	return blockMeta
+}
+
 func (s *m3storage) SearchSeries(
 	ctx context.Context,
 	query *storage.FetchQuery,
@@ -404,6 +404,143 @@ func TestLocalRead(t *testing.T) {
 	assertFetchResult(t, results, testTags)
 }
 
+func newTestStreamSeriesIterators(id string) encoding.SeriesIterators {
+	return encoding.NewSeriesIterators([]encoding.SeriesIterator{
+		encoding.NewSeriesIterator(encoding.SeriesIteratorOptions{
+			ID:        ident.StringID(id),
+			Namespace: ident.StringID("metrics_unaggregated"),
+		}, nil),
+	})
+}
+
+func TestLocalReadStreaming(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	session := client.NewMockSession(ctrl)
+	clusters, err := NewClusters(UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("metrics_unaggregated"),
+		Session:     session,
+		Retention:   test1MonthRetention,
+	})
+	require.NoError(t, err)
+
+	opts := NewOptions(encoding.NewOptions()).
+		SetLookbackDuration(time.Minute).
+		SetTagOptions(models.NewTagOptions().SetMetricName([]byte("name"))).
+		SetFetchStreamingEnabled(true)
+	store, err := NewStorage(clusters, opts, instrument.NewTestOptions(t))
+	require.NoError(t, err)
+
+	// The stream is closed without reading the remaining batches once the
+	// series limit is exceeded. A batch is only added once the next batch is
+	// read, since only the final batch of a stream can be exhaustive.
+	stream := client.NewMockSeriesIteratorsStream(ctrl)
+	gomock.InOrder(
+		stream.EXPECT().Next().Return(true),
+		stream.EXPECT().Current().Return(newTestStreamSeriesIterators("a"),
+			client.FetchResponseMetadata{Responses: 1}),
+		stream.EXPECT().Next().Return(true),
+		stream.EXPECT().Current().Return(newTestStreamSeriesIterators("b"),
+			client.FetchResponseMetadata{Responses: 1}),
+		stream.EXPECT().Next().Return(true),
+		stream.EXPECT().Current().Return(newTestStreamSeriesIterators("c"),
+			client.FetchResponseMetadata{Responses: 1}),
+		stream.EXPECT().Err().Return(nil),
+		stream.EXPECT().Close(),
+	)
+	session.EXPECT().
+		FetchTaggedStream(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(stream, nil)
+
+	fetchOpts := buildFetchOpts()
+	fetchOpts.SeriesLimit = 1
+	result, err := store.(*m3storage).FetchCompressed(context.TODO(), newFetchReq(), fetchOpts)
+	require.NoError(t, err)
+
+	res, err := result.FinalResult()
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Count())
+	require.False(t, res.Metadata.Exhaustive)
+	require.Equal(t, 2, res.Metadata.FetchedResponses)
+	require.NoError(t, result.Close())
+}
+
+func TestLocalReadStreamingCancelled(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	session := client.NewMockSession(ctrl)
+	clusters, err := NewClusters(UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("metrics_unaggregated"),
+		Session:     session,
+		Retention:   test1MonthRetention,
+	})
+	require.NoError(t, err)
+
+	opts := NewOptions(encoding.NewOptions()).
+		SetTagOptions(models.NewTagOptions().SetMetricName([]byte("name"))).
+		SetFetchStreamingEnabled(true)
+	store, err := NewStorage(clusters, opts, instrument.NewTestOptions(t))
+	require.NoError(t, err)
+
+	// The query is cancelled while the stream is read.
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	stream := client.NewMockSeriesIteratorsStream(ctrl)
+	gomock.InOrder(
+		stream.EXPECT().Next().DoAndReturn(func() bool {
+			cancel()
+			return true
+		}),
+		stream.EXPECT().Current().Return(newTestStreamSeriesIterators("a"),
+			client.FetchResponseMetadata{Responses: 1}),
+		stream.EXPECT().Err().Return(nil),
+		stream.EXPECT().Close(),
+	)
+	session.EXPECT().
+		FetchTaggedStream(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(stream, nil)
+
+	// The query fails rather than returning the series read before it was
+	// cancelled, the stream is still closed.
+	_, err = store.(*m3storage).FetchCompressed(ctx, newFetchReq(), buildFetchOpts())
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestLocalReadStreamingError(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	session := client.NewMockSession(ctrl)
+	clusters, err := NewClusters(UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("metrics_unaggregated"),
+		Session:     session,
+		Retention:   test1MonthRetention,
+	})
+	require.NoError(t, err)
+
+	opts := NewOptions(encoding.NewOptions()).
+		SetTagOptions(models.NewTagOptions().SetMetricName([]byte("name"))).
+		SetFetchStreamingEnabled(true)
+	store, err := NewStorage(clusters, opts, instrument.NewTestOptions(t))
+	require.NoError(t, err)
+
+	stream := client.NewMockSeriesIteratorsStream(ctrl)
+	gomock.InOrder(
+		stream.EXPECT().Next().Return(false),
+		stream.EXPECT().Err().Return(fmt.Errorf("stream error")),
+		stream.EXPECT().Close(),
+	)
+	session.EXPECT().
+		FetchTaggedStream(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(stream, nil)
+
+	_, err = store.FetchProm(context.TODO(), newFetchReq(), buildFetchOpts())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "stream error")
+}
+
 func TestLocalReadExceedsRetention(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -215,6 +215,12 @@ type Options interface {
 	// PromConvertOptions returns options for converting raw series iterators
 	// to a Prometheus-compatible result.
 	PromConvertOptions() storage.PromConvertOptions
+	// SetFetchStreamingEnabled sets whether series are fetched from the
+	// database nodes in streamed batches.
+	SetFetchStreamingEnabled(bool) Options
+	// FetchStreamingEnabled returns whether series are fetched from the
+	// database nodes in streamed batches.
+	FetchStreamingEnabled() bool
 	// Validate ensures that the given block options are valid.
 	Validate() error
 }
@@ -0,0 +1,407 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package metricmetadata records the metadata of metric families, such as
+// their type and help text, received via Prometheus remote write.
+package metricmetadata
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	// DefaultKVKey is the default KV key metadata is stored under.
+	DefaultKVKey = "_metric_metadata"
+
+	maxWriteAttempts = 5
+)
+
+var (
+	errNoKVStoreFn      = errors.New("no KV store function set")
+	errTooManyConflicts = errors.New("too many conflicting metric metadata updates")
+	errClosed           = errors.New("metric metadata store closed")
+)
+
+// Store records the metadata of metric families. Metadata is scoped to the
+// tenant it was written by, the tenant is empty if tenancy is disabled.
+type Store interface {
+	// Write records the metadata of metric families, metadata of a metric
+	// family replaces its previously recorded metadata.
+	Write(tenant string, metadata []prompb.MetricMetadata) error
+
+	// Metadata returns the recorded metadata sorted by metric family name. If
+	// metric is not empty only the metadata of that metric family is returned
+	// and if limit is positive at most limit metric families are returned.
+	Metadata(tenant, metric string, limit int) ([]prompb.MetricMetadata, error)
+
+	// Close stops watching for metadata updates.
+	Close()
+}
+
+// KVStoreFn returns the KV store metadata is stored in.
+type KVStoreFn func() (kv.Store, error)
+
+// Options are the options of a KV backed store.
+type Options struct {
+	// KVStoreFn returns the KV store metadata is stored in. It is called
+	// until it succeeds since the KV store may not be available at startup.
+	KVStoreFn KVStoreFn
+	// Key is the KV key metadata is stored under.
+	Key string
+	// InstrumentOptions are the instrument options.
+	InstrumentOptions instrument.Options
+}
+
+type storeMetrics struct {
+	updates      tally.Counter
+	updateErrors tally.Counter
+}
+
+// tenantKVStore keeps the metadata of each tenant in a separate kvStore
+// under its own KV key so that tenants only see the metadata they wrote.
+type tenantKVStore struct {
+	sync.Mutex
+
+	opts    Options
+	stores  map[string]*kvStore
+	closed  bool
+	metrics storeMetrics
+}
+
+// NewKVStore returns a new KV backed store.
+func NewKVStore(opts Options) (Store, error) {
+	if opts.KVStoreFn == nil {
+		return nil, errNoKVStoreFn
+	}
+	if opts.Key == "" {
+		opts.Key = DefaultKVKey
+	}
+	if opts.InstrumentOptions == nil {
+		opts.InstrumentOptions = instrument.NewOptions()
+	}
+
+	scope := opts.InstrumentOptions.MetricsScope().SubScope("metric-metadata")
+	return &tenantKVStore{
+		opts:   opts,
+		stores: make(map[string]*kvStore),
+		metrics: storeMetrics{
+			updates:      scope.Counter("updates"),
+			updateErrors: scope.Counter("update-errors"),
+		},
+	}, nil
+}
+
+func (s *tenantKVStore) Write(tenant string, metadata []prompb.MetricMetadata) error {
+	store, err := s.tenantStore(tenant)
+	if err != nil {
+		return err
+	}
+	return store.Write(metadata)
+}
+
+func (s *tenantKVStore) Metadata(
+	tenant, metric string,
+	limit int,
+) ([]prompb.MetricMetadata, error) {
+	store, err := s.tenantStore(tenant)
+	if err != nil {
+		return nil, err
+	}
+	return store.Metadata(metric, limit)
+}
+
+func (s *tenantKVStore) tenantStore(tenant string) (*kvStore, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.closed {
+		return nil, errClosed
+	}
+
+	store, ok := s.stores[tenant]
+	if !ok {
+		key := s.opts.Key
+		if tenant != "" {
+			key = key + "/" + tenant
+		}
+		store = newKVStore(s.opts, key, s.metrics)
+		s.stores[tenant] = store
+	}
+	return store, nil
+}
+
+func (s *tenantKVStore) Close() {
+	s.Lock()
+	defer s.Unlock()
+	if s.closed {
+		return
+	}
+
+	s.closed = true
+	for _, store := range s.stores {
+		store.Close()
+	}
+}
+
+// kvStore keeps the metadata of all metric families of a tenant in a single
+// KV value which is watched so that every coordinator serves the same
+// metadata. Metadata is sent periodically by Prometheus but rarely changes,
+// so KV is only written when the metadata of a metric family changes.
+type kvStore struct {
+	sync.RWMutex
+
+	opts     Options
+	key      string
+	store    kv.Store
+	watch    kv.ValueWatch
+	metadata map[string]prompb.MetricMetadata
+	closed   bool
+	doneCh   chan struct{}
+	metrics  storeMetrics
+	logger   *zap.Logger
+}
+
+func newKVStore(opts Options, key string, metrics storeMetrics) *kvStore {
+	return &kvStore{
+		opts:     opts,
+		key:      key,
+		metadata: make(map[string]prompb.MetricMetadata),
+		doneCh:   make(chan struct{}),
+		metrics:  metrics,
+		logger:   opts.InstrumentOptions.Logger(),
+	}
+}
+
+// kvStoreWithLock returns the KV store, initializing it and the watch of the
+// metadata key on first use.
+func (s *kvStore) kvStoreWithLock() (kv.Store, error) {
+	if s.closed {
+		return nil, errClosed
+	}
+	if s.store != nil {
+		return s.store, nil
+	}
+
+	store, err := s.opts.KVStoreFn()
+	if err != nil {
+		return nil, err
+	}
+
+	watch, err := store.Watch(s.key)
+	if err != nil {
+		return nil, err
+	}
+
+	s.store = store
+	s.watch = watch
+	go s.watchUpdates(watch)
+	return store, nil
+}
+
+func (s *kvStore) watchUpdates(watch kv.ValueWatch) {
+	for {
+		select {
+		case <-s.doneCh:
+			return
+		case <-watch.C():
+			if err := s.update(watch.Get()); err != nil {
+				s.logger.Error("could not update metric metadata", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (s *kvStore) update(value kv.Value) error {
+	if value == nil {
+		return nil
+	}
+
+	var list prompb.MetricMetadataList
+	if err := value.Unmarshal(&list); err != nil {
+		return err
+	}
+
+	metadata := make(map[string]prompb.MetricMetadata, len(list.Metadata))
+	for _, m := range list.Metadata {
+		metadata[m.MetricFamilyName] = m
+	}
+
+	s.Lock()
+	s.metadata = metadata
+	s.Unlock()
+	return nil
+}
+
+func (s *kvStore) Write(metadata []prompb.MetricMetadata) error {
+	s.RLock()
+	changed := s.changedWithLock(s.metadata, metadata)
+	s.RUnlock()
+	if !changed {
+		return nil
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	store, err := s.kvStoreWithLock()
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; attempt < maxWriteAttempts; attempt++ {
+		current, version, err := s.getWithLock(store)
+		if err != nil {
+			s.metrics.updateErrors.Inc(1)
+			return err
+		}
+
+		if !s.changedWithLock(current, metadata) {
+			s.metadata = current
+			return nil
+		}
+
+		for _, m := range metadata {
+			if m.MetricFamilyName != "" {
+				current[m.MetricFamilyName] = m
+			}
+		}
+
+		list := &prompb.MetricMetadataList{Metadata: sortedMetadata(current)}
+		if version == 0 {
+			_, err = store.SetIfNotExists(s.key, list)
+		} else {
+			_, err = store.CheckAndSet(s.key, version, list)
+		}
+		if errors.Is(err, kv.ErrVersionMismatch) || errors.Is(err, kv.ErrAlreadyExists) {
+			// Metadata was concurrently updated by another coordinator.
+			continue
+		}
+		if err != nil {
+			s.metrics.updateErrors.Inc(1)
+			return err
+		}
+
+		s.metrics.updates.Inc(1)
+		s.metadata = current
+		return nil
+	}
+
+	s.metrics.updateErrors.Inc(1)
+	return errTooManyConflicts
+}
+
+func (s *kvStore) getWithLock(
+	store kv.Store,
+) (map[string]prompb.MetricMetadata, int, error) {
+	value, err := store.Get(s.key)
+	if errors.Is(err, kv.ErrNotFound) {
+		return make(map[string]prompb.MetricMetadata), 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var list prompb.MetricMetadataList
+	if err := value.Unmarshal(&list); err != nil {
+		return nil, 0, err
+	}
+
+	metadata := make(map[string]prompb.MetricMetadata, len(list.Metadata))
+	for _, m := range list.Metadata {
+		metadata[m.MetricFamilyName] = m
+	}
+	return metadata, value.Version(), nil
+}
+
+func (s *kvStore) changedWithLock(
+	current map[string]prompb.MetricMetadata,
+	metadata []prompb.MetricMetadata,
+) bool {
+	for _, m := range metadata {
+		if m.MetricFamilyName == "" {
+			continue
+		}
+
+		existing, ok := current[m.MetricFamilyName]
+		if !ok || existing != m {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *kvStore) Metadata(metric string, limit int) ([]prompb.MetricMetadata, error) {
+	s.Lock()
+	_, err := s.kvStoreWithLock()
+	s.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	if metric != "" {
+		m, ok := s.metadata[metric]
+		if !ok {
+			return nil, nil
+		}
+		return []prompb.MetricMetadata{m}, nil
+	}
+
+	result := sortedMetadata(s.metadata)
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (s *kvStore) Close() {
+	s.Lock()
+	defer s.Unlock()
+	if s.closed {
+		return
+	}
+
+	s.closed = true
+	close(s.doneCh)
+	if s.watch != nil {
+		s.watch.Close()
+	}
+}
+
+func sortedMetadata(metadata map[string]prompb.MetricMetadata) []prompb.MetricMetadata {
+	result := make([]prompb.MetricMetadata, 0, len(metadata))
+	for _, m := range metadata {
+		result = append(result, m)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].MetricFamilyName < result[j].MetricFamilyName
+	})
+	return result
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metricmetadata
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+)
+
+func newTestStore(t *testing.T, kvStore kv.Store) Store {
+	store, err := NewKVStore(Options{
+		KVStoreFn: func() (kv.Store, error) {
+			return kvStore, nil
+		},
+	})
+	require.NoError(t, err)
+	return store
+}
+
+func TestKVStoreWriteAndMetadata(t *testing.T) {
+	store := newTestStore(t, mem.NewStore())
+	defer store.Close()
+
+	require.NoError(t, store.Write("", []prompb.MetricMetadata{
+		{
+			Type:             prompb.MetricType_GAUGE,
+			MetricFamilyName: "memory_bytes",
+			Help:             "Memory in use.",
+			Unit:             "bytes",
+		},
+		{
+			Type:             prompb.MetricType_COUNTER,
+			MetricFamilyName: "http_requests_total",
+			Help:             "Total HTTP requests.",
+		},
+		{
+			Type: prompb.MetricType_COUNTER,
+			Help: "No metric family name.",
+		},
+	}))
+
+	metadata, err := store.Metadata("", "", 0)
+	require.NoError(t, err)
+	require.Len(t, metadata, 2)
+	assert.Equal(t, "http_requests_total", metadata[0].MetricFamilyName)
+	assert.Equal(t, "memory_bytes", metadata[1].MetricFamilyName)
+	assert.Equal(t, "bytes", metadata[1].Unit)
+
+	metadata, err = store.Metadata("", "", 1)
+	require.NoError(t, err)
+	require.Len(t, metadata, 1)
+	assert.Equal(t, "http_requests_total", metadata[0].MetricFamilyName)
+
+	metadata, err = store.Metadata("", "memory_bytes", 0)
+	require.NoError(t, err)
+	require.Len(t, metadata, 1)
+	assert.Equal(t, prompb.MetricType_GAUGE, metadata[0].Type)
+
+	metadata, err = store.Metadata("", "unknown", 0)
+	require.NoError(t, err)
+	assert.Len(t, metadata, 0)
+}
+
+func TestKVStoreWriteUpdatesMetadata(t *testing.T) {
+	kvStore := mem.NewStore()
+	store := newTestStore(t, kvStore)
+	defer store.Close()
+
+	metadata := []prompb.MetricMetadata{{
+		Type:             prompb.MetricType_COUNTER,
+		MetricFamilyName: "http_requests_total",
+		Help:             "Total HTTP requests.",
+	}}
+	require.NoError(t, store.Write("", metadata))
+	require.NoError(t, store.Write("", metadata))
+
+	value, err := kvStore.Get(DefaultKVKey)
+	require.NoError(t, err)
+	assert.Equal(t, 1, value.Version())
+
+	metadata[0].Help = "Total number of HTTP requests."
+	require.NoError(t, store.Write("", metadata))
+
+	value, err = kvStore.Get(DefaultKVKey)
+	require.NoError(t, err)
+	assert.Equal(t, 2, value.Version())
+
+	var list prompb.MetricMetadataList
+	require.NoError(t, value.Unmarshal(&list))
+	require.Len(t, list.Metadata, 1)
+	assert.Equal(t, "Total number of HTTP requests.", list.Metadata[0].Help)
+}
+
+func TestKVStoreWatchesUpdates(t *testing.T) {
+	kvStore := mem.NewStore()
+	writer := newTestStore(t, kvStore)
+	defer writer.Close()
+	reader := newTestStore(t, kvStore)
+	defer reader.Close()
+
+	metadata, err := reader.Metadata("", "", 0)
+	require.NoError(t, err)
+	require.Len(t, metadata, 0)
+
+	require.NoError(t, writer.Write("", []prompb.MetricMetadata{{
+		Type:             prompb.MetricType_SUMMARY,
+		MetricFamilyName: "request_duration_seconds",
+	}}))
+
+	require.Eventually(t, func() bool {
+		metadata, err := reader.Metadata("", "request_duration_seconds", 0)
+		return err == nil && len(metadata) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestKVStoreUnavailable(t *testing.T) {
+	errUnavailable := errors.New("unavailable")
+	store, err := NewKVStore(Options{
+		KVStoreFn: func() (kv.Store, error) {
+			return nil, errUnavailable
+		},
+	})
+	require.NoError(t, err)
+	defer store.Close()
+
+	_, err = store.Metadata("", "", 0)
+	assert.Equal(t, errUnavailable, err)
+
+	err = store.Write("", []prompb.MetricMetadata{{MetricFamilyName: "foo"}})
+	assert.Equal(t, errUnavailable, err)
+}
+
+func TestKVStoreTenants(t *testing.T) {
+	kvStore := mem.NewStore()
+	store := newTestStore(t, kvStore)
+	defer store.Close()
+
+	require.NoError(t, store.Write("foo", []prompb.MetricMetadata{{
+		Type:             prompb.MetricType_COUNTER,
+		MetricFamilyName: "http_requests_total",
+	}}))
+
+	metadata, err := store.Metadata("foo", "", 0)
+	require.NoError(t, err)
+	require.Len(t, metadata, 1)
+
+	// Metadata is only visible to the tenant that wrote it.
+	metadata, err = store.Metadata("bar", "", 0)
+	require.NoError(t, err)
+	assert.Len(t, metadata, 0)
+	metadata, err = store.Metadata("", "", 0)
+	require.NoError(t, err)
+	assert.Len(t, metadata, 0)
+
+	_, err = kvStore.Get(DefaultKVKey + "/foo")
+	require.NoError(t, err)
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+)
+
+const (
+	// Native histogram schemas supported by Prometheus; the schema gives the
+	// growth factor between consecutive buckets as 2^(2^-schema).
+	minPromHistogramSchema = -4
+	maxPromHistogramSchema = 8
+)
+
+type promHistogramBucket struct {
+	upperBound string
+	value      float64
+}
+
+// PromHistogramsToM3Series expands Prometheus native histograms into classic
+// bucketed series: one series per populated bucket tagged with the bucket
+// upper bound holding the cumulative count, a series tagged with
+// models.HistogramCountBucket holding the total count and a series tagged with
+// models.HistogramSumBucket holding the sum of observations. This allows the
+// histogram to be stored as regular float series and read back with
+// histogram_quantile, histogram_count and histogram_sum.
+func PromHistogramsToM3Series(
+	tags models.Tags,
+	histograms []prompb.Histogram,
+) ([]models.Tags, []ts.Datapoints, error) {
+	var (
+		seriesTags       []models.Tags
+		seriesDatapoints []ts.Datapoints
+		seriesByBucket   = make(map[string]int)
+	)
+
+	for _, h := range histograms {
+		buckets, err := promHistogramToBuckets(h)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		timestamp := promTimestampToUnixNanos(h.Timestamp)
+		for _, b := range buckets {
+			idx, ok := seriesByBucket[b.upperBound]
+			if !ok {
+				idx = len(seriesTags)
+				seriesByBucket[b.upperBound] = idx
+				seriesTags = append(seriesTags,
+					tags.Clone().SetBucket([]byte(b.upperBound)))
+				seriesDatapoints = append(seriesDatapoints, nil)
+			}
+
+			seriesDatapoints[idx] = append(seriesDatapoints[idx],
+				ts.Datapoint{Timestamp: timestamp, Value: b.value})
+		}
+	}
+
+	return seriesTags, seriesDatapoints, nil
+}
+
+// PromHistogramsToSeriesAttributes returns the series attributes of the
+// series that native histograms are expanded into.
+func PromHistogramsToSeriesAttributes(
+	attributes ts.SeriesAttributes,
+	histograms []prompb.Histogram,
+) ts.SeriesAttributes {
+	attributes.PromType = ts.PromMetricTypeHistogram
+	attributes.HandleValueResets = true
+	for _, h := range histograms {
+		if h.ResetHint == prompb.Histogram_GAUGE {
+			attributes.PromType = ts.PromMetricTypeGaugeHistogram
+			attributes.HandleValueResets = false
+			break
+		}
+	}
+
+	return attributes
+}
+
+func promHistogramToBuckets(h prompb.Histogram) ([]promHistogramBucket, error) {
+	if h.Schema < minPromHistogramSchema || h.Schema > maxPromHistogramSchema {
+		return nil, fmt.Errorf("invalid native histogram schema %d: must be between %d and %d",
+			h.Schema, minPromHistogramSchema, maxPromHistogramSchema)
+	}
+
+	// NB: float histograms carry absolute bucket counts, integer histograms
+	// carry deltas between consecutive bucket counts.
+	isFloat := len(h.NegativeCounts) > 0 || len(h.PositiveCounts) > 0 ||
+		h.CountFloat != 0 || h.ZeroCountFloat != 0
+
+	var (
+		negDeltas, posDeltas = h.NegativeDeltas, h.PositiveDeltas
+		negCounts, posCounts = h.NegativeCounts, h.PositiveCounts
+		count                = float64(h.CountInt)
+		zeroCount            = float64(h.ZeroCountInt)
+	)
+	if isFloat {
+		negDeltas, posDeltas = nil, nil
+		count, zeroCount = h.CountFloat, h.ZeroCountFloat
+	} else {
+		negCounts, posCounts = nil, nil
+	}
+
+	negIndices, negValues, err := promHistogramBucketCounts(h.NegativeSpans, negDeltas, negCounts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid native histogram negative buckets: %v", err)
+	}
+
+	posIndices, posValues, err := promHistogramBucketCounts(h.PositiveSpans, posDeltas, posCounts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid native histogram positive buckets: %v", err)
+	}
+
+	var (
+		buckets    = make([]promHistogramBucket, 0, len(negValues)+len(posValues)+3)
+		cumulative float64
+	)
+
+	// Negative buckets are ordered from the most negative, i.e. the highest
+	// index; the upper bound of negative bucket i is the negated lower bound
+	// of positive bucket i.
+	for i := len(negIndices) - 1; i >= 0; i-- {
+		cumulative += negValues[i]
+		bound := -promHistogramBucketBound(h.Schema, negIndices[i]-1)
+		buckets = append(buckets, newPromHistogramBucket(bound, cumulative))
+	}
+
+	if zeroCount != 0 || h.ZeroThreshold != 0 {
+		cumulative += zeroCount
+		buckets = append(buckets, newPromHistogramBucket(h.ZeroThreshold, cumulative))
+	}
+
+	for i, idx := range posIndices {
+		cumulative += posValues[i]
+		bound := promHistogramBucketBound(h.Schema, idx)
+		buckets = append(buckets, newPromHistogramBucket(bound, cumulative))
+	}
+
+	buckets = append(buckets,
+		promHistogramBucket{upperBound: models.HistogramCountBucket, value: count},
+		promHistogramBucket{upperBound: models.HistogramSumBucket, value: h.Sum})
+	return buckets, nil
+}
+
+// promHistogramBucketCounts resolves the bucket indices described by the
+// given spans along with the absolute count of each bucket.
+func promHistogramBucketCounts(
+	spans []prompb.BucketSpan,
+	deltas []int64,
+	counts []float64,
+) ([]int32, []float64, error) {
+	var numBuckets int
+	for _, span := range spans {
+		numBuckets += int(span.Length)
+	}
+
+	if numBuckets != len(deltas)+len(counts) {
+		return nil, nil, fmt.Errorf("spans describe %d buckets but %d were sent",
+			numBuckets, len(deltas)+len(counts))
+	}
+
+	var (
+		indices = make([]int32, 0, numBuckets)
+		values  = make([]float64, 0, numBuckets)
+		idx     int32
+		current int64
+	)
+	for _, span := range spans {
+		// NB: the offset of the first span is the index of its first bucket,
+		// subsequent offsets are the gaps from the end of the previous span.
+		idx += span.Offset
+
+		for j := uint32(0); j < span.Length; j++ {
+			n := len(indices)
+			indices = append(indices, idx)
+			if len(counts) > 0 {
+				values = append(values, counts[n])
+			} else {
+				current += deltas[n]
+				values = append(values, float64(current))
+			}
+			idx++
+		}
+	}
+
+	return indices, values, nil
+}
+
+// promHistogramBucketBound returns the upper bound of the positive bucket
+// with the given index, which is (2^(2^-schema))^idx.
+func promHistogramBucketBound(schema int32, idx int32) float64 {
+	if schema <= 0 {
+		return math.Ldexp(1, int(idx)<<uint(-schema))
+	}
+
+	return math.Exp2(float64(idx) / float64(int(1)<<uint(schema)))
+}
+
+func newPromHistogramBucket(bound, value float64) promHistogramBucket {
+	return promHistogramBucket{
+		upperBound: strconv.FormatFloat(bound, 'g', -1, 64),
+		value:      value,
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func bucketValues(t *testing.T, tags []models.Tags, dps []ts.Datapoints) map[string][]float64 {
+	require.Equal(t, len(tags), len(dps))
+	result := make(map[string][]float64, len(tags))
+	for i, tag := range tags {
+		name, ok := tag.Name()
+		require.True(t, ok)
+		assert.Equal(t, "foo", string(name))
+
+		bucket, ok := tag.Bucket()
+		require.True(t, ok)
+		result[string(bucket)] = dps[i].Values()
+	}
+
+	return result
+}
+
+func TestPromHistogramsToM3SeriesIntegerHistogram(t *testing.T) {
+	tags := models.NewTags(1, models.NewTagOptions()).SetName([]byte("foo"))
+	histograms := []prompb.Histogram{
+		{
+			CountInt:      12,
+			Sum:           18.4,
+			Schema:        1,
+			ZeroThreshold: 0.001,
+			ZeroCountInt:  2,
+			PositiveSpans: []prompb.BucketSpan{
+				{Offset: 0, Length: 2},
+				{Offset: 1, Length: 1},
+			},
+			PositiveDeltas: []int64{1, 1, -1},
+			NegativeSpans:  []prompb.BucketSpan{{Offset: 0, Length: 1}},
+			NegativeDeltas: []int64{5},
+			Timestamp:      1000,
+		},
+	}
+
+	seriesTags, dps, err := PromHistogramsToM3Series(tags, histograms)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]float64{
+		"-0.7071067811865475": {5},
+		"0.001":               {7},
+		"1":                   {8},
+		"1.414213562373095":   {10},
+		"2.82842712474619":    {11},
+		"+Inf":                {12},
+		"sum":                 {18.4},
+	}, bucketValues(t, seriesTags, dps))
+
+	for _, series := range dps {
+		require.Len(t, series, 1)
+		assert.Equal(t, xtime.UnixNano(1000*1e6), series[0].Timestamp)
+	}
+}
+
+func TestPromHistogramsToM3SeriesFloatHistogram(t *testing.T) {
+	tags := models.NewTags(1, models.NewTagOptions()).SetName([]byte("foo"))
+	histograms := []prompb.Histogram{
+		{
+			CountFloat:     3.5,
+			Sum:            10,
+			Schema:         -1,
+			PositiveSpans:  []prompb.BucketSpan{{Offset: 1, Length: 2}},
+			PositiveCounts: []float64{1.5, 2},
+			Timestamp:      1000,
+		},
+		{
+			CountFloat:     4,
+			Sum:            12,
+			Schema:         -1,
+			PositiveSpans:  []prompb.BucketSpan{{Offset: 1, Length: 1}},
+			PositiveCounts: []float64{4},
+			Timestamp:      2000,
+		},
+	}
+
+	seriesTags, dps, err := PromHistogramsToM3Series(tags, histograms)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]float64{
+		"4":    {1.5, 4},
+		"16":   {3.5},
+		"+Inf": {3.5, 4},
+		"sum":  {10, 12},
+	}, bucketValues(t, seriesTags, dps))
+}
+
+func TestPromHistogramsToM3SeriesInvalid(t *testing.T) {
+	tags := models.NewTags(1, models.NewTagOptions()).SetName([]byte("foo"))
+
+	_, _, err := PromHistogramsToM3Series(tags, []prompb.Histogram{{Schema: 9}})
+	require.Error(t, err)
+
+	_, _, err = PromHistogramsToM3Series(tags, []prompb.Histogram{{
+		PositiveSpans:  []prompb.BucketSpan{{Length: 2}},
+		PositiveDeltas: []int64{1},
+	}})
+	require.Error(t, err)
+}
+
+func TestPromHistogramsToSeriesAttributes(t *testing.T) {
+	attrs := PromHistogramsToSeriesAttributes(ts.DefaultSeriesAttributes(),
+		[]prompb.Histogram{{ResetHint: prompb.Histogram_NO}})
+	assert.Equal(t, ts.PromMetricTypeHistogram, attrs.PromType)
+	assert.True(t, attrs.HandleValueResets)
+
+	attrs = PromHistogramsToSeriesAttributes(ts.DefaultSeriesAttributes(),
+		[]prompb.Histogram{{ResetHint: prompb.Histogram_GAUGE}})
+	assert.Equal(t, ts.PromMetricTypeGaugeHistogram, attrs.PromType)
+	assert.False(t, attrs.HandleValueResets)
+}
@@ -126,6 +126,33 @@ func (o *RestrictByTag) GetFilterByNames() [][]byte {
 	return o.Strip
 }
 
+// WithTenant returns a copy of the tag restrictions that additionally
+// restricts results to series with the given tenant tag, the tenant matcher
+// replaces any existing matcher on the tenant tag so it cannot be overridden.
+func (o *RestrictByTag) WithTenant(tagName, tenant []byte) (*RestrictByTag, error) {
+	matcher, err := models.NewMatcher(models.MatchEqual, tagName, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	if o == nil {
+		return &RestrictByTag{Restrict: models.Matchers{matcher}}, nil
+	}
+
+	result := &RestrictByTag{
+		Restrict: make(models.Matchers, 0, len(o.Restrict)+1),
+		Strip:    o.Strip,
+	}
+	for _, r := range o.Restrict {
+		if bytes.Equal(r.Name, tagName) {
+			continue
+		}
+		result.Restrict = append(result.Restrict, r)
+	}
+	result.Restrict = append(result.Restrict, matcher)
+	return result, nil
+}
+
 // WithAppliedOptions returns a copy of the fetch query applied options
 // that restricts the fetch with respect to labels that must be applied.
 func (q *FetchQuery) WithAppliedOptions(
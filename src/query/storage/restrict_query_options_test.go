@@ -64,3 +64,31 @@ func TestGetRestrict(t *testing.T) {
 	opts.RestrictByTypes = byTypes
 	require.Equal(t, byTypes, opts.GetRestrictByTypes())
 }
+
+func TestRestrictByTagWithTenant(t *testing.T) {
+	tenant, err := models.NewMatcher(models.MatchEqual, []byte("tenant"), []byte("foo"))
+	require.NoError(t, err)
+
+	var opts *RestrictByTag
+	result, err := opts.WithTenant([]byte("tenant"), []byte("foo"))
+	require.NoError(t, err)
+	require.Equal(t, models.Matchers{tenant}, result.GetMatchers())
+	require.Equal(t, [][]byte{[]byte("tenant")}, result.GetFilterByNames())
+
+	other, err := models.NewMatcher(models.MatchEqual, []byte("tenant"), []byte("bar"))
+	require.NoError(t, err)
+	existing, err := models.NewMatcher(models.MatchRegexp, []byte("qux"), []byte("q.*"))
+	require.NoError(t, err)
+
+	opts = &RestrictByTag{
+		Restrict: models.Matchers{other, existing},
+		Strip:    [][]byte{[]byte("qux")},
+	}
+	result, err = opts.WithTenant([]byte("tenant"), []byte("foo"))
+	require.NoError(t, err)
+	require.Equal(t, models.Matchers{existing, tenant}, result.GetMatchers())
+	require.Equal(t, [][]byte{[]byte("qux")}, result.GetFilterByNames())
+
+	// The original restrictions are not modified.
+	require.Equal(t, models.Matchers{other, existing}, opts.GetMatchers())
+}
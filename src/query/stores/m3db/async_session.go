@@ -178,6 +178,23 @@ func (s *AsyncSession) FetchTagged(
 	return s.session.FetchTagged(ctx, namespace, q, opts)
 }
 
+// FetchTaggedStream resolves the provided query to known IDs, and fetches
+// the data for them in batches streamed from each host.
+func (s *AsyncSession) FetchTaggedStream(
+	ctx context.Context,
+	namespace ident.ID,
+	q index.Query,
+	opts index.QueryOptions,
+) (client.SeriesIteratorsStream, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return s.session.FetchTaggedStream(ctx, namespace, q, opts)
+}
+
 // FetchTaggedIDs resolves the provided query to known IDs.
 func (s *AsyncSession) FetchTaggedIDs(
 	ctx context.Context,
@@ -210,6 +227,22 @@ func (s *AsyncSession) Aggregate(
 	return s.session.Aggregate(ctx, namespace, q, opts)
 }
 
+// Cardinality returns the number of series per tag name and value pair.
+func (s *AsyncSession) Cardinality(
+	ctx context.Context,
+	namespace ident.ID,
+	q index.Query,
+	opts index.CardinalityOptions,
+) (index.CardinalityResults, client.FetchResponseMetadata, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return nil, client.FetchResponseMetadata{}, s.err
+	}
+
+	return s.session.Cardinality(ctx, namespace, q, opts)
+}
+
 // ShardID returns the given shard for an ID for callers
 // to easily discern what shard is failing when operations
 // for given IDs begin failing.
@@ -62,6 +62,11 @@ const (
 	// SourceHeader tracks bytes and docs read for the given source, if provided.
 	SourceHeader = M3HeaderPrefix + "Source"
 
+	// TenantHeader identifies the tenant a request is made on behalf of when
+	// tenancy is enabled, writes are tagged with the tenant and reads are
+	// restricted to series tagged with the tenant.
+	TenantHeader = M3HeaderPrefix + "Tenant"
+
 	// DefaultWriteType is the default write type.
 	DefaultWriteType = "default"
 